@@ -65,6 +65,13 @@ export enum OrderRemoval_RemovalReason {
    *  would lead to the subaccount violating isolated subaccount constraints.
    */
   REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS = 8,
+
+  /**
+   * REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION - REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION represents a removal of an
+   * order whose fill would open a new position for a subaccount with less
+   * than the minimum collateral required to open a position.
+   */
+  REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION = 9,
   UNRECOGNIZED = -1,
 }
 export enum OrderRemoval_RemovalReasonSDKType {
@@ -131,6 +138,13 @@ export enum OrderRemoval_RemovalReasonSDKType {
    *  would lead to the subaccount violating isolated subaccount constraints.
    */
   REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS = 8,
+
+  /**
+   * REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION - REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION represents a removal of an
+   * order whose fill would open a new position for a subaccount with less
+   * than the minimum collateral required to open a position.
+   */
+  REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION = 9,
   UNRECOGNIZED = -1,
 }
 export function orderRemoval_RemovalReasonFromJSON(object: any): OrderRemoval_RemovalReason {
@@ -171,6 +185,10 @@ export function orderRemoval_RemovalReasonFromJSON(object: any): OrderRemoval_Re
     case "REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS":
       return OrderRemoval_RemovalReason.REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS;
 
+    case 9:
+    case "REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION":
+      return OrderRemoval_RemovalReason.REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -206,6 +224,9 @@ export function orderRemoval_RemovalReasonToJSON(object: OrderRemoval_RemovalRea
     case OrderRemoval_RemovalReason.REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
       return "REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS";
 
+    case OrderRemoval_RemovalReason.REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION:
+      return "REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION";
+
     case OrderRemoval_RemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
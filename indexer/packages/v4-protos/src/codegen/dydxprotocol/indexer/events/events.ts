@@ -1479,6 +1479,30 @@ export interface LiquidityTierUpsertEventV2SDKType {
 
   open_interest_upper_cap: Long;
 }
+/**
+ * DelayedMessageExecutionEventV1 message contains all the information about a
+ * delayed message executed by the x/delaymsg module.
+ */
+
+export interface DelayedMessageExecutionEventV1 {
+  /** The id of the executed delayed message. */
+  id: number;
+  /** The type url of the executed message. */
+
+  msgTypeUrl: string;
+}
+/**
+ * DelayedMessageExecutionEventV1 message contains all the information about a
+ * delayed message executed by the x/delaymsg module.
+ */
+
+export interface DelayedMessageExecutionEventV1SDKType {
+  /** The id of the executed delayed message. */
+  id: number;
+  /** The type url of the executed message. */
+
+  msg_type_url: string;
+}
 
 function createBaseFundingUpdateV1(): FundingUpdateV1 {
   return {
@@ -3713,4 +3737,59 @@ export const LiquidityTierUpsertEventV2 = {
     return message;
   }
 
+};
+
+function createBaseDelayedMessageExecutionEventV1(): DelayedMessageExecutionEventV1 {
+  return {
+    id: 0,
+    msgTypeUrl: ""
+  };
+}
+
+export const DelayedMessageExecutionEventV1 = {
+  encode(message: DelayedMessageExecutionEventV1, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).uint32(message.id);
+    }
+
+    if (message.msgTypeUrl !== "") {
+      writer.uint32(18).string(message.msgTypeUrl);
+    }
+
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DelayedMessageExecutionEventV1 {
+    const reader = input instanceof _m0.Reader ? input : new _m0.Reader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDelayedMessageExecutionEventV1();
+
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+
+      switch (tag >>> 3) {
+        case 1:
+          message.id = reader.uint32();
+          break;
+
+        case 2:
+          message.msgTypeUrl = reader.string();
+          break;
+
+        default:
+          reader.skipType(tag & 7);
+          break;
+      }
+    }
+
+    return message;
+  },
+
+  fromPartial(object: DeepPartial<DelayedMessageExecutionEventV1>): DelayedMessageExecutionEventV1 {
+    const message = createBaseDelayedMessageExecutionEventV1();
+    message.id = object.id ?? 0;
+    message.msgTypeUrl = object.msgTypeUrl ?? "";
+    return message;
+  }
+
 };
\ No newline at end of file
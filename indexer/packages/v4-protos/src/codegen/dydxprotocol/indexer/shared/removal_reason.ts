@@ -84,6 +84,38 @@ export enum OrderRemovalReason {
    * violating isolated subaccount constraints.
    */
   ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS = 15,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP - The order has been removed since its orderbook has reached the maximum
+   * number of resting orders.
+   */
+  ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP = 16,
+
+  /**
+   * ORDER_REMOVAL_REASON_MARKET_DELISTED - The order was an untriggered conditional order that has been removed
+   * since the market its trigger references has been delisted or no longer
+   * exists.
+   */
+  ORDER_REMOVAL_REASON_MARKET_DELISTED = 17,
+
+  /**
+   * ORDER_REMOVAL_REASON_FORCE_CANCELED - The stateful order has been forcefully canceled by the protocol, bypassing
+   * the usual order cancellation validation.
+   */
+  ORDER_REMOVAL_REASON_FORCE_CANCELED = 18,
+
+  /**
+   * ORDER_REMOVAL_REASON_STALE_MARKET_PRICE - The order has been removed since filling it would increase the risk of
+   * the subaccount while the oracle price of its market is stale.
+   */
+  ORDER_REMOVAL_REASON_STALE_MARKET_PRICE = 19,
+
+  /**
+   * ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION - The order has been removed since filling it would open a new position for
+   * a subaccount with less than the minimum collateral required to open a
+   * position.
+   */
+  ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION = 20,
   UNRECOGNIZED = -1,
 }
 /** OrderRemovalReason is an enum of all the reasons an order was removed. */
@@ -173,6 +205,38 @@ export enum OrderRemovalReasonSDKType {
    * violating isolated subaccount constraints.
    */
   ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS = 15,
+
+  /**
+   * ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP - The order has been removed since its orderbook has reached the maximum
+   * number of resting orders.
+   */
+  ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP = 16,
+
+  /**
+   * ORDER_REMOVAL_REASON_MARKET_DELISTED - The order was an untriggered conditional order that has been removed
+   * since the market its trigger references has been delisted or no longer
+   * exists.
+   */
+  ORDER_REMOVAL_REASON_MARKET_DELISTED = 17,
+
+  /**
+   * ORDER_REMOVAL_REASON_FORCE_CANCELED - The stateful order has been forcefully canceled by the protocol, bypassing
+   * the usual order cancellation validation.
+   */
+  ORDER_REMOVAL_REASON_FORCE_CANCELED = 18,
+
+  /**
+   * ORDER_REMOVAL_REASON_STALE_MARKET_PRICE - The order has been removed since filling it would increase the risk of
+   * the subaccount while the oracle price of its market is stale.
+   */
+  ORDER_REMOVAL_REASON_STALE_MARKET_PRICE = 19,
+
+  /**
+   * ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION - The order has been removed since filling it would open a new position for
+   * a subaccount with less than the minimum collateral required to open a
+   * position.
+   */
+  ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION = 20,
   UNRECOGNIZED = -1,
 }
 export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
@@ -241,6 +305,26 @@ export function orderRemovalReasonFromJSON(object: any): OrderRemovalReason {
     case "ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS":
       return OrderRemovalReason.ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS;
 
+    case 16:
+    case "ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP;
+
+    case 17:
+    case "ORDER_REMOVAL_REASON_MARKET_DELISTED":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_MARKET_DELISTED;
+
+    case 18:
+    case "ORDER_REMOVAL_REASON_FORCE_CANCELED":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_FORCE_CANCELED;
+
+    case 19:
+    case "ORDER_REMOVAL_REASON_STALE_MARKET_PRICE":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_STALE_MARKET_PRICE;
+
+    case 20:
+    case "ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION":
+      return OrderRemovalReason.ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION;
+
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -297,6 +381,21 @@ export function orderRemovalReasonToJSON(object: OrderRemovalReason): string {
     case OrderRemovalReason.ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
       return "ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS";
 
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP:
+      return "ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_MARKET_DELISTED:
+      return "ORDER_REMOVAL_REASON_MARKET_DELISTED";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_FORCE_CANCELED:
+      return "ORDER_REMOVAL_REASON_FORCE_CANCELED";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_STALE_MARKET_PRICE:
+      return "ORDER_REMOVAL_REASON_STALE_MARKET_PRICE";
+
+    case OrderRemovalReason.ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION:
+      return "ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION";
+
     case OrderRemovalReason.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
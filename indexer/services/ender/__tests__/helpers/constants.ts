@@ -36,6 +36,7 @@ import {
   UpdateClobPairEventV1,
   UpdatePerpetualEventV1,
   OpenInterestUpdateEventV1,
+  DelayedMessageExecutionEventV1,
   OpenInterestUpdate,
 } from '@dydxprotocol-indexer/v4-protos';
 import Long from 'long';
@@ -191,6 +192,11 @@ export const defaultOpenInterestUpdateEvent: OpenInterestUpdateEventV1 = {
   openInterestUpdates: [defaultOpenInterestUpdate1, defaultOpenInterestUpdate2],
 };
 
+export const defaultDelayedMessageExecutionEvent: DelayedMessageExecutionEventV1 = {
+  id: 0,
+  msgTypeUrl: '/dydxprotocol.bridge.MsgCompleteBridge',
+};
+
 export const defaultUpdatePerpetualEvent: UpdatePerpetualEventV1 = {
   id: 0,
   ticker: 'BTC-USD2',
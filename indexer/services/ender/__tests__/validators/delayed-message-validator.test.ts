@@ -0,0 +1,75 @@
+import { logger, ParseMessageError } from '@dydxprotocol-indexer/base';
+import {
+  DelayedMessageExecutionEventV1,
+  IndexerTendermintBlock,
+  IndexerTendermintEvent,
+} from '@dydxprotocol-indexer/v4-protos';
+import { DydxIndexerSubtypes } from '../../src/lib/types';
+import {
+  defaultDelayedMessageExecutionEvent,
+  defaultHeight,
+  defaultTime,
+} from '../helpers/constants';
+import {
+  createIndexerTendermintBlock,
+  createIndexerTendermintEvent,
+} from '../helpers/indexer-proto-helpers';
+import { expectDidntLogError } from '../helpers/validator-helpers';
+import { DelayedMessageValidator } from '../../src/validators/delayed-message-validator';
+
+describe('delayed-message-validator', () => {
+  beforeEach(() => {
+    jest.spyOn(logger, 'error');
+  });
+
+  afterEach(() => {
+    jest.clearAllMocks();
+  });
+
+  describe('validate', () => {
+    it('does not throw error on valid delayed message execution event', () => {
+      const validator: DelayedMessageValidator = new DelayedMessageValidator(
+        defaultDelayedMessageExecutionEvent,
+        createBlock(defaultDelayedMessageExecutionEvent),
+        0,
+      );
+
+      validator.validate();
+      expectDidntLogError();
+    });
+
+    it('throws error if msgTypeUrl is not populated', () => {
+      const event: DelayedMessageExecutionEventV1 = {
+        ...defaultDelayedMessageExecutionEvent,
+        msgTypeUrl: '',
+      };
+      const validator: DelayedMessageValidator = new DelayedMessageValidator(
+        event,
+        createBlock(event),
+        0,
+      );
+
+      expect(() => validator.validate()).toThrow(new ParseMessageError(
+        'DelayedMessageExecutionEventV1 msgTypeUrl is not populated',
+      ));
+    });
+  });
+});
+
+function createBlock(
+  delayedMessageExecutionEvent: DelayedMessageExecutionEventV1,
+): IndexerTendermintBlock {
+  const event: IndexerTendermintEvent = createIndexerTendermintEvent(
+    DydxIndexerSubtypes.DELAYED_MESSAGE,
+    DelayedMessageExecutionEventV1.encode(delayedMessageExecutionEvent).finish(),
+    -1,
+    0,
+  );
+
+  return createIndexerTendermintBlock(
+    defaultHeight,
+    defaultTime,
+    [event],
+    [],
+  );
+}
@@ -0,0 +1,31 @@
+import { logger } from '@dydxprotocol-indexer/base';
+import { DelayedMessageExecutionEventV1 } from '@dydxprotocol-indexer/v4-protos';
+import * as pg from 'pg';
+
+import { ConsolidatedKafkaEvent } from '../lib/types';
+import { Handler } from './handler';
+
+/**
+ * Handles delayed messages executed by the x/delaymsg module. The effects of the executed message
+ * are emitted as separate events, so the execution itself is only logged.
+ */
+export class DelayedMessageHandler extends Handler<DelayedMessageExecutionEventV1> {
+  eventType: string = 'DelayedMessageExecutionEventV1';
+
+  public getParallelizationIds(): string[] {
+    return [];
+  }
+
+  // eslint-disable-next-line @typescript-eslint/require-await
+  public async internalHandle(_: pg.QueryResultRow): Promise<ConsolidatedKafkaEvent[]> {
+    logger.info({
+      at: 'DelayedMessageHandler#handle',
+      message: 'Received DelayedMessageExecutionEvent',
+      blockHeight: this.block.height,
+      delayedMessageId: this.event.id,
+      msgTypeUrl: this.event.msgTypeUrl,
+    });
+
+    return [];
+  }
+}
@@ -16,6 +16,7 @@ import { DatabaseError } from 'pg';
 import config from '../config';
 import { Handler } from '../handlers/handler';
 import { AssetValidator } from '../validators/asset-validator';
+import { DelayedMessageValidator } from '../validators/delayed-message-validator';
 import { DeleveragingValidator } from '../validators/deleveraging-validator';
 import { FundingValidator } from '../validators/funding-validator';
 import { LiquidityTierValidatorV2, LiquidityTierValidator } from '../validators/liquidity-tier-validator';
@@ -60,6 +61,7 @@ const BLOCK_EVENT_SUBTYPE_VERSION_TO_VALIDATOR_MAPPING: Record<string, Validator
   [serializeSubtypeAndVersion(DydxIndexerSubtypes.TRADING_REWARD.toString(), 1)]: TradingRewardsValidator,
   [serializeSubtypeAndVersion(DydxIndexerSubtypes.STATEFUL_ORDER.toString(), 1)]: StatefulOrderValidator,
   [serializeSubtypeAndVersion(DydxIndexerSubtypes.OPEN_INTEREST_UPDATE.toString(), 1)]: OpenInterestUpdateValidator,
+  [serializeSubtypeAndVersion(DydxIndexerSubtypes.DELAYED_MESSAGE.toString(), 1)]: DelayedMessageValidator,
 };
 
 function serializeSubtypeAndVersion(
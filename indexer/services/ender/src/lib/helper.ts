@@ -26,6 +26,7 @@ import {
   DeleveragingEventV1,
   OpenInterestUpdateEventV1,
   TradingRewardsEventV1,
+  DelayedMessageExecutionEventV1,
 } from '@dydxprotocol-indexer/v4-protos';
 import Big from 'big.js';
 import _ from 'lodash';
@@ -241,6 +242,15 @@ export function indexerTendermintEventToEventProtoWithType(
         blockEventIndex,
       };
     }
+    case (DydxIndexerSubtypes.DELAYED_MESSAGE.toString()): {
+      return {
+        type: DydxIndexerSubtypes.DELAYED_MESSAGE,
+        eventProto: DelayedMessageExecutionEventV1.decode(eventDataBinary),
+        indexerTendermintEvent: event,
+        version,
+        blockEventIndex,
+      };
+    }
     default: {
       const message: string = `Unable to parse event subtype: ${event.subtype}`;
       logger.error({
@@ -35,6 +35,7 @@ import {
   DeleveragingEventV1,
   TradingRewardsEventV1,
   OpenInterestUpdateEventV1,
+  DelayedMessageExecutionEventV1,
   BlockHeightMessage,
 } from '@dydxprotocol-indexer/v4-protos';
 import { IHeaders } from 'kafkajs';
@@ -57,6 +58,7 @@ export enum DydxIndexerSubtypes {
   DELEVERAGING = 'deleveraging',
   TRADING_REWARD = 'trading_reward',
   OPEN_INTEREST_UPDATE = 'open_interest_update',
+  DELAYED_MESSAGE = 'delayed_message',
 }
 
 // Generic interface used for creating the Handler objects
@@ -165,6 +167,12 @@ export type EventProtoWithTypeAndVersion = {
   indexerTendermintEvent: IndexerTendermintEvent,
   version: number,
   blockEventIndex: number,
+} | {
+  type: DydxIndexerSubtypes.DELAYED_MESSAGE,
+  eventProto: DelayedMessageExecutionEventV1,
+  indexerTendermintEvent: IndexerTendermintEvent,
+  version: number,
+  blockEventIndex: number,
 });
 
 // Events grouped into events block events and events for each transactionIndex
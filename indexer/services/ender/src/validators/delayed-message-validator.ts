@@ -0,0 +1,32 @@
+import { DelayedMessageExecutionEventV1, IndexerTendermintEvent } from '@dydxprotocol-indexer/v4-protos';
+
+import { DelayedMessageHandler } from '../handlers/delayed-message-handler';
+import { Handler } from '../handlers/handler';
+import { Validator } from './validator';
+
+export class DelayedMessageValidator extends Validator<DelayedMessageExecutionEventV1> {
+  public validate(): void {
+    if (this.event.msgTypeUrl === '') {
+      return this.logAndThrowParseMessageError(
+        'DelayedMessageExecutionEventV1 msgTypeUrl is not populated',
+        { event: this.event },
+      );
+    }
+  }
+
+  public createHandlers(
+    indexerTendermintEvent: IndexerTendermintEvent,
+    txId: number,
+    _: string,
+  ): Handler<DelayedMessageExecutionEventV1>[] {
+    const handler: Handler<DelayedMessageExecutionEventV1> = new DelayedMessageHandler(
+      this.block,
+      this.blockEventIndex,
+      indexerTendermintEvent,
+      txId,
+      this.event,
+    );
+
+    return [handler];
+  }
+}
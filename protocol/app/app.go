@@ -160,6 +160,7 @@ import (
 	listingmodulekeeper "github.com/dydxprotocol/v4-chain/protocol/x/listing/keeper"
 	listingmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/listing/types"
 	perpetualsmodule "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
+	perpetualsflags "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/flags"
 	perpetualsmodulekeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
 	perpetualsmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricesmodule "github.com/dydxprotocol/v4-chain/protocol/x/prices"
@@ -745,6 +746,7 @@ func New(
 		msgSender,
 		tkeys[indexer_manager.TransientStoreKey],
 		indexerFlags.SendOffchainData,
+		indexerFlags.MaxTxnEventsPerBlock,
 	)
 
 	app.FullNodeStreamingManager = getFullNodeStreamingManagerFromOptions(appFlags, logger)
@@ -841,6 +843,7 @@ func New(
 					constants.StaticExchangeDetails,
 					&pricefeedclient.SubTaskRunnerImpl{},
 				)
+				app.Server.WithPriceFeedExchangeHealthProvider(app.PriceFeedClient)
 				app.RegisterDaemonWithHealthMonitor(app.PriceFeedClient, maxDaemonUnhealthyDuration)
 			}
 			if daemonFlags.Slinky.Enabled {
@@ -958,6 +961,7 @@ func New(
 		[]string{
 			lib.GovModuleAddress.String(),
 		},
+		app.IndexerEventManager,
 	)
 	delayMsgModule := delaymsgmodule.NewAppModule(appCodec, app.DelayMsgKeeper)
 
@@ -975,6 +979,9 @@ func New(
 	)
 	bridgeModule := bridgemodule.NewAppModule(appCodec, app.BridgeKeeper)
 
+	perpetualsFlags := perpetualsflags.GetPerpetualsFlagValuesFromOptions(appOpts)
+	logger.Info("Parsed Perpetuals flags", "Flags", perpetualsFlags)
+
 	app.PerpetualsKeeper = perpetualsmodulekeeper.NewKeeper(
 		appCodec,
 		keys[perpetualsmoduletypes.StoreKey],
@@ -987,6 +994,7 @@ func New(
 			delaymsgmoduletypes.ModuleAddress.String(),
 		},
 		tkeys[perpetualsmoduletypes.TransientStoreKey],
+		perpetualsFlags,
 	)
 	perpetualsModule := perpetualsmodule.NewAppModule(appCodec, app.PerpetualsKeeper)
 
@@ -1101,6 +1109,7 @@ func New(
 		app.SubaccountsKeeper,
 	)
 	app.PerpetualsKeeper.SetClobKeeper(app.ClobKeeper)
+	app.PerpetualsKeeper.SetQueryContextCreator(app.CreateQueryContext)
 
 	app.SendingKeeper = *sendingmodulekeeper.NewKeeper(
 		appCodec,
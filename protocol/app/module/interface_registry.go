@@ -86,6 +86,9 @@ func NewInterfaceRegistry(addrPrefix string, valAddrPrefix string) (types.Interf
 				"dydxprotocol.clob.MsgPlaceOrder": getLegacyMsgSignerFn(
 					[]string{"order", "order_id", "subaccount_id", "owner"},
 				),
+				"dydxprotocol.clob.MsgReduceOrder": getLegacyMsgSignerFn(
+					[]string{"order_id", "subaccount_id", "owner"},
+				),
 				"dydxprotocol.sending.MsgCreateTransfer": getLegacyMsgSignerFn(
 					[]string{"transfer", "sender", "owner"},
 				),
@@ -171,13 +171,19 @@ var (
 		"/dydxprotocol.clob.MsgCancelOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgCreateClobPair":                             {},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     {},
+		"/dydxprotocol.clob.MsgDelistPerpetualMarket":                      {},
+		"/dydxprotocol.clob.MsgDelistPerpetualMarketResponse":              {},
 		"/dydxprotocol.clob.MsgPlaceOrder":                                 {},
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":                         {},
 		"/dydxprotocol.clob.MsgProposedOperations":                         {},
 		"/dydxprotocol.clob.MsgProposedOperationsResponse":                 {},
+		"/dydxprotocol.clob.MsgReduceOrder":                                {},
+		"/dydxprotocol.clob.MsgReduceOrderResponse":                        {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          {},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  {},
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             {},
+		"/dydxprotocol.clob.MsgUpdateClobPairConfig":                       {},
+		"/dydxprotocol.clob.MsgUpdateClobPairConfigResponse":               {},
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse":                     {},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration":         {},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": {},
@@ -122,9 +122,13 @@ var (
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair":                             &clob.MsgCreateClobPair{},
 		"/dydxprotocol.clob.MsgCreateClobPairResponse":                     nil,
+		"/dydxprotocol.clob.MsgDelistPerpetualMarket":                      &clob.MsgDelistPerpetualMarket{},
+		"/dydxprotocol.clob.MsgDelistPerpetualMarketResponse":              nil,
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration":          &clob.MsgUpdateBlockRateLimitConfiguration{},
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse":  nil,
 		"/dydxprotocol.clob.MsgUpdateClobPair":                             &clob.MsgUpdateClobPair{},
+		"/dydxprotocol.clob.MsgUpdateClobPairConfig":                       &clob.MsgUpdateClobPairConfig{},
+		"/dydxprotocol.clob.MsgUpdateClobPairConfigResponse":               nil,
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse":                     nil,
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration":         &clob.MsgUpdateEquityTierLimitConfiguration{},
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse": nil,
@@ -80,9 +80,13 @@ func TestInternalMsgSamples_Gov_Key(t *testing.T) {
 		// clob
 		"/dydxprotocol.clob.MsgCreateClobPair",
 		"/dydxprotocol.clob.MsgCreateClobPairResponse",
+		"/dydxprotocol.clob.MsgDelistPerpetualMarket",
+		"/dydxprotocol.clob.MsgDelistPerpetualMarketResponse",
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfiguration",
 		"/dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse",
 		"/dydxprotocol.clob.MsgUpdateClobPair",
+		"/dydxprotocol.clob.MsgUpdateClobPairConfig",
+		"/dydxprotocol.clob.MsgUpdateClobPairConfigResponse",
 		"/dydxprotocol.clob.MsgUpdateClobPairResponse",
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfiguration",
 		"/dydxprotocol.clob.MsgUpdateEquityTierLimitConfigurationResponse",
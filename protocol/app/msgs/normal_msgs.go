@@ -223,6 +223,8 @@ var (
 		"/dydxprotocol.clob.MsgCancelOrderResponse": nil,
 		"/dydxprotocol.clob.MsgPlaceOrder":          &clob.MsgPlaceOrder{},
 		"/dydxprotocol.clob.MsgPlaceOrderResponse":  nil,
+		"/dydxprotocol.clob.MsgReduceOrder":         &clob.MsgReduceOrder{},
+		"/dydxprotocol.clob.MsgReduceOrderResponse": nil,
 
 		// perpetuals
 
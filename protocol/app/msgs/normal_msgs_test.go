@@ -125,6 +125,8 @@ func TestNormalMsgs_Key(t *testing.T) {
 		"/dydxprotocol.clob.MsgCancelOrderResponse",
 		"/dydxprotocol.clob.MsgPlaceOrder",
 		"/dydxprotocol.clob.MsgPlaceOrderResponse",
+		"/dydxprotocol.clob.MsgReduceOrder",
+		"/dydxprotocol.clob.MsgReduceOrderResponse",
 
 		// perpetuals
 
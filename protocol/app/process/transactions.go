@@ -2,6 +2,7 @@ package process
 
 import (
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,24 +11,48 @@ import (
 )
 
 const (
-	minTxsCount                   = 4
 	proposedOperationsTxIndex     = 0
 	updateMarketPricesTxLenOffset = -1
 	addPremiumVotesTxLenOffset    = -2
 	acknowledgeBridgesTxLenOffset = -3
 	lastOtherTxLenOffset          = acknowledgeBridgesTxLenOffset
 	firstOtherTxIndex             = proposedOperationsTxIndex + 1
+
+	// dependencyInjectedTxName is the name of txs injected by dependencies, such as vote-extensions.
+	dependencyInjectedTxName = "DependencyInjectedTx"
 )
 
+// injectedTxSlot is a tx that the block proposer must inject into every proposal.
+type injectedTxSlot struct {
+	// name is used to identify the tx in error messages.
+	name string
+	// indexOrLenOffset is the index of the tx in the proposal if non-negative. Otherwise, it is
+	// the offset of the tx from the end of the proposal.
+	indexOrLenOffset int
+}
+
+// requiredInjectedTxSlots are the txs that must be injected into every proposal, in the order in
+// which they appear in the proposal.
+var requiredInjectedTxSlots = []injectedTxSlot{
+	{name: "ProposedOperationsTx", indexOrLenOffset: proposedOperationsTxIndex},
+	{name: "AcknowledgeBridgesTx", indexOrLenOffset: acknowledgeBridgesTxLenOffset},
+	{name: "AddPremiumVotesTx", indexOrLenOffset: addPremiumVotesTxLenOffset},
+	{name: "UpdateMarketPricesTx", indexOrLenOffset: updateMarketPricesTxLenOffset},
+}
+
+// minTxsCount is the minimum number of txs in a proposal, not accounting for txs injected by dependencies.
+var minTxsCount = len(requiredInjectedTxSlots)
+
 func init() {
-	txIndicesAndOffsets := []int{
-		proposedOperationsTxIndex,
-		acknowledgeBridgesTxLenOffset,
-		addPremiumVotesTxLenOffset,
-		updateMarketPricesTxLenOffset,
-	}
-	if minTxsCount != len(txIndicesAndOffsets) {
-		panic("minTxsCount does not match expected count of Txs.")
+	txIndicesAndOffsets := make([]int, 0, len(requiredInjectedTxSlots))
+	txIndicesForMinTxsCount := make([]int, 0, len(requiredInjectedTxSlots))
+	for _, slot := range requiredInjectedTxSlots {
+		txIndicesAndOffsets = append(txIndicesAndOffsets, slot.indexOrLenOffset)
+		if slot.indexOrLenOffset < 0 {
+			txIndicesForMinTxsCount = append(txIndicesForMinTxsCount, slot.indexOrLenOffset+minTxsCount)
+		} else {
+			txIndicesForMinTxsCount = append(txIndicesForMinTxsCount, slot.indexOrLenOffset)
+		}
 	}
 	if lib.ContainsDuplicates(txIndicesAndOffsets) {
 		panic("Duplicate indices/offsets defined for Txs.")
@@ -38,23 +63,34 @@ func init() {
 	if slices.Max[[]int](txIndicesAndOffsets)+1 != firstOtherTxIndex {
 		panic("firstOtherTxIndex is <= the maximum offset")
 	}
-	txIndicesForMinTxsCount := []int{
-		proposedOperationsTxIndex,
-		acknowledgeBridgesTxLenOffset + minTxsCount,
-		addPremiumVotesTxLenOffset + minTxsCount,
-		updateMarketPricesTxLenOffset + minTxsCount,
-	}
-	if minTxsCount != len(txIndicesForMinTxsCount) {
-		panic("minTxsCount does not match expected count of Txs.")
-	}
 	if lib.ContainsDuplicates(txIndicesForMinTxsCount) {
 		panic("Overlapping indices and offsets defined for Txs.")
 	}
+	if !slices.IsSorted(txIndicesForMinTxsCount) {
+		panic("requiredInjectedTxSlots are not in the order in which they appear in the proposal.")
+	}
 	if minTxsCount != firstOtherTxIndex-lastOtherTxLenOffset {
 		panic("Unexpected gap between firstOtherTxIndex and lastOtherTxLenOffset which is greater than minTxsCount")
 	}
 }
 
+// getMissingInjectedTxNames returns the names of the injected txs missing from a proposal containing
+// `numTxs` txs, where `offset` txs are injected by dependencies ahead of the required injected txs.
+// Txs present in the proposal are assumed to fill the injected tx slots in proposal order.
+func getMissingInjectedTxNames(numTxs int, offset int) []string {
+	names := make([]string, 0, offset+len(requiredInjectedTxSlots))
+	for i := 0; i < offset; i++ {
+		names = append(names, dependencyInjectedTxName)
+	}
+	for _, slot := range requiredInjectedTxSlots {
+		names = append(names, slot.name)
+	}
+	if numTxs >= len(names) {
+		return nil
+	}
+	return names[numTxs:]
+}
+
 // ProcessProposalTxs is used as an intermediary struct to validate a proposed list of txs
 // for `ProcessProposal`.
 type ProcessProposalTxs struct {
@@ -83,9 +119,10 @@ func DecodeProcessProposalTxs(
 	if numTxs < injectedTxCount {
 		return nil, errorsmod.Wrapf(
 			ErrUnexpectedNumMsgs,
-			"Expected the proposal to contain at least %d txs, but got %d",
+			"Expected the proposal to contain at least %d txs, but got %d, missing injected txs: %s",
 			injectedTxCount,
 			numTxs,
+			strings.Join(getMissingInjectedTxNames(numTxs, offset), ", "),
 		)
 	}
 
@@ -171,3 +208,18 @@ func (ppt *ProcessProposalTxs) Validate() error {
 
 	return nil
 }
+
+// AllMsgs returns all the msgs across the txs in the order in which they appear in the proposal:
+// proposed operations, other txs, acknowledge bridges, add premium votes and update market prices.
+func (ppt *ProcessProposalTxs) AllMsgs() []sdk.Msg {
+	msgs := []sdk.Msg{ppt.ProposedOperationsTx.GetMsg()}
+	for _, mmt := range ppt.OtherTxs {
+		msgs = append(msgs, mmt.GetMsgs()...)
+	}
+	return append(
+		msgs,
+		ppt.AcknowledgeBridgesTx.GetMsg(),
+		ppt.AddPremiumVotesTx.GetMsg(),
+		ppt.UpdateMarketPricesTx.GetMsg(),
+	)
+}
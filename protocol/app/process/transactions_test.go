@@ -43,7 +43,24 @@ func TestDecodeProcessProposalTxs_Error(t *testing.T) {
 			txsBytes: [][]byte{validOperationsTx, validAddFundingTx, validUpdatePriceTx}, // need at least 4.
 			expectedErr: errorsmod.Wrapf(
 				process.ErrUnexpectedNumMsgs,
-				"Expected the proposal to contain at least 4 txs, but got 3",
+				"Expected the proposal to contain at least 4 txs, but got 3, missing injected txs: "+
+					"UpdateMarketPricesTx",
+			),
+		},
+		"Less than min num txs: multiple missing": {
+			txsBytes: [][]byte{validOperationsTx},
+			expectedErr: errorsmod.Wrapf(
+				process.ErrUnexpectedNumMsgs,
+				"Expected the proposal to contain at least 4 txs, but got 1, missing injected txs: "+
+					"AcknowledgeBridgesTx, AddPremiumVotesTx, UpdateMarketPricesTx",
+			),
+		},
+		"Less than min num txs: no txs": {
+			txsBytes: [][]byte{},
+			expectedErr: errorsmod.Wrapf(
+				process.ErrUnexpectedNumMsgs,
+				"Expected the proposal to contain at least 4 txs, but got 0, missing injected txs: "+
+					"ProposedOperationsTx, AcknowledgeBridgesTx, AddPremiumVotesTx, UpdateMarketPricesTx",
 			),
 		},
 		"Order tx decoding fails": {
@@ -102,6 +119,48 @@ func TestDecodeProcessProposalTxs_Error(t *testing.T) {
 				"Invalid msg type or content in OtherTxs *types.MsgUpdateMarketPrices",
 			),
 		},
+		"Other txs fails: app-injected proposed operations msg": {
+			txsBytes: [][]byte{
+				validOperationsTx,
+				validSendTx,       // other tx: valid.
+				validOperationsTx, // other tx: invalid due to app-injected msg.
+				validAcknowledgeBridgesTx,
+				validAddFundingTx,
+				validUpdatePriceTx,
+			},
+			expectedErr: errorsmod.Wrapf(
+				process.ErrUnexpectedMsgType,
+				"Invalid msg type or content in OtherTxs *types.MsgProposedOperations",
+			),
+		},
+		"Other txs fails: app-injected add premium votes msg": {
+			txsBytes: [][]byte{
+				validOperationsTx,
+				validSendTx,       // other tx: valid.
+				validAddFundingTx, // other tx: invalid due to app-injected msg.
+				validAcknowledgeBridgesTx,
+				validAddFundingTx,
+				validUpdatePriceTx,
+			},
+			expectedErr: errorsmod.Wrapf(
+				process.ErrUnexpectedMsgType,
+				"Invalid msg type or content in OtherTxs *types.MsgAddPremiumVotes",
+			),
+		},
+		"Other txs fails: app-injected acknowledge bridges msg": {
+			txsBytes: [][]byte{
+				validOperationsTx,
+				validSendTx,               // other tx: valid.
+				validAcknowledgeBridgesTx, // other tx: invalid due to app-injected msg.
+				validAcknowledgeBridgesTx,
+				validAddFundingTx,
+				validUpdatePriceTx,
+			},
+			expectedErr: errorsmod.Wrapf(
+				process.ErrUnexpectedMsgType,
+				"Invalid msg type or content in OtherTxs *types.MsgAcknowledgeBridges",
+			),
+		},
 	}
 
 	for name, tc := range tests {
@@ -227,6 +286,41 @@ func TestDecodeProcessProposalTxs_Valid(t *testing.T) {
 	}
 }
 
+func TestProcessProposalTxs_AllMsgs(t *testing.T) {
+	ctx, pricesKeeper, _, _, _, _ := keepertest.PricesKeepers(t)
+	_, bridgeKeeper, _, _, _, _, _ := keepertest.BridgeKeepers(t)
+
+	ppt, err := process.DecodeProcessProposalTxs(
+		ctx,
+		constants.TestEncodingCfg.TxConfig.TxDecoder(),
+		&abci.RequestProcessProposal{Txs: [][]byte{
+			constants.ValidEmptyMsgProposedOperationsTxBytes,
+			constants.Msg_Send_TxBytes,
+			constants.Msg_SendAndTransfer_TxBytes,
+			constants.MsgAcknowledgeBridges_Ids0_1_Height0_TxBytes,
+			constants.ValidMsgAddPremiumVotesTxBytes,
+			constants.ValidMsgUpdateMarketPricesTxBytes,
+		}},
+		bridgeKeeper,
+		process.NewDefaultUpdateMarketPriceTxDecoder(pricesKeeper, constants.TestEncodingCfg.TxConfig.TxDecoder()),
+	)
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]sdk.Msg{
+			constants.ValidEmptyMsgProposedOperations,
+			constants.Msg_Send,
+			constants.Msg_Send,
+			constants.Msg_Transfer,
+			constants.MsgAcknowledgeBridges_Ids0_1_Height0,
+			constants.ValidMsgAddPremiumVotes,
+			constants.ValidMsgUpdateMarketPrices,
+		},
+		ppt.AllMsgs(),
+	)
+}
+
 func TestProcessProposalTxs_Validate_Error(t *testing.T) {
 	encodingCfg := encoding.GetTestEncodingCfg()
 	txBuilder := encodingCfg.TxConfig.NewTxBuilder()
@@ -34,6 +34,8 @@ func (app *App) setupUpgradeHandlers() {
 			app.PricesKeeper,
 			app.MarketMapKeeper,
 			app.RevShareKeeper,
+			app.PerpetualsKeeper,
+			&app.StatsKeeper,
 		),
 	)
 }
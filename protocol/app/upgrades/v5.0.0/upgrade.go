@@ -244,6 +244,7 @@ func initializeOIMFCaps(
 			tier.ImpactNotional,
 			tier.OpenInterestLowerCap,
 			tier.OpenInterestUpperCap,
+			tier.PremiumCombinationMethod,
 		)
 		if err != nil {
 			panic(fmt.Sprintf("failed to set liquidity tier: %+v,\n err: %s", tier.Id, err))
@@ -10,8 +10,11 @@ import (
 	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perpkeeper "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	revsharetypes "github.com/dydxprotocol/v4-chain/protocol/x/revshare/types"
+	statskeeper "github.com/dydxprotocol/v4-chain/protocol/x/stats/keeper"
 	"github.com/skip-mev/slinky/oracle/config"
 	"github.com/skip-mev/slinky/providers/apis/dydx"
 	dydxtypes "github.com/skip-mev/slinky/providers/apis/dydx/types"
@@ -131,6 +134,20 @@ func initRevShareModuleState(
 	}
 }
 
+// initializePerpetualsFundingRatePeriod sets the funding rate period param, which did not exist
+// prior to this upgrade and therefore reads as zero, to its default of 8 hours.
+func initializePerpetualsFundingRatePeriod(ctx sdk.Context, perpetualsKeeper *perpkeeper.Keeper) {
+	params := perpetualsKeeper.GetParams(ctx)
+	if params.FundingRatePeriodSeconds != 0 {
+		return
+	}
+
+	params.FundingRatePeriodSeconds = perptypes.DefaultFundingRatePeriodSeconds
+	if err := perpetualsKeeper.SetParams(ctx, params); err != nil {
+		panic(fmt.Sprintf("failed to set x/perpetuals params: %s", err))
+	}
+}
+
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -138,6 +155,8 @@ func CreateUpgradeHandler(
 	pricesKeeper pricestypes.PricesKeeper,
 	mmKeeper marketmapkeeper.Keeper,
 	revShareKeeper revsharetypes.RevShareKeeper,
+	perpetualsKeeper *perpkeeper.Keeper,
+	statsKeeper *statskeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		sdkCtx := lib.UnwrapSDKContext(ctx, "app/upgrades")
@@ -154,6 +173,15 @@ func CreateUpgradeHandler(
 		// Initialize the rev share module state.
 		initRevShareModuleState(sdkCtx, revShareKeeper, pricesKeeper)
 
+		// Initialize the x/perpetuals funding rate period param.
+		initializePerpetualsFundingRatePeriod(sdkCtx, perpetualsKeeper)
+
+		// Index all existing perpetuals by the market they reference.
+		perpetualsKeeper.InitializePerpetualMarketIndex(sdkCtx)
+
+		// Initialize the x/stats global maker notional from the epochs within the window.
+		statsKeeper.InitializeGlobalMakerNotional(sdkCtx)
+
 		sdkCtx.Logger().Info("Successfully removed stateful orders from state")
 
 		return mm.RunMigrations(ctx, configurator, vm)
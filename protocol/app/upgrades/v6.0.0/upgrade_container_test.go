@@ -18,6 +18,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/testing/containertest"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	clobtypes "github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	revsharetypes "github.com/dydxprotocol/v4-chain/protocol/x/revshare/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
@@ -60,6 +61,7 @@ func postUpgradeChecks(node *containertest.Node, t *testing.T) {
 	postUpgradeMarketMapState(node, t)
 	postUpgradeStatefulOrderCheck(node, t)
 	postUpgradeMarketMapperRevShareChecks(node, t)
+	postUpgradePerpetualsParamsCheck(node, t)
 }
 
 func placeOrders(node *containertest.Node, t *testing.T) {
@@ -247,3 +249,15 @@ func postUpgradeMarketMapState(node *containertest.Node, t *testing.T) {
 
 	require.Equal(t, v_6_0_0.DefaultMarketMapParams, paramsResp.Params)
 }
+
+func postUpgradePerpetualsParamsCheck(node *containertest.Node, t *testing.T) {
+	// Check that the funding rate period is initialized to its default.
+	resp, err := containertest.Query(node, perptypes.NewQueryClient, perptypes.QueryClient.Params,
+		&perptypes.QueryParamsRequest{})
+	require.NoError(t, err)
+
+	params := perptypes.QueryParamsResponse{}
+	err = proto.UnmarshalText(resp.String(), &params)
+	require.NoError(t, err)
+	require.Equal(t, uint32(perptypes.DefaultFundingRatePeriodSeconds), params.Params.FundingRatePeriodSeconds)
+}
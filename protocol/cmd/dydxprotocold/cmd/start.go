@@ -5,6 +5,7 @@ import (
 	daemonflags "github.com/dydxprotocol/v4-chain/protocol/daemons/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer"
 	clobflags "github.com/dydxprotocol/v4-chain/protocol/x/clob/flags"
+	perpetualsflags "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/flags"
 	"github.com/spf13/cobra"
 )
 
@@ -23,6 +24,9 @@ func GetOptionWithCustomStartCmd() *RootCmdOption {
 
 		// Add clob flags.
 		clobflags.AddClobFlagsToCmd(cmd)
+
+		// Add perpetuals flags.
+		perpetualsflags.AddPerpetualsFlagsToCmd(cmd)
 	}
 	option.setCustomizeStartCmd(f)
 	return option
@@ -15,8 +15,16 @@ const (
 	FlagPanicOnDaemonFailureEnabled = "panic-on-daemon-failure-enabled"
 	FlagMaxDaemonUnhealthySeconds   = "max-daemon-unhealthy-seconds"
 
-	FlagPriceDaemonEnabled     = "price-daemon-enabled"
-	FlagPriceDaemonLoopDelayMs = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonEnabled                       = "price-daemon-enabled"
+	FlagPriceDaemonLoopDelayMs                   = "price-daemon-loop-delay-ms"
+	FlagPriceDaemonMaxConcurrentExchangeStartups = "price-daemon-max-concurrent-exchange-startups"
+	FlagPriceDaemonExchangeStartupStaggerMs      = "price-daemon-exchange-startup-stagger-ms"
+	FlagPriceDaemonExchangeFailureThreshold      = "price-daemon-exchange-failure-threshold"
+	FlagPriceDaemonExchangeFailureCooldownMs     = "price-daemon-exchange-failure-cooldown-ms"
+	FlagPriceDaemonMaxPriceAgeMs                 = "price-daemon-max-price-age-ms"
+	FlagPriceDaemonMinExchangesPerMarket         = "price-daemon-min-exchanges-per-market"
+	FlagPriceDaemonMarketParamQueryMaxAttempts   = "price-daemon-market-param-query-max-attempts"
+	FlagPriceDaemonMarketParamQueryRetryDelayMs  = "price-daemon-market-param-query-retry-delay-ms"
 
 	FlagBridgeDaemonEnabled        = "bridge-daemon-enabled"
 	FlagBridgeDaemonLoopDelayMs    = "bridge-daemon-loop-delay-ms"
@@ -70,6 +78,28 @@ type PriceFlags struct {
 	Enabled bool
 	// LoopDelayMs configures the update frequency of the price daemon.
 	LoopDelayMs uint32
+	// MaxConcurrentExchangeStartups configures the maximum number of exchanges whose subtasks are started
+	// at the same time on startup. A value of 0 starts all exchanges at once.
+	MaxConcurrentExchangeStartups uint32
+	// ExchangeStartupStaggerMs configures the delay between starting each batch of exchanges on startup.
+	ExchangeStartupStaggerMs uint32
+	// ExchangeFailureThreshold configures the number of consecutive failed queries to an exchange after which
+	// the exchange is no longer queried for `ExchangeFailureCooldownMs`. A value of 0 always queries the exchange.
+	ExchangeFailureThreshold uint32
+	// ExchangeFailureCooldownMs configures the delay before querying an exchange again after it is disabled.
+	ExchangeFailureCooldownMs uint32
+	// MaxPriceAgeMs configures the maximum age of exchange prices sent to the application. Older prices are
+	// dropped. A value of 0 sends prices of any age.
+	MaxPriceAgeMs uint32
+	// MinExchangesPerMarket configures the minimum number of exchange prices a market must have to be sent to the
+	// application. Markets with fewer exchange prices are dropped. A value of 0 or 1 sends all markets.
+	MinExchangesPerMarket uint32
+	// MarketParamQueryMaxAttempts configures the maximum number of attempts to query market params from the
+	// application before the market param updater gives up until its next run.
+	MarketParamQueryMaxAttempts uint32
+	// MarketParamQueryRetryDelayMs configures the base delay between attempts to query market params. The delay
+	// doubles after each failed attempt.
+	MarketParamQueryRetryDelayMs uint32
 }
 
 type SlinkyFlags struct {
@@ -107,8 +137,16 @@ func GetDefaultDaemonFlags() DaemonFlags {
 				QueryPageLimit: 1_000,
 			},
 			Price: PriceFlags{
-				Enabled:     false,
-				LoopDelayMs: 3_000,
+				Enabled:                       false,
+				LoopDelayMs:                   3_000,
+				MaxConcurrentExchangeStartups: 0,
+				ExchangeStartupStaggerMs:      1_000,
+				ExchangeFailureThreshold:      10,
+				ExchangeFailureCooldownMs:     60_000,
+				MaxPriceAgeMs:                 30_000,
+				MinExchangesPerMarket:         1,
+				MarketParamQueryMaxAttempts:   3,
+				MarketParamQueryRetryDelayMs:  100,
 			},
 			Slinky: SlinkyFlags{
 				AppConfig: oracleconfig.AppConfig{
@@ -195,6 +233,49 @@ func AddDaemonFlagsToCmd(
 		df.Price.LoopDelayMs,
 		"Delay in milliseconds between sending price updates to the application.",
 	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonMaxConcurrentExchangeStartups,
+		df.Price.MaxConcurrentExchangeStartups,
+		"Maximum number of exchanges started at the same time during Price Daemon startup. 0 means no limit.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonExchangeStartupStaggerMs,
+		df.Price.ExchangeStartupStaggerMs,
+		"Delay in milliseconds between starting each batch of exchanges during Price Daemon startup.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonExchangeFailureThreshold,
+		df.Price.ExchangeFailureThreshold,
+		"Number of consecutive failed queries after which the Price Daemon stops querying an exchange. "+
+			"0 means no limit.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonExchangeFailureCooldownMs,
+		df.Price.ExchangeFailureCooldownMs,
+		"Delay in milliseconds before the Price Daemon queries an exchange again after it stopped querying it.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonMaxPriceAgeMs,
+		df.Price.MaxPriceAgeMs,
+		"Maximum age in milliseconds of exchange prices sent to the application by the Price Daemon. "+
+			"0 means no limit.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonMinExchangesPerMarket,
+		df.Price.MinExchangesPerMarket,
+		"Minimum number of exchange prices a market must have to be sent to the application by the Price Daemon.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonMarketParamQueryMaxAttempts,
+		df.Price.MarketParamQueryMaxAttempts,
+		"Maximum number of attempts by the Price Daemon to query market params before waiting for the next update.",
+	)
+	cmd.Flags().Uint32(
+		FlagPriceDaemonMarketParamQueryRetryDelayMs,
+		df.Price.MarketParamQueryRetryDelayMs,
+		"Base delay in milliseconds between attempts by the Price Daemon to query market params. "+
+			"The delay doubles after each failed attempt.",
+	)
 
 	// Slinky Daemon.
 	cmd.Flags().Bool(
@@ -288,6 +369,46 @@ func GetDaemonFlagValuesFromOptions(
 			result.Price.LoopDelayMs = v
 		}
 	}
+	if option := appOpts.Get(FlagPriceDaemonMaxConcurrentExchangeStartups); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.MaxConcurrentExchangeStartups = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonExchangeStartupStaggerMs); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.ExchangeStartupStaggerMs = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonExchangeFailureThreshold); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.ExchangeFailureThreshold = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonExchangeFailureCooldownMs); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.ExchangeFailureCooldownMs = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonMaxPriceAgeMs); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.MaxPriceAgeMs = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonMinExchangesPerMarket); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.MinExchangesPerMarket = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonMarketParamQueryMaxAttempts); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.MarketParamQueryMaxAttempts = v
+		}
+	}
+	if option := appOpts.Get(FlagPriceDaemonMarketParamQueryRetryDelayMs); option != nil {
+		if v, err := cast.ToUint32E(option); err == nil {
+			result.Price.MarketParamQueryRetryDelayMs = v
+		}
+	}
 
 	// Slinky Daemon.
 	if option := appOpts.Get(FlagOracleEnabled); option != nil {
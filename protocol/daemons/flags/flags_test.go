@@ -29,6 +29,14 @@ func TestAddDaemonFlagsToCmd(t *testing.T) {
 
 		flags.FlagPriceDaemonEnabled,
 		flags.FlagPriceDaemonLoopDelayMs,
+		flags.FlagPriceDaemonMaxConcurrentExchangeStartups,
+		flags.FlagPriceDaemonExchangeStartupStaggerMs,
+		flags.FlagPriceDaemonExchangeFailureThreshold,
+		flags.FlagPriceDaemonExchangeFailureCooldownMs,
+		flags.FlagPriceDaemonMaxPriceAgeMs,
+		flags.FlagPriceDaemonMinExchangesPerMarket,
+		flags.FlagPriceDaemonMarketParamQueryMaxAttempts,
+		flags.FlagPriceDaemonMarketParamQueryRetryDelayMs,
 	}
 
 	for _, v := range tests {
@@ -56,6 +64,14 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 
 	optsMap[flags.FlagPriceDaemonEnabled] = true
 	optsMap[flags.FlagPriceDaemonLoopDelayMs] = uint32(4444)
+	optsMap[flags.FlagPriceDaemonMaxConcurrentExchangeStartups] = uint32(5555)
+	optsMap[flags.FlagPriceDaemonExchangeStartupStaggerMs] = uint32(6666)
+	optsMap[flags.FlagPriceDaemonExchangeFailureThreshold] = uint32(7777)
+	optsMap[flags.FlagPriceDaemonExchangeFailureCooldownMs] = uint32(8888)
+	optsMap[flags.FlagPriceDaemonMaxPriceAgeMs] = uint32(9999)
+	optsMap[flags.FlagPriceDaemonMinExchangesPerMarket] = uint32(3)
+	optsMap[flags.FlagPriceDaemonMarketParamQueryMaxAttempts] = uint32(4)
+	optsMap[flags.FlagPriceDaemonMarketParamQueryRetryDelayMs] = uint32(250)
 
 	mockOpts := mocks.AppOptions{}
 	mockOpts.On("Get", mock.Anything).
@@ -87,6 +103,18 @@ func TestGetDaemonFlagValuesFromOptions_Custom(t *testing.T) {
 	// Price Daemon.
 	require.Equal(t, optsMap[flags.FlagPriceDaemonEnabled], r.Price.Enabled)
 	require.Equal(t, optsMap[flags.FlagPriceDaemonLoopDelayMs], r.Price.LoopDelayMs)
+	require.Equal(
+		t,
+		optsMap[flags.FlagPriceDaemonMaxConcurrentExchangeStartups],
+		r.Price.MaxConcurrentExchangeStartups,
+	)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonExchangeStartupStaggerMs], r.Price.ExchangeStartupStaggerMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonExchangeFailureThreshold], r.Price.ExchangeFailureThreshold)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonExchangeFailureCooldownMs], r.Price.ExchangeFailureCooldownMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonMaxPriceAgeMs], r.Price.MaxPriceAgeMs)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonMinExchangesPerMarket], r.Price.MinExchangesPerMarket)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonMarketParamQueryMaxAttempts], r.Price.MarketParamQueryMaxAttempts)
+	require.Equal(t, optsMap[flags.FlagPriceDaemonMarketParamQueryRetryDelayMs], r.Price.MarketParamQueryRetryDelayMs)
 }
 
 func TestGetDaemonFlagValuesFromOptions_Default(t *testing.T) {
@@ -119,6 +119,9 @@ type ExchangePrice struct {
 	ExchangeId     string     `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
 	Price          uint64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
 	LastUpdateTime *time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time,omitempty"`
+	// The weight of the exchange's price in the median price of the market. A
+	// weight of 0 is treated as a weight of 1.
+	Weight uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
 func (m *ExchangePrice) Reset()         { *m = ExchangePrice{} }
@@ -175,6 +178,13 @@ func (m *ExchangePrice) GetLastUpdateTime() *time.Time {
 	return nil
 }
 
+func (m *ExchangePrice) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 // MarketPriceUpdate represents an update to a single market
 type MarketPriceUpdate struct {
 	MarketId       uint32           `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -228,11 +238,179 @@ func (m *MarketPriceUpdate) GetExchangePrices() []*ExchangePrice {
 	return nil
 }
 
+// ExchangeHealthRequest is a request message for the health of the exchanges
+// queried by the price feed daemon.
+type ExchangeHealthRequest struct {
+}
+
+func (m *ExchangeHealthRequest) Reset()         { *m = ExchangeHealthRequest{} }
+func (m *ExchangeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealthRequest) ProtoMessage()    {}
+func (*ExchangeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{4}
+}
+func (m *ExchangeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealthRequest.Merge(m, src)
+}
+func (m *ExchangeHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealthRequest proto.InternalMessageInfo
+
+// ExchangeHealthResponse is a response message containing the health of each
+// exchange queried by the price feed daemon, sorted by exchange id.
+type ExchangeHealthResponse struct {
+	ExchangeHealth []ExchangeHealth `protobuf:"bytes,1,rep,name=exchange_health,json=exchangeHealth,proto3" json:"exchange_health"`
+}
+
+func (m *ExchangeHealthResponse) Reset()         { *m = ExchangeHealthResponse{} }
+func (m *ExchangeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealthResponse) ProtoMessage()    {}
+func (*ExchangeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{5}
+}
+func (m *ExchangeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealthResponse.Merge(m, src)
+}
+func (m *ExchangeHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealthResponse proto.InternalMessageInfo
+
+func (m *ExchangeHealthResponse) GetExchangeHealth() []ExchangeHealth {
+	if m != nil {
+		return m.ExchangeHealth
+	}
+	return nil
+}
+
+// ExchangeHealth represents the recent price fetches of a specific exchange.
+type ExchangeHealth struct {
+	ExchangeId string `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	// The durations of the most recent fetches, ordered from oldest to newest.
+	RecentFetchDurations []time.Duration `protobuf:"bytes,2,rep,name=recent_fetch_durations,json=recentFetchDurations,proto3,stdduration" json:"recent_fetch_durations"`
+	// The time of the last successful fetch, if any.
+	LastSuccessTime *time.Time `protobuf:"bytes,3,opt,name=last_success_time,json=lastSuccessTime,proto3,stdtime" json:"last_success_time,omitempty"`
+	// The error of the last failed fetch, if any.
+	LastError string `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The time of the last failed fetch, if any.
+	LastErrorTime *time.Time `protobuf:"bytes,5,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time,omitempty"`
+}
+
+func (m *ExchangeHealth) Reset()         { *m = ExchangeHealth{} }
+func (m *ExchangeHealth) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealth) ProtoMessage()    {}
+func (*ExchangeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d8cd2726a0e97cb, []int{6}
+}
+func (m *ExchangeHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealth.Merge(m, src)
+}
+func (m *ExchangeHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeHealth proto.InternalMessageInfo
+
+func (m *ExchangeHealth) GetExchangeId() string {
+	if m != nil {
+		return m.ExchangeId
+	}
+	return ""
+}
+
+func (m *ExchangeHealth) GetRecentFetchDurations() []time.Duration {
+	if m != nil {
+		return m.RecentFetchDurations
+	}
+	return nil
+}
+
+func (m *ExchangeHealth) GetLastSuccessTime() *time.Time {
+	if m != nil {
+		return m.LastSuccessTime
+	}
+	return nil
+}
+
+func (m *ExchangeHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ExchangeHealth) GetLastErrorTime() *time.Time {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpdateMarketPricesRequest)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesRequest")
 	proto.RegisterType((*UpdateMarketPricesResponse)(nil), "dydxprotocol.daemons.pricefeed.UpdateMarketPricesResponse")
 	proto.RegisterType((*ExchangePrice)(nil), "dydxprotocol.daemons.pricefeed.ExchangePrice")
 	proto.RegisterType((*MarketPriceUpdate)(nil), "dydxprotocol.daemons.pricefeed.MarketPriceUpdate")
+	proto.RegisterType((*ExchangeHealthRequest)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealthRequest")
+	proto.RegisterType((*ExchangeHealthResponse)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealthResponse")
+	proto.RegisterType((*ExchangeHealth)(nil), "dydxprotocol.daemons.pricefeed.ExchangeHealth")
 }
 
 func init() {
@@ -240,35 +418,46 @@ func init() {
 }
 
 var fileDescriptor_3d8cd2726a0e97cb = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0x2d, 0xa0, 0xf6, 0xa2, 0x96, 0x72, 0xca, 0x60, 0x0c, 0xb2, 0x2d, 0x4f, 0x5e,
-	0x7a, 0x16, 0x81, 0x05, 0x16, 0xa4, 0x4a, 0x20, 0x75, 0x00, 0x21, 0xf3, 0x22, 0xc1, 0x62, 0x5d,
-	0x7c, 0x4f, 0x1d, 0x8b, 0xd8, 0x67, 0x7c, 0xe7, 0xaa, 0x6c, 0x8c, 0x2c, 0x48, 0x9d, 0xf8, 0x00,
-	0x7c, 0x9a, 0x8e, 0x19, 0x99, 0x00, 0x25, 0x5f, 0x04, 0xf9, 0xce, 0x89, 0x12, 0x02, 0x89, 0xc4,
-	0xf6, 0xbc, 0xfd, 0xef, 0xfe, 0xcf, 0xcf, 0x3e, 0x1c, 0xf1, 0x8f, 0xfc, 0xa2, 0xaa, 0x85, 0x12,
-	0xa9, 0x18, 0x47, 0x9c, 0x41, 0x21, 0x4a, 0x19, 0x55, 0x75, 0x9e, 0xc2, 0x19, 0x00, 0x37, 0x51,
-	0xd2, 0x86, 0x54, 0x4f, 0x11, 0x77, 0x59, 0x40, 0x3b, 0x01, 0x5d, 0x08, 0x9c, 0x7e, 0x26, 0x32,
-	0xa1, 0xfb, 0x51, 0x1b, 0x19, 0x95, 0xe3, 0x65, 0x42, 0x64, 0x63, 0x88, 0x74, 0x36, 0x6c, 0xce,
-	0x22, 0x95, 0x17, 0x20, 0x15, 0x2b, 0x2a, 0x33, 0x10, 0x7c, 0x42, 0xf8, 0xf6, 0xeb, 0x8a, 0x33,
-	0x05, 0xcf, 0x58, 0xfd, 0x1e, 0xd4, 0x8b, 0xf6, 0x40, 0x19, 0xc3, 0x87, 0x06, 0xa4, 0x22, 0x29,
-	0xee, 0x17, 0xba, 0x9c, 0x18, 0x3f, 0x8d, 0x9e, 0x94, 0x36, 0xf2, 0x77, 0xc3, 0xde, 0xe0, 0x1e,
-	0xdd, 0xec, 0x89, 0x2e, 0x1d, 0x69, 0xee, 0x88, 0x49, 0xf1, 0x67, 0x49, 0x06, 0x77, 0xb1, 0xf3,
-	0x37, 0x07, 0xb2, 0x12, 0xa5, 0x84, 0xe0, 0x2b, 0xc2, 0x07, 0x4f, 0x2e, 0xd2, 0x11, 0x2b, 0x33,
-	0xd0, 0x2d, 0xe2, 0xe1, 0x1e, 0x74, 0x85, 0x24, 0xe7, 0x36, 0xf2, 0x51, 0xb8, 0x1f, 0xe3, 0x79,
-	0xe9, 0x94, 0x93, 0x3e, 0xbe, 0xae, 0x3d, 0xd8, 0x3b, 0x3e, 0x0a, 0xaf, 0xc5, 0x26, 0x21, 0xcf,
-	0xf1, 0xd1, 0x98, 0x49, 0xd5, 0xed, 0x90, 0xb4, 0x20, 0xec, 0x5d, 0x1f, 0x85, 0xbd, 0x81, 0x43,
-	0x0d, 0x25, 0x3a, 0xa7, 0x44, 0x5f, 0xcd, 0x29, 0x9d, 0xec, 0x5d, 0xfd, 0xf0, 0xd0, 0xe5, 0x4f,
-	0x0f, 0xc5, 0x87, 0xad, 0xda, 0x18, 0x6d, 0xdb, 0xc1, 0x67, 0x84, 0x6f, 0xad, 0x2d, 0x48, 0xee,
-	0xe0, 0xfd, 0x8e, 0x58, 0x67, 0xed, 0x20, 0xde, 0x33, 0x85, 0x53, 0x4e, 0xde, 0xe0, 0x9b, 0x0b,
-	0xe7, 0xda, 0x94, 0xb4, 0x77, 0x34, 0xc9, 0xe3, 0x6d, 0x24, 0x57, 0x08, 0xc4, 0x87, 0xb0, 0x9c,
-	0xca, 0xc1, 0x37, 0x84, 0x8f, 0x74, 0xf8, 0x14, 0x80, 0xbf, 0x84, 0xfa, 0xbc, 0xdd, 0xf7, 0x0b,
-	0xc2, 0x64, 0x9d, 0x2b, 0x79, 0xb8, 0xed, 0xaa, 0x7f, 0xfe, 0x0d, 0xce, 0xa3, 0xff, 0x91, 0x76,
-	0x9f, 0xd1, 0x3a, 0x79, 0x7b, 0x35, 0x75, 0xd1, 0x64, 0xea, 0xa2, 0x5f, 0x53, 0x17, 0x5d, 0xce,
-	0x5c, 0x6b, 0x32, 0x73, 0xad, 0xef, 0x33, 0xd7, 0x7a, 0xf7, 0x38, 0xcb, 0xd5, 0xa8, 0x19, 0xd2,
-	0x54, 0x14, 0xab, 0xcf, 0xe2, 0xfc, 0xc1, 0x71, 0x3a, 0x62, 0x79, 0x19, 0x6d, 0x78, 0x28, 0xac,
-	0xca, 0x87, 0x37, 0x74, 0xff, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0x1e, 0xd0, 0x18,
-	0x55, 0x03, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0xd4, 0x40,
+	0x18, 0xde, 0x59, 0x3e, 0xc2, 0x0e, 0x59, 0x3e, 0x26, 0x2b, 0x96, 0x55, 0xbb, 0x9b, 0x9e, 0xf6,
+	0x42, 0x1b, 0xf1, 0x23, 0xd1, 0x8b, 0x09, 0x11, 0x22, 0x89, 0x1a, 0x52, 0xd4, 0x04, 0x13, 0xd3,
+	0x94, 0xf6, 0xa5, 0x6d, 0xdc, 0x76, 0x6a, 0x67, 0x0a, 0x78, 0xd2, 0xa3, 0x89, 0x17, 0x8e, 0x5e,
+	0xfd, 0x03, 0xfe, 0x0e, 0x12, 0x2f, 0x1c, 0x3d, 0xa1, 0x81, 0x3f, 0x62, 0x66, 0xa6, 0x85, 0xfd,
+	0x50, 0xd6, 0xf5, 0xd6, 0x79, 0x9f, 0xf7, 0xe3, 0x99, 0xe7, 0x79, 0x3b, 0xd8, 0xf2, 0xdf, 0xfb,
+	0x87, 0x69, 0x46, 0x39, 0xf5, 0x68, 0xd7, 0xf2, 0x5d, 0x88, 0x69, 0xc2, 0xac, 0x34, 0x8b, 0x3c,
+	0xd8, 0x03, 0xf0, 0xd5, 0x97, 0x23, 0x3e, 0x4d, 0x99, 0x45, 0xf4, 0xde, 0x02, 0xb3, 0x28, 0x30,
+	0x2f, 0x0a, 0x9a, 0x8d, 0x80, 0x06, 0x54, 0xe2, 0x96, 0xf8, 0x52, 0x55, 0x4d, 0x3d, 0xa0, 0x34,
+	0xe8, 0x82, 0x25, 0x4f, 0xbb, 0xf9, 0x9e, 0xe5, 0xe7, 0x99, 0xcb, 0x23, 0x9a, 0x14, 0x78, 0x6b,
+	0x10, 0xe7, 0x51, 0x0c, 0x8c, 0xbb, 0x71, 0xaa, 0x12, 0x8c, 0x8f, 0x08, 0x2f, 0xbf, 0x4c, 0x7d,
+	0x97, 0xc3, 0x33, 0x37, 0x7b, 0x0b, 0x7c, 0x4b, 0x0c, 0x64, 0x36, 0xbc, 0xcb, 0x81, 0x71, 0xe2,
+	0xe1, 0x46, 0x2c, 0xc3, 0x8e, 0xe2, 0x9b, 0xcb, 0x4c, 0xa6, 0xa1, 0xf6, 0x44, 0x67, 0x76, 0xf5,
+	0xb6, 0x79, 0x35, 0x67, 0xb3, 0xa7, 0xa5, 0x9a, 0x61, 0x93, 0x78, 0x30, 0xc4, 0x8c, 0x9b, 0xb8,
+	0xf9, 0x27, 0x06, 0x2c, 0xa5, 0x09, 0x03, 0xe3, 0x1b, 0xc2, 0xf5, 0xf5, 0x43, 0x2f, 0x74, 0x93,
+	0x00, 0x24, 0x44, 0x5a, 0x78, 0x16, 0x8a, 0x80, 0x13, 0xf9, 0x1a, 0x6a, 0xa3, 0x4e, 0xcd, 0xc6,
+	0x65, 0x68, 0xd3, 0x27, 0x0d, 0x3c, 0x25, 0x39, 0x68, 0xd5, 0x36, 0xea, 0x4c, 0xda, 0xea, 0x40,
+	0x9e, 0xe3, 0x85, 0xae, 0xcb, 0x78, 0x71, 0x07, 0x47, 0x08, 0xa1, 0x4d, 0xb4, 0x51, 0x67, 0x76,
+	0xb5, 0x69, 0x2a, 0x95, 0xcc, 0x52, 0x25, 0xf3, 0x45, 0xa9, 0xd2, 0xda, 0xcc, 0xf1, 0x69, 0x0b,
+	0x1d, 0xfd, 0x6c, 0x21, 0x7b, 0x4e, 0x54, 0x2b, 0xa2, 0x02, 0x26, 0x4b, 0x78, 0xfa, 0x00, 0xa2,
+	0x20, 0xe4, 0xda, 0x64, 0x1b, 0x75, 0xea, 0x76, 0x71, 0x32, 0x3e, 0x21, 0xbc, 0x38, 0x74, 0x71,
+	0x72, 0x03, 0xd7, 0x0a, 0x25, 0x0b, 0xca, 0x75, 0x7b, 0x46, 0x05, 0x36, 0x7d, 0xf2, 0x0a, 0xcf,
+	0x5f, 0xdc, 0x48, 0x92, 0x65, 0x5a, 0x55, 0x2a, 0xbc, 0x32, 0x4a, 0xe1, 0x3e, 0x65, 0xec, 0x39,
+	0xe8, 0x3d, 0x32, 0xe3, 0x3a, 0xbe, 0x56, 0x26, 0x3c, 0x01, 0xb7, 0xcb, 0xc3, 0xc2, 0x57, 0xe3,
+	0x00, 0x2f, 0x0d, 0x02, 0x4a, 0x6e, 0xf2, 0xa6, 0x87, 0x4a, 0x28, 0xa1, 0xc2, 0x6c, 0xf3, 0x5f,
+	0xa9, 0xa8, 0x86, 0x6b, 0x93, 0xc7, 0xa7, 0xad, 0xca, 0x25, 0x23, 0x15, 0x35, 0xbe, 0x57, 0xf1,
+	0x5c, 0x7f, 0xe2, 0x68, 0x3b, 0x77, 0xf0, 0x52, 0x06, 0x1e, 0x24, 0xdc, 0xd9, 0x03, 0xee, 0x85,
+	0x4e, 0xb9, 0xe2, 0xa5, 0x48, 0xcb, 0x43, 0xf6, 0x3d, 0x2e, 0x32, 0xa4, 0x7b, 0x95, 0x2f, 0xc2,
+	0xbd, 0x86, 0x6a, 0xb1, 0x21, 0x3a, 0x94, 0x30, 0x23, 0x5b, 0x78, 0x51, 0xee, 0x04, 0xcb, 0x3d,
+	0x0f, 0x18, 0x1b, 0x7f, 0x29, 0xe6, 0x45, 0xf9, 0xb6, 0xaa, 0x96, 0x5b, 0x71, 0x0b, 0x63, 0xd9,
+	0x11, 0xb2, 0x8c, 0x66, 0x72, 0x33, 0x6a, 0x76, 0x4d, 0x44, 0xd6, 0x45, 0x80, 0x3c, 0xc5, 0xf3,
+	0x97, 0xb0, 0x1a, 0x37, 0x35, 0xc6, 0xb8, 0xfa, 0x45, 0x27, 0x81, 0xae, 0x7e, 0xad, 0xe2, 0x05,
+	0x69, 0xf5, 0x06, 0x80, 0xbf, 0x0d, 0xd9, 0xbe, 0xd8, 0xf3, 0xcf, 0x08, 0x93, 0xe1, 0xff, 0x89,
+	0x3c, 0x18, 0xe5, 0xdf, 0x5f, 0x5f, 0x81, 0xe6, 0xc3, 0xff, 0x29, 0x2d, 0xf6, 0xe9, 0xc3, 0x90,
+	0xdf, 0xf7, 0xc6, 0x5b, 0xa4, 0x92, 0xc4, 0xfd, 0x71, 0xcb, 0x14, 0x81, 0xb5, 0x9d, 0xe3, 0x33,
+	0x1d, 0x9d, 0x9c, 0xe9, 0xe8, 0xd7, 0x99, 0x8e, 0x8e, 0xce, 0xf5, 0xca, 0xc9, 0xb9, 0x5e, 0xf9,
+	0x71, 0xae, 0x57, 0x5e, 0x3f, 0x0a, 0x22, 0x1e, 0xe6, 0xbb, 0xa6, 0x47, 0xe3, 0xfe, 0xd7, 0x7a,
+	0xff, 0xee, 0x8a, 0x17, 0xba, 0x51, 0x62, 0x5d, 0xf1, 0x7e, 0xbb, 0x69, 0xb4, 0x3b, 0x2d, 0xf1,
+	0x3b, 0xbf, 0x07, 0x00, 0x27, 0x66, 0x42, 0x5b, 0xec, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +474,8 @@ const _ = grpc.SupportPackageIsVersion4
 type PriceFeedServiceClient interface {
 	// Updates market prices.
 	UpdateMarketPrices(ctx context.Context, in *UpdateMarketPricesRequest, opts ...grpc.CallOption) (*UpdateMarketPricesResponse, error)
+	// Queries the health of the exchanges queried by the price feed daemon.
+	ExchangeHealth(ctx context.Context, in *ExchangeHealthRequest, opts ...grpc.CallOption) (*ExchangeHealthResponse, error)
 }
 
 type priceFeedServiceClient struct {
@@ -304,10 +495,21 @@ func (c *priceFeedServiceClient) UpdateMarketPrices(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *priceFeedServiceClient) ExchangeHealth(ctx context.Context, in *ExchangeHealthRequest, opts ...grpc.CallOption) (*ExchangeHealthResponse, error) {
+	out := new(ExchangeHealthResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.daemons.pricefeed.PriceFeedService/ExchangeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PriceFeedServiceServer is the server API for PriceFeedService service.
 type PriceFeedServiceServer interface {
 	// Updates market prices.
 	UpdateMarketPrices(context.Context, *UpdateMarketPricesRequest) (*UpdateMarketPricesResponse, error)
+	// Queries the health of the exchanges queried by the price feed daemon.
+	ExchangeHealth(context.Context, *ExchangeHealthRequest) (*ExchangeHealthResponse, error)
 }
 
 // UnimplementedPriceFeedServiceServer can be embedded to have forward compatible implementations.
@@ -317,6 +519,9 @@ type UnimplementedPriceFeedServiceServer struct {
 func (*UnimplementedPriceFeedServiceServer) UpdateMarketPrices(ctx context.Context, req *UpdateMarketPricesRequest) (*UpdateMarketPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPrices not implemented")
 }
+func (*UnimplementedPriceFeedServiceServer) ExchangeHealth(ctx context.Context, req *ExchangeHealthRequest) (*ExchangeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeHealth not implemented")
+}
 
 func RegisterPriceFeedServiceServer(s grpc1.Server, srv PriceFeedServiceServer) {
 	s.RegisterService(&_PriceFeedService_serviceDesc, srv)
@@ -340,6 +545,24 @@ func _PriceFeedService_UpdateMarketPrices_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PriceFeedService_ExchangeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceFeedServiceServer).ExchangeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.daemons.pricefeed.PriceFeedService/ExchangeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceFeedServiceServer).ExchangeHealth(ctx, req.(*ExchangeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PriceFeedService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.daemons.pricefeed.PriceFeedService",
 	HandlerType: (*PriceFeedServiceServer)(nil),
@@ -348,6 +571,10 @@ var _PriceFeedService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMarketPrices",
 			Handler:    _PriceFeedService_UpdateMarketPrices_Handler,
 		},
+		{
+			MethodName: "ExchangeHealth",
+			Handler:    _PriceFeedService_ExchangeHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/daemons/pricefeed/price_feed.proto",
@@ -433,6 +660,11 @@ func (m *ExchangePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LastUpdateTime != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime):])
 		if err1 != nil {
@@ -500,6 +732,135 @@ func (m *MarketPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExchangeHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeHealth) > 0 {
+		for iNdEx := len(m.ExchangeHealth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeHealth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastErrorTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastSuccessTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSuccessTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintPriceFeed(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecentFetchDurations) > 0 {
+		for iNdEx := len(m.RecentFetchDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecentFetchDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecentFetchDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintPriceFeed(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeId) > 0 {
+		i -= len(m.ExchangeId)
+		copy(dAtA[i:], m.ExchangeId)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.ExchangeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPriceFeed(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPriceFeed(v)
 	base := offset
@@ -552,6 +913,9 @@ func (m *ExchangePrice) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastUpdateTime)
 		n += 1 + l + sovPriceFeed(uint64(l))
 	}
+	if m.Weight != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Weight))
+	}
 	return n
 }
 
@@ -573,33 +937,88 @@ func (m *MarketPriceUpdate) Size() (n int) {
 	return n
 }
 
-func sovPriceFeed(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPriceFeed(x uint64) (n int) {
-	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ExchangeHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPriceFeed
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *ExchangeHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeHealth) > 0 {
+		for _, e := range m.ExchangeHealth {
+			l = e.Size()
+			n += 1 + l + sovPriceFeed(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
+	}
+	return n
+}
+
+func (m *ExchangeHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if len(m.RecentFetchDurations) > 0 {
+		for _, e := range m.RecentFetchDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovPriceFeed(uint64(l))
+		}
+	}
+	if m.LastSuccessTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	if m.LastErrorTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastErrorTime)
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	return n
+}
+
+func sovPriceFeed(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceFeed(x uint64) (n int) {
+	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateMarketPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: UpdateMarketPricesRequest: wiretype end group for non-group")
@@ -829,6 +1248,25 @@ func (m *ExchangePrice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPriceFeed(dAtA[iNdEx:])
@@ -953,6 +1391,360 @@ func (m *MarketPriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExchangeHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeHealth = append(m.ExchangeHealth, ExchangeHealth{})
+			if err := m.ExchangeHealth[len(m.ExchangeHealth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentFetchDurations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentFetchDurations = append(m.RecentFetchDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.RecentFetchDurations[len(m.RecentFetchDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSuccessTime == nil {
+				m.LastSuccessTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSuccessTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorTime == nil {
+				m.LastErrorTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastErrorTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPriceFeed(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/handler"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
 	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -50,6 +51,9 @@ type Client struct {
 	// Ensure stop only executes one time.
 	stopDaemon sync.Once
 
+	// exchangeHealthTracker records the outcome of the price fetches of each exchange.
+	exchangeHealthTracker *pricefeed_types.ExchangeHealthTracker
+
 	// logger is the logger for the daemon.
 	logger log.Logger
 }
@@ -67,7 +71,8 @@ func newClient(logger log.Logger) *Client {
 			&libtime.TimeProviderImpl{},
 			logger,
 		),
-		logger: logger,
+		exchangeHealthTracker: pricefeed_types.NewExchangeHealthTracker(),
+		logger:                logger,
 	}
 
 	// Set the client's daemonStartup state to indicate that the daemon has not finished starting up.
@@ -90,6 +95,12 @@ func (c *Client) newTickerWithStop(intervalMs int) (*time.Ticker, <-chan bool) {
 	return ticker, stop
 }
 
+// GetExchangeHealth returns a snapshot of the recent fetch durations and last error of each exchange queried
+// by the daemon.
+func (c *Client) GetExchangeHealth() map[string]pricefeed_types.ExchangeHealth {
+	return c.exchangeHealthTracker.GetExchangeHealth()
+}
+
 // Stop stops the daemon and all running subtasks. This method is synchronized by the daemonStartup WaitGroup.
 func (c *Client) Stop() {
 	c.stopDaemon.Do(func() {
@@ -123,7 +134,9 @@ func (c *Client) Stop() {
 //  2. Validate daemon configuration.
 //  3. Initialize synchronized, in-memory shared daemon configuration.
 //  4. Start PriceEncoder and PriceFetcher per exchange. Each price fetcher adds itself to the shared
-//     daemon config.
+//     daemon config. If `MaxConcurrentExchangeStartups` is set, exchanges are started in batches of at
+//     most that size, with a delay of `ExchangeStartupStaggerMs` between batches. Startup stops early if
+//     the context is cancelled during a delay.
 //  5. Start MarketUpdater subtask to periodically update the market configs.
 //  6. Start PriceUpdater to begin broadcasting prices.
 func (c *Client) start(ctx context.Context,
@@ -187,6 +200,7 @@ func (c *Client) start(ctx context.Context,
 
 	// 4. Start PriceEncoder and PriceFetcher per exchange.
 	timeProvider := &libtime.TimeProviderImpl{}
+	numStartedExchanges := uint32(0)
 	for _exchangeId := range exchangeIdToQueryConfig {
 		// Assign these within the loop to avoid unexpected values being passed to the goroutines.
 		exchangeId := _exchangeId
@@ -198,6 +212,19 @@ func (c *Client) start(ctx context.Context,
 			return fmt.Errorf("no exchange details exists for exchangeId: %v", exchangeId)
 		}
 
+		// Stagger the startup of exchanges to avoid querying all exchanges at the same time.
+		maxConcurrentStartups := daemonFlags.Price.MaxConcurrentExchangeStartups
+		if maxConcurrentStartups > 0 && numStartedExchanges > 0 && numStartedExchanges%maxConcurrentStartups == 0 {
+			select {
+			case <-ctx.Done():
+				// Signal startup completion so that `Stop` can shut down the subtasks started so far.
+				c.daemonStartup.Done()
+				return nil
+			case <-time.After(time.Duration(daemonFlags.Price.ExchangeStartupStaggerMs) * time.Millisecond):
+			}
+		}
+		numStartedExchanges++
+
 		// Instantiate shared buffered channel to be written to by the price fetcher and read from
 		// by the price encoder.
 		bCh := make(chan *price_fetcher.PriceFetcherSubtaskResponse, constants.FixedBufferSize)
@@ -225,6 +252,9 @@ func (c *Client) start(ctx context.Context,
 				*exchangeConfig,
 				exchangeDetails,
 				&handler.ExchangeQueryHandlerImpl{TimeProvider: timeProvider},
+				daemonFlags.Price.ExchangeFailureThreshold,
+				time.Duration(daemonFlags.Price.ExchangeFailureCooldownMs)*time.Millisecond,
+				c.exchangeHealthTracker,
 				c.logger,
 				bCh,
 			)
@@ -242,6 +272,8 @@ func (c *Client) start(ctx context.Context,
 			marketParamUpdaterStop,
 			priceFeedMutableMarketConfigs,
 			pricesQueryClient,
+			daemonFlags.Price.MarketParamQueryMaxAttempts,
+			time.Duration(daemonFlags.Price.MarketParamQueryRetryDelayMs)*time.Millisecond,
 			c.logger,
 		)
 	}()
@@ -267,6 +299,8 @@ func (c *Client) start(ctx context.Context,
 		priceUpdaterStop,
 		exchangeToMarketPrices,
 		pricefeedClient,
+		time.Duration(daemonFlags.Price.MaxPriceAgeMs)*time.Millisecond,
+		daemonFlags.Price.MinExchangesPerMarket,
 		c.logger,
 	)
 	return nil
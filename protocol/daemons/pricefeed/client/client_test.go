@@ -30,6 +30,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/client"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -48,6 +49,7 @@ type FakeSubTaskRunner struct {
 	EncoderCallCount       int
 	FetcherCallCount       int
 	MarketUpdaterCallCount int
+	FetcherStartTimes      []time.Time
 }
 
 // StartPriceUpdater replaces `client.StartPriceUpdater` and advances `UpdaterCallCount` by one.
@@ -58,6 +60,8 @@ func (f *FakeSubTaskRunner) StartPriceUpdater(
 	stop <-chan bool,
 	exchangeToMarketPrices types.ExchangeToMarketPrices,
 	priceFeedServiceClient api.PriceFeedServiceClient,
+	maxPriceAge time.Duration,
+	minExchangesPerMarket uint32,
 	logger log.Logger,
 ) {
 	// No need to lock/unlock since there is only one updater running and no risk of race-condition.
@@ -91,6 +95,9 @@ func (f *FakeSubTaskRunner) StartPriceFetcher(
 	exchangeQueryConfig types.ExchangeQueryConfig,
 	exchangeDetails types.ExchangeQueryDetails,
 	queryHandler handler.ExchangeQueryHandler,
+	exchangeFailureThreshold uint32,
+	exchangeFailureCooldown time.Duration,
+	exchangeHealthTracker *pricefeedtypes.ExchangeHealthTracker,
 	logger log.Logger,
 	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
 ) {
@@ -98,6 +105,7 @@ func (f *FakeSubTaskRunner) StartPriceFetcher(
 	defer f.Unlock()
 
 	f.FetcherCallCount += 1
+	f.FetcherStartTimes = append(f.FetcherStartTimes, time.Now())
 	f.Done()
 }
 
@@ -107,6 +115,8 @@ func (f *FakeSubTaskRunner) StartMarketParamUpdater(
 	stop <-chan bool,
 	configs types.PricefeedMutableMarketConfigs,
 	pricesQueryClient pricetypes.QueryClient,
+	maxQueryAttempts uint32,
+	queryRetryDelay time.Duration,
 	logger log.Logger,
 ) {
 	f.Lock()
@@ -295,6 +305,88 @@ func TestStart_InvalidConfig(t *testing.T) {
 	}
 }
 
+// TestStart_StaggersExchangeStartups tests that no more than `MaxConcurrentExchangeStartups` exchanges
+// are started within the `ExchangeStartupStaggerMs` window.
+func TestStart_StaggersExchangeStartups(t *testing.T) {
+	const (
+		maxConcurrentExchangeStartups = 4
+		exchangeStartupStaggerMs      = 200
+	)
+	numExchanges := len(pricefeed_constants.StaticExchangeQueryConfig)
+	require.Greater(t, numExchanges, maxConcurrentExchangeStartups)
+
+	faketaskRunner := FakeSubTaskRunner{}
+	faketaskRunner.WaitGroup.Add(numExchanges * 2)
+
+	daemonFlags := daemonflags.GetDefaultDaemonFlags()
+	daemonFlags.Price.MaxConcurrentExchangeStartups = maxConcurrentExchangeStartups
+	daemonFlags.Price.ExchangeStartupStaggerMs = exchangeStartupStaggerMs
+
+	client := newClient(log.NewNopLogger())
+	err := client.start(
+		grpc_util.Ctx,
+		daemonFlags,
+		appflags.GetFlagValuesFromOptions(appoptions.GetDefaultTestAppOptions("", nil)),
+		grpc_util.GenerateMockGrpcClientWithOptionalGrpcConnectionErrors(nil, nil, true),
+		pricefeed_constants.StaticExchangeQueryConfig,
+		pricefeed_constants.StaticExchangeDetails,
+		&faketaskRunner,
+	)
+	require.NoError(t, err)
+
+	faketaskRunner.Wait()
+	require.Equal(t, numExchanges, faketaskRunner.FetcherCallCount)
+
+	// Any window shorter than the stagger delay contains at most `maxConcurrentExchangeStartups` startups.
+	startTimes := faketaskRunner.FetcherStartTimes
+	sort.Slice(startTimes, func(i, j int) bool { return startTimes[i].Before(startTimes[j]) })
+	window := exchangeStartupStaggerMs / 2 * time.Millisecond
+	for i := 0; i+maxConcurrentExchangeStartups < len(startTimes); i++ {
+		require.GreaterOrEqual(t, startTimes[i+maxConcurrentExchangeStartups].Sub(startTimes[i]), window)
+	}
+}
+
+// TestStart_StaggerStopsOnContextCancellation tests that the exchange startup stagger returns promptly
+// without starting further exchanges once the context is cancelled.
+func TestStart_StaggerStopsOnContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockGrpcClient := &mocks.GrpcClient{}
+	mockGrpcClient.On("NewTcpConnection", ctx, grpc_util.TcpEndpoint).Return(grpc_util.TcpConn, nil)
+	mockGrpcClient.On("NewGrpcConnection", ctx, grpc_util.SocketPath).Return(grpc_util.GrpcConn, nil)
+	mockGrpcClient.On("CloseConnection", grpc_util.TcpConn).Return(nil)
+	mockGrpcClient.On("CloseConnection", grpc_util.GrpcConn).Return(nil)
+
+	// Only the encoder and fetcher of the first exchange are started before the stagger.
+	faketaskRunner := FakeSubTaskRunner{}
+	faketaskRunner.WaitGroup.Add(2)
+
+	daemonFlags := daemonflags.GetDefaultDaemonFlags()
+	daemonFlags.Price.MaxConcurrentExchangeStartups = 1
+	daemonFlags.Price.ExchangeStartupStaggerMs = uint32(time.Hour.Milliseconds())
+
+	client := newClient(log.NewNopLogger())
+	err := client.start(
+		ctx,
+		daemonFlags,
+		appflags.GetFlagValuesFromOptions(appoptions.GetDefaultTestAppOptions("", nil)),
+		mockGrpcClient,
+		pricefeed_constants.StaticExchangeQueryConfig,
+		pricefeed_constants.StaticExchangeDetails,
+		&faketaskRunner,
+	)
+	require.NoError(t, err)
+
+	faketaskRunner.Wait()
+	require.Equal(t, 1, faketaskRunner.FetcherCallCount)
+	require.Equal(t, 0, faketaskRunner.UpdaterCallCount)
+	mockGrpcClient.AssertExpectations(t)
+
+	// Stop does not hang after an early return.
+	client.Stop()
+}
+
 // TestStop tests that the Stop interface works as expected. It's difficult to ensure that each go-routine
 // is stopped, but this test ensures that the Stop executes successfully with no hangs.
 func TestStop(t *testing.T) {
@@ -636,6 +728,32 @@ func TestPriceUpdater_Mixed(t *testing.T) {
 			},
 			expectedMarketPriceUpdate: constants.Market9_SingleExchange_AtTimeUpdate,
 		},
+		"One weighted market for one exchange": {
+			exchangeAndMarketPrices: []*client.ExchangeIdMarketPriceTimestamp{
+				{
+					ExchangeId: constants.ExchangeId1,
+					MarketPriceTimestamp: &types.MarketPriceTimestamp{
+						MarketId:      constants.MarketId9,
+						Price:         constants.Price1,
+						LastUpdatedAt: constants.TimeT,
+						Weight:        2,
+					},
+				},
+			},
+			expectedMarketPriceUpdate: []*api.MarketPriceUpdate{
+				{
+					MarketId: constants.MarketId9,
+					ExchangePrices: []*api.ExchangePrice{
+						{
+							ExchangeId:     constants.ExchangeId1,
+							Price:          constants.Price1,
+							LastUpdateTime: &constants.TimeT,
+							Weight:         2,
+						},
+					},
+				},
+			},
+		},
 		"Three markets at timeT": {
 			exchangeAndMarketPrices: []*client.ExchangeIdMarketPriceTimestamp{
 				constants.ExchangeId1_Market9_TimeT_Price1,
@@ -689,6 +807,8 @@ func TestPriceUpdater_Mixed(t *testing.T) {
 				grpc_util.Ctx,
 				etmp,
 				mockPriceFeedClient,
+				0,
+				0,
 				log.NewNopLogger(),
 			)
 			require.Equal(
@@ -731,6 +851,235 @@ func TestPriceUpdater_Mixed(t *testing.T) {
 	}
 }
 
+// TestRunPriceUpdaterTaskLoop_DropsStalePrices tests that `RunPriceUpdaterTaskLoop` only sends prices that
+// were last updated within the max price age.
+func TestRunPriceUpdaterTaskLoop_DropsStalePrices(t *testing.T) {
+	now := time.Now()
+	etmp, err := types.NewExchangeToMarketPrices(
+		[]types.ExchangeId{
+			constants.ExchangeId1,
+			constants.ExchangeId2,
+		},
+	)
+	require.NoError(t, err)
+	freshTime := now.Add(-time.Second)
+	staleTime := now.Add(-time.Hour)
+	// Market 7 has a fresh price on exchange 1 and a stale price on exchange 2.
+	etmp.UpdatePrice(
+		constants.ExchangeId1,
+		&types.MarketPriceTimestamp{MarketId: constants.MarketId7, Price: constants.Price1, LastUpdatedAt: freshTime},
+	)
+	etmp.UpdatePrice(
+		constants.ExchangeId2,
+		&types.MarketPriceTimestamp{MarketId: constants.MarketId7, Price: constants.Price2, LastUpdatedAt: staleTime},
+	)
+	// Market 8 only has stale prices.
+	etmp.UpdatePrice(
+		constants.ExchangeId1,
+		&types.MarketPriceTimestamp{MarketId: constants.MarketId8, Price: constants.Price3, LastUpdatedAt: staleTime},
+	)
+	// Market 9 only has a fresh price.
+	etmp.UpdatePrice(
+		constants.ExchangeId2,
+		&types.MarketPriceTimestamp{MarketId: constants.MarketId9, Price: constants.Price4, LastUpdatedAt: freshTime},
+	)
+
+	mockPriceFeedClient := generateMockQueryClient()
+	mockPriceFeedClient.On("UpdateMarketPrices", grpc_util.Ctx, mock.Anything).Return(nil, nil)
+
+	err = RunPriceUpdaterTaskLoop(
+		grpc_util.Ctx,
+		etmp,
+		mockPriceFeedClient,
+		time.Minute,
+		0,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	expectedUpdates := []*api.MarketPriceUpdate{
+		{
+			MarketId: constants.MarketId9,
+			ExchangePrices: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId2, Price: constants.Price4, LastUpdateTime: &freshTime},
+			},
+		},
+		{
+			MarketId: constants.MarketId7,
+			ExchangePrices: []*api.ExchangePrice{
+				{ExchangeId: constants.ExchangeId1, Price: constants.Price1, LastUpdateTime: &freshTime},
+			},
+		},
+	}
+	mockPriceFeedClient.AssertCalled(
+		t,
+		"UpdateMarketPrices",
+		grpc_util.Ctx,
+		mock.MatchedBy(func(i interface{}) bool {
+			updates := i.(*api.UpdateMarketPricesRequest).MarketPriceUpdates
+			sortMarketPriceUpdateByMarketIdDescending(updates)
+			return assert.ObjectsAreEqual(expectedUpdates, updates)
+		}),
+	)
+}
+
+// TestRunPriceUpdaterTaskLoop_AllPricesStale tests that `RunPriceUpdaterTaskLoop` does not send a request
+// if all prices are older than the max price age.
+func TestRunPriceUpdaterTaskLoop_AllPricesStale(t *testing.T) {
+	etmp, err := types.NewExchangeToMarketPrices([]types.ExchangeId{constants.ExchangeId1})
+	require.NoError(t, err)
+	etmp.UpdatePrice(constants.ExchangeId1, constants.Market9_TimeT_Price1)
+
+	mockPriceFeedClient := generateMockQueryClient()
+
+	err = RunPriceUpdaterTaskLoop(
+		grpc_util.Ctx,
+		etmp,
+		mockPriceFeedClient,
+		time.Minute,
+		0,
+		log.NewNopLogger(),
+	)
+	require.ErrorIs(t, err, types.ErrEmptyMarketPriceUpdate)
+	mockPriceFeedClient.AssertNotCalled(t, "UpdateMarketPrices")
+}
+
+// TestRunPriceUpdaterTaskLoop_MinExchangesPerMarket tests that `RunPriceUpdaterTaskLoop` only sends markets that
+// have prices from at least the minimum number of exchanges.
+func TestRunPriceUpdaterTaskLoop_MinExchangesPerMarket(t *testing.T) {
+	etmp, err := types.NewExchangeToMarketPrices(
+		[]types.ExchangeId{
+			constants.ExchangeId1,
+			constants.ExchangeId2,
+			constants.ExchangeId3,
+		},
+	)
+	require.NoError(t, err)
+	for _, exchangeAndMarketPrice := range []*client.ExchangeIdMarketPriceTimestamp{
+		// Market 7 has prices from 3 exchanges.
+		constants.ExchangeId1_Market7_TimeT_Price1,
+		constants.ExchangeId2_Market7_BeforeTimeT_Price1,
+		constants.ExchangeId3_Market7_TimeT_Price3,
+		// Market 8 has prices from 2 exchanges.
+		constants.ExchangeId2_Market8_TimeT_Price2,
+		constants.ExchangeId3_Market8_TimeT_Price3,
+		// Market 9 has a price from 1 exchange.
+		constants.ExchangeId1_Market9_TimeT_Price1,
+	} {
+		etmp.UpdatePrice(exchangeAndMarketPrice.ExchangeId, exchangeAndMarketPrice.MarketPriceTimestamp)
+	}
+
+	mockPriceFeedClient := generateMockQueryClient()
+	mockPriceFeedClient.On("UpdateMarketPrices", grpc_util.Ctx, mock.Anything).Return(nil, nil)
+
+	err = RunPriceUpdaterTaskLoop(
+		grpc_util.Ctx,
+		etmp,
+		mockPriceFeedClient,
+		0,
+		2,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, err)
+
+	mockPriceFeedClient.AssertCalled(
+		t,
+		"UpdateMarketPrices",
+		grpc_util.Ctx,
+		mock.MatchedBy(func(i interface{}) bool {
+			updates := i.(*api.UpdateMarketPricesRequest).MarketPriceUpdates
+			sortMarketPriceUpdateByMarketIdDescending(updates)
+			return len(updates) == 2 &&
+				updates[0].MarketId == constants.MarketId8 &&
+				len(updates[0].ExchangePrices) == 2 &&
+				updates[1].MarketId == constants.MarketId7 &&
+				len(updates[1].ExchangePrices) == 3
+		}),
+	)
+}
+
+// TestRunPriceUpdaterTaskLoop_NoMarketHasMinExchanges tests that `RunPriceUpdaterTaskLoop` does not send a
+// request if no market has prices from the minimum number of exchanges.
+func TestRunPriceUpdaterTaskLoop_NoMarketHasMinExchanges(t *testing.T) {
+	etmp, err := types.NewExchangeToMarketPrices([]types.ExchangeId{constants.ExchangeId1})
+	require.NoError(t, err)
+	etmp.UpdatePrice(constants.ExchangeId1, constants.Market9_TimeT_Price1)
+
+	mockPriceFeedClient := generateMockQueryClient()
+
+	err = RunPriceUpdaterTaskLoop(
+		grpc_util.Ctx,
+		etmp,
+		mockPriceFeedClient,
+		0,
+		2,
+		log.NewNopLogger(),
+	)
+	require.ErrorIs(t, err, types.ErrEmptyMarketPriceUpdate)
+	mockPriceFeedClient.AssertNotCalled(t, "UpdateMarketPrices")
+}
+
+func TestTransformPriceUpdates_DuplicateExchangePrices(t *testing.T) {
+	tests := map[string]struct {
+		// parameters
+		updates map[types.ExchangeId][]types.MarketPriceTimestamp
+
+		// expectations
+		expectedMarketPriceUpdates []*api.MarketPriceUpdate
+	}{
+		"Fresher duplicate after stale price survives": {
+			updates: map[types.ExchangeId][]types.MarketPriceTimestamp{
+				constants.ExchangeId1: {
+					*constants.Market9_TimeT_Price1,
+					*constants.Market9_TimeTPlusThreshold_Price2,
+				},
+				constants.ExchangeId2: {
+					*constants.Market9_TimeT_Price2,
+				},
+			},
+			expectedMarketPriceUpdates: []*api.MarketPriceUpdate{
+				{
+					MarketId: constants.MarketId9,
+					ExchangePrices: []*api.ExchangePrice{
+						constants.Exchange1_Price2_AfterTimeT,
+						constants.Exchange2_Price2_TimeT,
+					},
+				},
+			},
+		},
+		"Fresher duplicate before stale price survives": {
+			updates: map[types.ExchangeId][]types.MarketPriceTimestamp{
+				constants.ExchangeId1: {
+					*constants.Market9_TimeT_Price1,
+					*constants.Market9_TimeTMinusThreshold_Price3,
+				},
+			},
+			expectedMarketPriceUpdates: []*api.MarketPriceUpdate{
+				{
+					MarketId: constants.MarketId9,
+					ExchangePrices: []*api.ExchangePrice{
+						constants.Exchange1_Price1_TimeT,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := transformPriceUpdates(tc.updates)
+
+			require.Len(t, request.MarketPriceUpdates, len(tc.expectedMarketPriceUpdates))
+			sortMarketPriceUpdateByMarketIdDescending(request.MarketPriceUpdates)
+			sortMarketPriceUpdateByMarketIdDescending(tc.expectedMarketPriceUpdates)
+			for i, update := range request.MarketPriceUpdates {
+				require.Equal(t, tc.expectedMarketPriceUpdates[i].MarketId, update.MarketId)
+				require.ElementsMatch(t, tc.expectedMarketPriceUpdates[i].ExchangePrices, update.ExchangePrices)
+			}
+		})
+	}
+}
+
 func TestHealthCheck_Mixed(t *testing.T) {
 	tests := map[string]struct {
 		updateMarketPricesError error
@@ -775,6 +1124,8 @@ func TestHealthCheck_Mixed(t *testing.T) {
 				stop,
 				etmp,
 				mockPriceFeedClient,
+				0,
+				0,
 				log.NewNopLogger(),
 			)
 
@@ -817,6 +1168,8 @@ func TestMarketUpdater_Mixed(t *testing.T) {
 				grpc_util.Ctx,
 				configs,
 				pricesQueryClient,
+				1,
+				0,
 				log.NewNopLogger(),
 				true,
 			)
@@ -830,6 +1183,66 @@ func TestMarketUpdater_Mixed(t *testing.T) {
 	}
 }
 
+// TestMarketUpdater_RetriesFailedQueries tests that `RunMarketParamUpdaterTaskLoop` retries failed market param
+// queries and only updates the config if a query succeeds within the maximum number of attempts.
+func TestMarketUpdater_RetriesFailedQueries(t *testing.T) {
+	tests := map[string]struct {
+		maxQueryAttempts uint32
+
+		expectedQueryCount int
+		expectUpdate       bool
+	}{
+		"Success: query succeeds on the last attempt": {
+			maxQueryAttempts:   3,
+			expectedQueryCount: 3,
+			expectUpdate:       true,
+		},
+		"Success: query succeeds before the last attempt": {
+			maxQueryAttempts:   5,
+			expectedQueryCount: 3,
+			expectUpdate:       true,
+		},
+		"Failure: retry budget is exhausted": {
+			maxQueryAttempts:   2,
+			expectedQueryCount: 2,
+			expectUpdate:       false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := []pricetypes.MarketParam{}
+			response := &pricetypes.QueryAllMarketParamsResponse{
+				MarketParams: params,
+			}
+			// The query fails twice and then succeeds.
+			pricesQueryClient := generateMockQueryClient()
+			pricesQueryClient.On("AllMarketParams", grpc_util.Ctx, mock.Anything).
+				Return(nil, fmt.Errorf("transient failure")).Twice()
+			pricesQueryClient.On("AllMarketParams", grpc_util.Ctx, mock.Anything).
+				Return(response, nil)
+			configs := &mocks.PricefeedMutableMarketConfigs{}
+			configs.On("UpdateMarkets", params).Return(map[types.MarketId]error{}, nil)
+
+			RunMarketParamUpdaterTaskLoop(
+				grpc_util.Ctx,
+				configs,
+				pricesQueryClient,
+				tc.maxQueryAttempts,
+				time.Millisecond,
+				log.NewNopLogger(),
+				true,
+			)
+
+			pricesQueryClient.AssertNumberOfCalls(t, "AllMarketParams", tc.expectedQueryCount)
+			if tc.expectUpdate {
+				configs.AssertCalled(t, "UpdateMarkets", params)
+			} else {
+				configs.AssertNotCalled(t, "UpdateMarkets", params)
+			}
+		})
+	}
+}
+
 // ----------------- Generate Mock Instances ----------------- //
 
 // generateMockQueryClient generates a mock QueryClient that can be used to support any of the QueryClient
@@ -3,7 +3,9 @@ package constants
 import "fmt"
 
 const (
-	UnexpectedResponseStatusMessage = "Unexpected response status code of:"
+	UnexpectedResponseStatusMessage   = "Unexpected response status code of:"
+	UnsupportedContentEncodingMessage = "Unsupported response content encoding:"
+	UnsupportedRequestMethodMessage   = "Unsupported request method:"
 )
 
 var (
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	// 5K is chosen to be >> than the number of messages an exchange could send in any period before the
 	// price encoder is able to read the messages from the buffer, even if we add O(10-100) markets dynamically,
@@ -8,4 +10,11 @@ const (
 	// https://stackoverflow.com/questions/37774624/go-http-get-concurrency-and-connection-reset-by-peer.
 	// This is a good number to start with based on the above link. Adjustments can/will be made accordingly.
 	MaxConnectionsPerExchange = 50
+
+	// BadPriceDisableThreshold is the number of bad prices an exchange may return for a market within
+	// `BadPriceWindow` before the price fetcher stops querying the exchange for that market. The market is
+	// re-enabled on the exchange after `BadPriceDisableCooldown` has elapsed.
+	BadPriceDisableThreshold = 5
+	BadPriceWindow           = 5 * time.Minute
+	BadPriceDisableCooldown  = 10 * time.Minute
 )
@@ -1,10 +1,17 @@
 package handler
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
@@ -45,9 +52,11 @@ type ExchangeQueryHandler interface {
 // 1) Validate `marketIds` contains at least one id.
 // 2) Convert the list of `marketIds` to tickers that are specific for a given exchange. Create a mapping of
 // tickers to price exponents and a reverse mapping of ticker back to `MarketId`.
-// 3) Make API call to an exchange and verify the response status code is not an error status code.
+// 3) Make API call to an exchange using the HTTP method of the exchange, verify the response status code is not an
+// error status code and decode the response body according to its `Content-Encoding`.
 // 4) Transform the API response to market prices, while tracking unavailable tickers.
-// 5) Return dual values:
+// 5) Attach the weight of the exchange for each market to its price.
+// 6) Return dual values:
 // - a slice of `MarketPriceTimestamp`s that contains resolved market prices
 // - a map of marketIds that could not be resolved with corresponding specific errors.
 func (eqh *ExchangeQueryHandlerImpl) Query(
@@ -112,7 +121,7 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 	url := CreateRequestUrl(exchangeQueryDetails.Url, tickers)
 
 	beforeRequest := time.Now()
-	response, err := requestHandler.Get(ctx, url)
+	response, err := sendRequest(ctx, requestHandler, exchangeQueryDetails, url, tickers)
 	// Measure time to make API request for exchange.
 	metrics.ModuleMeasureSinceWithLabels(
 		metrics.PricefeedDaemon,
@@ -153,6 +162,10 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		return nil, nil, fmt.Errorf("%s %v", constants.UnexpectedResponseStatusMessage, response.StatusCode)
 	}
 
+	if err := decodeResponseBody(response); err != nil {
+		return nil, nil, err
+	}
+
 	// 4) Transform the API response to market prices, while tracking unavailable tickers.
 	prices, unavailableTickers, err := exchangeQueryDetails.PriceFunction(
 		response,
@@ -163,8 +176,8 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 		return nil, nil, price_function.NewExchangeError(exchangeQueryDetails.Exchange, err.Error())
 	}
 
-	// 5) Insert prices into MarketPriceTimestamp struct slice, convert unavailable tickers back into marketIds,
-	// and return.
+	// 5) Insert prices and the weight of the exchange for each market into MarketPriceTimestamp struct slice,
+	// convert unavailable tickers back into marketIds, and return.
 	marketPriceTimestamps = make([]*types.MarketPriceTimestamp, 0, len(prices))
 	now := eqh.Now()
 
@@ -174,10 +187,12 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 			return nil, nil, fmt.Errorf("Severe unexpected error: no market id for ticker: %v", ticker)
 		}
 
+		marketConfig := exchangeConfig.MarketToMarketConfig[marketId]
 		marketPriceTimestamp := &types.MarketPriceTimestamp{
 			MarketId:      marketId,
 			Price:         price,
 			LastUpdatedAt: now,
+			Weight:        marketConfig.GetWeight(),
 		}
 
 		marketPriceTimestamps = append(marketPriceTimestamps, marketPriceTimestamp)
@@ -195,6 +210,93 @@ func (eqh *ExchangeQueryHandlerImpl) Query(
 	return marketPriceTimestamps, unavailableMarkets, nil
 }
 
+// sendRequest queries the exchange with the HTTP method of the exchange. GET requests are sent if no method is
+// configured, and POST requests are sent with a JSON body created from the body template of the exchange.
+func sendRequest(
+	ctx context.Context,
+	requestHandler daemontypes.RequestHandler,
+	exchangeQueryDetails *types.ExchangeQueryDetails,
+	url string,
+	tickers []string,
+) (*http.Response, error) {
+	switch exchangeQueryDetails.Method {
+	case "", http.MethodGet:
+		return requestHandler.Get(ctx, url)
+	case http.MethodPost:
+		body, err := CreateRequestBody(exchangeQueryDetails.BodyTemplate, tickers)
+		if err != nil {
+			return nil, err
+		}
+		return requestHandler.Post(ctx, url, "application/json", body)
+	default:
+		return nil, fmt.Errorf("%s %v", constants.UnsupportedRequestMethodMessage, exchangeQueryDetails.Method)
+	}
+}
+
+// decodeResponseBody replaces the body of the response with its decoded contents based on the `Content-Encoding`
+// header of the response, so that price functions always receive uncompressed bytes. Responses without a
+// `Content-Encoding` header or with the `identity` encoding are left unchanged.
+func decodeResponseBody(response *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(response.Header.Get("Content-Encoding")))
+
+	var reader io.ReadCloser
+	switch encoding {
+	case "", "identity":
+		return nil
+	case "gzip":
+		gzipReader, err := gzip.NewReader(response.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decode gzip response body: %w", err)
+		}
+		reader = gzipReader
+	case "deflate":
+		deflateReader, err := newDeflateReader(response.Body)
+		if err != nil {
+			return fmt.Errorf("failed to decode deflate response body: %w", err)
+		}
+		reader = deflateReader
+	default:
+		return fmt.Errorf("%s %v", constants.UnsupportedContentEncodingMessage, encoding)
+	}
+	defer reader.Close()
+	defer response.Body.Close()
+
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s response body: %w", encoding, err)
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(decoded))
+	response.Header.Del("Content-Encoding")
+	response.ContentLength = int64(len(decoded))
+	return nil
+}
+
+// newDeflateReader returns a reader decoding a `deflate` encoded body. The `deflate` content encoding is defined
+// as zlib-wrapped DEFLATE data, however some servers send raw DEFLATE data instead, so bodies without a valid
+// zlib header are decoded as raw DEFLATE data.
+func newDeflateReader(body io.Reader) (io.ReadCloser, error) {
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if zlibReader, err := zlib.NewReader(bytes.NewReader(raw)); err == nil {
+		return zlibReader, nil
+	}
+	return flate.NewReader(bytes.NewReader(raw)), nil
+}
+
 func CreateRequestUrl(baseUrl string, tickers []string) string {
 	return strings.Replace(baseUrl, "$", strings.Join(tickers, ","), -1)
 }
+
+// CreateRequestBody returns the body of a POST request to an exchange by replacing each "$" in the body template
+// with a JSON array of the tickers.
+func CreateRequestBody(bodyTemplate string, tickers []string) ([]byte, error) {
+	tickersJson, err := json.Marshal(tickers)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.Replace(bodyTemplate, "$", string(tickersJson), -1)), nil
+}
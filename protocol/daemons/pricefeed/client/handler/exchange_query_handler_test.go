@@ -1,10 +1,15 @@
 package handler
 
 import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
 	"errors"
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/daemons/pricefeed/exchange_config"
+	"io"
 	"net/http"
 	"testing"
 	"time"
@@ -50,6 +55,7 @@ var (
 			},
 			exchange_config.MARKET_ETH_USD: {
 				Ticker: constants.EthUsdPair,
+				Weight: 3,
 			},
 			noPriceExponentMarketId: {
 				Ticker: noPriceExponentTicker,
@@ -96,6 +102,7 @@ func TestQuery(t *testing.T) {
 					Price:         dummyPrice,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
+					Weight:        1,
 				},
 			},
 		},
@@ -116,11 +123,13 @@ func TestQuery(t *testing.T) {
 					Price:         dummyPrice,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
+					Weight:        1,
 				},
 				{
 					Price:         dummyPrice,
 					MarketId:      exchange_config.MARKET_ETH_USD,
 					LastUpdatedAt: lastUpdatedAt,
+					Weight:        3,
 				},
 			},
 		},
@@ -142,11 +151,13 @@ func TestQuery(t *testing.T) {
 					Price:         dummyPrice,
 					MarketId:      exchange_config.MARKET_BTC_USD,
 					LastUpdatedAt: lastUpdatedAt,
+					Weight:        1,
 				},
 				{
 					Price:         dummyPrice,
 					MarketId:      exchange_config.MARKET_ETH_USD,
 					LastUpdatedAt: lastUpdatedAt,
+					Weight:        3,
 				},
 			},
 			expectedUnavailable: map[types.MarketId]error{
@@ -291,6 +302,217 @@ func TestQuery(t *testing.T) {
 	}
 }
 
+func TestQuery_DecodesResponseBody(t *testing.T) {
+	body := []byte(`{"symbol":"BTCUSDT","lastPrice":"1"}`)
+
+	tests := map[string]struct {
+		contentEncoding string
+		encodeBody      func(t *testing.T, body []byte) []byte
+
+		expectedError error
+	}{
+		"No content encoding": {
+			encodeBody: func(t *testing.T, body []byte) []byte { return body },
+		},
+		"Identity content encoding": {
+			contentEncoding: "identity",
+			encodeBody:      func(t *testing.T, body []byte) []byte { return body },
+		},
+		"Gzip content encoding": {
+			contentEncoding: "gzip",
+			encodeBody:      gzipBody,
+		},
+		"Deflate content encoding": {
+			contentEncoding: "deflate",
+			encodeBody:      zlibBody,
+		},
+		"Deflate content encoding with raw deflate body": {
+			contentEncoding: "deflate",
+			encodeBody:      deflateBody,
+		},
+		"Failure - invalid gzip body": {
+			contentEncoding: "gzip",
+			encodeBody:      func(t *testing.T, body []byte) []byte { return body },
+			expectedError:   errors.New("failed to decode gzip response body: gzip: invalid header"),
+		},
+		"Failure - unknown content encoding": {
+			contentEncoding: "br",
+			encodeBody:      func(t *testing.T, body []byte) []byte { return body },
+			expectedError:   fmt.Errorf("%s %v", pf_constants.UnsupportedContentEncodingMessage, "br"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if tc.contentEncoding != "" {
+				header.Set("Content-Encoding", tc.contentEncoding)
+			}
+			requestHandler := &mocks.RequestHandler{}
+			requestHandler.On(
+				"Get",
+				context.Background(),
+				CreateRequestUrl(baseEqd.Url, []string{constants.BtcUsdPair}),
+			).Return(
+				&http.Response{
+					StatusCode: successStatus,
+					Header:     header,
+					Body:       io.NopCloser(bytes.NewReader(tc.encodeBody(t, body))),
+				},
+				nil,
+			)
+
+			var receivedBody []byte
+			eqd := &types.ExchangeQueryDetails{
+				Url: baseEqd.Url,
+				PriceFunction: func(
+					response *http.Response,
+					tickerToPriceExponent map[string]int32,
+					resolver pft.Resolver,
+				) (prices map[string]uint64, unavailable map[string]error, err error) {
+					receivedBody, err = io.ReadAll(response.Body)
+					require.NoError(t, err)
+					return priceFunc(response, tickerToPriceExponent, resolver)
+				},
+			}
+
+			eqh := ExchangeQueryHandlerImpl{generateMockTimeProvider(time.Unix(0, 0))}
+			prices, _, err := eqh.Query(
+				context.Background(),
+				eqd,
+				baseEmc,
+				[]types.MarketId{exchange_config.MARKET_BTC_USD},
+				requestHandler,
+				testMarketExponentMap,
+			)
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+				require.Nil(t, receivedBody)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, prices, 1)
+				require.Equal(t, body, receivedBody)
+			}
+		})
+	}
+}
+
+func TestQuery_RequestMethod(t *testing.T) {
+	postUrl := "https://api.exchange.com/api/v1/tickers"
+	bodyTemplate := `{"symbols":$}`
+	tickers := []string{constants.BtcUsdPair, constants.EthUsdPair}
+	marketIds := []types.MarketId{exchange_config.MARKET_BTC_USD, exchange_config.MARKET_ETH_USD}
+
+	tests := map[string]struct {
+		method string
+
+		expectedGetUrl   string
+		expectedPostUrl  string
+		expectedPostBody []byte
+		expectedError    error
+	}{
+		"GET - default method": {
+			expectedGetUrl: CreateRequestUrl(baseEqd.Url, tickers),
+		},
+		"GET - explicit method": {
+			method:         http.MethodGet,
+			expectedGetUrl: CreateRequestUrl(baseEqd.Url, tickers),
+		},
+		"POST - templated body": {
+			method:           http.MethodPost,
+			expectedPostUrl:  postUrl,
+			expectedPostBody: []byte(fmt.Sprintf(`{"symbols":["%s","%s"]}`, constants.BtcUsdPair, constants.EthUsdPair)),
+		},
+		"Failure - unsupported method": {
+			method:        http.MethodPut,
+			expectedError: fmt.Errorf("%s %v", pf_constants.UnsupportedRequestMethodMessage, http.MethodPut),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			requestHandler := &mocks.RequestHandler{}
+			eqd := &types.ExchangeQueryDetails{
+				Url:           baseEqd.Url,
+				Method:        tc.method,
+				PriceFunction: priceFunc,
+			}
+			if tc.expectedGetUrl != "" {
+				requestHandler.On("Get", context.Background(), tc.expectedGetUrl).
+					Return(&http.Response{StatusCode: successStatus}, nil)
+			}
+			if tc.expectedPostUrl != "" {
+				eqd.Url = postUrl
+				eqd.BodyTemplate = bodyTemplate
+				requestHandler.On("Post", context.Background(), tc.expectedPostUrl, "application/json", tc.expectedPostBody).
+					Return(&http.Response{StatusCode: successStatus}, nil)
+			}
+
+			eqh := ExchangeQueryHandlerImpl{generateMockTimeProvider(time.Unix(0, 0))}
+			prices, _, err := eqh.Query(
+				context.Background(),
+				eqd,
+				baseEmc,
+				marketIds,
+				requestHandler,
+				testMarketExponentMap,
+			)
+
+			requestHandler.AssertExpectations(t)
+			if tc.expectedGetUrl == "" {
+				requestHandler.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+			}
+			if tc.expectedPostUrl == "" {
+				requestHandler.AssertNotCalled(t, "Post", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				require.Nil(t, prices)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, prices, len(marketIds))
+			}
+		})
+	}
+}
+
+func TestCreateRequestBody(t *testing.T) {
+	body, err := CreateRequestBody(`{"symbols":$,"type":"spot"}`, []string{"BTC-USD", "ETH-USD"})
+	require.NoError(t, err)
+	require.Equal(t, `{"symbols":["BTC-USD","ETH-USD"],"type":"spot"}`, string(body))
+}
+
+func gzipBody(t *testing.T, body []byte) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func zlibBody(t *testing.T, body []byte) []byte {
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	_, err := writer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func deflateBody(t *testing.T, body []byte) []byte {
+	var buf bytes.Buffer
+	writer, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	require.NoError(t, err)
+	_, err = writer.Write(body)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
 func generateMockTimeProvider(time time.Time) *mocks.TimeProvider {
 	mockTimeProvider := &mocks.TimeProvider{}
 	mockTimeProvider.On("Now").Return(time)
@@ -155,7 +155,7 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 		adjustByIndexPrice, numPricesMedianized := p.exchangeToMarketPrices.GetIndexPrice(
 			conversionDetails.AdjustByMarketDetails.MarketId,
 			time.Now().Add(-pricefeedtypes.MaxPriceAge),
-			lib.Median[uint64],
+			lib.WeightedMedian[uint64],
 		)
 		// If the index price is not valid due to insufficient pricing data, return an error.
 		if numPricesMedianized < int(conversionDetails.AdjustByMarketDetails.MinExchanges) {
@@ -214,6 +214,7 @@ func (p PriceEncoderImpl) convertPriceUpdate(marketPriceTimestamp *types.MarketP
 		MarketId:      marketPriceTimestamp.MarketId,
 		Price:         price,
 		LastUpdatedAt: marketPriceTimestamp.LastUpdatedAt,
+		Weight:        marketPriceTimestamp.Weight,
 	}, nil
 }
 
@@ -257,6 +258,18 @@ func (p *PriceEncoderImpl) UpdatePrice(marketPriceTimestamp *types.MarketPriceTi
 	// Update exchangeToMarketPrices cache.
 	p.exchangeToMarketPrices.UpdatePrice(p.GetExchangeId(), price)
 
+	// Record the time of the last successful update for the market on the exchange as a unix timestamp, in seconds,
+	// so that markets that have stopped updating can be alerted on. A precision gauge is used because unix
+	// timestamps cannot be represented accurately as a float32.
+	gometrics.SetPrecisionGaugeWithLabels(
+		[]string{metrics.PricefeedDaemon, metrics.PriceEncoderLastUpdateTimestamp},
+		float64(price.LastUpdatedAt.Unix()),
+		[]gometrics.Label{
+			pricefeedmetrics.GetLabelForMarketId(marketPriceTimestamp.MarketId),
+			pricefeedmetrics.GetLabelForExchangeId(p.GetExchangeId()),
+		},
+	)
+
 	// Record success.
 	telemetry.IncrCounterWithLabels(
 		[]string{metrics.PricefeedDaemon, metrics.PriceEncoderPriceConversion, metrics.Success},
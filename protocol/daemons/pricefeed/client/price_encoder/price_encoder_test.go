@@ -9,10 +9,12 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_fetcher"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/price_function"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
-	pft "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	gometrics "github.com/hashicorp/go-metrics"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"syscall"
@@ -110,7 +112,11 @@ type MockExchangeToMarketPrices struct {
 	numPricesMedianized int
 }
 
-func (m *MockExchangeToMarketPrices) GetIndexPrice(types.MarketId, time.Time, pft.Resolver) (uint64, int) {
+func (m *MockExchangeToMarketPrices) GetIndexPrice(
+	types.MarketId,
+	time.Time,
+	pricefeedtypes.WeightedMedianizer,
+) (uint64, int) {
 	return m.indexPrice, m.numPricesMedianized
 }
 
@@ -270,6 +276,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 					MarketId:      constants.MarketId1,
 					Price:         constants.FiveBillion,
 					LastUpdatedAt: constants.TimeT,
+					Weight:        3,
 				},
 			)
 			if tc.expectedErr != nil {
@@ -280,6 +287,7 @@ func TestConvertPriceUpdate_Mixed(t *testing.T) {
 				require.Equal(t, constants.TimeT, convertedPriceTimestamp.LastUpdatedAt)
 				require.Equal(t, constants.MarketId1, convertedPriceTimestamp.MarketId)
 				require.Equal(t, tc.expectedPrice, convertedPriceTimestamp.Price)
+				require.Equal(t, uint32(3), convertedPriceTimestamp.Weight)
 			}
 		})
 	}
@@ -417,3 +425,72 @@ func TestProcessPriceFetcherResponse_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessPriceFetcherResponse_LastUpdateTimestampGauge(t *testing.T) {
+	tests := map[string]struct {
+		responses []*price_fetcher.PriceFetcherSubtaskResponse
+
+		expectGauge        bool
+		expectedGaugeValue float64
+	}{
+		"Gauge is set after a successful update": {
+			responses: []*price_fetcher.PriceFetcherSubtaskResponse{
+				{Price: constants.Market9_TimeT_Price1},
+			},
+			expectGauge:        true,
+			expectedGaugeValue: float64(constants.TimeT.Unix()),
+		},
+		"Gauge is not set after an error response": {
+			responses: []*price_fetcher.PriceFetcherSubtaskResponse{
+				{Err: context.DeadlineExceeded},
+			},
+			expectGauge: false,
+		},
+		"Gauge is not updated by an error response after a successful update": {
+			responses: []*price_fetcher.PriceFetcherSubtaskResponse{
+				{Price: constants.Market9_TimeT_Price1},
+				{Err: errors.New("unidentified error")},
+			},
+			expectGauge:        true,
+			expectedGaugeValue: float64(constants.TimeT.Unix()),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(gometrics.Shutdown)
+			conf := gometrics.DefaultConfig("service")
+			conf.EnableHostname = false
+			sink := gometrics.NewInmemSink(time.Hour, time.Hour)
+			_, err := gometrics.NewGlobal(conf, sink)
+			require.NoError(t, err)
+
+			pe := genNewPriceEncoder(t)
+			for _, response := range tc.responses {
+				pe.ProcessPriceFetcherResponse(response)
+			}
+
+			expectedName := fmt.Sprintf(
+				"service.%s.%s",
+				metrics.PricefeedDaemon,
+				metrics.PriceEncoderLastUpdateTimestamp,
+			)
+			expectedLabels := []gometrics.Label{
+				pricefeedmetrics.GetLabelForMarketId(constants.MarketId9),
+				pricefeedmetrics.GetLabelForExchangeId(constants.ExchangeId1),
+			}
+			found := false
+			for _, interval := range sink.Data() {
+				interval.RLock()
+				for _, gauge := range interval.PrecisionGauges {
+					if gauge.Name == expectedName {
+						require.Equal(t, expectedLabels, gauge.Labels)
+						require.Equal(t, tc.expectedGaugeValue, gauge.Value)
+						found = true
+					}
+				}
+				interval.RUnlock()
+			}
+			require.Equal(t, tc.expectGauge, found)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package price_fetcher
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+)
+
+// badPriceTracker tracks the bad prices returned by a single exchange for each of its markets, and
+// temporarily disables a market on the exchange once it returns `threshold` bad prices within `window`.
+// A disabled market is re-enabled once `cooldown` has elapsed since it was disabled.
+// All access is synchronized by a mutex, as bad prices are recorded concurrently by subtask goroutines.
+type badPriceTracker struct {
+	sync.Mutex
+	// threshold is the number of bad prices within `window` that disables a market. A threshold of 0
+	// never disables a market.
+	threshold    uint32
+	window       time.Duration
+	cooldown     time.Duration
+	timeProvider libtime.TimeProvider
+
+	// Access to all following fields is protected.
+	// badPriceTimes stores the times of the bad prices returned for each enabled market within `window`.
+	badPriceTimes map[types.MarketId][]time.Time
+	// disabledUntil stores the time at which each disabled market is re-enabled.
+	disabledUntil map[types.MarketId]time.Time
+}
+
+// newBadPriceTracker creates a new badPriceTracker with no bad prices recorded.
+func newBadPriceTracker(
+	threshold uint32,
+	window time.Duration,
+	cooldown time.Duration,
+	timeProvider libtime.TimeProvider,
+) *badPriceTracker {
+	return &badPriceTracker{
+		threshold:     threshold,
+		window:        window,
+		cooldown:      cooldown,
+		timeProvider:  timeProvider,
+		badPriceTimes: make(map[types.MarketId][]time.Time),
+		disabledUntil: make(map[types.MarketId]time.Time),
+	}
+}
+
+// RecordBadPrice records a bad price returned for the market, and returns true if this bad price
+// caused the market to be disabled. This method is synchronized.
+func (t *badPriceTracker) RecordBadPrice(marketId types.MarketId) (disabled bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.threshold == 0 {
+		return false
+	}
+	if _, isDisabled := t.disabledUntil[marketId]; isDisabled {
+		return false
+	}
+
+	// Drop bad prices that fall outside of the window.
+	now := t.timeProvider.Now()
+	windowStart := now.Add(-t.window)
+	badPriceTimes := make([]time.Time, 0, len(t.badPriceTimes[marketId])+1)
+	for _, badPriceTime := range t.badPriceTimes[marketId] {
+		if badPriceTime.After(windowStart) {
+			badPriceTimes = append(badPriceTimes, badPriceTime)
+		}
+	}
+	badPriceTimes = append(badPriceTimes, now)
+
+	if uint32(len(badPriceTimes)) < t.threshold {
+		t.badPriceTimes[marketId] = badPriceTimes
+		return false
+	}
+
+	delete(t.badPriceTimes, marketId)
+	t.disabledUntil[marketId] = now.Add(t.cooldown)
+	return true
+}
+
+// GetEnabledMarkets filters out the disabled markets from `marketIds`. Markets whose cooldown has
+// elapsed are re-enabled and returned in `reEnabledMarketIds`. This method is synchronized.
+func (t *badPriceTracker) GetEnabledMarkets(marketIds []types.MarketId) (
+	enabledMarketIds []types.MarketId,
+	reEnabledMarketIds []types.MarketId,
+) {
+	t.Lock()
+	defer t.Unlock()
+
+	now := t.timeProvider.Now()
+	enabledMarketIds = make([]types.MarketId, 0, len(marketIds))
+	for _, marketId := range marketIds {
+		disabledUntil, isDisabled := t.disabledUntil[marketId]
+		if isDisabled {
+			if now.Before(disabledUntil) {
+				continue
+			}
+			delete(t.disabledUntil, marketId)
+			reEnabledMarketIds = append(reEnabledMarketIds, marketId)
+		}
+		enabledMarketIds = append(enabledMarketIds, marketId)
+	}
+	return enabledMarketIds, reEnabledMarketIds
+}
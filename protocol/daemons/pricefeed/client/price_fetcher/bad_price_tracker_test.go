@@ -0,0 +1,129 @@
+package price_fetcher
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockTimeProvider returns a mock time provider whose current time is read from `now`.
+func newMockTimeProvider(now *time.Time) *mocks.TimeProvider {
+	timeProvider := &mocks.TimeProvider{}
+	timeProvider.On("Now").Return(func() time.Time { return *now })
+	return timeProvider
+}
+
+func TestBadPriceTracker(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tracker := newBadPriceTracker(3, time.Minute, 10*time.Minute, newMockTimeProvider(&now))
+	allMarkets := []types.MarketId{constants.MarketId7, constants.MarketId8}
+
+	// Bad prices spread out beyond the window do not disable the market.
+	for i := 0; i < 5; i++ {
+		require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+		now = now.Add(31 * time.Second)
+	}
+	enabled, reEnabled := tracker.GetEnabledMarkets(allMarkets)
+	require.Equal(t, allMarkets, enabled)
+	require.Empty(t, reEnabled)
+
+	// Repeated bad prices within the window disable the market.
+	now = now.Add(time.Minute)
+	require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+	require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+	require.True(t, tracker.RecordBadPrice(constants.MarketId7))
+
+	// Further bad prices for a disabled market are ignored.
+	require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+
+	// Other markets on the exchange are unaffected.
+	enabled, reEnabled = tracker.GetEnabledMarkets(allMarkets)
+	require.Equal(t, []types.MarketId{constants.MarketId8}, enabled)
+	require.Empty(t, reEnabled)
+
+	// The market remains disabled until the cooldown elapses.
+	now = now.Add(10*time.Minute - time.Second)
+	enabled, reEnabled = tracker.GetEnabledMarkets(allMarkets)
+	require.Equal(t, []types.MarketId{constants.MarketId8}, enabled)
+	require.Empty(t, reEnabled)
+
+	// The market is re-enabled once the cooldown elapses, with no bad prices carried over.
+	now = now.Add(time.Second)
+	enabled, reEnabled = tracker.GetEnabledMarkets(allMarkets)
+	require.Equal(t, allMarkets, enabled)
+	require.Equal(t, []types.MarketId{constants.MarketId7}, reEnabled)
+	require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+	require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+}
+
+func TestBadPriceTracker_ZeroThresholdNeverDisables(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	tracker := newBadPriceTracker(0, time.Minute, time.Minute, newMockTimeProvider(&now))
+
+	for i := 0; i < 10; i++ {
+		require.False(t, tracker.RecordBadPrice(constants.MarketId7))
+	}
+	enabled, reEnabled := tracker.GetEnabledMarkets([]types.MarketId{constants.MarketId7})
+	require.Equal(t, []types.MarketId{constants.MarketId7}, enabled)
+	require.Empty(t, reEnabled)
+}
+
+// TestRunTaskLoop_RepeatedBadPricesDisableMarket tests that the price fetcher stops querying a market
+// that repeatedly returns bad prices, and resumes querying it after the cooldown.
+func TestRunTaskLoop_RepeatedBadPricesDisableMarket(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	mutableExchangeMarketConfig := constants.Exchange1_1Markets_MutableExchangeMarketConfig
+	mutableMarketConfigs := constants.MutableMarketConfigs_1Markets
+
+	queryHandler := &mocks.ExchangeQueryHandler{}
+	queryHandler.On(
+		"Query",
+		mock.AnythingOfType("*context.timerCtx"),
+		mock.AnythingOfType("*types.ExchangeQueryDetails"),
+		mock.AnythingOfType("*types.MutableExchangeMarketConfig"),
+		[]types.MarketId{constants.MarketId7},
+		&daemontypes.RequestHandlerImpl{},
+		generateMarketExponentsMap(mutableMarketConfigs),
+	).Return([]*types.MarketPriceTimestamp{{MarketId: constants.MarketId7, Price: 0}}, nil, nil)
+
+	bCh := newTestPriceFetcherBufferedChannel()
+	pf, err := NewPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		constants.SingleMarketExchangeQueryDetails,
+		&mutableExchangeMarketConfig,
+		mutableMarketConfigs,
+		queryHandler,
+		0,
+		0,
+		nil,
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+	pf.badPriceTracker = newBadPriceTracker(2, time.Minute, 5*time.Minute, newMockTimeProvider(&now))
+
+	// Two bad prices in a row disable the market.
+	for i := 0; i < 2; i++ {
+		pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+		require.Error(t, (<-bCh).Err)
+	}
+	queryHandler.AssertNumberOfCalls(t, "Query", 2)
+
+	// The disabled market is no longer queried.
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+	queryHandler.AssertNumberOfCalls(t, "Query", 2)
+	require.Empty(t, bCh)
+
+	// The market is queried again after the cooldown.
+	now = now.Add(5 * time.Minute)
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+	queryHandler.AssertNumberOfCalls(t, "Query", 3)
+	require.Error(t, (<-bCh).Err)
+}
@@ -0,0 +1,86 @@
+package price_fetcher
+
+import (
+	"sync"
+	"time"
+
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
+)
+
+// exchangeCircuitBreaker tracks the consecutive failed queries to a single exchange, and temporarily
+// stops querying the exchange once `threshold` consecutive queries have failed. Querying resumes once
+// `cooldown` has elapsed since the exchange was disabled.
+// All access is synchronized by a mutex, as query results are recorded concurrently by subtask goroutines.
+type exchangeCircuitBreaker struct {
+	sync.Mutex
+	// threshold is the number of consecutive failed queries that disables the exchange. A threshold of 0
+	// never disables the exchange.
+	threshold    uint32
+	cooldown     time.Duration
+	timeProvider libtime.TimeProvider
+
+	// Access to all following fields is protected.
+	// consecutiveFailures is the number of failed queries since the last successful query.
+	consecutiveFailures uint32
+	// disabledUntil is the time at which the exchange is re-enabled, or the zero time if it is enabled.
+	disabledUntil time.Time
+}
+
+// newExchangeCircuitBreaker creates a new exchangeCircuitBreaker with no failed queries recorded.
+func newExchangeCircuitBreaker(
+	threshold uint32,
+	cooldown time.Duration,
+	timeProvider libtime.TimeProvider,
+) *exchangeCircuitBreaker {
+	return &exchangeCircuitBreaker{
+		threshold:    threshold,
+		cooldown:     cooldown,
+		timeProvider: timeProvider,
+	}
+}
+
+// RecordFailure records a failed query to the exchange, and returns true if this failure caused the
+// exchange to be disabled. This method is synchronized.
+func (b *exchangeCircuitBreaker) RecordFailure() (disabled bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.threshold == 0 || !b.disabledUntil.IsZero() {
+		return false
+	}
+
+	b.consecutiveFailures++
+	if b.consecutiveFailures < b.threshold {
+		return false
+	}
+
+	b.consecutiveFailures = 0
+	b.disabledUntil = b.timeProvider.Now().Add(b.cooldown)
+	return true
+}
+
+// RecordSuccess records a successful query to the exchange, resetting the count of consecutive failures.
+// This method is synchronized.
+func (b *exchangeCircuitBreaker) RecordSuccess() {
+	b.Lock()
+	defer b.Unlock()
+
+	b.consecutiveFailures = 0
+}
+
+// IsEnabled returns true if the exchange may be queried. An exchange whose cooldown has elapsed is
+// re-enabled, in which case `reEnabled` is also true. This method is synchronized.
+func (b *exchangeCircuitBreaker) IsEnabled() (enabled bool, reEnabled bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if b.disabledUntil.IsZero() {
+		return true, false
+	}
+	if b.timeProvider.Now().Before(b.disabledUntil) {
+		return false, false
+	}
+
+	b.disabledUntil = time.Time{}
+	return true, true
+}
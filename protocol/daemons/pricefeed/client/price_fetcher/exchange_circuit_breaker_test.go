@@ -0,0 +1,118 @@
+package price_fetcher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeCircuitBreaker(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	breaker := newExchangeCircuitBreaker(3, 10*time.Minute, newMockTimeProvider(&now))
+
+	// A successful query resets the consecutive failures.
+	require.False(t, breaker.RecordFailure())
+	require.False(t, breaker.RecordFailure())
+	breaker.RecordSuccess()
+	require.False(t, breaker.RecordFailure())
+	require.False(t, breaker.RecordFailure())
+	enabled, reEnabled := breaker.IsEnabled()
+	require.True(t, enabled)
+	require.False(t, reEnabled)
+
+	// Consecutive failures disable the exchange.
+	require.True(t, breaker.RecordFailure())
+
+	// Further failures for a disabled exchange are ignored.
+	require.False(t, breaker.RecordFailure())
+
+	// The exchange remains disabled until the cooldown elapses.
+	now = now.Add(10*time.Minute - time.Second)
+	enabled, reEnabled = breaker.IsEnabled()
+	require.False(t, enabled)
+	require.False(t, reEnabled)
+
+	// The exchange is re-enabled once the cooldown elapses, with no failures carried over.
+	now = now.Add(time.Second)
+	enabled, reEnabled = breaker.IsEnabled()
+	require.True(t, enabled)
+	require.True(t, reEnabled)
+	enabled, reEnabled = breaker.IsEnabled()
+	require.True(t, enabled)
+	require.False(t, reEnabled)
+	require.False(t, breaker.RecordFailure())
+	require.False(t, breaker.RecordFailure())
+}
+
+func TestExchangeCircuitBreaker_ZeroThresholdNeverDisables(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	breaker := newExchangeCircuitBreaker(0, time.Minute, newMockTimeProvider(&now))
+
+	for i := 0; i < 10; i++ {
+		require.False(t, breaker.RecordFailure())
+	}
+	enabled, reEnabled := breaker.IsEnabled()
+	require.True(t, enabled)
+	require.False(t, reEnabled)
+}
+
+// TestRunTaskLoop_ConsecutiveFailedQueriesDisableExchange tests that the price fetcher stops querying an
+// exchange after consecutive failed queries, and resumes querying it after the cooldown.
+func TestRunTaskLoop_ConsecutiveFailedQueriesDisableExchange(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	mutableExchangeMarketConfig := constants.Exchange1_1Markets_MutableExchangeMarketConfig
+	mutableMarketConfigs := constants.MutableMarketConfigs_1Markets
+
+	queryHandler := &mocks.ExchangeQueryHandler{}
+	queryHandler.On(
+		"Query",
+		mock.AnythingOfType("*context.timerCtx"),
+		mock.AnythingOfType("*types.ExchangeQueryDetails"),
+		mock.AnythingOfType("*types.MutableExchangeMarketConfig"),
+		[]types.MarketId{constants.MarketId7},
+		&daemontypes.RequestHandlerImpl{},
+		generateMarketExponentsMap(mutableMarketConfigs),
+	).Return(nil, nil, errors.New("503 Service Unavailable"))
+
+	bCh := newTestPriceFetcherBufferedChannel()
+	pf, err := NewPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		constants.SingleMarketExchangeQueryDetails,
+		&mutableExchangeMarketConfig,
+		mutableMarketConfigs,
+		queryHandler,
+		0,
+		0,
+		nil,
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+	pf.exchangeCircuitBreaker = newExchangeCircuitBreaker(2, 5*time.Minute, newMockTimeProvider(&now))
+
+	// Two failed queries in a row disable the exchange.
+	for i := 0; i < 2; i++ {
+		pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+		require.Error(t, (<-bCh).Err)
+	}
+	queryHandler.AssertNumberOfCalls(t, "Query", 2)
+
+	// The disabled exchange is no longer queried.
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+	queryHandler.AssertNumberOfCalls(t, "Query", 2)
+	require.Empty(t, bCh)
+
+	// The exchange is queried again after the cooldown.
+	now = now.Add(5 * time.Minute)
+	pf.RunTaskLoop(&daemontypes.RequestHandlerImpl{})
+	queryHandler.AssertNumberOfCalls(t, "Query", 3)
+	require.Error(t, (<-bCh).Err)
+}
@@ -16,6 +16,9 @@ func TestGetNextNMarkets(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -46,6 +49,9 @@ func TestGetMarketIds(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -62,6 +68,9 @@ func TestGetMarketExponents(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -81,6 +90,9 @@ func TestGetMutableExchangeConfig(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -101,6 +113,9 @@ func TestGetTaskLoopDefinition(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	libtime "github.com/dydxprotocol/v4-chain/protocol/lib/time"
 	"math/rand"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/handler"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	gometrics "github.com/hashicorp/go-metrics"
@@ -36,6 +38,17 @@ type PriceFetcher struct {
 	logger              log.Logger
 	bCh                 chan<- *PriceFetcherSubtaskResponse
 
+	// badPriceTracker tracks the bad prices returned by the exchange and temporarily disables markets
+	// that repeatedly return bad prices.
+	badPriceTracker *badPriceTracker
+
+	// exchangeCircuitBreaker tracks the consecutive failed queries to the exchange and temporarily stops
+	// querying the exchange if it is continuously failing.
+	exchangeCircuitBreaker *exchangeCircuitBreaker
+
+	// exchangeHealthTracker records the duration and outcome of every query to the exchange. May be nil.
+	exchangeHealthTracker *pricefeed_types.ExchangeHealthTracker
+
 	// mutableState contains all mutable state on the price fetcher is consolidated into a single object with access
 	// and update protected by a mutex.
 	mutableState *mutableState
@@ -43,13 +56,18 @@ type PriceFetcher struct {
 
 // NewPriceFetcher creates a new PriceFetcher struct. It manages querying markets via goroutine
 // queries to an exchange and encodes the responses or related errors into the shared buffered
-// channel `bCh`.
+// channel `bCh`. After `exchangeFailureThreshold` consecutive failed queries, the exchange is not
+// queried until `exchangeFailureCooldown` has elapsed. A threshold of 0 always queries the exchange. The
+// duration and outcome of each query are recorded in `exchangeHealthTracker`, if set.
 func NewPriceFetcher(
 	exchangeQueryConfig types.ExchangeQueryConfig,
 	exchangeDetails types.ExchangeQueryDetails,
 	mutableExchangeConfig *types.MutableExchangeMarketConfig,
 	mutableMarketConfigs []*types.MutableMarketConfig,
 	queryHandler handler.ExchangeQueryHandler,
+	exchangeFailureThreshold uint32,
+	exchangeFailureCooldown time.Duration,
+	exchangeHealthTracker *pricefeed_types.ExchangeHealthTracker,
 	logger log.Logger,
 	bCh chan<- *PriceFetcherSubtaskResponse,
 ) (
@@ -70,7 +88,19 @@ func NewPriceFetcher(
 		queryHandler:        queryHandler,
 		logger:              pfLogger,
 		bCh:                 bCh,
-		mutableState:        &mutableState{},
+		badPriceTracker: newBadPriceTracker(
+			constants.BadPriceDisableThreshold,
+			constants.BadPriceWindow,
+			constants.BadPriceDisableCooldown,
+			&libtime.TimeProviderImpl{},
+		),
+		exchangeCircuitBreaker: newExchangeCircuitBreaker(
+			exchangeFailureThreshold,
+			exchangeFailureCooldown,
+			&libtime.TimeProviderImpl{},
+		),
+		exchangeHealthTracker: exchangeHealthTracker,
+		mutableState:          &mutableState{},
 	}
 
 	// This will instantiate the price fetcher's mutable state.
@@ -156,16 +186,26 @@ func (p *PriceFetcher) getNumQueriesPerTaskLoop() int {
 
 // RunTaskLoop queries the exchange for market prices.
 // Each goroutine makes a single exchange query for a specific set of one or more markets.
+// Markets that are disabled on the exchange due to repeated bad prices are not queried, and the exchange
+// is not queried at all while it is disabled due to consecutive failed queries.
 // RunTaskLoop blocks until all spawned goroutines have completed.
 func (pf *PriceFetcher) RunTaskLoop(requestHandler daemontypes.RequestHandler) {
+	if !pf.isExchangeEnabled() {
+		return
+	}
+
 	taskLoopDefinition := pf.getTaskLoopDefinition()
+	taskLoopDefinition.marketIds = pf.getEnabledMarkets(taskLoopDefinition.marketIds)
 
 	if pf.isMultiMarketAndHasMarkets() {
-		pf.runSubTask(
-			requestHandler,
-			taskLoopDefinition.marketIds,
-			taskLoopDefinition,
-		)
+		// Skip the query if all markets are disabled.
+		if len(taskLoopDefinition.marketIds) > 0 {
+			pf.runSubTask(
+				requestHandler,
+				taskLoopDefinition.marketIds,
+				taskLoopDefinition,
+			)
+		}
 	} else {
 		// Run all subtasks in parallel and wait for each to complete.
 		var waitGroup sync.WaitGroup
@@ -185,6 +225,108 @@ func (pf *PriceFetcher) RunTaskLoop(requestHandler daemontypes.RequestHandler) {
 	}
 }
 
+// isExchangeEnabled returns true if the exchange may be queried, and logs and reports metrics if the
+// exchange is re-enabled after its cooldown.
+func (pf *PriceFetcher) isExchangeEnabled() bool {
+	enabled, reEnabled := pf.exchangeCircuitBreaker.IsEnabled()
+	if reEnabled {
+		pf.logger.Info("price_fetcher: Re-enabling exchange after failed query cooldown.")
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedDaemon,
+				metrics.PriceFetcherExchangeReEnabled,
+			},
+			1,
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForExchangeId(pf.exchangeQueryConfig.ExchangeId),
+			},
+		)
+	}
+	return enabled
+}
+
+// recordQueryFailure records a failed query to the exchange, and logs and reports metrics if the exchange
+// is disabled as a result.
+func (pf *PriceFetcher) recordQueryFailure() {
+	if !pf.exchangeCircuitBreaker.RecordFailure() {
+		return
+	}
+
+	pf.logger.Error(
+		"price_fetcher: Disabling exchange after consecutive failed queries.",
+		"threshold",
+		pf.exchangeCircuitBreaker.threshold,
+		"cooldown",
+		pf.exchangeCircuitBreaker.cooldown,
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			metrics.PricefeedDaemon,
+			metrics.PriceFetcherExchangeDisabled,
+		},
+		1,
+		[]gometrics.Label{
+			pricefeedmetrics.GetLabelForExchangeId(pf.exchangeQueryConfig.ExchangeId),
+		},
+	)
+}
+
+// getEnabledMarkets filters out the markets that are disabled on the exchange due to repeated bad prices,
+// and logs and reports metrics for markets that are re-enabled after their cooldown.
+func (pf *PriceFetcher) getEnabledMarkets(marketIds []types.MarketId) []types.MarketId {
+	enabledMarketIds, reEnabledMarketIds := pf.badPriceTracker.GetEnabledMarkets(marketIds)
+	for _, marketId := range reEnabledMarketIds {
+		pf.logger.Info(
+			"price_fetcher: Re-enabling market after bad price cooldown.",
+			constants.MarketIdLogKey,
+			marketId,
+		)
+		telemetry.IncrCounterWithLabels(
+			[]string{
+				metrics.PricefeedDaemon,
+				metrics.PriceFetcherMarketReEnabled,
+			},
+			1,
+			[]gometrics.Label{
+				pricefeedmetrics.GetLabelForExchangeId(pf.exchangeQueryConfig.ExchangeId),
+				pricefeedmetrics.GetLabelForMarketId(marketId),
+			},
+		)
+	}
+	return enabledMarketIds
+}
+
+// recordBadPrice records a bad price returned by the exchange for the market, and logs and reports metrics
+// if the market is disabled on the exchange as a result.
+func (pf *PriceFetcher) recordBadPrice(marketId types.MarketId) {
+	if !pf.badPriceTracker.RecordBadPrice(marketId) {
+		return
+	}
+
+	pf.logger.Error(
+		"price_fetcher: Disabling market after repeated bad prices.",
+		constants.MarketIdLogKey,
+		marketId,
+		"threshold",
+		pf.badPriceTracker.threshold,
+		"window",
+		pf.badPriceTracker.window,
+		"cooldown",
+		pf.badPriceTracker.cooldown,
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			metrics.PricefeedDaemon,
+			metrics.PriceFetcherMarketDisabled,
+		},
+		1,
+		[]gometrics.Label{
+			pricefeedmetrics.GetLabelForExchangeId(pf.exchangeQueryConfig.ExchangeId),
+			pricefeedmetrics.GetLabelForMarketId(marketId),
+		},
+	)
+}
+
 // emitMarketAvailabilityMetrics emits telemetry that tracks whether a market was available when queried on an exchange.
 // Success is tracked by (market, exchange) so that we can track the availability of each market on each exchange.
 func emitMarketAvailabilityMetrics(exchangeId types.ExchangeId, id types.MarketId, available bool) {
@@ -251,6 +393,7 @@ func (pf *PriceFetcher) runSubTask(
 		[]gometrics.Label{pricefeedmetrics.GetLabelForExchangeId(exchangeId)},
 	)
 
+	queryStart := time.Now()
 	prices, _, err := pf.queryHandler.Query(
 		ctxWithTimeout,
 		&pf.exchangeDetails,
@@ -259,12 +402,16 @@ func (pf *PriceFetcher) runSubTask(
 		requestHandler,
 		taskLoopDefinition.marketExponents,
 	)
+	if pf.exchangeHealthTracker != nil {
+		pf.exchangeHealthTracker.RecordFetch(exchangeId, time.Since(queryStart), err)
+	}
 
 	// Emit metrics at the `AvailableMarketsSampleRate`.
 	emitMetricsSample := rand.Float64() < metrics.AvailableMarketsSampleRate
 
 	if err != nil {
 		pf.writeToBufferedChannel(exchangeId, nil, err)
+		pf.recordQueryFailure()
 
 		// Since the query failed, report all markets as unavailable, according to the sampling rate.
 		if emitMetricsSample {
@@ -276,6 +423,8 @@ func (pf *PriceFetcher) runSubTask(
 		return
 	}
 
+	pf.exchangeCircuitBreaker.RecordSuccess()
+
 	// Track which markets were available when queried, and which were not, for telemetry.
 	availableMarkets := make(map[types.MarketId]bool, len(marketIds))
 	for _, marketId := range marketIds {
@@ -294,6 +443,7 @@ func (pf *PriceFetcher) runSubTask(
 					price.MarketId,
 				),
 			)
+			pf.recordBadPrice(price.MarketId)
 
 			continue
 		}
@@ -11,6 +11,7 @@ import (
 
 	"cosmossdk.io/log"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
+	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -135,6 +136,9 @@ func TestRunTaskLoop(t *testing.T) {
 				&tc.mutableExchangeConfig,
 				tc.mutableMarketConfigs,
 				queryHandler,
+				0,
+				0,
+				nil,
 				log.NewNopLogger(),
 				bCh,
 			)
@@ -199,6 +203,9 @@ func TestGetTaskLoopDefinition_SingleMarketExchange(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -237,6 +244,9 @@ func TestGetTaskLoopDefinition_MultiMarketExchange(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -339,6 +349,9 @@ func TestUpdateMutableExchangeConfig_CorrectlyUpdatesTaskDefinition(t *testing.T
 				&tc.initialMutableExchangeConfig,
 				tc.initialMarketConfig,
 				queryHandler,
+				0,
+				0,
+				nil,
 				log.NewNopLogger(),
 				bCh,
 			)
@@ -513,6 +526,9 @@ func TestUpdateMutableExchangeConfig_ProducesExpectedPrices(t *testing.T) {
 				&tc.initialMutableExchangeConfig,
 				tc.initialMarketConfigs,
 				queryHandler,
+				0,
+				0,
+				nil,
 				log.NewNopLogger(),
 				bCh,
 			)
@@ -613,6 +629,9 @@ func TestGetExchangeId(t *testing.T) {
 		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
 		constants.MutableMarketConfigs_3Markets,
 		&mocks.ExchangeQueryHandler{},
+		0,
+		0,
+		nil,
 		log.NewNopLogger(),
 		newTestPriceFetcherBufferedChannel(),
 	)
@@ -690,6 +709,9 @@ func TestRunSubTask_Mixed(t *testing.T) {
 				&mutableExchangeMarketConfig,
 				mutableMarketConfigs,
 				mockExchangeQueryHandler,
+				0,
+				0,
+				nil,
 				log.NewNopLogger(),
 				bCh,
 			)
@@ -722,6 +744,37 @@ func TestRunSubTask_Mixed(t *testing.T) {
 	}
 }
 
+// TestRunSubTask_RecordsExchangeHealthOncePerQuery tests that a query returning prices for several markets
+// is recorded once in the exchange health tracker.
+func TestRunSubTask_RecordsExchangeHealthOncePerQuery(t *testing.T) {
+	bCh := newTestPriceFetcherBufferedChannel()
+	tracker := pricefeed_types.NewExchangeHealthTracker()
+	pf, err := NewPriceFetcher(
+		constants.Exchange1_1MaxQueries_QueryConfig,
+		constants.MultiMarketExchangeQueryDetails,
+		&constants.Exchange1_3Markets_MutableExchangeMarketConfig,
+		constants.MutableMarketConfigs_3Markets,
+		generateMockExchangeQueryHandler(),
+		0,
+		0,
+		tracker,
+		log.NewNopLogger(),
+		bCh,
+	)
+	require.NoError(t, err)
+
+	marketIds := constants.Exchange1_3Markets_MutableExchangeMarketConfig.GetMarketIds()
+	pf.runSubTask(&daemontypes.RequestHandlerImpl{}, marketIds, pf.getTaskLoopDefinition())
+	require.Len(t, bCh, len(marketIds))
+
+	exchangeHealth := tracker.GetExchangeHealth()
+	require.Len(t, exchangeHealth, 1)
+	health := exchangeHealth[constants.ExchangeId1]
+	require.Len(t, health.RecentFetchDurations, 1)
+	require.False(t, health.LastSuccessTime.IsZero())
+	require.NoError(t, health.LastError)
+}
+
 // ----------------- Generate Mock Instances ----------------- //
 func generateMockExchangeQueryHandler() *mocks.ExchangeQueryHandler {
 	mockExchangeQueryHandler := &mocks.ExchangeQueryHandler{}
@@ -17,6 +17,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/handler"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	daemonlib "github.com/dydxprotocol/v4-chain/protocol/daemons/shared"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	gometrics "github.com/hashicorp/go-metrics"
@@ -43,6 +44,8 @@ type SubTaskRunner interface {
 		stop <-chan bool,
 		exchangeToMarketPrices types.ExchangeToMarketPrices,
 		priceFeedServiceClient api.PriceFeedServiceClient,
+		maxPriceAge time.Duration,
+		minExchangesPerMarket uint32,
 		logger log.Logger,
 	)
 	StartPriceEncoder(
@@ -59,6 +62,9 @@ type SubTaskRunner interface {
 		exchangeQueryConfig types.ExchangeQueryConfig,
 		exchangeDetails types.ExchangeQueryDetails,
 		queryHandler handler.ExchangeQueryHandler,
+		exchangeFailureThreshold uint32,
+		exchangeFailureCooldown time.Duration,
+		exchangeHealthTracker *pricefeed_types.ExchangeHealthTracker,
 		logger log.Logger,
 		bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
 	)
@@ -68,6 +74,8 @@ type SubTaskRunner interface {
 		stop <-chan bool,
 		configs types.PricefeedMutableMarketConfigs,
 		pricesQueryClient pricetypes.QueryClient,
+		maxQueryAttempts uint32,
+		queryRetryDelay time.Duration,
 		logger log.Logger,
 	)
 }
@@ -75,7 +83,9 @@ type SubTaskRunner interface {
 // StartPriceUpdater periodically runs a task loop to send price updates to the pricefeed server
 // via:
 // 1) Get `MarketPriceTimestamps` for all exchanges in an `ExchangeToMarketPrices` struct.
-// 2) Transform `MarketPriceTimestamps` and exchange ids into an `UpdateMarketPricesRequest` struct.
+// 2) Drop `MarketPriceTimestamps` that were last updated more than `maxPriceAge` ago.
+// 3) Transform `MarketPriceTimestamps` and exchange ids into an `UpdateMarketPricesRequest` struct.
+// 4) Drop markets that have prices from fewer than `minExchangesPerMarket` exchanges.
 // StartPriceUpdater runs in the daemon's main goroutine and does not need access to the daemon's wait group
 // to signal task completion.
 func (s *SubTaskRunnerImpl) StartPriceUpdater(
@@ -85,12 +95,21 @@ func (s *SubTaskRunnerImpl) StartPriceUpdater(
 	stop <-chan bool,
 	exchangeToMarketPrices types.ExchangeToMarketPrices,
 	priceFeedServiceClient api.PriceFeedServiceClient,
+	maxPriceAge time.Duration,
+	minExchangesPerMarket uint32,
 	logger log.Logger,
 ) {
 	for {
 		select {
 		case <-ticker.C:
-			err := RunPriceUpdaterTaskLoop(ctx, exchangeToMarketPrices, priceFeedServiceClient, logger)
+			err := RunPriceUpdaterTaskLoop(
+				ctx,
+				exchangeToMarketPrices,
+				priceFeedServiceClient,
+				maxPriceAge,
+				minExchangesPerMarket,
+				logger,
+			)
 
 			if err == nil {
 				// Record update success for the daemon health check.
@@ -157,6 +176,9 @@ func (s *SubTaskRunnerImpl) StartPriceEncoder(
 // 2) transform response to `MarketPriceTimestamp`
 // 3) send transformed response to a buffered channel that's shared across multiple goroutines
 // NOTE: the subtask response shared channel has a buffer size and goroutines will block if the buffer is full.
+// NOTE: after `exchangeFailureThreshold` consecutive failed queries, the exchange is not queried until
+// `exchangeFailureCooldown` has elapsed.
+// NOTE: the duration and outcome of every query are recorded once per query in `exchangeHealthTracker`.
 // NOTE: the price fetcher kicks off 1 to n go routines every time the subtask loop runs, but the subtask
 // loop blocks until all go routines are done. This means that these go routines are not tracked by the wait group.
 func (s *SubTaskRunnerImpl) StartPriceFetcher(
@@ -166,6 +188,9 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 	exchangeQueryConfig types.ExchangeQueryConfig,
 	exchangeDetails types.ExchangeQueryDetails,
 	queryHandler handler.ExchangeQueryHandler,
+	exchangeFailureThreshold uint32,
+	exchangeFailureCooldown time.Duration,
+	exchangeHealthTracker *pricefeed_types.ExchangeHealthTracker,
 	logger log.Logger,
 	bCh chan<- *price_fetcher.PriceFetcherSubtaskResponse,
 ) {
@@ -186,6 +211,9 @@ func (s *SubTaskRunnerImpl) StartPriceFetcher(
 		exchangeMarketConfig,
 		marketConfigs,
 		queryHandler,
+		exchangeFailureThreshold,
+		exchangeFailureCooldown,
+		exchangeHealthTracker,
 		logger,
 		bCh,
 	)
@@ -228,6 +256,8 @@ func (s *SubTaskRunnerImpl) StartMarketParamUpdater(
 	stop <-chan bool,
 	configs types.PricefeedMutableMarketConfigs,
 	pricesQueryClient pricetypes.QueryClient,
+	maxQueryAttempts uint32,
+	queryRetryDelay time.Duration,
 	logger log.Logger,
 ) {
 	// Delay reporting certain errors for a grace period to allow the daemon to start up. There is a bit of a race
@@ -243,7 +273,15 @@ func (s *SubTaskRunnerImpl) StartMarketParamUpdater(
 	for {
 		select {
 		case <-ticker.C:
-			RunMarketParamUpdaterTaskLoop(ctx, configs, pricesQueryClient, logger, isPastGracePeriod)
+			RunMarketParamUpdaterTaskLoop(
+				ctx,
+				configs,
+				pricesQueryClient,
+				maxQueryAttempts,
+				queryRetryDelay,
+				logger,
+				isPastGracePeriod,
+			)
 
 		case <-stop:
 			return
@@ -254,17 +292,27 @@ func (s *SubTaskRunnerImpl) StartMarketParamUpdater(
 // -------------------- Task Loops -------------------- //
 
 // RunPriceUpdaterTaskLoop copies the map of current `exchangeId -> MarketPriceTimestamp`,
-// transforms the map values into a market price update request and sends the request to the socket
-// where the pricefeed server is listening.
+// drops prices that were last updated more than `maxPriceAge` ago, transforms the map values into a
+// market price update request, drops markets with prices from fewer than `minExchangesPerMarket` exchanges
+// and sends the request to the socket where the pricefeed server is listening.
+// A `maxPriceAge` of 0 does not drop any prices, and a `minExchangesPerMarket` of 0 or 1 does not drop any markets.
 func RunPriceUpdaterTaskLoop(
 	ctx context.Context,
 	exchangeToMarketPrices types.ExchangeToMarketPrices,
 	priceFeedServiceClient api.PriceFeedServiceClient,
+	maxPriceAge time.Duration,
+	minExchangesPerMarket uint32,
 	logger log.Logger,
 ) error {
 	logger = logger.With(constants.SubmoduleLogKey, constants.PriceUpdaterSubmoduleName)
 	priceUpdates := exchangeToMarketPrices.GetAllPrices()
+	if maxPriceAge > 0 {
+		priceUpdates = removeStalePriceUpdates(priceUpdates, time.Now().Add(-maxPriceAge))
+	}
 	request := transformPriceUpdates(priceUpdates)
+	if minExchangesPerMarket > 1 {
+		request.MarketPriceUpdates = removeMarketsWithTooFewExchanges(request.MarketPriceUpdates, minExchangesPerMarket)
+	}
 
 	// Measure latency to send prices over gRPC.
 	// Note: intentionally skipping latency for `GetAllPrices`.
@@ -310,11 +358,15 @@ func RunPriceUpdaterTaskLoop(
 }
 
 // RunMarketParamUpdaterTaskLoop queries all market params from the query client, and then updates the
-// shared, in-memory `PricefeedMutableMarketConfigs` object with the latest market params.
+// shared, in-memory `PricefeedMutableMarketConfigs` object with the latest market params. The query is
+// attempted up to `maxQueryAttempts` times, waiting `queryRetryDelay` before the first retry and doubling
+// the delay after each failed attempt.
 func RunMarketParamUpdaterTaskLoop(
 	ctx context.Context,
 	configs types.PricefeedMutableMarketConfigs,
 	pricesQueryClient pricetypes.QueryClient,
+	maxQueryAttempts uint32,
+	queryRetryDelay time.Duration,
 	logger log.Logger,
 	isPastGracePeriod bool,
 ) {
@@ -329,7 +381,7 @@ func RunMarketParamUpdaterTaskLoop(
 	logger = logger.With(constants.SubmoduleLogKey, constants.MarketParamUpdaterSubmoduleName)
 
 	// Query all market params from the query client.
-	marketParams, err := daemonlib.AllPaginatedMarketParams(ctx, pricesQueryClient)
+	marketParams, err := queryAllMarketParamsWithRetry(ctx, pricesQueryClient, maxQueryAttempts, queryRetryDelay, logger)
 	if err != nil {
 		var logMethod = logger.Info
 		if isPastGracePeriod {
@@ -393,8 +445,110 @@ func RunMarketParamUpdaterTaskLoop(
 
 // -------------------- Task Loop Helpers -------------------- //
 
+// queryAllMarketParamsWithRetry queries all market params from the query client, retrying failed queries with
+// an exponential backoff until `maxQueryAttempts` attempts have been made or the context is done. A
+// `maxQueryAttempts` of 0 is treated as a single attempt. The error of the last attempt is returned.
+func queryAllMarketParamsWithRetry(
+	ctx context.Context,
+	pricesQueryClient pricetypes.QueryClient,
+	maxQueryAttempts uint32,
+	queryRetryDelay time.Duration,
+	logger log.Logger,
+) ([]pricetypes.MarketParam, error) {
+	delay := queryRetryDelay
+	for attempt := uint32(1); ; attempt++ {
+		marketParams, err := daemonlib.AllPaginatedMarketParams(ctx, pricesQueryClient)
+		if err == nil || attempt >= maxQueryAttempts {
+			return marketParams, err
+		}
+
+		logger.Info(
+			"Failed to get all market params, retrying",
+			"error",
+			err,
+			"attempt",
+			attempt,
+			"delay",
+			delay,
+		)
+		telemetry.IncrCounter(
+			1,
+			metrics.PricefeedDaemon,
+			metrics.MarketUpdaterGetAllMarketParams,
+			metrics.Retry,
+		)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, err
+		}
+		delay *= 2
+	}
+}
+
+// removeStalePriceUpdates returns the price updates in the map (key: exchangeId, value: list of market prices)
+// that were last updated at or after `cutoffTime`. Each dropped price is reported via telemetry.
+func removeStalePriceUpdates(
+	updates map[types.ExchangeId][]types.MarketPriceTimestamp,
+	cutoffTime time.Time,
+) map[types.ExchangeId][]types.MarketPriceTimestamp {
+	freshUpdates := make(map[types.ExchangeId][]types.MarketPriceTimestamp, len(updates))
+	for exchangeId, marketPriceTimestamps := range updates {
+		freshMarketPriceTimestamps := make([]types.MarketPriceTimestamp, 0, len(marketPriceTimestamps))
+		for _, marketPriceTimestamp := range marketPriceTimestamps {
+			if marketPriceTimestamp.LastUpdatedAt.Before(cutoffTime) {
+				telemetry.IncrCounterWithLabels(
+					[]string{
+						metrics.PricefeedDaemon,
+						metrics.PriceUpdaterStalePrice,
+						metrics.Count,
+					},
+					1,
+					[]gometrics.Label{
+						pricefeedmetrics.GetLabelForExchangeId(exchangeId),
+						pricefeedmetrics.GetLabelForMarketId(marketPriceTimestamp.MarketId),
+					},
+				)
+				continue
+			}
+			freshMarketPriceTimestamps = append(freshMarketPriceTimestamps, marketPriceTimestamp)
+		}
+		freshUpdates[exchangeId] = freshMarketPriceTimestamps
+	}
+	return freshUpdates
+}
+
+// removeMarketsWithTooFewExchanges returns the market price updates that have prices from at least
+// `minExchangesPerMarket` exchanges. Each dropped market is reported via telemetry.
+func removeMarketsWithTooFewExchanges(
+	marketPriceUpdates []*api.MarketPriceUpdate,
+	minExchangesPerMarket uint32,
+) []*api.MarketPriceUpdate {
+	qualifyingUpdates := make([]*api.MarketPriceUpdate, 0, len(marketPriceUpdates))
+	for _, marketPriceUpdate := range marketPriceUpdates {
+		if uint32(len(marketPriceUpdate.ExchangePrices)) < minExchangesPerMarket {
+			telemetry.IncrCounterWithLabels(
+				[]string{
+					metrics.PricefeedDaemon,
+					metrics.PriceUpdaterTooFewExchanges,
+					metrics.Count,
+				},
+				1,
+				[]gometrics.Label{
+					pricefeedmetrics.GetLabelForMarketId(marketPriceUpdate.MarketId),
+				},
+			)
+			continue
+		}
+		qualifyingUpdates = append(qualifyingUpdates, marketPriceUpdate)
+	}
+	return qualifyingUpdates
+}
+
 // transformPriceUpdates transforms a map (key: exchangeId, value: list of market prices) into a
-// market price update request.
+// market price update request. If an exchange reports more than one price for the same market, only
+// the most recently updated price is kept so that a single exchange is never double-counted.
 func transformPriceUpdates(
 	updates map[types.ExchangeId][]types.MarketPriceTimestamp,
 ) *api.UpdateMarketPricesRequest {
@@ -407,6 +561,8 @@ func transformPriceUpdates(
 	)
 
 	marketPriceUpdateMap := make(map[types.MarketId]*api.MarketPriceUpdate)
+	// Index of each exchange's price within `ExchangePrices`, keyed by market id and then exchange id.
+	exchangePriceIndexMap := make(map[types.MarketId]map[types.ExchangeId]int)
 
 	// Invert to marketId -> `api.MarketPriceUpdate`.
 	for exchangeId, marketPriceTimestamps := range updates {
@@ -432,15 +588,40 @@ func transformPriceUpdates(
 					ExchangePrices: []*api.ExchangePrice{},
 				}
 				marketPriceUpdateMap[marketPriceTimestamp.MarketId] = marketPriceUpdate
+				exchangePriceIndexMap[marketPriceTimestamp.MarketId] = make(map[types.ExchangeId]int)
 			}
 
-			// Add `api.ExchangePrice`.
 			priceUpdateTime := marketPriceTimestamp.LastUpdatedAt
 			exchangePrice := &api.ExchangePrice{
 				ExchangeId:     exchangeId,
 				Price:          marketPriceTimestamp.Price,
 				LastUpdateTime: &priceUpdateTime,
+				Weight:         marketPriceTimestamp.Weight,
+			}
+
+			// If this exchange already has a price for this market, keep only the fresher price.
+			exchangePriceIndices := exchangePriceIndexMap[marketPriceTimestamp.MarketId]
+			if i, exists := exchangePriceIndices[exchangeId]; exists {
+				telemetry.IncrCounterWithLabels(
+					[]string{
+						metrics.PricefeedDaemon,
+						metrics.PriceUpdaterDuplicateExchangePrice,
+						metrics.Count,
+					},
+					1,
+					[]gometrics.Label{
+						pricefeedmetrics.GetLabelForExchangeId(exchangeId),
+						pricefeedmetrics.GetLabelForMarketId(marketPriceTimestamp.MarketId),
+					},
+				)
+				if priceUpdateTime.After(*marketPriceUpdate.ExchangePrices[i].LastUpdateTime) {
+					marketPriceUpdate.ExchangePrices[i] = exchangePrice
+				}
+				continue
 			}
+
+			// Add `api.ExchangePrice`.
+			exchangePriceIndices[exchangeId] = len(marketPriceUpdate.ExchangePrices)
 			marketPriceUpdate.ExchangePrices = append(marketPriceUpdate.ExchangePrices, exchangePrice)
 		}
 	}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 )
 
 // ExchangeMarketConfigJson captures per-exchange information for resolving a market, including
@@ -12,6 +13,9 @@ type ExchangeMarketConfigJson struct {
 	Ticker         string `json:"ticker"`
 	AdjustByMarket string `json:"adjustByMarket,omitempty"`
 	Invert         bool   `json:"invert,omitempty"`
+	// Weight specifies how heavily the price of the market on this exchange counts in the index price of the
+	// market. An unset weight is treated as a weight of 1.
+	Weight *int64 `json:"weight,omitempty"`
 }
 
 // Validate validates the exchange market configuration json. It returns an error if the
@@ -41,5 +45,8 @@ func (emcj *ExchangeMarketConfigJson) Validate(
 			return fmt.Errorf("adjustment market '%v' is not valid", emcj.AdjustByMarket)
 		}
 	}
+	if emcj.Weight != nil && (*emcj.Weight <= 0 || *emcj.Weight > math.MaxUint32) {
+		return fmt.Errorf("weight %v must be positive and at most %v", *emcj.Weight, uint32(math.MaxUint32))
+	}
 	return nil
 }
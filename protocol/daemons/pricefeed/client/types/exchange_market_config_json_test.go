@@ -4,10 +4,16 @@ import (
 	"fmt"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	"github.com/stretchr/testify/require"
+	"math"
 	"testing"
 )
 
 func TestExchangeMarketConfigJsonValidate_Mixed(t *testing.T) {
+	validWeight := int64(3)
+	zeroWeight := int64(0)
+	negativeWeight := int64(-1)
+	overflowingWeight := int64(math.MaxUint32) + 1
+
 	tests := map[string]struct {
 		exchangeMarketConfigJson types.ExchangeMarketConfigJson
 		expectedErr              error
@@ -25,6 +31,13 @@ func TestExchangeMarketConfigJsonValidate_Mixed(t *testing.T) {
 				AdjustByMarket: "ABC-USD",
 			},
 		},
+		"Valid with weight": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "binance",
+				Ticker:       "BTC-USDT",
+				Weight:       &validWeight,
+			},
+		},
 		"Invalid - no exchange name": {
 			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
 				Ticker: "BTC-USDT",
@@ -52,6 +65,30 @@ func TestExchangeMarketConfigJsonValidate_Mixed(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("adjustment market 'XYZ-USD' is not valid"),
 		},
+		"Invalid - zero weight": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "binance",
+				Ticker:       "BTC-USDT",
+				Weight:       &zeroWeight,
+			},
+			expectedErr: fmt.Errorf("weight 0 must be positive and at most 4294967295"),
+		},
+		"Invalid - negative weight": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "binance",
+				Ticker:       "BTC-USDT",
+				Weight:       &negativeWeight,
+			},
+			expectedErr: fmt.Errorf("weight -1 must be positive and at most 4294967295"),
+		},
+		"Invalid - weight overflows uint32": {
+			exchangeMarketConfigJson: types.ExchangeMarketConfigJson{
+				ExchangeName: "binance",
+				Ticker:       "BTC-USDT",
+				Weight:       &overflowingWeight,
+			},
+			expectedErr: fmt.Errorf("weight 4294967296 must be positive and at most 4294967295"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -11,6 +11,12 @@ type ExchangeQueryDetails struct {
 	Exchange ExchangeId
 	// Url is the url to query the exchange.
 	Url string
+	// Method is the HTTP method used to query the exchange. Only GET and POST are supported. Defaults to GET
+	// if empty.
+	Method string
+	// BodyTemplate is the JSON body sent when querying the exchange with POST. Each "$" in the template is
+	// replaced with a JSON array of the queried tickers, e.g. `{"symbols":$}`.
+	BodyTemplate string
 	// PriceFunction computes a map of tickers to prices from an exchange's response
 	PriceFunction func(
 		response *http.Response,
@@ -19,10 +19,11 @@ type ExchangeToMarketPrices interface {
 		marketPriceTimestamp *MarketPriceTimestamp,
 	)
 	GetAllPrices() map[ExchangeId][]MarketPriceTimestamp
+	GetPricesForMarket(marketId MarketId) []MarketPriceTimestamp
 	GetIndexPrice(
 		marketId MarketId,
 		cutoffTime time.Time,
-		resolver types.Resolver,
+		medianizer types.WeightedMedianizer,
 	) (
 		medianPrice uint64,
 		numPricesMedianized int,
@@ -107,28 +108,46 @@ func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetAllPrices() map[Exc
 	return exchangeIdToPrices
 }
 
-// GetIndexPrice returns the index price for a given marketId, disallowing prices that are older than cutoffTime.
-// If no valid prices are found, an error is returned.
+// GetPricesForMarket returns the latest `MarketPriceTimestamp` of every exchange that has a price for the market.
+// The order of the returned prices is not deterministic.
+func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetPricesForMarket(
+	marketId MarketId,
+) []MarketPriceTimestamp {
+	marketPrices := make([]MarketPriceTimestamp, 0, len(exchangeToMarketPrices.ExchangeMarketPrices))
+	for _, mtp := range exchangeToMarketPrices.ExchangeMarketPrices {
+		if marketPrice, ok := mtp.GetPriceForMarket(marketId); ok {
+			marketPrices = append(marketPrices, marketPrice)
+		}
+	}
+
+	return marketPrices
+}
+
+// GetIndexPrice returns the index price for a given marketId across all exchanges, resolved by `medianizer`
+// from the price of each exchange weighted by the exchange's weight for the market. Prices that are older than
+// cutoffTime are disallowed. If no valid prices are found, 0 prices are medianized.
 func (exchangeToMarketPrices *ExchangeToMarketPricesImpl) GetIndexPrice(
 	marketId MarketId,
 	cutoffTime time.Time,
-	resolver types.Resolver,
+	medianizer types.WeightedMedianizer,
 ) (
 	medianPrice uint64,
 	numPricesMedianized int,
 ) {
 	prices := make([]uint64, 0, len(exchangeToMarketPrices.ExchangeMarketPrices))
+	weights := make([]uint32, 0, len(exchangeToMarketPrices.ExchangeMarketPrices))
 	for _, mtp := range exchangeToMarketPrices.ExchangeMarketPrices {
-		price, ok := mtp.GetValidPriceForMarket(marketId, cutoffTime)
+		price, weight, ok := mtp.GetValidWeightedPriceForMarket(marketId, cutoffTime)
 		if ok {
 			prices = append(prices, price)
+			weights = append(weights, weight)
 		}
 	}
 
 	if len(prices) == 0 {
 		return 0, 0
 	}
-	median, err := resolver(prices)
+	median, err := medianizer(prices, weights)
 
 	if err != nil {
 		return 0, 0
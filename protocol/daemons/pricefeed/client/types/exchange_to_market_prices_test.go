@@ -240,6 +240,48 @@ func TestNewExchangeToMarketPrices_UpdateIsInvalidForInvalidExchange(t *testing.
 	)
 }
 
+func TestGetPricesForMarket(t *testing.T) {
+	exchangeToMarketPrices := getNewExchangeToMarketPricesAndCheckForError(
+		t,
+		[]types.ExchangeId{constants.ExchangeId1, constants.ExchangeId2, constants.ExchangeId3},
+		nil,
+	)
+	for _, exchangeAndMarketPrice := range []*client.ExchangeIdMarketPriceTimestamp{
+		constants.ExchangeId1_Market9_TimeT_Price1,
+		constants.ExchangeId2_Market9_TimeT_Price2,
+		constants.ExchangeId3_Market9_TimeT_Price3,
+		constants.ExchangeId1_Market8_BeforeTimeT_Price3,
+		constants.ExchangeId2_Market8_TimeT_Price2,
+	} {
+		updatePriceAndCheckForPanic(
+			t,
+			exchangeToMarketPrices,
+			exchangeAndMarketPrice.ExchangeId,
+			exchangeAndMarketPrice.MarketPriceTimestamp,
+			false,
+		)
+	}
+
+	require.ElementsMatch(
+		t,
+		[]types.MarketPriceTimestamp{
+			{MarketId: constants.MarketId9, Price: constants.Price1, LastUpdatedAt: constants.TimeT},
+			{MarketId: constants.MarketId9, Price: constants.Price2, LastUpdatedAt: constants.TimeT},
+			{MarketId: constants.MarketId9, Price: constants.Price3, LastUpdatedAt: constants.TimeT},
+		},
+		exchangeToMarketPrices.GetPricesForMarket(constants.MarketId9),
+	)
+	require.ElementsMatch(
+		t,
+		[]types.MarketPriceTimestamp{
+			{MarketId: constants.MarketId8, Price: constants.Price3, LastUpdatedAt: constants.TimeTMinusThreshold},
+			{MarketId: constants.MarketId8, Price: constants.Price2, LastUpdatedAt: constants.TimeT},
+		},
+		exchangeToMarketPrices.GetPricesForMarket(constants.MarketId8),
+	)
+	require.Empty(t, exchangeToMarketPrices.GetPricesForMarket(constants.MarketId7))
+}
+
 func TestGetIndexPrice_Mixed(t *testing.T) {
 	tests := map[string]struct {
 		initialPrices []*client.ExchangeIdMarketPriceTimestamp
@@ -312,8 +354,77 @@ func TestGetIndexPrice_Mixed(t *testing.T) {
 			}
 
 			// Execute.
-			resolver := lib.Median[uint64]
-			medianPrice, numPricesMedianized := etmp.GetIndexPrice(tc.market, tc.cutoffTime, resolver)
+			medianPrice, numPricesMedianized := etmp.GetIndexPrice(tc.market, tc.cutoffTime, lib.WeightedMedian[uint64])
+
+			// Assert.
+			require.Equal(t, tc.expectedMedianPrice, medianPrice)
+			require.Equal(t, tc.expectedNumPricesMedianized, numPricesMedianized)
+		})
+	}
+}
+
+func TestGetIndexPrice_Weighted(t *testing.T) {
+	tests := map[string]struct {
+		weights    []uint32
+		cutoffTime time.Time
+
+		expectedMedianPrice         uint64
+		expectedNumPricesMedianized int
+	}{
+		"no prices after cutoff": {
+			weights:                     []uint32{1, 1, 1},
+			cutoffTime:                  constants.TimeTPlusThreshold,
+			expectedMedianPrice:         0,
+			expectedNumPricesMedianized: 0,
+		},
+		"equal weights match the unweighted median": {
+			weights:                     []uint32{1, 1, 1},
+			cutoffTime:                  constants.TimeTMinus1,
+			expectedMedianPrice:         200,
+			expectedNumPricesMedianized: 3,
+		},
+		"unset weights default to one": {
+			weights:                     []uint32{0, 0, 0},
+			cutoffTime:                  constants.TimeTMinus1,
+			expectedMedianPrice:         200,
+			expectedNumPricesMedianized: 3,
+		},
+		"heavily weighted exchange moves the median": {
+			weights:                     []uint32{1, 1, 3},
+			cutoffTime:                  constants.TimeTMinus1,
+			expectedMedianPrice:         300,
+			expectedNumPricesMedianized: 3,
+		},
+		"median between two halves of the total weight": {
+			weights:                     []uint32{2, 1, 1},
+			cutoffTime:                  constants.TimeTMinus1,
+			expectedMedianPrice:         150,
+			expectedNumPricesMedianized: 3,
+		},
+	}
+
+	testExchanges := []types.ExchangeId{constants.ExchangeId1, constants.ExchangeId2, constants.ExchangeId3}
+	prices := []uint64{100, 200, 300}
+
+	for testName, tc := range tests {
+		t.Run(testName, func(t *testing.T) {
+			// Setup.
+			etmp := getNewExchangeToMarketPricesAndCheckForError(t, testExchanges, nil)
+			for i, exchange := range testExchanges {
+				etmp.UpdatePrice(exchange, &types.MarketPriceTimestamp{
+					MarketId:      constants.MarketId9,
+					Price:         prices[i],
+					LastUpdatedAt: constants.TimeT,
+					Weight:        tc.weights[i],
+				})
+			}
+
+			// Execute.
+			medianPrice, numPricesMedianized := etmp.GetIndexPrice(
+				constants.MarketId9,
+				tc.cutoffTime,
+				lib.WeightedMedian[uint64],
+			)
 
 			// Assert.
 			require.Equal(t, tc.expectedMedianPrice, medianPrice)
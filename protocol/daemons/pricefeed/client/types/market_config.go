@@ -39,12 +39,18 @@ type MarketConfig struct {
 	//
 	// BTC-USD = 1 / USD-BTC
 	Invert bool
+
+	// Weight specifies how heavily the price of the market on this exchange counts when the prices of the
+	// market on all exchanges are combined into an index price. A weight of 0 is treated as the default
+	// weight of 1.
+	Weight uint32
 }
 
 // Equal returns true if the two MarketConfigs are equal.
 func (mc *MarketConfig) Equal(other MarketConfig) bool {
 	return mc.Ticker == other.Ticker &&
 		mc.Invert == other.Invert &&
+		mc.Weight == other.Weight &&
 		((mc.AdjustByMarket == nil && other.AdjustByMarket == nil) ||
 			(mc.AdjustByMarket != nil && other.AdjustByMarket != nil &&
 				*mc.AdjustByMarket == *other.AdjustByMarket))
@@ -61,5 +67,14 @@ func (mc *MarketConfig) Copy() MarketConfig {
 		Ticker:         mc.Ticker,
 		AdjustByMarket: adjustByMarket,
 		Invert:         mc.Invert,
+		Weight:         mc.Weight,
+	}
+}
+
+// GetWeight returns the weight of the market on this exchange, treating an unset weight as a weight of 1.
+func (mc *MarketConfig) GetWeight() uint32 {
+	if mc.Weight == 0 {
+		return 1
 	}
+	return mc.Weight
 }
@@ -2,9 +2,11 @@ package types
 
 import "time"
 
-// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`.
+// MarketPriceTimestamp maintains a `MarketId`, `Price` and `LastUpdatedAt`, along with the `Weight` of the
+// exchange the price was queried from when computing the index price of the market.
 type MarketPriceTimestamp struct {
 	MarketId      uint32
 	Price         uint64
 	LastUpdatedAt time.Time
+	Weight        uint32
 }
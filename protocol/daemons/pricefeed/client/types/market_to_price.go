@@ -10,17 +10,19 @@ import (
 )
 
 // MarketToPrice maintains multiple prices for different markets for the same exchange,
-// along with the last time that each market price was updated.
+// along with the last time that each market price was updated and the weight of the exchange for each market.
 // Methods are goroutine safe.
 type MarketToPrice struct {
 	sync.Mutex                                              // lock
 	MarketToPriceTimestamp map[uint32]*types.PriceTimestamp // {k: market id, v: PriceTimestamp}
+	MarketToWeight         map[uint32]uint32                // {k: market id, v: weight of the latest price}
 }
 
 // NewMarketToPrice creates a new MarketToPrice.
 func NewMarketToPrice() *MarketToPrice {
 	return &MarketToPrice{
 		MarketToPriceTimestamp: make(map[uint32]*types.PriceTimestamp),
+		MarketToWeight:         make(map[uint32]uint32),
 	}
 }
 
@@ -42,7 +44,9 @@ func (mtp *MarketToPrice) UpdatePrice(
 	isUpdated := priceTimestamp.UpdatePrice(marketPriceTimestamp.Price, &marketPriceTimestamp.LastUpdatedAt)
 
 	validity := metrics.Valid
-	if !isUpdated {
+	if isUpdated {
+		mtp.MarketToWeight[marketId] = marketPriceTimestamp.Weight
+	} else {
 		validity = metrics.PriceIsInvalid
 	}
 
@@ -61,6 +65,7 @@ func (mtp *MarketToPrice) GetAllPrices() []MarketPriceTimestamp {
 			MarketId:      marketId,
 			LastUpdatedAt: priceTimestamp.LastUpdateTime,
 			Price:         priceTimestamp.Price,
+			Weight:        mtp.MarketToWeight[marketId],
 		}
 		marketPricesForExchange = append(marketPricesForExchange, mpt)
 	}
@@ -68,6 +73,23 @@ func (mtp *MarketToPrice) GetAllPrices() []MarketPriceTimestamp {
 	return marketPricesForExchange
 }
 
+// GetPriceForMarket returns the latest `MarketPriceTimestamp` for a market for an exchange, if one exists.
+func (mtp *MarketToPrice) GetPriceForMarket(marketId MarketId) (MarketPriceTimestamp, bool) {
+	mtp.Lock()
+	defer mtp.Unlock()
+	priceTimestamp, exists := mtp.MarketToPriceTimestamp[marketId]
+	if !exists {
+		return MarketPriceTimestamp{}, false
+	}
+
+	return MarketPriceTimestamp{
+		MarketId:      marketId,
+		LastUpdatedAt: priceTimestamp.LastUpdateTime,
+		Price:         priceTimestamp.Price,
+		Weight:        mtp.MarketToWeight[marketId],
+	}, true
+}
+
 // GetValidPriceForMarket returns the most recent valid price for a market for an exchange.
 func (mtp *MarketToPrice) GetValidPriceForMarket(marketId MarketId, cutoffTime time.Time) (uint64, bool) {
 	mtp.Lock()
@@ -79,3 +101,27 @@ func (mtp *MarketToPrice) GetValidPriceForMarket(marketId MarketId, cutoffTime t
 
 	return price.GetValidPrice(cutoffTime)
 }
+
+// GetValidWeightedPriceForMarket returns the most recent valid price for a market for an exchange, along with
+// the weight of the exchange for the market. An unset weight is returned as a weight of 1.
+func (mtp *MarketToPrice) GetValidWeightedPriceForMarket(
+	marketId MarketId,
+	cutoffTime time.Time,
+) (price uint64, weight uint32, ok bool) {
+	mtp.Lock()
+	defer mtp.Unlock()
+	priceTimestamp, exists := mtp.MarketToPriceTimestamp[marketId]
+	if !exists {
+		return 0, 0, false
+	}
+
+	price, ok = priceTimestamp.GetValidPrice(cutoffTime)
+	if !ok {
+		return 0, 0, false
+	}
+	weight = mtp.MarketToWeight[marketId]
+	if weight == 0 {
+		weight = 1
+	}
+	return price, weight, true
+}
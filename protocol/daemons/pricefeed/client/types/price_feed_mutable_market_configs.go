@@ -273,6 +273,11 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 				Invert: exchangeConfig.Invert,
 			}
 
+			// Populate the weight only if it is specified in the config.
+			if exchangeConfig.Weight != nil {
+				marketConfig.Weight = uint32(*exchangeConfig.Weight)
+			}
+
 			// Populate the adjustByMarket only if it is specified in the config.
 			if exchangeConfig.AdjustByMarket != "" {
 				adjustByMarketId, ok := marketNameToId[exchangeConfig.AdjustByMarket]
@@ -296,7 +301,7 @@ func (pfmmc *PricefeedMutableMarketConfigsImpl) ValidateAndTransformParams(marke
 			Id:           marketParam.Id,
 			Pair:         marketParam.Pair,
 			Exponent:     marketParam.Exponent,
-			MinExchanges: marketParam.MinExchanges,
+			MinExchanges: marketParam.GetMinExchangesQuorum(uint32(len(exchangeConfigJson.Exchanges))),
 		}
 	}
 	return mutableExchangeConfigs, mutableMarketConfigs, marketParamErrors, nil
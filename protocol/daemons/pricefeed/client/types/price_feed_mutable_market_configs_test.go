@@ -23,6 +23,8 @@ const (
 	exchangeConfigBinanceBtc             = `{"exchangeName":"Binance","ticker":"BTCUSDT"}`
 	exchangeConfigCoinbaseEth            = `{"exchangeName":"Coinbase","ticker":"ETH-USD"}`
 	exchangeConfigBinanceEth             = `{"exchangeName":"Binance","ticker":"ETHUSDT"}`
+	exchangeConfigBinanceBtcWeighted     = `{"exchangeName":"Binance","ticker":"BTCUSDT","weight":3}`
+	exchangeConfigZeroWeight             = `{"exchangeName":"Binance","ticker":"BTCUSDT","weight":0}`
 
 	exchangeIdCoinbase = "Coinbase"
 	exchangeIdBinance  = "Binance"
@@ -296,6 +298,46 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 			expectedMutableMarketConfigs:   testEmptyMarketConfigs,
 			expectedMutableExchangeConfigs: testEmptyExchangeMarketConfigs,
 		},
+		"Invalid: invalid exchangeConfigJson (weight zero)": {
+			marketParams: []prices_types.MarketParam{
+				validMarketParamWithExchangeConfig(fmt.Sprintf(`{"exchanges":[%s]}`, exchangeConfigZeroWeight)),
+			},
+			expectedMarketParamErrors: map[types.MarketId]error{
+				1: errors.New(
+					"invalid exchange config json for market param 1: invalid exchange: weight 0 must be positive " +
+						"and at most 4294967295"),
+			},
+			expectedMutableMarketConfigs:   testEmptyMarketConfigs,
+			expectedMutableExchangeConfigs: testEmptyExchangeMarketConfigs,
+		},
+		"Valid: weighted exchange": {
+			marketParams: []prices_types.MarketParam{
+				validMarketParamWithExchangeConfig(fmt.Sprintf(`{"exchanges":[%s]}`, exchangeConfigBinanceBtcWeighted)),
+			},
+			expectedMutableMarketConfigs: map[types.MarketId]*types.MutableMarketConfig{
+				1: {
+					Id:           1,
+					Exponent:     -2,
+					Pair:         "BTC-USD",
+					MinExchanges: 1,
+				},
+			},
+			expectedMutableExchangeConfigs: map[types.ExchangeId]*types.MutableExchangeMarketConfig{
+				exchangeIdCoinbase: {
+					Id:                   exchangeIdCoinbase,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{},
+				},
+				exchangeIdBinance: {
+					Id: exchangeIdBinance,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+						1: {
+							Ticker: "BTCUSDT",
+							Weight: 3,
+						},
+					},
+				},
+			},
+		},
 		"Invalid: invalid params (duplicate ids)": {
 			marketParams: []prices_types.MarketParam{
 				{
@@ -410,6 +452,50 @@ func TestValidateAndTransformParams_Mixed(t *testing.T) {
 				},
 			},
 		},
+		"Valid: fractional quorum of configured exchanges": {
+			marketParams: []prices_types.MarketParam{
+				{
+					Id:                1,
+					Pair:              "ETH-USD",
+					Exponent:          -3,
+					MinExchanges:      1,
+					MinExchangesPpm:   600_000,
+					MinPriceChangePpm: 1,
+					ExchangeConfigJson: fmt.Sprintf(
+						`{"exchanges":[%s,%s]}`,
+						exchangeConfigCoinbaseEth,
+						exchangeConfigBinanceEth,
+					),
+				},
+			},
+			expectedMutableMarketConfigs: map[types.MarketId]*types.MutableMarketConfig{
+				1: {
+					Id:       1,
+					Exponent: -3,
+					Pair:     "ETH-USD",
+					// 60% of 2 configured exchanges, rounded up.
+					MinExchanges: 2,
+				},
+			},
+			expectedMutableExchangeConfigs: map[types.ExchangeId]*types.MutableExchangeMarketConfig{
+				exchangeIdCoinbase: {
+					Id: exchangeIdCoinbase,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+						1: {
+							Ticker: "ETH-USD",
+						},
+					},
+				},
+				exchangeIdBinance: {
+					Id: exchangeIdBinance,
+					MarketToMarketConfig: map[types.MarketId]types.MarketConfig{
+						1: {
+							Ticker: "ETHUSDT",
+						},
+					},
+				},
+			},
+		},
 		"Valid: 2 markets, 2 exchanges, with adjust-by markets": {
 			marketParams: []prices_types.MarketParam{
 				{
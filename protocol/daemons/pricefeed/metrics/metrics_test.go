@@ -111,6 +111,8 @@ func TestGetLabelForMarketIdAutoPopulated(t *testing.T) {
 				grpc_util.Ctx,
 				configs,
 				pricesQueryClient,
+				1,
+				0,
 				log.NewNopLogger(),
 				true,
 			)
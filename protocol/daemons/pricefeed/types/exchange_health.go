@@ -0,0 +1,77 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// MaxRecentFetchDurations is the maximum number of recent fetch durations kept per exchange.
+	MaxRecentFetchDurations = 10
+)
+
+// ExchangeHealth is a snapshot of the recent price fetches for an exchange.
+type ExchangeHealth struct {
+	// RecentFetchDurations are the durations of the most recent fetches, ordered from oldest to newest.
+	RecentFetchDurations []time.Duration
+	// LastSuccessTime is the time the last successful fetch was recorded.
+	LastSuccessTime time.Time
+	// LastError is the error of the last failed fetch, if any.
+	LastError error
+	// LastErrorTime is the time the last failed fetch was recorded.
+	LastErrorTime time.Time
+}
+
+// ExchangeHealthTracker records the outcome of price fetches for each exchange. Methods are goroutine safe.
+type ExchangeHealthTracker struct {
+	sync.Mutex                                  // lock
+	exchangeToHealth map[string]*ExchangeHealth // {k: exchange id, v: health of the exchange}
+}
+
+// NewExchangeHealthTracker creates a new ExchangeHealthTracker.
+func NewExchangeHealthTracker() *ExchangeHealthTracker {
+	return &ExchangeHealthTracker{
+		exchangeToHealth: make(map[string]*ExchangeHealth),
+	}
+}
+
+// RecordFetch records the duration and error of a price fetch for an exchange. Only the most recent
+// `MaxRecentFetchDurations` durations are kept.
+func (t *ExchangeHealthTracker) RecordFetch(exchangeId string, fetchDuration time.Duration, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	health, exists := t.exchangeToHealth[exchangeId]
+	if !exists {
+		health = &ExchangeHealth{}
+		t.exchangeToHealth[exchangeId] = health
+	}
+
+	health.RecentFetchDurations = append(health.RecentFetchDurations, fetchDuration)
+	if len(health.RecentFetchDurations) > MaxRecentFetchDurations {
+		health.RecentFetchDurations = health.RecentFetchDurations[len(health.RecentFetchDurations)-MaxRecentFetchDurations:]
+	}
+
+	now := time.Now()
+	if err != nil {
+		health.LastError = err
+		health.LastErrorTime = now
+	} else {
+		health.LastSuccessTime = now
+	}
+}
+
+// GetExchangeHealth returns a copy of the health of every exchange with at least one recorded fetch.
+func (t *ExchangeHealthTracker) GetExchangeHealth() map[string]ExchangeHealth {
+	t.Lock()
+	defer t.Unlock()
+
+	snapshot := make(map[string]ExchangeHealth, len(t.exchangeToHealth))
+	for exchangeId, health := range t.exchangeToHealth {
+		healthCopy := *health
+		healthCopy.RecentFetchDurations = make([]time.Duration, len(health.RecentFetchDurations))
+		copy(healthCopy.RecentFetchDurations, health.RecentFetchDurations)
+		snapshot[exchangeId] = healthCopy
+	}
+	return snapshot
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeHealthTracker_RecordFetch(t *testing.T) {
+	tracker := types.NewExchangeHealthTracker()
+	require.Empty(t, tracker.GetExchangeHealth())
+
+	fetchErr := errors.New("fetch failed")
+	tracker.RecordFetch(constants.ExchangeId1, time.Second, nil)
+	tracker.RecordFetch(constants.ExchangeId1, 2*time.Second, fetchErr)
+	tracker.RecordFetch(constants.ExchangeId1, 3*time.Second, nil)
+	tracker.RecordFetch(constants.ExchangeId2, 4*time.Second, nil)
+
+	snapshot := tracker.GetExchangeHealth()
+	require.Len(t, snapshot, 2)
+
+	exchange1Health := snapshot[constants.ExchangeId1]
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, exchange1Health.RecentFetchDurations)
+	// The last error is kept after a later successful fetch.
+	require.Equal(t, fetchErr, exchange1Health.LastError)
+	require.False(t, exchange1Health.LastErrorTime.IsZero())
+	require.False(t, exchange1Health.LastSuccessTime.Before(exchange1Health.LastErrorTime))
+
+	exchange2Health := snapshot[constants.ExchangeId2]
+	require.Equal(t, []time.Duration{4 * time.Second}, exchange2Health.RecentFetchDurations)
+	require.NoError(t, exchange2Health.LastError)
+	require.True(t, exchange2Health.LastErrorTime.IsZero())
+}
+
+func TestExchangeHealthTracker_KeepsMostRecentFetchDurations(t *testing.T) {
+	tracker := types.NewExchangeHealthTracker()
+	for i := 1; i <= types.MaxRecentFetchDurations+2; i++ {
+		tracker.RecordFetch(constants.ExchangeId1, time.Duration(i), nil)
+	}
+
+	durations := tracker.GetExchangeHealth()[constants.ExchangeId1].RecentFetchDurations
+	require.Len(t, durations, types.MaxRecentFetchDurations)
+	require.Equal(t, time.Duration(3), durations[0])
+	require.Equal(t, time.Duration(types.MaxRecentFetchDurations+2), durations[len(durations)-1])
+}
+
+func TestExchangeHealthTracker_SnapshotIsCopy(t *testing.T) {
+	tracker := types.NewExchangeHealthTracker()
+	tracker.RecordFetch(constants.ExchangeId1, time.Second, nil)
+
+	snapshot := tracker.GetExchangeHealth()
+	snapshot[constants.ExchangeId1].RecentFetchDurations[0] = time.Hour
+
+	require.Equal(
+		t,
+		[]time.Duration{time.Second},
+		tracker.GetExchangeHealth()[constants.ExchangeId1].RecentFetchDurations,
+	)
+}
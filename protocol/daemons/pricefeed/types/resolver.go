@@ -3,3 +3,8 @@ package types
 // Resolver is a function type that "resolves" a slice of values to a single value.
 // The function also returns an error if there was an error in resolving the value.
 type Resolver func([]uint64) (uint64, error)
+
+// WeightedMedianizer is a function type that resolves a slice of values to their weighted median, where each
+// value counts as many times as the weight at the same index. The function also returns an error if there was
+// an error in resolving the value.
+type WeightedMedianizer func(values []uint64, weights []uint32) (uint64, error)
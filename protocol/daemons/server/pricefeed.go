@@ -4,21 +4,31 @@ import (
 	"context"
 	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/server/types"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
+	pricefeedhealthtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	pricefeedtypes "github.com/dydxprotocol/v4-chain/protocol/daemons/server/types/pricefeed"
 	daemontypes "github.com/dydxprotocol/v4-chain/protocol/daemons/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 	gometrics "github.com/hashicorp/go-metrics"
 )
 
+// PriceFeedExchangeHealthProvider provides a snapshot of the health of each exchange queried by the
+// price feed daemon.
+type PriceFeedExchangeHealthProvider interface {
+	GetExchangeHealth() map[string]pricefeedhealthtypes.ExchangeHealth
+}
+
 // PriceFeedServer defines the fields required for price updates.
 type PriceFeedServer struct {
-	marketToExchange *pricefeedtypes.MarketToExchangePrices
+	marketToExchange       *pricefeedtypes.MarketToExchangePrices
+	exchangeHealthProvider PriceFeedExchangeHealthProvider
 }
 
 // WithPriceFeedMarketToExchangePrices sets the `MarketToExchangePrices` field.
@@ -31,6 +41,51 @@ func (server *Server) WithPriceFeedMarketToExchangePrices(
 	return server
 }
 
+// WithPriceFeedExchangeHealthProvider sets the `PriceFeedExchangeHealthProvider` field.
+// This is used to expose the health of the exchanges queried by the price feed daemon.
+func (server *Server) WithPriceFeedExchangeHealthProvider(
+	exchangeHealthProvider PriceFeedExchangeHealthProvider,
+) *Server {
+	server.exchangeHealthProvider = exchangeHealthProvider
+	return server
+}
+
+// ExchangeHealth returns the recent fetch durations and last error of each exchange queried by the price
+// feed daemon, sorted by exchange id. An empty response is returned if no provider has been set.
+func (s *Server) ExchangeHealth(
+	ctx context.Context,
+	req *api.ExchangeHealthRequest,
+) (
+	response *api.ExchangeHealthResponse,
+	err error,
+) {
+	response = &api.ExchangeHealthResponse{}
+	if s.exchangeHealthProvider == nil {
+		return response, nil
+	}
+
+	exchangeToHealth := s.exchangeHealthProvider.GetExchangeHealth()
+	response.ExchangeHealth = make([]api.ExchangeHealth, 0, len(exchangeToHealth))
+	for _, exchangeId := range lib.GetSortedKeys[sort.StringSlice](exchangeToHealth) {
+		health := exchangeToHealth[exchangeId]
+		exchangeHealth := api.ExchangeHealth{
+			ExchangeId:           exchangeId,
+			RecentFetchDurations: health.RecentFetchDurations,
+		}
+		if !health.LastSuccessTime.IsZero() {
+			lastSuccessTime := health.LastSuccessTime
+			exchangeHealth.LastSuccessTime = &lastSuccessTime
+		}
+		if health.LastError != nil {
+			lastErrorTime := health.LastErrorTime
+			exchangeHealth.LastError = health.LastError.Error()
+			exchangeHealth.LastErrorTime = &lastErrorTime
+		}
+		response.ExchangeHealth = append(response.ExchangeHealth, exchangeHealth)
+	}
+	return response, nil
+}
+
 // UpdateMarketPrices updates prices from exchanges for each market provided.
 func (s *Server) UpdateMarketPrices(
 	ctx context.Context,
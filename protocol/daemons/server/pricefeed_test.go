@@ -7,6 +7,7 @@ import (
 	"fmt"
 	pricefeed_types "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/types"
 	"testing"
+	"time"
 
 	pricefeedconstants "github.com/dydxprotocol/v4-chain/protocol/daemons/constants"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/api"
@@ -66,6 +67,45 @@ func TestUpdateMarketPrices_NotInitialized(t *testing.T) {
 	)
 }
 
+func TestExchangeHealth(t *testing.T) {
+	mockGrpcServer := &mocks.GrpcServer{}
+	mockFileHandler := &mocks.FileHandler{}
+
+	s := createServerWithMocks(
+		t,
+		mockGrpcServer,
+		mockFileHandler,
+	)
+	response, err := s.ExchangeHealth(context.Background(), &api.ExchangeHealthRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.ExchangeHealth)
+
+	fetchErr := errors.New("fetch failed")
+	tracker := pricefeed_types.NewExchangeHealthTracker()
+	tracker.RecordFetch(constants.ExchangeId2, 2*time.Second, fetchErr)
+	tracker.RecordFetch(constants.ExchangeId1, time.Second, nil)
+	s.WithPriceFeedExchangeHealthProvider(tracker)
+
+	response, err = s.ExchangeHealth(context.Background(), &api.ExchangeHealthRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.ExchangeHealth, 2)
+
+	// Exchanges are sorted by exchange id.
+	health1 := response.ExchangeHealth[0]
+	require.Equal(t, constants.ExchangeId1, health1.ExchangeId)
+	require.Equal(t, []time.Duration{time.Second}, health1.RecentFetchDurations)
+	require.NotNil(t, health1.LastSuccessTime)
+	require.Empty(t, health1.LastError)
+	require.Nil(t, health1.LastErrorTime)
+
+	health2 := response.ExchangeHealth[1]
+	require.Equal(t, constants.ExchangeId2, health2.ExchangeId)
+	require.Equal(t, []time.Duration{2 * time.Second}, health2.RecentFetchDurations)
+	require.Nil(t, health2.LastSuccessTime)
+	require.Equal(t, fetchErr.Error(), health2.LastError)
+	require.NotNil(t, health2.LastErrorTime)
+}
+
 func TestUpdateMarketPrices_InvalidEmptyRequest(t *testing.T) {
 	mockGrpcServer := &mocks.GrpcServer{}
 	mockFileHandler := &mocks.FileHandler{}
@@ -13,10 +13,12 @@ import (
 )
 
 // ExchangeToPrice maintains multiple prices from different exchanges for
-// the same market, along with the last time the each exchange price was updated.
+// the same market, along with the last time the each exchange price was updated
+// and the weight of each exchange price in the median price of the market.
 type ExchangeToPrice struct {
 	marketId                 uint32
 	exchangeToPriceTimestamp map[string]*types.PriceTimestamp
+	exchangeToWeight         map[string]uint32
 }
 
 // NewExchangeToPrice creates a new ExchangeToPrice. It takes a market ID, which is used in logging and metrics to
@@ -26,12 +28,14 @@ func NewExchangeToPrice(marketId uint32) *ExchangeToPrice {
 	return &ExchangeToPrice{
 		marketId:                 marketId,
 		exchangeToPriceTimestamp: make(map[string]*types.PriceTimestamp),
+		exchangeToWeight:         make(map[string]uint32),
 	}
 }
 
 // UpdatePrices updates prices given a list of prices from different exchanges.
 // Prices are only updated if the timestamp on the updates are greater than
-// the timestamp on existing prices.
+// the timestamp on existing prices. The weight of an exchange is updated along with
+// its price, where a weight of 0 is treated as a weight of 1.
 func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 	for _, exchangePrice := range updates {
 		exchangeId := exchangePrice.ExchangeId
@@ -42,6 +46,13 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 		}
 
 		isUpdated := priceTimestamp.UpdatePrice(exchangePrice.Price, exchangePrice.LastUpdateTime)
+		if isUpdated {
+			weight := exchangePrice.Weight
+			if weight == 0 {
+				weight = 1
+			}
+			etp.exchangeToWeight[exchangeId] = weight
+		}
 
 		// Measure invalid price updates inserted into the in-memory map.
 		if exists && !isUpdated {
@@ -57,17 +68,22 @@ func (etp *ExchangeToPrice) UpdatePrices(updates []*api.ExchangePrice) {
 	}
 }
 
-// GetValidPrices returns a list of "valid" prices. Prices are considered
+// GetValidPrices returns a list of "valid" prices and the weight of each price. Prices are considered
 // "valid" iff the last update time is greater than or equal to the given cutoff time.
 func (etp *ExchangeToPrice) GetValidPrices(
 	logger log.Logger,
 	cutoffTime time.Time,
-) []uint64 {
-	validExchangePricesForMarket := make([]uint64, 0, len(etp.exchangeToPriceTimestamp))
+) (
+	validExchangePricesForMarket []uint64,
+	weights []uint32,
+) {
+	validExchangePricesForMarket = make([]uint64, 0, len(etp.exchangeToPriceTimestamp))
+	weights = make([]uint32, 0, len(etp.exchangeToPriceTimestamp))
 	for exchangeId, priceTimestamp := range etp.exchangeToPriceTimestamp {
 		// PriceTimestamp returns price if the last update time is valid.
 		if price, ok := priceTimestamp.GetValidPrice(cutoffTime); ok {
 			validExchangePricesForMarket = append(validExchangePricesForMarket, price)
+			weights = append(weights, etp.exchangeToWeight[exchangeId])
 		} else {
 			// Price is invalid.
 			logger.Warn(
@@ -79,5 +95,5 @@ func (etp *ExchangeToPrice) GetValidPrices(
 			)
 		}
 	}
-	return validExchangePricesForMarket
+	return validExchangePricesForMarket, weights
 }
@@ -115,16 +115,51 @@ func TestGetValidPrices(t *testing.T) {
 			constants.Exchange1_Price1_TimeT,
 		})
 
-	r := etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
+	r, weights := etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
 	require.Len(t, r, 1)
 	require.Equal(t, constants.Price1, r[0])
+	require.Equal(t, []uint32{1}, weights)
+}
+
+func TestGetValidPrices_Weighted(t *testing.T) {
+	etp := NewExchangeToPrice(0)
+
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{
+				ExchangeId:     constants.ExchangeId1,
+				Price:          constants.Price1,
+				LastUpdateTime: &constants.TimeT,
+				Weight:         3,
+			},
+		})
+
+	r, weights := etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
+	require.Equal(t, []uint64{constants.Price1}, r)
+	require.Equal(t, []uint32{3}, weights)
+
+	// The weight is only updated along with the price.
+	etp.UpdatePrices(
+		[]*api.ExchangePrice{
+			{
+				ExchangeId:     constants.ExchangeId1,
+				Price:          constants.Price2,
+				LastUpdateTime: &constants.TimeT,
+				Weight:         5,
+			},
+		})
+
+	r, weights = etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
+	require.Equal(t, []uint64{constants.Price1}, r)
+	require.Equal(t, []uint32{3}, weights)
 }
 
 func TestGetValidPrices_Empty(t *testing.T) {
 	etp := NewExchangeToPrice(0)
 
-	r := etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
+	r, weights := etp.GetValidPrices(log.NewNopLogger(), constants.TimeT)
 	require.Empty(t, r)
+	require.Empty(t, weights)
 }
 
 func TestGetValidPrices_OldPricesEmpty(t *testing.T) {
@@ -136,8 +171,9 @@ func TestGetValidPrices_OldPricesEmpty(t *testing.T) {
 			constants.Exchange2_Price2_TimeT,
 		})
 
-	r := etp.GetValidPrices(log.NewNopLogger(), constants.TimeTPlus1)
+	r, weights := etp.GetValidPrices(log.NewNopLogger(), constants.TimeTPlus1)
 	require.Empty(t, r)
+	require.Empty(t, weights)
 }
 
 func TestGetValidPrices_ValidAndOldPrices(t *testing.T) {
@@ -151,8 +187,9 @@ func TestGetValidPrices_ValidAndOldPrices(t *testing.T) {
 		})
 
 	// Exchange 1's Price is before cutoff, so it's ignored
-	r := etp.GetValidPrices(log.NewNopLogger(), constants.TimeTPlus1)
+	r, weights := etp.GetValidPrices(log.NewNopLogger(), constants.TimeTPlus1)
 	require.Len(t, r, 2)
+	require.Equal(t, []uint32{1, 1}, weights)
 
 	expected := []uint64{constants.Price3, constants.Price4}
 	assert.ElementsMatch(t, expected, r)
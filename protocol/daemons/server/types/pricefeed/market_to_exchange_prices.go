@@ -54,7 +54,7 @@ func (mte *MarketToExchangePrices) UpdatePrices(
 
 // GetValidMedianPrices returns median prices for multiple markets.
 // Specifically, it returns a map where the key is the market ID and the value
-// is the median price for the market. It only returns "valid" prices where
+// is the median price for the market, weighted by the weight of each exchange price. It only returns "valid" prices where
 // a price is valid iff
 // 1) the last update time is within a predefined threshold away from the given
 // read time.
@@ -89,10 +89,10 @@ func (mte *MarketToExchangePrices) GetValidMedianPrices(
 		}
 
 		// GetValidPriceForMarket filters prices based on cutoff time.
-		validPrices := exchangeToPrice.GetValidPrices(logger, cutoffTime)
+		validPrices, weights := exchangeToPrice.GetValidPrices(logger, cutoffTime)
 
-		// Calculate the median. Returns an error if the input is empty.
-		median, err := lib.Median(validPrices)
+		// Calculate the weighted median. Returns an error if the input is empty.
+		median, err := lib.WeightedMedian(validPrices, weights)
 		if err != nil {
 			logger.Error("No valid median price", metrics.MarketId, marketId, metrics.Error, err)
 			telemetry.IncrCounterWithLabels(
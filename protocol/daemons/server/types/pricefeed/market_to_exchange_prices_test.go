@@ -193,3 +193,42 @@ func TestGetValidMedianPrices_MultiMarketSuccess(t *testing.T) {
 	require.Equal(t, uint64(2002), r[constants.MarketId9]) // Median of 1001, 2002, 3003
 	require.Equal(t, uint64(2503), r[constants.MarketId8]) // Median of 2002, 3003
 }
+
+func TestGetValidMedianPrices_Weighted(t *testing.T) {
+	mte := NewMarketToExchangePrices(pricefeed_types.MaxPriceAge)
+
+	mte.UpdatePrices([]*api.MarketPriceUpdate{
+		{
+			MarketId: constants.MarketId9,
+			ExchangePrices: []*api.ExchangePrice{
+				{
+					ExchangeId:     constants.ExchangeId1,
+					Price:          constants.Price1,
+					LastUpdateTime: &constants.TimeT,
+					Weight:         1,
+				},
+				{
+					ExchangeId:     constants.ExchangeId2,
+					Price:          constants.Price2,
+					LastUpdateTime: &constants.TimeT,
+				},
+				{
+					ExchangeId:     constants.ExchangeId3,
+					Price:          constants.Price3,
+					LastUpdateTime: &constants.TimeT,
+					Weight:         3,
+				},
+			},
+		},
+	})
+
+	r := mte.GetValidMedianPrices(
+		log.NewNopLogger(),
+		constants.AllMarketParamsMinExchanges2,
+		constants.TimeT,
+	)
+
+	// The price of exchange 3 makes up more than half of the total weight of 5.
+	require.Len(t, r, 1)
+	require.Equal(t, constants.Price3, r[constants.MarketId9])
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 )
@@ -13,6 +14,7 @@ type RequestHandlerImpl struct {
 // RequestHandler is an interface that handles making HTTP requests.
 type RequestHandler interface {
 	Get(ctx context.Context, url string) (*http.Response, error)
+	Post(ctx context.Context, url string, contentType string, body []byte) (*http.Response, error)
 }
 
 // NewRequestHandlerImpl creates a new RequestHandlerImpl. It manages making HTTP requests.
@@ -22,12 +24,32 @@ func NewRequestHandlerImpl(client *http.Client) *RequestHandlerImpl {
 	}
 }
 
-// Get wraps `http.Get` which makes an HTTP GET request to a URL and returns a response.
+// Get wraps `http.Get` which makes an HTTP GET request to a URL and returns a response. Compressed responses are
+// requested to reduce bandwidth, so callers must decode the response body based on its `Content-Encoding`.
 func (r *RequestHandlerImpl) Get(ctx context.Context, url string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+
+	return r.client.Do(req)
+}
+
+// Post makes an HTTP POST request with the provided body to a URL and returns a response. As with `Get`,
+// compressed responses are requested, so callers must decode the response body based on its `Content-Encoding`.
+func (r *RequestHandlerImpl) Post(
+	ctx context.Context,
+	url string,
+	contentType string,
+	body []byte,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
 
 	return r.client.Do(req)
 }
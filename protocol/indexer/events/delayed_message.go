@@ -0,0 +1,13 @@
+package events
+
+// NewDelayedMessageExecutionEvent creates a DelayedMessageExecutionEvent representing the execution of a
+// delayed message by the x/delaymsg module.
+func NewDelayedMessageExecutionEvent(
+	id uint32,
+	msgTypeUrl string,
+) *DelayedMessageExecutionEventV1 {
+	return &DelayedMessageExecutionEventV1{
+		Id:         id,
+		MsgTypeUrl: msgTypeUrl,
+	}
+}
@@ -0,0 +1,19 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDelayedMessageExecutionEvent_Success(t *testing.T) {
+	delayedMessageExecutionEvent := NewDelayedMessageExecutionEvent(
+		3,
+		"/dydxprotocol.bridge.MsgCompleteBridge",
+	)
+	expectedDelayedMessageExecutionEventProto := &DelayedMessageExecutionEventV1{
+		Id:         3,
+		MsgTypeUrl: "/dydxprotocol.bridge.MsgCompleteBridge",
+	}
+	require.Equal(t, expectedDelayedMessageExecutionEventProto, delayedMessageExecutionEvent)
+}
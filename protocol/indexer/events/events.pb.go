@@ -198,6 +198,7 @@ type MarketEventV1 struct {
 	// either an event for price update, market creation, or market modification.
 	//
 	// Types that are valid to be assigned to Event:
+	//
 	//	*MarketEventV1_PriceUpdate
 	//	*MarketEventV1_MarketCreate
 	//	*MarketEventV1_MarketModify
@@ -512,8 +513,7 @@ func (m *MarketModifyEventV1) GetBase() *MarketBaseEventV1 {
 
 // SourceOfFunds is the source of funds in a transfer event.
 type SourceOfFunds struct {
-	//	one of below
-	//
+	//  one of below
 	// - a subaccount ID
 	// - a wallet address
 	//
@@ -2536,6 +2536,62 @@ func (m *LiquidityTierUpsertEventV2) GetOpenInterestUpperCap() uint64 {
 	return 0
 }
 
+// DelayedMessageExecutionEventV1 message contains all the information about a
+// delayed message executed by the x/delaymsg module.
+type DelayedMessageExecutionEventV1 struct {
+	// The id of the executed delayed message.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type url of the executed message.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *DelayedMessageExecutionEventV1) Reset()         { *m = DelayedMessageExecutionEventV1{} }
+func (m *DelayedMessageExecutionEventV1) String() string { return proto.CompactTextString(m) }
+func (*DelayedMessageExecutionEventV1) ProtoMessage()    {}
+func (*DelayedMessageExecutionEventV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6331dfb59c6fd2bb, []int{25}
+}
+func (m *DelayedMessageExecutionEventV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedMessageExecutionEventV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedMessageExecutionEventV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedMessageExecutionEventV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedMessageExecutionEventV1.Merge(m, src)
+}
+func (m *DelayedMessageExecutionEventV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedMessageExecutionEventV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedMessageExecutionEventV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedMessageExecutionEventV1 proto.InternalMessageInfo
+
+func (m *DelayedMessageExecutionEventV1) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DelayedMessageExecutionEventV1) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.indexer.events.FundingEventV1_Type", FundingEventV1_Type_name, FundingEventV1_Type_value)
 	proto.RegisterType((*FundingUpdateV1)(nil), "dydxprotocol.indexer.events.FundingUpdateV1")
@@ -2569,6 +2625,7 @@ func init() {
 	proto.RegisterType((*OpenInterestUpdateEventV1)(nil), "dydxprotocol.indexer.events.OpenInterestUpdateEventV1")
 	proto.RegisterType((*OpenInterestUpdate)(nil), "dydxprotocol.indexer.events.OpenInterestUpdate")
 	proto.RegisterType((*LiquidityTierUpsertEventV2)(nil), "dydxprotocol.indexer.events.LiquidityTierUpsertEventV2")
+	proto.RegisterType((*DelayedMessageExecutionEventV1)(nil), "dydxprotocol.indexer.events.DelayedMessageExecutionEventV1")
 }
 
 func init() {
@@ -2576,153 +2633,155 @@ func init() {
 }
 
 var fileDescriptor_6331dfb59c6fd2bb = []byte{
-	// 2322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xdb, 0x8e, 0xe3, 0x3c, 0xc7, 0x19, 0xbb, 0xc6, 0xc9, 0x38, 0x09, 0x64, 0x86, 0x96,
-	0x90, 0x46, 0xfb, 0xe1, 0x4c, 0xc2, 0x2e, 0x5a, 0xed, 0x01, 0x11, 0xe7, 0x63, 0xe3, 0x28, 0xc9,
-	0x78, 0x3b, 0xc9, 0xec, 0xee, 0x80, 0xb6, 0xa9, 0x74, 0x57, 0x9c, 0x52, 0xfa, 0x6b, 0xba, 0xda,
-	0x99, 0xc9, 0x20, 0x24, 0x6e, 0x70, 0x40, 0x02, 0x09, 0x71, 0xe0, 0x80, 0xc4, 0x05, 0x0e, 0x48,
-	0x1c, 0x90, 0x10, 0x37, 0x0e, 0x88, 0xcb, 0xde, 0x18, 0x71, 0x01, 0x81, 0xb4, 0x42, 0x33, 0x07,
-	0xfe, 0x0d, 0x54, 0x1f, 0xdd, 0xfe, 0xf6, 0x78, 0x26, 0x59, 0x09, 0x21, 0x4e, 0x76, 0xbd, 0x57,
-	0xef, 0xf7, 0x5e, 0xbd, 0xf7, 0xaa, 0xea, 0xd5, 0x6b, 0xb8, 0x6b, 0x5f, 0xda, 0x4f, 0x82, 0xd0,
-	0x8f, 0x7c, 0xcb, 0x77, 0x56, 0xa8, 0x67, 0x93, 0x27, 0x24, 0x5c, 0x21, 0x17, 0xc4, 0x8b, 0x98,
-	0xfa, 0xa9, 0x0a, 0x36, 0x5a, 0xea, 0x9c, 0x59, 0x55, 0x33, 0xab, 0x72, 0xca, 0xe2, 0x82, 0xe5,
-	0x33, 0xd7, 0x67, 0xa6, 0xe0, 0xaf, 0xc8, 0x81, 0x94, 0x5b, 0x2c, 0x37, 0xfd, 0xa6, 0x2f, 0xe9,
-	0xfc, 0x9f, 0xa2, 0xde, 0x1b, 0xa8, 0x97, 0x9d, 0xe1, 0x90, 0xd8, 0x2b, 0x21, 0x71, 0xfd, 0x0b,
-	0xec, 0x98, 0x21, 0xc1, 0xcc, 0xf7, 0x94, 0xc4, 0x9b, 0x03, 0x25, 0x12, 0xc2, 0xc5, 0xea, 0x8a,
-	0xe5, 0xf8, 0x27, 0x23, 0xe1, 0x3b, 0x27, 0x07, 0x24, 0x0c, 0x48, 0xd4, 0xc2, 0x8e, 0x92, 0x58,
-	0x7d, 0xa9, 0x04, 0x6b, 0x9d, 0x60, 0xcb, 0xf2, 0x5b, 0x5e, 0x24, 0x45, 0xf4, 0xbf, 0x68, 0x70,
-	0x63, 0xbb, 0xe5, 0xd9, 0xd4, 0x6b, 0x1e, 0x07, 0x36, 0x8e, 0xc8, 0x83, 0x55, 0xf4, 0x15, 0x98,
-	0x49, 0x90, 0x4d, 0x6a, 0x57, 0xb4, 0x3b, 0xda, 0xdd, 0x82, 0x91, 0x4f, 0x68, 0x75, 0x1b, 0xbd,
-	0x01, 0xa5, 0x53, 0x29, 0x65, 0x5e, 0x60, 0xa7, 0x45, 0xcc, 0x20, 0x70, 0x2b, 0xa9, 0x3b, 0xda,
-	0xdd, 0x49, 0xe3, 0x86, 0x62, 0x3c, 0xe0, 0xf4, 0x46, 0xe0, 0x22, 0x17, 0x0a, 0xf1, 0x5c, 0x61,
-	0x52, 0x25, 0x7d, 0x47, 0xbb, 0x3b, 0x53, 0xdb, 0xf9, 0xec, 0xf3, 0xdb, 0x13, 0xff, 0xf8, 0xfc,
-	0xf6, 0x37, 0x9b, 0x34, 0x3a, 0x6b, 0x9d, 0x54, 0x2d, 0xdf, 0x5d, 0xe9, 0xb2, 0xff, 0xe2, 0x9d,
-	0xb7, 0xad, 0x33, 0x4c, 0xbd, 0xf6, 0x02, 0xec, 0xe8, 0x32, 0x20, 0xac, 0x7a, 0x48, 0x42, 0x8a,
-	0x1d, 0xfa, 0x14, 0x9f, 0x38, 0xa4, 0xee, 0x45, 0xc6, 0x8c, 0x82, 0xaf, 0x73, 0x74, 0xfd, 0xa7,
-	0x29, 0x98, 0x55, 0x2b, 0xda, 0xe2, 0x81, 0x7d, 0xb0, 0x8a, 0xf6, 0x60, 0xaa, 0x25, 0x16, 0xc7,
-	0x2a, 0xda, 0x9d, 0xf4, 0xdd, 0xfc, 0xda, 0x5b, 0xd5, 0x11, 0x89, 0x50, 0xed, 0xf1, 0x47, 0x2d,
-	0xc3, 0x2d, 0x35, 0x62, 0x08, 0xb4, 0x09, 0x19, 0x6e, 0x87, 0x58, 0xee, 0xec, 0xda, 0xbd, 0x71,
-	0xa0, 0x94, 0x21, 0xd5, 0xa3, 0xcb, 0x80, 0x18, 0x42, 0x5a, 0x77, 0x21, 0xc3, 0x47, 0xa8, 0x0c,
-	0xc5, 0xa3, 0x4f, 0x1a, 0x5b, 0xe6, 0xf1, 0xc1, 0x61, 0x63, 0x6b, 0xa3, 0xbe, 0x5d, 0xdf, 0xda,
-	0x2c, 0x4e, 0xa0, 0x5b, 0x70, 0x53, 0x50, 0x1b, 0xc6, 0xd6, 0x7e, 0xfd, 0x78, 0xdf, 0x3c, 0x5c,
-	0xdf, 0x6f, 0xec, 0x6d, 0x15, 0x35, 0x74, 0x1b, 0x96, 0x04, 0x63, 0xfb, 0xf8, 0x60, 0xb3, 0x7e,
-	0xf0, 0x81, 0x69, 0xac, 0x1f, 0x6d, 0x99, 0xeb, 0x07, 0x9b, 0x66, 0xfd, 0x60, 0x73, 0xeb, 0xe3,
-	0x62, 0x0a, 0xcd, 0x41, 0xa9, 0x4b, 0xf2, 0xc1, 0xfd, 0xa3, 0xad, 0x62, 0x5a, 0xff, 0x73, 0x0a,
-	0x0a, 0xfb, 0x38, 0x3c, 0x27, 0x51, 0xec, 0x94, 0x25, 0x98, 0x76, 0x05, 0xa1, 0x1d, 0xe2, 0x9c,
-	0x24, 0xd4, 0x6d, 0xf4, 0x10, 0x66, 0x82, 0x90, 0x5a, 0xc4, 0x94, 0x8b, 0x16, 0x6b, 0xcd, 0xaf,
-	0xbd, 0x3b, 0x72, 0xad, 0x12, 0xbe, 0xc1, 0xc5, 0xa4, 0xeb, 0x94, 0xa6, 0x9d, 0x09, 0x23, 0x1f,
-	0xb4, 0xa9, 0xe8, 0x23, 0x28, 0x28, 0xc5, 0x56, 0x48, 0x38, 0x78, 0x5a, 0x80, 0xdf, 0x1b, 0x03,
-	0x7c, 0x43, 0x08, 0xb4, 0x71, 0x67, 0xdc, 0x0e, 0x72, 0x07, 0xb0, 0xeb, 0xdb, 0xf4, 0xf4, 0xb2,
-	0x92, 0x19, 0x1b, 0x78, 0x5f, 0x08, 0xf4, 0x01, 0x4b, 0x72, 0x6d, 0x0a, 0x26, 0xc5, 0x6c, 0x7d,
-	0x17, 0x2a, 0xc3, 0x56, 0x89, 0xaa, 0x70, 0x53, 0xba, 0xec, 0x31, 0x8d, 0xce, 0x4c, 0xf2, 0x24,
-	0xf0, 0x3d, 0xe2, 0x45, 0xc2, 0xb3, 0x19, 0xa3, 0x24, 0x58, 0x1f, 0xd1, 0xe8, 0x6c, 0x4b, 0x31,
-	0xf4, 0x8f, 0xa1, 0x24, 0xb1, 0x6a, 0x98, 0x25, 0x20, 0x08, 0x32, 0x01, 0xa6, 0xa1, 0x90, 0x9a,
-	0x36, 0xc4, 0x7f, 0xb4, 0x02, 0x65, 0x97, 0x7a, 0xa6, 0x04, 0xb7, 0xce, 0xb0, 0xd7, 0x6c, 0x6f,
-	0xb7, 0x82, 0x51, 0x72, 0xa9, 0x27, 0xac, 0xd9, 0x10, 0x9c, 0x46, 0xe0, 0xea, 0x2d, 0xb8, 0x39,
-	0xc0, 0x5d, 0xa8, 0x06, 0x99, 0x13, 0xcc, 0x88, 0xc0, 0xce, 0xaf, 0x55, 0xc7, 0xf0, 0x4a, 0x87,
-	0x65, 0x86, 0x90, 0x45, 0x8b, 0x90, 0x4b, 0x56, 0xc6, 0xf5, 0x97, 0x8c, 0x64, 0xac, 0x7f, 0x12,
-	0xab, 0xed, 0x72, 0xe6, 0x75, 0xa8, 0xd5, 0x7f, 0xab, 0x41, 0xe1, 0xd0, 0x6f, 0x85, 0x16, 0xb9,
-	0x7f, 0xca, 0xb7, 0x14, 0x43, 0xdf, 0x86, 0x42, 0xfb, 0x2c, 0x8b, 0x33, 0x78, 0x68, 0x86, 0x26,
-	0x84, 0x8b, 0xd5, 0x6a, 0x5d, 0xd2, 0x0e, 0x13, 0xe9, 0xba, 0xcd, 0x03, 0xce, 0x3a, 0xc6, 0xe8,
-	0x1d, 0x98, 0xc2, 0xb6, 0x1d, 0x12, 0xc6, 0xc4, 0x2a, 0xa7, 0x6b, 0x95, 0xbf, 0xfe, 0xfe, 0xed,
-	0xb2, 0xba, 0x12, 0xd6, 0x25, 0xe7, 0x30, 0x0a, 0xa9, 0xd7, 0xdc, 0x99, 0x30, 0xe2, 0xa9, 0xb5,
-	0x1c, 0x64, 0x99, 0x30, 0x52, 0xff, 0x4d, 0x1a, 0x6e, 0x1c, 0x85, 0xd8, 0x63, 0xa7, 0x24, 0x8c,
-	0xfd, 0xd0, 0x84, 0x32, 0x23, 0x9e, 0x4d, 0x42, 0xf3, 0xfa, 0x0c, 0x37, 0x90, 0x84, 0xec, 0xa4,
-	0x21, 0x17, 0x6e, 0x85, 0xc4, 0xa2, 0x01, 0x25, 0x5e, 0xd4, 0xa3, 0x2b, 0x75, 0x15, 0x5d, 0x73,
-	0x09, 0x6a, 0x97, 0xba, 0x05, 0xc8, 0x61, 0xc6, 0xe4, 0x31, 0x92, 0x16, 0x29, 0x39, 0x25, 0xc6,
-	0x75, 0x1b, 0xcd, 0x43, 0x16, 0xbb, 0x7c, 0x9a, 0xd8, 0x89, 0x19, 0x43, 0x8d, 0x50, 0x0d, 0xb2,
-	0xd2, 0xee, 0xca, 0xa4, 0x30, 0xe8, 0x8d, 0x91, 0x49, 0xd1, 0x15, 0x78, 0x43, 0x49, 0xa2, 0x1d,
-	0x98, 0x4e, 0xec, 0xa9, 0x64, 0x5f, 0x19, 0xa6, 0x2d, 0xac, 0xff, 0x2d, 0x0d, 0xc5, 0xfb, 0xa1,
-	0x4d, 0xc2, 0x6d, 0xea, 0x38, 0x71, 0xb4, 0x8e, 0x21, 0xef, 0xe2, 0x73, 0x12, 0x9a, 0x3e, 0xe7,
-	0x8c, 0x4e, 0xde, 0x01, 0x8e, 0x13, 0x78, 0xea, 0xe2, 0x00, 0x01, 0x24, 0x28, 0x68, 0x1b, 0x26,
-	0x25, 0x60, 0xea, 0x75, 0x00, 0x77, 0x26, 0x0c, 0x29, 0x8e, 0x3e, 0x85, 0x92, 0x43, 0x1f, 0xb5,
-	0xa8, 0x8d, 0x23, 0xea, 0x7b, 0xca, 0x48, 0x79, 0xdc, 0xad, 0x8c, 0xf4, 0xc2, 0x5e, 0x5b, 0x4a,
-	0x40, 0x8a, 0xd3, 0xae, 0xe8, 0xf4, 0x50, 0xd1, 0x6d, 0xc8, 0x9f, 0x52, 0xc7, 0x31, 0x55, 0xf8,
-	0xd2, 0x22, 0x7c, 0xc0, 0x49, 0xeb, 0x32, 0x84, 0xe2, 0xf6, 0xe0, 0xfe, 0x39, 0x25, 0x44, 0x44,
-	0x11, 0xf1, 0xdb, 0xe3, 0x9c, 0x84, 0xdb, 0x84, 0x70, 0x66, 0x94, 0x30, 0xb3, 0x92, 0x19, 0xc5,
-	0xcc, 0xb7, 0x00, 0x45, 0x7e, 0x84, 0x1d, 0x93, 0xa3, 0x11, 0xdb, 0x14, 0x52, 0x95, 0x29, 0xa1,
-	0xa1, 0x28, 0x38, 0xdb, 0x82, 0xb1, 0xcf, 0xe9, 0x7d, 0xb3, 0x05, 0x4c, 0x25, 0xd7, 0x37, 0xfb,
-	0x88, 0xd3, 0x6b, 0x05, 0xc8, 0x47, 0xed, 0xa8, 0xe9, 0x3f, 0x4a, 0xc3, 0xcd, 0x4d, 0xe2, 0x90,
-	0x0b, 0x12, 0xe2, 0x66, 0x47, 0x3d, 0xf0, 0x2d, 0x80, 0x78, 0xc5, 0xe4, 0x6a, 0x1b, 0x30, 0x0e,
-	0x71, 0x1b, 0x8e, 0x83, 0xfb, 0xa7, 0xa7, 0x8c, 0x44, 0x11, 0xf5, 0x9a, 0x57, 0xda, 0x71, 0x31,
-	0x78, 0x1b, 0xae, 0xaf, 0x34, 0x4b, 0xf7, 0x97, 0x66, 0x3d, 0xa1, 0xcb, 0xf4, 0x85, 0xee, 0x1e,
-	0x94, 0xa5, 0x4b, 0x1f, 0xb5, 0xfc, 0x88, 0x98, 0x8f, 0x5a, 0xd8, 0x8b, 0x5a, 0x2e, 0x13, 0x51,
-	0xcc, 0x18, 0xd2, 0xdd, 0x1f, 0x72, 0xd6, 0x87, 0x8a, 0x83, 0xe6, 0x20, 0x4b, 0x99, 0x79, 0xd2,
-	0xba, 0x14, 0xc1, 0xcc, 0x19, 0x93, 0x94, 0xd5, 0x5a, 0x97, 0xfc, 0xc6, 0xa3, 0xcc, 0x3c, 0xa5,
-	0x1e, 0x76, 0x4c, 0x6e, 0xa0, 0x43, 0x5c, 0xbe, 0x19, 0xa7, 0xc4, 0x9c, 0x12, 0x65, 0xdb, 0x9c,
-	0x73, 0x98, 0x30, 0xf4, 0x1f, 0xa6, 0x00, 0xf5, 0xe7, 0xdf, 0x17, 0x1b, 0x8d, 0x3b, 0x30, 0xc3,
-	0x4b, 0x6a, 0x93, 0xdf, 0xa4, 0xf1, 0x09, 0x58, 0x30, 0x80, 0xd3, 0x1a, 0x98, 0x86, 0x75, 0x7b,
-	0x1c, 0x97, 0x7e, 0x19, 0x40, 0x7a, 0x8c, 0xd1, 0xa7, 0x44, 0x79, 0x74, 0x5a, 0x50, 0x0e, 0xe9,
-	0x53, 0xd2, 0xe1, 0x9e, 0xc9, 0x4e, 0xf7, 0x2c, 0x42, 0x8e, 0xb5, 0x4e, 0x22, 0x6a, 0x9d, 0x33,
-	0xe1, 0xb7, 0x8c, 0x91, 0x8c, 0xf5, 0x7f, 0xa7, 0xe0, 0x56, 0xdb, 0xf2, 0xee, 0x42, 0xe2, 0xe1,
-	0x75, 0x5e, 0x6d, 0x3d, 0x17, 0xdb, 0x53, 0x58, 0x92, 0x15, 0x9d, 0x6d, 0xb6, 0x17, 0x1d, 0xf8,
-	0x8c, 0xf2, 0x80, 0xb0, 0x4a, 0x5a, 0x54, 0xc7, 0xef, 0x8f, 0xad, 0xa9, 0x11, 0x63, 0x34, 0x14,
-	0x84, 0xb1, 0xa0, 0xe0, 0xfb, 0x38, 0x0c, 0x79, 0x70, 0x2b, 0xd6, 0x2d, 0x2f, 0x8c, 0xb6, 0xde,
-	0x8c, 0xd0, 0xfb, 0xf5, 0xb1, 0xf5, 0xae, 0x73, 0xf9, 0x44, 0xe7, 0x9c, 0x82, 0xed, 0xa2, 0xb2,
-	0xdd, 0x4c, 0x2e, 0x55, 0x4c, 0xeb, 0xff, 0x9c, 0x81, 0xf2, 0x61, 0x84, 0x23, 0x72, 0xda, 0x72,
-	0x44, 0xc6, 0xc5, 0x6e, 0x7e, 0x04, 0x79, 0x71, 0x4a, 0x98, 0x81, 0x83, 0xad, 0xb8, 0x3c, 0xd9,
-	0x1d, 0x7d, 0x85, 0x0c, 0xc0, 0xe9, 0x26, 0x36, 0x38, 0x96, 0x2b, 0x18, 0xb5, 0x54, 0x45, 0xdb,
-	0xe1, 0xbb, 0x37, 0xa1, 0x23, 0x1f, 0x0a, 0x52, 0xa5, 0x7a, 0x1c, 0xaa, 0x13, 0x7b, 0xe7, 0x8a,
-	0x4a, 0x0d, 0x89, 0x26, 0x0b, 0x57, 0xbf, 0x83, 0x82, 0x7e, 0xac, 0xc1, 0x92, 0xe5, 0x7b, 0xb6,
-	0xf0, 0x08, 0x76, 0xcc, 0x8e, 0x05, 0x8b, 0xad, 0x2a, 0xaf, 0xdf, 0xfd, 0x57, 0xd7, 0xbf, 0xd1,
-	0x06, 0xed, 0x5d, 0xf7, 0xce, 0x84, 0xb1, 0x60, 0x0d, 0x63, 0x0f, 0xb1, 0x28, 0x0a, 0x69, 0xb3,
-	0x49, 0x42, 0x62, 0xab, 0x9b, 0xfc, 0x1a, 0x2c, 0x3a, 0x8a, 0x21, 0x07, 0x5b, 0x94, 0xb0, 0xd1,
-	0x0f, 0x34, 0x58, 0x70, 0x7c, 0xaf, 0x69, 0x46, 0x24, 0x74, 0xfb, 0x3c, 0x34, 0xf5, 0xba, 0x69,
-	0xb1, 0xe7, 0x7b, 0xcd, 0x23, 0x12, 0xba, 0x03, 0xdc, 0x33, 0xef, 0x0c, 0xe4, 0xa1, 0xef, 0x42,
-	0x29, 0x4e, 0x8f, 0xb6, 0x01, 0x39, 0x61, 0xc0, 0xde, 0x15, 0x0d, 0x30, 0xda, 0x88, 0xf2, 0xc6,
-	0xf7, 0x7b, 0xa8, 0x8b, 0xdf, 0x81, 0xca, 0xb0, 0x4c, 0x46, 0x9b, 0x71, 0xd5, 0xf2, 0x5a, 0x65,
-	0x90, 0xaa, 0x59, 0x16, 0xff, 0xa8, 0xc1, 0xfc, 0xe0, 0xbc, 0x45, 0x0f, 0xa1, 0x28, 0xb6, 0x04,
-	0xb1, 0x55, 0x00, 0x92, 0x53, 0xef, 0xde, 0xab, 0xe9, 0xaa, 0xdb, 0xc6, 0xac, 0x42, 0x52, 0x63,
-	0xf4, 0x01, 0x64, 0x65, 0x0f, 0x46, 0x3d, 0xd8, 0x87, 0xd4, 0x47, 0xb2, 0x6d, 0x53, 0xed, 0x34,
-	0xcc, 0x10, 0x62, 0x86, 0x12, 0x5f, 0xb4, 0x60, 0x69, 0x44, 0xda, 0x5f, 0x93, 0x93, 0xbe, 0xd7,
-	0xaf, 0xa4, 0x23, 0x93, 0xd1, 0xa7, 0x80, 0x92, 0xbd, 0x72, 0x75, 0x57, 0x15, 0x13, 0x2c, 0x45,
-	0xe1, 0x59, 0x30, 0x2c, 0x71, 0xaf, 0x69, 0x81, 0x7f, 0xd0, 0x60, 0x71, 0x78, 0x6a, 0x22, 0x03,
-	0x66, 0x7c, 0xe7, 0x1a, 0x96, 0x06, 0xbe, 0x93, 0x64, 0xc0, 0xe6, 0x95, 0x8a, 0x6e, 0x65, 0x78,
-	0xd2, 0x04, 0x90, 0xf7, 0xca, 0x6e, 0x26, 0x97, 0x2e, 0x66, 0xf4, 0x5f, 0x69, 0x80, 0xc4, 0xb5,
-	0xd3, 0xfd, 0xd4, 0x9e, 0x85, 0x54, 0xd2, 0x54, 0x49, 0x51, 0xf1, 0x10, 0x62, 0x97, 0xee, 0x89,
-	0xef, 0xc8, 0xe7, 0xa4, 0xa1, 0x46, 0xbc, 0xb0, 0x38, 0xc3, 0xcc, 0x94, 0xcd, 0x06, 0x51, 0x79,
-	0xe4, 0x8c, 0xe9, 0x33, 0xcc, 0xe4, 0x3b, 0xb8, 0xbb, 0x45, 0x93, 0xe9, 0x69, 0xd1, 0xbc, 0x09,
-	0x25, 0x1c, 0xf9, 0x2e, 0xb5, 0xcc, 0x90, 0x30, 0xdf, 0x69, 0xf1, 0x8c, 0x11, 0x07, 0x7a, 0xc9,
-	0x28, 0x4a, 0x86, 0x91, 0xd0, 0xf5, 0x3f, 0xa5, 0xe1, 0x4b, 0xc9, 0x95, 0x3c, 0xa8, 0x39, 0xd0,
-	0x6b, 0xf1, 0xcb, 0xeb, 0xa6, 0x79, 0xc8, 0xf2, 0x5a, 0x86, 0x84, 0xc2, 0xee, 0x69, 0x43, 0x8d,
-	0x46, 0x1b, 0xbd, 0x03, 0x59, 0x16, 0xe1, 0xa8, 0x25, 0xab, 0xcd, 0xd9, 0x71, 0x02, 0xbb, 0xa1,
-	0x54, 0x1e, 0x0a, 0x39, 0x43, 0xc9, 0xa3, 0x6f, 0xc0, 0x92, 0xaa, 0x5c, 0x4d, 0xcb, 0xf7, 0x2e,
-	0x48, 0xc8, 0xf8, 0x43, 0x28, 0x69, 0x4e, 0x64, 0x85, 0x23, 0x16, 0xd4, 0x94, 0x8d, 0x64, 0x46,
-	0xdc, 0x7e, 0x19, 0xec, 0xbe, 0xa9, 0xc1, 0xee, 0x43, 0x6f, 0x40, 0x29, 0x2e, 0xdd, 0x78, 0xdd,
-	0x64, 0xf2, 0x7f, 0xe2, 0x64, 0x2e, 0x18, 0x37, 0x62, 0x46, 0x83, 0x84, 0x47, 0xd4, 0x3a, 0xe7,
-	0x2f, 0x16, 0x16, 0x91, 0xc0, 0x3c, 0xc1, 0xac, 0xa3, 0xb8, 0x9e, 0x96, 0x2f, 0x16, 0xce, 0xa9,
-	0x61, 0xd6, 0x2e, 0xad, 0xbf, 0x0a, 0xb3, 0xb2, 0x5a, 0xa5, 0xd1, 0xa5, 0x19, 0x51, 0x12, 0x56,
-	0x40, 0xc0, 0x16, 0x12, 0xea, 0x11, 0x25, 0xe1, 0xfb, 0xa9, 0x8a, 0xa6, 0xff, 0x2c, 0x33, 0x32,
-	0x86, 0x6b, 0xff, 0x8f, 0xe1, 0x7f, 0x75, 0x0c, 0xd1, 0x03, 0xc8, 0x2b, 0xa7, 0x8a, 0xf6, 0x71,
-	0x5e, 0x38, 0x6f, 0x8c, 0xaa, 0xbe, 0x27, 0xe6, 0xa2, 0x87, 0x0c, 0x6e, 0xf2, 0x5f, 0xff, 0x65,
-	0x0a, 0x16, 0xf7, 0x3a, 0x35, 0x1d, 0x07, 0x8c, 0x84, 0xd1, 0xb0, 0x9d, 0x8d, 0x20, 0xe3, 0x61,
-	0x97, 0xa8, 0x93, 0x48, 0xfc, 0xe7, 0xeb, 0xa5, 0x1e, 0x8d, 0x28, 0x76, 0xf8, 0x59, 0xd4, 0xa4,
-	0x9e, 0x68, 0x30, 0xca, 0x97, 0x50, 0x51, 0x71, 0xf6, 0x05, 0xa3, 0x11, 0xb8, 0xe8, 0x3d, 0xa8,
-	0xb8, 0x98, 0x7a, 0x11, 0xf1, 0xb0, 0x67, 0x11, 0xf3, 0x34, 0xc4, 0x96, 0xe8, 0x42, 0x70, 0x19,
-	0x99, 0x2c, 0xf3, 0x1d, 0xfc, 0x6d, 0xc5, 0x96, 0x92, 0xf3, 0xc2, 0xa5, 0x71, 0xe5, 0x6f, 0x7a,
-	0xbe, 0xbc, 0xe8, 0xe4, 0xe3, 0x93, 0x97, 0xcc, 0x46, 0x99, 0xcf, 0x88, 0xab, 0xf8, 0x03, 0xc5,
-	0xdf, 0xcd, 0xe4, 0xb2, 0xc5, 0xa9, 0xdd, 0x4c, 0x6e, 0xaa, 0x98, 0x33, 0x6e, 0xf9, 0x01, 0xf1,
-	0x4c, 0xae, 0x20, 0x24, 0x2c, 0x32, 0x1d, 0xff, 0x31, 0x09, 0x4d, 0x0b, 0x07, 0xbd, 0x8c, 0x56,
-	0x10, 0x48, 0x86, 0xfe, 0x8b, 0x14, 0xcc, 0xc9, 0x47, 0x56, 0x9c, 0x89, 0xb1, 0x77, 0x7a, 0xf7,
-	0x88, 0xd6, 0xb7, 0x47, 0xda, 0xe9, 0x9e, 0xfa, 0x62, 0xd3, 0x3d, 0xfd, 0xb2, 0x74, 0x1f, 0x98,
-	0xc1, 0x99, 0x57, 0xc9, 0xe0, 0xc9, 0xc1, 0x19, 0xac, 0xff, 0x4e, 0x83, 0x79, 0xe9, 0x9f, 0x24,
-	0xd9, 0x46, 0x5c, 0x65, 0xea, 0xc8, 0x48, 0x0d, 0x3f, 0x32, 0xd2, 0xe3, 0xdc, 0x55, 0x99, 0x21,
-	0x1b, 0xb5, 0x7f, 0x3b, 0x4d, 0x0e, 0xd8, 0x4e, 0x3a, 0x83, 0xb9, 0xa3, 0x10, 0xdb, 0xd4, 0x6b,
-	0x1a, 0xe4, 0x31, 0x0e, 0x6d, 0xd6, 0x7e, 0x3f, 0xdf, 0x88, 0x24, 0xc3, 0x0c, 0x25, 0x47, 0x7d,
-	0xf5, 0x59, 0x1d, 0x59, 0x44, 0xab, 0xb6, 0x6e, 0x17, 0xa6, 0x31, 0x1b, 0x75, 0xa9, 0xd0, 0x7f,
-	0xae, 0x41, 0x79, 0xd0, 0x44, 0x54, 0x86, 0x49, 0xff, 0xb1, 0x47, 0xe2, 0xce, 0xbd, 0x1c, 0xa0,
-	0x73, 0x98, 0xb1, 0x89, 0xe7, 0xbb, 0x71, 0x33, 0x26, 0x75, 0xcd, 0x5f, 0xbe, 0xf2, 0x02, 0x5d,
-	0xf6, 0x75, 0xf4, 0xef, 0x6b, 0xb0, 0x70, 0x3f, 0x20, 0x5e, 0x5d, 0xe5, 0x7f, 0x77, 0x57, 0xc1,
-	0x82, 0xb9, 0xde, 0xdd, 0xd1, 0xf9, 0x45, 0x6c, 0x74, 0xd7, 0xb0, 0x1f, 0xd6, 0xb8, 0xe9, 0xf7,
-	0xd1, 0x98, 0xfe, 0x6b, 0x0d, 0x50, 0xff, 0xdc, 0x71, 0x3e, 0x28, 0xba, 0x50, 0xe8, 0x32, 0xef,
-	0xda, 0x5d, 0x35, 0xd3, 0x69, 0xaf, 0xfe, 0x6c, 0xd4, 0x99, 0xb9, 0xf6, 0xbf, 0x71, 0x66, 0xa2,
-	0x77, 0x61, 0xd8, 0x49, 0xa9, 0xfa, 0x51, 0xe5, 0x4e, 0x9f, 0xec, 0x71, 0xe6, 0x06, 0x0e, 0xfa,
-	0xc5, 0x92, 0x73, 0x54, 0x75, 0x69, 0xcb, 0xdd, 0xa1, 0x0f, 0x84, 0x58, 0xcd, 0xf8, 0xec, 0xf9,
-	0xb2, 0xf6, 0xec, 0xf9, 0xb2, 0xf6, 0xaf, 0xe7, 0xcb, 0xda, 0x4f, 0x5e, 0x2c, 0x4f, 0x3c, 0x7b,
-	0xb1, 0x3c, 0xf1, 0xf7, 0x17, 0xcb, 0x13, 0x0f, 0xdf, 0x1b, 0x3f, 0x78, 0xdd, 0x1f, 0xef, 0x4f,
-	0xb2, 0x82, 0xf1, 0xb5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xa5, 0x55, 0x11, 0xe2, 0x1f,
-	0x00, 0x00,
+	// 2358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xcf, 0xb4, 0xc7, 0xe3, 0x37, 0x1e, 0x67, 0x5c, 0xb1, 0x9d, 0xb1, 0x0d, 0x4e, 0x68,
+	0x09, 0x29, 0xda, 0x8f, 0x71, 0x6c, 0x76, 0xd1, 0x6a, 0x0f, 0x08, 0x8f, 0x3f, 0xd6, 0x63, 0xd9,
+	0xce, 0x6c, 0xdb, 0xce, 0xee, 0x06, 0xb4, 0x4d, 0xb9, 0xbb, 0x3c, 0x6e, 0xb9, 0xbf, 0x52, 0xd5,
+	0xe3, 0xc4, 0x41, 0x48, 0xdc, 0xe0, 0x80, 0x04, 0x12, 0xe2, 0xc0, 0x01, 0x89, 0x0b, 0x1c, 0x90,
+	0x38, 0x20, 0x21, 0x6e, 0x1c, 0x10, 0x97, 0xbd, 0x11, 0x71, 0x01, 0x81, 0xb4, 0x42, 0xc9, 0x81,
+	0x7f, 0x03, 0xd5, 0x47, 0xf7, 0x7c, 0x4f, 0x26, 0xb1, 0x57, 0x42, 0x88, 0x93, 0xa7, 0xde, 0xab,
+	0xf7, 0x7b, 0xaf, 0xde, 0x7b, 0x55, 0xf5, 0xea, 0xb5, 0xe1, 0xae, 0x73, 0xe9, 0x3c, 0x89, 0x68,
+	0x18, 0x87, 0x76, 0xe8, 0xad, 0xb8, 0x81, 0x43, 0x9e, 0x10, 0xba, 0x42, 0x2e, 0x48, 0x10, 0x33,
+	0xf5, 0xa7, 0x22, 0xd8, 0x68, 0xa9, 0x7d, 0x66, 0x45, 0xcd, 0xac, 0xc8, 0x29, 0x8b, 0x0b, 0x76,
+	0xc8, 0xfc, 0x90, 0x59, 0x82, 0xbf, 0x22, 0x07, 0x52, 0x6e, 0x71, 0xb6, 0x11, 0x36, 0x42, 0x49,
+	0xe7, 0xbf, 0x14, 0xf5, 0x5e, 0x5f, 0xbd, 0xec, 0x0c, 0x53, 0xe2, 0xac, 0x50, 0xe2, 0x87, 0x17,
+	0xd8, 0xb3, 0x28, 0xc1, 0x2c, 0x0c, 0x94, 0xc4, 0x9b, 0x7d, 0x25, 0x52, 0xc2, 0xc5, 0xea, 0x8a,
+	0xed, 0x85, 0x27, 0x43, 0xe1, 0xdb, 0x27, 0x47, 0x84, 0x46, 0x24, 0x6e, 0x62, 0x4f, 0x49, 0xac,
+	0xbe, 0x54, 0x82, 0x35, 0x4f, 0xb0, 0x6d, 0x87, 0xcd, 0x20, 0x96, 0x22, 0xc6, 0x5f, 0x34, 0xb8,
+	0xb1, 0xdd, 0x0c, 0x1c, 0x37, 0x68, 0x1c, 0x47, 0x0e, 0x8e, 0xc9, 0x83, 0x55, 0xf4, 0x15, 0x98,
+	0x4a, 0x91, 0x2d, 0xd7, 0x29, 0x6b, 0x77, 0xb4, 0xbb, 0x45, 0xb3, 0x90, 0xd2, 0x6a, 0x0e, 0x7a,
+	0x03, 0x66, 0x4e, 0xa5, 0x94, 0x75, 0x81, 0xbd, 0x26, 0xb1, 0xa2, 0xc8, 0x2f, 0x67, 0xee, 0x68,
+	0x77, 0xc7, 0xcd, 0x1b, 0x8a, 0xf1, 0x80, 0xd3, 0xeb, 0x91, 0x8f, 0x7c, 0x28, 0x26, 0x73, 0x85,
+	0x49, 0xe5, 0xec, 0x1d, 0xed, 0xee, 0x54, 0x75, 0xe7, 0xb3, 0xcf, 0x6f, 0x8f, 0xfd, 0xe3, 0xf3,
+	0xdb, 0xdf, 0x6c, 0xb8, 0xf1, 0x59, 0xf3, 0xa4, 0x62, 0x87, 0xfe, 0x4a, 0x87, 0xfd, 0x17, 0xef,
+	0xbc, 0x6d, 0x9f, 0x61, 0x37, 0x68, 0x2d, 0xc0, 0x89, 0x2f, 0x23, 0xc2, 0x2a, 0x87, 0x84, 0xba,
+	0xd8, 0x73, 0x9f, 0xe2, 0x13, 0x8f, 0xd4, 0x82, 0xd8, 0x9c, 0x52, 0xf0, 0x35, 0x8e, 0x6e, 0xfc,
+	0x34, 0x03, 0xd3, 0x6a, 0x45, 0x5b, 0x3c, 0xb0, 0x0f, 0x56, 0xd1, 0x1e, 0x4c, 0x34, 0xc5, 0xe2,
+	0x58, 0x59, 0xbb, 0x93, 0xbd, 0x5b, 0x58, 0x7b, 0xab, 0x32, 0x24, 0x11, 0x2a, 0x5d, 0xfe, 0xa8,
+	0xea, 0xdc, 0x52, 0x33, 0x81, 0x40, 0x9b, 0xa0, 0x73, 0x3b, 0xc4, 0x72, 0xa7, 0xd7, 0xee, 0x8d,
+	0x02, 0xa5, 0x0c, 0xa9, 0x1c, 0x5d, 0x46, 0xc4, 0x14, 0xd2, 0x86, 0x0f, 0x3a, 0x1f, 0xa1, 0x59,
+	0x28, 0x1d, 0x7d, 0x52, 0xdf, 0xb2, 0x8e, 0x0f, 0x0e, 0xeb, 0x5b, 0x1b, 0xb5, 0xed, 0xda, 0xd6,
+	0x66, 0x69, 0x0c, 0xdd, 0x82, 0x9b, 0x82, 0x5a, 0x37, 0xb7, 0xf6, 0x6b, 0xc7, 0xfb, 0xd6, 0xe1,
+	0xfa, 0x7e, 0x7d, 0x6f, 0xab, 0xa4, 0xa1, 0xdb, 0xb0, 0x24, 0x18, 0xdb, 0xc7, 0x07, 0x9b, 0xb5,
+	0x83, 0x0f, 0x2c, 0x73, 0xfd, 0x68, 0xcb, 0x5a, 0x3f, 0xd8, 0xb4, 0x6a, 0x07, 0x9b, 0x5b, 0x1f,
+	0x97, 0x32, 0x68, 0x0e, 0x66, 0x3a, 0x24, 0x1f, 0xdc, 0x3f, 0xda, 0x2a, 0x65, 0x8d, 0x3f, 0x67,
+	0xa0, 0xb8, 0x8f, 0xe9, 0x39, 0x89, 0x13, 0xa7, 0x2c, 0xc1, 0xa4, 0x2f, 0x08, 0xad, 0x10, 0xe7,
+	0x25, 0xa1, 0xe6, 0xa0, 0x87, 0x30, 0x15, 0x51, 0xd7, 0x26, 0x96, 0x5c, 0xb4, 0x58, 0x6b, 0x61,
+	0xed, 0xdd, 0xa1, 0x6b, 0x95, 0xf0, 0x75, 0x2e, 0x26, 0x5d, 0xa7, 0x34, 0xed, 0x8c, 0x99, 0x85,
+	0xa8, 0x45, 0x45, 0x1f, 0x41, 0x51, 0x29, 0xb6, 0x29, 0xe1, 0xe0, 0x59, 0x01, 0x7e, 0x6f, 0x04,
+	0xf0, 0x0d, 0x4a, 0x3a, 0x70, 0xa7, 0xfc, 0x36, 0x72, 0x1b, 0xb0, 0x1f, 0x3a, 0xee, 0xe9, 0x65,
+	0x59, 0x1f, 0x19, 0x78, 0x5f, 0x08, 0xf4, 0x00, 0x4b, 0x72, 0x75, 0x02, 0xc6, 0xc5, 0x6c, 0x63,
+	0x17, 0xca, 0x83, 0x56, 0x89, 0x2a, 0x70, 0x53, 0xba, 0xec, 0xb1, 0x1b, 0x9f, 0x59, 0xe4, 0x49,
+	0x14, 0x06, 0x24, 0x88, 0x85, 0x67, 0x75, 0x73, 0x46, 0xb0, 0x3e, 0x72, 0xe3, 0xb3, 0x2d, 0xc5,
+	0x30, 0x3e, 0x86, 0x19, 0x89, 0x55, 0xc5, 0x2c, 0x05, 0x41, 0xa0, 0x47, 0xd8, 0xa5, 0x42, 0x6a,
+	0xd2, 0x14, 0xbf, 0xd1, 0x0a, 0xcc, 0xfa, 0x6e, 0x60, 0x49, 0x70, 0xfb, 0x0c, 0x07, 0x8d, 0xd6,
+	0x76, 0x2b, 0x9a, 0x33, 0xbe, 0x1b, 0x08, 0x6b, 0x36, 0x04, 0xa7, 0x1e, 0xf9, 0x46, 0x13, 0x6e,
+	0xf6, 0x71, 0x17, 0xaa, 0x82, 0x7e, 0x82, 0x19, 0x11, 0xd8, 0x85, 0xb5, 0xca, 0x08, 0x5e, 0x69,
+	0xb3, 0xcc, 0x14, 0xb2, 0x68, 0x11, 0xf2, 0xe9, 0xca, 0xb8, 0xfe, 0x19, 0x33, 0x1d, 0x1b, 0x9f,
+	0x24, 0x6a, 0x3b, 0x9c, 0x79, 0x1d, 0x6a, 0x8d, 0xdf, 0x6a, 0x50, 0x3c, 0x0c, 0x9b, 0xd4, 0x26,
+	0xf7, 0x4f, 0xf9, 0x96, 0x62, 0xe8, 0xdb, 0x50, 0x6c, 0x9d, 0x65, 0x49, 0x06, 0x0f, 0xcc, 0xd0,
+	0x94, 0x70, 0xb1, 0x5a, 0xa9, 0x49, 0xda, 0x61, 0x2a, 0x5d, 0x73, 0x78, 0xc0, 0x59, 0xdb, 0x18,
+	0xbd, 0x03, 0x13, 0xd8, 0x71, 0x28, 0x61, 0x4c, 0xac, 0x72, 0xb2, 0x5a, 0xfe, 0xeb, 0xef, 0xdf,
+	0x9e, 0x55, 0x57, 0xc2, 0xba, 0xe4, 0x1c, 0xc6, 0xd4, 0x0d, 0x1a, 0x3b, 0x63, 0x66, 0x32, 0xb5,
+	0x9a, 0x87, 0x1c, 0x13, 0x46, 0x1a, 0xbf, 0xc9, 0xc2, 0x8d, 0x23, 0x8a, 0x03, 0x76, 0x4a, 0x68,
+	0xe2, 0x87, 0x06, 0xcc, 0x32, 0x12, 0x38, 0x84, 0x5a, 0xd7, 0x67, 0xb8, 0x89, 0x24, 0x64, 0x3b,
+	0x0d, 0xf9, 0x70, 0x8b, 0x12, 0xdb, 0x8d, 0x5c, 0x12, 0xc4, 0x5d, 0xba, 0x32, 0x57, 0xd1, 0x35,
+	0x97, 0xa2, 0x76, 0xa8, 0x5b, 0x80, 0x3c, 0x66, 0x4c, 0x1e, 0x23, 0x59, 0x91, 0x92, 0x13, 0x62,
+	0x5c, 0x73, 0xd0, 0x3c, 0xe4, 0xb0, 0xcf, 0xa7, 0x89, 0x9d, 0xa8, 0x9b, 0x6a, 0x84, 0xaa, 0x90,
+	0x93, 0x76, 0x97, 0xc7, 0x85, 0x41, 0x6f, 0x0c, 0x4d, 0x8a, 0x8e, 0xc0, 0x9b, 0x4a, 0x12, 0xed,
+	0xc0, 0x64, 0x6a, 0x4f, 0x39, 0xf7, 0xca, 0x30, 0x2d, 0x61, 0xe3, 0x6f, 0x59, 0x28, 0xdd, 0xa7,
+	0x0e, 0xa1, 0xdb, 0xae, 0xe7, 0x25, 0xd1, 0x3a, 0x86, 0x82, 0x8f, 0xcf, 0x09, 0xb5, 0x42, 0xce,
+	0x19, 0x9e, 0xbc, 0x7d, 0x1c, 0x27, 0xf0, 0xd4, 0xc5, 0x01, 0x02, 0x48, 0x50, 0xd0, 0x36, 0x8c,
+	0x4b, 0xc0, 0xcc, 0xeb, 0x00, 0xee, 0x8c, 0x99, 0x52, 0x1c, 0x7d, 0x0a, 0x33, 0x9e, 0xfb, 0xa8,
+	0xe9, 0x3a, 0x38, 0x76, 0xc3, 0x40, 0x19, 0x29, 0x8f, 0xbb, 0x95, 0xa1, 0x5e, 0xd8, 0x6b, 0x49,
+	0x09, 0x48, 0x71, 0xda, 0x95, 0xbc, 0x2e, 0x2a, 0xba, 0x0d, 0x85, 0x53, 0xd7, 0xf3, 0x2c, 0x15,
+	0xbe, 0xac, 0x08, 0x1f, 0x70, 0xd2, 0xba, 0x0c, 0xa1, 0xb8, 0x3d, 0xb8, 0x7f, 0x4e, 0x09, 0x11,
+	0x51, 0x44, 0xfc, 0xf6, 0x38, 0x27, 0x74, 0x9b, 0x10, 0xce, 0x8c, 0x53, 0x66, 0x4e, 0x32, 0xe3,
+	0x84, 0xf9, 0x16, 0xa0, 0x38, 0x8c, 0xb1, 0x67, 0x71, 0x34, 0xe2, 0x58, 0x42, 0xaa, 0x3c, 0x21,
+	0x34, 0x94, 0x04, 0x67, 0x5b, 0x30, 0xf6, 0x39, 0xbd, 0x67, 0xb6, 0x80, 0x29, 0xe7, 0x7b, 0x66,
+	0x1f, 0x71, 0x7a, 0xb5, 0x08, 0x85, 0xb8, 0x15, 0x35, 0xe3, 0x47, 0x59, 0xb8, 0xb9, 0x49, 0x3c,
+	0x72, 0x41, 0x28, 0x6e, 0xb4, 0xd5, 0x03, 0xdf, 0x02, 0x48, 0x56, 0x4c, 0xae, 0xb6, 0x01, 0x93,
+	0x10, 0xb7, 0xe0, 0x38, 0x78, 0x78, 0x7a, 0xca, 0x48, 0x1c, 0xbb, 0x41, 0xa3, 0x9c, 0xb9, 0x06,
+	0xf0, 0x16, 0x5c, 0x4f, 0x69, 0x96, 0xed, 0x2d, 0xcd, 0xba, 0x42, 0xa7, 0xf7, 0x84, 0xee, 0x1e,
+	0xcc, 0x4a, 0x97, 0x3e, 0x6a, 0x86, 0x31, 0xb1, 0x1e, 0x35, 0x71, 0x10, 0x37, 0x7d, 0x26, 0xa2,
+	0xa8, 0x9b, 0xd2, 0xdd, 0x1f, 0x72, 0xd6, 0x87, 0x8a, 0x83, 0xe6, 0x20, 0xe7, 0x32, 0xeb, 0xa4,
+	0x79, 0x29, 0x82, 0x99, 0x37, 0xc7, 0x5d, 0x56, 0x6d, 0x5e, 0xf2, 0x1b, 0xcf, 0x65, 0xd6, 0xa9,
+	0x1b, 0x60, 0xcf, 0xe2, 0x06, 0x7a, 0xc4, 0xe7, 0x9b, 0x71, 0x42, 0xcc, 0x99, 0x71, 0xd9, 0x36,
+	0xe7, 0x1c, 0xa6, 0x0c, 0xe3, 0x87, 0x19, 0x40, 0xbd, 0xf9, 0xf7, 0xc5, 0x46, 0xe3, 0x0e, 0x4c,
+	0xf1, 0x92, 0xda, 0xe2, 0x37, 0x69, 0x72, 0x02, 0x16, 0x4d, 0xe0, 0xb4, 0x3a, 0x76, 0x69, 0xcd,
+	0x19, 0xc5, 0xa5, 0x5f, 0x06, 0x90, 0x1e, 0x63, 0xee, 0x53, 0xa2, 0x3c, 0x3a, 0x29, 0x28, 0x87,
+	0xee, 0x53, 0xd2, 0xe6, 0x9e, 0xf1, 0x76, 0xf7, 0x2c, 0x42, 0x9e, 0x35, 0x4f, 0x62, 0xd7, 0x3e,
+	0x67, 0xc2, 0x6f, 0xba, 0x99, 0x8e, 0x8d, 0x7f, 0x67, 0xe0, 0x56, 0xcb, 0xf2, 0xce, 0x42, 0xe2,
+	0xe1, 0x75, 0x5e, 0x6d, 0x5d, 0x17, 0xdb, 0x53, 0x58, 0x92, 0x15, 0x9d, 0x63, 0xb5, 0x16, 0x1d,
+	0x85, 0xcc, 0xe5, 0x01, 0x61, 0xe5, 0xac, 0xa8, 0x8e, 0xdf, 0x1f, 0x59, 0x53, 0x3d, 0xc1, 0xa8,
+	0x2b, 0x08, 0x73, 0x41, 0xc1, 0xf7, 0x70, 0x18, 0x0a, 0xe0, 0x56, 0xa2, 0x5b, 0x5e, 0x18, 0x2d,
+	0xbd, 0xba, 0xd0, 0xfb, 0xf5, 0x91, 0xf5, 0xae, 0x73, 0xf9, 0x54, 0xe7, 0x9c, 0x82, 0xed, 0xa0,
+	0xb2, 0x5d, 0x3d, 0x9f, 0x29, 0x65, 0x8d, 0x7f, 0x4e, 0xc1, 0xec, 0x61, 0x8c, 0x63, 0x72, 0xda,
+	0xf4, 0x44, 0xc6, 0x25, 0x6e, 0x7e, 0x04, 0x05, 0x71, 0x4a, 0x58, 0x91, 0x87, 0xed, 0xa4, 0x3c,
+	0xd9, 0x1d, 0x7e, 0x85, 0xf4, 0xc1, 0xe9, 0x24, 0xd6, 0x39, 0x96, 0x2f, 0x18, 0xd5, 0x4c, 0x59,
+	0xdb, 0xe1, 0xbb, 0x37, 0xa5, 0xa3, 0x10, 0x8a, 0x52, 0xa5, 0x7a, 0x1c, 0xaa, 0x13, 0x7b, 0xe7,
+	0x8a, 0x4a, 0x4d, 0x89, 0x26, 0x0b, 0xd7, 0xb0, 0x8d, 0x82, 0x7e, 0xac, 0xc1, 0x92, 0x1d, 0x06,
+	0x8e, 0xf0, 0x08, 0xf6, 0xac, 0xb6, 0x05, 0x8b, 0xad, 0x2a, 0xaf, 0xdf, 0xfd, 0x57, 0xd7, 0xbf,
+	0xd1, 0x02, 0xed, 0x5e, 0xf7, 0xce, 0x98, 0xb9, 0x60, 0x0f, 0x62, 0x0f, 0xb0, 0x28, 0xa6, 0x6e,
+	0xa3, 0x41, 0x28, 0x71, 0xca, 0xb9, 0xeb, 0xb2, 0xe8, 0x28, 0x81, 0xec, 0x6f, 0x51, 0xca, 0x46,
+	0x3f, 0xd0, 0x60, 0xc1, 0x0b, 0x83, 0x86, 0x15, 0x13, 0xea, 0xf7, 0x78, 0x68, 0xe2, 0x75, 0xd3,
+	0x62, 0x2f, 0x0c, 0x1a, 0x47, 0x84, 0xfa, 0x7d, 0xdc, 0x33, 0xef, 0xf5, 0xe5, 0xa1, 0xef, 0xc2,
+	0x4c, 0x92, 0x1e, 0x2d, 0x03, 0xf2, 0xc2, 0x80, 0xbd, 0x2b, 0x1a, 0x60, 0x92, 0xa8, 0xc3, 0x84,
+	0x52, 0xd8, 0x45, 0x5d, 0xfc, 0x0e, 0x94, 0x07, 0x65, 0x32, 0xda, 0x4c, 0xaa, 0x96, 0xd7, 0x2a,
+	0x83, 0x54, 0xcd, 0xb2, 0xf8, 0x47, 0x0d, 0xe6, 0xfb, 0xe7, 0x2d, 0x7a, 0x08, 0x25, 0xb1, 0x25,
+	0x88, 0xa3, 0x02, 0x90, 0x9e, 0x7a, 0xf7, 0x5e, 0x4d, 0x57, 0xcd, 0x31, 0xa7, 0x15, 0x92, 0x1a,
+	0xa3, 0x0f, 0x20, 0x27, 0x7b, 0x30, 0xea, 0xc1, 0x3e, 0xa0, 0x3e, 0x92, 0x6d, 0x9b, 0x4a, 0xbb,
+	0x61, 0xa6, 0x10, 0x33, 0x95, 0xf8, 0xa2, 0x0d, 0x4b, 0x43, 0xd2, 0xfe, 0x9a, 0x9c, 0xf4, 0xbd,
+	0x5e, 0x25, 0x6d, 0x99, 0x8c, 0x3e, 0x05, 0x94, 0xee, 0x95, 0xab, 0xbb, 0xaa, 0x94, 0x62, 0x29,
+	0x0a, 0xcf, 0x82, 0x41, 0x89, 0x7b, 0x4d, 0x0b, 0xfc, 0x83, 0x06, 0x8b, 0x83, 0x53, 0x13, 0x99,
+	0x30, 0x15, 0x7a, 0xd7, 0xb0, 0x34, 0x08, 0xbd, 0x34, 0x03, 0x36, 0xaf, 0x54, 0x74, 0x2b, 0xc3,
+	0xd3, 0x26, 0x80, 0xbc, 0x57, 0x76, 0xf5, 0x7c, 0xb6, 0xa4, 0x1b, 0xbf, 0xd2, 0x00, 0x89, 0x6b,
+	0xa7, 0xf3, 0xa9, 0x3d, 0x0d, 0x99, 0xb4, 0xa9, 0x92, 0x71, 0xc5, 0x43, 0x88, 0x5d, 0xfa, 0x27,
+	0xa1, 0x27, 0x9f, 0x93, 0xa6, 0x1a, 0xf1, 0xc2, 0xe2, 0x0c, 0x33, 0x4b, 0x36, 0x1b, 0x44, 0xe5,
+	0x91, 0x37, 0x27, 0xcf, 0x30, 0x93, 0xef, 0xe0, 0xce, 0x16, 0x8d, 0xde, 0xd5, 0xa2, 0x79, 0x13,
+	0x66, 0x70, 0x1c, 0xfa, 0xae, 0x6d, 0x51, 0xc2, 0x42, 0xaf, 0xc9, 0x33, 0x46, 0x1c, 0xe8, 0x33,
+	0x66, 0x49, 0x32, 0xcc, 0x94, 0x6e, 0xfc, 0x29, 0x0b, 0x5f, 0x4a, 0xaf, 0xe4, 0x7e, 0xcd, 0x81,
+	0x6e, 0x8b, 0x5f, 0x5e, 0x37, 0xcd, 0x43, 0x8e, 0xd7, 0x32, 0x84, 0x0a, 0xbb, 0x27, 0x4d, 0x35,
+	0x1a, 0x6e, 0xf4, 0x0e, 0xe4, 0x58, 0x8c, 0xe3, 0xa6, 0xac, 0x36, 0xa7, 0x47, 0x09, 0xec, 0x86,
+	0x52, 0x79, 0x28, 0xe4, 0x4c, 0x25, 0x8f, 0xbe, 0x01, 0x4b, 0xaa, 0x72, 0xb5, 0xec, 0x30, 0xb8,
+	0x20, 0x94, 0xf1, 0x87, 0x50, 0xda, 0x9c, 0xc8, 0x09, 0x47, 0x2c, 0xa8, 0x29, 0x1b, 0xe9, 0x8c,
+	0xa4, 0xfd, 0xd2, 0xdf, 0x7d, 0x13, 0xfd, 0xdd, 0xc7, 0xdb, 0x9d, 0x49, 0xe9, 0xc6, 0xeb, 0x26,
+	0x8b, 0xff, 0x12, 0x27, 0x73, 0xd1, 0xbc, 0x91, 0x30, 0xea, 0x84, 0x1e, 0xb9, 0xf6, 0x39, 0x7f,
+	0xb1, 0xb0, 0x98, 0x44, 0x16, 0x6f, 0x5c, 0xb4, 0x8a, 0xeb, 0x49, 0xf9, 0x62, 0xe1, 0x1c, 0xde,
+	0xde, 0x48, 0x4b, 0xeb, 0xaf, 0xc2, 0xb4, 0xac, 0x56, 0xdd, 0xf8, 0xd2, 0x8a, 0x5d, 0x42, 0xcb,
+	0x20, 0x60, 0x8b, 0x29, 0xf5, 0xc8, 0x25, 0xf4, 0xfd, 0x4c, 0x59, 0x33, 0x7e, 0xa6, 0x0f, 0x8d,
+	0xe1, 0xda, 0xff, 0x63, 0xf8, 0x5f, 0x1d, 0x43, 0xf4, 0x00, 0x0a, 0xd2, 0x87, 0x96, 0x68, 0x1f,
+	0x17, 0x84, 0xf3, 0x46, 0xa8, 0xea, 0xbb, 0x62, 0x2e, 0x7a, 0xc8, 0xe0, 0xa7, 0xbf, 0x8d, 0x5f,
+	0x66, 0x60, 0x71, 0xaf, 0x5d, 0xd3, 0x71, 0xc4, 0x08, 0x8d, 0x07, 0xed, 0x6c, 0x04, 0x7a, 0x80,
+	0x7d, 0xa2, 0x4e, 0x22, 0xf1, 0x9b, 0xaf, 0xd7, 0x0d, 0xdc, 0xd8, 0xc5, 0x1e, 0x3f, 0x8b, 0x1a,
+	0xbc, 0xdb, 0x18, 0xf9, 0xea, 0x25, 0x54, 0x52, 0x9c, 0x7d, 0xc1, 0xe0, 0x0d, 0xfd, 0xf7, 0xa0,
+	0xec, 0x63, 0x37, 0x88, 0x49, 0x80, 0x03, 0x9b, 0x58, 0xa7, 0x14, 0xdb, 0xa2, 0x0b, 0xc1, 0x65,
+	0x64, 0xb2, 0xcc, 0xb7, 0xf1, 0xb7, 0x15, 0x5b, 0x4a, 0xce, 0x0b, 0x97, 0x26, 0x95, 0xbf, 0x15,
+	0x84, 0xf2, 0xa2, 0x93, 0x8f, 0x4f, 0x5e, 0x32, 0x9b, 0xb3, 0x7c, 0x46, 0x52, 0xc5, 0x1f, 0x28,
+	0xfe, 0xae, 0x9e, 0xcf, 0x95, 0x26, 0x76, 0xf5, 0xfc, 0x44, 0x29, 0x6f, 0xde, 0x0a, 0x23, 0x12,
+	0x58, 0x5c, 0x01, 0x25, 0x2c, 0xb6, 0xbc, 0xf0, 0x31, 0xa1, 0x96, 0x8d, 0xa3, 0x6e, 0x46, 0x33,
+	0x8a, 0x24, 0xc3, 0xf8, 0x45, 0x06, 0xe6, 0xe4, 0x23, 0x2b, 0xc9, 0xc4, 0xc4, 0x3b, 0xdd, 0x7b,
+	0x44, 0xeb, 0xd9, 0x23, 0xad, 0x74, 0xcf, 0x7c, 0xb1, 0xe9, 0x9e, 0x7d, 0x59, 0xba, 0xf7, 0xcd,
+	0x60, 0xfd, 0x55, 0x32, 0x78, 0xbc, 0x7f, 0x06, 0x1b, 0xbf, 0xd3, 0x60, 0x5e, 0xfa, 0x27, 0x4d,
+	0xb6, 0x21, 0x57, 0x99, 0x3a, 0x32, 0x32, 0x83, 0x8f, 0x8c, 0xec, 0x28, 0x77, 0x95, 0x3e, 0x60,
+	0xa3, 0xf6, 0x6e, 0xa7, 0xf1, 0x3e, 0xdb, 0xc9, 0x60, 0x30, 0x77, 0x44, 0x31, 0xff, 0xba, 0x62,
+	0x92, 0xc7, 0x98, 0x3a, 0xac, 0xf5, 0x7e, 0xbe, 0x11, 0x4b, 0x86, 0x45, 0x25, 0x47, 0x7d, 0xf5,
+	0x59, 0x1d, 0x5a, 0x44, 0xab, 0xb6, 0x6e, 0x07, 0xa6, 0x39, 0x1d, 0x77, 0xa8, 0x30, 0x7e, 0xae,
+	0xc1, 0x6c, 0xbf, 0x89, 0x68, 0x16, 0xc6, 0xc3, 0xc7, 0x01, 0x49, 0x3a, 0xf7, 0x72, 0x80, 0xce,
+	0x61, 0xca, 0x21, 0x41, 0xe8, 0x27, 0xcd, 0x98, 0xcc, 0x35, 0x7f, 0xf9, 0x2a, 0x08, 0x74, 0xd9,
+	0xd7, 0x31, 0xbe, 0xaf, 0xc1, 0xc2, 0xfd, 0x88, 0x04, 0x35, 0x95, 0xff, 0x9d, 0x5d, 0x05, 0x1b,
+	0xe6, 0xba, 0x77, 0x47, 0xfb, 0x17, 0xb1, 0xe1, 0x5d, 0xc3, 0x5e, 0x58, 0xf3, 0x66, 0xd8, 0x43,
+	0x63, 0xc6, 0xaf, 0x35, 0x40, 0xbd, 0x73, 0x47, 0xf9, 0xa0, 0xe8, 0x43, 0xb1, 0xc3, 0xbc, 0x6b,
+	0x77, 0xd5, 0x54, 0xbb, 0xbd, 0xc6, 0xb3, 0x61, 0x67, 0xe6, 0xda, 0xff, 0xc6, 0x99, 0x89, 0xde,
+	0x85, 0x41, 0x27, 0xa5, 0xea, 0x47, 0xcd, 0xb6, 0xfb, 0x64, 0x8f, 0x33, 0x37, 0x70, 0xd4, 0x2b,
+	0x96, 0x9e, 0xa3, 0xe5, 0x89, 0x5e, 0xb1, 0x63, 0xce, 0xdc, 0xc0, 0x91, 0x61, 0xc2, 0xf2, 0x26,
+	0xf1, 0xf0, 0x25, 0x71, 0xf6, 0x09, 0x63, 0xb8, 0x41, 0xb6, 0x9e, 0x10, 0x5b, 0xec, 0xe8, 0x21,
+	0x35, 0xa6, 0xcf, 0x1a, 0xe2, 0x36, 0xb4, 0x9a, 0x34, 0xa9, 0x8d, 0xc1, 0x67, 0x0d, 0x7e, 0xaf,
+	0x1d, 0x53, 0xaf, 0x6a, 0x7e, 0xf6, 0x7c, 0x59, 0x7b, 0xf6, 0x7c, 0x59, 0xfb, 0xd7, 0xf3, 0x65,
+	0xed, 0x27, 0x2f, 0x96, 0xc7, 0x9e, 0xbd, 0x58, 0x1e, 0xfb, 0xfb, 0x8b, 0xe5, 0xb1, 0x87, 0xef,
+	0x8d, 0x9e, 0x10, 0x9d, 0xff, 0x10, 0x70, 0x92, 0x13, 0x8c, 0xaf, 0xfd, 0x67, 0x00, 0x19, 0xb6,
+	0xff, 0xe7, 0x36, 0x20, 0x00, 0x00,
 }
 
 func (m *FundingUpdateV1) Marshal() (dAtA []byte, err error) {
@@ -4464,6 +4523,41 @@ func (m *LiquidityTierUpsertEventV2) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *DelayedMessageExecutionEventV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedMessageExecutionEventV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedMessageExecutionEventV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -5256,6 +5350,22 @@ func (m *LiquidityTierUpsertEventV2) Size() (n int) {
 	return n
 }
 
+func (m *DelayedMessageExecutionEventV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -9741,6 +9851,107 @@ func (m *LiquidityTierUpsertEventV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelayedMessageExecutionEventV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedMessageExecutionEventV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedMessageExecutionEventV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
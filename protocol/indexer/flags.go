@@ -9,14 +9,16 @@ import (
 )
 
 type IndexerFlags struct {
-	KafkaAddrs       []string
-	MaxRetries       int
-	SendOffchainData bool
+	KafkaAddrs           []string
+	MaxRetries           int
+	SendOffchainData     bool
+	MaxTxnEventsPerBlock uint32
 }
 
 // List of default values
 const (
-	DefaultMaxRetries = 3
+	DefaultMaxRetries           = 3
+	DefaultMaxTxnEventsPerBlock = 0
 )
 
 // List of CLI flags
@@ -24,6 +26,7 @@ const (
 	FlagKafkaConnStr         = "indexer-kafka-conn-str"
 	FlagKafkaMaxRetry        = "indexer-kafka-max-retry"
 	FlagSendOffchainData     = "indexer-send-offchain-data"
+	FlagMaxTxnEventsPerBlock = "indexer-max-txn-events-per-block"
 	MsgSenderInstanceForTest = "msgsender-instance-for-test"
 )
 
@@ -57,6 +60,15 @@ func AddIndexerFlagsToCmd(cmd *cobra.Command) {
 				"full node is being restarted from a snapshot and is behind the Indexer's view of the "+
 				"chain during the fast sync process.",
 		)
+	cmd.
+		Flags().
+		Uint32(
+			FlagMaxTxnEventsPerBlock,
+			DefaultMaxTxnEventsPerBlock,
+			"Number of indexer transaction events per block above which an error is logged and a metric is "+
+				"emitted. Events are never dropped since the Indexer must receive every onchain event. A value "+
+				"of 0 disables the check.",
+		)
 }
 
 // GetIndexerFlagValuesFromOptions gets values for connecting to Kafka from the `AppOptions`
@@ -68,14 +80,16 @@ func GetIndexerFlagValuesFromOptions(
 	kafkaConnStr, err := cast.ToStringE(option)
 	if option == nil || err != nil {
 		return IndexerFlags{
-			KafkaAddrs:       []string{},
-			MaxRetries:       DefaultMaxRetries,
-			SendOffchainData: false,
+			KafkaAddrs:           []string{},
+			MaxRetries:           DefaultMaxRetries,
+			SendOffchainData:     false,
+			MaxTxnEventsPerBlock: DefaultMaxTxnEventsPerBlock,
 		}
 	}
 
 	maxRetries := cast.ToInt(appOpts.Get(FlagKafkaMaxRetry))
 	sendOffchainData := cast.ToBool(appOpts.Get(FlagSendOffchainData))
+	maxTxnEventsPerBlock := cast.ToUint32(appOpts.Get(FlagMaxTxnEventsPerBlock))
 
 	var kafkaAddrs []string
 	if kafkaConnStr == "" {
@@ -85,8 +99,9 @@ func GetIndexerFlagValuesFromOptions(
 	}
 
 	return IndexerFlags{
-		KafkaAddrs:       kafkaAddrs,
-		MaxRetries:       maxRetries,
-		SendOffchainData: sendOffchainData,
+		KafkaAddrs:           kafkaAddrs,
+		MaxRetries:           maxRetries,
+		SendOffchainData:     sendOffchainData,
+		MaxTxnEventsPerBlock: maxTxnEventsPerBlock,
 	}
 }
@@ -27,6 +27,9 @@ func TestAddIndexerFlagsToCommand(t *testing.T) {
 		fmt.Sprintf("Has %s flag", indexer.FlagSendOffchainData): {
 			flagName: indexer.FlagSendOffchainData,
 		},
+		fmt.Sprintf("Has %s flag", indexer.FlagMaxTxnEventsPerBlock): {
+			flagName: indexer.FlagMaxTxnEventsPerBlock,
+		},
 	}
 
 	for name, tc := range tests {
@@ -39,10 +42,11 @@ func TestAddIndexerFlagsToCommand(t *testing.T) {
 func TestGetIndexerFlagValuesFromOptions(t *testing.T) {
 	tests := map[string]struct {
 		// Parameters.
-		kafkaConnStr     string
-		maxRetries       int
-		nilConnStr       bool
-		sendOffchainData bool
+		kafkaConnStr         string
+		maxRetries           int
+		nilConnStr           bool
+		sendOffchainData     bool
+		maxTxnEventsPerBlock uint32
 
 		// Expectations.
 		expectedIndexerFlags indexer.IndexerFlags
@@ -91,6 +95,19 @@ func TestGetIndexerFlagValuesFromOptions(t *testing.T) {
 				SendOffchainData: false,
 			},
 		},
+		"Sets MaxTxnEventsPerBlock": {
+			kafkaConnStr:         "",
+			maxRetries:           0,
+			nilConnStr:           false,
+			sendOffchainData:     false,
+			maxTxnEventsPerBlock: 1_000,
+			expectedIndexerFlags: indexer.IndexerFlags{
+				KafkaAddrs:           []string{},
+				MaxRetries:           0,
+				SendOffchainData:     false,
+				MaxTxnEventsPerBlock: 1_000,
+			},
+		},
 		"Sets KafkaAddrs to empty slice and MaxRetries to default if kafkaConnStr is nil": {
 			kafkaConnStr:     "kafka:9092",
 			maxRetries:       5,
@@ -114,6 +131,7 @@ func TestGetIndexerFlagValuesFromOptions(t *testing.T) {
 			}
 			optsMap[indexer.FlagKafkaMaxRetry] = tc.maxRetries
 			optsMap[indexer.FlagSendOffchainData] = tc.sendOffchainData
+			optsMap[indexer.FlagMaxTxnEventsPerBlock] = tc.maxTxnEventsPerBlock
 			mockOpts := mocks.AppOptions{}
 			mockOpts.On("Get", mock.AnythingOfType("string")).
 				Return(func(key string) interface{} {
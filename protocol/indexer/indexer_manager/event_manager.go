@@ -2,8 +2,10 @@ package indexer_manager
 
 import (
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/msgsender"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
 )
 
 type IndexerEventManager interface {
@@ -11,7 +13,10 @@ type IndexerEventManager interface {
 	AddTxnEvent(ctx sdk.Context, subType string, version uint32, dataByes []byte)
 	SendOffchainData(message msgsender.Message)
 	SendOnchainData(block *IndexerTendermintBlock)
+	GetPendingEventCount(ctx sdk.Context) int
+	GetPendingEventBytes(ctx sdk.Context) int
 	ProduceBlock(ctx sdk.Context) *IndexerTendermintBlock
+	ProduceBlockFiltered(ctx sdk.Context, subtypes []string) *IndexerTendermintBlock
 	AddBlockEvent(
 		ctx sdk.Context,
 		subType string,
@@ -29,17 +34,22 @@ type indexerEventManagerImpl struct {
 	indexerMessageSender           msgsender.IndexerMessageSender
 	indexerEventsTransientStoreKey storetypes.StoreKey
 	sendOffchainData               bool
+	// maxTxnEventsPerBlock is the number of transaction events per block above which an error is logged
+	// and a metric is emitted. Events are never dropped. 0 disables the check.
+	maxTxnEventsPerBlock uint32
 }
 
 func NewIndexerEventManager(
 	indexerMessageSender msgsender.IndexerMessageSender,
 	indexerEventsTransientStoreKey storetypes.StoreKey,
 	sendOffchainData bool,
+	maxTxnEventsPerBlock uint32,
 ) IndexerEventManager {
 	return &indexerEventManagerImpl{
 		indexerMessageSender:           indexerMessageSender,
 		indexerEventsTransientStoreKey: indexerEventsTransientStoreKey,
 		sendOffchainData:               sendOffchainData,
+		maxTxnEventsPerBlock:           maxTxnEventsPerBlock,
 	}
 }
 
@@ -65,15 +75,36 @@ func (i *indexerEventManagerImpl) SendOnchainData(block *IndexerTendermintBlock)
 }
 
 // AddTxnEvent adds a transaction event to the context's transient store of indexer events.
+// Events are never dropped since the Indexer must receive every onchain event. Instead, a metric is
+// emitted for each transaction event above `maxTxnEventsPerBlock` in the block, and an error is logged
+// the first time the limit is exceeded.
 func (i *indexerEventManagerImpl) AddTxnEvent(
 	ctx sdk.Context,
 	subType string,
 	version uint32,
 	dataBytes []byte,
 ) {
-	if i.Enabled() {
-		addTxnEvent(ctx, subType, version, i.indexerEventsTransientStoreKey, dataBytes)
+	if !i.Enabled() {
+		return
+	}
+
+	addTxnEvent(ctx, subType, version, i.indexerEventsTransientStoreKey, dataBytes)
+
+	if i.maxTxnEventsPerBlock == 0 {
+		return
+	}
+	numTxnEvents := getNumIndexerTxnEvents(ctx, i.indexerEventsTransientStoreKey)
+	if numTxnEvents <= i.maxTxnEventsPerBlock {
+		return
+	}
+	if numTxnEvents == i.maxTxnEventsPerBlock+1 {
+		ctx.Logger().Error(
+			"Number of indexer transaction events in block exceeds max txn events per block",
+			"subtype", subType,
+			"max_txn_events_per_block", i.maxTxnEventsPerBlock,
+		)
 	}
+	telemetry.IncrCounter(1, ModuleName, metrics.ExceededMaxIndexerTxnEvents)
 }
 
 // ClearEvents clears all events in the context's transient store of indexer events.
@@ -98,6 +129,24 @@ func (i *indexerEventManagerImpl) AddBlockEvent(
 	}
 }
 
+// GetPendingEventCount returns the number of indexer events accumulated in the context's transient
+// store that have not yet been produced into a block.
+func (i *indexerEventManagerImpl) GetPendingEventCount(ctx sdk.Context) int {
+	if i.Enabled() {
+		return int(getNumIndexerEvents(ctx, i.indexerEventsTransientStoreKey))
+	}
+	return 0
+}
+
+// GetPendingEventBytes returns the total size in bytes of the indexer events accumulated in the
+// context's transient store that have not yet been produced into a block.
+func (i *indexerEventManagerImpl) GetPendingEventBytes(ctx sdk.Context) int {
+	if i.Enabled() {
+		return getIndexerEventsBytes(ctx, i.indexerEventsTransientStoreKey)
+	}
+	return 0
+}
+
 // ProduceBlock returns an `IndexerTendermintBlock` containing all the indexer events in the block.
 // It should only be called in EndBlocker when the transient store contains all onchain events from
 // a ready-to-be-committed block.
@@ -105,7 +154,24 @@ func (i *indexerEventManagerImpl) ProduceBlock(
 	ctx sdk.Context,
 ) *IndexerTendermintBlock {
 	if i.Enabled() {
-		return produceBlock(ctx, i.indexerEventsTransientStoreKey)
+		return produceBlock(ctx, i.indexerEventsTransientStoreKey, nil)
+	}
+	return nil
+}
+
+// ProduceBlockFiltered returns an `IndexerTendermintBlock` containing only the indexer events in the
+// block whose subtype is in `subtypes`. Events keep their relative ordering and `EventIndex` values
+// are assigned within the filtered set of events.
+func (i *indexerEventManagerImpl) ProduceBlockFiltered(
+	ctx sdk.Context,
+	subtypes []string,
+) *IndexerTendermintBlock {
+	if i.Enabled() {
+		subtypeSet := make(map[string]struct{}, len(subtypes))
+		for _, subtype := range subtypes {
+			subtypeSet[subtype] = struct{}{}
+		}
+		return produceBlock(ctx, i.indexerEventsTransientStoreKey, subtypeSet)
 	}
 	return nil
 }
@@ -104,7 +104,7 @@ func assertIsEnabled(t *testing.T, isEnabled bool) {
 	storeKey := storetypes.NewTransientStoreKey(indexer_manager.TransientStoreKey)
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(isEnabled)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, isEnabled)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, isEnabled, 0)
 	require.Equal(t, isEnabled, indexerEventManager.Enabled())
 }
 
@@ -118,7 +118,7 @@ func TestSendOffchainData(t *testing.T) {
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
 	mockMsgSender.On("SendOffchainData", mock.Anything).Return(nil)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	var message msgsender.Message
 	indexerEventManager.SendOffchainData(message)
 	mockMsgSender.AssertExpectations(t)
@@ -130,7 +130,7 @@ func TestSendOnchainData(t *testing.T) {
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
 	mockMsgSender.On("SendOnchainData", mock.Anything).Return(nil)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.SendOnchainData(indexerTendermintBlock)
 	mockMsgSender.AssertExpectations(t)
 }
@@ -144,7 +144,7 @@ func TestProduceBlockBasicTxnEvent(t *testing.T) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.AddTxnEvent(
 		ctx,
 		indexerevents.SubtypeOrderFill,
@@ -172,7 +172,7 @@ func TestProduceBlockBasicBlockEvent(t *testing.T) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.AddBlockEvent(
 		ctx,
 		indexerevents.SubtypeFundingValues,
@@ -201,7 +201,7 @@ func TestProduceBlockMultipleTxnEvents(t *testing.T) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.AddTxnEvent(
 		ctx,
 		indexerevents.SubtypeOrderFill,
@@ -251,7 +251,7 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.AddTxnEvent(
 		ctx,
 		indexerevents.SubtypeOrderFill,
@@ -332,6 +332,150 @@ func TestProduceBlockMultipleTxnAndBlockEvents(t *testing.T) {
 	require.Equal(t, ConsumedGas, ctx.GasMeter().GasConsumed())
 }
 
+func TestProduceBlockFilteredMultipleTxnAndBlockEvents(t *testing.T) {
+	ctx, stateStore, db := sdk.NewSdkContextWithMultistore()
+	storeKey := storetypes.NewTransientStoreKey(indexer_manager.TransientStoreKey)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeTransient, db)
+	ctx = ctx.WithBlockTime(BlockTime).WithBlockHeight(BlockHeight).WithTxBytes(constants.TestTxBytes)
+	ctx.GasMeter().ConsumeGas(ConsumedGas, "beforeWrite")
+	require.NoError(t, stateStore.LoadLatestVersion())
+	mockMsgSender := &mocks.IndexerMessageSender{}
+	mockMsgSender.On("Enabled").Return(true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
+	indexerEventManager.AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeOrderFill,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&OrderFillEvent,
+		),
+	)
+	indexerEventManager.AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeSubaccountUpdate,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&SubaccountEvent,
+		),
+	)
+	ctx = ctx.WithTxBytes(constants.TestTxBytes1)
+	indexerEventManager.AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeTransfer,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&TransferEvent,
+		),
+	)
+	indexerEventManager.AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeFundingValues,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&FundingRateAndIndexEvent,
+		),
+	)
+	// Filtered out block event between the two funding events should not affect `EventIndex`.
+	indexerEventManager.AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeMarket,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&FundingRateAndIndexEvent,
+		),
+	)
+	indexerEventManager.AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeFundingValues,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&FundingPremiumSampleEvent,
+		),
+	)
+
+	block := indexerEventManager.ProduceBlockFiltered(ctx, []string{indexerevents.SubtypeFundingValues})
+	require.Len(t, block.Events, 2)
+	require.Equal(t, ExpectedEvent3, *block.Events[0])
+	require.Equal(t, ExpectedEvent4, *block.Events[1])
+	require.Empty(t, block.TxHashes)
+	require.Equal(t, uint32(BlockHeight), block.Height)
+	require.Equal(t, BlockTime, block.Time)
+
+	// Transaction indices are assigned within the filtered set of transactions.
+	block = indexerEventManager.ProduceBlockFiltered(ctx, []string{indexerevents.SubtypeTransfer})
+	require.Len(t, block.Events, 1)
+	expectedTransferEvent := ExpectedEvent2
+	expectedTransferEvent.OrderingWithinBlock = &indexer_manager.IndexerTendermintEvent_TransactionIndex{
+		TransactionIndex: 0,
+	}
+	require.Equal(t, expectedTransferEvent, *block.Events[0])
+	require.Equal(t, []string{string(constants.TestTxHashString1)}, block.TxHashes)
+
+	block = indexerEventManager.ProduceBlockFiltered(ctx, []string{})
+	require.Empty(t, block.Events)
+	require.Empty(t, block.TxHashes)
+
+	// Filtering does not consume the events.
+	block = indexerEventManager.ProduceBlock(ctx)
+	require.Len(t, block.Events, 6)
+	require.Equal(t, ConsumedGas, ctx.GasMeter().GasConsumed())
+}
+
+func TestAddTxnEventMaxTxnEventsPerBlock(t *testing.T) {
+	ctx, stateStore, db := sdk.NewSdkContextWithMultistore()
+	storeKey := storetypes.NewTransientStoreKey(indexer_manager.TransientStoreKey)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeTransient, db)
+	ctx = ctx.WithBlockTime(BlockTime).WithBlockHeight(BlockHeight).WithTxBytes(constants.TestTxBytes)
+	ctx.GasMeter().ConsumeGas(ConsumedGas, "beforeWrite")
+	require.NoError(t, stateStore.LoadLatestVersion())
+	mockMsgSender := &mocks.IndexerMessageSender{}
+	mockMsgSender.On("Enabled").Return(true)
+	maxTxnEventsPerBlock := uint32(3)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(
+		mockMsgSender,
+		storeKey,
+		true,
+		maxTxnEventsPerBlock,
+	)
+
+	// Block events are not counted towards the limit.
+	indexerEventManager.AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeFundingValues,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		EventVersion,
+		indexer_manager.GetBytes(
+			&FundingRateAndIndexEvent,
+		),
+	)
+
+	// Transaction events above the limit are still added to the block.
+	numTxnEvents := 5
+	for i := 0; i < numTxnEvents; i++ {
+		indexerEventManager.AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeOrderFill,
+			EventVersion,
+			indexer_manager.GetBytes(
+				&OrderFillEvent,
+			),
+		)
+	}
+
+	block := indexerEventManager.ProduceBlock(ctx)
+	require.Len(t, block.Events, numTxnEvents+1)
+	for i, event := range block.Events[:numTxnEvents] {
+		expectedEvent := ExpectedEvent0
+		expectedEvent.EventIndex = uint32(i)
+		require.Equal(t, expectedEvent, *event)
+	}
+	require.Equal(t, ExpectedEvent3, *block.Events[numTxnEvents])
+	require.Equal(t, ConsumedGas, ctx.GasMeter().GasConsumed())
+}
+
 func TestClearEvents(t *testing.T) {
 	ctx, stateStore, db := sdk.NewSdkContextWithMultistore()
 	storeKey := storetypes.NewTransientStoreKey(indexer_manager.TransientStoreKey)
@@ -341,7 +485,7 @@ func TestClearEvents(t *testing.T) {
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true)
+	indexerEventManager := indexer_manager.NewIndexerEventManager(mockMsgSender, storeKey, true, 0)
 	indexerEventManager.AddTxnEvent(
 		ctx,
 		indexerevents.SubtypeOrderFill,
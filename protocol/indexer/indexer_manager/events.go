@@ -22,6 +22,10 @@ const (
 	IndexerEventsCountKey = "c"
 	IndexerEventsPrefix   = "e"
 
+	// IndexerTxnEventsCountKey is the key to retrieve the count of the transaction events
+	// among the indexer events within the last block.
+	IndexerTxnEventsCountKey = "t"
+
 	ModuleName = "indexer_events"
 )
 
@@ -33,6 +37,37 @@ func getIndexerEventsCount(noGasCtx sdk.Context, store storetypes.KVStore) uint3
 	return binary.BigEndian.Uint32(countsBytes)
 }
 
+// getNumIndexerEvents returns the number of events in the context's transient store of indexer events.
+func getNumIndexerEvents(ctx sdk.Context, storeKey storetypes.StoreKey) uint32 {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	return getIndexerEventsCount(noGasCtx, noGasCtx.TransientStore(storeKey))
+}
+
+// getNumIndexerTxnEvents returns the number of transaction events in the context's transient store of
+// indexer events.
+func getNumIndexerTxnEvents(ctx sdk.Context, storeKey storetypes.StoreKey) uint32 {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	countBytes := noGasCtx.TransientStore(storeKey).Get([]byte(IndexerTxnEventsCountKey))
+	if countBytes == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(countBytes)
+}
+
+// getIndexerEventsBytes returns the total size in bytes of the marshaled events in the context's
+// transient store of indexer events.
+func getIndexerEventsBytes(ctx sdk.Context, storeKey storetypes.StoreKey) int {
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := noGasCtx.TransientStore(storeKey)
+	count := getIndexerEventsCount(noGasCtx, store)
+	store = prefix.NewStore(store, []byte(IndexerEventsPrefix))
+	numBytes := 0
+	for i := uint32(0); i < count; i++ {
+		numBytes += len(store.Get(lib.Uint32ToKey(i)))
+	}
+	return numBytes
+}
+
 func getIndexerEvents(noGasCtx sdk.Context, storeKey storetypes.StoreKey) []*IndexerTendermintEventWrapper {
 	store := noGasCtx.TransientStore(storeKey)
 	count := getIndexerEventsCount(noGasCtx, store)
@@ -78,6 +113,12 @@ func addTxnEvent(
 		TxnHash: string(lib.GetTxHash(ctx.TxBytes())),
 	}
 	addEvent(ctx, event, storeKey)
+
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	noGasCtx.TransientStore(storeKey).Set(
+		[]byte(IndexerTxnEventsCountKey),
+		lib.Uint32ToKey(getNumIndexerTxnEvents(noGasCtx, storeKey)+1),
+	)
 }
 
 // addBlockEvent adds a block event to the context's transient store of indexer events.
@@ -128,11 +169,17 @@ func clearEvents(
 	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
 	store := noGasCtx.TransientStore(storeKey)
 	store.Delete([]byte(IndexerEventsCountKey))
+	store.Delete([]byte(IndexerTxnEventsCountKey))
 }
 
 // produceBlock returns the block. It should only be called in EndBlocker when the
 // transient store contains all onchain events from a ready-to-be-committed block.
-func produceBlock(ctx sdk.Context, storeKey storetypes.StoreKey) *IndexerTendermintBlock {
+// If `subtypes` is non-nil, only events whose subtype is in `subtypes` are included.
+func produceBlock(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	subtypes map[string]struct{},
+) *IndexerTendermintBlock {
 	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
 	txHashes := []string{}
 	txEventsMap := make(map[string][]*IndexerTendermintEvent)
@@ -142,6 +189,11 @@ func produceBlock(ctx sdk.Context, storeKey storetypes.StoreKey) *IndexerTenderm
 	events := getIndexerEvents(noGasCtx, storeKey)
 
 	for _, event := range events {
+		if subtypes != nil {
+			if _, ok := subtypes[event.Event.Subtype]; !ok {
+				continue
+			}
+		}
 		switch event.Event.OrderingWithinBlock.(type) {
 		case *IndexerTendermintEvent_BlockEvent_:
 			blockEvents = append(blockEvents, event.Event)
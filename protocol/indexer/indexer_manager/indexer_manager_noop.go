@@ -7,6 +7,7 @@ func NewIndexerEventManagerNoop() IndexerEventManager {
 		msgsender.NewIndexerMessageSenderNoop(),
 		nil,
 		false,
+		0,
 	)
 }
 
@@ -15,5 +16,6 @@ func NewIndexerEventManagerNoopEnabled() IndexerEventManager {
 		msgsender.NewIndexerMessageSenderNoopEnabled(),
 		nil,
 		false,
+		0,
 	)
 }
@@ -30,6 +30,8 @@ func ConvertOrderRemovalReasonToIndexerOrderRemovalReason(
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_IMMEDIATE_OR_CANCEL_WOULD_REST_ON_BOOK
 	case clobtypes.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
 		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS
+	case clobtypes.OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION:
+		reason = sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION
 	default:
 		panic("ConvertOrderRemovalReasonToIndexerOrderRemovalReason: unspecified removal reason not allowed")
 	}
@@ -66,6 +68,12 @@ func GetOrderRemovalReason(
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_REDUCE_ONLY_RESIZE, nil
 	case clobtypes.ViolatesIsolatedSubaccountConstraints:
 		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS, nil
+	case clobtypes.OrderbookDepthCapReached:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP, nil
+	case clobtypes.ViolatesStaleMarketPriceConstraints:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_STALE_MARKET_PRICE, nil
+	case clobtypes.ViolatesMinCollateralToOpenPosition:
+		return sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION, nil
 	default:
 		return 0, fmt.Errorf("unrecognized order status %d and error \"%w\"", orderStatus, orderError)
 	}
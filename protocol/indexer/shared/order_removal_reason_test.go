@@ -40,6 +40,21 @@ func TestGetOrderRemovalReason_Success(t *testing.T) {
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS,
 			expectedErr:    nil,
 		},
+		"Gets order removal reason for order status OrderbookDepthCapReached": {
+			orderStatus:    clobtypes.OrderbookDepthCapReached,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status ViolatesStaleMarketPriceConstraints": {
+			orderStatus:    clobtypes.ViolatesStaleMarketPriceConstraints,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_STALE_MARKET_PRICE,
+			expectedErr:    nil,
+		},
+		"Gets order removal reason for order status ViolatesMinCollateralToOpenPosition": {
+			orderStatus:    clobtypes.ViolatesMinCollateralToOpenPosition,
+			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION,
+			expectedErr:    nil,
+		},
 		"Gets order removal reason for order error ErrFokOrderCouldNotBeFullyFilled": {
 			orderError:     clobtypes.ErrFokOrderCouldNotBeFullyFilled,
 			expectedReason: sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED,
@@ -73,6 +73,23 @@ const (
 	// The order has been removed since filling it would lead to the subaccount
 	// violating isolated subaccount constraints.
 	OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS OrderRemovalReason = 15
+	// The order has been removed since its orderbook has reached the maximum
+	// number of resting orders.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP OrderRemovalReason = 16
+	// The order was an untriggered conditional order that has been removed
+	// since the market its trigger references has been delisted or no longer
+	// exists.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_DELISTED OrderRemovalReason = 17
+	// The stateful order has been forcefully canceled by the protocol, bypassing
+	// the usual order cancellation validation.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_FORCE_CANCELED OrderRemovalReason = 18
+	// The order has been removed since filling it would increase the risk of
+	// the subaccount while the oracle price of its market is stale.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_STALE_MARKET_PRICE OrderRemovalReason = 19
+	// The order has been removed since filling it would open a new position for
+	// a subaccount with less than the minimum collateral required to open a
+	// position.
+	OrderRemovalReason_ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION OrderRemovalReason = 20
 )
 
 var OrderRemovalReason_name = map[int32]string{
@@ -92,6 +109,11 @@ var OrderRemovalReason_name = map[int32]string{
 	13: "ORDER_REMOVAL_REASON_EQUITY_TIER",
 	14: "ORDER_REMOVAL_REASON_FINAL_SETTLEMENT",
 	15: "ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS",
+	16: "ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP",
+	17: "ORDER_REMOVAL_REASON_MARKET_DELISTED",
+	18: "ORDER_REMOVAL_REASON_FORCE_CANCELED",
+	19: "ORDER_REMOVAL_REASON_STALE_MARKET_PRICE",
+	20: "ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION",
 }
 
 var OrderRemovalReason_value = map[string]int32{
@@ -111,6 +133,11 @@ var OrderRemovalReason_value = map[string]int32{
 	"ORDER_REMOVAL_REASON_EQUITY_TIER":                              13,
 	"ORDER_REMOVAL_REASON_FINAL_SETTLEMENT":                         14,
 	"ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS": 15,
+	"ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP":                      16,
+	"ORDER_REMOVAL_REASON_MARKET_DELISTED":                          17,
+	"ORDER_REMOVAL_REASON_FORCE_CANCELED":                           18,
+	"ORDER_REMOVAL_REASON_STALE_MARKET_PRICE":                       19,
+	"ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION":          20,
 }
 
 func (x OrderRemovalReason) String() string {
@@ -130,37 +157,41 @@ func init() {
 }
 
 var fileDescriptor_0d5eea5cab8c58ba = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4d, 0x6f, 0x13, 0x3f,
-	0x10, 0xc6, 0x93, 0xff, 0x9f, 0x16, 0x30, 0x6f, 0x96, 0xaf, 0xc0, 0xaa, 0x85, 0x96, 0x96, 0xb7,
-	0x04, 0x09, 0x84, 0x10, 0xaf, 0x72, 0xec, 0x89, 0x64, 0xd5, 0xb1, 0xc3, 0xd8, 0x5b, 0x92, 0x5c,
-	0xac, 0x34, 0x59, 0x91, 0x48, 0x6d, 0xb6, 0xda, 0x84, 0xaa, 0xfd, 0x16, 0x7c, 0x2c, 0x8e, 0x3d,
-	0x72, 0x44, 0xc9, 0x77, 0xe0, 0x8c, 0xba, 0x09, 0x08, 0xa4, 0xdd, 0xcb, 0x6a, 0x25, 0x3f, 0xbf,
-	0x67, 0x1e, 0x7b, 0x66, 0xc8, 0xb3, 0xe1, 0xd9, 0xf0, 0xf4, 0x38, 0x4b, 0x67, 0xe9, 0x20, 0x3d,
-	0xac, 0x8f, 0x27, 0xc3, 0xe4, 0x34, 0xc9, 0xea, 0xd3, 0x51, 0x3f, 0x4b, 0x86, 0xf5, 0x2c, 0x39,
-	0x4a, 0x4f, 0xfa, 0x87, 0x21, 0x4b, 0xfa, 0xd3, 0x74, 0x52, 0xcb, 0x65, 0xec, 0xf6, 0xdf, 0x44,
-	0x6d, 0x45, 0xd4, 0x96, 0xc4, 0xa3, 0x9f, 0x6b, 0x84, 0xd9, 0x6c, 0x98, 0x64, 0xb8, 0x44, 0x31,
-	0x27, 0xd9, 0x16, 0xd9, 0xb0, 0x28, 0x01, 0x03, 0x42, 0xcb, 0xee, 0x73, 0x1d, 0x10, 0xb8, 0xb3,
-	0x26, 0xc4, 0xc6, 0xb5, 0x41, 0xa8, 0xa6, 0x02, 0x49, 0x2b, 0x6c, 0x83, 0xdc, 0x29, 0x54, 0x41,
-	0xa7, 0xad, 0x10, 0x24, 0xad, 0xb2, 0x07, 0xe4, 0x5e, 0xb1, 0x8f, 0x03, 0x0c, 0x82, 0x1b, 0x01,
-	0x1a, 0x24, 0xfd, 0x8f, 0x3d, 0x21, 0xbb, 0x25, 0xf5, 0x24, 0xa0, 0xb0, 0x5a, 0x73, 0x0f, 0xc8,
-	0xb5, 0xea, 0x81, 0xa4, 0xff, 0xb3, 0x1d, 0x72, 0xbf, 0x50, 0xad, 0x8c, 0x07, 0x34, 0x5c, 0x07,
-	0x40, 0xb4, 0x48, 0x2f, 0xb1, 0x87, 0x64, 0xbb, 0x50, 0xe8, 0x40, 0x37, 0x83, 0x47, 0x2e, 0x61,
-	0x25, 0x5d, 0x63, 0xaf, 0xc9, 0xcb, 0x42, 0x69, 0xdb, 0x3a, 0x1f, 0xac, 0xd1, 0xdd, 0xf0, 0xc9,
-	0xc6, 0x5a, 0x06, 0x81, 0xd6, 0xb9, 0xd0, 0xe2, 0x7b, 0x80, 0x21, 0x07, 0xe8, 0x3a, 0xfb, 0x40,
-	0xde, 0x14, 0xe7, 0x69, 0xb5, 0x40, 0x2a, 0xee, 0x21, 0xd8, 0xdf, 0xb7, 0x5d, 0xb9, 0x20, 0xe4,
-	0xae, 0xa1, 0x61, 0xed, 0x1e, 0xbd, 0xcc, 0xde, 0x92, 0x57, 0x85, 0x06, 0x4d, 0xbb, 0xb7, 0x2c,
-	0x12, 0x44, 0x8e, 0x19, 0xeb, 0x43, 0x03, 0x42, 0x33, 0xd6, 0xba, 0x9b, 0x7f, 0x41, 0xd2, 0x2b,
-	0xec, 0x31, 0xd9, 0x29, 0xa4, 0x11, 0x64, 0x2c, 0x60, 0x19, 0x1e, 0xc1, 0xa9, 0x1e, 0xd0, 0xab,
-	0x6c, 0x97, 0x6c, 0x95, 0xbc, 0x9d, 0x84, 0x0e, 0xe0, 0x9f, 0xde, 0x11, 0xb6, 0x49, 0xee, 0x96,
-	0xd8, 0xb6, 0x35, 0x17, 0x20, 0xe9, 0x35, 0xb6, 0x4d, 0x36, 0x8b, 0x73, 0x2f, 0x03, 0xaa, 0x3c,
-	0xe0, 0xf5, 0xd2, 0x69, 0x82, 0x8f, 0xb1, 0xf2, 0xdd, 0xe0, 0x15, 0x20, 0xbd, 0x51, 0xda, 0xac,
-	0xa6, 0xba, 0x68, 0xa9, 0x03, 0xef, 0x35, 0xb4, 0xc0, 0x78, 0x7a, 0x93, 0x71, 0xf2, 0xae, 0x50,
-	0xba, 0xaf, 0xec, 0xc5, 0xa4, 0xb8, 0xa0, 0x5c, 0xfe, 0x23, 0x83, 0x8b, 0x1b, 0x5c, 0x08, 0x1b,
-	0x1b, 0x1f, 0x84, 0x35, 0xce, 0x23, 0x57, 0xc6, 0x3b, 0x7a, 0xab, 0xd1, 0xf9, 0x36, 0x8f, 0xaa,
-	0xe7, 0xf3, 0xa8, 0xfa, 0x63, 0x1e, 0x55, 0xbf, 0x2e, 0xa2, 0xca, 0xf9, 0x22, 0xaa, 0x7c, 0x5f,
-	0x44, 0x95, 0xde, 0xfb, 0xcf, 0xe3, 0xd9, 0xe8, 0xcb, 0x41, 0x6d, 0x90, 0x1e, 0xd5, 0xff, 0x59,
-	0xb6, 0x93, 0x17, 0x4f, 0x07, 0xa3, 0xfe, 0x78, 0x52, 0x2f, 0x5b, 0xbf, 0xd9, 0xd9, 0x71, 0x32,
-	0x3d, 0x58, 0xcf, 0x8f, 0x9f, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x04, 0x74, 0x55, 0x04, 0xaa,
-	0x03, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x5d, 0x4f, 0x13, 0x41,
+	0x14, 0x6d, 0xfd, 0x00, 0x1d, 0xbf, 0xc6, 0xd1, 0x37, 0xb5, 0x01, 0x05, 0xc1, 0xaf, 0xd6, 0x44,
+	0x62, 0x88, 0x9f, 0x99, 0xce, 0xdc, 0xc6, 0x49, 0xa7, 0x33, 0xeb, 0x9d, 0x59, 0x04, 0x5e, 0x6e,
+	0x0a, 0xdd, 0x08, 0x09, 0x50, 0xb2, 0x20, 0x81, 0x7f, 0xe1, 0xcf, 0xf2, 0x91, 0x47, 0x9f, 0x8c,
+	0x81, 0x3f, 0x62, 0xd8, 0x82, 0x68, 0xb2, 0xfb, 0xb2, 0xd9, 0xec, 0x9e, 0x73, 0xee, 0xd9, 0x39,
+	0x67, 0x2f, 0x7b, 0x39, 0x38, 0x18, 0xec, 0x6f, 0xe7, 0xc3, 0xdd, 0xe1, 0xea, 0x70, 0xa3, 0xb5,
+	0xbe, 0x35, 0xc8, 0xf6, 0xb3, 0xbc, 0xb5, 0xb3, 0xd6, 0xcf, 0xb3, 0x41, 0x2b, 0xcf, 0x36, 0x87,
+	0x7b, 0xfd, 0x0d, 0xca, 0xb3, 0xfe, 0xce, 0x70, 0xab, 0x59, 0xc0, 0xc4, 0xbd, 0x7f, 0x19, 0xcd,
+	0x53, 0x46, 0x73, 0xc4, 0x78, 0xfa, 0x6b, 0x9c, 0x09, 0x9f, 0x0f, 0xb2, 0x1c, 0x47, 0x54, 0x2c,
+	0x98, 0x62, 0x8a, 0x4d, 0x78, 0xd4, 0x80, 0x84, 0xd0, 0xf3, 0x0b, 0xd2, 0x12, 0x82, 0x0c, 0xde,
+	0x51, 0xea, 0x42, 0x02, 0xca, 0x74, 0x0c, 0x68, 0x5e, 0x13, 0x13, 0xec, 0x7e, 0x29, 0x0a, 0x16,
+	0x13, 0x83, 0xa0, 0x79, 0x5d, 0x3c, 0x66, 0x0f, 0xcb, 0x75, 0x02, 0x20, 0x29, 0xe9, 0x14, 0x58,
+	0xd0, 0xfc, 0x82, 0x78, 0xce, 0x66, 0x2b, 0xe6, 0x69, 0x40, 0xe5, 0xad, 0x95, 0x11, 0x50, 0x5a,
+	0xb3, 0x0c, 0x9a, 0x5f, 0x14, 0x33, 0xec, 0x51, 0x29, 0xda, 0xb8, 0x08, 0xe8, 0xa4, 0x25, 0x40,
+	0xf4, 0xc8, 0x2f, 0x89, 0x27, 0x6c, 0xba, 0x14, 0x18, 0xc0, 0x76, 0x28, 0xa2, 0xd4, 0x70, 0x0a,
+	0xbd, 0x2c, 0xde, 0xb0, 0xd7, 0xa5, 0xd0, 0xc4, 0x87, 0x48, 0xde, 0xd9, 0x25, 0xfa, 0xe2, 0x53,
+	0xab, 0x49, 0xa1, 0x0f, 0x81, 0x7a, 0xb2, 0x0b, 0x48, 0x05, 0x81, 0x8f, 0x89, 0x8f, 0xec, 0x6d,
+	0xb9, 0x9f, 0x5e, 0x0f, 0xb4, 0x91, 0x11, 0xc8, 0x9f, 0x7d, 0xed, 0xa9, 0x0a, 0x42, 0xa1, 0x4a,
+	0x6d, 0xef, 0xbb, 0x7c, 0x5c, 0xbc, 0x63, 0xf3, 0xa5, 0x02, 0x1d, 0xdf, 0x1d, 0x0d, 0x21, 0x55,
+	0xd0, 0x9c, 0x8f, 0xd4, 0x06, 0xea, 0xa4, 0xd6, 0x2e, 0x15, 0x57, 0xd0, 0xfc, 0x8a, 0x78, 0xc6,
+	0x66, 0x4a, 0xd9, 0x08, 0x3a, 0x55, 0x30, 0x32, 0x8f, 0x10, 0xcc, 0x32, 0xf0, 0xab, 0x62, 0x96,
+	0x4d, 0x55, 0x9c, 0x9d, 0x86, 0x45, 0xc0, 0xbf, 0xd9, 0x31, 0x31, 0xc9, 0x1e, 0x54, 0xc8, 0x26,
+	0x56, 0x2a, 0xd0, 0xfc, 0x9a, 0x98, 0x66, 0x93, 0xe5, 0xbe, 0x47, 0x06, 0x4d, 0x61, 0xf0, 0x7a,
+	0x65, 0x9b, 0xe0, 0x73, 0x6a, 0xe2, 0x12, 0x45, 0x03, 0xc8, 0x6f, 0x54, 0x86, 0xd5, 0x31, 0x27,
+	0x91, 0x06, 0x88, 0xd1, 0x42, 0x0f, 0x5c, 0xe4, 0x37, 0x85, 0x64, 0xef, 0x4b, 0xa1, 0x0b, 0xc6,
+	0x9f, 0x34, 0x25, 0x90, 0x09, 0xc5, 0x8d, 0xa6, 0x90, 0xb6, 0xa5, 0x52, 0x3e, 0x75, 0x91, 0x94,
+	0x77, 0x21, 0xa2, 0x34, 0x2e, 0x06, 0x7e, 0xab, 0xb2, 0x71, 0xc5, 0xc3, 0x93, 0x58, 0x48, 0x43,
+	0x12, 0x3f, 0x91, 0x92, 0x09, 0xe7, 0x95, 0xa7, 0xd6, 0x93, 0xd8, 0x85, 0x48, 0x1a, 0xac, 0x09,
+	0x11, 0x34, 0xbf, 0x5d, 0xd9, 0xcd, 0x8e, 0x47, 0x05, 0xe7, 0x95, 0x17, 0x95, 0xa9, 0x85, 0x28,
+	0x2d, 0x9c, 0x09, 0x27, 0x68, 0x14, 0xf0, 0x3b, 0x62, 0x9e, 0xcd, 0x95, 0xcf, 0x37, 0x8e, 0xce,
+	0x7f, 0x0f, 0x8a, 0x9e, 0x7c, 0x02, 0x45, 0x69, 0x4d, 0x34, 0xde, 0xf1, 0xbb, 0xed, 0xc5, 0x1f,
+	0x47, 0x8d, 0xfa, 0xe1, 0x51, 0xa3, 0xfe, 0xfb, 0xa8, 0x51, 0xff, 0x7e, 0xdc, 0xa8, 0x1d, 0x1e,
+	0x37, 0x6a, 0x3f, 0x8f, 0x1b, 0xb5, 0xe5, 0x0f, 0x5f, 0xd7, 0x77, 0xd7, 0xbe, 0xad, 0x34, 0x57,
+	0x87, 0x9b, 0xad, 0xff, 0x96, 0xca, 0xde, 0xdc, 0x8b, 0xd5, 0xb5, 0xfe, 0xfa, 0x56, 0xab, 0x6a,
+	0xcd, 0xec, 0x1e, 0x6c, 0x67, 0x3b, 0x2b, 0x63, 0xc5, 0xeb, 0x57, 0x7f, 0x06, 0x00, 0x80, 0x82,
+	0x48, 0xbb, 0x92, 0x04, 0x00, 0x00,
 }
@@ -81,8 +81,10 @@ func IsInternalMsg(msg sdk.Msg) bool {
 
 		// clob
 		*clob.MsgCreateClobPair,
+		*clob.MsgDelistPerpetualMarket,
 		*clob.MsgUpdateBlockRateLimitConfiguration,
 		*clob.MsgUpdateClobPair,
+		*clob.MsgUpdateClobPairConfig,
 		*clob.MsgUpdateEquityTierLimitConfiguration,
 		*clob.MsgUpdateLiquidationsConfig,
 
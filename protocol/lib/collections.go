@@ -66,6 +66,19 @@ func GetSortedKeys[R interface {
 	return keys
 }
 
+// GetKeysSortedBy returns the keys of the map sorted by the provided `less` function. `less` must define a strict
+// total order over the keys for the result to be deterministic, since map iteration order is random.
+func GetKeysSortedBy[K comparable, V any](m map[K]V, less func(a, b K) bool) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return less(keys[i], keys[j])
+	})
+	return keys
+}
+
 // UniqueSliceToSet converts a slice of unique values to a set.
 // The function will panic if there are duplicate values.
 func UniqueSliceToSet[K comparable](values []K) map[K]struct{} {
@@ -126,6 +139,19 @@ func FilterSlice[V any](values []V, filterFunc func(V) bool) []V {
 	return filteredValues
 }
 
+// FilterMap takes a function that returns a boolean on whether to include the entry in the final
+// result, and returns a new map of entries where the function returned true when called with each entry.
+func FilterMap[K comparable, V any](m map[K]V, keep func(K, V) bool) map[K]V {
+	filteredMap := make(map[K]V)
+	for k, v := range m {
+		if keep(k, v) {
+			filteredMap[k] = v
+		}
+	}
+
+	return filteredMap
+}
+
 // MergeAllMapsMustHaveDistinctKeys merges all the maps into a single map.
 // Panics if there are duplicate keys.
 func MergeAllMapsMustHaveDistinctKeys[K comparable, V any](maps ...map[K]V) map[K]V {
@@ -142,7 +168,8 @@ func MergeAllMapsMustHaveDistinctKeys[K comparable, V any](maps ...map[K]V) map[
 }
 
 // MergeMaps merges all the maps into a single map.
-// Does not require maps to have distinct keys.
+// Does not require maps to have distinct keys. If a key exists in multiple maps, the value from the
+// last map containing the key is used. The input maps are not modified.
 func MergeMaps[K comparable, V any](maps ...map[K]V) map[K]V {
 	combinedMap := make(map[K]V)
 	for _, m := range maps {
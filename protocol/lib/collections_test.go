@@ -1,6 +1,7 @@
 package lib_test
 
 import (
+	"maps"
 	"sort"
 	"testing"
 
@@ -199,6 +200,85 @@ func TestGetSortedKeys(t *testing.T) {
 	}
 }
 
+func TestGetKeysSortedBy(t *testing.T) {
+	type key struct {
+		priority uint32
+		name     string
+	}
+	tests := map[string]struct {
+		inputMap       map[key]int
+		less           func(a, b key) bool
+		expectedResult []key
+	}{
+		"Nil input": {
+			inputMap:       nil,
+			less:           func(a, b key) bool { return a.priority < b.priority },
+			expectedResult: []key{},
+		},
+		"Empty map": {
+			inputMap:       map[key]int{},
+			less:           func(a, b key) bool { return a.priority < b.priority },
+			expectedResult: []key{},
+		},
+		"Ascending": {
+			inputMap: map[key]int{
+				{priority: 3, name: "c"}: 3,
+				{priority: 1, name: "a"}: 1,
+				{priority: 4, name: "d"}: 4,
+				{priority: 2, name: "b"}: 2,
+			},
+			less: func(a, b key) bool { return a.priority < b.priority },
+			expectedResult: []key{
+				{priority: 1, name: "a"},
+				{priority: 2, name: "b"},
+				{priority: 3, name: "c"},
+				{priority: 4, name: "d"},
+			},
+		},
+		"Descending": {
+			inputMap: map[key]int{
+				{priority: 3, name: "c"}: 3,
+				{priority: 1, name: "a"}: 1,
+				{priority: 4, name: "d"}: 4,
+				{priority: 2, name: "b"}: 2,
+			},
+			less: func(a, b key) bool { return a.priority > b.priority },
+			expectedResult: []key{
+				{priority: 4, name: "d"},
+				{priority: 3, name: "c"},
+				{priority: 2, name: "b"},
+				{priority: 1, name: "a"},
+			},
+		},
+		"Ties are broken by a secondary field": {
+			inputMap: map[key]int{
+				{priority: 2, name: "b"}: 0,
+				{priority: 1, name: "c"}: 0,
+				{priority: 2, name: "a"}: 0,
+				{priority: 1, name: "a"}: 0,
+			},
+			less: func(a, b key) bool {
+				if a.priority != b.priority {
+					return a.priority > b.priority
+				}
+				return a.name < b.name
+			},
+			expectedResult: []key{
+				{priority: 2, name: "a"},
+				{priority: 2, name: "b"},
+				{priority: 1, name: "a"},
+				{priority: 1, name: "c"},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualResult := lib.GetKeysSortedBy(tc.inputMap, tc.less)
+			require.Equal(t, tc.expectedResult, actualResult)
+		})
+	}
+}
+
 func TestUniqueSliceToSet(t *testing.T) {
 	tests := map[string]struct {
 		input     []string
@@ -401,6 +481,66 @@ func TestFilterSlice(t *testing.T) {
 	)
 }
 
+func TestFilterMap(t *testing.T) {
+	// Can filter out all entries with keys less than 3.
+	require.Equal(
+		t,
+		map[uint32]string{3: "c", 4: "d"},
+		lib.FilterMap(
+			map[uint32]string{1: "a", 2: "b", 3: "c", 4: "d"},
+			func(k uint32, _ string) bool {
+				return k >= 3
+			},
+		),
+	)
+
+	// Can filter out all entries with values that have length greater than 3.
+	require.Equal(
+		t,
+		map[string]string{"a": "1", "b": "22"},
+		lib.FilterMap(
+			map[string]string{"a": "1", "b": "22", "c": "hello"},
+			func(_ string, v string) bool {
+				return len(v) <= 3
+			},
+		),
+	)
+
+	// Works properly on nil map.
+	require.Equal(
+		t,
+		map[string]string{},
+		lib.FilterMap(
+			nil,
+			func(_ string, _ string) bool {
+				return true
+			},
+		),
+	)
+
+	// Works properly when no entries pass the predicate.
+	require.Equal(
+		t,
+		map[string]string{},
+		lib.FilterMap(
+			map[string]string{"a": "1", "b": "22", "c": "hello"},
+			func(_ string, _ string) bool {
+				return false
+			},
+		),
+	)
+
+	// The input map is not modified.
+	input := map[string]string{"a": "1", "b": "22"}
+	_ = lib.FilterMap(
+		input,
+		func(k string, _ string) bool {
+			return k == "a"
+		},
+	)
+	require.Equal(t, map[string]string{"a": "1", "b": "22"}, input)
+}
+
 func TestMergeAllMapsWithDistinctKeys(t *testing.T) {
 	tests := map[string]struct {
 		inputMaps []map[string]string
@@ -471,3 +611,60 @@ func TestMergeAllMapsWithDistinctKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeMaps(t *testing.T) {
+	tests := map[string]struct {
+		inputMaps []map[string]string
+
+		expectedMap map[string]string
+	}{
+		"Nil input": {
+			inputMaps:   nil,
+			expectedMap: map[string]string{},
+		},
+		"Multiple maps, some empty": {
+			inputMaps: []map[string]string{
+				{}, nil, {"a": "1", "b": "2"},
+			},
+			expectedMap: map[string]string{
+				"a": "1", "b": "2",
+			},
+		},
+		"Multiple maps, distinct keys": {
+			inputMaps: []map[string]string{
+				{"a": "1", "b": "2"},
+				{"c": "3", "d": "4"},
+			},
+			expectedMap: map[string]string{
+				"a": "1", "b": "2", "c": "3", "d": "4",
+			},
+		},
+		"Multiple maps, later maps take precedence": {
+			inputMaps: []map[string]string{
+				{"a": "1", "b": "2", "c": "3"},
+				{"a": "4", "b": "5"},
+				{"a": "6", "d": "7"},
+			},
+			expectedMap: map[string]string{
+				"a": "6", "b": "5", "c": "3", "d": "7",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			inputMapsCopy := make([]map[string]string, len(tc.inputMaps))
+			for i, m := range tc.inputMaps {
+				inputMapsCopy[i] = maps.Clone(m)
+			}
+
+			actualMap := lib.MergeMaps(tc.inputMaps...)
+			require.Equal(t, tc.expectedMap, actualMap)
+
+			// The input maps are not modified.
+			for i, m := range tc.inputMaps {
+				require.Equal(t, inputMapsCopy[i], m)
+			}
+		})
+	}
+}
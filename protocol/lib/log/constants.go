@@ -58,6 +58,7 @@ const (
 	AnteHandler        = "AnteHandler"
 	PlaceOrder         = "PlaceOrder"
 	CancelOrder        = "CancelOrder"
+	ReduceOrder        = "ReduceOrder"
 	ProposedOperations = "ProposedOperations"
 	BeginBlocker       = "BeginBlocker"
 	EndBlocker         = "EndBlocker"
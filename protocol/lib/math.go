@@ -193,20 +193,59 @@ func Median[V uint64 | uint32 | int64 | int32](input []V) (V, error) {
 	// The median is an average of the two middle numbers. It's rounded away from zero
 	// to the nearest integer.
 	// Note x <= y since `inputCopy` is sorted.
-	x := inputCopy[midIdx-1]
-	y := inputCopy[midIdx]
+	return averageRoundedAwayFromZero(inputCopy[midIdx-1], inputCopy[midIdx]), nil
+}
+
+// WeightedMedian is a generic weighted median calculator, where each element of `input` counts `weights[i]`
+// times. Elements with a weight of zero are ignored.
+// If the weights of the elements below and above the median are equal, then the average of the two middle
+// numbers is rounded away from zero, so equal weights return the same result as `Median`.
+func WeightedMedian[V uint64 | uint32 | int64 | int32](input []V, weights []uint32) (V, error) {
+	if len(input) != len(weights) {
+		return 0, fmt.Errorf("input length %d does not match weights length %d", len(input), len(weights))
+	}
+
+	indices := make([]int, 0, len(input))
+	totalWeight := uint64(0)
+	for i, weight := range weights {
+		if weight > 0 {
+			indices = append(indices, i)
+			totalWeight += uint64(weight)
+		}
+	}
+	if totalWeight == 0 {
+		return 0, errors.New("input cannot be empty or have a total weight of zero")
+	}
+	sort.SliceStable(indices, func(i, j int) bool { return input[indices[i]] < input[indices[j]] })
+
+	cumulativeWeight := uint64(0)
+	for i, idx := range indices {
+		cumulativeWeight += uint64(weights[idx])
+		if 2*cumulativeWeight > totalWeight {
+			return input[idx], nil
+		}
+		if 2*cumulativeWeight == totalWeight {
+			// Note x <= y since `indices` is sorted by value.
+			return averageRoundedAwayFromZero(input[idx], input[indices[i+1]]), nil
+		}
+	}
+	panic("WeightedMedian: cumulative weight never reached half of the total weight")
+}
 
+// averageRoundedAwayFromZero returns the average of `x` and `y` rounded away from zero to the nearest
+// integer, without overflowing. It requires x <= y.
+func averageRoundedAwayFromZero[V uint64 | uint32 | int64 | int32](x V, y V) V {
 	if x <= 0 && y >= 0 {
 		// x and y have different signs, so x+y cannot overflow.
 		sum := x + y
-		return sum/2 + sum%2, nil
+		return sum/2 + sum%2
 	}
 
 	if y > 0 {
 		// x and y are both positive.
-		return y - (y-x)/2, nil
+		return y - (y-x)/2
 	}
 
 	// x and y are both negative.
-	return x + (y-x)/2, nil
+	return x + (y-x)/2
 }
@@ -750,3 +750,64 @@ func TestMedian_Uint64(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedMedian_Uint64(t *testing.T) {
+	tests := map[string]struct {
+		input          []uint64
+		weights        []uint32
+		expectedResult uint64
+		expectedError  string
+	}{
+		"Empty input causes error": {
+			input:         []uint64{},
+			weights:       []uint32{},
+			expectedError: "input cannot be empty or have a total weight of zero",
+		},
+		"Zero total weight causes error": {
+			input:         []uint64{1, 2},
+			weights:       []uint32{0, 0},
+			expectedError: "input cannot be empty or have a total weight of zero",
+		},
+		"Mismatched lengths cause error": {
+			input:         []uint64{1, 2},
+			weights:       []uint32{1},
+			expectedError: "input length 2 does not match weights length 1",
+		},
+		"Equal weights, odd number input": {
+			input:          []uint64{2, 0, 1, 3, 4},
+			weights:        []uint32{1, 1, 1, 1, 1},
+			expectedResult: 2,
+		},
+		"Equal weights, even number input": {
+			input:          []uint64{5, 12, 1, 3, 12, 50}, // median is (5+12)/2=8.5
+			weights:        []uint32{2, 2, 2, 2, 2, 2},
+			expectedResult: 9,
+		},
+		"Heavier weight moves the median": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint32{1, 1, 3},
+			expectedResult: 300,
+		},
+		"Weights split evenly between two values": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint32{2, 1, 1},
+			expectedResult: 150,
+		},
+		"Zero weights are ignored": {
+			input:          []uint64{100, 200, 300},
+			weights:        []uint32{0, 1, 1},
+			expectedResult: 250,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := lib.WeightedMedian(tc.input, tc.weights)
+			require.Equal(t, tc.expectedResult, result)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
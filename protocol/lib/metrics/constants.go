@@ -28,6 +28,7 @@ const (
 	Reason           = "reason"
 	Received         = "received"
 	Rejected         = "rejected"
+	Retry            = "retry"
 	SampleRate       = "sample_rate"
 	SequenceNumber   = "sequence_number"
 	Success          = "success"
@@ -164,6 +165,7 @@ const (
 	QuoteQuantums                                           = "quote_quantums"
 	RateLimit                                               = "rate_limit"
 	ReduceOnly                                              = "reduce_only"
+	ReduceOrder                                             = "reduce_order"
 	RemovalReason                                           = "removal_reason"
 	RemoveAndClearOperationsQueue                           = "remove_and_clear_operations_queue"
 	ReplayOperations                                        = "replay_operations"
@@ -235,6 +237,12 @@ const (
 	PremiumRate                  = "premium_rate"
 	PremiumSampleValue           = "premium_sample_value"
 	PremiumType                  = "premium_type"
+	PremiumsOverflow             = "premiums_overflow"
+	NumNonZeroPremiums           = "num_non_zero_premiums"
+	NumPremiums                  = "num_premiums"
+	Truncated                    = "truncated"
+	SampleTailsRemovalSkipped    = "sample_tails_removal_skipped"
+	RemovalExceedsNumSamples     = "removal_exceeds_num_samples"
 
 	// Rewards.
 	GetRewardShare                   = "get_reward_share"
@@ -335,6 +343,7 @@ const (
 	SubaccountMaxNotionalLiquidated       = "exceeds_subaccount_max_notional_liquidated"
 	LiquidationRequiresDeleveraging       = "liquidation_requires_deleveraging"
 	LiquidationMatchNegativeTNC           = "liquidation_match_negative_tnc"
+	SubaccountInLiquidationCooldown       = "subaccount_in_liquidation_cooldown"
 
 	// Deleveraging.
 	CannotDeleverageSubaccount     = "cannot_deleverage_subaccount"
@@ -352,6 +361,7 @@ const (
 	ExchangeSpecificError                   = "exchange_specific_error"
 	GetAllPrices_MarketIdToPrice            = "get_all_prices_market_id_to_price"
 	PriceEncoderUpdatePrice                 = "price_encoder_update_price"
+	PriceEncoderLastUpdateTimestamp         = "price_encoder_last_update_timestamp"
 	PricefeedDaemon                         = "pricefeed_daemon"
 	ConfiguredMarketCount                   = "configured_market_count"
 	ConfiguredMarketCountPerExchange        = "configured_market_count_per_exchange"
@@ -360,13 +370,20 @@ const (
 	MarketUpdaterApplyMarketUpdates         = "market_updater_apply_market_updates"
 	MarketUpdaterUpdateMarkets              = "market_updater_update_markets"
 	PriceEncoderPriceConversion             = "price_encoder_price_conversion"
+	PriceFetcherExchangeDisabled            = "price_fetcher_exchange_disabled"
+	PriceFetcherExchangeReEnabled           = "price_fetcher_exchange_re_enabled"
+	PriceFetcherMarketDisabled              = "price_fetcher_market_disabled"
+	PriceFetcherMarketReEnabled             = "price_fetcher_market_re_enabled"
 	PriceFetcherQueryExchange               = "price_fetcher_query_exchange"
 	PriceFetcherQueryForMarket              = "price_fetcher_query_for_market_sampled"
 	PriceFetcherSubtaskLoop                 = "price_fetcher_subtask_loop"
 	PriceFetcherSubtaskLoopAndSetCtxTimeout = "price_fetcher_subtask_loop_and_set_ctx_timeout"
 	PriceUpdateCount                        = "price_update_count"
+	PriceUpdaterDuplicateExchangePrice      = "price_updater_duplicate_exchange_price"
 	PriceUpdaterSendPrices                  = "price_updater_send_prices"
+	PriceUpdaterStalePrice                  = "price_updater_stale_price"
 	PriceUpdaterTaskLoop                    = "price_updater_task_loop"
+	PriceUpdaterTooFewExchanges             = "price_updater_too_few_exchanges"
 	PriceUpdaterTransformPrices             = "price_updater_transform_prices"
 	PriceUpdaterZeroPrices                  = "price_updater_zero_prices"
 
@@ -390,8 +407,9 @@ const (
 	OffchainMessageLength = "offchain_message_length"
 
 	// Indexer events.
-	TotalNumIndexerBlockEvents = "total_num_block_events"
-	TotalNumIndexerTxnEvents   = "total_num_txn_events"
+	TotalNumIndexerBlockEvents  = "total_num_block_events"
+	TotalNumIndexerTxnEvents    = "total_num_txn_events"
+	ExceededMaxIndexerTxnEvents = "exceeded_max_txn_events"
 
 	// Mev.
 	MevFallbackToOracle            = "mev_fallback_to_oracle"
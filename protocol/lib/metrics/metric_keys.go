@@ -27,6 +27,7 @@ const (
 	ClobRateLimitPlaceOrderCount                       = "clob_rate_limit_place_order_count"
 	ClobRateLimitCancelOrderCount                      = "clob_rate_limit_cancel_order_count"
 	ClobRateLimitBatchCancelCount                      = "clob_rate_limit_batch_cancel_count"
+	ClobOpenInterestInvariantViolated                  = "clob_open_interest_invariant_violated"
 
 	// Gauges
 	InsuranceFundBalance                      = "insurance_fund_balance"
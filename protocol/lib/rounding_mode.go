@@ -0,0 +1,76 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode specifies how the result of a division that is not exact is rounded to an integer.
+type RoundingMode uint32
+
+const (
+	// RoundingModeFloor rounds towards negative infinity.
+	RoundingModeFloor RoundingMode = iota
+	// RoundingModeCeil rounds towards positive infinity.
+	RoundingModeCeil
+	// RoundingModeHalfEven rounds to the nearest integer, and to the nearest even integer on ties.
+	RoundingModeHalfEven
+	// RoundingModeTowardZero rounds towards zero.
+	RoundingModeTowardZero
+)
+
+// String returns a textual representation of the rounding mode.
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundingModeFloor:
+		return "Floor"
+	case RoundingModeCeil:
+		return "Ceil"
+	case RoundingModeHalfEven:
+		return "HalfEven"
+	case RoundingModeTowardZero:
+		return "TowardZero"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", uint32(m))
+	}
+}
+
+// BigDivRound returns `a / b`, rounded according to `mode`. This function panics if `b` is not positive
+// or if `mode` is unknown.
+func BigDivRound(a *big.Int, b *big.Int, mode RoundingMode) *big.Int {
+	if b.Sign() <= 0 {
+		panic("BigDivRound: divisor must be positive")
+	}
+
+	// Since `b` is positive, Euclidean division rounds towards negative infinity and the remainder is
+	// non-negative.
+	result, remainder := new(big.Int).DivMod(a, b, new(big.Int))
+	if remainder.Sign() == 0 {
+		return result
+	}
+
+	switch mode {
+	case RoundingModeFloor:
+	case RoundingModeCeil:
+		result.Add(result, big.NewInt(1))
+	case RoundingModeHalfEven:
+		// Round up if the remainder is more than half of `b`, or exactly half of `b` and the result is odd.
+		cmp := new(big.Int).Lsh(remainder, 1).Cmp(b)
+		if cmp > 0 || (cmp == 0 && result.Bit(0) == 1) {
+			result.Add(result, big.NewInt(1))
+		}
+	case RoundingModeTowardZero:
+		if a.Sign() < 0 {
+			result.Add(result, big.NewInt(1))
+		}
+	default:
+		panic(fmt.Sprintf("BigDivRound: unknown rounding mode %v", mode))
+	}
+	return result
+}
+
+// BigMulPpmWithRoundingMode returns the result of `val * ppm / 1_000_000`, rounded according to `mode`.
+func BigMulPpmWithRoundingMode(val *big.Int, ppm *big.Int, mode RoundingMode) *big.Int {
+	result := new(big.Int).Mul(val, ppm)
+	return BigDivRound(result, BigIntOneMillion(), mode)
+}
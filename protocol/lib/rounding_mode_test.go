@@ -0,0 +1,184 @@
+package lib_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigDivRound(t *testing.T) {
+	tests := map[string]struct {
+		numerator   *big.Int
+		denominator *big.Int
+
+		expectedFloor      *big.Int
+		expectedCeil       *big.Int
+		expectedHalfEven   *big.Int
+		expectedTowardZero *big.Int
+	}{
+		"Divides evenly": {
+			numerator:          big.NewInt(10),
+			denominator:        big.NewInt(5),
+			expectedFloor:      big.NewInt(2),
+			expectedCeil:       big.NewInt(2),
+			expectedHalfEven:   big.NewInt(2),
+			expectedTowardZero: big.NewInt(2),
+		},
+		"Zero numerator": {
+			numerator:          big.NewInt(0),
+			denominator:        big.NewInt(3),
+			expectedFloor:      big.NewInt(0),
+			expectedCeil:       big.NewInt(0),
+			expectedHalfEven:   big.NewInt(0),
+			expectedTowardZero: big.NewInt(0),
+		},
+		"Below half": {
+			numerator:          big.NewInt(13),
+			denominator:        big.NewInt(4),
+			expectedFloor:      big.NewInt(3),
+			expectedCeil:       big.NewInt(4),
+			expectedHalfEven:   big.NewInt(3),
+			expectedTowardZero: big.NewInt(3),
+		},
+		"Above half": {
+			numerator:          big.NewInt(15),
+			denominator:        big.NewInt(4),
+			expectedFloor:      big.NewInt(3),
+			expectedCeil:       big.NewInt(4),
+			expectedHalfEven:   big.NewInt(4),
+			expectedTowardZero: big.NewInt(3),
+		},
+		"Exactly half, rounds to even result above": {
+			numerator:          big.NewInt(7),
+			denominator:        big.NewInt(2),
+			expectedFloor:      big.NewInt(3),
+			expectedCeil:       big.NewInt(4),
+			expectedHalfEven:   big.NewInt(4),
+			expectedTowardZero: big.NewInt(3),
+		},
+		"Exactly half, rounds to even result below": {
+			numerator:          big.NewInt(5),
+			denominator:        big.NewInt(2),
+			expectedFloor:      big.NewInt(2),
+			expectedCeil:       big.NewInt(3),
+			expectedHalfEven:   big.NewInt(2),
+			expectedTowardZero: big.NewInt(2),
+		},
+		"Negative numerator below half": {
+			numerator:          big.NewInt(-13),
+			denominator:        big.NewInt(4),
+			expectedFloor:      big.NewInt(-4),
+			expectedCeil:       big.NewInt(-3),
+			expectedHalfEven:   big.NewInt(-3),
+			expectedTowardZero: big.NewInt(-3),
+		},
+		"Negative numerator exactly half": {
+			numerator:          big.NewInt(-5),
+			denominator:        big.NewInt(2),
+			expectedFloor:      big.NewInt(-3),
+			expectedCeil:       big.NewInt(-2),
+			expectedHalfEven:   big.NewInt(-2),
+			expectedTowardZero: big.NewInt(-2),
+		},
+		"Negative numerator exactly half 2": {
+			numerator:          big.NewInt(-7),
+			denominator:        big.NewInt(2),
+			expectedFloor:      big.NewInt(-4),
+			expectedCeil:       big.NewInt(-3),
+			expectedHalfEven:   big.NewInt(-4),
+			expectedTowardZero: big.NewInt(-3),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for mode, expected := range map[lib.RoundingMode]*big.Int{
+				lib.RoundingModeFloor:      tc.expectedFloor,
+				lib.RoundingModeCeil:       tc.expectedCeil,
+				lib.RoundingModeHalfEven:   tc.expectedHalfEven,
+				lib.RoundingModeTowardZero: tc.expectedTowardZero,
+			} {
+				result := lib.BigDivRound(tc.numerator, tc.denominator, mode)
+				require.Zero(t, expected.Cmp(result), "%v: expected %v, got %v", mode, expected, result)
+			}
+		})
+	}
+}
+
+func TestBigDivRound_Panics(t *testing.T) {
+	require.PanicsWithValue(t, "BigDivRound: divisor must be positive", func() {
+		lib.BigDivRound(big.NewInt(1), big.NewInt(0), lib.RoundingModeFloor)
+	})
+	require.PanicsWithValue(t, "BigDivRound: divisor must be positive", func() {
+		lib.BigDivRound(big.NewInt(1), big.NewInt(-2), lib.RoundingModeFloor)
+	})
+	require.PanicsWithValue(t, "BigDivRound: unknown rounding mode RoundingMode(4)", func() {
+		lib.BigDivRound(big.NewInt(1), big.NewInt(2), lib.RoundingMode(4))
+	})
+}
+
+func TestBigMulPpmWithRoundingMode(t *testing.T) {
+	tests := map[string]struct {
+		val *big.Int
+		ppm *big.Int
+
+		expectedFloor    *big.Int
+		expectedCeil     *big.Int
+		expectedHalfEven *big.Int
+	}{
+		"Exact result": {
+			val:              big.NewInt(2_000_000),
+			ppm:              big.NewInt(500),
+			expectedFloor:    big.NewInt(1_000),
+			expectedCeil:     big.NewInt(1_000),
+			expectedHalfEven: big.NewInt(1_000),
+		},
+		"Fee just below a rounding boundary": {
+			// 2_499 * 200 / 1_000_000 = 0.4998
+			val:              big.NewInt(2_499),
+			ppm:              big.NewInt(200),
+			expectedFloor:    big.NewInt(0),
+			expectedCeil:     big.NewInt(1),
+			expectedHalfEven: big.NewInt(0),
+		},
+		"Fee exactly on a rounding boundary": {
+			// 2_500 * 200 / 1_000_000 = 0.5
+			val:              big.NewInt(2_500),
+			ppm:              big.NewInt(200),
+			expectedFloor:    big.NewInt(0),
+			expectedCeil:     big.NewInt(1),
+			expectedHalfEven: big.NewInt(0),
+		},
+		"Fee just above a rounding boundary": {
+			// 2_501 * 200 / 1_000_000 = 0.5002
+			val:              big.NewInt(2_501),
+			ppm:              big.NewInt(200),
+			expectedFloor:    big.NewInt(0),
+			expectedCeil:     big.NewInt(1),
+			expectedHalfEven: big.NewInt(1),
+		},
+		"Rebate exactly on a rounding boundary": {
+			// 7_500 * -200 / 1_000_000 = -1.5
+			val:              big.NewInt(7_500),
+			ppm:              big.NewInt(-200),
+			expectedFloor:    big.NewInt(-2),
+			expectedCeil:     big.NewInt(-1),
+			expectedHalfEven: big.NewInt(-2),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for mode, expected := range map[lib.RoundingMode]*big.Int{
+				lib.RoundingModeFloor:    tc.expectedFloor,
+				lib.RoundingModeCeil:     tc.expectedCeil,
+				lib.RoundingModeHalfEven: tc.expectedHalfEven,
+			} {
+				result := lib.BigMulPpmWithRoundingMode(tc.val, tc.ppm, mode)
+				require.Zero(t, expected.Cmp(result), "%v: expected %v, got %v", mode, expected, result)
+			}
+		})
+	}
+}
@@ -158,6 +158,24 @@ func (_m *BridgeKeeper) HasAuthority(authority string) bool {
 	return r0
 }
 
+// IsBridgingPaused provides a mock function with given fields: ctx
+func (_m *BridgeKeeper) IsBridgingPaused(ctx types.Context) bool {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBridgingPaused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // UpdateEventParams provides a mock function with given fields: ctx, params
 func (_m *BridgeKeeper) UpdateEventParams(ctx types.Context, params bridgetypes.EventParams) error {
 	ret := _m.Called(ctx, params)
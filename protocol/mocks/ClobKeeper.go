@@ -166,6 +166,24 @@ func (_m *ClobKeeper) DeleteLongTermOrderPlacement(ctx types.Context, orderId cl
 	_m.Called(ctx, orderId)
 }
 
+// DelistPerpetualMarket provides a mock function with given fields: ctx, perpetualId
+func (_m *ClobKeeper) DelistPerpetualMarket(ctx types.Context, perpetualId uint32) error {
+	ret := _m.Called(ctx, perpetualId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DelistPerpetualMarket")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) error); ok {
+		r0 = rf(ctx, perpetualId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllClobPairs provides a mock function with given fields: ctx
 func (_m *ClobKeeper) GetAllClobPairs(ctx types.Context) []clobtypes.ClobPair {
 	ret := _m.Called(ctx)
@@ -282,6 +300,24 @@ func (_m *ClobKeeper) GetClobPair(ctx types.Context, id clobtypes.ClobPairId) (c
 	return r0, r1
 }
 
+// GetClobPairConfig provides a mock function with given fields: ctx
+func (_m *ClobKeeper) GetClobPairConfig(ctx types.Context) clobtypes.ClobPairConfig {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClobPairConfig")
+	}
+
+	var r0 clobtypes.ClobPairConfig
+	if rf, ok := ret.Get(0).(func(types.Context) clobtypes.ClobPairConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(clobtypes.ClobPairConfig)
+	}
+
+	return r0
+}
+
 // GetFillablePrice provides a mock function with given fields: ctx, subaccountId, perpetualId, deltaQuantums
 func (_m *ClobKeeper) GetFillablePrice(ctx types.Context, subaccountId subaccountstypes.SubaccountId, perpetualId uint32, deltaQuantums *big.Int) (*big.Rat, error) {
 	ret := _m.Called(ctx, subaccountId, perpetualId, deltaQuantums)
@@ -647,6 +683,24 @@ func (_m *ClobKeeper) HandleMsgPlaceOrder(ctx types.Context, msg *clobtypes.MsgP
 	return r0
 }
 
+// HandleMsgReduceOrder provides a mock function with given fields: ctx, msg
+func (_m *ClobKeeper) HandleMsgReduceOrder(ctx types.Context, msg *clobtypes.MsgReduceOrder) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandleMsgReduceOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *clobtypes.MsgReduceOrder) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *ClobKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -688,6 +742,11 @@ func (_m *ClobKeeper) InitializeBlockRateLimit(ctx types.Context, config clobtyp
 	return r0
 }
 
+// InitializeClobPairConfig provides a mock function with given fields: ctx, config
+func (_m *ClobKeeper) InitializeClobPairConfig(ctx types.Context, config clobtypes.ClobPairConfig) {
+	_m.Called(ctx, config)
+}
+
 // InitializeEquityTierLimit provides a mock function with given fields: ctx, config
 func (_m *ClobKeeper) InitializeEquityTierLimit(ctx types.Context, config clobtypes.EquityTierLimitConfiguration) error {
 	ret := _m.Called(ctx, config)
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	indexer_manager "github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	lib "github.com/dydxprotocol/v4-chain/protocol/lib"
 	delaymsgtypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 
@@ -114,6 +115,26 @@ func (_m *DelayMsgKeeper) GetBlockMessageIds(ctx types.Context, blockHeight uint
 	return r0, r1
 }
 
+// GetIndexerEventManager provides a mock function with given fields:
+func (_m *DelayMsgKeeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexerEventManager")
+	}
+
+	var r0 indexer_manager.IndexerEventManager
+	if rf, ok := ret.Get(0).(func() indexer_manager.IndexerEventManager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(indexer_manager.IndexerEventManager)
+		}
+	}
+
+	return r0
+}
+
 // GetMessage provides a mock function with given fields: ctx, id
 func (_m *DelayMsgKeeper) GetMessage(ctx types.Context, id uint32) (delaymsgtypes.DelayedMessage, bool) {
 	ret := _m.Called(ctx, id)
@@ -180,6 +201,24 @@ func (_m *DelayMsgKeeper) Logger(ctx types.Context) log.Logger {
 	return r0
 }
 
+// RescheduleDelayedMessage provides a mock function with given fields: ctx, id, newBlockHeight
+func (_m *DelayMsgKeeper) RescheduleDelayedMessage(ctx types.Context, id uint32, newBlockHeight uint32) error {
+	ret := _m.Called(ctx, id, newBlockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RescheduleDelayedMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, uint32) error); ok {
+		r0 = rf(ctx, id, newBlockHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Router provides a mock function with given fields:
 func (_m *DelayMsgKeeper) Router() lib.MsgRouter {
 	ret := _m.Called()
@@ -36,9 +36,9 @@ func (_m *ExchangeToMarketPrices) GetAllPrices() map[string][]types.MarketPriceT
 	return r0
 }
 
-// GetIndexPrice provides a mock function with given fields: marketId, cutoffTime, resolver
-func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time.Time, resolver pricefeedtypes.Resolver) (uint64, int) {
-	ret := _m.Called(marketId, cutoffTime, resolver)
+// GetIndexPrice provides a mock function with given fields: marketId, cutoffTime, medianizer
+func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time.Time, medianizer pricefeedtypes.WeightedMedianizer) (uint64, int) {
+	ret := _m.Called(marketId, cutoffTime, medianizer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetIndexPrice")
@@ -46,17 +46,17 @@ func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time
 
 	var r0 uint64
 	var r1 int
-	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.Resolver) (uint64, int)); ok {
-		return rf(marketId, cutoffTime, resolver)
+	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.WeightedMedianizer) (uint64, int)); ok {
+		return rf(marketId, cutoffTime, medianizer)
 	}
-	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.Resolver) uint64); ok {
-		r0 = rf(marketId, cutoffTime, resolver)
+	if rf, ok := ret.Get(0).(func(uint32, time.Time, pricefeedtypes.WeightedMedianizer) uint64); ok {
+		r0 = rf(marketId, cutoffTime, medianizer)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func(uint32, time.Time, pricefeedtypes.Resolver) int); ok {
-		r1 = rf(marketId, cutoffTime, resolver)
+	if rf, ok := ret.Get(1).(func(uint32, time.Time, pricefeedtypes.WeightedMedianizer) int); ok {
+		r1 = rf(marketId, cutoffTime, medianizer)
 	} else {
 		r1 = ret.Get(1).(int)
 	}
@@ -64,6 +64,26 @@ func (_m *ExchangeToMarketPrices) GetIndexPrice(marketId uint32, cutoffTime time
 	return r0, r1
 }
 
+// GetPricesForMarket provides a mock function with given fields: marketId
+func (_m *ExchangeToMarketPrices) GetPricesForMarket(marketId uint32) []types.MarketPriceTimestamp {
+	ret := _m.Called(marketId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPricesForMarket")
+	}
+
+	var r0 []types.MarketPriceTimestamp
+	if rf, ok := ret.Get(0).(func(uint32) []types.MarketPriceTimestamp); ok {
+		r0 = rf(marketId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.MarketPriceTimestamp)
+		}
+	}
+
+	return r0
+}
+
 // UpdatePrice provides a mock function with given fields: exchangeId, marketPriceTimestamp
 func (_m *ExchangeToMarketPrices) UpdatePrice(exchangeId string, marketPriceTimestamp *types.MarketPriceTimestamp) {
 	_m.Called(exchangeId, marketPriceTimestamp)
@@ -49,6 +49,42 @@ func (_m *IndexerEventManager) Enabled() bool {
 	return r0
 }
 
+// GetPendingEventBytes provides a mock function with given fields: ctx
+func (_m *IndexerEventManager) GetPendingEventBytes(ctx types.Context) int {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingEventBytes")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(types.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetPendingEventCount provides a mock function with given fields: ctx
+func (_m *IndexerEventManager) GetPendingEventCount(ctx types.Context) int {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingEventCount")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(types.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // ProduceBlock provides a mock function with given fields: ctx
 func (_m *IndexerEventManager) ProduceBlock(ctx types.Context) *indexer_manager.IndexerTendermintBlock {
 	ret := _m.Called(ctx)
@@ -69,6 +105,26 @@ func (_m *IndexerEventManager) ProduceBlock(ctx types.Context) *indexer_manager.
 	return r0
 }
 
+// ProduceBlockFiltered provides a mock function with given fields: ctx, subtypes
+func (_m *IndexerEventManager) ProduceBlockFiltered(ctx types.Context, subtypes []string) *indexer_manager.IndexerTendermintBlock {
+	ret := _m.Called(ctx, subtypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceBlockFiltered")
+	}
+
+	var r0 *indexer_manager.IndexerTendermintBlock
+	if rf, ok := ret.Get(0).(func(types.Context, []string) *indexer_manager.IndexerTendermintBlock); ok {
+		r0 = rf(ctx, subtypes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexer_manager.IndexerTendermintBlock)
+		}
+	}
+
+	return r0
+}
+
 // SendOffchainData provides a mock function with given fields: message
 func (_m *IndexerEventManager) SendOffchainData(message msgsender.Message) {
 	_m.Called(message)
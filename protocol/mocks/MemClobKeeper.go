@@ -137,6 +137,24 @@ func (_m *MemClobKeeper) CancelShortTermOrder(ctx types.Context, msgCancelOrder
 	return r0
 }
 
+// GetClobPairConfig provides a mock function with given fields: ctx
+func (_m *MemClobKeeper) GetClobPairConfig(ctx types.Context) clobtypes.ClobPairConfig {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClobPairConfig")
+	}
+
+	var r0 clobtypes.ClobPairConfig
+	if rf, ok := ret.Get(0).(func(types.Context) clobtypes.ClobPairConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(clobtypes.ClobPairConfig)
+	}
+
+	return r0
+}
+
 // GetIndexerEventManager provides a mock function with given fields:
 func (_m *MemClobKeeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
 	ret := _m.Called()
@@ -240,6 +240,26 @@ func (_m *PerpetualsKeeper) GetPerpetual(ctx types.Context, id uint32) (perpetua
 	return r0, r1
 }
 
+// GetPerpetualsForMarket provides a mock function with given fields: ctx, marketId
+func (_m *PerpetualsKeeper) GetPerpetualsForMarket(ctx types.Context, marketId uint32) []perpetualstypes.Perpetual {
+	ret := _m.Called(ctx, marketId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPerpetualsForMarket")
+	}
+
+	var r0 []perpetualstypes.Perpetual
+	if rf, ok := ret.Get(0).(func(types.Context, uint32) []perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, marketId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]perpetualstypes.Perpetual)
+		}
+	}
+
+	return r0
+}
+
 // HasAuthority provides a mock function with given fields: authority
 func (_m *PerpetualsKeeper) HasAuthority(authority string) bool {
 	ret := _m.Called(authority)
@@ -286,9 +306,9 @@ func (_m *PerpetualsKeeper) ModifyOpenInterest(ctx types.Context, perpetualId ui
 	return r0
 }
 
-// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier
-func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32) (perpetualstypes.Perpetual, error) {
-	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+// ModifyPerpetual provides a mock function with given fields: ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, initialMarginPpmOverride
+func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker string, marketId uint32, defaultFundingPpm int32, liquidityTier uint32, initialMarginPpmOverride uint32) (perpetualstypes.Perpetual, error) {
+	ret := _m.Called(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, initialMarginPpmOverride)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ModifyPerpetual")
@@ -296,17 +316,17 @@ func (_m *PerpetualsKeeper) ModifyPerpetual(ctx types.Context, id uint32, ticker
 
 	var r0 perpetualstypes.Perpetual
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32) (perpetualstypes.Perpetual, error)); ok {
-		return rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32, uint32) (perpetualstypes.Perpetual, error)); ok {
+		return rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, initialMarginPpmOverride)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32) perpetualstypes.Perpetual); ok {
-		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, int32, uint32, uint32) perpetualstypes.Perpetual); ok {
+		r0 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, initialMarginPpmOverride)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.Perpetual)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32) error); ok {
-		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, int32, uint32, uint32) error); ok {
+		r1 = rf(ctx, id, ticker, marketId, defaultFundingPpm, liquidityTier, initialMarginPpmOverride)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -332,14 +352,19 @@ func (_m *PerpetualsKeeper) PerformStatefulPremiumVotesValidation(ctx types.Cont
 	return r0
 }
 
+// PersistBlockNetFunding provides a mock function with given fields: ctx
+func (_m *PerpetualsKeeper) PersistBlockNetFunding(ctx types.Context) {
+	_m.Called(ctx)
+}
+
 // SendOIUpdatesToIndexer provides a mock function with given fields: ctx
 func (_m *PerpetualsKeeper) SendOIUpdatesToIndexer(ctx types.Context) {
 	_m.Called(ctx)
 }
 
-// SetLiquidityTier provides a mock function with given fields: ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap
-func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name string, initialMarginPpm uint32, maintenanceFractionPpm uint32, impactNotional uint64, openInterestLowerCap uint64, openInterestUpperCap uint64) (perpetualstypes.LiquidityTier, error) {
-	ret := _m.Called(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap)
+// SetLiquidityTier provides a mock function with given fields: ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap, premiumCombinationMethod
+func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name string, initialMarginPpm uint32, maintenanceFractionPpm uint32, impactNotional uint64, openInterestLowerCap uint64, openInterestUpperCap uint64, premiumCombinationMethod perpetualstypes.PremiumCombinationMethod) (perpetualstypes.LiquidityTier, error) {
+	ret := _m.Called(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap, premiumCombinationMethod)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetLiquidityTier")
@@ -347,17 +372,17 @@ func (_m *PerpetualsKeeper) SetLiquidityTier(ctx types.Context, id uint32, name
 
 	var r0 perpetualstypes.LiquidityTier
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64) (perpetualstypes.LiquidityTier, error)); ok {
-		return rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64, perpetualstypes.PremiumCombinationMethod) (perpetualstypes.LiquidityTier, error)); ok {
+		return rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap, premiumCombinationMethod)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64) perpetualstypes.LiquidityTier); ok {
-		r0 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap)
+	if rf, ok := ret.Get(0).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64, perpetualstypes.PremiumCombinationMethod) perpetualstypes.LiquidityTier); ok {
+		r0 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap, premiumCombinationMethod)
 	} else {
 		r0 = ret.Get(0).(perpetualstypes.LiquidityTier)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64) error); ok {
-		r1 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap)
+	if rf, ok := ret.Get(1).(func(types.Context, uint32, string, uint32, uint32, uint64, uint64, uint64, perpetualstypes.PremiumCombinationMethod) error); ok {
+		r1 = rf(ctx, id, name, initialMarginPpm, maintenanceFractionPpm, impactNotional, openInterestLowerCap, openInterestUpperCap, premiumCombinationMethod)
 	} else {
 		r1 = ret.Error(1)
 	}
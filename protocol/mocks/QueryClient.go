@@ -215,6 +215,80 @@ func (_m *QueryClient) AllMarketPrices(ctx context.Context, in *pricestypes.Quer
 	return r0, r1
 }
 
+// AllMarketsOverview provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllMarketsOverview(ctx context.Context, in *clobtypes.QueryAllMarketsOverviewRequest, opts ...grpc.CallOption) (*clobtypes.QueryAllMarketsOverviewResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllMarketsOverview")
+	}
+
+	var r0 *clobtypes.QueryAllMarketsOverviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllMarketsOverviewRequest, ...grpc.CallOption) (*clobtypes.QueryAllMarketsOverviewResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryAllMarketsOverviewRequest, ...grpc.CallOption) *clobtypes.QueryAllMarketsOverviewResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryAllMarketsOverviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryAllMarketsOverviewRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllOpenInterest provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) AllOpenInterest(ctx context.Context, in *perpetualstypes.QueryAllOpenInterestRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryAllOpenInterestResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllOpenInterest")
+	}
+
+	var r0 *perpetualstypes.QueryAllOpenInterestResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryAllOpenInterestRequest, ...grpc.CallOption) (*perpetualstypes.QueryAllOpenInterestResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryAllOpenInterestRequest, ...grpc.CallOption) *perpetualstypes.QueryAllOpenInterestResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryAllOpenInterestResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryAllOpenInterestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AllPerpetuals provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) AllPerpetuals(ctx context.Context, in *perpetualstypes.QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryAllPerpetualsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -363,6 +437,80 @@ func (_m *QueryClient) ClobPairAll(ctx context.Context, in *clobtypes.QueryAllCl
 	return r0, r1
 }
 
+// ClobPairMarketPrice provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ClobPairMarketPrice(ctx context.Context, in *clobtypes.QueryClobPairMarketPriceRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairMarketPriceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClobPairMarketPrice")
+	}
+
+	var r0 *clobtypes.QueryClobPairMarketPriceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryClobPairMarketPriceRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairMarketPriceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryClobPairMarketPriceRequest, ...grpc.CallOption) *clobtypes.QueryClobPairMarketPriceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryClobPairMarketPriceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryClobPairMarketPriceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClobPairsByStatus provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ClobPairsByStatus(ctx context.Context, in *clobtypes.QueryClobPairsByStatusRequest, opts ...grpc.CallOption) (*clobtypes.QueryClobPairsByStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClobPairsByStatus")
+	}
+
+	var r0 *clobtypes.QueryClobPairsByStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryClobPairsByStatusRequest, ...grpc.CallOption) (*clobtypes.QueryClobPairsByStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryClobPairsByStatusRequest, ...grpc.CallOption) *clobtypes.QueryClobPairsByStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryClobPairsByStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryClobPairsByStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CollateralPoolAddress provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccountstypes.QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryCollateralPoolAddressResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -400,6 +548,80 @@ func (_m *QueryClient) CollateralPoolAddress(ctx context.Context, in *subaccount
 	return r0, r1
 }
 
+// ConfiguredExchanges provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ConfiguredExchanges(ctx context.Context, in *pricestypes.QueryConfiguredExchangesRequest, opts ...grpc.CallOption) (*pricestypes.QueryConfiguredExchangesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfiguredExchanges")
+	}
+
+	var r0 *pricestypes.QueryConfiguredExchangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryConfiguredExchangesRequest, ...grpc.CallOption) (*pricestypes.QueryConfiguredExchangesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricestypes.QueryConfiguredExchangesRequest, ...grpc.CallOption) *pricestypes.QueryConfiguredExchangesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricestypes.QueryConfiguredExchangesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricestypes.QueryConfiguredExchangesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentFundingRate provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) CurrentFundingRate(ctx context.Context, in *perpetualstypes.QueryCurrentFundingRateRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryCurrentFundingRateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentFundingRate")
+	}
+
+	var r0 *perpetualstypes.QueryCurrentFundingRateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryCurrentFundingRateRequest, ...grpc.CallOption) (*perpetualstypes.QueryCurrentFundingRateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryCurrentFundingRateRequest, ...grpc.CallOption) *perpetualstypes.QueryCurrentFundingRateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryCurrentFundingRateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryCurrentFundingRateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DowntimeParams provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) DowntimeParams(ctx context.Context, in *types.QueryDowntimeParamsRequest, opts ...grpc.CallOption) (*types.QueryDowntimeParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -474,6 +696,43 @@ func (_m *QueryClient) EquityTierLimitConfiguration(ctx context.Context, in *clo
 	return r0, r1
 }
 
+// ExchangeHealth provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ExchangeHealth(ctx context.Context, in *pricefeedapi.ExchangeHealthRequest, opts ...grpc.CallOption) (*pricefeedapi.ExchangeHealthResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExchangeHealth")
+	}
+
+	var r0 *pricefeedapi.ExchangeHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pricefeedapi.ExchangeHealthRequest, ...grpc.CallOption) (*pricefeedapi.ExchangeHealthResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pricefeedapi.ExchangeHealthRequest, ...grpc.CallOption) *pricefeedapi.ExchangeHealthResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pricefeedapi.ExchangeHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pricefeedapi.ExchangeHealthRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWithdrawalAndTransfersBlockedInfo provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*subaccountstypes.QueryGetWithdrawalAndTransfersBlockedInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -511,6 +770,80 @@ func (_m *QueryClient) GetWithdrawalAndTransfersBlockedInfo(ctx context.Context,
 	return r0, r1
 }
 
+// InsuranceFundBalance provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) InsuranceFundBalance(ctx context.Context, in *clobtypes.QueryInsuranceFundBalanceRequest, opts ...grpc.CallOption) (*clobtypes.QueryInsuranceFundBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsuranceFundBalance")
+	}
+
+	var r0 *clobtypes.QueryInsuranceFundBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryInsuranceFundBalanceRequest, ...grpc.CallOption) (*clobtypes.QueryInsuranceFundBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryInsuranceFundBalanceRequest, ...grpc.CallOption) *clobtypes.QueryInsuranceFundBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryInsuranceFundBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryInsuranceFundBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastBlockRejectedOperations provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) LastBlockRejectedOperations(ctx context.Context, in *clobtypes.QueryLastBlockRejectedOperationsRequest, opts ...grpc.CallOption) (*clobtypes.QueryLastBlockRejectedOperationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastBlockRejectedOperations")
+	}
+
+	var r0 *clobtypes.QueryLastBlockRejectedOperationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLastBlockRejectedOperationsRequest, ...grpc.CallOption) (*clobtypes.QueryLastBlockRejectedOperationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryLastBlockRejectedOperationsRequest, ...grpc.CallOption) *clobtypes.QueryLastBlockRejectedOperationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryLastBlockRejectedOperationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryLastBlockRejectedOperationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LiquidateSubaccounts provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) LiquidateSubaccounts(ctx context.Context, in *liquidationapi.LiquidateSubaccountsRequest, opts ...grpc.CallOption) (*liquidationapi.LiquidateSubaccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -659,6 +992,43 @@ func (_m *QueryClient) MarketPrice(ctx context.Context, in *pricestypes.QueryMar
 	return r0, r1
 }
 
+// MaxOrderSize provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MaxOrderSize(ctx context.Context, in *clobtypes.QueryMaxOrderSizeRequest, opts ...grpc.CallOption) (*clobtypes.QueryMaxOrderSizeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxOrderSize")
+	}
+
+	var r0 *clobtypes.QueryMaxOrderSizeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryMaxOrderSizeRequest, ...grpc.CallOption) (*clobtypes.QueryMaxOrderSizeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *clobtypes.QueryMaxOrderSizeRequest, ...grpc.CallOption) *clobtypes.QueryMaxOrderSizeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*clobtypes.QueryMaxOrderSizeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *clobtypes.QueryMaxOrderSizeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MevNodeToNodeCalculation provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtypes.MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*clobtypes.MevNodeToNodeCalculationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -696,6 +1066,43 @@ func (_m *QueryClient) MevNodeToNodeCalculation(ctx context.Context, in *clobtyp
 	return r0, r1
 }
 
+// NetFundingPerPerpetual provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) NetFundingPerPerpetual(ctx context.Context, in *perpetualstypes.QueryNetFundingPerPerpetualRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryNetFundingPerPerpetualResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NetFundingPerPerpetual")
+	}
+
+	var r0 *perpetualstypes.QueryNetFundingPerPerpetualResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNetFundingPerPerpetualRequest, ...grpc.CallOption) (*perpetualstypes.QueryNetFundingPerPerpetualResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryNetFundingPerPerpetualRequest, ...grpc.CallOption) *perpetualstypes.QueryNetFundingPerPerpetualResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryNetFundingPerPerpetualResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryNetFundingPerPerpetualRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Params(ctx context.Context, in *perpetualstypes.QueryParamsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -770,6 +1177,117 @@ func (_m *QueryClient) Perpetual(ctx context.Context, in *perpetualstypes.QueryP
 	return r0, r1
 }
 
+// PerpetualMarginFractions provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PerpetualMarginFractions(ctx context.Context, in *perpetualstypes.QueryPerpetualMarginFractionsRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPerpetualMarginFractionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PerpetualMarginFractions")
+	}
+
+	var r0 *perpetualstypes.QueryPerpetualMarginFractionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualMarginFractionsRequest, ...grpc.CallOption) (*perpetualstypes.QueryPerpetualMarginFractionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualMarginFractionsRequest, ...grpc.CallOption) *perpetualstypes.QueryPerpetualMarginFractionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPerpetualMarginFractionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPerpetualMarginFractionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PerpetualMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PerpetualMetadata(ctx context.Context, in *perpetualstypes.QueryPerpetualMetadataRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPerpetualMetadataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PerpetualMetadata")
+	}
+
+	var r0 *perpetualstypes.QueryPerpetualMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualMetadataRequest, ...grpc.CallOption) (*perpetualstypes.QueryPerpetualMetadataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPerpetualMetadataRequest, ...grpc.CallOption) *perpetualstypes.QueryPerpetualMetadataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPerpetualMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPerpetualMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PremiumAccumulationStatus provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) PremiumAccumulationStatus(ctx context.Context, in *perpetualstypes.QueryPremiumAccumulationStatusRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumAccumulationStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PremiumAccumulationStatus")
+	}
+
+	var r0 *perpetualstypes.QueryPremiumAccumulationStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumAccumulationStatusRequest, ...grpc.CallOption) (*perpetualstypes.QueryPremiumAccumulationStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.QueryPremiumAccumulationStatusRequest, ...grpc.CallOption) *perpetualstypes.QueryPremiumAccumulationStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*perpetualstypes.QueryPremiumAccumulationStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.QueryPremiumAccumulationStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PremiumSamples provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) PremiumSamples(ctx context.Context, in *perpetualstypes.QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*perpetualstypes.QueryPremiumSamplesResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -918,6 +1436,43 @@ func (_m *QueryClient) StatefulOrder(ctx context.Context, in *clobtypes.QuerySta
 	return r0, r1
 }
 
+// StreamFundingHistory provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) StreamFundingHistory(ctx context.Context, in *perpetualstypes.StreamFundingHistoryRequest, opts ...grpc.CallOption) (perpetualstypes.Query_StreamFundingHistoryClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamFundingHistory")
+	}
+
+	var r0 perpetualstypes.Query_StreamFundingHistoryClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.StreamFundingHistoryRequest, ...grpc.CallOption) (perpetualstypes.Query_StreamFundingHistoryClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *perpetualstypes.StreamFundingHistoryRequest, ...grpc.CallOption) perpetualstypes.Query_StreamFundingHistoryClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(perpetualstypes.Query_StreamFundingHistoryClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *perpetualstypes.StreamFundingHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StreamOrderbookUpdates provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) StreamOrderbookUpdates(ctx context.Context, in *clobtypes.StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (clobtypes.Query_StreamOrderbookUpdatesClient, error) {
 	_va := make([]interface{}, len(opts))
@@ -1029,6 +1584,43 @@ func (_m *QueryClient) SubaccountAll(ctx context.Context, in *subaccountstypes.Q
 	return r0, r1
 }
 
+// SubaccountPositions provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) SubaccountPositions(ctx context.Context, in *subaccountstypes.QuerySubaccountPositionsRequest, opts ...grpc.CallOption) (*subaccountstypes.QuerySubaccountPositionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubaccountPositions")
+	}
+
+	var r0 *subaccountstypes.QuerySubaccountPositionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountPositionsRequest, ...grpc.CallOption) (*subaccountstypes.QuerySubaccountPositionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *subaccountstypes.QuerySubaccountPositionsRequest, ...grpc.CallOption) *subaccountstypes.QuerySubaccountPositionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*subaccountstypes.QuerySubaccountPositionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *subaccountstypes.QuerySubaccountPositionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMarketPrices provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) UpdateMarketPrices(ctx context.Context, in *pricefeedapi.UpdateMarketPricesRequest, opts ...grpc.CallOption) (*pricefeedapi.UpdateMarketPricesResponse, error) {
 	_va := make([]interface{}, len(opts))
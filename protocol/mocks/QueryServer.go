@@ -75,6 +75,36 @@ func (_m *QueryServer) AllMarketPrices(_a0 context.Context, _a1 *types.QueryAllM
 	return r0, r1
 }
 
+// ConfiguredExchanges provides a mock function with given fields: _a0, _a1
+func (_m *QueryServer) ConfiguredExchanges(_a0 context.Context, _a1 *types.QueryConfiguredExchangesRequest) (*types.QueryConfiguredExchangesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfiguredExchanges")
+	}
+
+	var r0 *types.QueryConfiguredExchangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfiguredExchangesRequest) (*types.QueryConfiguredExchangesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryConfiguredExchangesRequest) *types.QueryConfiguredExchangesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryConfiguredExchangesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryConfiguredExchangesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketParam provides a mock function with given fields: _a0, _a1
 func (_m *QueryServer) MarketParam(_a0 context.Context, _a1 *types.QueryMarketParamRequest) (*types.QueryMarketParamResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -44,6 +44,36 @@ func (_m *RequestHandler) Get(ctx context.Context, url string) (*http.Response,
 	return r0, r1
 }
 
+// Post provides a mock function with given fields: ctx, url, contentType, body
+func (_m *RequestHandler) Post(ctx context.Context, url string, contentType string, body []byte) (*http.Response, error) {
+	ret := _m.Called(ctx, url, contentType, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Post")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) (*http.Response, error)); ok {
+		return rf(ctx, url, contentType, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) *http.Response); ok {
+		r0 = rf(ctx, url, contentType, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) error); ok {
+		r1 = rf(ctx, url, contentType, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRequestHandler creates a new instance of RequestHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRequestHandler(t interface {
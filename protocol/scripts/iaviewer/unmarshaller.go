@@ -24,9 +24,10 @@ func protoUnmarshaller[M proto.Message](b []byte) string {
 // Thus, keys each inner registry map should not be prefixes of each other.
 var unmarshallerRegistry = map[string]map[string]func([]byte) string{
 	"s/k:clob/": {
-		"Clob:":      protoUnmarshaller[*clob.ClobPair],
-		"EqTierCfg":  protoUnmarshaller[*clob.EquityTierLimitConfiguration],
-		"LiqCfg":     protoUnmarshaller[*clob.LiquidationsConfig],
-		"RateLimCfg": protoUnmarshaller[*clob.BlockRateLimitConfiguration],
+		"Clob:":       protoUnmarshaller[*clob.ClobPair],
+		"ClobPairCfg": protoUnmarshaller[*clob.ClobPairConfig],
+		"EqTierCfg":   protoUnmarshaller[*clob.EquityTierLimitConfiguration],
+		"LiqCfg":      protoUnmarshaller[*clob.LiquidationsConfig],
+		"RateLimCfg":  protoUnmarshaller[*clob.BlockRateLimitConfiguration],
 	},
 }
@@ -20,16 +20,16 @@ func TestUpdateFeeTiersModuleParams(t *testing.T) {
 		Tiers: []*feetierstypes.PerpetualFeeTier{
 			{
 				Name:        "test_tier_0",
-				MakerFeePpm: 1_000,
-				TakerFeePpm: 2_000,
+				MakerFeePpm: 11_000,
+				TakerFeePpm: 22_000,
 			},
 			{
 				Name:                           "test_tier_1",
 				AbsoluteVolumeRequirement:      200_000,
 				TotalVolumeShareRequirementPpm: 100_000,
 				MakerVolumeShareRequirementPpm: 50_000,
-				MakerFeePpm:                    11_000,
-				TakerFeePpm:                    22_000,
+				MakerFeePpm:                    1_000,
+				TakerFeePpm:                    2_000,
 			},
 		},
 	}
@@ -92,6 +92,27 @@ func TestUpdateFeeTiersModuleParams(t *testing.T) {
 			},
 			expectCheckTxFails: true,
 		},
+		"Failure: fees increase at a higher tier": {
+			msg: &feetierstypes.MsgUpdatePerpetualFeeParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: feetierstypes.PerpetualFeeParams{
+					Tiers: []*feetierstypes.PerpetualFeeTier{
+						{
+							Name:        "test_tier_0",
+							MakerFeePpm: 1_000,
+							TakerFeePpm: 2_000,
+						},
+						{
+							Name:                      "test_tier_1",
+							AbsoluteVolumeRequirement: 200_000,
+							MakerFeePpm:               1_000,
+							TakerFeePpm:               2_001,
+						},
+					},
+				},
+			},
+			expectCheckTxFails: true,
+		},
 		"Failure: invalid authority": {
 			msg: &feetierstypes.MsgUpdatePerpetualFeeParams{
 				Authority: authtypes.NewModuleAddress(feetierstypes.ModuleName).String(),
@@ -52,6 +52,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 					FundingRateClampFactorPpm: 123_456,
 					PremiumVoteClampFactorPpm: 123_456_789,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedProposalStatus: govtypesv1.ProposalStatus_PROPOSAL_STATUS_PASSED,
@@ -63,6 +64,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 					FundingRateClampFactorPpm: 0,
 					PremiumVoteClampFactorPpm: 100,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectCheckTxFails: true,
@@ -74,6 +76,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 					FundingRateClampFactorPpm: 100,
 					PremiumVoteClampFactorPpm: 0,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectCheckTxFails: true,
@@ -85,6 +88,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 					FundingRateClampFactorPpm: 100,
 					PremiumVoteClampFactorPpm: 100,
 					MinNumVotesPerSample:      0,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectCheckTxFails: true,
@@ -96,6 +100,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 					FundingRateClampFactorPpm: 100,
 					PremiumVoteClampFactorPpm: 100,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectSubmitProposalFail: true,
@@ -120,6 +125,7 @@ func TestUpdatePerpetualsModuleParams(t *testing.T) {
 							FundingRateClampFactorPpm: tc.msg.Params.FundingRateClampFactorPpm + 1,
 							PremiumVoteClampFactorPpm: tc.msg.Params.PremiumVoteClampFactorPpm + 2,
 							MinNumVotesPerSample:      tc.msg.Params.MinNumVotesPerSample + 3,
+							FundingRatePeriodSeconds:  tc.msg.Params.FundingRatePeriodSeconds + 4,
 						}
 					},
 				)
@@ -887,6 +887,7 @@ const GenesisState = `{
       ],
       "params": {
         "funding_rate_clamp_factor_ppm": 6000000,
+        "funding_rate_period_seconds": 28800,
         "min_num_votes_per_sample": 15,
         "premium_vote_clamp_factor_ppm": 60000000
       },
@@ -121,11 +121,13 @@ var (
 const TestFundingRateClampFactorPpm = 6_000_000
 const TestPremiumVoteClampFactorPpm = 60_000_000
 const TestMinNumVotesPerSample = 15
+const TestFundingRatePeriodSeconds = 28_800
 
 var PerpetualsGenesisParams = perptypes.Params{
 	FundingRateClampFactorPpm: TestFundingRateClampFactorPpm,
 	PremiumVoteClampFactorPpm: TestPremiumVoteClampFactorPpm,
 	MinNumVotesPerSample:      TestMinNumVotesPerSample,
+	FundingRatePeriodSeconds:  TestFundingRatePeriodSeconds,
 }
 
 var Perpetuals_GenesisState_ParamsOnly = perptypes.GenesisState{
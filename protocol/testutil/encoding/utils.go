@@ -79,6 +79,7 @@ func GetTestEncodingCfg() testutil.TestEncodingConfig {
 		&clobtypes.MsgPlaceOrder{},
 		&clobtypes.MsgCancelOrder{},
 		&clobtypes.MsgBatchCancel{},
+		&clobtypes.MsgReduceOrder{},
 
 		// Perpetuals.
 		&perpetualtypes.MsgAddPremiumVotes{},
@@ -12,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	bridgekeeper "github.com/dydxprotocol/v4-chain/protocol/x/bridge/keeper"
 	bridgetypes "github.com/dydxprotocol/v4-chain/protocol/x/bridge/types"
@@ -58,12 +59,15 @@ func DelayMsgKeepers(
 			bridgetypes.ModuleAddress.String(),
 			lib.GovModuleAddress.String(),
 		}
+		mockMsgSender := &mocks.IndexerMessageSender{}
+		mockMsgSender.On("Enabled").Return(false)
 		delayMsgKeeper, storeKey = createDelayMsgKeeper(
 			stateStore,
 			db,
 			cdc,
 			router,
 			authorities,
+			indexer_manager.NewIndexerEventManager(mockMsgSender, transientStoreKey, true, 0),
 		)
 
 		return []GenesisInitializer{
@@ -80,6 +84,7 @@ func DelayMsgKeeperWithMockBridgeKeeper(
 	delayMsgKeeper *keeper.Keeper,
 	storeKey storetypes.StoreKey,
 	bridgeKeeper *mocks.BridgeKeeper,
+	indexerEventManager *mocks.IndexerEventManager,
 	authorities []string,
 ) {
 	ctx = initKeepers(t, func(
@@ -108,17 +113,19 @@ func DelayMsgKeeperWithMockBridgeKeeper(
 			bridgetypes.ModuleAddress.String(),
 			lib.GovModuleAddress.String(),
 		}
+		indexerEventManager = &mocks.IndexerEventManager{}
 		delayMsgKeeper, storeKey = createDelayMsgKeeper(
 			stateStore,
 			db,
 			cdc,
 			router,
 			authorities,
+			indexerEventManager,
 		)
 
 		return []GenesisInitializer{delayMsgKeeper}
 	})
-	return ctx, delayMsgKeeper, storeKey, bridgeKeeper, authorities
+	return ctx, delayMsgKeeper, storeKey, bridgeKeeper, indexerEventManager, authorities
 }
 
 func createDelayMsgKeeper(
@@ -127,6 +134,7 @@ func createDelayMsgKeeper(
 	cdc *codec.ProtoCodec,
 	router *baseapp.MsgServiceRouter,
 	authorities []string,
+	indexerEventManager indexer_manager.IndexerEventManager,
 ) (*keeper.Keeper, storetypes.StoreKey) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -137,6 +145,7 @@ func createDelayMsgKeeper(
 		storeKey,
 		router,
 		authorities,
+		indexerEventManager,
 	)
 	return k, storeKey
 }
@@ -21,6 +21,7 @@ import (
 	delaymsgmoduletypes "github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 	epochskeeper "github.com/dydxprotocol/v4-chain/protocol/x/epochs/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	priceskeeper "github.com/dydxprotocol/v4-chain/protocol/x/prices/keeper"
@@ -111,7 +112,7 @@ func createPerpetualsKeeperWithClobHelpers(
 
 	mockMsgSender := &mocks.IndexerMessageSender{}
 	mockMsgSender.On("Enabled").Return(true)
-	mockIndexerEventsManager := indexer_manager.NewIndexerEventManager(mockMsgSender, transientStoreKey, true)
+	mockIndexerEventsManager := indexer_manager.NewIndexerEventManager(mockMsgSender, transientStoreKey, true, 0)
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -124,6 +125,7 @@ func createPerpetualsKeeperWithClobHelpers(
 			delaymsgmoduletypes.ModuleAddress.String(),
 		},
 		transientStoreKey,
+		flags.GetDefaultPerpetualsFlags(),
 	)
 
 	k.SetClobKeeper(pck)
@@ -198,6 +200,7 @@ func CreateTestLiquidityTiers(t testing.TB, ctx sdk.Context, k *keeper.Keeper) {
 			l.ImpactNotional,
 			l.OpenInterestLowerCap,
 			l.OpenInterestUpperCap,
+			l.PremiumCombinationMethod,
 		)
 
 		require.NoError(t, err)
@@ -30,6 +30,7 @@ type FakeMemClobKeeper struct {
 	statePositionFn                      types.GetStatePositionFn
 	useCollatCheckFnForSingleMatch       bool
 	indexerEventManager                  indexer_manager.IndexerEventManager
+	clobPairConfig                       types.ClobPairConfig
 }
 
 func NewFakeMemClobKeeper() *FakeMemClobKeeper {
@@ -62,6 +63,13 @@ func (f *FakeMemClobKeeper) WithIndexerEventManager(
 	return f
 }
 
+func (f *FakeMemClobKeeper) WithClobPairConfig(
+	clobPairConfig types.ClobPairConfig,
+) *FakeMemClobKeeper {
+	f.clobPairConfig = clobPairConfig
+	return f
+}
+
 // Commit simulates the `checkState` being reset and uncommitted.
 func (f *FakeMemClobKeeper) ResetState() {
 	f.dirtyPositionSizes = make(map[satypes.SubaccountId]map[types.ClobPairId]*big.Int)
@@ -512,6 +520,12 @@ func (f *FakeMemClobKeeper) SendOrderbookFillUpdates(
 ) {
 }
 
+func (f *FakeMemClobKeeper) GetClobPairConfig(
+	ctx sdk.Context,
+) types.ClobPairConfig {
+	return f.clobPairConfig
+}
+
 // Placeholder to satisfy interface implementation of types.MemClobKeeper
 func (f *FakeMemClobKeeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 	ctx sdk.Context,
@@ -48,6 +48,12 @@ func WithMinPriceChangePpm(minPriceChangePpm uint32) MarketParamPriceModifierOpt
 	}
 }
 
+func WithMaxPriceUpdateGapBlocks(maxPriceUpdateGapBlocks uint32) MarketParamPriceModifierOption {
+	return func(cp *pricestypes.MarketParamPrice) {
+		cp.Param.MaxPriceUpdateGapBlocks = maxPriceUpdateGapBlocks
+	}
+}
+
 func WithExchangeConfigJson(configJson string) MarketParamPriceModifierOption {
 	return func(cp *pricestypes.MarketParamPrice) {
 		cp.Param.ExchangeConfigJson = configJson
@@ -5,6 +5,7 @@ package cli_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -25,13 +26,18 @@ func setupNetwork(
 	client.Context,
 ) {
 	t.Helper()
-	cfg := network.DefaultConfig(nil)
-
-	// Init state.
-	state := types.GenesisState{}
-	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	return setupNetworkWithGenesis(t, *types.DefaultGenesis())
+}
 
-	state = *types.DefaultGenesis()
+func setupNetworkWithGenesis(
+	t *testing.T,
+	state types.GenesisState,
+) (
+	*network.Network,
+	client.Context,
+) {
+	t.Helper()
+	cfg := network.DefaultConfig(nil)
 
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
@@ -75,6 +81,44 @@ func TestQuerySafetyParams(t *testing.T) {
 	require.Equal(t, types.DefaultGenesis().SafetyParams, resp.Params)
 }
 
+func TestQueryParams_NonDefaultGenesis(t *testing.T) {
+	state := *types.DefaultGenesis()
+	state.EventParams = types.EventParams{
+		Denom:      "adv4tnt",
+		EthChainId: 1,
+		EthAddress: "0x8F2E1A4C3b5d6E7f8091a2B3c4D5e6F708192a3B",
+	}
+	state.ProposeParams = types.ProposeParams{
+		MaxBridgesPerBlock:           3,
+		ProposeDelayDuration:         30 * time.Second,
+		SkipRatePpm:                  500_000,
+		SkipIfBlockDelayedByDuration: 10 * time.Second,
+	}
+	state.SafetyParams = types.SafetyParams{
+		IsDisabled:  true,
+		DelayBlocks: 100,
+	}
+	net, ctx := setupNetworkWithGenesis(t, state)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryEventParams(), []string{})
+	require.NoError(t, err)
+	var eventParamsResp types.QueryEventParamsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &eventParamsResp))
+	require.Equal(t, state.EventParams, eventParamsResp.Params)
+
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryProposeParams(), []string{})
+	require.NoError(t, err)
+	var proposeParamsResp types.QueryProposeParamsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &proposeParamsResp))
+	require.Equal(t, state.ProposeParams, proposeParamsResp.Params)
+
+	out, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdQuerySafetyParams(), []string{})
+	require.NoError(t, err)
+	var safetyParamsResp types.QuerySafetyParamsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &safetyParamsResp))
+	require.Equal(t, state.SafetyParams, safetyParamsResp.Params)
+}
+
 func TestQueryAcknowledgedEventInfo(t *testing.T) {
 	net, ctx := setupNetwork(t)
 
@@ -18,8 +18,8 @@ func (k Keeper) GetAcknowledgeBridges(
 	ctx sdk.Context,
 	blockTimestamp time.Time,
 ) (msg *types.MsgAcknowledgeBridges) {
-	// Do not propose bridge events if bridging is disabled.
-	if k.GetSafetyParams(ctx).IsDisabled {
+	// Do not propose bridge events if bridging is paused.
+	if k.IsBridgingPaused(ctx) {
 		return &types.MsgAcknowledgeBridges{
 			Events: []types.BridgeEvent{},
 		}
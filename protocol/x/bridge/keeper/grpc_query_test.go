@@ -3,7 +3,9 @@ package keeper_test
 import (
 	sdkmath "cosmossdk.io/math"
 	"testing"
+	"time"
 
+	cometbfttypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -127,6 +129,54 @@ func TestSafetyParams(t *testing.T) {
 	}
 }
 
+func TestParamsQueries_NonDefaultGenesis(t *testing.T) {
+	eventParams := types.EventParams{
+		Denom:      "adv4tnt",
+		EthChainId: 1,
+		EthAddress: "0x8F2E1A4C3b5d6E7f8091a2B3c4D5e6F708192a3B",
+	}
+	proposeParams := types.ProposeParams{
+		MaxBridgesPerBlock:           3,
+		ProposeDelayDuration:         30 * time.Second,
+		SkipRatePpm:                  500_000,
+		SkipIfBlockDelayedByDuration: 10 * time.Second,
+	}
+	safetyParams := types.SafetyParams{
+		IsDisabled:  true,
+		DelayBlocks: 100,
+	}
+	require.NotEqual(t, types.DefaultGenesis().EventParams, eventParams)
+	require.NotEqual(t, types.DefaultGenesis().ProposeParams, proposeParams)
+	require.NotEqual(t, types.DefaultGenesis().SafetyParams, safetyParams)
+
+	tApp := testapp.NewTestAppBuilder(t).WithGenesisDocFn(func() (genesis cometbfttypes.GenesisDoc) {
+		genesis = testapp.DefaultGenesis()
+		testapp.UpdateGenesisDocWithAppStateForModule(
+			&genesis,
+			func(genesisState *types.GenesisState) {
+				genesisState.EventParams = eventParams
+				genesisState.ProposeParams = proposeParams
+				genesisState.SafetyParams = safetyParams
+			},
+		)
+		return genesis
+	}).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+
+	eventParamsRes, err := k.EventParams(ctx, &types.QueryEventParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, eventParams, eventParamsRes.Params)
+
+	proposeParamsRes, err := k.ProposeParams(ctx, &types.QueryProposeParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, proposeParams, proposeParamsRes.Params)
+
+	safetyParamsRes, err := k.SafetyParams(ctx, &types.QuerySafetyParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, safetyParams, safetyParamsRes.Params)
+}
+
 func TestAcknowledgedEventInfo(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 	ctx := tApp.InitChain()
@@ -75,6 +75,14 @@ func (k Keeper) GetSafetyParams(
 	return params
 }
 
+// IsBridgingPaused returns true if acknowledging new bridge events is paused, which is the case
+// whenever bridging is disabled via `SafetyParams.IsDisabled`.
+func (k Keeper) IsBridgingPaused(
+	ctx sdk.Context,
+) bool {
+	return k.GetSafetyParams(ctx).IsDisabled
+}
+
 // UpdateSafetyParams updates the SafetyParams in state.
 // Returns an error iff validation fails.
 func (k Keeper) UpdateSafetyParams(
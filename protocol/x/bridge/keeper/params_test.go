@@ -94,3 +94,19 @@ func TestUpdateSafetyParams_Success(t *testing.T) {
 	require.NoError(t, k.UpdateSafetyParams(ctx, params))
 	require.Equal(t, params, k.GetSafetyParams(ctx))
 }
+
+func TestIsBridgingPaused(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.BridgeKeeper
+	require.False(t, k.IsBridgingPaused(ctx))
+
+	params := k.GetSafetyParams(ctx)
+	params.IsDisabled = true
+	require.NoError(t, k.UpdateSafetyParams(ctx, params))
+	require.True(t, k.IsBridgingPaused(ctx))
+
+	params.IsDisabled = false
+	require.NoError(t, k.UpdateSafetyParams(ctx, params))
+	require.False(t, k.IsBridgingPaused(ctx))
+}
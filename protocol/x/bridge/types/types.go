@@ -29,6 +29,8 @@ type BridgeKeeper interface {
 
 	UpdateSafetyParams(ctx sdk.Context, params SafetyParams) error
 
+	IsBridgingPaused(ctx sdk.Context) bool
+
 	// Authority.
 	HasAuthority(authority string) bool
 }
@@ -60,6 +60,9 @@ func EndBlocker(
 	// Prune any fill amounts from state which are now past their `pruneableBlockHeight`.
 	keeper.PruneStateFillAmountsForShortTermOrders(ctx)
 
+	// Prune the last liquidation block of subaccounts whose liquidation cooldown has ended.
+	keeper.PruneSubaccountLastLiquidationBlocks(ctx, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+
 	// Prune expired stateful orders completely from state.
 	expiredStatefulOrderIds := keeper.RemoveExpiredStatefulOrders(ctx, ctx.BlockTime())
 	for _, orderId := range expiredStatefulOrderIds {
@@ -113,18 +116,33 @@ func EndBlocker(
 		lib.UniqueSliceToSet(expiredStatefulOrderIds),
 	)
 
+	// Remove untriggered conditional orders whose trigger references a delisted or missing market, since
+	// they can never be triggered. These removed order ids will be purged from the memclob in `PrepareCheckState`.
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		keeper.RemoveUntriggeredConditionalOrdersForDelistedMarkets(ctx)...,
+	)
+
 	// Poll out all triggered conditional orders from `UntriggeredConditionalOrders` and update state.
 	triggeredConditionalOrderIds := keeper.MaybeTriggerConditionalOrders(ctx)
 	// Update the memstore with conditional order ids triggered in the last block.
 	// These triggered conditional orders will be placed in the `PrepareCheckState``.
 	processProposerMatchesEvents.ConditionalOrderIdsTriggeredInLastBlock = triggeredConditionalOrderIds
 
+	// Pause ClobPairs whose market oracle price went stale, and resume those whose price is no longer stale.
+	keeper.UpdateClobPairStatusesForStaleMarketPrices(ctx)
+
 	// Write the ProcessProposerMatchcesEvents with all the EndBlocker updates to state.
 	keeper.MustSetProcessProposerMatchesEvents(
 		ctx,
 		processProposerMatchesEvents,
 	)
 
+	// Verify that open interest has not drifted from subaccount positions, if enabled.
+	if keeper.Flags.OpenInterestInvariantCheckEnabled {
+		keeper.CheckOpenInterestInvariants(ctx)
+	}
+
 	// Emit relevant metrics at the end of every block.
 	metrics.SetGauge(
 		metrics.InsuranceFundBalance,
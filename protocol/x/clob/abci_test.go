@@ -462,6 +462,66 @@ func TestEndBlocker_Success(t *testing.T) {
 				},
 			},
 		},
+		`Force-cancelled untriggered conditional order is pruned from UntriggeredConditionalOrders and
+		not triggered once its trigger price is reached`: {
+			blockTime: unixTimeTen,
+			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext, m *mocks.MemClob) {
+				// Update the perpetual price such that the conditional order would be triggered.
+				err := ks.PricesKeeper.UpdateMarketPrices(ctx, []*prices.MsgUpdateMarketPrices_MarketPrice{
+					{
+						MarketId: constants.ClobPair_Btc.Id,
+						Price: types.SubticksToPrice(
+							types.Subticks(10),
+							constants.BtcUsdExponent,
+							constants.ClobPair_Btc,
+							constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.AtomicResolution,
+							lib.QuoteCurrencyAtomicResolution,
+						),
+					},
+				})
+				require.NoError(t, err)
+
+				order := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_TakeProfit10
+				ks.ClobKeeper.UntriggeredConditionalOrders = map[types.ClobPairId]*keeper.UntriggeredConditionalOrders{
+					constants.ClobPair_Btc.GetClobPairId(): {
+						OrdersToTriggerWhenOraclePriceLTETriggerPrice: []types.Order{order},
+						OrdersToTriggerWhenOraclePriceGTETriggerPrice: []types.Order{},
+					},
+				}
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, blockHeight)
+				ks.ClobKeeper.AddStatefulOrderIdExpiration(ctx, order.MustGetUnixGoodTilBlockTime(), order.OrderId)
+
+				ks.ClobKeeper.MustSetProcessProposerMatchesEvents(
+					ctx,
+					types.ProcessProposerMatchesEvents{
+						BlockHeight: blockHeight,
+					},
+				)
+
+				ks.ClobKeeper.GetIndexerEventManager().(*mocks.IndexerEventManager).On("AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewStatefulOrderRemovalEvent(
+							order.OrderId,
+							indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_FORCE_CANCELED,
+						),
+					),
+				).Once().Return()
+				require.NoError(t, ks.ClobKeeper.ForceCancelStatefulOrder(ctx, order.OrderId))
+			},
+			expectedStatefulPlacementInState: map[types.OrderId]bool{
+				constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_TakeProfit10.OrderId: false,
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+				RemovedStatefulOrderIds: []types.OrderId{
+					constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_TakeProfit10.OrderId,
+				},
+			},
+			expectedUntriggeredConditionalOrders: map[types.ClobPairId]*keeper.UntriggeredConditionalOrders{},
+		},
 		"Removes expired stateful orders and updates process proposer matches events": {
 			blockTime: unixTimeTen,
 			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext, m *mocks.MemClob) {
@@ -22,10 +22,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdListClobPair())
+	cmd.AddCommand(CmdListClobPairByStatus())
+	cmd.AddCommand(CmdListMarketsOverview())
 	cmd.AddCommand(CmdShowClobPair())
 	cmd.AddCommand(CmdGetBlockRateLimitConfiguration())
+	cmd.AddCommand(CmdGetClobPairMarketPrice())
 	cmd.AddCommand(CmdGetEquityTierLimitConfig())
+	cmd.AddCommand(CmdGetInsuranceFundBalance())
 	cmd.AddCommand(CmdGetLiquidationsConfiguration())
+	cmd.AddCommand(CmdGetLastBlockRejectedOperations())
+	cmd.AddCommand(CmdGetMaxOrderSize())
 	cmd.AddCommand(CmdQueryStatefulOrder())
 
 	return cmd
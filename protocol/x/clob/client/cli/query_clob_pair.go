@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -43,6 +44,72 @@ func CmdListClobPair() *cobra.Command {
 	return cmd
 }
 
+func CmdListClobPairByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-clob-pair-by-status [status]",
+		Short: "list all clob_pair with the given status, e.g. STATUS_ACTIVE",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			argStatus, found := types.ClobPair_Status_value[args[0]]
+			if !found {
+				return fmt.Errorf("invalid clob pair status: %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryClobPairsByStatusRequest{
+				Status: types.ClobPair_Status(argStatus),
+			}
+
+			res, err := queryClient.ClobPairsByStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListMarketsOverview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-markets-overview",
+		Short: "list all clob pairs with their perpetual ticker and market price",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllMarketsOverviewRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllMarketsOverview(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowClobPair() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-clob-pair [index]",
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetMaxOrderSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-max-order-size subaccount_owner subaccount_number perpetual_id side",
+		Short: "get the largest order size a subaccount can place without violating initial margin",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner := args[0]
+
+			number, err := cast.ToUint32E(args[1])
+			if err != nil {
+				return err
+			}
+
+			perpetualId, err := cast.ToUint32E(args[2])
+			if err != nil {
+				return err
+			}
+
+			side, err := cast.ToInt32E(args[3])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMaxOrderSizeRequest{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  owner,
+					Number: number,
+				},
+				PerpetualId: perpetualId,
+				Side:        types.Order_Side(side),
+			}
+
+			res, err := queryClient.MaxOrderSize(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdPlaceOrder())
 	cmd.AddCommand(CmdCancelOrder())
+	cmd.AddCommand(CmdReduceOrder())
 	batchCancelCmd := CmdBatchCancel()
 	batchCancelCmd.PersistentFlags().String("clientIds", "", "A list of client ids to to batch cancel")
 	cmd.AddCommand(batchCancelCmd)
@@ -925,6 +925,9 @@ func TestStats(t *testing.T) {
 		))
 	}
 
+	// Each pair of Alice and Bob orders results in a single fill of 5000 notional.
+	fillNotional := uint64(5000)
+
 	// Check that UserStats and GlobalStats reflect the orders filled
 	requireStatsEqual := func(expectedNotional uint64) {
 		require.Equal(t, &stattypes.UserStats{
@@ -937,6 +940,8 @@ func TestStats(t *testing.T) {
 		}, tApp.App.StatsKeeper.GetUserStats(ctx, bobAddress))
 		require.Equal(t, &stattypes.GlobalStats{
 			NotionalTraded: expectedNotional,
+			MakerNotional:  expectedNotional,
+			FillCount:      expectedNotional / fillNotional,
 		}, tApp.App.StatsKeeper.GetGlobalStats(ctx))
 	}
 
@@ -963,6 +968,7 @@ func TestStats(t *testing.T) {
 					},
 				},
 			},
+			FillCount: expectedNotional / fillNotional,
 		}, tApp.App.StatsKeeper.GetEpochStatsOrNil(ctx, epoch))
 	}
 
@@ -18,6 +18,8 @@ type ClobFlags struct {
 	MevTelemetryEnabled    bool
 	MevTelemetryHosts      []string
 	MevTelemetryIdentifier string
+
+	OpenInterestInvariantCheckEnabled bool
 }
 
 // List of CLI flags.
@@ -31,6 +33,9 @@ const (
 	MevTelemetryEnabled    = "mev-telemetry-enabled"
 	MevTelemetryHosts      = "mev-telemetry-hosts"
 	MevTelemetryIdentifier = "mev-telemetry-identifier"
+
+	// Invariants.
+	OpenInterestInvariantCheckEnabled = "open-interest-invariant-check-enabled"
 )
 
 // Default values.
@@ -43,6 +48,8 @@ const (
 	DefaultMevTelemetryEnabled    = false
 	DefaultMevTelemetryHostsFlag  = ""
 	DefaultMevTelemetryIdentifier = ""
+
+	DefaultOpenInterestInvariantCheckEnabled = false
 )
 
 var DefaultMevTelemetryHosts = []string{}
@@ -90,6 +97,12 @@ func AddClobFlagsToCmd(cmd *cobra.Command) {
 		DefaultMevTelemetryIdentifier,
 		"Sets the identifier to use for MEV Telemetry collection agents.",
 	)
+	cmd.Flags().Bool(
+		OpenInterestInvariantCheckEnabled,
+		DefaultOpenInterestInvariantCheckEnabled,
+		"Recomputes the open interest of every perpetual from subaccount positions in EndBlocker "+
+			"and logs any drift from the stored open interest if true.",
+	)
 }
 
 func GetDefaultClobFlags() ClobFlags {
@@ -100,6 +113,7 @@ func GetDefaultClobFlags() ClobFlags {
 		MevTelemetryEnabled:                 DefaultMevTelemetryEnabled,
 		MevTelemetryHosts:                   DefaultMevTelemetryHosts,
 		MevTelemetryIdentifier:              DefaultMevTelemetryIdentifier,
+		OpenInterestInvariantCheckEnabled:   DefaultOpenInterestInvariantCheckEnabled,
 	}
 }
 
@@ -148,5 +162,11 @@ func GetClobFlagValuesFromOptions(
 		}
 	}
 
+	if option := appOpts.Get(OpenInterestInvariantCheckEnabled); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.OpenInterestInvariantCheckEnabled = v
+		}
+	}
+
 	return result
 }
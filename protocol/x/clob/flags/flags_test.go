@@ -30,6 +30,9 @@ func TestAddFlagsToCommand(t *testing.T) {
 		fmt.Sprintf("Has %s flag", flags.MevTelemetryIdentifier): {
 			flagName: flags.MevTelemetryIdentifier,
 		},
+		fmt.Sprintf("Has %s flag", flags.OpenInterestInvariantCheckEnabled): {
+			flagName: flags.OpenInterestInvariantCheckEnabled,
+		},
 	}
 
 	for name, tc := range tests {
@@ -50,6 +53,7 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 		expectedMaxDeleveragingSubaccountsToIterate uint32
 		expectedMevTelemetryHosts                   []string
 		expectedMevTelemetryIdentifier              string
+		expectedOpenInterestInvariantCheckEnabled   bool
 	}{
 		"Sets to default if unset": {
 			expectedMaxLiquidationAttemptsPerBlock:      flags.DefaultMaxLiquidationAttemptsPerBlock,
@@ -57,6 +61,7 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 			expectedMaxDeleveragingSubaccountsToIterate: flags.DefaultMaxDeleveragingSubaccountsToIterate,
 			expectedMevTelemetryHosts:                   flags.DefaultMevTelemetryHosts,
 			expectedMevTelemetryIdentifier:              flags.DefaultMevTelemetryIdentifier,
+			expectedOpenInterestInvariantCheckEnabled:   flags.DefaultOpenInterestInvariantCheckEnabled,
 		},
 		"Sets values from options with one host": {
 			optsMap: map[string]any{
@@ -65,12 +70,14 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				flags.MaxDeleveragingSubaccountsToIterate: uint32(100),
 				flags.MevTelemetryHosts:                   "https://localhost:13137",
 				flags.MevTelemetryIdentifier:              "node-agent-01",
+				flags.OpenInterestInvariantCheckEnabled:   true,
 			},
 			expectedMaxLiquidationAttemptsPerBlock:      uint32(50),
 			expectedMaxDeleveragingAttemptsPerBlock:     uint32(25),
 			expectedMaxDeleveragingSubaccountsToIterate: uint32(100),
 			expectedMevTelemetryHosts:                   []string{"https://localhost:13137"},
 			expectedMevTelemetryIdentifier:              "node-agent-01",
+			expectedOpenInterestInvariantCheckEnabled:   true,
 		},
 		"Sets values from options with multiple hosts": {
 			optsMap: map[string]any{
@@ -122,6 +129,11 @@ func TestGetFlagValuesFromOptions(t *testing.T) {
 				tc.expectedMaxDeleveragingSubaccountsToIterate,
 				flags.MaxDeleveragingSubaccountsToIterate,
 			)
+			require.Equal(
+				t,
+				tc.expectedOpenInterestInvariantCheckEnabled,
+				flags.OpenInterestInvariantCheckEnabled,
+			)
 		})
 	}
 }
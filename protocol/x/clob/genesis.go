@@ -19,6 +19,11 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		if err != nil {
 			panic(errorsmod.Wrap(types.ErrInvalidClobPairParameter, err.Error()))
 		}
+		// ClobPairs cannot be created as paused, so paused ClobPairs are created as active and then paused.
+		status := elem.Status
+		if status == types.ClobPair_STATUS_PAUSED {
+			status = types.ClobPair_STATUS_ACTIVE
+		}
 		_, err = k.CreatePerpetualClobPair(
 			ctx,
 			elem.Id,
@@ -26,11 +31,16 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 			satypes.BaseQuantums(elem.StepBaseQuantums),
 			elem.QuantumConversionExponent,
 			elem.SubticksPerTick,
-			elem.Status,
+			status,
 		)
 		if err != nil {
 			panic(err)
 		}
+		if status != elem.Status {
+			if err := k.SetClobPairStatus(ctx, elem.GetClobPairId(), elem.Status); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// Create the `LiquidationsConfig` in state, and panic if the genesis state is invalid.
@@ -46,6 +56,9 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
+	// Create the `ClobPairConfig` in state.
+	k.InitializeClobPairConfig(ctx, genState.ClobPairConfig)
+
 	k.InitializeProcessProposerMatchesEvents(ctx)
 	k.ResetAllDeliveredOrderIds(ctx)
 }
@@ -65,6 +78,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	// Read the equity tier limit configuration from state.
 	genesis.EquityTierLimitConfig = k.GetEquityTierLimitConfiguration(ctx)
+	genesis.ClobPairConfig = k.GetClobPairConfig(ctx)
 
 	return genesis
 }
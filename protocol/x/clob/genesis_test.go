@@ -20,6 +20,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals"
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -484,3 +485,24 @@ func TestGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesis_PausedClobPair(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	ctx := ks.Ctx.WithBlockTime(constants.TimeT)
+
+	prices.InitGenesis(ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+	perpetuals.InitGenesis(ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+	// Paused clob pairs cannot be created directly, but exported paused clob pairs must be importable.
+	genesis := *types.DefaultGenesis()
+	genesis.ClobPairs = []types.ClobPair{constants.ClobPair_Btc_Paused}
+	clob.InitGenesis(ctx, ks.ClobKeeper, genesis)
+
+	got := clob.ExportGenesis(ctx, *ks.ClobKeeper)
+	require.Equal(t, genesis.ClobPairs, got.ClobPairs)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -16,6 +17,7 @@ import (
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -37,6 +39,7 @@ func clobPairKey(
 // Additionally, it creates an order book matching the ID of the newly created CLOB pair.
 //
 // An error will occur if any of the fields fail validation (see validateClobPair for details),
+// if `subticksPerTick` is below the `MinSubticksPerTick` of the `ClobPairConfig`,
 // or if the `perpetualId` cannot be found.
 // In the event of an error, the store will not be updated nor will a matching order book be created.
 //
@@ -85,6 +88,17 @@ func (k Keeper) CreatePerpetualClobPair(
 	if err := k.validateClobPair(ctx, &clobPair); err != nil {
 		return clobPair, err
 	}
+	// The governance minimum only applies to newly created CLOB pairs, so that raising it does not
+	// prevent updates to existing CLOB pairs created under a lower minimum.
+	minSubticksPerTick := k.GetClobPairConfig(ctx).MinSubticksPerTick
+	if clobPair.SubticksPerTick < minSubticksPerTick {
+		return clobPair, errorsmod.Wrapf(
+			types.ErrInvalidClobPairParameter,
+			"invalid ClobPair parameter: SubticksPerTick must be >= %v. Got %v",
+			minSubticksPerTick,
+			clobPair.SubticksPerTick,
+		)
+	}
 	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
 	if err != nil {
 		return clobPair, err
@@ -293,6 +307,34 @@ func (k Keeper) GetClobPair(
 	return val, true
 }
 
+// GetClobPairMarketPrice returns the oracle price of the market of the perpetual that the ClobPair
+// references. Returns an error if the ClobPair, its perpetual or the perpetual's market does not exist.
+func (k Keeper) GetClobPairMarketPrice(
+	ctx sdk.Context,
+	id types.ClobPairId,
+) (marketPrice pricestypes.MarketPrice, err error) {
+	clobPair, found := k.GetClobPair(ctx, id)
+	if !found {
+		return marketPrice, errorsmod.Wrapf(
+			types.ErrInvalidClob,
+			"Clob %v is not a valid clob",
+			id,
+		)
+	}
+
+	perpetualId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return marketPrice, err
+	}
+
+	_, marketPrice, err = k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if err != nil {
+		return pricestypes.MarketPrice{}, err
+	}
+
+	return marketPrice, nil
+}
+
 // RemoveClobPair removes a clobPair from the store
 func (k Keeper) RemoveClobPair(
 	ctx sdk.Context,
@@ -322,6 +364,19 @@ func (k Keeper) GetAllClobPairs(ctx sdk.Context) (list []types.ClobPair) {
 	return
 }
 
+// GetClobPairsByStatus returns all clobPair with the provided status, sorted by ClobPair id.
+func (k Keeper) GetClobPairsByStatus(
+	ctx sdk.Context,
+	status types.ClobPair_Status,
+) (list []types.ClobPair) {
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		if clobPair.Status == status {
+			list = append(list, clobPair)
+		}
+	}
+	return list
+}
+
 // validateLiquidationAgainstClobPairStatus returns an error if placing the provided
 // liquidation order would conflict with the clob pair's current status.
 func (k Keeper) validateLiquidationAgainstClobPairStatus(
@@ -427,6 +482,23 @@ func (k Keeper) validateOrderAgainstClobPairStatus(
 				clobPair.Status,
 			)
 		}
+	case types.ClobPair_STATUS_PAUSED:
+		// Only allow orders that close the subaccount's position. ClobPairs are paused when their
+		// perpetual's market is delisted, after which positions may only be reduced.
+		// Reduce-only orders are resized during matching, so only their side is validated here.
+		positionSize := k.GetStatePosition(ctx, order.OrderId.SubaccountId, clobPair.GetClobPairId())
+		isOppositeSide := (order.IsBuy() && positionSize.Sign() < 0) || (!order.IsBuy() && positionSize.Sign() > 0)
+		exceedsPosition := !order.IsReduceOnly() &&
+			new(big.Int).SetUint64(order.Quantums).CmpAbs(positionSize) > 0
+		if !isOppositeSide || exceedsPosition {
+			return errorsmod.Wrapf(
+				types.ErrOrderConflictsWithClobPairStatus,
+				"Order %+v must close the subaccount's position of %v for clob pair with status %+v",
+				order,
+				positionSize,
+				clobPair.Status,
+			)
+		}
 	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
 		return errorsmod.Wrapf(
 			types.ErrOrderConflictsWithClobPairStatus,
@@ -472,7 +544,7 @@ func (k Keeper) mustGetClobPairForPerpetualId(
 
 // UpdateClobPair overwrites a ClobPair in state and sends an update to the indexer.
 // This function returns an error if the update includes an unsupported transition
-// for the ClobPair's status.
+// for the ClobPair's status, or resumes a ClobPair whose perpetual's market is delisted.
 func (k Keeper) UpdateClobPair(
 	ctx sdk.Context,
 	clobPair types.ClobPair,
@@ -538,7 +610,20 @@ func (k Keeper) UpdateClobPair(
 		)
 	}
 
-	if err := k.validateClobPair(ctx, &clobPair); err != nil {
+	// ClobPairs paused by delisting their perpetual's market may never be resumed.
+	if oldStatus == types.ClobPair_STATUS_PAUSED &&
+		newStatus == types.ClobPair_STATUS_ACTIVE &&
+		k.isClobPairMarketDelisted(ctx, clobPair) {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClobPairStatusTransition,
+			"Cannot transition ClobPair %d from status %+v to status %+v since its perpetual's market is delisted",
+			clobPair.Id,
+			oldStatus,
+			newStatus,
+		)
+	}
+
+	if err := clobPair.ValidateUpdate(); err != nil {
 		return err
 	}
 
@@ -568,6 +653,28 @@ func (k Keeper) UpdateClobPair(
 	return nil
 }
 
+// SetClobPairStatus transitions the ClobPair with the provided id to the provided status.
+// The transition must be listed in `types.SupportedClobPairStatusTransitions`, otherwise
+// `ErrInvalidClobPairStatusTransition` is returned. ClobPairs that were paused by delisting their
+// perpetual's market, see `DelistPerpetualMarket`, cannot be resumed.
+func (k Keeper) SetClobPairStatus(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+	status types.ClobPair_Status,
+) error {
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClobPairUpdate,
+			"SetClobPairStatus: ClobPair with id %d not found in state",
+			clobPairId,
+		)
+	}
+
+	clobPair.Status = status
+	return k.UpdateClobPair(ctx, clobPair)
+}
+
 // getInternalOperationClobPairId returns the ClobPairId associated with the operation. This function
 // will panic if called for a PreexistingStatefulOrder internal operation since this operation type
 // should never be included in MsgProposedOperations.
@@ -655,6 +762,18 @@ func (k Keeper) validateInternalOperationAgainstClobPairStatus(
 			clobPairId,
 			types.ClobPair_STATUS_INITIALIZING,
 		)
+	case types.ClobPair_STATUS_PAUSED:
+		// Disallow liquidations. Order placements are validated to close positions, and subaccount
+		// updates for delisted perpetuals may only reduce positions.
+		if match := internalOperation.GetMatch(); match != nil && match.GetMatchPerpetualLiquidation() != nil {
+			return errorsmod.Wrapf(
+				types.ErrOperationConflictsWithClobPairStatus,
+				"Operation %s invalid for ClobPair with id %d with status %s",
+				internalOperation.GetInternalOperationTextString(),
+				clobPairId,
+				types.ClobPair_STATUS_PAUSED,
+			)
+		}
 	case types.ClobPair_STATUS_FINAL_SETTLEMENT:
 		// Only allow deleveraging events. This allows the protocol to close out open
 		// positions in the market. All other operations are not allowed.
@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetClobPairConfig gets the ClobPair config from state. If the config was never set in state,
+// the default config is returned, which applies no additional limits to ClobPairs.
+func (k Keeper) GetClobPairConfig(
+	ctx sdk.Context,
+) (config types.ClobPairConfig) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.ClobPairConfigKey))
+	if b == nil {
+		return types.ClobPairConfig{}
+	}
+
+	k.cdc.MustUnmarshal(b, &config)
+
+	return config
+}
+
+// InitializeClobPairConfig initializes the ClobPair config in state.
+// This function should only be called from CLOB genesis or when a ClobPair config
+// change is accepted via governance.
+func (k Keeper) InitializeClobPairConfig(
+	ctx sdk.Context,
+	config types.ClobPairConfig,
+) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&config)
+	store.Set([]byte(types.ClobPairConfigKey), b)
+}
+
+// ArePricesStale returns true if more than `MaxPriceUpdateGapBlocks` blocks have passed since the
+// last block in which any oracle price was updated. Always returns false if `MaxPriceUpdateGapBlocks`
+// is zero, or if the block in which any oracle price was last updated is unknown.
+func (k Keeper) ArePricesStale(ctx sdk.Context) bool {
+	return isPriceUpdateStale(
+		ctx,
+		k.pricesKeeper.GetLastPriceUpdateBlockHeight(ctx),
+		k.GetClobPairConfig(ctx).MaxPriceUpdateGapBlocks,
+	)
+}
+
+// IsClobPairMarketPriceStale returns true if more than the market's `MaxPriceUpdateGapBlocks` blocks
+// have passed since the last block in which the oracle price of the market underlying the ClobPair
+// was updated. Always returns false if the market's `MaxPriceUpdateGapBlocks` is zero, if the
+// ClobPair, its perpetual or the perpetual's market does not exist, or if the block in which the
+// market price was last updated is unknown, e.g. because it was never updated since the chain
+// started tracking it.
+func (k Keeper) IsClobPairMarketPriceStale(ctx sdk.Context, clobPairId types.ClobPairId) bool {
+	marketParam, found := k.getClobPairMarketParam(ctx, clobPairId)
+	if !found {
+		return false
+	}
+
+	return isPriceUpdateStale(
+		ctx,
+		k.pricesKeeper.GetMarketLastPriceUpdateBlockHeight(ctx, marketParam.Id),
+		marketParam.MaxPriceUpdateGapBlocks,
+	)
+}
+
+// isClobPairPausedByStalePrices returns true if fills that increase risk are paused on the ClobPair,
+// either because oracle prices are stale or because the oracle price of the ClobPair's market is stale.
+func (k Keeper) isClobPairPausedByStalePrices(ctx sdk.Context, clobPairId types.ClobPairId) bool {
+	return k.ArePricesStale(ctx) || k.IsClobPairMarketPriceStale(ctx, clobPairId)
+}
+
+// isPriceUpdateStale returns true if more than `maxGapBlocks` blocks have passed since the block at
+// `lastUpdateBlockHeight`. Always returns false if `maxGapBlocks` is zero, or if `lastUpdateBlockHeight`
+// is zero since the block of the last price update is then unknown.
+func isPriceUpdateStale(ctx sdk.Context, lastUpdateBlockHeight uint32, maxGapBlocks uint32) bool {
+	if maxGapBlocks == 0 || lastUpdateBlockHeight == 0 {
+		return false
+	}
+
+	return ctx.BlockHeight()-int64(lastUpdateBlockHeight) > int64(maxGapBlocks)
+}
+
+// UpdateClobPairStatusesForStaleMarketPrices pauses every ACTIVE ClobPair whose market oracle price is
+// stale, so that positions may only be closed until the price resumes updating. ClobPairs paused here
+// are resumed once their market oracle price is no longer stale, unless their perpetual's market was
+// delisted in the meantime. ClobPairs paused for any other reason are never resumed here.
+// This function should only be called from EndBlocker.
+func (k Keeper) UpdateClobPairStatusesForStaleMarketPrices(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.StaleMarketPricePausedClobPairKeyPrefix))
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		clobPairId := clobPair.GetClobPairId()
+		key := clobPairKey(clobPairId)
+		isStale := k.IsClobPairMarketPriceStale(ctx, clobPairId)
+
+		if isStale && clobPair.Status == types.ClobPair_STATUS_ACTIVE {
+			store.Set(key, []byte{})
+			k.setClobPairStatusInEndBlocker(ctx, clobPair, types.ClobPair_STATUS_PAUSED)
+		} else if !isStale && store.Has(key) {
+			store.Delete(key)
+			if clobPair.Status == types.ClobPair_STATUS_PAUSED && !k.isClobPairMarketDelisted(ctx, clobPair) {
+				k.setClobPairStatusInEndBlocker(ctx, clobPair, types.ClobPair_STATUS_ACTIVE)
+			}
+		}
+	}
+}
+
+// IsClobPairPausedForStaleMarketPrice returns true if the ClobPair was paused in EndBlocker because the
+// oracle price of its market went stale, and has not been resumed since.
+func (k Keeper) IsClobPairPausedForStaleMarketPrice(ctx sdk.Context, clobPairId types.ClobPairId) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.StaleMarketPricePausedClobPairKeyPrefix))
+	return store.Has(clobPairKey(clobPairId))
+}
+
+// setClobPairStatusInEndBlocker transitions the ClobPair to the provided status and emits an
+// end-of-block `UpdateClobPair` indexer event.
+func (k Keeper) setClobPairStatusInEndBlocker(
+	ctx sdk.Context,
+	clobPair types.ClobPair,
+	status types.ClobPair_Status,
+) {
+	clobPair.Status = status
+	k.setClobPair(ctx, clobPair)
+
+	k.GetIndexerEventManager().AddBlockEvent(
+		ctx,
+		indexerevents.SubtypeUpdateClobPair,
+		indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+		indexerevents.UpdateClobPairEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewUpdateClobPairEvent(
+				clobPair.GetClobPairId(),
+				clobPair.Status,
+				clobPair.QuantumConversionExponent,
+				types.SubticksPerTick(clobPair.GetSubticksPerTick()),
+				satypes.BaseQuantums(clobPair.GetStepBaseQuantums()),
+			),
+		),
+	)
+}
+
+// isClobPairMarketDelisted returns true if the market of the perpetual that the ClobPair references
+// has been delisted.
+func (k Keeper) isClobPairMarketDelisted(ctx sdk.Context, clobPair types.ClobPair) bool {
+	perpetualId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return false
+	}
+
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return false
+	}
+
+	return perpetual.IsMarketDelisted()
+}
+
+// getClobPairMarketParam returns the params of the market of the perpetual that the ClobPair
+// references, and whether they were found.
+func (k Keeper) getClobPairMarketParam(
+	ctx sdk.Context,
+	clobPairId types.ClobPairId,
+) (marketParam pricestypes.MarketParam, found bool) {
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found {
+		return marketParam, false
+	}
+
+	perpetualId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return marketParam, false
+	}
+
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return marketParam, false
+	}
+
+	return k.pricesKeeper.GetMarketParam(ctx, perpetual.Params.MarketId)
+}
+
+// validateOrderPlacementNotPausedByStalePrices returns an error if oracle prices are stale, or if the
+// oracle price of the order's ClobPair is stale, and the order is not reduce-only. Reduce-only orders
+// are still accepted since they can only decrease risk.
+func (k Keeper) validateOrderPlacementNotPausedByStalePrices(ctx sdk.Context, order types.Order) error {
+	if order.IsReduceOnly() {
+		return nil
+	}
+
+	if k.ArePricesStale(ctx) {
+		return errorsmod.Wrapf(
+			types.ErrOrderPlacementPausedStalePrices,
+			"last oracle price update at block %d, current block %d, order %+v",
+			k.pricesKeeper.GetLastPriceUpdateBlockHeight(ctx),
+			ctx.BlockHeight(),
+			order,
+		)
+	}
+
+	if k.IsClobPairMarketPriceStale(ctx, order.GetClobPairId()) {
+		return errorsmod.Wrapf(
+			types.ErrOrderPlacementPausedStaleMarketPrice,
+			"ClobPair %d oracle price is stale at block %d, order %+v",
+			order.GetClobPairId(),
+			ctx.BlockHeight(),
+			order,
+		)
+	}
+
+	return nil
+}
+
+// validateMatchNotPausedByStaleMarketPrice returns the update results of the taker and maker of a match
+// on a ClobPair paused by stale oracle prices, and an error if either fill would open, increase or flip
+// the perpetual position of its subaccount. Fills that only reduce or close a position are still
+// allowed since they can only decrease risk.
+func (k Keeper) validateMatchNotPausedByStaleMarketPrice(
+	ctx sdk.Context,
+	matchWithOrders *types.MatchWithOrders,
+	perpetualId uint32,
+) (
+	takerUpdateResult satypes.UpdateResult,
+	makerUpdateResult satypes.UpdateResult,
+	err error,
+) {
+	bigTakerQuantumsDelta := matchWithOrders.FillAmount.ToBigInt()
+	if !matchWithOrders.TakerOrder.IsBuy() {
+		bigTakerQuantumsDelta.Neg(bigTakerQuantumsDelta)
+	}
+	bigMakerQuantumsDelta := new(big.Int).Neg(bigTakerQuantumsDelta)
+
+	takerUpdateResult, makerUpdateResult = satypes.Success, satypes.Success
+	takerSubaccountId := matchWithOrders.TakerOrder.GetSubaccountId()
+	if k.fillIncreasesPositionSize(ctx, takerSubaccountId, perpetualId, bigTakerQuantumsDelta) {
+		takerUpdateResult = satypes.ViolatesStaleMarketPriceConstraints
+	}
+	makerSubaccountId := matchWithOrders.MakerOrder.GetSubaccountId()
+	if k.fillIncreasesPositionSize(ctx, makerSubaccountId, perpetualId, bigMakerQuantumsDelta) {
+		makerUpdateResult = satypes.ViolatesStaleMarketPriceConstraints
+	}
+
+	if !takerUpdateResult.IsSuccess() || !makerUpdateResult.IsSuccess() {
+		return takerUpdateResult, makerUpdateResult, errorsmod.Wrapf(
+			satypes.ErrFailedToUpdateSubaccounts,
+			"ClobPair %d oracle price is stale at block %d, taker result: %v, maker result: %v",
+			matchWithOrders.TakerOrder.GetClobPairId(),
+			ctx.BlockHeight(),
+			takerUpdateResult,
+			makerUpdateResult,
+		)
+	}
+	return takerUpdateResult, makerUpdateResult, nil
+}
+
+// fillIncreasesPositionSize returns true if applying `bigQuantumsDelta` to the position of the subaccount
+// in the perpetual would open, increase or flip the position.
+func (k Keeper) fillIncreasesPositionSize(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	bigQuantumsDelta *big.Int,
+) bool {
+	bigCurrentQuantums := new(big.Int)
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	if position, exists := subaccount.GetPerpetualPositionForId(perpetualId); exists {
+		bigCurrentQuantums = position.GetBigQuantums()
+	}
+	bigNewQuantums := new(big.Int).Add(bigCurrentQuantums, bigQuantumsDelta)
+
+	return bigNewQuantums.Sign() != 0 &&
+		(bigNewQuantums.Sign() != bigCurrentQuantums.Sign() || bigNewQuantums.CmpAbs(bigCurrentQuantums) > 0)
+}
@@ -0,0 +1,468 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArePricesStale(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		maxPriceUpdateGapBlocks uint32
+		priceUpdateBlockHeights []int64
+		blockHeight             int64
+
+		// Expectations.
+		expectedStale bool
+	}{
+		"Not stale when disabled": {
+			maxPriceUpdateGapBlocks: 0,
+			priceUpdateBlockHeights: []int64{2},
+			blockHeight:             100,
+			expectedStale:           false,
+		},
+		"Not stale within the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             12,
+			expectedStale:           false,
+		},
+		"Not stale at the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             15,
+			expectedStale:           false,
+		},
+		"Stale beyond the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             16,
+			expectedStale:           true,
+		},
+		"Not stale when prices were never updated": {
+			maxPriceUpdateGapBlocks: 5,
+			blockHeight:             6,
+			expectedStale:           false,
+		},
+		"Not stale once prices resume": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 30},
+			blockHeight:             30,
+			expectedStale:           false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := setUpStalePricesTest(t, tc.maxPriceUpdateGapBlocks, tc.priceUpdateBlockHeights)
+
+			require.Equal(t, tc.expectedStale, ks.ClobKeeper.ArePricesStale(ks.Ctx.WithBlockHeight(tc.blockHeight)))
+		})
+	}
+}
+
+func TestPlaceOrder_PausedWhenPricesStale(t *testing.T) {
+	// The price daemon goes silent after block 5, so prices are stale at block 12.
+	ks := setUpStalePricesTest(t, 5, []int64{2, 5})
+	ctx := ks.Ctx.WithBlockHeight(12)
+	require.True(t, ks.ClobKeeper.ArePricesStale(ctx))
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(1, 0),
+	})
+
+	_, _, err := ks.ClobKeeper.PlaceShortTermOrder(
+		ctx.WithIsCheckTx(true),
+		types.NewMsgPlaceOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20),
+	)
+	require.ErrorIs(t, err, types.ErrOrderPlacementPausedStalePrices)
+
+	err = ks.ClobKeeper.PlaceStatefulOrder(
+		ctx,
+		types.NewMsgPlaceOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5),
+		false,
+	)
+	require.ErrorIs(t, err, types.ErrOrderPlacementPausedStalePrices)
+	require.Empty(t, ks.ClobKeeper.GetAllStatefulOrders(ctx))
+}
+
+func TestProcessSingleMatch_PausedWhenPricesStale(t *testing.T) {
+	// The price daemon goes silent after block 5, so prices are stale at block 12.
+	ks := setUpStalePricesTest(t, 5, []int64{2, 5})
+	ctx := ks.Ctx.WithBlockHeight(12)
+	require.True(t, ks.ClobKeeper.ArePricesStale(ctx))
+	require.False(t, ks.ClobKeeper.IsClobPairMarketPriceStale(ctx, 0))
+
+	// Neither subaccount has a position, so both fills would open a position.
+	takerOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+	makerOrder := constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22
+	success, takerUpdateResult, makerUpdateResult, err := ks.ClobKeeper.ProcessSingleMatch(
+		ctx,
+		&types.MatchWithOrders{
+			TakerOrder: &takerOrder,
+			MakerOrder: &makerOrder,
+			FillAmount: 5,
+		},
+	)
+	require.False(t, success)
+	require.ErrorIs(t, err, satypes.ErrFailedToUpdateSubaccounts)
+	require.Equal(t, satypes.ViolatesStaleMarketPriceConstraints, takerUpdateResult)
+	require.Equal(t, satypes.ViolatesStaleMarketPriceConstraints, makerUpdateResult)
+}
+
+func TestIsClobPairMarketPriceStale(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		maxPriceUpdateGapBlocks uint32
+		priceUpdateBlockHeights []int64
+		blockHeight             int64
+		clobPairId              types.ClobPairId
+
+		// Expectations.
+		expectedStale bool
+	}{
+		"Not stale when disabled": {
+			maxPriceUpdateGapBlocks: 0,
+			priceUpdateBlockHeights: []int64{2},
+			blockHeight:             100,
+			clobPairId:              0,
+			expectedStale:           false,
+		},
+		"Not stale within the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             12,
+			clobPairId:              0,
+			expectedStale:           false,
+		},
+		"Not stale at the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             15,
+			clobPairId:              0,
+			expectedStale:           false,
+		},
+		"Stale beyond the gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2, 10},
+			blockHeight:             16,
+			clobPairId:              0,
+			expectedStale:           true,
+		},
+		"Not stale when the market price was never updated": {
+			maxPriceUpdateGapBlocks: 5,
+			blockHeight:             100,
+			clobPairId:              0,
+			expectedStale:           false,
+		},
+		"Not stale for a ClobPair whose market has no max gap": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2},
+			blockHeight:             100,
+			clobPairId:              1,
+			expectedStale:           false,
+		},
+		"Not stale for a ClobPair that does not exist": {
+			maxPriceUpdateGapBlocks: 5,
+			priceUpdateBlockHeights: []int64{2},
+			blockHeight:             100,
+			clobPairId:              2,
+			expectedStale:           false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := setUpStaleMarketPriceTest(t, tc.maxPriceUpdateGapBlocks, tc.priceUpdateBlockHeights)
+
+			require.Equal(
+				t,
+				tc.expectedStale,
+				ks.ClobKeeper.IsClobPairMarketPriceStale(ks.Ctx.WithBlockHeight(tc.blockHeight), tc.clobPairId),
+			)
+		})
+	}
+}
+
+func TestPlaceOrder_PausedWhenMarketPriceStale(t *testing.T) {
+	// The BTC market price was last updated at block 5, so it is stale at block 12. The ETH market has
+	// no max gap, so the ETH ClobPair is unaffected.
+	ks := setUpStaleMarketPriceTest(t, 5, []int64{2, 5})
+	ctx := ks.Ctx.WithBlockHeight(12)
+	require.False(t, ks.ClobKeeper.ArePricesStale(ctx))
+	require.True(t, ks.ClobKeeper.IsClobPairMarketPriceStale(ctx, 0))
+	require.False(t, ks.ClobKeeper.IsClobPairMarketPriceStale(ctx, 1))
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(1, 0),
+	})
+
+	_, _, err := ks.ClobKeeper.PlaceShortTermOrder(
+		ctx.WithIsCheckTx(true),
+		types.NewMsgPlaceOrder(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20),
+	)
+	require.ErrorIs(t, err, types.ErrOrderPlacementPausedStaleMarketPrice)
+
+	err = ks.ClobKeeper.PlaceStatefulOrder(
+		ctx,
+		types.NewMsgPlaceOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5),
+		false,
+	)
+	require.ErrorIs(t, err, types.ErrOrderPlacementPausedStaleMarketPrice)
+	require.Empty(t, ks.ClobKeeper.GetAllStatefulOrders(ctx))
+}
+
+func TestProcessSingleMatch_PausedWhenMarketPriceStale(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		alicePositionQuantums int64
+		bobPositionQuantums   int64
+
+		// Expectations.
+		expectedTakerUpdateResult satypes.UpdateResult
+		expectedMakerUpdateResult satypes.UpdateResult
+	}{
+		"Fails when both fills open a position": {
+			expectedTakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+			expectedMakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+		},
+		"Fails when both fills increase a position": {
+			alicePositionQuantums:     10,
+			bobPositionQuantums:       -10,
+			expectedTakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+			expectedMakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+		},
+		"Fails when the maker fill opens a position and the taker fill reduces a position": {
+			alicePositionQuantums:     -10,
+			expectedTakerUpdateResult: satypes.Success,
+			expectedMakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+		},
+		"Fails when the taker fill flips a position and the maker fill closes a position": {
+			alicePositionQuantums:     -3,
+			bobPositionQuantums:       5,
+			expectedTakerUpdateResult: satypes.ViolatesStaleMarketPriceConstraints,
+			expectedMakerUpdateResult: satypes.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// The BTC market price was last updated at block 5, so it is stale at block 12.
+			ks := setUpStaleMarketPriceTest(t, 5, []int64{2, 5})
+			ctx := ks.Ctx.WithBlockHeight(12)
+			require.True(t, ks.ClobKeeper.IsClobPairMarketPriceStale(ctx, 0))
+
+			for subaccountId, quantums := range map[satypes.SubaccountId]int64{
+				constants.Alice_Num0: tc.alicePositionQuantums,
+				constants.Bob_Num0:   tc.bobPositionQuantums,
+			} {
+				subaccount := satypes.Subaccount{Id: &subaccountId}
+				if quantums != 0 {
+					subaccount.PerpetualPositions = []*satypes.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(0, big.NewInt(quantums), big.NewInt(0), big.NewInt(0)),
+					}
+				}
+				ks.SubaccountsKeeper.SetSubaccount(ctx, subaccount)
+			}
+
+			takerOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+			makerOrder := constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22
+			success, takerUpdateResult, makerUpdateResult, err := ks.ClobKeeper.ProcessSingleMatch(
+				ctx,
+				&types.MatchWithOrders{
+					TakerOrder: &takerOrder,
+					MakerOrder: &makerOrder,
+					FillAmount: 5,
+				},
+			)
+			require.False(t, success)
+			require.ErrorIs(t, err, satypes.ErrFailedToUpdateSubaccounts)
+			require.Equal(t, tc.expectedTakerUpdateResult, takerUpdateResult)
+			require.Equal(t, tc.expectedMakerUpdateResult, makerUpdateResult)
+
+			// Neither order was filled.
+			_, takerFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, takerOrder.OrderId)
+			require.Zero(t, takerFillAmount)
+			_, makerFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, makerOrder.OrderId)
+			require.Zero(t, makerFillAmount)
+		})
+	}
+}
+
+func TestUpdateClobPairStatusesForStaleMarketPrices(t *testing.T) {
+	// The BTC market price was last updated at block 5 and is stale after block 10. The ETH market has
+	// no max gap and is never stale.
+	ks := setUpStaleMarketPriceTest(t, 5, []int64{2, 5})
+	indexerEventManager := ks.ClobKeeper.GetIndexerEventManager().(*mocks.IndexerEventManager)
+	indexerEventManager.On("AddBlockEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	requireClobPairStatuses := func(ctx sdk.Context, btcStatus, ethStatus types.ClobPair_Status) {
+		btcClobPair, found := ks.ClobKeeper.GetClobPair(ctx, 0)
+		require.True(t, found)
+		require.Equal(t, btcStatus, btcClobPair.Status)
+		ethClobPair, found := ks.ClobKeeper.GetClobPair(ctx, 1)
+		require.True(t, found)
+		require.Equal(t, ethStatus, ethClobPair.Status)
+	}
+
+	// Neither market price is stale.
+	ctx := ks.Ctx.WithBlockHeight(10)
+	ks.ClobKeeper.UpdateClobPairStatusesForStaleMarketPrices(ctx)
+	requireClobPairStatuses(ctx, types.ClobPair_STATUS_ACTIVE, types.ClobPair_STATUS_ACTIVE)
+	require.False(t, ks.ClobKeeper.IsClobPairPausedForStaleMarketPrice(ctx, 0))
+
+	// Only the BTC ClobPair is paused once its market price goes stale.
+	ctx = ks.Ctx.WithBlockHeight(11)
+	ks.ClobKeeper.UpdateClobPairStatusesForStaleMarketPrices(ctx)
+	requireClobPairStatuses(ctx, types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_ACTIVE)
+	require.True(t, ks.ClobKeeper.IsClobPairPausedForStaleMarketPrice(ctx, 0))
+	require.False(t, ks.ClobKeeper.IsClobPairPausedForStaleMarketPrice(ctx, 1))
+	indexerEventManager.AssertNumberOfCalls(t, "AddBlockEvent", 1)
+
+	// The ETH ClobPair is paused by governance, which is never resumed in EndBlocker.
+	require.NoError(t, ks.ClobKeeper.SetClobPairStatus(ctx, 1, types.ClobPair_STATUS_PAUSED))
+
+	// The BTC ClobPair stays paused while its market price is stale.
+	ctx = ks.Ctx.WithBlockHeight(12)
+	ks.ClobKeeper.UpdateClobPairStatusesForStaleMarketPrices(ctx)
+	requireClobPairStatuses(ctx, types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_PAUSED)
+	indexerEventManager.AssertNumberOfCalls(t, "AddBlockEvent", 1)
+
+	// The BTC ClobPair is resumed once its market price is updated again.
+	err := ks.PricesKeeper.UpdateMarketPrices(
+		ctx,
+		[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+			pricestypes.NewMarketPriceUpdate(0, 2_000),
+		},
+	)
+	require.NoError(t, err)
+	ks.ClobKeeper.UpdateClobPairStatusesForStaleMarketPrices(ctx)
+	requireClobPairStatuses(ctx, types.ClobPair_STATUS_ACTIVE, types.ClobPair_STATUS_PAUSED)
+	require.False(t, ks.ClobKeeper.IsClobPairPausedForStaleMarketPrice(ctx, 0))
+	indexerEventManager.AssertNumberOfCalls(t, "AddBlockEvent", 2)
+}
+
+// setUpStalePricesTest creates a ClobPair whose oracle prices were updated at the provided block heights,
+// with the provided `MaxPriceUpdateGapBlocks`.
+func setUpStalePricesTest(
+	t *testing.T,
+	maxPriceUpdateGapBlocks uint32,
+	priceUpdateBlockHeights []int64,
+) keepertest.ClobKeepersTestContext {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	indexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	keepertest.CreateTestPricesAndPerpetualMarkets(
+		t,
+		ks.Ctx,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		[]perptypes.Perpetual{
+			*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+		},
+		[]pricestypes.MarketParamPrice{
+			*pricestest.GenerateMarketParamPrice(pricestest.WithId(0)),
+		},
+	)
+	keepertest.CreateTestClobPairs(
+		t,
+		ks.Ctx,
+		ks.ClobKeeper,
+		[]types.ClobPair{
+			*clobtest.GenerateClobPair(clobtest.WithId(0), clobtest.WithPerpetualId(0)),
+		},
+	)
+	ks.ClobKeeper.InitializeClobPairConfig(
+		ks.Ctx,
+		types.ClobPairConfig{MaxPriceUpdateGapBlocks: maxPriceUpdateGapBlocks},
+	)
+
+	for i, blockHeight := range priceUpdateBlockHeights {
+		err := ks.PricesKeeper.UpdateMarketPrices(
+			ks.Ctx.WithBlockHeight(blockHeight),
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+				pricestypes.NewMarketPriceUpdate(0, uint64(1_000+i)),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	return ks
+}
+
+// setUpStaleMarketPriceTest creates a BTC ClobPair whose market has the provided `MaxPriceUpdateGapBlocks`
+// and whose oracle price was updated at the provided block heights, and an ETH ClobPair whose market has
+// no max gap and whose oracle price was never updated.
+func setUpStaleMarketPriceTest(
+	t *testing.T,
+	maxPriceUpdateGapBlocks uint32,
+	priceUpdateBlockHeights []int64,
+) keepertest.ClobKeepersTestContext {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	indexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	keepertest.CreateTestPricesAndPerpetualMarkets(
+		t,
+		ks.Ctx,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		[]perptypes.Perpetual{
+			*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+			*perptest.GeneratePerpetual(perptest.WithId(1), perptest.WithMarketId(1)),
+		},
+		[]pricestypes.MarketParamPrice{
+			*pricestest.GenerateMarketParamPrice(
+				pricestest.WithId(0),
+				pricestest.WithPair("BTC-USD"),
+				pricestest.WithMaxPriceUpdateGapBlocks(maxPriceUpdateGapBlocks),
+			),
+			*pricestest.GenerateMarketParamPrice(pricestest.WithId(1), pricestest.WithPair("ETH-USD")),
+		},
+	)
+	keepertest.CreateTestClobPairs(
+		t,
+		ks.Ctx,
+		ks.ClobKeeper,
+		[]types.ClobPair{
+			*clobtest.GenerateClobPair(clobtest.WithId(0), clobtest.WithPerpetualId(0)),
+			*clobtest.GenerateClobPair(clobtest.WithId(1), clobtest.WithPerpetualId(1)),
+		},
+	)
+
+	for i, blockHeight := range priceUpdateBlockHeights {
+		err := ks.PricesKeeper.UpdateMarketPrices(
+			ks.Ctx.WithBlockHeight(blockHeight),
+			[]*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+				pricestypes.NewMarketPriceUpdate(0, uint64(1_000+i)),
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	return ks
+}
@@ -146,6 +146,134 @@ func TestCreatePerpetualClobPair_FailsWithPerpetualAssociatedWithExistingClobPai
 	)
 }
 
+func TestCreatePerpetualClobPair_FailsWithSubticksPerTickBelowMinimum(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	// Set up mock indexer event manager that accepts anything.
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		mockIndexerEventManager,
+	)
+	// Create test perpetuals and markets (ids 0 and 1).
+	keepertest.CreateTestPricesAndPerpetualMarkets(
+		t,
+		ks.Ctx,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		[]perptypes.Perpetual{
+			*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+			*perptest.GeneratePerpetual(
+				perptest.WithId(1),
+				perptest.WithMarketId(1),
+				perptest.WithTicker("ETH-USD"),
+			),
+		},
+		[]pricestypes.MarketParamPrice{
+			*pricestest.GenerateMarketParamPrice(pricestest.WithId(0)),
+			*pricestest.GenerateMarketParamPrice(pricestest.WithId(1), pricestest.WithPair("ETH-USD")),
+		},
+	)
+	ks.ClobKeeper.InitializeClobPairConfig(ks.Ctx, types.ClobPairConfig{MinSubticksPerTick: 100})
+
+	// A clob pair below the global minimum is rejected.
+	belowMinimum := clobtest.GenerateClobPair(
+		clobtest.WithId(0),
+		clobtest.WithPerpetualId(0),
+		clobtest.WithSubticksPerTick(99),
+	)
+	_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+		ks.Ctx,
+		belowMinimum.Id,
+		belowMinimum.MustGetPerpetualId(),
+		satypes.BaseQuantums(belowMinimum.StepBaseQuantums),
+		belowMinimum.QuantumConversionExponent,
+		belowMinimum.SubticksPerTick,
+		belowMinimum.Status,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidClobPairParameter)
+	require.ErrorContains(t, err, "SubticksPerTick must be >= 100")
+	_, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(belowMinimum.Id))
+	require.False(t, found)
+
+	// A clob pair at the global minimum is created.
+	atMinimum := clobtest.GenerateClobPair(
+		clobtest.WithId(1),
+		clobtest.WithPerpetualId(1),
+		clobtest.WithSubticksPerTick(100),
+	)
+	_, err = ks.ClobKeeper.CreatePerpetualClobPair(
+		ks.Ctx,
+		atMinimum.Id,
+		atMinimum.MustGetPerpetualId(),
+		satypes.BaseQuantums(atMinimum.StepBaseQuantums),
+		atMinimum.QuantumConversionExponent,
+		atMinimum.SubticksPerTick,
+		atMinimum.Status,
+	)
+	require.NoError(t, err)
+	_, found = ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(atMinimum.Id))
+	require.True(t, found)
+}
+
+func TestUpdateClobPair_SucceedsAfterMinSubticksPerTickRaised(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	// Set up mock indexer event manager that accepts anything.
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		mockIndexerEventManager,
+	)
+	keepertest.CreateTestPricesAndPerpetualMarkets(
+		t,
+		ks.Ctx,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		[]perptypes.Perpetual{
+			*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+		},
+		[]pricestypes.MarketParamPrice{
+			*pricestest.GenerateMarketParamPrice(pricestest.WithId(0)),
+		},
+	)
+	ks.ClobKeeper.InitializeClobPairConfig(ks.Ctx, types.ClobPairConfig{MinSubticksPerTick: 100})
+
+	clobPair := clobtest.GenerateClobPair(
+		clobtest.WithId(0),
+		clobtest.WithPerpetualId(0),
+		clobtest.WithSubticksPerTick(100),
+		clobtest.WithStatus(types.ClobPair_STATUS_INITIALIZING),
+	)
+	_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+		ks.Ctx,
+		clobPair.Id,
+		clobPair.MustGetPerpetualId(),
+		satypes.BaseQuantums(clobPair.StepBaseQuantums),
+		clobPair.QuantumConversionExponent,
+		clobPair.SubticksPerTick,
+		clobPair.Status,
+	)
+	require.NoError(t, err)
+
+	// Raising the global minimum above the existing clob pair's SubticksPerTick does not block updates to it.
+	ks.ClobKeeper.InitializeClobPairConfig(ks.Ctx, types.ClobPairConfig{MinSubticksPerTick: 1_000})
+	clobPair.Status = types.ClobPair_STATUS_ACTIVE
+	require.NoError(t, ks.ClobKeeper.UpdateClobPair(ks.Ctx, *clobPair))
+
+	got, found := ks.ClobKeeper.GetClobPair(ks.Ctx, types.ClobPairId(clobPair.Id))
+	require.True(t, found)
+	require.Equal(t, *clobPair, got)
+}
+
 func TestCreatePerpetualClobPair_FailsWithDuplicateClobPairId(t *testing.T) {
 	memClob := memclob.NewMemClobPriceTimePriority(false)
 	mockIndexerEventManager := &mocks.IndexerEventManager{}
@@ -586,19 +714,35 @@ func TestClobPairRemove(t *testing.T) {
 func TestClobPairGetAll(t *testing.T) {
 	memClob := memclob.NewMemClobPriceTimePriority(false)
 	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	mockIndexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
 	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
-	items := keepertest.CreateNClobPair(t,
-		ks.ClobKeeper,
-		ks.PerpetualsKeeper,
-		ks.PricesKeeper,
-		ks.Ctx,
-		10,
-		mockIndexerEventManager,
-	)
-	require.ElementsMatch(t,
-		nullify.Fill(items), //nolint:staticcheck
-		nullify.Fill(ks.ClobKeeper.GetAllClobPairs(ks.Ctx)), //nolint:staticcheck
-	)
+
+	// Create ClobPairs with out-of-order ids, including ids whose big-endian encodings span multiple bytes.
+	ids := []uint32{300, 7, 256, 1, 65, 2, 1000, 0, 12, 5}
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, ks.Ctx, ks.PerpetualsKeeper, ks.PricesKeeper, len(ids))
+	for i, id := range ids {
+		_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+			ks.Ctx,
+			id,
+			perps[i].Params.Id,
+			satypes.BaseQuantums(5),
+			0,
+			5,
+			types.ClobPair_STATUS_ACTIVE,
+		)
+		require.NoError(t, err)
+	}
+
+	// ClobPairs are returned in strictly ascending id order.
+	clobPairs := ks.ClobKeeper.GetAllClobPairs(ks.Ctx)
+	require.Len(t, clobPairs, len(ids))
+	for i := 1; i < len(clobPairs); i++ {
+		require.Less(t, clobPairs[i-1].Id, clobPairs[i].Id)
+	}
+	require.Equal(t, uint32(0), clobPairs[0].Id)
+	require.Equal(t, uint32(1000), clobPairs[len(clobPairs)-1].Id)
 }
 
 func TestUpdateClobPair_FinalSettlement(t *testing.T) {
@@ -882,6 +1026,155 @@ func TestUpdateClobPair(t *testing.T) {
 	}
 }
 
+func TestSetClobPairStatus(t *testing.T) {
+	testCases := map[string]struct {
+		fromStatus  types.ClobPair_Status
+		toStatus    types.ClobPair_Status
+		expectedErr error
+	}{
+		"Succeeds transitioning from initializing to active": {
+			fromStatus: types.ClobPair_STATUS_INITIALIZING,
+			toStatus:   types.ClobPair_STATUS_ACTIVE,
+		},
+		"Succeeds transitioning from initializing to final settlement": {
+			fromStatus: types.ClobPair_STATUS_INITIALIZING,
+			toStatus:   types.ClobPair_STATUS_FINAL_SETTLEMENT,
+		},
+		"Succeeds transitioning from active to final settlement": {
+			fromStatus: types.ClobPair_STATUS_ACTIVE,
+			toStatus:   types.ClobPair_STATUS_FINAL_SETTLEMENT,
+		},
+		"Succeeds transitioning from final settlement to initializing": {
+			fromStatus: types.ClobPair_STATUS_FINAL_SETTLEMENT,
+			toStatus:   types.ClobPair_STATUS_INITIALIZING,
+		},
+		"Succeeds transitioning from paused to final settlement": {
+			fromStatus: types.ClobPair_STATUS_PAUSED,
+			toStatus:   types.ClobPair_STATUS_FINAL_SETTLEMENT,
+		},
+		"Succeeds transitioning from active to paused": {
+			fromStatus: types.ClobPair_STATUS_ACTIVE,
+			toStatus:   types.ClobPair_STATUS_PAUSED,
+		},
+		"Succeeds transitioning from paused to active": {
+			fromStatus: types.ClobPair_STATUS_PAUSED,
+			toStatus:   types.ClobPair_STATUS_ACTIVE,
+		},
+		"Succeeds transitioning from active to active": {
+			fromStatus: types.ClobPair_STATUS_ACTIVE,
+			toStatus:   types.ClobPair_STATUS_ACTIVE,
+		},
+		"Errors transitioning from active to unspecified": {
+			fromStatus:  types.ClobPair_STATUS_ACTIVE,
+			toStatus:    types.ClobPair_STATUS_UNSPECIFIED,
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
+		"Errors transitioning from active to initializing": {
+			fromStatus:  types.ClobPair_STATUS_ACTIVE,
+			toStatus:    types.ClobPair_STATUS_INITIALIZING,
+			expectedErr: types.ErrInvalidClobPairStatusTransition,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+			prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+			perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+			mockIndexerEventManager.On("AddTxnEvent",
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+				mock.Anything,
+			).Return()
+
+			// ClobPairs cannot be created as paused, so paused ClobPairs are created as active and then paused.
+			createStatus := tc.fromStatus
+			if createStatus == types.ClobPair_STATUS_PAUSED {
+				createStatus = types.ClobPair_STATUS_ACTIVE
+			}
+			clobPair := constants.ClobPair_Btc
+			_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+				ks.Ctx,
+				clobPair.Id,
+				clobtest.MustPerpetualId(clobPair),
+				satypes.BaseQuantums(clobPair.StepBaseQuantums),
+				clobPair.QuantumConversionExponent,
+				clobPair.SubticksPerTick,
+				createStatus,
+			)
+			require.NoError(t, err)
+			require.NoError(t, ks.ClobKeeper.SetClobPairStatus(ks.Ctx, clobPair.GetClobPairId(), tc.fromStatus))
+
+			err = ks.ClobKeeper.SetClobPairStatus(ks.Ctx, clobPair.GetClobPairId(), tc.toStatus)
+			got, found := ks.ClobKeeper.GetClobPair(ks.Ctx, clobPair.GetClobPairId())
+			require.True(t, found)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, tc.fromStatus, got.Status)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.toStatus, got.Status)
+			}
+		})
+	}
+
+	t.Run("Errors with missing clob pair", func(t *testing.T) {
+		memClob := memclob.NewMemClobPriceTimePriority(false)
+		ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+		err := ks.ClobKeeper.SetClobPairStatus(ks.Ctx, types.ClobPairId(0), types.ClobPair_STATUS_ACTIVE)
+		require.ErrorIs(t, err, types.ErrInvalidClobPairUpdate)
+	})
+
+	t.Run("Errors resuming a clob pair whose perpetual's market is delisted", func(t *testing.T) {
+		memClob := memclob.NewMemClobPriceTimePriority(false)
+		mockIndexerEventManager := &mocks.IndexerEventManager{}
+		ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+		prices.InitGenesis(ks.Ctx, *ks.PricesKeeper, constants.Prices_DefaultGenesisState)
+		perpetuals.InitGenesis(ks.Ctx, *ks.PerpetualsKeeper, constants.Perpetuals_DefaultGenesisState)
+
+		mockIndexerEventManager.On("AddTxnEvent",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return()
+
+		clobPair := constants.ClobPair_Btc
+		_, err := ks.ClobKeeper.CreatePerpetualClobPair(
+			ks.Ctx,
+			clobPair.Id,
+			clobtest.MustPerpetualId(clobPair),
+			satypes.BaseQuantums(clobPair.StepBaseQuantums),
+			clobPair.QuantumConversionExponent,
+			clobPair.SubticksPerTick,
+			types.ClobPair_STATUS_ACTIVE,
+		)
+		require.NoError(t, err)
+		require.NoError(t, ks.ClobKeeper.DelistPerpetualMarket(ks.Ctx, clobtest.MustPerpetualId(clobPair)))
+
+		err = ks.ClobKeeper.SetClobPairStatus(ks.Ctx, clobPair.GetClobPairId(), types.ClobPair_STATUS_ACTIVE)
+		require.ErrorIs(t, err, types.ErrInvalidClobPairStatusTransition)
+
+		clobPair.Status = types.ClobPair_STATUS_ACTIVE
+		err = ks.ClobKeeper.UpdateClobPair(ks.Ctx, clobPair)
+		require.ErrorIs(t, err, types.ErrInvalidClobPairStatusTransition)
+
+		got, found := ks.ClobKeeper.GetClobPair(ks.Ctx, clobPair.GetClobPairId())
+		require.True(t, found)
+		require.Equal(t, types.ClobPair_STATUS_PAUSED, got.Status)
+
+		// Delisted clob pairs may still be moved to final settlement.
+		require.NoError(
+			t,
+			ks.ClobKeeper.SetClobPairStatus(ks.Ctx, clobPair.GetClobPairId(), types.ClobPair_STATUS_FINAL_SETTLEMENT),
+		)
+	})
+}
+
 func TestGetClobPairIdForPerpetual_Success(t *testing.T) {
 	memClob := memclob.NewMemClobPriceTimePriority(false)
 	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
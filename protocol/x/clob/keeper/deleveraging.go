@@ -337,7 +337,15 @@ func (k Keeper) OffsetSubaccountPerpetualPosition(
 	// Iterate at most `MaxDeleveragingSubaccountsToIterate` subaccounts.
 	numSubaccountsToIterate := lib.Min(numSubaccounts, int(k.Flags.MaxDeleveragingSubaccountsToIterate))
 
+	// Stop once `MaxDeleveragingFillsPerOperation` fills are generated, since the resulting operation
+	// would otherwise be rejected by `PersistMatchDeleveragingToState`.
+	maxFills := k.GetLiquidationsConfig(ctx).MaxDeleveragingFillsPerOperation
+
 	for i := 0; i < numSubaccountsToIterate && deltaQuantumsRemaining.Sign() != 0; i++ {
+		if maxFills != 0 && len(fills) >= int(maxFills) {
+			break
+		}
+
 		index := (i + indexOffset) % numSubaccounts
 		subaccountId := subaccountsWithOpenPositions[index]
 
@@ -456,7 +456,8 @@ func TestCanDeleverageSubaccount(t *testing.T) {
 func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
-		subaccounts []satypes.Subaccount
+		subaccounts          []satypes.Subaccount
+		maxDeleveragingFills uint32
 
 		// Parameters.
 		liquidatedSubaccountId satypes.SubaccountId
@@ -598,6 +599,74 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 			expectedQuantumsRemaining: new(big.Int),
 			expectedOpenInterest:      new(big.Int), // fully deleveraged
 		},
+		"Stops after the maximum number of fills per operation": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(50_000_000), // 0.5 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				},
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(50_000_000), // 0.5 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				},
+			},
+			maxDeleveragingFills:   1,
+			liquidatedSubaccountId: constants.Carl_Num0,
+			perpetualId:            0,
+			deltaQuantums:          big.NewInt(100_000_000),
+			expectedSubaccounts: []satypes.Subaccount{
+				{
+					Id: &constants.Dave_Num0,
+					AssetPositions: testutil.CreateUsdcAssetPositions(
+						big.NewInt(50_000_000_000 + 27_499_500_000),
+					),
+				},
+				// Dave_Num1 is not used to offset the position since the maximum number of fills is reached.
+				{
+					Id: &constants.Dave_Num1,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_50_000,
+					},
+					PerpetualPositions: []*satypes.PerpetualPosition{
+						testutil.CreateSinglePerpetualPosition(
+							0,
+							big.NewInt(50_000_000), // 0.5 BTC
+							big.NewInt(0),
+							big.NewInt(0),
+						),
+					},
+				},
+			},
+			expectedFills: []types.MatchPerpetualDeleveraging_Fill{
+				{
+					OffsettingSubaccountId: constants.Dave_Num0,
+					FillAmount:             50_000_000,
+				},
+			},
+			expectedQuantumsRemaining: big.NewInt(50_000_000),
+			expectedOpenInterest:      big.NewInt(50_000_000),
+		},
 		"Skips subaccounts with positions on the same side": {
 			subaccounts: []satypes.Subaccount{
 				constants.Carl_Num0_1BTC_Short_54999USD,
@@ -866,6 +935,10 @@ func TestOffsetSubaccountPerpetualPosition(t *testing.T) {
 				).Return()
 			}
 
+			liquidationsConfig := constants.LiquidationsConfig_No_Limit
+			liquidationsConfig.MaxDeleveragingFillsPerOperation = tc.maxDeleveragingFills
+			require.NoError(t, ks.ClobKeeper.InitializeLiquidationsConfig(ks.Ctx, liquidationsConfig))
+
 			positions := clobtest.GetOpenPositionsFromSubaccounts(tc.subaccounts)
 			ks.ClobKeeper.DaemonLiquidationInfo.UpdateSubaccountsWithPositions(positions)
 			fills, deltaQuantumsRemaining := ks.ClobKeeper.OffsetSubaccountPerpetualPosition(
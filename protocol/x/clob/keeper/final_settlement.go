@@ -22,27 +22,17 @@ func (k Keeper) mustTransitionToFinalSettlement(ctx sdk.Context, clobPairId type
 // mustCancelStatefulOrdersForFinalSettlement forcefully cancels all stateful orders
 // for the provided ClobPair. These orders will be removed from the memclob in PrepareCheckState.
 func (k Keeper) mustCancelStatefulOrdersForFinalSettlement(ctx sdk.Context, clobPairId types.ClobPairId) {
-	statefulOrders := k.GetAllStatefulOrders(ctx)
-	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	k.mustCancelStatefulOrdersForClobPair(ctx, k.GetAllStatefulOrders(ctx), clobPairId)
+}
 
-	// This logic is executed in EndBlocker and should not panic. This would be unexpected,
-	// but if it happens we would rather recover and continue if an order fails to be removed from state
-	// rather than halt the chain.
-	removeStatefulOrderWithoutPanicing := func(ctx sdk.Context, orderId types.OrderId) {
-		defer func() {
-			if err := recover(); err != nil {
-				log.ErrorLog(
-					ctx,
-					"mustCancelStatefulOrdersForFinalSettlement: Failed to remove stateful order",
-					"orderId",
-					orderId,
-					"error",
-					err,
-				)
-			}
-		}()
-		k.MustRemoveStatefulOrder(ctx, orderId)
-	}
+// mustCancelStatefulOrdersForClobPair forcefully cancels the provided stateful orders that belong
+// to the provided ClobPair. These orders will be removed from the memclob in PrepareCheckState.
+func (k Keeper) mustCancelStatefulOrdersForClobPair(
+	ctx sdk.Context,
+	statefulOrders []types.Order,
+	clobPairId types.ClobPairId,
+) {
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
 
 	// TODO(CLOB-1053): Iterate over stateful orders for only specified clob pair
 	for _, order := range statefulOrders {
@@ -51,7 +41,7 @@ func (k Keeper) mustCancelStatefulOrdersForFinalSettlement(ctx sdk.Context, clob
 		}
 
 		// Remove from state, recovering from panic if necessary
-		removeStatefulOrderWithoutPanicing(ctx, order.OrderId)
+		k.removeStatefulOrderWithoutPanicing(ctx, order.OrderId)
 
 		// Append to RemovedStatefulOrderIds so this order gets removed
 		// from the memclob in PrepareCheckState during the PurgeInvalidMemclobState step
@@ -75,3 +65,22 @@ func (k Keeper) mustCancelStatefulOrdersForFinalSettlement(ctx sdk.Context, clob
 
 	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
 }
+
+// removeStatefulOrderWithoutPanicing removes the provided stateful order from state. This logic is
+// executed in EndBlocker and should not panic. This would be unexpected, but if it happens we would
+// rather recover and continue if an order fails to be removed from state rather than halt the chain.
+func (k Keeper) removeStatefulOrderWithoutPanicing(ctx sdk.Context, orderId types.OrderId) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.ErrorLog(
+				ctx,
+				"removeStatefulOrderWithoutPanicing: Failed to remove stateful order",
+				"orderId",
+				orderId,
+				"error",
+				err,
+			)
+		}
+	}()
+	k.MustRemoveStatefulOrder(ctx, orderId)
+}
@@ -42,6 +42,20 @@ func (k Keeper) ClobPairAll(
 	return &types.QueryClobPairAllResponse{ClobPair: clobPairs, Pagination: pageRes}, nil
 }
 
+func (k Keeper) ClobPairsByStatus(
+	c context.Context,
+	req *types.QueryClobPairsByStatusRequest,
+) (*types.QueryClobPairsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	return &types.QueryClobPairsByStatusResponse{
+		ClobPair: k.GetClobPairsByStatus(ctx, req.Status),
+	}, nil
+}
+
 func (k Keeper) ClobPair(c context.Context, req *types.QueryGetClobPairRequest) (*types.QueryClobPairResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClobPairMarketPrice returns the oracle price of the market of the perpetual of a ClobPair.
+func (k Keeper) ClobPairMarketPrice(
+	c context.Context,
+	req *types.QueryClobPairMarketPriceRequest,
+) (*types.QueryClobPairMarketPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	marketPrice, err := k.GetClobPairMarketPrice(ctx, types.ClobPairId(req.ClobPairId))
+	if err != nil {
+		if errorsmod.IsOf(
+			err,
+			types.ErrInvalidClob,
+			perptypes.ErrPerpetualDoesNotExist,
+			perptypes.ErrMarketDoesNotExist,
+		) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClobPairMarketPriceResponse{MarketPrice: marketPrice}, nil
+}
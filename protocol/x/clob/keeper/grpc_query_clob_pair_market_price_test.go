@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClobPairMarketPrice(t *testing.T) {
+	tc := processProposerOperationsTestCase{
+		perpetuals: []perptypes.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+		},
+		perpetualFeeParams: &constants.PerpetualFeeParams,
+		clobPairs: []types.ClobPair{
+			constants.ClobPair_Btc,
+		},
+	}
+	ctx, ks, _ := setupProcessProposerOperationsTestCase(t, tc)
+
+	expectedMarketPrice := pricestypes.MarketPrice{
+		Id:       0,
+		Exponent: constants.BtcUsdExponent,
+		Price:    constants.FiveBillion,
+	}
+
+	marketPrice, err := ks.ClobKeeper.GetClobPairMarketPrice(ctx, constants.ClobPair_Btc.GetClobPairId())
+	require.NoError(t, err)
+	require.Equal(t, expectedMarketPrice, marketPrice)
+
+	res, err := ks.ClobKeeper.ClobPairMarketPrice(
+		ctx,
+		&types.QueryClobPairMarketPriceRequest{ClobPairId: constants.ClobPair_Btc.Id},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryClobPairMarketPriceResponse{MarketPrice: expectedMarketPrice}, res)
+
+	// A ClobPair that does not exist is not found.
+	_, err = ks.ClobKeeper.GetClobPairMarketPrice(ctx, types.ClobPairId(100))
+	require.ErrorIs(t, err, types.ErrInvalidClob)
+	_, err = ks.ClobKeeper.ClobPairMarketPrice(ctx, &types.QueryClobPairMarketPriceRequest{ClobPairId: 100})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// A nil request is rejected.
+	_, err = ks.ClobKeeper.ClobPairMarketPrice(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
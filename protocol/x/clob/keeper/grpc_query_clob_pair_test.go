@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
@@ -141,3 +143,82 @@ func TestClobPairQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestClobPairsByStatusQuery(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	mockIndexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+	msgs := keepertest.CreateNClobPair(t,
+		ks.ClobKeeper,
+		ks.PerpetualsKeeper,
+		ks.PricesKeeper,
+		ks.Ctx,
+		5,
+		mockIndexerEventManager,
+	)
+
+	// Move the odd ClobPairs to final settlement so that the ClobPairs have mixed statuses.
+	mockIndexerEventManager.On("AddTxnEvent",
+		ks.Ctx,
+		indexerevents.SubtypeUpdateClobPair,
+		indexerevents.UpdateClobPairEventVersion,
+		mock.Anything,
+	).Return()
+	for i := 1; i < len(msgs); i += 2 {
+		require.NoError(
+			t,
+			ks.ClobKeeper.SetClobPairStatus(ks.Ctx, msgs[i].GetClobPairId(), types.ClobPair_STATUS_FINAL_SETTLEMENT),
+		)
+		msgs[i].Status = types.ClobPair_STATUS_FINAL_SETTLEMENT
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryClobPairsByStatusRequest
+		response *types.QueryClobPairsByStatusResponse
+		err      error
+	}{
+		{
+			desc: "Active",
+			request: &types.QueryClobPairsByStatusRequest{
+				Status: types.ClobPair_STATUS_ACTIVE,
+			},
+			response: &types.QueryClobPairsByStatusResponse{
+				ClobPair: []types.ClobPair{msgs[0], msgs[2], msgs[4]},
+			},
+		},
+		{
+			desc: "FinalSettlement",
+			request: &types.QueryClobPairsByStatusRequest{
+				Status: types.ClobPair_STATUS_FINAL_SETTLEMENT,
+			},
+			response: &types.QueryClobPairsByStatusResponse{
+				ClobPair: []types.ClobPair{msgs[1], msgs[3]},
+			},
+		},
+		{
+			desc: "NoMatches",
+			request: &types.QueryClobPairsByStatusRequest{
+				Status: types.ClobPair_STATUS_PAUSED,
+			},
+			response: &types.QueryClobPairsByStatusResponse{},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := ks.ClobKeeper.ClobPairsByStatus(ks.Ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response), //nolint:staticcheck
+					nullify.Fill(response),    //nolint:staticcheck
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// InsuranceFundBalance returns the address and balance of the insurance fund used by a perpetual.
+func (k Keeper) InsuranceFundBalance(
+	c context.Context,
+	req *types.QueryInsuranceFundBalanceRequest,
+) (*types.QueryInsuranceFundBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	insuranceFundAddr, err := k.perpetualsKeeper.GetInsuranceFundModuleAddress(ctx, req.PerpetualId)
+	if err != nil {
+		if errorsmod.IsOf(err, perptypes.ErrPerpetualDoesNotExist) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInsuranceFundBalanceResponse{
+		Address: insuranceFundAddr.String(),
+		Balance: dtypes.NewIntFromBigInt(k.GetInsuranceFundBalance(ctx, req.PerpetualId)),
+	}, nil
+}
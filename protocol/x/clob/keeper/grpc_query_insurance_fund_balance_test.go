@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInsuranceFundBalance(t *testing.T) {
+	tests := map[string]struct {
+		perpetualId          uint32
+		insuranceFundBalance *big.Int
+		expectedAddress      sdk.AccAddress
+	}{
+		"zero balance": {
+			perpetualId:          0,
+			insuranceFundBalance: big.NewInt(0),
+			expectedAddress:      perptypes.InsuranceFundModuleAddress,
+		},
+		"positive balance": {
+			perpetualId:          0,
+			insuranceFundBalance: big.NewInt(1_000_000_000),
+			expectedAddress:      perptypes.InsuranceFundModuleAddress,
+		},
+		"positive balance - isolated market": {
+			perpetualId:          3, // Isolated market.
+			insuranceFundBalance: big.NewInt(100),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			bankMock := &mocks.BankKeeper{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, bankMock, &mocks.IndexerEventManager{})
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ks.Ctx, ks.PerpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ks.Ctx, ks.AssetsKeeper))
+
+			insuranceFundAddr, err := ks.PerpetualsKeeper.GetInsuranceFundModuleAddress(ks.Ctx, tc.perpetualId)
+			require.NoError(t, err)
+			if tc.expectedAddress != nil {
+				require.Equal(t, tc.expectedAddress, insuranceFundAddr)
+			}
+			bankMock.On(
+				"GetBalance",
+				mock.Anything,
+				insuranceFundAddr,
+				constants.Usdc.Denom,
+			).Return(
+				sdk.NewCoin(constants.Usdc.Denom, sdkmath.NewIntFromBigInt(tc.insuranceFundBalance)),
+			)
+
+			res, err := ks.ClobKeeper.InsuranceFundBalance(
+				ks.Ctx,
+				&types.QueryInsuranceFundBalanceRequest{PerpetualId: tc.perpetualId},
+			)
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				&types.QueryInsuranceFundBalanceResponse{
+					Address: insuranceFundAddr.String(),
+					Balance: dtypes.NewIntFromBigInt(tc.insuranceFundBalance),
+				},
+				res,
+			)
+		})
+	}
+
+	t.Run("perpetual not found", func(t *testing.T) {
+		memClob := memclob.NewMemClobPriceTimePriority(false)
+		ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+		_, err := ks.ClobKeeper.InsuranceFundBalance(
+			ks.Ctx,
+			&types.QueryInsuranceFundBalanceRequest{PerpetualId: 100},
+		)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		memClob := memclob.NewMemClobPriceTimePriority(false)
+		ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+		_, err := ks.ClobKeeper.InsuranceFundBalance(ks.Ctx, nil)
+		require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+	})
+}
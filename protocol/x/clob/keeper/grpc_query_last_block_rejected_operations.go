@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LastBlockRejectedOperations returns the proposed operations that were rejected in the last block.
+func (k Keeper) LastBlockRejectedOperations(
+	c context.Context,
+	req *types.QueryLastBlockRejectedOperationsRequest,
+) (*types.QueryLastBlockRejectedOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blockHeight, rejectedOperations := k.GetLastBlockRejectedOperations()
+	return &types.QueryLastBlockRejectedOperationsResponse{
+		BlockHeight:        blockHeight,
+		RejectedOperations: rejectedOperations,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLastBlockRejectedOperations(t *testing.T) {
+	blockHeight := uint32(5)
+	tc := processProposerOperationsTestCase{
+		perpetuals: []perptypes.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+		},
+		perpetualFeeParams: &constants.PerpetualFeeParams,
+		clobPairs: []types.ClobPair{
+			constants.ClobPair_Btc,
+		},
+		subaccounts: []satypes.Subaccount{
+			constants.Alice_Num0_10_000USD,
+		},
+		expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+			BlockHeight: blockHeight,
+		},
+	}
+	ctx, ks, _ := setupProcessProposerOperationsTestCase(t, tc)
+
+	// No operations have been rejected yet.
+	res, err := ks.ClobKeeper.LastBlockRejectedOperations(ctx, &types.QueryLastBlockRejectedOperationsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.BlockHeight)
+	require.Empty(t, res.RejectedOperations)
+
+	// The order removal has an unspecified removal reason, which fails validation.
+	rawOperations := []types.OperationRaw{
+		clobtest.NewShortTermOrderPlacementOperationRaw(
+			constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15,
+		),
+		clobtest.NewOrderRemovalOperationRaw(
+			constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15.OrderId,
+			types.OrderRemoval_REMOVAL_REASON_UNSPECIFIED,
+		),
+	}
+	err = ks.ClobKeeper.ProcessProposerOperations(ctx, rawOperations)
+	require.ErrorIs(t, err, types.ErrInvalidMsgProposedOperations)
+
+	res, err = ks.ClobKeeper.LastBlockRejectedOperations(ctx, &types.QueryLastBlockRejectedOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, blockHeight, res.BlockHeight)
+	require.Len(t, res.RejectedOperations, 1)
+	require.Equal(t, uint32(1), res.RejectedOperations[0].OperationIndex)
+	require.Equal(t, rawOperations[1], res.RejectedOperations[0].Operation)
+	require.Contains(t, res.RejectedOperations[0].Reason, types.ErrInvalidOrderRemoval.Error())
+
+	// Successfully processing an operations queue clears the rejected operations.
+	require.NoError(t, ks.ClobKeeper.ProcessProposerOperations(ctx, []types.OperationRaw{}))
+	res, err = ks.ClobKeeper.LastBlockRejectedOperations(ctx, &types.QueryLastBlockRejectedOperationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, blockHeight, res.BlockHeight)
+	require.Empty(t, res.RejectedOperations)
+
+	// A nil request is rejected.
+	_, err = ks.ClobKeeper.LastBlockRejectedOperations(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AllMarketsOverview returns every ClobPair along with the ticker of its perpetual and the market price
+// of the perpetual, which is the settlement price if the perpetual's market is delisted. A ClobPair whose market price is unavailable is returned with a nil price
+// rather than failing the whole response.
+func (k Keeper) AllMarketsOverview(
+	c context.Context,
+	req *types.QueryAllMarketsOverviewRequest,
+) (*types.QueryAllMarketsOverviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var markets []types.MarketOverview
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	store := ctx.KVStore(k.storeKey)
+	clobPairStore := prefix.NewStore(store, []byte(types.ClobPairKeyPrefix))
+
+	pageRes, err := query.Paginate(clobPairStore, req.Pagination, func(key []byte, value []byte) error {
+		var clobPair types.ClobPair
+		if err := k.cdc.Unmarshal(value, &clobPair); err != nil {
+			return err
+		}
+
+		markets = append(markets, k.getMarketOverview(ctx, clobPair))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllMarketsOverviewResponse{Markets: markets, Pagination: pageRes}, nil
+}
+
+// getMarketOverview resolves the perpetual of the ClobPair and the market price of the perpetual, which is the
+// settlement price if the perpetual's market is delisted. Fields that cannot be resolved are left unset.
+func (k Keeper) getMarketOverview(ctx sdk.Context, clobPair types.ClobPair) types.MarketOverview {
+	overview := types.MarketOverview{ClobPairId: clobPair.Id}
+
+	perpetualId, err := clobPair.GetPerpetualId()
+	if err != nil {
+		return overview
+	}
+	overview.PerpetualId = perpetualId
+
+	perpetual, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if errorsmod.IsOf(err, perptypes.ErrPerpetualDoesNotExist) {
+		return overview
+	}
+	overview.Ticker = perpetual.Params.Ticker
+	if err != nil {
+		return overview
+	}
+	overview.MarketPrice = &marketPrice
+
+	return overview
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAllMarketsOverview(t *testing.T) {
+	tc := processProposerOperationsTestCase{
+		perpetuals: []perptypes.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+			constants.EthUsd_20PercentInitial_10PercentMaintenance,
+		},
+		perpetualFeeParams: &constants.PerpetualFeeParams,
+		clobPairs: []types.ClobPair{
+			constants.ClobPair_Btc,
+			constants.ClobPair_Eth,
+		},
+	}
+	ctx, ks, _ := setupProcessProposerOperationsTestCase(t, tc)
+
+	btcOverview := types.MarketOverview{
+		ClobPairId:  constants.ClobPair_Btc.Id,
+		PerpetualId: constants.BtcUsd_100PercentMarginRequirement.Params.Id,
+		Ticker:      constants.BtcUsd_100PercentMarginRequirement.Params.Ticker,
+		MarketPrice: &constants.TestMarketPrices[0],
+	}
+	ethOverview := types.MarketOverview{
+		ClobPairId:  constants.ClobPair_Eth.Id,
+		PerpetualId: constants.EthUsd_20PercentInitial_10PercentMaintenance.Params.Id,
+		Ticker:      constants.EthUsd_20PercentInitial_10PercentMaintenance.Params.Ticker,
+		MarketPrice: &constants.TestMarketPrices[1],
+	}
+
+	res, err := ks.ClobKeeper.AllMarketsOverview(ctx, &types.QueryAllMarketsOverviewRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MarketOverview{btcOverview, ethOverview}, res.Markets)
+
+	// Markets are paginated.
+	res, err = ks.ClobKeeper.AllMarketsOverview(
+		ctx,
+		&types.QueryAllMarketsOverviewRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.MarketOverview{btcOverview}, res.Markets)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = ks.ClobKeeper.AllMarketsOverview(
+		ctx,
+		&types.QueryAllMarketsOverviewRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.MarketOverview{ethOverview}, res.Markets)
+
+	// A delisted perpetual reports its settlement price rather than the current oracle price.
+	_, err = ks.PerpetualsKeeper.DelistPerpetualMarket(ctx, constants.BtcUsd_100PercentMarginRequirement.Params.Id)
+	require.NoError(t, err)
+	err = ks.PricesKeeper.UpdateMarketPrices(ctx, []*pricestypes.MsgUpdateMarketPrices_MarketPrice{
+		pricestypes.NewMarketPriceUpdate(constants.BtcUsd.MarketId, 2*constants.TestMarketPrices[0].Price),
+	})
+	require.NoError(t, err)
+	oraclePrice, err := ks.PricesKeeper.GetMarketPrice(ctx, constants.BtcUsd.MarketId)
+	require.NoError(t, err)
+	require.NotEqual(t, constants.TestMarketPrices[0].Price, oraclePrice.Price)
+
+	res, err = ks.ClobKeeper.AllMarketsOverview(ctx, &types.QueryAllMarketsOverviewRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MarketOverview{btcOverview, ethOverview}, res.Markets)
+
+	// A nil request is rejected.
+	_, err = ks.ClobKeeper.AllMarketsOverview(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
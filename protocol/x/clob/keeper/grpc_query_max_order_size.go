@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxOrderSize returns the largest order size that a subaccount can place on a side of a perpetual
+// without violating its initial margin requirement at the current oracle price.
+func (k Keeper) MaxOrderSize(
+	c context.Context,
+	req *types.QueryMaxOrderSizeRequest,
+) (*types.QueryMaxOrderSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.SubaccountId.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	maxSize, err := k.GetMaxOrderSize(ctx, req.SubaccountId, req.PerpetualId, req.Side)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrInvalidOrderSide) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errorsmod.IsOf(err, types.ErrNoClobPairForPerpetual) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMaxOrderSizeResponse{MaxSize: maxSize.ToUint64()}, nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMaxOrderSize(t *testing.T) {
+	tc := processProposerOperationsTestCase{
+		perpetuals: []perptypes.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+		},
+		perpetualFeeParams: &constants.PerpetualFeeParams,
+		clobPairs: []types.ClobPair{
+			constants.ClobPair_Btc,
+		},
+		subaccounts: []satypes.Subaccount{
+			constants.Alice_Num0_10_000USD,
+		},
+	}
+	ctx, ks, _ := setupProcessProposerOperationsTestCase(t, tc)
+
+	res, err := ks.ClobKeeper.MaxOrderSize(
+		ctx,
+		&types.QueryMaxOrderSizeRequest{
+			SubaccountId: constants.Alice_Num0,
+			PerpetualId:  0,
+			Side:         types.Order_SIDE_BUY,
+		},
+	)
+	require.NoError(t, err)
+	// $10,000 of net collateral at a 100% initial margin fraction is 0.2 BTC.
+	require.Equal(t, &types.QueryMaxOrderSizeResponse{MaxSize: 20_000_000}, res)
+
+	// A perpetual without a ClobPair is not found.
+	_, err = ks.ClobKeeper.MaxOrderSize(
+		ctx,
+		&types.QueryMaxOrderSizeRequest{
+			SubaccountId: constants.Alice_Num0,
+			PerpetualId:  100,
+			Side:         types.Order_SIDE_BUY,
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// An unspecified side is rejected.
+	_, err = ks.ClobKeeper.MaxOrderSize(
+		ctx,
+		&types.QueryMaxOrderSizeRequest{SubaccountId: constants.Alice_Num0},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A nil request is rejected.
+	_, err = ks.ClobKeeper.MaxOrderSize(ctx, nil)
+	require.Equal(t, status.Error(codes.InvalidArgument, "invalid request"), err)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+// CheckOpenInterestInvariants recomputes the open interest of every perpetual with a clob pair
+// from subaccount positions and logs an error for each perpetual whose stored open interest has
+// drifted from the recomputed value.
+//
+// The recomputation is performed on a cached context which is never written, since this check is
+// gated behind a node-local flag and must not affect consensus state.
+func (k Keeper) CheckOpenInterestInvariants(ctx sdk.Context) {
+	cacheCtx, _ := ctx.CacheContext()
+	for _, clobPair := range k.GetAllClobPairs(ctx) {
+		perpetualId, err := clobPair.GetPerpetualId()
+		if err != nil {
+			// Spot clob pairs do not have open interest.
+			continue
+		}
+
+		openInterest, drift, err := k.subaccountsKeeper.RecomputeOpenInterest(cacheCtx, perpetualId)
+		if err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"Failed to recompute open interest",
+				err,
+				log.PerpetualId, perpetualId,
+			)
+			continue
+		}
+
+		if drift.Sign() != 0 {
+			log.ErrorLog(
+				ctx,
+				"Open interest invariant violated",
+				log.PerpetualId, perpetualId,
+				"recomputedOpenInterest", openInterest,
+				"drift", drift,
+			)
+			metrics.IncrCounterWithLabels(
+				metrics.ClobOpenInterestInvariantViolated,
+				1,
+				metrics.GetLabelForIntValue(metrics.PerpetualId, int(perpetualId)),
+			)
+		}
+	}
+}
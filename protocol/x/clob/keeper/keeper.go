@@ -60,6 +60,8 @@ type (
 		placeCancelOrderRateLimiter rate_limit.RateLimiter[sdk.Msg]
 
 		DaemonLiquidationInfo *liquidationtypes.DaemonLiquidationInfo
+
+		lastBlockRejectedOperations *types.LastBlockRejectedOperations
 	}
 )
 
@@ -122,6 +124,7 @@ func NewKeeper(
 		Flags:                       clobFlags,
 		placeCancelOrderRateLimiter: placeCancelOrderRateLimiter,
 		DaemonLiquidationInfo:       daemonLiquidationInfo,
+		lastBlockRejectedOperations: types.NewLastBlockRejectedOperations(),
 	}
 
 	// Provide the keeper to the MemClob.
@@ -237,7 +240,8 @@ func (k Keeper) InitMemStore(ctx sdk.Context) {
 		}
 	}
 
-	// Ensure that the stateful order count is accurately represented in the memstore on restart.
+	// Ensure that the stateful order count and the stateful orders of each subaccount are accurately
+	// represented in the memstore on restart.
 	statefulOrders := k.GetAllStatefulOrders(ctx)
 	for _, order := range statefulOrders {
 		subaccountId := order.GetSubaccountId()
@@ -246,6 +250,7 @@ func (k Keeper) InitMemStore(ctx sdk.Context) {
 			subaccountId,
 			k.GetStatefulOrderCount(ctx, subaccountId)+1,
 		)
+		k.addStatefulOrderIdForSubaccount(ctx, order.OrderId)
 	}
 }
 
@@ -108,6 +108,7 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 
 	// Attempt to place each liquidation order and perform deleveraging if necessary.
 	startPlaceLiquidationOrders := time.Now()
+	nextBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight() + 1)
 	for _, subaccountId := range subaccountIdsToLiquidate {
 		// Generate a new liquidation order with the appropriate order size from the sorted subaccount ids.
 		liquidationOrder, err := k.MaybeGetLiquidationOrder(ctx, subaccountId)
@@ -122,6 +123,17 @@ func (k Keeper) LiquidateSubaccountsAgainstOrderbook(
 			return nil, err
 		}
 
+		// Subaccounts in the liquidation cooldown can't be liquidated in the next block. They are still
+		// considered for deleveraging, which is a no-op unless the subaccount has negative TNC.
+		if k.IsSubaccountInLiquidationCooldown(ctx, subaccountId, nextBlockHeight) {
+			telemetry.IncrCounter(1, metrics.Liquidations, metrics.SubaccountInLiquidationCooldown, metrics.Count)
+			subaccountsToDeleverage = append(subaccountsToDeleverage, subaccountToDeleverage{
+				SubaccountId: subaccountId,
+				PerpetualId:  liquidationOrder.MustGetLiquidatedPerpetualId(),
+			})
+			continue
+		}
+
 		optimisticallyFilledQuantums, _, err := k.PlacePerpetualLiquidation(ctx, *liquidationOrder)
 		// Exception for liquidation which conflicts with clob pair status. This is expected for liquidations generated
 		// for subaccounts with open positions in final settlement markets.
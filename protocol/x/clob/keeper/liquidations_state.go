@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
@@ -118,6 +119,104 @@ func (k Keeper) UpdateSubaccountLiquidationInfo(
 	store.Set(subaccountId.ToStateKey(), b)
 }
 
+// GetSubaccountLastLiquidationBlock returns the block height at which the given subaccount was
+// last liquidated, and whether the subaccount has ever been liquidated.
+func (k Keeper) GetSubaccountLastLiquidationBlock(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) (
+	blockHeight uint32,
+	found bool,
+) {
+	store := k.getSubaccountLastLiquidationBlockStore(ctx)
+
+	b := store.Get(subaccountId.ToStateKey())
+	if b == nil {
+		return 0, false
+	}
+
+	var result gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &result)
+	return result.Value, true
+}
+
+// SetSubaccountLastLiquidationBlock sets the block height at which the given subaccount was
+// last liquidated.
+func (k Keeper) SetSubaccountLastLiquidationBlock(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	blockHeight uint32,
+) {
+	store := k.getSubaccountLastLiquidationBlockStore(ctx)
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	store.Set(subaccountId.ToStateKey(), k.cdc.MustMarshal(&value))
+}
+
+// IsSubaccountInLiquidationCooldown returns true if the given subaccount cannot be liquidated at
+// `blockHeight` because it was liquidated in an earlier block less than
+// `SubaccountLiquidationCooldownBlocks` blocks ago. Liquidations within the same block as the last
+// liquidation are governed by the block limits and are not affected by the cooldown.
+func (k Keeper) IsSubaccountInLiquidationCooldown(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	blockHeight uint32,
+) bool {
+	cooldownBlocks := k.GetLiquidationsConfig(ctx).SubaccountLiquidationCooldownBlocks
+	if cooldownBlocks == 0 {
+		return false
+	}
+
+	lastLiquidationBlock, found := k.GetSubaccountLastLiquidationBlock(ctx, subaccountId)
+	if !found || blockHeight <= lastLiquidationBlock {
+		return false
+	}
+
+	return uint64(blockHeight) < uint64(lastLiquidationBlock)+uint64(cooldownBlocks)
+}
+
+// PruneSubaccountLastLiquidationBlocks removes the last liquidation block height of all subaccounts
+// whose liquidation cooldown has ended by `blockHeight`, since they no longer affect whether the
+// subaccount can be liquidated.
+func (k Keeper) PruneSubaccountLastLiquidationBlocks(
+	ctx sdk.Context,
+	blockHeight uint32,
+) {
+	store := k.getSubaccountLastLiquidationBlockStore(ctx)
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	// Only read the liquidations config if there is anything to prune.
+	if !it.Valid() {
+		return
+	}
+	cooldownBlocks := k.GetLiquidationsConfig(ctx).SubaccountLiquidationCooldownBlocks
+
+	var prunableKeys [][]byte
+	for ; it.Valid(); it.Next() {
+		var lastLiquidationBlock gogotypes.UInt32Value
+		k.cdc.MustUnmarshal(it.Value(), &lastLiquidationBlock)
+		if uint64(blockHeight) >= uint64(lastLiquidationBlock.Value)+uint64(cooldownBlocks) {
+			prunableKeys = append(prunableKeys, it.Key())
+		}
+	}
+
+	for _, key := range prunableKeys {
+		store.Delete(key)
+	}
+}
+
+// getSubaccountLastLiquidationBlockStore is an internal helper function for fetching the store
+// used for reading and writing the last liquidation block height of subaccounts.
+func (k Keeper) getSubaccountLastLiquidationBlockStore(
+	ctx sdk.Context,
+) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.SubaccountLastLiquidationBlockKeyPrefix),
+	)
+}
+
 // getSubaccountLiquidationInfoStore is an internal helper function for fetching the store
 // used for reading and writing `SubaccountLiquidationInfo` messages to the transient store.
 func (k Keeper) getSubaccountLiquidationInfoStore(
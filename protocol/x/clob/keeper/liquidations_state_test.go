@@ -348,3 +348,66 @@ func TestUpdateSubaccountLiquidationInfo_MultipleLiquidationsOfSubaccountAndPerp
 		},
 	)
 }
+
+func TestPruneSubaccountLastLiquidationBlocks(t *testing.T) {
+	tests := map[string]struct {
+		// Parameters.
+		cooldownBlocks uint32
+		blockHeight    uint32
+
+		// Expectations.
+		expectedRemaining []satypes.SubaccountId
+	}{
+		"prunes subaccounts whose cooldown has ended": {
+			cooldownBlocks:    5,
+			blockHeight:       15,
+			expectedRemaining: []satypes.SubaccountId{constants.Bob_Num0, constants.Carl_Num0},
+		},
+		"retains subaccounts still in cooldown": {
+			cooldownBlocks: 5,
+			blockHeight:    14,
+			expectedRemaining: []satypes.SubaccountId{
+				constants.Alice_Num0,
+				constants.Bob_Num0,
+				constants.Carl_Num0,
+			},
+		},
+		"prunes all subaccounts when the cooldown is disabled": {
+			cooldownBlocks:    0,
+			blockHeight:       15,
+			expectedRemaining: []satypes.SubaccountId{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			liquidationsConfig := constants.LiquidationsConfig_No_Limit
+			liquidationsConfig.SubaccountLiquidationCooldownBlocks = tc.cooldownBlocks
+			require.NoError(t, ks.ClobKeeper.InitializeLiquidationsConfig(ks.Ctx, liquidationsConfig))
+
+			lastLiquidationBlocks := map[satypes.SubaccountId]uint32{
+				constants.Alice_Num0: 10,
+				constants.Bob_Num0:   11,
+				constants.Carl_Num0:  15,
+			}
+			for subaccountId, blockHeight := range lastLiquidationBlocks {
+				ks.ClobKeeper.SetSubaccountLastLiquidationBlock(ks.Ctx, subaccountId, blockHeight)
+			}
+
+			ks.ClobKeeper.PruneSubaccountLastLiquidationBlocks(ks.Ctx, tc.blockHeight)
+
+			remaining := make([]satypes.SubaccountId, 0)
+			for subaccountId, blockHeight := range lastLiquidationBlocks {
+				lastLiquidationBlock, found := ks.ClobKeeper.GetSubaccountLastLiquidationBlock(ks.Ctx, subaccountId)
+				if found {
+					require.Equal(t, blockHeight, lastLiquidationBlock)
+					remaining = append(remaining, subaccountId)
+				}
+			}
+			require.ElementsMatch(t, tc.expectedRemaining, remaining)
+		})
+	}
+}
@@ -2445,6 +2445,74 @@ func TestIsLiquidatable(t *testing.T) {
 	}
 }
 
+func TestIsLiquidatable_SubaccountFixtures(t *testing.T) {
+	tests := map[string]struct {
+		// Subaccount state.
+		subaccount satypes.Subaccount
+
+		// Expectations.
+		expectedIsLiquidatable bool
+	}{
+		"Subaccount with no open positions is not liquidatable": {
+			subaccount:             constants.Carl_Num0_10000USD,
+			expectedIsLiquidatable: false,
+		},
+		"Subaccount with net collateral well above maintenance margin is not liquidatable": {
+			subaccount:             constants.Carl_Num0_1BTC_Short_100000USD,
+			expectedIsLiquidatable: false,
+		},
+		"Subaccount with net collateral equal to maintenance margin is not liquidatable": {
+			subaccount:             constants.Carl_Num0_1BTC_Short_55000USD,
+			expectedIsLiquidatable: false,
+		},
+		"Subaccount with net collateral just below maintenance margin is liquidatable": {
+			subaccount:             constants.Carl_Num0_1BTC_Short_54999USD,
+			expectedIsLiquidatable: true,
+		},
+		"Subaccount with zero net collateral is liquidatable": {
+			subaccount:             constants.Carl_Num0_1BTC_Short_50000USD,
+			expectedIsLiquidatable: true,
+		},
+		"Subaccount with negative net collateral is liquidatable": {
+			subaccount:             constants.Carl_Num0_1BTC_Short_49999USD,
+			expectedIsLiquidatable: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup keeper state.
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+
+			// Create the default markets.
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+
+			// Create liquidity tiers.
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+
+			// BTC at $50,000 with 10% maintenance margin, so a 1 BTC position requires $5,000.
+			p := constants.BtcUsd_20PercentInitial_10PercentMaintenance
+			_, err := ks.PerpetualsKeeper.CreatePerpetual(
+				ks.Ctx,
+				p.Params.Id,
+				p.Params.Ticker,
+				p.Params.MarketId,
+				p.Params.AtomicResolution,
+				p.Params.DefaultFundingPpm,
+				p.Params.LiquidityTier,
+				p.Params.MarketType,
+			)
+			require.NoError(t, err)
+
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.subaccount)
+			isLiquidatable, err := ks.ClobKeeper.IsLiquidatable(ks.Ctx, *tc.subaccount.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIsLiquidatable, isLiquidatable)
+		})
+	}
+}
+
 func TestGetBankruptcyPriceInQuoteQuantums(t *testing.T) {
 	tests := map[string]struct {
 		// Parameters.
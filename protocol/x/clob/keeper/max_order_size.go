@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	assettypes "github.com/dydxprotocol/v4-chain/protocol/x/assets/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetMaxOrderSize returns the largest order size, in base quantums, that the subaccount can place on the
+// provided side of the perpetual without violating its initial margin requirement, assuming the order is
+// fully filled at the current oracle price.
+//
+// Existing positions are accounted for, so an order on the opposite side of an existing position may close
+// it and open a new position on the other side. The returned size is rounded down to a multiple of the
+// `StepBaseQuantums` of the perpetual's ClobPair. If the subaccount cannot place any order on the provided
+// side without violating its initial margin requirement, zero is returned.
+func (k Keeper) GetMaxOrderSize(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	side types.Order_Side,
+) (
+	maxSize satypes.BaseQuantums,
+	err error,
+) {
+	if side != types.Order_SIDE_BUY && side != types.Order_SIDE_SELL {
+		return 0, errorsmod.Wrapf(types.ErrInvalidOrderSide, "invalid order side %v", side)
+	}
+
+	clobPairIds, exists := k.PerpetualIdToClobPairId[perpetualId]
+	if !exists || len(clobPairIds) == 0 {
+		return 0, errorsmod.Wrapf(
+			types.ErrNoClobPairForPerpetual,
+			"Perpetual ID %d has no associated CLOB pairs",
+			perpetualId,
+		)
+	}
+	clobPair := k.mustGetClobPair(ctx, clobPairIds[0])
+
+	// Orders on the opposite side of an existing position first close the position, which can only
+	// decrease the initial margin requirement of the subaccount.
+	closingSize := uint64(0)
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	if position, exists := subaccount.GetPerpetualPositionForId(perpetualId); exists {
+		positionSize := position.GetBigQuantums()
+		if (side == types.Order_SIDE_BUY) == (positionSize.Sign() < 0) {
+			closingSize = lib.BigUint64Clamp(positionSize.Abs(positionSize), 0, math.MaxUint64)
+		}
+	}
+
+	// Beyond `closingSize`, the initial margin requirement only increases with the size of the order,
+	// so the largest size that keeps the subaccount initially collateralized is found with a binary search.
+	isCollateralized, err := k.isInitialCollateralizedAfterFill(ctx, subaccountId, perpetualId, side, closingSize)
+	if err != nil || !isCollateralized {
+		return 0, err
+	}
+
+	low, high := closingSize, uint64(math.MaxUint64)
+	isCollateralized, err = k.isInitialCollateralizedAfterFill(ctx, subaccountId, perpetualId, side, high)
+	if err != nil {
+		return 0, err
+	}
+	if isCollateralized {
+		low = high
+	}
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		isCollateralized, err := k.isInitialCollateralizedAfterFill(ctx, subaccountId, perpetualId, side, mid)
+		if err != nil {
+			return 0, err
+		}
+		if isCollateralized {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return satypes.BaseQuantums(low - low%clobPair.StepBaseQuantums), nil
+}
+
+// isInitialCollateralizedAfterFill returns true if the subaccount is initially collateralized after an
+// order of the provided size and side for the perpetual is fully filled at the current oracle price.
+func (k Keeper) isInitialCollateralizedAfterFill(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	side types.Order_Side,
+	size uint64,
+) (bool, error) {
+	update := satypes.Update{SubaccountId: subaccountId}
+	if size == 0 {
+		risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(ctx, update)
+		if err != nil {
+			return false, err
+		}
+		return risk.IsInitialCollateralized(), nil
+	}
+
+	bigQuantumsDelta := new(big.Int).SetUint64(size)
+	if side == types.Order_SIDE_SELL {
+		bigQuantumsDelta.Neg(bigQuantumsDelta)
+	}
+
+	netNotional, err := k.perpetualsKeeper.GetNetNotional(ctx, perpetualId, bigQuantumsDelta)
+	if err != nil {
+		return false, err
+	}
+
+	update.AssetUpdates = []satypes.AssetUpdate{
+		{
+			AssetId:          assettypes.AssetUsdc.Id,
+			BigQuantumsDelta: netNotional.Neg(netNotional),
+		},
+	}
+	update.PerpetualUpdates = []satypes.PerpetualUpdate{
+		{
+			PerpetualId:      perpetualId,
+			BigQuantumsDelta: bigQuantumsDelta,
+		},
+	}
+	risk, err := k.subaccountsKeeper.GetNetCollateralAndMarginRequirements(ctx, update)
+	if err != nil {
+		return false, err
+	}
+
+	return risk.IsInitialCollateralized(), nil
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMaxOrderSize(t *testing.T) {
+	// Alice has $10,000 of net collateral and a 0.1 BTC long position worth $5,000.
+	aliceWithBtcLong := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(0, big.NewInt(5_000_000_000)),
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			&constants.PerpetualPosition_OneTenthBTCLong,
+		},
+	}
+	// Alice has $400 of net collateral and a 0.1 BTC long position worth $5,000.
+	aliceUndercollateralized := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Short_Usdc_Asset_4_600,
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			&constants.PerpetualPosition_OneTenthBTCLong,
+		},
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		subaccount  satypes.Subaccount
+		perpetualId uint32
+		side        types.Order_Side
+
+		// Expectations.
+		expectedMaxSize satypes.BaseQuantums
+		expectedErr     error
+	}{
+		"Empty subaccount cannot place any order": {
+			subaccount:      satypes.Subaccount{Id: &constants.Alice_Num0},
+			perpetualId:     0,
+			side:            types.Order_SIDE_BUY,
+			expectedMaxSize: 0,
+		},
+		"Subaccount without positions can buy up to its net collateral": {
+			subaccount: satypes.Subaccount{
+				Id: &constants.Alice_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Usdc_Asset_10_000,
+				},
+			},
+			perpetualId: 0,
+			side:        types.Order_SIDE_BUY,
+			// $10,000 of net collateral at a 100% initial margin fraction is 0.2 BTC.
+			expectedMaxSize: 20_000_000,
+		},
+		"Subaccount without positions can sell up to its net collateral": {
+			subaccount: satypes.Subaccount{
+				Id: &constants.Alice_Num0,
+				AssetPositions: []*satypes.AssetPosition{
+					&constants.Usdc_Asset_10_000,
+				},
+			},
+			perpetualId:     0,
+			side:            types.Order_SIDE_SELL,
+			expectedMaxSize: 20_000_000,
+		},
+		"Existing same-side position reduces the available size": {
+			subaccount:  aliceWithBtcLong,
+			perpetualId: 0,
+			side:        types.Order_SIDE_BUY,
+			// The 0.1 BTC long position uses $5,000 of the $10,000 of net collateral.
+			expectedMaxSize: 10_000_000,
+		},
+		"Existing opposite-side position increases the available size": {
+			subaccount:  aliceWithBtcLong,
+			perpetualId: 0,
+			side:        types.Order_SIDE_SELL,
+			// Closing the 0.1 BTC long position and opening a 0.2 BTC short position.
+			expectedMaxSize: 30_000_000,
+		},
+		"Undercollateralized subaccount cannot increase its position": {
+			subaccount:      aliceUndercollateralized,
+			perpetualId:     0,
+			side:            types.Order_SIDE_BUY,
+			expectedMaxSize: 0,
+		},
+		"Undercollateralized subaccount can close its position and open an opposite-side position": {
+			subaccount:  aliceUndercollateralized,
+			perpetualId: 0,
+			side:        types.Order_SIDE_SELL,
+			// Closing the 0.1 BTC long position leaves $400 of net collateral for a 0.008 BTC short position.
+			expectedMaxSize: 10_800_000,
+		},
+		"Fails for an unspecified side": {
+			subaccount:  aliceWithBtcLong,
+			perpetualId: 0,
+			side:        types.Order_SIDE_UNSPECIFIED,
+			expectedErr: types.ErrInvalidOrderSide,
+		},
+		"Fails for a perpetual without a clob pair": {
+			subaccount:  aliceWithBtcLong,
+			perpetualId: 1,
+			side:        types.Order_SIDE_BUY,
+			expectedErr: types.ErrNoClobPairForPerpetual,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			indexerEventManager.On("AddTxnEvent",
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			).Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+				constants.EthUsd_100PercentMarginRequirement,
+			} {
+				_, err := ks.PerpetualsKeeper.CreatePerpetual(
+					ks.Ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+			keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, tc.subaccount)
+
+			maxSize, err := ks.ClobKeeper.GetMaxOrderSize(ks.Ctx, constants.Alice_Num0, tc.perpetualId, tc.side)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMaxSize, maxSize)
+		})
+	}
+}
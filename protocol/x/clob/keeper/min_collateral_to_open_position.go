@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// ValidateMinCollateralToOpenPositionForOrder returns an error if the order would open a new perpetual
+// position for a subaccount whose USDC asset position is below the `MinCollateralToOpenPosition` of the
+// `ClobPairConfig`.
+//
+// An order opens a new position if the subaccount holds no position in the perpetual of the order.
+// Orders that increase, reduce or close an existing position are never rejected.
+func (k Keeper) ValidateMinCollateralToOpenPositionForOrder(ctx sdk.Context, order types.Order) error {
+	minCollateral := k.GetClobPairConfig(ctx).MinCollateralToOpenPosition
+	if minCollateral == 0 || order.IsReduceOnly() {
+		return nil
+	}
+
+	clobPair, found := k.GetClobPair(ctx, order.GetClobPairId())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClob,
+			"Clob %v is not a valid clob",
+			order.GetClobPairId(),
+		)
+	}
+	orderPerpetualId := clobPair.MustGetPerpetualId()
+
+	opensPosition, usdcPosition := k.opensPositionBelowMinCollateral(
+		ctx,
+		order.GetSubaccountId(),
+		orderPerpetualId,
+		minCollateral,
+	)
+	if opensPosition {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientCollateralToOpenPosition,
+			"USDC asset position: %v, min collateral to open position: %d, order id: %+v",
+			usdcPosition,
+			minCollateral,
+			order.GetOrderId(),
+		)
+	}
+	return nil
+}
+
+// validateMatchMinCollateralToOpenPosition returns the update results of the taker and maker of a match,
+// and an error if either fill would open a new perpetual position for a subaccount whose USDC asset
+// position is below the `MinCollateralToOpenPosition` of the `ClobPairConfig`. Orders placed before the
+// limit was raised, or whose subaccount withdrew collateral after placement, are caught here.
+// Liquidation and reduce-only orders are exempt since they can never open a position.
+func (k Keeper) validateMatchMinCollateralToOpenPosition(
+	ctx sdk.Context,
+	matchWithOrders *types.MatchWithOrders,
+	perpetualId uint32,
+) (
+	takerUpdateResult satypes.UpdateResult,
+	makerUpdateResult satypes.UpdateResult,
+	err error,
+) {
+	takerUpdateResult, makerUpdateResult = satypes.Success, satypes.Success
+	minCollateral := k.GetClobPairConfig(ctx).MinCollateralToOpenPosition
+	if minCollateral == 0 {
+		return takerUpdateResult, makerUpdateResult, nil
+	}
+
+	takerOrder := matchWithOrders.TakerOrder
+	if !takerOrder.IsLiquidation() && !takerOrder.IsReduceOnly() {
+		if opensPosition, _ := k.opensPositionBelowMinCollateral(
+			ctx,
+			takerOrder.GetSubaccountId(),
+			perpetualId,
+			minCollateral,
+		); opensPosition {
+			takerUpdateResult = satypes.ViolatesMinCollateralToOpenPosition
+		}
+	}
+	makerOrder := matchWithOrders.MakerOrder
+	if !makerOrder.IsReduceOnly() {
+		if opensPosition, _ := k.opensPositionBelowMinCollateral(
+			ctx,
+			makerOrder.GetSubaccountId(),
+			perpetualId,
+			minCollateral,
+		); opensPosition {
+			makerUpdateResult = satypes.ViolatesMinCollateralToOpenPosition
+		}
+	}
+
+	if !takerUpdateResult.IsSuccess() || !makerUpdateResult.IsSuccess() {
+		return takerUpdateResult, makerUpdateResult, errorsmod.Wrapf(
+			satypes.ErrFailedToUpdateSubaccounts,
+			"Fill would open a position below min collateral %d, taker result: %v, maker result: %v",
+			minCollateral,
+			takerUpdateResult,
+			makerUpdateResult,
+		)
+	}
+	return takerUpdateResult, makerUpdateResult, nil
+}
+
+// opensPositionBelowMinCollateral returns true if the subaccount holds no position in the perpetual and
+// its USDC asset position is below `minCollateral`, along with the USDC asset position.
+func (k Keeper) opensPositionBelowMinCollateral(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+	perpetualId uint32,
+	minCollateral uint64,
+) (bool, *big.Int) {
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, subaccountId)
+	usdcPosition := subaccount.GetUsdcPosition()
+	if _, exists := subaccount.GetPerpetualPositionForId(perpetualId); exists {
+		return false, usdcPosition
+	}
+	return usdcPosition.Cmp(new(big.Int).SetUint64(minCollateral)) < 0, usdcPosition
+}
@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMinCollateralToOpenPositionForOrder(t *testing.T) {
+	buyOneBtc := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     100_000_000,
+		Subticks:     50_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+	sellOneBtc := buyOneBtc
+	sellOneBtc.Side = types.Order_SIDE_SELL
+	reduceOnlySellOneBtc := sellOneBtc
+	reduceOnlySellOneBtc.ReduceOnly = true
+	reduceOnlySellOneBtc.TimeInForce = types.Order_TIME_IN_FORCE_IOC
+
+	// Alice has $500 of USDC and no positions.
+	aliceWithoutPositions := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Usdc_Asset_500,
+		},
+	}
+	// Alice has $500 of USDC and a 1 BTC long position.
+	aliceWithBtcPosition := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Usdc_Asset_500,
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			&constants.PerpetualPosition_OneBTCLong,
+		},
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		minCollateral uint64
+		subaccount    satypes.Subaccount
+		order         types.Order
+
+		// Expectations.
+		expectedErr error
+	}{
+		"Succeeds when the limit is disabled": {
+			minCollateral: 0,
+			subaccount:    aliceWithoutPositions,
+			order:         buyOneBtc,
+		},
+		"Succeeds opening a position when the USDC asset position is above the minimum": {
+			minCollateral: 499_000_000,
+			subaccount:    aliceWithoutPositions,
+			order:         buyOneBtc,
+		},
+		"Succeeds opening a position when the USDC asset position is at the minimum": {
+			minCollateral: 500_000_000,
+			subaccount:    aliceWithoutPositions,
+			order:         buyOneBtc,
+		},
+		"Fails opening a position when the USDC asset position is below the minimum": {
+			minCollateral: 500_000_001,
+			subaccount:    aliceWithoutPositions,
+			order:         sellOneBtc,
+			expectedErr:   types.ErrInsufficientCollateralToOpenPosition,
+		},
+		"Fails opening a position when the subaccount has no USDC asset position": {
+			minCollateral: 1,
+			subaccount:    satypes.Subaccount{Id: &constants.Alice_Num0},
+			order:         buyOneBtc,
+			expectedErr:   types.ErrInsufficientCollateralToOpenPosition,
+		},
+		"Succeeds increasing an existing position when the USDC asset position is below the minimum": {
+			minCollateral: 1_000_000_000,
+			subaccount:    aliceWithBtcPosition,
+			order:         buyOneBtc,
+		},
+		"Succeeds reducing an existing position when the USDC asset position is below the minimum": {
+			minCollateral: 1_000_000_000,
+			subaccount:    aliceWithBtcPosition,
+			order:         sellOneBtc,
+		},
+		"Succeeds for a reduce-only order when the USDC asset position is below the minimum": {
+			minCollateral: 1_000_000_000,
+			subaccount:    aliceWithoutPositions,
+			order:         reduceOnlySellOneBtc,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := setUpMinCollateralToOpenPositionTest(t, tc.minCollateral, tc.subaccount)
+
+			err := ks.ClobKeeper.ValidateMinCollateralToOpenPositionForOrder(ks.Ctx, tc.order)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceStatefulOrder_FailsBelowMinCollateralToOpenPosition(t *testing.T) {
+	ks := setUpMinCollateralToOpenPositionTest(
+		t,
+		1_000_000_000,
+		satypes.Subaccount{
+			Id: &constants.Alice_Num0,
+			AssetPositions: []*satypes.AssetPosition{
+				&constants.Usdc_Asset_500,
+			},
+		},
+	)
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(1, 0),
+	})
+
+	err := ks.ClobKeeper.PlaceStatefulOrder(
+		ks.Ctx,
+		types.NewMsgPlaceOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5),
+		false,
+	)
+	require.ErrorIs(t, err, types.ErrInsufficientCollateralToOpenPosition)
+	require.Empty(t, ks.ClobKeeper.GetAllStatefulOrders(ks.Ctx))
+}
+
+func TestProcessSingleMatch_FailsBelowMinCollateralToOpenPosition(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		alicePerpetualPositions []*satypes.PerpetualPosition
+		bobPerpetualPositions   []*satypes.PerpetualPosition
+
+		// Expectations.
+		expectedTakerUpdateResult satypes.UpdateResult
+		expectedMakerUpdateResult satypes.UpdateResult
+	}{
+		"Fails when both fills open a position": {
+			expectedTakerUpdateResult: satypes.ViolatesMinCollateralToOpenPosition,
+			expectedMakerUpdateResult: satypes.ViolatesMinCollateralToOpenPosition,
+		},
+		"Fails when only the maker fill opens a position": {
+			alicePerpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			expectedTakerUpdateResult: satypes.Success,
+			expectedMakerUpdateResult: satypes.ViolatesMinCollateralToOpenPosition,
+		},
+		"Fails when only the taker fill opens a position": {
+			bobPerpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+			expectedTakerUpdateResult: satypes.ViolatesMinCollateralToOpenPosition,
+			expectedMakerUpdateResult: satypes.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Alice has $500 of USDC and Bob has none, both below the $1,000 minimum.
+			ks := setUpMinCollateralToOpenPositionTest(
+				t,
+				1_000_000_000,
+				satypes.Subaccount{
+					Id: &constants.Alice_Num0,
+					AssetPositions: []*satypes.AssetPosition{
+						&constants.Usdc_Asset_500,
+					},
+					PerpetualPositions: tc.alicePerpetualPositions,
+				},
+			)
+			ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, satypes.Subaccount{
+				Id:                 &constants.Bob_Num0,
+				PerpetualPositions: tc.bobPerpetualPositions,
+			})
+
+			takerOrder := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB20
+			makerOrder := constants.Order_Bob_Num0_Id8_Clob0_Sell20_Price10_GTB22
+			success, takerUpdateResult, makerUpdateResult, err := ks.ClobKeeper.ProcessSingleMatch(
+				ks.Ctx,
+				&types.MatchWithOrders{
+					TakerOrder: &takerOrder,
+					MakerOrder: &makerOrder,
+					FillAmount: 5,
+				},
+			)
+			require.False(t, success)
+			require.ErrorIs(t, err, satypes.ErrFailedToUpdateSubaccounts)
+			require.Equal(t, tc.expectedTakerUpdateResult, takerUpdateResult)
+			require.Equal(t, tc.expectedMakerUpdateResult, makerUpdateResult)
+
+			// Neither order was filled.
+			_, takerFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, takerOrder.OrderId)
+			require.Zero(t, takerFillAmount)
+			_, makerFillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, makerOrder.OrderId)
+			require.Zero(t, makerFillAmount)
+		})
+	}
+}
+
+// setUpMinCollateralToOpenPositionTest creates the BTC and ETH markets and the BTC ClobPair, sets the provided
+// subaccount in state, and sets the provided `MinCollateralToOpenPosition`.
+func setUpMinCollateralToOpenPositionTest(
+	t *testing.T,
+	minCollateral uint64,
+	subaccount satypes.Subaccount,
+) keepertest.ClobKeepersTestContext {
+	ks := setUpSubaccountNotionalLimitTest(t, 0, subaccount)
+	ks.ClobKeeper.InitializeClobPairConfig(
+		ks.Ctx,
+		types.ClobPairConfig{MinCollateralToOpenPosition: minCollateral},
+	)
+	return ks
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// DelistPerpetualMarket delists the market of a perpetual and pauses its ClobPairs.
+func (k msgServer) DelistPerpetualMarket(
+	goCtx context.Context,
+	msg *types.MsgDelistPerpetualMarket,
+) (resp *types.MsgDelistPerpetualMarketResponse, err error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	if err := k.Keeper.DelistPerpetualMarket(ctx, msg.PerpetualId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelistPerpetualMarketResponse{}, nil
+}
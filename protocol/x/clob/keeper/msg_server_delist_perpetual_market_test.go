@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerDelistPerpetualMarket(t *testing.T) {
+	testCases := map[string]struct {
+		msg            *types.MsgDelistPerpetualMarket
+		expectedStatus types.ClobPair_Status
+		expectedError  error
+	}{
+		"Succeeds": {
+			msg: &types.MsgDelistPerpetualMarket{
+				Authority:   lib.GovModuleAddress.String(),
+				PerpetualId: 0,
+			},
+			expectedStatus: types.ClobPair_STATUS_PAUSED,
+		},
+		"Error: invalid authority": {
+			msg: &types.MsgDelistPerpetualMarket{
+				Authority:   "foobar",
+				PerpetualId: 0,
+			},
+			expectedStatus: types.ClobPair_STATUS_ACTIVE,
+			expectedError:  govtypes.ErrInvalidSigner,
+		},
+		"Error: perpetual has no clob pair": {
+			msg: &types.MsgDelistPerpetualMarket{
+				Authority:   lib.GovModuleAddress.String(),
+				PerpetualId: 1,
+			},
+			expectedStatus: types.ClobPair_STATUS_ACTIVE,
+			expectedError:  types.ErrNoClobPairForPerpetual,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			indexerEventManager.On("AddTxnEvent",
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			).Return()
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ks.Ctx, ks.PerpetualsKeeper)
+			keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+			_, err := msgServer.DelistPerpetualMarket(ks.Ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			clobPair, found := ks.ClobKeeper.GetClobPair(ks.Ctx, constants.ClobPair_Btc.GetClobPairId())
+			require.True(t, found)
+			require.Equal(t, tc.expectedStatus, clobPair.Status)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ReduceOrder performs order size reduction functionality for stateful orders.
+func (k msgServer) ReduceOrder(
+	goCtx context.Context,
+	msg *types.MsgReduceOrder,
+) (resp *types.MsgReduceOrderResponse, err error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if err := k.Keeper.HandleMsgReduceOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReduceOrderResponse{}, nil
+}
+
+// HandleMsgReduceOrder handles a MsgReduceOrder by
+// 1. persisting the reduced order on chain.
+// 2. updating the memstore so the memclob replaces the resting order with the reduced order.
+// 3. adding the on-chain indexer event for the reduced order.
+func (k Keeper) HandleMsgReduceOrder(
+	ctx sdk.Context,
+	msg *types.MsgReduceOrder,
+) (err error) {
+	lib.AssertDeliverTxMode(ctx)
+
+	// Attach various logging tags relative to this request. These should be static with no changes.
+	ctx = log.AddPersistentTagsToLogger(ctx,
+		log.Module, log.Clob,
+		log.ProposerConsAddress, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		log.Callback, lib.TxMode(ctx),
+		log.BlockHeight, ctx.BlockHeight(),
+		log.Handler, log.ReduceOrder,
+	)
+
+	defer func() {
+		metrics.IncrSuccessOrErrorCounter(
+			err,
+			types.ModuleName,
+			metrics.ReduceOrder,
+			metrics.DeliverTx,
+			msg.OrderId.GetOrderIdLabels()...,
+		)
+		if err != nil {
+			log.ErrorLogWithError(ctx, "Error reducing order", err)
+		}
+	}()
+
+	// 1. Reduce the order in state.
+	reducedOrder, err := k.ReduceStatefulOrder(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	// 2. Update the memstore so that the resting order is removed from the memclob and the reduced order
+	// is placed from state in the next `PrepareCheckState`. Note that this prevents the order from being
+	// replaced by a `MsgPlaceOrder` in the same block.
+	k.AddDeliveredCancelledOrderId(ctx, reducedOrder.OrderId)
+	k.AddDeliveredLongTermOrderId(ctx, reducedOrder.OrderId)
+
+	// 3. Emit a placement indexer event for the reduced order, which overwrites the existing order.
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewLongTermOrderPlacementEvent(
+				reducedOrder,
+			),
+		),
+	)
+
+	return nil
+}
+
+// ReduceStatefulOrder validates a MsgReduceOrder and, if valid, overwrites the resting Long-Term order in
+// state with a copy of the order that has its `Quantums` set to `NewQuantums`. The fill amount of the order
+// is left untouched, so the remaining size of the order becomes `NewQuantums - fillAmount`.
+//
+// The following conditions must be true otherwise an error will be returned:
+//   - The order exists in state.
+//   - `NewQuantums` is smaller than the current `Quantums` of the order.
+//   - `NewQuantums` is a multiple of the ClobPair's `StepBaseQuantums`.
+//   - `NewQuantums` is greater than the amount of the order that has already been filled.
+func (k Keeper) ReduceStatefulOrder(
+	ctx sdk.Context,
+	msg *types.MsgReduceOrder,
+) (reducedOrder types.Order, err error) {
+	orderId := msg.GetOrderId()
+	orderId.MustBeStatefulOrder()
+
+	orderPlacement, exists := k.GetLongTermOrderPlacement(ctx, orderId)
+	if !exists {
+		return types.Order{}, errorsmod.Wrapf(
+			types.ErrStatefulOrderDoesNotExist,
+			"Order Id to reduce does not exist. OrderId : %+v",
+			orderId,
+		)
+	}
+
+	order := orderPlacement.Order
+	if msg.NewQuantums >= order.Quantums {
+		return types.Order{}, errorsmod.Wrapf(
+			types.ErrInvalidOrderReduction,
+			"New quantums %d must be less than the current order quantums %d. OrderId : %+v",
+			msg.NewQuantums,
+			order.Quantums,
+			orderId,
+		)
+	}
+
+	clobPair, found := k.GetClobPair(ctx, order.GetClobPairId())
+	if !found {
+		return types.Order{}, errorsmod.Wrapf(
+			types.ErrInvalidClob,
+			"Clob %v is not a valid clob",
+			order.GetClobPairId(),
+		)
+	}
+	if msg.NewQuantums%clobPair.StepBaseQuantums != 0 {
+		return types.Order{}, errorsmod.Wrapf(
+			types.ErrInvalidOrderReduction,
+			"New quantums %v must be a multiple of the ClobPair's StepBaseQuantums %v",
+			msg.NewQuantums,
+			clobPair.StepBaseQuantums,
+		)
+	}
+
+	_, fillAmount, _ := k.GetOrderFillAmount(ctx, orderId)
+	if msg.NewQuantums <= fillAmount.ToUint64() {
+		return types.Order{}, errorsmod.Wrapf(
+			types.ErrOrderReductionBelowFilledAmount,
+			"New quantums %d must be greater than the filled amount %d. OrderId : %+v",
+			msg.NewQuantums,
+			fillAmount,
+			orderId,
+		)
+	}
+
+	order.Quantums = msg.NewQuantums
+	k.SetLongTermOrderPlacement(ctx, order, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+
+	return order, nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	perptest "github.com/dydxprotocol/v4-chain/protocol/testutil/perpetuals"
+	pricestest "github.com/dydxprotocol/v4-chain/protocol/testutil/prices"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	pricestypes "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReduceOrder(t *testing.T) {
+	// Long-Term order with a size of 100 quantums on a ClobPair with `StepBaseQuantums` of 5.
+	order := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy100_Price10_GTBT15
+
+	tests := map[string]struct {
+		// Setup.
+		orderExists bool
+		fillAmount  satypes.BaseQuantums
+		newQuantums uint64
+
+		// Expectations.
+		expectedQuantums uint64
+		expectedErr      error
+	}{
+		"Succeeds reducing an unfilled order": {
+			orderExists:      true,
+			newQuantums:      50,
+			expectedQuantums: 50,
+		},
+		"Succeeds reducing a partially filled order": {
+			orderExists:      true,
+			fillAmount:       40,
+			newQuantums:      45,
+			expectedQuantums: 45,
+		},
+		"Fails when the order does not exist": {
+			newQuantums: 50,
+			expectedErr: types.ErrStatefulOrderDoesNotExist,
+		},
+		"Fails when increasing the size of the order": {
+			orderExists:      true,
+			newQuantums:      105,
+			expectedQuantums: 100,
+			expectedErr:      types.ErrInvalidOrderReduction,
+		},
+		"Fails when the size of the order is unchanged": {
+			orderExists:      true,
+			newQuantums:      100,
+			expectedQuantums: 100,
+			expectedErr:      types.ErrInvalidOrderReduction,
+		},
+		"Fails when the new size is not a multiple of StepBaseQuantums": {
+			orderExists:      true,
+			newQuantums:      52,
+			expectedQuantums: 100,
+			expectedErr:      types.ErrInvalidOrderReduction,
+		},
+		"Fails when reducing below the filled amount": {
+			orderExists:      true,
+			fillAmount:       60,
+			newQuantums:      50,
+			expectedQuantums: 100,
+			expectedErr:      types.ErrOrderReductionBelowFilledAmount,
+		},
+		"Fails when reducing to the filled amount": {
+			orderExists:      true,
+			fillAmount:       50,
+			newQuantums:      50,
+			expectedQuantums: 100,
+			expectedErr:      types.ErrOrderReductionBelowFilledAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			indexerEventManager := &mocks.IndexerEventManager{}
+			indexerEventManager.On("AddTxnEvent",
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+			).Return()
+
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+			ctx := ks.Ctx.WithBlockHeight(2)
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+
+			keepertest.CreateTestPricesAndPerpetualMarkets(
+				t,
+				ctx,
+				ks.PerpetualsKeeper,
+				ks.PricesKeeper,
+				[]perptypes.Perpetual{
+					*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+				},
+				[]pricestypes.MarketParamPrice{
+					*pricestest.GenerateMarketParamPrice(pricestest.WithId(0)),
+				},
+			)
+			keepertest.CreateTestClobPairs(
+				t,
+				ctx,
+				ks.ClobKeeper,
+				[]types.ClobPair{
+					*clobtest.GenerateClobPair(
+						clobtest.WithId(0),
+						clobtest.WithPerpetualId(0),
+						clobtest.WithStepBaseQuantums(5),
+					),
+				},
+			)
+
+			if tc.orderExists {
+				ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+				ks.ClobKeeper.AddStatefulOrderIdExpiration(
+					ctx,
+					order.MustGetUnixGoodTilBlockTime(),
+					order.GetOrderId(),
+				)
+			}
+			if tc.fillAmount > 0 {
+				ks.ClobKeeper.SetOrderFillAmount(ctx, order.OrderId, tc.fillAmount, 20)
+			}
+
+			_, err := msgServer.ReduceOrder(ctx, types.NewMsgReduceOrder(order.OrderId, tc.newQuantums))
+
+			expectedOrder := order
+			expectedOrder.Quantums = tc.expectedQuantums
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, ks.ClobKeeper.GetDeliveredCancelledOrderIds(ctx))
+				require.Empty(t, ks.ClobKeeper.GetDeliveredLongTermOrderIds(ctx))
+				indexerEventManager.AssertNotCalled(
+					t,
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					mock.Anything,
+				)
+			} else {
+				require.NoError(t, err)
+
+				// The order is removed from and re-placed on the memclob in the next `PrepareCheckState`.
+				require.Equal(t, []types.OrderId{order.OrderId}, ks.ClobKeeper.GetDeliveredCancelledOrderIds(ctx))
+				require.Equal(t, []types.OrderId{order.OrderId}, ks.ClobKeeper.GetDeliveredLongTermOrderIds(ctx))
+				indexerEventManager.AssertCalled(
+					t,
+					"AddTxnEvent",
+					ctx,
+					indexerevents.SubtypeStatefulOrder,
+					indexerevents.StatefulOrderEventVersion,
+					indexer_manager.GetBytes(
+						indexerevents.NewLongTermOrderPlacementEvent(expectedOrder),
+					),
+				)
+			}
+
+			// Verify the stored order and that its fill amount is unchanged.
+			orderPlacement, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, order.OrderId)
+			require.Equal(t, tc.orderExists, found)
+			if found {
+				require.Equal(t, expectedOrder, orderPlacement.Order)
+				require.Equal(t, uint32(1), ks.ClobKeeper.GetStatefulOrderCount(ctx, order.OrderId.SubaccountId))
+			}
+			_, fillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, order.OrderId)
+			require.Equal(t, tc.fillAmount, fillAmount)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// UpdateClobPairConfig updates the ClobPair config in state.
+func (k msgServer) UpdateClobPairConfig(
+	goCtx context.Context,
+	msg *types.MsgUpdateClobPairConfig,
+) (resp *types.MsgUpdateClobPairConfigResponse, err error) {
+	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+
+	if !k.Keeper.HasAuthority(msg.Authority) {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority %s",
+			msg.Authority,
+		)
+	}
+
+	k.Keeper.InitializeClobPairConfig(ctx, msg.ClobPairConfig)
+	return &types.MsgUpdateClobPairConfigResponse{}, nil
+}
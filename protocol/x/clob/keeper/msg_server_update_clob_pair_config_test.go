@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateClobPairConfig(t *testing.T) {
+	testCases := map[string]struct {
+		msg           *types.MsgUpdateClobPairConfig
+		expectedError error
+	}{
+		"Succeeds": {
+			msg: &types.MsgUpdateClobPairConfig{
+				Authority: lib.GovModuleAddress.String(),
+				ClobPairConfig: types.ClobPairConfig{
+					MinSubticksPerTick: 1_000,
+				},
+			},
+		},
+		"Succeeds: disables minimum": {
+			msg: &types.MsgUpdateClobPairConfig{
+				Authority:      lib.GovModuleAddress.String(),
+				ClobPairConfig: types.ClobPairConfig{},
+			},
+		},
+		"Error: invalid authority": {
+			msg: &types.MsgUpdateClobPairConfig{
+				Authority: "foobar",
+				ClobPairConfig: types.ClobPairConfig{
+					MinSubticksPerTick: 1_000,
+				},
+			},
+			expectedError: govtypes.ErrInvalidSigner,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			memClob := memclob.NewMemClobPriceTimePriority(false)
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, &mocks.IndexerEventManager{})
+			initialConfig := types.ClobPairConfig{MinSubticksPerTick: 10}
+			ks.ClobKeeper.InitializeClobPairConfig(ks.Ctx, initialConfig)
+
+			msgServer := keeper.NewMsgServerImpl(ks.ClobKeeper)
+			_, err := msgServer.UpdateClobPairConfig(ks.Ctx, tc.msg)
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.Equal(t, initialConfig, ks.ClobKeeper.GetClobPairConfig(ks.Ctx))
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.msg.ClobPairConfig, ks.ClobKeeper.GetClobPairConfig(ks.Ctx))
+			}
+		})
+	}
+}
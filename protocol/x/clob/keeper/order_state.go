@@ -54,6 +54,8 @@ func (k Keeper) GetAllOrderFillStates(ctx sdk.Context) (fillStates []OrderIdFill
 }
 
 // SetOrderFillAmount writes the total `fillAmount` and `prunableBlockHeight` of an order to on-chain state.
+// If the order already has a fill amount in state with a different `prunableBlockHeight`, the order is removed
+// from the orders that are potentially prunable at the previous `prunableBlockHeight`.
 // TODO(DEC-1219): Determine whether we should continue using `OrderFillState` proto for stateful orders.
 func (k Keeper) SetOrderFillAmount(
 	ctx sdk.Context,
@@ -61,6 +63,12 @@ func (k Keeper) SetOrderFillAmount(
 	fillAmount satypes.BaseQuantums,
 	prunableBlockHeight uint32,
 ) {
+	// Remove the order from the pruning schedule of its previous `prunableBlockHeight`, if it changed.
+	exists, _, prevPrunableBlockHeight := k.GetOrderFillAmount(ctx, orderId)
+	if exists && prevPrunableBlockHeight != prunableBlockHeight {
+		k.RemoveOrderForPruning(ctx, orderId, prevPrunableBlockHeight)
+	}
+
 	// Define `OrderFillState` based on the provided arguments.
 	var orderFillState = types.OrderFillState{
 		FillAmount:          uint64(fillAmount),
@@ -116,6 +124,39 @@ func (k Keeper) GetOrderFillAmount(
 	return true, satypes.BaseQuantums(orderFillState.FillAmount), orderFillState.PrunableBlockHeight
 }
 
+// GetOrderFillAmounts returns the `OrderFillState` of each of the provided `orderIds` from state,
+// using a single prefix store for all reads. Orders without an `OrderFillState` in state are
+// absent from the returned map.
+func (k Keeper) GetOrderFillAmounts(
+	ctx sdk.Context,
+	orderIds []types.OrderId,
+) (
+	orderFillStates map[types.OrderId]types.OrderFillState,
+) {
+	prefixStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		[]byte(types.OrderAmountFilledKeyPrefix),
+	)
+
+	orderFillStates = make(map[types.OrderId]types.OrderFillState, len(orderIds))
+	for _, orderId := range orderIds {
+		if _, exists := orderFillStates[orderId]; exists {
+			continue
+		}
+
+		orderFillStateBytes := prefixStore.Get(orderId.ToStateKey())
+		if orderFillStateBytes == nil {
+			continue
+		}
+
+		var orderFillState types.OrderFillState
+		k.cdc.MustUnmarshal(orderFillStateBytes, &orderFillState)
+		orderFillStates[orderId] = orderFillState
+	}
+
+	return orderFillStates
+}
+
 // GetPruneableOrdersStore gets a prefix store for pruneable orders at a given height.
 // The full format for these keys is <PrunableOrdersKeyPrefix><height>:<order_id>.
 func (k Keeper) GetPruneableOrdersStore(ctx sdk.Context, height uint32) prefix.Store {
@@ -137,6 +178,14 @@ func (k Keeper) AddOrdersForPruning(ctx sdk.Context, orderIds []types.OrderId, p
 	}
 }
 
+// RemoveOrderForPruning removes `orderId` from the orders that are potentially prunable at
+// `prunableBlockHeight`. This is used when an order is rescheduled for pruning at a later block height.
+// This function is a no-op if `orderId` is not potentially prunable at `prunableBlockHeight`.
+func (k Keeper) RemoveOrderForPruning(ctx sdk.Context, orderId types.OrderId, prunableBlockHeight uint32) {
+	store := k.GetPruneableOrdersStore(ctx, prunableBlockHeight)
+	store.Delete(orderId.ToStateKey())
+}
+
 // Deprecated: Do not use. Retained for testing purposes.
 // LegacyAddOrdersForPruning is the old key-per-height format of storing orders to prune.
 func (k Keeper) LegacyAddOrdersForPruning(ctx sdk.Context, orderIds []types.OrderId, prunableBlockHeight uint32) {
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -208,6 +209,55 @@ func TestSetGetOrderFillAmount(t *testing.T) {
 	}
 }
 
+func TestGetOrderFillAmounts(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		&mocks.IndexerEventManager{},
+	)
+
+	orderA := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId
+	orderB := constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price15_GTB20.OrderId
+	orderC := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15.OrderId
+	missingOrder := constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId
+	ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, orderA, 100, 10)
+	ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, orderB, 200, 20)
+	ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, orderC, 300, 30)
+
+	// An empty list of order IDs returns an empty map.
+	require.Empty(t, ks.ClobKeeper.GetOrderFillAmounts(ks.Ctx, []types.OrderId{}))
+
+	orderIds := []types.OrderId{orderA, missingOrder, orderC, orderA}
+	orderFillStates := ks.ClobKeeper.GetOrderFillAmounts(ks.Ctx, orderIds)
+
+	// Missing order IDs are absent from the map.
+	require.Len(t, orderFillStates, 2)
+	_, found := orderFillStates[missingOrder]
+	require.False(t, found)
+	_, found = orderFillStates[orderB]
+	require.False(t, found)
+
+	// The batch result matches individual `GetOrderFillAmount` calls.
+	for _, orderId := range orderIds {
+		exists, fillAmount, prunableBlockHeight := ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, orderId)
+		orderFillState, found := orderFillStates[orderId]
+		require.Equal(t, exists, found)
+		if exists {
+			require.Equal(
+				t,
+				types.OrderFillState{
+					FillAmount:          uint64(fillAmount),
+					PrunableBlockHeight: prunableBlockHeight,
+				},
+				orderFillState,
+			)
+		}
+	}
+}
+
 func TestOrderFillAmountInitMemStore_Success(t *testing.T) {
 	memClob := &mocks.MemClob{}
 	memClob.On("SetClobKeeper", mock.Anything).Return()
@@ -343,6 +393,40 @@ func TestPruning(t *testing.T) {
 			expectedPrunableBlockHeight: 11,
 			expectedEmptyPotentiallyPrunableOrderBlockHeights: []uint32{10},
 		},
+		`Updating the prunableBlockHeight on the OrderFillState removes the order from the pruning schedule of
+			previous prunableBlockHeights`: {
+			orderId: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+			setup: func(t *testing.T, ctx sdk.Context, k keeper.Keeper, orderId types.OrderId) {
+				for _, blockHeight := range []uint32{10, 11, 12} {
+					k.AddOrdersForPruning(
+						ctx,
+						[]types.OrderId{orderId},
+						blockHeight,
+					)
+
+					k.SetOrderFillAmount(
+						ctx,
+						orderId,
+						100,
+						blockHeight,
+					)
+				}
+
+				it := k.GetPruneableOrdersStore(ctx, 12).Iterator(nil, nil)
+				defer it.Close()
+				require.True(t, it.Valid())
+				var prunableOrderId types.OrderId
+				require.NoError(t, prunableOrderId.Unmarshal(it.Value()))
+				require.Equal(t, orderId, prunableOrderId)
+				it.Next()
+				require.False(t, it.Valid())
+			},
+
+			expectedExists:              true,
+			expectedFillAmount:          100,
+			expectedPrunableBlockHeight: 12,
+			expectedEmptyPotentiallyPrunableOrderBlockHeights: []uint32{10, 11},
+		},
 		`Prunes orders for a block height that never had orders`: {
 			orderId: constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
 			setup: func(t *testing.T, ctx sdk.Context, k keeper.Keeper, orderId types.OrderId) {
@@ -548,6 +632,60 @@ func TestMigratePruneableOrders(t *testing.T) {
 	require.False(t, it.Valid())
 }
 
+func TestRemoveOrderForPruning(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+
+	ks := keepertest.NewClobKeepersTestContext(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		&mocks.IndexerEventManager{},
+	)
+
+	getOrdersForPruningAtHeight := func(height uint32) []types.OrderId {
+		orderIds := []types.OrderId{}
+		store := ks.ClobKeeper.GetPruneableOrdersStore(ks.Ctx, height)
+		it := store.Iterator(nil, nil)
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			var orderId types.OrderId
+			err := orderId.Unmarshal(it.Value())
+			require.NoError(t, err)
+			orderIds = append(orderIds, orderId)
+		}
+		return orderIds
+	}
+
+	orderA := constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId
+	orderB := constants.Order_Alice_Num1_Id0_Clob0_Sell10_Price15_GTB20.OrderId
+	orderC := constants.Order_Bob_Num0_Id0_Clob1_Sell10_Price15_GTB20.OrderId
+	ks.ClobKeeper.AddOrdersForPruning(ks.Ctx, []types.OrderId{orderA, orderB}, 10)
+	ks.ClobKeeper.AddOrdersForPruning(ks.Ctx, []types.OrderId{orderA, orderC}, 20)
+
+	// Removing one of several order ids leaves the other order ids at that height.
+	ks.ClobKeeper.RemoveOrderForPruning(ks.Ctx, orderA, 10)
+	require.ElementsMatch(t, []types.OrderId{orderB}, getOrdersForPruningAtHeight(10))
+	require.ElementsMatch(t, []types.OrderId{orderA, orderC}, getOrdersForPruningAtHeight(20))
+
+	// Removing an order id that is not prunable at that height is a no-op.
+	ks.ClobKeeper.RemoveOrderForPruning(ks.Ctx, orderC, 10)
+	require.ElementsMatch(t, []types.OrderId{orderB}, getOrdersForPruningAtHeight(10))
+
+	// Removing the last order id leaves no orders to prune at that height.
+	ks.ClobKeeper.RemoveOrderForPruning(ks.Ctx, orderB, 10)
+	require.Empty(t, getOrdersForPruningAtHeight(10))
+	it := ks.ClobKeeper.GetPruneableOrdersStore(ks.Ctx, 10).Iterator(nil, nil)
+	defer it.Close()
+	require.False(t, it.Valid())
+
+	// Pruning at the height of removed order ids does not prune them.
+	ks.ClobKeeper.SetOrderFillAmount(ks.Ctx, orderB, 5, 10)
+	require.Empty(t, ks.ClobKeeper.PruneOrdersForBlockHeight(ks.Ctx, 10))
+	exists, _, _ := ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, orderB)
+	require.True(t, exists)
+}
+
 func TestRemoveOrderFillAmount(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
@@ -609,6 +747,11 @@ func TestRemoveOrderFillAmount(t *testing.T) {
 			expectedMultiStoreWrites: []string{
 				types.OrderAmountFilledKeyPrefix +
 					string(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.ToStateKey()),
+				// The order is removed from the pruning schedule of its previous prunable block height.
+				types.PrunableOrdersKeyPrefix +
+					string(lib.Uint32ToKey(10)) +
+					":" +
+					string(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.ToStateKey()),
 				types.OrderAmountFilledKeyPrefix +
 					string(constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId.ToStateKey()),
 				types.OrderAmountFilledKeyPrefix +
@@ -208,6 +208,19 @@ func (k Keeper) PlaceShortTermOrder(
 		return 0, 0, err
 	}
 
+	// Reject orders that would increase risk while oracle prices are stale, that would cause the
+	// subaccount to exceed the max subaccount notional, or that would open a new position without the
+	// minimum collateral.
+	if err := k.validateOrderPlacementNotPausedByStalePrices(ctx, order); err != nil {
+		return 0, 0, err
+	}
+	if err := k.ValidateSubaccountNotionalLimitForOrder(ctx, order); err != nil {
+		return 0, 0, err
+	}
+	if err := k.ValidateMinCollateralToOpenPositionForOrder(ctx, order); err != nil {
+		return 0, 0, err
+	}
+
 	// Place the order on the memclob and return the result.
 	orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, err := k.MemClob.PlaceOrder(
 		ctx,
@@ -305,10 +318,13 @@ func (k Keeper) CancelStatefulOrder(
 
 // PlaceStatefulOrder performs order validation, equity tier limit check, a collateralization check and writes the
 // order to state and the memstore. The order will not be placed on the orderbook.
-// Metrics, equity tier limit, and collateralization check are skipped for orders internal to the protocol.
+// Metrics, stale price check, subaccount notional limit, equity tier limit, and collateralization check are
+// skipped for orders internal to the protocol.
 //
 // An error will be returned if any of the following conditions are true:
 //   - Standard stateful validation fails.
+//   - Oracle prices are stale and the order is not reduce-only.
+//   - Max subaccount notional exceeded.
 //   - Equity tier limit exceeded.
 //   - Collateralization check fails.
 //
@@ -360,7 +376,18 @@ func (k Keeper) PlaceStatefulOrder(
 	}
 
 	if !isInternalOrder {
-		// 3. Check that adding the order would not exceed the equity tier for the account.
+		// 3. Reject orders that would increase risk while oracle prices are stale, that would exceed the
+		// max subaccount notional, or that would open a new position without the minimum collateral, then
+		// check that adding the order would not exceed the equity tier for the account.
+		if err := k.validateOrderPlacementNotPausedByStalePrices(ctx, order); err != nil {
+			return err
+		}
+		if err := k.ValidateSubaccountNotionalLimitForOrder(ctx, order); err != nil {
+			return err
+		}
+		if err := k.ValidateMinCollateralToOpenPositionForOrder(ctx, order); err != nil {
+			return err
+		}
 		if err := k.ValidateSubaccountEquityTierLimitForStatefulOrder(ctx, order); err != nil {
 			return err
 		}
@@ -1024,7 +1051,11 @@ func (k Keeper) AddOrderToOrderbookSubaccountUpdatesCheck(
 		quoteDelta.Neg(quoteDelta)
 		baseDelta.Neg(baseDelta)
 	}
-	fee := lib.BigMulPpm(bigFillQuoteQuantums, lib.BigI(makerFeePpm), true)
+	fee := lib.BigMulPpmWithRoundingMode(
+		bigFillQuoteQuantums,
+		lib.BigI(makerFeePpm),
+		k.GetClobPairConfig(ctx).GetFeeLibRoundingMode(),
+	)
 	quoteDelta.Sub(quoteDelta, fee)
 	_, updateResults, err := k.subaccountsKeeper.CanUpdateSubaccounts(
 		ctx,
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// DelistPerpetualMarket delists the market of the provided perpetual and pauses all of its
+// ClobPairs. The perpetual's last-known market price is recorded as its settlement price and
+// used in place of the oracle price from then on. Paused ClobPairs only accept orders that
+// close existing positions, so open positions may be reduced but not increased.
+// ClobPairs already in final settlement are left unchanged.
+func (k Keeper) DelistPerpetualMarket(
+	ctx sdk.Context,
+	perpetualId uint32,
+) error {
+	clobPairIds, exists := k.PerpetualIdToClobPairId[perpetualId]
+	if !exists {
+		return errorsmod.Wrapf(
+			types.ErrNoClobPairForPerpetual,
+			"Perpetual ID %d has no associated CLOB pairs",
+			perpetualId,
+		)
+	}
+
+	if _, err := k.perpetualsKeeper.DelistPerpetualMarket(ctx, perpetualId); err != nil {
+		return err
+	}
+
+	for _, clobPairId := range clobPairIds {
+		clobPair := k.mustGetClobPair(ctx, clobPairId)
+		if clobPair.Status == types.ClobPair_STATUS_FINAL_SETTLEMENT {
+			continue
+		}
+
+		clobPair.Status = types.ClobPair_STATUS_PAUSED
+		k.setClobPair(ctx, clobPair)
+
+		// Send UpdateClobPair to indexer.
+		k.GetIndexerEventManager().AddTxnEvent(
+			ctx,
+			indexerevents.SubtypeUpdateClobPair,
+			indexerevents.UpdateClobPairEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewUpdateClobPairEvent(
+					clobPair.GetClobPairId(),
+					clobPair.Status,
+					clobPair.QuantumConversionExponent,
+					types.SubticksPerTick(clobPair.GetSubticksPerTick()),
+					satypes.BaseQuantums(clobPair.GetStepBaseQuantums()),
+				),
+			),
+		)
+
+		// Stateful orders cannot be reduce-only, so forcefully cancel all placed stateful orders
+		// for this clob pair. Untriggered conditional orders can no longer be triggered and are
+		// removed in EndBlocker, see `RemoveUntriggeredConditionalOrdersForDelistedMarkets`.
+		k.mustCancelStatefulOrdersForClobPair(ctx, k.GetAllPlacedStatefulOrders(ctx), clobPairId)
+	}
+
+	return nil
+}
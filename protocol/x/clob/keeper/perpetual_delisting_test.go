@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelistPerpetualMarket(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	indexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	ctx := ks.Ctx
+
+	keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+	keepertest.CreateTestPerpetuals(t, ctx, ks.PerpetualsKeeper)
+	keepertest.CreateTestClobPairs(t, ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	ks.ClobKeeper.SetLongTermOrderPlacement(ctx, longTermOrder, 1)
+
+	// Delisting a perpetual without a clob pair should fail.
+	err := ks.ClobKeeper.DelistPerpetualMarket(ctx, 1)
+	require.ErrorIs(t, err, types.ErrNoClobPairForPerpetual)
+
+	err = ks.ClobKeeper.DelistPerpetualMarket(ctx, 0)
+	require.NoError(t, err)
+
+	// The perpetual records its last-known market price as its settlement price.
+	perpetual, marketPrice, err := ks.PerpetualsKeeper.GetPerpetualAndMarketPrice(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, perpetual.IsMarketDelisted())
+	require.Equal(t, marketPrice, *perpetual.DelistedSettlementPrice)
+
+	// The clob pair is paused and its stateful orders are cancelled.
+	clobPair, found := ks.ClobKeeper.GetClobPair(ctx, constants.ClobPair_Btc.GetClobPairId())
+	require.True(t, found)
+	require.Equal(t, types.ClobPair_STATUS_PAUSED, clobPair.Status)
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, longTermOrder.OrderId)
+	require.False(t, found)
+	require.Equal(
+		t,
+		[]types.OrderId{longTermOrder.OrderId},
+		ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx).RemovedStatefulOrderIds,
+	)
+
+	// Delisting the same perpetual again should fail.
+	err = ks.ClobKeeper.DelistPerpetualMarket(ctx, 0)
+	require.ErrorIs(t, err, perptypes.ErrPerpetualMarketAlreadyDelisted)
+}
+
+func TestRemoveUntriggeredConditionalOrdersForDelistedMarkets(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	indexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+	ctx := ks.Ctx
+
+	keepertest.CreateTestMarkets(t, ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, ks.PerpetualsKeeper)
+	keepertest.CreateTestPerpetuals(t, ctx, ks.PerpetualsKeeper)
+	keepertest.CreateTestClobPairs(
+		t,
+		ctx,
+		ks.ClobKeeper,
+		[]types.ClobPair{constants.ClobPair_Btc, constants.ClobPair_Eth},
+	)
+
+	btcStopLoss := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	btcTakeProfit := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy15_Price10_GTBT15_TakeProfit5
+	ethStopLoss := constants.ConditionalOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTBT15_StopLoss20
+	orderIds := make([]types.OrderId, 0)
+	for _, order := range []types.Order{btcStopLoss, btcTakeProfit, ethStopLoss} {
+		ks.ClobKeeper.SetLongTermOrderPlacement(ctx, order, 1)
+		orderIds = append(orderIds, order.OrderId)
+	}
+	ks.ClobKeeper.AddUntriggeredConditionalOrders(
+		ctx,
+		orderIds,
+		map[types.OrderId]struct{}{},
+		map[types.OrderId]struct{}{},
+	)
+
+	// No conditional orders are removed while their markets are listed.
+	require.Empty(t, ks.ClobKeeper.RemoveUntriggeredConditionalOrdersForDelistedMarkets(ctx))
+
+	// Delisting the BTC market leaves its untriggered conditional orders to be removed in EndBlocker.
+	require.NoError(t, ks.ClobKeeper.DelistPerpetualMarket(ctx, 0))
+	_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, btcStopLoss.OrderId)
+	require.True(t, found)
+
+	for _, orderId := range []types.OrderId{btcStopLoss.OrderId, btcTakeProfit.OrderId} {
+		indexerEventManager.On("AddBlockEvent",
+			ctx,
+			indexerevents.SubtypeStatefulOrder,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.StatefulOrderEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewStatefulOrderRemovalEvent(
+					orderId,
+					indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_DELISTED,
+				),
+			),
+		).Once().Return()
+	}
+
+	removedOrderIds := ks.ClobKeeper.RemoveUntriggeredConditionalOrdersForDelistedMarkets(ctx)
+	require.ElementsMatch(t, []types.OrderId{btcStopLoss.OrderId, btcTakeProfit.OrderId}, removedOrderIds)
+	indexerEventManager.AssertExpectations(t)
+
+	// The conditional orders of the delisted market are removed from state and memory.
+	for _, orderId := range removedOrderIds {
+		_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ctx, orderId)
+		require.False(t, found)
+	}
+	_, found = ks.ClobKeeper.UntriggeredConditionalOrders[constants.ClobPair_Btc.GetClobPairId()]
+	require.False(t, found)
+
+	// The conditional order of the ETH market is unaffected.
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ctx, ethStopLoss.OrderId)
+	require.True(t, found)
+	require.Equal(
+		t,
+		[]types.Order{ethStopLoss},
+		ks.ClobKeeper.UntriggeredConditionalOrders[constants.ClobPair_Eth.GetClobPairId()].
+			OrdersToTriggerWhenOraclePriceGTETriggerPrice,
+	)
+}
+
+func TestProcessProposerOperations_DelistedMarket(t *testing.T) {
+	blockHeight := uint32(5)
+	carlBuy := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Carl_Num0, ClientId: 0, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     50_000_000, // 0.5 BTC
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+	}
+	daveSell := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Dave_Num0, ClientId: 0, ClobPairId: 0},
+		Side:         types.Order_SIDE_SELL,
+		Quantums:     50_000_000, // 0.5 BTC
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+	}
+	carlSell := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Carl_Num0, ClientId: 1, ClobPairId: 0},
+		Side:         types.Order_SIDE_SELL,
+		Quantums:     50_000_000, // 0.5 BTC
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+	}
+	daveBuy := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Dave_Num0, ClientId: 1, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     50_000_000, // 0.5 BTC
+		Subticks:     50_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 10},
+	}
+	delistMarket := func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
+		require.NoError(t, ks.ClobKeeper.DelistPerpetualMarket(ctx, 0))
+	}
+
+	tests := map[string]processProposerOperationsTestCase{
+		"Match reducing both positions succeeds on a delisted market": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParamsNoFee,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			setupState: delistMarket,
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(carlBuy),
+				clobtest.NewShortTermOrderPlacementOperationRaw(daveSell),
+				clobtest.NewMatchOperationRaw(
+					&daveSell,
+					[]types.MakerFill{
+						{
+							FillAmount:   50_000_000,
+							MakerOrderId: carlBuy.OrderId,
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				OrderIdsFilledInLastBlock: []types.OrderId{
+					carlBuy.OrderId,
+					daveSell.OrderId,
+				},
+				BlockHeight: blockHeight,
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Carl_Num0: constants.Usdc_Asset_100_000.GetBigQuantums().Int64() - 25_000_000_000,
+				constants.Dave_Num0: constants.Usdc_Asset_50_000.GetBigQuantums().Int64() + 25_000_000_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Carl_Num0: {
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-50_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+				constants.Dave_Num0: {
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(50_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			},
+		},
+		"Order increasing a position fails on a delisted market": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_100PercentMarginRequirement,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParamsNoFee,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			setupState: delistMarket,
+			rawOperations: []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(carlSell),
+				clobtest.NewShortTermOrderPlacementOperationRaw(daveBuy),
+				clobtest.NewMatchOperationRaw(
+					&daveBuy,
+					[]types.MakerFill{
+						{
+							FillAmount:   50_000_000,
+							MakerOrderId: carlSell.OrderId,
+						},
+					},
+				),
+			},
+			expectedError: types.ErrOrderConflictsWithClobPairStatus,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runProcessProposerOperationsTestCase(t, tc)
+		})
+	}
+}
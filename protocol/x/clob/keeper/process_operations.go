@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -46,15 +47,26 @@ func fetchOrdersInvolvedInOpQueue(
 func (k Keeper) ProcessProposerOperations(
 	ctx sdk.Context,
 	rawOperations []types.OperationRaw,
-) error {
+) (err error) {
 	// This function should be only run in DeliverTx mode.
 	lib.AssertDeliverTxMode(ctx)
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), metrics.ProcessOperations)
 
+	// Record any operation that causes the operations queue to be rejected.
+	defer func() {
+		k.recordLastBlockRejectedOperations(ctx, rawOperations, err)
+	}()
+
 	// Stateless validation of RawOperations and transforms them into InternalOperations to be used internally by memclob.
 	operations, err := types.ValidateAndTransformRawOperations(ctx, rawOperations, k.txDecoder, k.antehandler)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrInvalidMsgProposedOperations, "Error: %+v", err)
+		wrappedErr := errorsmod.Wrapf(types.ErrInvalidMsgProposedOperations, "Error: %+v", err)
+		// Preserve the index of the invalid operation, which is otherwise lost when wrapping.
+		var operationErr *types.OperationError
+		if errors.As(err, &operationErr) {
+			return types.NewOperationError(operationErr.OperationIndex, wrappedErr)
+		}
+		return wrappedErr
 	}
 
 	// If grpc streams are on, send absolute fill amounts from local + proposed opqueue to the grpc stream.
@@ -81,7 +93,7 @@ func (k Keeper) ProcessProposerOperations(
 		log.OperationsQueue, types.GetInternalOperationsQueueTextString(operations))
 
 	// Write results of the operations queue to state. Performs stateful validation as well.
-	if err := k.ProcessInternalOperations(ctx, operations); err != nil {
+	if err = k.ProcessInternalOperations(ctx, operations); err != nil {
 		return err
 	}
 
@@ -138,6 +150,68 @@ func (k Keeper) ProcessProposerOperations(
 	return nil
 }
 
+// ReplayOperationsOnCachedMemclob validates an []OperationRaw operations queue and replays it against a
+// cached branch of the current state, without committing any of the resulting state changes. It returns
+// the matches contained in the operations queue if the entire queue is valid, or the first validation
+// error encountered otherwise. This performs the same validation as `ProcessProposerOperations`, and
+// should only be run in DeliverTx mode.
+func (k Keeper) ReplayOperationsOnCachedMemclob(
+	ctx sdk.Context,
+	rawOperations []types.OperationRaw,
+) (
+	matches []types.ClobMatch,
+	err error,
+) {
+	lib.AssertDeliverTxMode(ctx)
+
+	operations, err := types.ValidateAndTransformRawOperations(ctx, rawOperations, k.txDecoder, k.antehandler)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgProposedOperations, "Error: %+v", err)
+	}
+
+	// Process the operations on a cached context so that no state changes or events are written.
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.ProcessInternalOperations(cacheCtx, operations); err != nil {
+		return nil, err
+	}
+
+	matches = make([]types.ClobMatch, 0)
+	for _, operation := range operations {
+		if clobMatch := operation.GetMatch(); clobMatch != nil {
+			matches = append(matches, *clobMatch)
+		}
+	}
+	return matches, nil
+}
+
+// recordLastBlockRejectedOperations records the operation that caused the operations queue of the current
+// block to be rejected, if any. Since operations queues are rejected as a whole, at most one operation is
+// recorded per block.
+func (k Keeper) recordLastBlockRejectedOperations(
+	ctx sdk.Context,
+	rawOperations []types.OperationRaw,
+	err error,
+) {
+	rejectedOperations := []types.RejectedOperation{}
+	var operationErr *types.OperationError
+	if errors.As(err, &operationErr) &&
+		operationErr.OperationIndex >= 0 &&
+		operationErr.OperationIndex < len(rawOperations) {
+		rejectedOperations = append(rejectedOperations, types.RejectedOperation{
+			OperationIndex: lib.MustConvertIntegerToUint32(operationErr.OperationIndex),
+			Operation:      rawOperations[operationErr.OperationIndex],
+			Reason:         err.Error(),
+		})
+	}
+	k.lastBlockRejectedOperations.Set(lib.MustConvertIntegerToUint32(ctx.BlockHeight()), rejectedOperations)
+}
+
+// GetLastBlockRejectedOperations returns the height of the last block in which proposed operations were
+// processed, and the operations that were rejected in it.
+func (k Keeper) GetLastBlockRejectedOperations() (blockHeight uint32, rejectedOperations []types.RejectedOperation) {
+	return k.lastBlockRejectedOperations.Get()
+}
+
 // ProcessInternalOperations takes in an InternalOperations slice and writes all relevant
 // operations to state. This function assumes that the operations have passed all stateless validation.
 // This function will perform stateful validation as it processes operations.
@@ -145,6 +219,8 @@ func (k Keeper) ProcessProposerOperations(
 // - Order Matches, Liquidation Matches, Deleveraging Matches
 // - Order Removals
 
+// Returned errors are `OperationError`s which identify the invalid operation.
+//
 // Function will panic if:
 // - any orderId referenced in clobMatch cannot be found.
 // - any orderId referenced in order removal operations cannot be found.
@@ -157,20 +233,20 @@ func (k Keeper) ProcessInternalOperations(
 	placedShortTermOrders := make(map[types.OrderId]types.Order, 0)
 
 	// Write the matches to state if all stateful validation passes.
-	for _, operation := range operations {
+	for i, operation := range operations {
 		if err := k.validateInternalOperationAgainstClobPairStatus(ctx, operation); err != nil {
-			return err
+			return types.NewOperationError(i, err)
 		}
 
 		switch castedOperation := operation.Operation.(type) {
 		case *types.InternalOperation_Match:
 			clobMatch := castedOperation.Match
 			if err := k.PersistMatchToState(ctx, clobMatch, placedShortTermOrders); err != nil {
-				return errorsmod.Wrapf(
+				return types.NewOperationError(i, errorsmod.Wrapf(
 					err,
 					"ProcessInternalOperations: Failed to process clobMatch: %+v",
 					clobMatch,
-				)
+				))
 			}
 		case *types.InternalOperation_ShortTermOrderPlacement:
 			order := castedOperation.ShortTermOrderPlacement.GetOrder()
@@ -180,19 +256,19 @@ func (k Keeper) ProcessInternalOperations(
 				lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
 				false,
 			); err != nil {
-				return err
+				return types.NewOperationError(i, err)
 			}
 			placedShortTermOrders[order.GetOrderId()] = order
 		case *types.InternalOperation_OrderRemoval:
 			orderRemoval := castedOperation.OrderRemoval
 
 			if err := k.PersistOrderRemovalToState(ctx, *orderRemoval); err != nil {
-				return errorsmod.Wrapf(
+				return types.NewOperationError(i, errorsmod.Wrapf(
 					types.ErrInvalidOrderRemoval,
 					"Order Removal (%+v) invalid. Error: %+v",
 					*orderRemoval,
 					err,
-				)
+				))
 			}
 		case *types.InternalOperation_PreexistingStatefulOrder:
 			// When we fetch operations to propose, preexisting stateful orders are not included
@@ -420,6 +496,9 @@ func (k Keeper) PersistOrderRemovalToState(
 	case types.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS:
 		// TODO(CLOB-877)
 		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
+	case types.OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION:
+		// TODO(CLOB-877)
+		k.statUnverifiedOrderRemoval(ctx, orderRemoval)
 	default:
 		return errorsmod.Wrapf(
 			types.ErrInvalidOrderRemovalReason,
@@ -583,6 +662,19 @@ func (k Keeper) PersistMatchLiquidationToState(
 		return err
 	}
 
+	// The subaccount cannot be liquidated again until the liquidation cooldown has passed.
+	blockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	if k.IsSubaccountInLiquidationCooldown(ctx, matchLiquidation.Liquidated, blockHeight) {
+		lastLiquidationBlock, _ := k.GetSubaccountLastLiquidationBlock(ctx, matchLiquidation.Liquidated)
+		return errorsmod.Wrapf(
+			types.ErrSubaccountInLiquidationCooldown,
+			"Subaccount %+v was last liquidated at block %d, current block %d",
+			matchLiquidation.Liquidated,
+			lastLiquidationBlock,
+			blockHeight,
+		)
+	}
+
 	takerOrder, err := k.GetLiquidationOrderForPerpetual(
 		ctx,
 		matchLiquidation.Liquidated,
@@ -656,6 +748,7 @@ func (k Keeper) PersistMatchLiquidationToState(
 		matchLiquidation.Liquidated,
 		matchLiquidation.PerpetualId,
 	)
+	k.SetSubaccountLastLiquidationBlock(ctx, matchLiquidation.Liquidated, blockHeight)
 
 	// if GRPC streaming is on, emit a generated clob match to stream.
 	if streamingManager := k.GetFullNodeStreamingManager(); streamingManager.Enabled() {
@@ -685,6 +778,7 @@ func (k Keeper) PersistMatchLiquidationToState(
 // subaccount failed deleveraging validation.
 // - The IsFinalSettlement flag on the operation does not match the expected value based on collateralization
 // and market status.
+// - The fills sum to more than the size of the liquidated subaccount's position.
 // - OffsetSubaccountPerpetualPosition returns an error.
 // - The generated fills do not match the fills in the Operations object.
 // TODO(CLOB-654) Verify deleveraging is triggered by unmatched liquidation orders and for the correct amount.
@@ -695,6 +789,18 @@ func (k Keeper) PersistMatchDeleveragingToState(
 	liquidatedSubaccountId := matchDeleveraging.GetLiquidated()
 	perpetualId := matchDeleveraging.GetPerpetualId()
 
+	// Validate that the deleveraging match does not exceed the maximum number of fills.
+	maxFills := k.GetLiquidationsConfig(ctx).MaxDeleveragingFillsPerOperation
+	if maxFills != 0 && len(matchDeleveraging.GetFills()) > int(maxFills) {
+		return errorsmod.Wrapf(
+			types.ErrTooManyDeleveragingFills,
+			"MatchPerpetualDeleveraging %+v has %d fills, maximum is %d",
+			matchDeleveraging,
+			len(matchDeleveraging.GetFills()),
+			maxFills,
+		)
+	}
+
 	// Validate that the provided subaccount can be deleveraged.
 	shouldDeleverageAtBankruptcyPrice, shouldDeleverageAtOraclePrice, err := k.CanDeleverageSubaccount(
 		ctx,
@@ -746,6 +852,25 @@ func (k Keeper) PersistMatchDeleveragingToState(
 	}
 	deltaBaseQuantumsIsNegative := position.GetIsLong()
 
+	// Validate that the fills do not deleverage more than the size of the liquidated position. Fills summing to
+	// less than the position size are valid, since the proposer partially deleverages a subaccount when there
+	// are not enough offsetting positions to close the position in full.
+	if len(matchDeleveraging.GetFills()) > 0 {
+		totalFillQuantums := new(big.Int)
+		for _, fill := range matchDeleveraging.GetFills() {
+			totalFillQuantums.Add(totalFillQuantums, new(big.Int).SetUint64(fill.FillAmount))
+		}
+		if totalFillQuantums.CmpAbs(position.GetBigQuantums()) > 0 {
+			return errorsmod.Wrapf(
+				types.ErrDeleveragingFillsDoNotMatchPositionSize,
+				"MatchPerpetualDeleveraging %+v has total fill amount %v, exceeding position size %v",
+				matchDeleveraging,
+				totalFillQuantums,
+				new(big.Int).Abs(position.GetBigQuantums()),
+			)
+		}
+	}
+
 	// If there are zero-fill deleveraging operations, this is a sentinel value to indicate a subaccount could not be
 	// liquidated or deleveraged and still has negative equity. Mark the current block number in state to indicate a
 	// negative TNC subaccount was seen.
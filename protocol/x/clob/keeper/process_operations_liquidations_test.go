@@ -21,6 +21,7 @@ import (
 	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	statstypes "github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -2466,3 +2467,232 @@ func TestValidateProposerMatches_InsuranceFund(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessProposerMatches_Liquidation_Cooldown(t *testing.T) {
+	liquidatedSubaccount := satypes.Subaccount{
+		Id: &constants.Dave_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			testutil.CreateSingleAssetPosition(
+				0,
+				big.NewInt(-45_001_000_000), // -$45,001
+			),
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			testutil.CreateSinglePerpetualPosition(
+				0,
+				big.NewInt(100_000_000), // 1 BTC
+				big.NewInt(0),
+				big.NewInt(0),
+			),
+		},
+	}
+	makerOrder := types.Order{
+		OrderId: types.OrderId{
+			SubaccountId: constants.Carl_Num0,
+			ClientId:     0,
+			ClobPairId:   0,
+		},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     10,
+		Subticks:     90_000_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+	liquidationOperations := []types.OperationRaw{
+		clobtest.NewShortTermOrderPlacementOperationRaw(makerOrder),
+		clobtest.NewMatchOperationRawFromPerpetualLiquidation(
+			types.MatchPerpetualLiquidation{
+				Liquidated:  constants.Dave_Num0,
+				ClobPairId:  0,
+				PerpetualId: 0,
+				TotalSize:   100_000_000,
+				IsBuy:       false,
+				Fills: []types.MakerFill{
+					{
+						MakerOrderId: makerOrder.OrderId,
+						FillAmount:   10,
+					},
+				},
+			},
+		),
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		cooldownBlocks uint32
+		blockHeight    int64
+
+		// Expectations.
+		expectedErr error
+	}{
+		"Back-to-back liquidation in the next block fails within the cooldown": {
+			cooldownBlocks: 3,
+			blockHeight:    6,
+			expectedErr:    types.ErrSubaccountInLiquidationCooldown,
+		},
+		"Liquidation on the last block of the cooldown fails": {
+			cooldownBlocks: 3,
+			blockHeight:    7,
+			expectedErr:    types.ErrSubaccountInLiquidationCooldown,
+		},
+		"Liquidation once the cooldown has passed succeeds": {
+			cooldownBlocks: 3,
+			blockHeight:    8,
+		},
+		"Back-to-back liquidation succeeds when the cooldown is disabled": {
+			cooldownBlocks: 0,
+			blockHeight:    6,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			liquidationConfig := constants.LiquidationsConfig_No_Limit
+			liquidationConfig.SubaccountLiquidationCooldownBlocks = tc.cooldownBlocks
+			ctx, ks, _ := setupProcessProposerOperationsTestCase(t, processProposerOperationsTestCase{
+				perpetuals: []perptypes.Perpetual{
+					constants.BtcUsd_100PercentMarginRequirement,
+				},
+				perpetualFeeParams: &constants.PerpetualFeeParams,
+				clobPairs: []types.ClobPair{
+					constants.ClobPair_Btc,
+				},
+				subaccounts: []satypes.Subaccount{
+					constants.Carl_Num0_1BTC_Short,
+					liquidatedSubaccount,
+				},
+				liquidationConfig: &liquidationConfig,
+				expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+					BlockHeight: 5,
+				},
+			})
+
+			// The subaccount was last liquidated in block 5, and is liquidated again in a later block.
+			ks.ClobKeeper.SetSubaccountLastLiquidationBlock(ctx, constants.Dave_Num0, 5)
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
+			err := ks.ClobKeeper.ProcessProposerOperations(ctx, liquidationOperations)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				lastLiquidationBlock, _ := ks.ClobKeeper.GetSubaccountLastLiquidationBlock(ctx, constants.Dave_Num0)
+				require.Equal(t, uint32(5), lastLiquidationBlock)
+				return
+			}
+
+			require.NoError(t, err)
+			lastLiquidationBlock, _ := ks.ClobKeeper.GetSubaccountLastLiquidationBlock(ctx, constants.Dave_Num0)
+			require.Equal(t, uint32(tc.blockHeight), lastLiquidationBlock)
+		})
+	}
+}
+
+func TestProcessProposerMatches_Liquidation_RecordsInsuranceFundFlow(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		subaccounts         []satypes.Subaccount
+		makerOrder          types.Order
+		setupMockBankKeeper func(bk *mocks.BankKeeper)
+
+		// Expectations.
+		expectedInsuranceFundFlow *statstypes.InsuranceFundFlow
+	}{
+		"Liquidation paying into the insurance fund records an inflow": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_54999USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			makerOrder: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000_GTB10,
+			setupMockBankKeeper: func(bk *mocks.BankKeeper) {
+				bk.On(
+					"SendCoins",
+					mock.Anything,
+					satypes.ModuleAddress,
+					authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+					mock.MatchedBy(testutil_bank.MatchUsdcOfAmount(10_000_000)),
+				).Return(nil)
+				bk.On(
+					"SendCoins",
+					mock.Anything,
+					satypes.ModuleAddress,
+					perptypes.InsuranceFundModuleAddress,
+					// Subaccount pays $250 to insurance fund for liquidating 1 BTC.
+					mock.MatchedBy(testutil_bank.MatchUsdcOfAmount(250_000_000)),
+				).Return(nil).Once()
+			},
+			expectedInsuranceFundFlow: &statstypes.InsuranceFundFlow{
+				Inflow: 250_000_000,
+			},
+		},
+		"Liquidation drawing from the insurance fund records an outflow": {
+			subaccounts: []satypes.Subaccount{
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			// Bankruptcy price in quote quantums is $50499 for 1 BTC.
+			// When subticks is $50,500, the insurance fund delta is -$1.
+			makerOrder: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50500_GTB10,
+			setupMockBankKeeper: func(bk *mocks.BankKeeper) {
+				bk.On(
+					"SendCoins",
+					mock.Anything,
+					satypes.ModuleAddress,
+					authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+					mock.MatchedBy(testutil_bank.MatchUsdcOfAmount(10_100_000)),
+				).Return(nil)
+				bk.On(
+					"GetBalance",
+					mock.Anything,
+					mock.Anything,
+					mock.Anything,
+				).Return(sdk.NewCoin("USDC", sdkmath.NewIntFromUint64(math.MaxUint64)))
+				bk.On(
+					"SendCoins",
+					mock.Anything,
+					perptypes.InsuranceFundModuleAddress,
+					satypes.ModuleAddress,
+					// Insurance fund covers $1 loss for liquidating 1 BTC.
+					mock.MatchedBy(testutil_bank.MatchUsdcOfAmount(1_000_000)),
+				).Return(nil).Once()
+			},
+			expectedInsuranceFundFlow: &statstypes.InsuranceFundFlow{
+				Outflow: 1_000_000,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, ks := runProcessProposerOperationsTestCase(t, processProposerOperationsTestCase{
+				perpetuals: []perptypes.Perpetual{
+					constants.BtcUsd_100PercentMarginRequirement,
+				},
+				subaccounts:         tc.subaccounts,
+				perpetualFeeParams:  &constants.PerpetualFeeParams,
+				clobPairs:           []types.ClobPair{constants.ClobPair_Btc},
+				setupMockBankKeeper: tc.setupMockBankKeeper,
+				rawOperations: []types.OperationRaw{
+					clobtest.NewShortTermOrderPlacementOperationRaw(tc.makerOrder),
+					clobtest.NewMatchOperationRawFromPerpetualLiquidation(
+						types.MatchPerpetualLiquidation{
+							Liquidated:  constants.Carl_Num0,
+							ClobPairId:  0,
+							PerpetualId: 0,
+							TotalSize:   100_000_000, // 1 BTC
+							IsBuy:       true,
+							Fills: []types.MakerFill{
+								{
+									MakerOrderId: tc.makerOrder.OrderId,
+									FillAmount:   100_000_000, // 1 BTC
+								},
+							},
+						},
+					),
+				},
+				expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+					OrderIdsFilledInLastBlock: []types.OrderId{tc.makerOrder.OrderId},
+					BlockHeight:               5,
+				},
+			})
+
+			require.Equal(t, tc.expectedInsuranceFundFlow, ks.StatsKeeper.GetBlockStats(ctx).InsuranceFundFlow)
+		})
+	}
+}
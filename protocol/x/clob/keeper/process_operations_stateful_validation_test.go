@@ -286,7 +286,7 @@ func TestProcessProposerMatches_LongTerm_StatefulValidation_Failure(t *testing.T
 					},
 				),
 			},
-			expectedError: errors.New("ClobPairIds do not match in match"),
+			expectedError: types.ErrMatchOrderClobPairIdMismatch,
 		},
 		"Fails with Long-Term order when considering state fill amount": {
 			perpetuals: []perptypes.Perpetual{
@@ -582,7 +582,7 @@ func TestProcessProposerMatches_Conditional_Validation_Failure(t *testing.T) {
 					},
 				),
 			},
-			expectedError: errors.New("ClobPairIds do not match in match"),
+			expectedError: types.ErrMatchOrderClobPairIdMismatch,
 		},
 		"Fails with conditional order when considering state fill amount": {
 			perpetuals: []perptypes.Perpetual{
@@ -15,6 +15,7 @@ import (
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/shared"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	clobtest "github.com/dydxprotocol/v4-chain/protocol/testutil/clob"
@@ -873,6 +874,104 @@ func TestProcessProposerOperations(t *testing.T) {
 				constants.Dave_Num0: {},
 			},
 		},
+		"Succeeds with deleveraging with the maximum number of fills per operation": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// liquidatable: MMR = $5000, TNC = -$1
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+				constants.Dave_Num1_1BTC_Long_50000USD,
+			},
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_050_000_000, // $50,500 / BTC
+			},
+			liquidationConfig: &types.LiquidationsConfig{
+				MaxLiquidationFeePpm:             constants.LiquidationsConfig_No_Limit.MaxLiquidationFeePpm,
+				PositionBlockLimits:              constants.LiquidationsConfig_No_Limit.PositionBlockLimits,
+				SubaccountBlockLimits:            constants.LiquidationsConfig_No_Limit.SubaccountBlockLimits,
+				FillablePriceConfig:              constants.LiquidationsConfig_No_Limit.FillablePriceConfig,
+				MaxDeleveragingFillsPerOperation: 2,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             50_000_000,
+							},
+							{
+								OffsettingSubaccountId: constants.Dave_Num1,
+								FillAmount:             50_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Carl_Num0: 0,
+				constants.Dave_Num0: 50_000_000_000 + 25_249_500_000,
+				constants.Dave_Num1: 50_000_000_000 + 25_249_500_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Carl_Num0: {},
+			},
+		},
+		"Fails with deleveraging exceeding the maximum number of fills per operation": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// liquidatable: MMR = $5000, TNC = -$1
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+				constants.Dave_Num1_1BTC_Long_50000USD,
+			},
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_050_000_000, // $50,500 / BTC
+			},
+			liquidationConfig: &types.LiquidationsConfig{
+				MaxLiquidationFeePpm:             constants.LiquidationsConfig_No_Limit.MaxLiquidationFeePpm,
+				PositionBlockLimits:              constants.LiquidationsConfig_No_Limit.PositionBlockLimits,
+				SubaccountBlockLimits:            constants.LiquidationsConfig_No_Limit.SubaccountBlockLimits,
+				FillablePriceConfig:              constants.LiquidationsConfig_No_Limit.FillablePriceConfig,
+				MaxDeleveragingFillsPerOperation: 1,
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             50_000_000,
+							},
+							{
+								OffsettingSubaccountId: constants.Dave_Num1,
+								FillAmount:             50_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedError: types.ErrTooManyDeleveragingFills,
+		},
 		// This test proposes a set of operations where a liquidation taker order matches with a short
 		// term maker order. A deleveraging match is also proposed. For this to happen, the liquidation
 		// would have matched with this first order and then tried to match with a second order, resulting
@@ -1149,6 +1248,141 @@ func TestProcessProposerOperations(t *testing.T) {
 				constants.BtcUsd_20PercentInitial_10PercentMaintenance.Params.Id: true,
 			},
 		},
+		"Succeeds when deleveraging fills are less than the liquidated position size": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// deleveragable: TNC = -$1
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_050_000_000, // $50,500 / BTC
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             50_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Carl_Num0: constants.Carl_Num0_1BTC_Short_50499USD.GetUsdcPosition().Int64() - 25_249_500_000,
+				constants.Dave_Num0: constants.Dave_Num0_1BTC_Long_50000USD.GetUsdcPosition().Int64() + 25_249_500_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Carl_Num0: {
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-100_000_000+50_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+				constants.Dave_Num0: {
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000-50_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				},
+			},
+		},
+		"Succeeds when deleveraging fills are equal to the liquidated position size": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// deleveragable: TNC = -$1
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+			},
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_050_000_000, // $50,500 / BTC
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             100_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+				BlockHeight: blockHeight,
+			},
+			expectedQuoteBalances: map[satypes.SubaccountId]int64{
+				constants.Carl_Num0: 0,
+				constants.Dave_Num0: constants.Dave_Num0_1BTC_Long_50000USD.GetUsdcPosition().Int64() + 50_499_000_000,
+			},
+			expectedPerpetualPositions: map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+				constants.Carl_Num0: {},
+				constants.Dave_Num0: {},
+			},
+		},
+		"Fails when deleveraging fills are greater than the liquidated position size": {
+			perpetuals: []perptypes.Perpetual{
+				constants.BtcUsd_20PercentInitial_10PercentMaintenance,
+			},
+			perpetualFeeParams: &constants.PerpetualFeeParams,
+			clobPairs: []types.ClobPair{
+				constants.ClobPair_Btc,
+			},
+			subaccounts: []satypes.Subaccount{
+				// deleveragable: TNC = -$1
+				constants.Carl_Num0_1BTC_Short_50499USD,
+				constants.Dave_Num0_1BTC_Long_50000USD,
+				constants.Dave_Num1_1BTC_Long_50000USD,
+			},
+			marketIdToOraclePriceOverride: map[uint32]uint64{
+				constants.BtcUsd.MarketId: 5_050_000_000, // $50,500 / BTC
+			},
+			rawOperations: []types.OperationRaw{
+				clobtest.NewMatchOperationRawFromPerpetualDeleveragingLiquidation(
+					types.MatchPerpetualDeleveraging{
+						Liquidated:  constants.Carl_Num0,
+						PerpetualId: 0,
+						Fills: []types.MatchPerpetualDeleveraging_Fill{
+							{
+								OffsettingSubaccountId: constants.Dave_Num0,
+								FillAmount:             100_000_000,
+							},
+							{
+								OffsettingSubaccountId: constants.Dave_Num1,
+								FillAmount:             50_000_000,
+							},
+						},
+					},
+				),
+			},
+			expectedError: types.ErrDeleveragingFillsDoNotMatchPositionSize,
+		},
 		"Zero-fill deleveraging succeeds when the account is negative TNC and updates the last negative TNC subaccount " +
 			"seen block number in state for an isolated perpetual collateral pool if the subaccount is isolated to the " +
 			"isolated perpetual": {
@@ -1737,8 +1971,10 @@ func TestProcessProposerOperations(t *testing.T) {
 			setupState: func(ctx sdk.Context, ks keepertest.ClobKeepersTestContext) {
 				cdc := codec.NewProtoCodec(module.InterfaceRegistry)
 				store := prefix.NewStore(ks.Ctx.KVStore(ks.StoreKey), []byte(types.ClobPairKeyPrefix))
-				b := cdc.MustMarshal(&constants.ClobPair_Btc_Paused)
-				store.Set(lib.Uint32ToKey(constants.ClobPair_Btc_Paused.Id), b)
+				clobPair := constants.ClobPair_Btc
+				clobPair.Status = types.ClobPair_STATUS_CANCEL_ONLY
+				b := cdc.MustMarshal(&clobPair)
+				store.Set(lib.Uint32ToKey(clobPair.Id), b)
 			},
 			expectedPanics: "validateInternalOperationAgainstClobPairStatus: ClobPair's status is not supported",
 		},
@@ -2326,6 +2562,222 @@ func TestGenerateProcessProposerMatchesEvents(t *testing.T) {
 	}
 }
 
+func TestReplayOperationsOnCachedMemclob(t *testing.T) {
+	blockHeight := uint32(5)
+	takerOrder := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+		Side:         types.Order_SIDE_SELL,
+		Quantums:     100_000_000, // 1 BTC
+		Subticks:     50_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+	}
+	makerOrder := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     100_000_000, // 1 BTC
+		Subticks:     50_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+	}
+	initialPerpetualPosition := testutil.CreateSinglePerpetualPosition(
+		0,
+		big.NewInt(1_000_000_000), // 10 BTC
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	tc := processProposerOperationsTestCase{
+		perpetuals: []perptypes.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+		},
+		perpetualFeeParams: &constants.PerpetualFeeParams,
+		clobPairs: []types.ClobPair{
+			constants.ClobPair_Btc,
+		},
+		subaccounts: []satypes.Subaccount{
+			{
+				Id:                 &constants.Alice_Num0,
+				AssetPositions:     []*satypes.AssetPosition{&constants.Usdc_Asset_100_000},
+				PerpetualPositions: []*satypes.PerpetualPosition{initialPerpetualPosition},
+			},
+			{
+				Id:                 &constants.Bob_Num0,
+				AssetPositions:     []*satypes.AssetPosition{&constants.Usdc_Asset_100_000},
+				PerpetualPositions: []*satypes.PerpetualPosition{initialPerpetualPosition},
+			},
+		},
+		rawOperations: []types.OperationRaw{
+			clobtest.NewShortTermOrderPlacementOperationRaw(makerOrder),
+			clobtest.NewShortTermOrderPlacementOperationRaw(takerOrder),
+			clobtest.NewMatchOperationRaw(
+				&takerOrder,
+				[]types.MakerFill{
+					{
+						FillAmount:   100_000_000,
+						MakerOrderId: makerOrder.OrderId,
+					},
+				},
+			),
+		},
+		expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+			BlockHeight: blockHeight,
+		},
+	}
+	ctx, ks, _ := setupProcessProposerOperationsTestCase(t, tc)
+
+	// Replaying the operations returns the matches in the operations queue.
+	matches, err := ks.ClobKeeper.ReplayOperationsOnCachedMemclob(ctx, tc.rawOperations)
+	require.NoError(t, err)
+	require.Equal(t, []types.ClobMatch{*tc.rawOperations[2].GetMatch()}, matches)
+
+	// Replaying the operations does not modify state.
+	for _, orderId := range []types.OrderId{makerOrder.OrderId, takerOrder.OrderId} {
+		exists, _, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, orderId)
+		require.False(t, exists)
+	}
+	initialQuoteBalance := constants.Usdc_Asset_100_000.GetBigQuantums().Int64()
+	assertSubaccountState(
+		t,
+		ctx,
+		ks.SubaccountsKeeper,
+		map[satypes.SubaccountId]int64{
+			constants.Alice_Num0: initialQuoteBalance,
+			constants.Bob_Num0:   initialQuoteBalance,
+		},
+		map[satypes.SubaccountId][]*satypes.PerpetualPosition{
+			constants.Alice_Num0: {initialPerpetualPosition},
+			constants.Bob_Num0:   {initialPerpetualPosition},
+		},
+	)
+
+	// The committed path fills the same orders as the replayed matches.
+	require.NoError(t, ks.ClobKeeper.ProcessProposerOperations(ctx, tc.rawOperations))
+	matchOrders := matches[0].GetMatchOrders()
+	require.Equal(
+		t,
+		[]types.OrderId{matchOrders.TakerOrderId, matchOrders.Fills[0].MakerOrderId},
+		ks.ClobKeeper.GetProcessProposerMatchesEvents(ctx).OrderIdsFilledInLastBlock,
+	)
+	for _, orderId := range []types.OrderId{makerOrder.OrderId, takerOrder.OrderId} {
+		_, fillAmount, _ := ks.ClobKeeper.GetOrderFillAmount(ctx, orderId)
+		require.Equal(t, satypes.BaseQuantums(100_000_000), fillAmount)
+	}
+
+	// Replaying an invalid operations queue returns the validation error.
+	_, err = ks.ClobKeeper.ReplayOperationsOnCachedMemclob(
+		ctx,
+		[]types.OperationRaw{tc.rawOperations[2]},
+	)
+	require.Error(t, err)
+}
+
+func TestProcessProposerOperations_FeeRoundingMode(t *testing.T) {
+	// 0.1 BTC is filled at a price of 50_000 subticks, for a total of 5_000 quote quantums. The taker fee
+	// of 500 ppm is 2.5 quote quantums and the maker fee of 200 ppm is exactly 1 quote quantum.
+	takerOrder := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 14, ClobPairId: 0},
+		Side:         types.Order_SIDE_SELL,
+		Quantums:     10_000_000, // 0.1 BTC
+		Subticks:     50_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+	}
+	makerOrder := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 14, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     10_000_000, // 0.1 BTC
+		Subticks:     50_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 25},
+	}
+	initialPerpetualPosition := testutil.CreateSinglePerpetualPosition(
+		0,
+		big.NewInt(1_000_000_000), // 10 BTC
+		big.NewInt(0),
+		big.NewInt(0),
+	)
+	initialQuoteBalance := constants.Usdc_Asset_100_000.GetBigQuantums().Int64()
+
+	tests := map[string]struct {
+		feeRoundingMode types.ClobPairConfig_RoundingMode
+
+		expectedTakerFee int64
+	}{
+		"Unspecified rounds towards positive infinity": {
+			feeRoundingMode:  types.ClobPairConfig_ROUNDING_MODE_UNSPECIFIED,
+			expectedTakerFee: 3,
+		},
+		"Floor": {
+			feeRoundingMode:  types.ClobPairConfig_ROUNDING_MODE_FLOOR,
+			expectedTakerFee: 2,
+		},
+		"Ceil": {
+			feeRoundingMode:  types.ClobPairConfig_ROUNDING_MODE_CEIL,
+			expectedTakerFee: 3,
+		},
+		"Half even": {
+			feeRoundingMode:  types.ClobPairConfig_ROUNDING_MODE_HALF_EVEN,
+			expectedTakerFee: 2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			testCase := processProposerOperationsTestCase{
+				perpetuals: []perptypes.Perpetual{
+					constants.BtcUsd_100PercentMarginRequirement,
+				},
+				perpetualFeeParams: &constants.PerpetualFeeParams,
+				clobPairs: []types.ClobPair{
+					constants.ClobPair_Btc,
+				},
+				subaccounts: []satypes.Subaccount{
+					{
+						Id:                 &constants.Alice_Num0,
+						AssetPositions:     []*satypes.AssetPosition{&constants.Usdc_Asset_100_000},
+						PerpetualPositions: []*satypes.PerpetualPosition{initialPerpetualPosition},
+					},
+					{
+						Id:                 &constants.Bob_Num0,
+						AssetPositions:     []*satypes.AssetPosition{&constants.Usdc_Asset_100_000},
+						PerpetualPositions: []*satypes.PerpetualPosition{initialPerpetualPosition},
+					},
+				},
+				expectedProcessProposerMatchesEvents: types.ProcessProposerMatchesEvents{
+					BlockHeight: 5,
+				},
+			}
+			ctx, ks, _ := setupProcessProposerOperationsTestCase(t, testCase)
+			ks.ClobKeeper.InitializeClobPairConfig(
+				ctx,
+				types.ClobPairConfig{FeeRoundingMode: tc.feeRoundingMode},
+			)
+
+			err := ks.ClobKeeper.ProcessProposerOperations(ctx, []types.OperationRaw{
+				clobtest.NewShortTermOrderPlacementOperationRaw(makerOrder),
+				clobtest.NewShortTermOrderPlacementOperationRaw(takerOrder),
+				clobtest.NewMatchOperationRaw(
+					&takerOrder,
+					[]types.MakerFill{
+						{
+							FillAmount:   10_000_000,
+							MakerOrderId: makerOrder.OrderId,
+						},
+					},
+				),
+			})
+			require.NoError(t, err)
+
+			assertSubaccountState(
+				t,
+				ctx,
+				ks.SubaccountsKeeper,
+				map[satypes.SubaccountId]int64{
+					constants.Alice_Num0: initialQuoteBalance - 5_000 - 1,
+					constants.Bob_Num0:   initialQuoteBalance + 5_000 - tc.expectedTakerFee,
+				},
+				nil,
+			)
+		})
+	}
+}
+
 func setupProcessProposerOperationsTestCase(
 	t *testing.T,
 	tc processProposerOperationsTestCase,
@@ -2612,6 +3064,96 @@ func runProcessProposerOperationsTestCase(
 	return ctx, ks
 }
 
+func TestPersistOrderRemovalToState_IndexerEvent(t *testing.T) {
+	withTimeInForce := func(order types.Order, tif types.Order_TimeInForce) types.Order {
+		order.TimeInForce = tif
+		return order
+	}
+	longTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+
+	tests := map[types.OrderRemoval_RemovalReason]struct {
+		order types.Order
+
+		expectedIndexerReason indexershared.OrderRemovalReason
+	}{
+		types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED: {
+			order:                 longTermOrder,
+			expectedIndexerReason: indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_UNDERCOLLATERALIZED,
+		},
+		types.OrderRemoval_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER: {
+			order: withTimeInForce(longTermOrder, types.Order_TIME_IN_FORCE_POST_ONLY),
+			expectedIndexerReason: indexershared.
+				OrderRemovalReason_ORDER_REMOVAL_REASON_POST_ONLY_WOULD_CROSS_MAKER_ORDER,
+		},
+		types.OrderRemoval_REMOVAL_REASON_INVALID_SELF_TRADE: {
+			order:                 longTermOrder,
+			expectedIndexerReason: indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_SELF_TRADE_ERROR,
+		},
+		types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_FOK_COULD_NOT_BE_FULLY_FILLED: {
+			order: withTimeInForce(longTermOrder, types.Order_TIME_IN_FORCE_FILL_OR_KILL),
+			expectedIndexerReason: indexershared.
+				OrderRemovalReason_ORDER_REMOVAL_REASON_FOK_ORDER_COULD_NOT_BE_FULLY_FULLED,
+		},
+		types.OrderRemoval_REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK: {
+			order: withTimeInForce(longTermOrder, types.Order_TIME_IN_FORCE_IOC),
+			expectedIndexerReason: indexershared.
+				OrderRemovalReason_ORDER_REMOVAL_REASON_IMMEDIATE_OR_CANCEL_WOULD_REST_ON_BOOK,
+		},
+		types.OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS: {
+			order: longTermOrder,
+			expectedIndexerReason: indexershared.
+				OrderRemovalReason_ORDER_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS,
+		},
+		types.OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION: {
+			order: longTermOrder,
+			expectedIndexerReason: indexershared.
+				OrderRemovalReason_ORDER_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION,
+		},
+	}
+
+	for removalReason, tc := range tests {
+		t.Run(removalReason.String(), func(t *testing.T) {
+			memClob := &mocks.MemClob{}
+			memClob.On("SetClobKeeper", mock.Anything).Return()
+			memClob.On("GetOrderRemainingAmount", mock.Anything, tc.order).Return(satypes.BaseQuantums(5), true)
+			mockIndexerEventManager := &mocks.IndexerEventManager{}
+			ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, mockIndexerEventManager)
+
+			ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, tc.order, 1)
+			ks.ClobKeeper.AddStatefulOrderIdExpiration(
+				ks.Ctx,
+				tc.order.MustGetUnixGoodTilBlockTime(),
+				tc.order.OrderId,
+			)
+
+			mockIndexerEventManager.On("AddTxnEvent",
+				ks.Ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewStatefulOrderRemovalEvent(
+						tc.order.OrderId,
+						tc.expectedIndexerReason,
+					),
+				),
+			).Once().Return()
+
+			err := ks.ClobKeeper.PersistOrderRemovalToState(
+				ks.Ctx,
+				types.OrderRemoval{
+					OrderId:       tc.order.OrderId,
+					RemovalReason: removalReason,
+				},
+			)
+			require.NoError(t, err)
+			mockIndexerEventManager.AssertExpectations(t)
+
+			_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, tc.order.OrderId)
+			require.False(t, found)
+		})
+	}
+}
+
 func setupNewMockEventManager(
 	t *testing.T,
 	ctx sdk.Context,
@@ -131,6 +131,29 @@ func (k Keeper) ProcessSingleMatch(
 		return false, takerUpdateResult, makerUpdateResult, err
 	}
 
+	// Fills that would increase the risk of either subaccount are paused while oracle prices or the
+	// oracle price of the ClobPair's market are stale. Liquidations are exempt since they decrease risk.
+	if !takerMatchableOrder.IsLiquidation() && k.isClobPairPausedByStalePrices(ctx, clobPairId) {
+		takerUpdateResult, makerUpdateResult, err = k.validateMatchNotPausedByStaleMarketPrice(
+			ctx,
+			matchWithOrders,
+			perpetualId,
+		)
+		if err != nil {
+			return false, takerUpdateResult, makerUpdateResult, err
+		}
+	}
+
+	// Fills that would open a new position for a subaccount below the minimum collateral are rejected.
+	takerUpdateResult, makerUpdateResult, err = k.validateMatchMinCollateralToOpenPosition(
+		ctx,
+		matchWithOrders,
+		perpetualId,
+	)
+	if err != nil {
+		return false, takerUpdateResult, makerUpdateResult, err
+	}
+
 	// Calculate taker and maker fee ppms.
 	takerFeePpm := k.feeTiersKeeper.GetPerpetualFeePpm(
 		ctx, matchWithOrders.TakerOrder.GetSubaccountId().Owner, true)
@@ -311,9 +334,19 @@ func (k Keeper) persistMatchedOrders(
 ) {
 	isTakerLiquidation := matchWithOrders.TakerOrder.IsLiquidation()
 
-	// Taker fees and maker fees/rebates are rounded towards positive infinity.
-	bigTakerFeeQuoteQuantums := lib.BigMulPpm(bigFillQuoteQuantums, lib.BigI(takerFeePpm), true)
-	bigMakerFeeQuoteQuantums := lib.BigMulPpm(bigFillQuoteQuantums, lib.BigI(makerFeePpm), true)
+	// Taker fees and maker fees/rebates are rounded according to the `FeeRoundingMode` of the ClobPair
+	// config, which rounds towards positive infinity by default.
+	feeRoundingMode := k.GetClobPairConfig(ctx).GetFeeLibRoundingMode()
+	bigTakerFeeQuoteQuantums := lib.BigMulPpmWithRoundingMode(
+		bigFillQuoteQuantums,
+		lib.BigI(takerFeePpm),
+		feeRoundingMode,
+	)
+	bigMakerFeeQuoteQuantums := lib.BigMulPpmWithRoundingMode(
+		bigFillQuoteQuantums,
+		lib.BigI(makerFeePpm),
+		feeRoundingMode,
+	)
 
 	matchWithOrders.MakerFee = bigMakerFeeQuoteQuantums.Int64()
 	// Liquidation orders pay the liquidation fee instead of the standard taker fee
@@ -472,7 +505,9 @@ func (k Keeper) persistMatchedOrders(
 		matchWithOrders.TakerOrder.GetSubaccountId().Owner,
 		matchWithOrders.MakerOrder.GetSubaccountId().Owner,
 		bigFillQuoteQuantums,
+		isTakerLiquidation,
 	)
+	k.statsKeeper.RecordInsuranceFundFlow(ctx, insuranceFundDelta)
 
 	// Emit an event indicating a match occurred.
 	ctx.EventManager().EmitEvent(
@@ -8,11 +8,15 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	dbm "github.com/cosmos/cosmos-db"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	cometbftlog "github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -69,6 +73,9 @@ func (k Keeper) SetLongTermOrderPlacement(
 			k.GetStatefulOrderCount(ctx, order.OrderId.SubaccountId)+1,
 		)
 
+		// Add the order to the stateful orders of its subaccount.
+		k.addStatefulOrderIdForSubaccount(ctx, order.OrderId)
+
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, metrics.StatefulOrder, metrics.Count},
 			1,
@@ -165,6 +172,9 @@ func (k Keeper) DeleteLongTermOrderPlacement(
 	// Set the count.
 	k.SetStatefulOrderCount(ctx, orderId.SubaccountId, count)
 
+	// Remove the order from the stateful orders of its subaccount.
+	k.GetStatefulOrderSubaccountIndexMemStore(ctx, orderId.SubaccountId).Delete(orderKey)
+
 	telemetry.IncrCounterWithLabels(
 		[]string{types.ModuleName, metrics.StatefulOrderRemoved, metrics.Count},
 		1,
@@ -334,6 +344,62 @@ func (k Keeper) MustRemoveStatefulOrder(
 	k.DeleteLongTermOrderPlacement(ctx, orderId)
 }
 
+// ForceCancelStatefulOrder forcefully cancels the placed stateful order with the provided `OrderId`,
+// bypassing order cancellation validation. This is intended for governance or admin flows that need to
+// clear stuck orders, e.g. after a market is paused. The order is removed from state, and is appended to
+// `ProcessProposerMatchesEvents.RemovedStatefulOrderIds` so that it is removed from the memclob in
+// PrepareCheckState. Untriggered conditional orders are additionally recorded as delivered cancellations
+// so that they are pruned from the in-memory `UntriggeredConditionalOrders` in EndBlocker.
+//
+// An error is returned if no placed stateful order exists in state with `orderId`.
+func (k Keeper) ForceCancelStatefulOrder(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) error {
+	if !orderId.IsStatefulOrder() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidOrderFlag,
+			"ForceCancelStatefulOrder: order %+v is not a stateful order",
+			orderId,
+		)
+	}
+
+	if _, exists := k.GetLongTermOrderPlacement(ctx, orderId); !exists {
+		return errorsmod.Wrapf(
+			types.ErrStatefulOrderDoesNotExist,
+			"ForceCancelStatefulOrder: order %+v does not exist",
+			orderId,
+		)
+	}
+
+	if orderId.IsConditionalOrder() && !k.IsConditionalOrderTriggered(ctx, orderId) {
+		k.AddDeliveredCancelledOrderId(ctx, orderId)
+	}
+
+	k.MustRemoveStatefulOrder(ctx, orderId)
+
+	processProposerMatchesEvents := k.GetProcessProposerMatchesEvents(ctx)
+	processProposerMatchesEvents.RemovedStatefulOrderIds = append(
+		processProposerMatchesEvents.RemovedStatefulOrderIds,
+		orderId,
+	)
+	k.MustSetProcessProposerMatchesEvents(ctx, processProposerMatchesEvents)
+
+	k.GetIndexerEventManager().AddTxnEvent(
+		ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				orderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_FORCE_CANCELED,
+			),
+		),
+	)
+
+	return nil
+}
+
 // IsConditionalOrderTriggered checks if a given order ID is triggered or untriggered in state.
 // Note: If the given order ID is neither in triggered or untriggered state, function will return false.
 func (k Keeper) IsConditionalOrderTriggered(
@@ -422,6 +488,51 @@ func (k Keeper) getUntriggeredConditionalOrdersIterator(ctx sdk.Context) storety
 	return storetypes.KVStorePrefixIterator(store, []byte{})
 }
 
+// GetStatefulOrdersForSubaccount returns all stateful orders of a subaccount that are stored in state,
+// ordered by ascending time priority. This includes Long-Term orders and conditional orders, both
+// triggered and untriggered.
+func (k Keeper) GetStatefulOrdersForSubaccount(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) []types.Order {
+	store := k.GetStatefulOrderSubaccountIndexMemStore(ctx, subaccountId)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	statefulOrderPlacements := make([]types.LongTermOrderPlacement, 0)
+	for ; it.Valid(); it.Next() {
+		var orderId types.OrderId
+		k.cdc.MustUnmarshal(it.Value(), &orderId)
+
+		statefulOrderPlacement, found := k.GetLongTermOrderPlacement(ctx, orderId)
+		if !found {
+			log.ErrorLog(ctx, "Stateful order is indexed for subaccount but does not exist in state",
+				"orderId", cometbftlog.NewLazySprintf("%+v", orderId),
+			)
+			continue
+		}
+		statefulOrderPlacements = append(statefulOrderPlacements, statefulOrderPlacement)
+	}
+
+	// Sort all stateful order placements in ascending time priority and return the orders.
+	sort.Sort(types.SortedLongTermOrderPlacements(statefulOrderPlacements))
+	sortedOrders := make([]types.Order, 0, len(statefulOrderPlacements))
+	for _, orderPlacement := range statefulOrderPlacements {
+		sortedOrders = append(sortedOrders, orderPlacement.Order)
+	}
+
+	return sortedOrders
+}
+
+// addStatefulOrderIdForSubaccount adds the `orderId` to the stateful orders of its subaccount.
+func (k Keeper) addStatefulOrderIdForSubaccount(
+	ctx sdk.Context,
+	orderId types.OrderId,
+) {
+	store := k.GetStatefulOrderSubaccountIndexMemStore(ctx, orderId.SubaccountId)
+	store.Set(orderId.ToStateKey(), k.cdc.MustMarshal(&orderId))
+}
+
 // GetStatefulOrderCount gets a count of how many stateful orders are written to state for a subaccount.
 func (k Keeper) GetStatefulOrderCount(
 	ctx sdk.Context,
@@ -7,6 +7,9 @@ import (
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
@@ -31,6 +34,12 @@ func orderToStringSubaccountId(
 	return string(o.OrderId.SubaccountId.ToStateKey())
 }
 
+func orderToStringSubaccountIndexKey(
+	o types.Order,
+) string {
+	return string(o.OrderId.SubaccountId.ToStateKey()) + ":" + string(o.OrderId.ToStateKey())
+}
+
 // TODO(jonfung) make ticket and remove all conditional orderes
 func createPartiallyFilledStatefulOrderInState(
 	ctx sdk.Context,
@@ -126,6 +135,84 @@ func TestLongTermOrderInitMemStore_Success(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestGetStatefulOrdersForSubaccount(t *testing.T) {
+	memClob := &mocks.MemClob{}
+	memClob.On("SetClobKeeper", mock.Anything).Return()
+	ks := keepertest.NewClobKeepersTestContextWithUninitializedMemStore(
+		t,
+		memClob,
+		&mocks.BankKeeper{},
+		&mocks.IndexerEventManager{},
+	)
+
+	aliceLongTermOrder := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20
+	aliceConditionalOrder := constants.ConditionalOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15_StopLoss20
+	aliceTriggeredConditionalOrder := constants.ConditionalOrder_Alice_Num0_Id1_Clob0_Buy15_Price10_GTBT15_StopLoss20
+	bobLongTermOrder := constants.LongTermOrder_Bob_Num0_Id0_Clob0_Sell5_Price5_GTBT10
+	bobLongTermOrder2 := constants.LongTermOrder_Bob_Num0_Id1_Clob0_Sell50_Price10_GTBT15
+
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, aliceLongTermOrder, 1)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, bobLongTermOrder, 1)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, aliceConditionalOrder, 2)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, aliceTriggeredConditionalOrder, 2)
+	ks.ClobKeeper.MustTriggerConditionalOrder(ks.Ctx.WithBlockHeight(3), aliceTriggeredConditionalOrder.OrderId)
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, bobLongTermOrder2, 3)
+
+	// Each subaccount only returns its own orders, ordered by ascending time priority.
+	require.Equal(
+		t,
+		[]types.Order{aliceLongTermOrder, aliceConditionalOrder, aliceTriggeredConditionalOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.Order{bobLongTermOrder, bobLongTermOrder2},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Bob_Num0),
+	)
+	require.Empty(t, ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num1))
+
+	// Replacing an order does not duplicate it.
+	ks.ClobKeeper.SetLongTermOrderPlacement(ks.Ctx, bobLongTermOrder, 4)
+	require.Equal(
+		t,
+		[]types.Order{bobLongTermOrder2, bobLongTermOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Bob_Num0),
+	)
+
+	// Removed orders are no longer returned.
+	ks.ClobKeeper.DeleteLongTermOrderPlacement(ks.Ctx, aliceConditionalOrder.OrderId)
+	require.Equal(
+		t,
+		[]types.Order{aliceLongTermOrder, aliceTriggeredConditionalOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.Order{bobLongTermOrder2, bobLongTermOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Bob_Num0),
+	)
+
+	// The stateful orders of each subaccount are rebuilt from state when the memstore is initialized.
+	for _, subaccountId := range []satypes.SubaccountId{constants.Alice_Num0, constants.Bob_Num0} {
+		store := ks.ClobKeeper.GetStatefulOrderSubaccountIndexMemStore(ks.Ctx, subaccountId)
+		for _, order := range ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, subaccountId) {
+			store.Delete(order.OrderId.ToStateKey())
+		}
+		require.Empty(t, ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, subaccountId))
+	}
+	ks.ClobKeeper.InitMemStore(ks.Ctx)
+	require.Equal(
+		t,
+		[]types.Order{aliceLongTermOrder, aliceTriggeredConditionalOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Alice_Num0),
+	)
+	require.Equal(
+		t,
+		[]types.Order{bobLongTermOrder2, bobLongTermOrder},
+		ks.ClobKeeper.GetStatefulOrdersForSubaccount(ks.Ctx, constants.Bob_Num0),
+	)
+}
+
 func TestMustTriggerConditionalOrder(t *testing.T) {
 	// Setup keeper state and test parameters.
 	memClob := memclob.NewMemClobPriceTimePriority(false)
@@ -211,6 +298,8 @@ func TestMustTriggerConditionalOrder(t *testing.T) {
 				orderToStringId(conditionalOrder),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(conditionalOrder),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(conditionalOrder),
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			// Write to triggered state
 			types.TriggeredConditionalOrderKeyPrefix +
@@ -330,67 +419,91 @@ func TestGetSetDeleteLongTermOrderState(t *testing.T) {
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			// Delete the order from state and decrement the stateful order count.
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			// Delete the order from state and decrement the stateful order count.
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			// Delete the order from state and decrement the stateful order count.
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			// Delete the order from state and decrement the stateful order count.
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			// Delete the order from state and decrement the stateful order count.
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT20),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id1_Clob1_Sell65_Price15_GTBT25),
 			// Write the order to state and increment the stateful order count.
 			types.NextStatefulOrderBlockTransactionIndexKey,
 			types.LongTermOrderPlacementKeyPrefix +
 				orderToStringId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num1_Id0_Clob0_Sell15_Price5_GTBT10),
 		},
 	)
 }
@@ -459,6 +572,8 @@ func TestGetSetDeleteLongTermOrderState_Replacements(t *testing.T) {
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
 			// Write the order to state. We should not expect the stateful order
 			// count to change since this is a replacement.
 			types.NextStatefulOrderBlockTransactionIndexKey,
@@ -469,6 +584,8 @@ func TestGetSetDeleteLongTermOrderState_Replacements(t *testing.T) {
 				orderToStringId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
 			types.StatefulOrderCountPrefix +
 				orderToStringSubaccountId(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
+			types.StatefulOrderSubaccountIndexKeyPrefix +
+				orderToStringSubaccountIndexKey(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15),
 		},
 	)
 }
@@ -891,6 +1008,68 @@ func TestRemoveLongTermOrder_PanicsIfNotFound(t *testing.T) {
 	)
 }
 
+func TestForceCancelStatefulOrder(t *testing.T) {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	order := constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT15
+	otherOrder := constants.LongTermOrder_Alice_Num0_Id1_Clob0_Sell20_Price10_GTBT10
+	for _, o := range []types.Order{order, otherOrder} {
+		createPartiallyFilledStatefulOrderInState(ks.Ctx, *ks.ClobKeeper, o, o.MustGetUnixGoodTilBlockTime())
+	}
+
+	indexerEventManager.On("AddTxnEvent",
+		ks.Ctx,
+		indexerevents.SubtypeStatefulOrder,
+		indexerevents.StatefulOrderEventVersion,
+		indexer_manager.GetBytes(
+			indexerevents.NewStatefulOrderRemovalEvent(
+				order.OrderId,
+				indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_FORCE_CANCELED,
+			),
+		),
+	).Once().Return()
+
+	require.NoError(t, ks.ClobKeeper.ForceCancelStatefulOrder(ks.Ctx, order.OrderId))
+	indexerEventManager.AssertExpectations(t)
+
+	// The order placement, fill amount and expiration are removed from state.
+	_, found := ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, order.OrderId)
+	require.False(t, found)
+	found, _, _ = ks.ClobKeeper.GetOrderFillAmount(ks.Ctx, order.OrderId)
+	require.False(t, found)
+	require.Empty(
+		t,
+		ks.ClobKeeper.GetStatefulOrderIdExpirations(ks.Ctx, order.MustGetUnixGoodTilBlockTime()),
+	)
+	require.Equal(
+		t,
+		[]types.OrderId{order.OrderId},
+		ks.ClobKeeper.GetProcessProposerMatchesEvents(ks.Ctx).RemovedStatefulOrderIds,
+	)
+
+	// Other stateful orders are unaffected.
+	_, found = ks.ClobKeeper.GetLongTermOrderPlacement(ks.Ctx, otherOrder.OrderId)
+	require.True(t, found)
+
+	// Cancelling the same order again fails.
+	err := ks.ClobKeeper.ForceCancelStatefulOrder(ks.Ctx, order.OrderId)
+	require.ErrorIs(t, err, types.ErrStatefulOrderDoesNotExist)
+
+	// Cancelling a Short-Term order fails.
+	err = ks.ClobKeeper.ForceCancelStatefulOrder(
+		ks.Ctx,
+		constants.Order_Alice_Num0_Id0_Clob0_Buy5_Price10_GTB15.OrderId,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidOrderFlag)
+	require.Equal(
+		t,
+		[]types.OrderId{order.OrderId},
+		ks.ClobKeeper.GetProcessProposerMatchesEvents(ks.Ctx).RemovedStatefulOrderIds,
+	)
+}
+
 // TODO(CLOB-786): Fix this test to verify sorting by transaction index works.
 func TestGetAllStatefulOrders(t *testing.T) {
 	tests := map[string]struct {
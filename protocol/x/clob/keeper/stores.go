@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	storetypes "cosmossdk.io/store/types"
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
 // GetLongTermOrderPlacementStore fetches a state store used for creating,
@@ -69,6 +71,20 @@ func (k Keeper) GetStatefulOrderCountMemStore(ctx sdk.Context) prefix.Store {
 	)
 }
 
+// GetStatefulOrderSubaccountIndexMemStore fetches a memstore used for creating, reading, and deleting
+// the IDs of the stateful orders of a subaccount. This represents all long term orders and
+// conditional orders, both triggered and untriggered, of the subaccount.
+func (k Keeper) GetStatefulOrderSubaccountIndexMemStore(
+	ctx sdk.Context,
+	subaccountId satypes.SubaccountId,
+) prefix.Store {
+	var buf bytes.Buffer
+	buf.Write([]byte(types.StatefulOrderSubaccountIndexKeyPrefix))
+	buf.Write(subaccountId.ToStateKey())
+	buf.Write([]byte(":"))
+	return prefix.NewStore(ctx.KVStore(k.memKey), buf.Bytes())
+}
+
 // GetTriggeredConditionalOrderPlacementStore fetches a state store used for creating,
 // reading, updating, and deleting a stateful order placement from state.
 func (k Keeper) GetTriggeredConditionalOrderPlacementStore(ctx sdk.Context) prefix.Store {
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+)
+
+// ValidateSubaccountNotionalLimitForOrder returns an error if fully filling the order would cause the total
+// notional exposure of the subaccount to exceed the `MaxSubaccountNotional` of the `ClobPairConfig`.
+//
+// The total notional exposure of a subaccount is the sum of the absolute net notional of each of its perpetual
+// positions, where the position in the perpetual of the order includes the full size of the order.
+// Reduce-only orders are never rejected since they can only decrease exposure.
+//
+// Note that this limit is only enforced when orders are placed and is not re-validated when orders are
+// matched. A subaccount may therefore exceed the limit through fills of multiple resting orders that were
+// each accepted individually, or through changes in oracle prices.
+func (k Keeper) ValidateSubaccountNotionalLimitForOrder(ctx sdk.Context, order types.Order) error {
+	maxSubaccountNotional := k.GetClobPairConfig(ctx).MaxSubaccountNotional
+	if maxSubaccountNotional == 0 || order.IsReduceOnly() {
+		return nil
+	}
+
+	clobPair, found := k.GetClobPair(ctx, order.GetClobPairId())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClob,
+			"Clob %v is not a valid clob",
+			order.GetClobPairId(),
+		)
+	}
+	orderPerpetualId := clobPair.MustGetPerpetualId()
+
+	// Compute the position sizes of the subaccount after the order is fully filled.
+	positionSizes := make(map[uint32]*big.Int)
+	subaccount := k.subaccountsKeeper.GetSubaccount(ctx, order.GetSubaccountId())
+	for _, position := range subaccount.PerpetualPositions {
+		positionSizes[position.PerpetualId] = position.GetBigQuantums()
+	}
+	if size, exists := positionSizes[orderPerpetualId]; exists {
+		size.Add(size, order.GetBigQuantums())
+	} else {
+		positionSizes[orderPerpetualId] = order.GetBigQuantums()
+	}
+
+	totalNotional := big.NewInt(0)
+	for _, perpetualId := range lib.GetSortedKeys[lib.Sortable[uint32]](positionSizes) {
+		netNotional, err := k.perpetualsKeeper.GetNetNotional(ctx, perpetualId, positionSizes[perpetualId])
+		if err != nil {
+			return err
+		}
+		totalNotional.Add(totalNotional, netNotional.Abs(netNotional))
+	}
+
+	if totalNotional.Cmp(new(big.Int).SetUint64(maxSubaccountNotional)) > 0 {
+		return errorsmod.Wrapf(
+			types.ErrOrderWouldExceedMaxSubaccountNotional,
+			"Filling order would result in a total notional of %v, max subaccount notional: %d, order id: %+v",
+			totalNotional,
+			maxSubaccountNotional,
+			order.GetOrderId(),
+		)
+	}
+	return nil
+}
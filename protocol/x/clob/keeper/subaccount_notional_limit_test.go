@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	blocktimetypes "github.com/dydxprotocol/v4-chain/protocol/x/blocktime/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/memclob"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSubaccountNotionalLimitForOrder(t *testing.T) {
+	// 1 BTC, $50,000 notional.
+	buyOneBtc := types.Order{
+		OrderId:      types.OrderId{SubaccountId: constants.Alice_Num0, ClientId: 0, ClobPairId: 0},
+		Side:         types.Order_SIDE_BUY,
+		Quantums:     100_000_000,
+		Subticks:     50_000_000,
+		GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+	}
+	sellOneBtc := buyOneBtc
+	sellOneBtc.Side = types.Order_SIDE_SELL
+	reduceOnlyBuyOneBtc := buyOneBtc
+	reduceOnlyBuyOneBtc.ReduceOnly = true
+	reduceOnlyBuyOneBtc.TimeInForce = types.Order_TIME_IN_FORCE_IOC
+
+	// Alice's positions have a total notional of $50,300.
+	aliceSubaccount := satypes.Subaccount{
+		Id: &constants.Alice_Num0,
+		AssetPositions: []*satypes.AssetPosition{
+			&constants.Usdc_Asset_100_000,
+		},
+		PerpetualPositions: []*satypes.PerpetualPosition{
+			&constants.PerpetualPosition_OneBTCLong,
+			&constants.PerpetualPosition_OneTenthEthShort,
+		},
+	}
+
+	tests := map[string]struct {
+		// Setup.
+		maxSubaccountNotional uint64
+		order                 types.Order
+
+		// Expectations.
+		expectedErr error
+	}{
+		"Succeeds when the limit is disabled": {
+			maxSubaccountNotional: 0,
+			order:                 buyOneBtc,
+		},
+		"Succeeds when the total notional after the order is below the limit": {
+			maxSubaccountNotional: 200_000_000_000,
+			order:                 buyOneBtc,
+		},
+		"Succeeds when the total notional after the order is at the limit": {
+			maxSubaccountNotional: 100_300_000_000,
+			order:                 buyOneBtc,
+		},
+		"Fails when the total notional after the order is beyond the limit": {
+			maxSubaccountNotional: 100_299_999_999,
+			order:                 buyOneBtc,
+			expectedErr:           types.ErrOrderWouldExceedMaxSubaccountNotional,
+		},
+		"Succeeds when the order decreases the total notional": {
+			maxSubaccountNotional: 300_000_000,
+			order:                 sellOneBtc,
+		},
+		"Succeeds for a reduce-only order beyond the limit": {
+			maxSubaccountNotional: 50_000_000_000,
+			order:                 reduceOnlyBuyOneBtc,
+		},
+		"Fails for a subaccount without positions when the order is beyond the limit": {
+			maxSubaccountNotional: 49_999_999_999,
+			order: types.Order{
+				OrderId:      types.OrderId{SubaccountId: constants.Bob_Num0, ClientId: 0, ClobPairId: 0},
+				Side:         types.Order_SIDE_SELL,
+				Quantums:     100_000_000,
+				Subticks:     50_000_000,
+				GoodTilOneof: &types.Order_GoodTilBlock{GoodTilBlock: 20},
+			},
+			expectedErr: types.ErrOrderWouldExceedMaxSubaccountNotional,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ks := setUpSubaccountNotionalLimitTest(t, tc.maxSubaccountNotional, aliceSubaccount)
+
+			err := ks.ClobKeeper.ValidateSubaccountNotionalLimitForOrder(ks.Ctx, tc.order)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceStatefulOrder_FailsBeyondMaxSubaccountNotional(t *testing.T) {
+	ks := setUpSubaccountNotionalLimitTest(
+		t,
+		50_000_000_000,
+		satypes.Subaccount{
+			Id: &constants.Alice_Num0,
+			AssetPositions: []*satypes.AssetPosition{
+				&constants.Usdc_Asset_100_000,
+			},
+			PerpetualPositions: []*satypes.PerpetualPosition{
+				&constants.PerpetualPosition_OneBTCLong,
+			},
+		},
+	)
+	ks.BlockTimeKeeper.SetPreviousBlockInfo(ks.Ctx, &blocktimetypes.BlockInfo{
+		Timestamp: time.Unix(1, 0),
+	})
+
+	err := ks.ClobKeeper.PlaceStatefulOrder(
+		ks.Ctx,
+		types.NewMsgPlaceOrder(constants.LongTermOrder_Alice_Num0_Id0_Clob0_Buy5_Price10_GTBT5),
+		false,
+	)
+	require.ErrorIs(t, err, types.ErrOrderWouldExceedMaxSubaccountNotional)
+	require.Empty(t, ks.ClobKeeper.GetAllStatefulOrders(ks.Ctx))
+}
+
+// setUpSubaccountNotionalLimitTest creates the BTC and ETH markets and the BTC ClobPair, sets the provided
+// subaccount in state, and sets the provided `MaxSubaccountNotional`.
+func setUpSubaccountNotionalLimitTest(
+	t *testing.T,
+	maxSubaccountNotional uint64,
+	subaccount satypes.Subaccount,
+) keepertest.ClobKeepersTestContext {
+	memClob := memclob.NewMemClobPriceTimePriority(false)
+	indexerEventManager := &mocks.IndexerEventManager{}
+	indexerEventManager.On("AddTxnEvent",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return()
+	ks := keepertest.NewClobKeepersTestContext(t, memClob, &mocks.BankKeeper{}, indexerEventManager)
+
+	keepertest.CreateTestMarkets(t, ks.Ctx, ks.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ks.Ctx, ks.PerpetualsKeeper)
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_SmallMarginRequirement,
+		constants.EthUsd_NoMarginRequirement,
+	} {
+		_, err := ks.PerpetualsKeeper.CreatePerpetual(
+			ks.Ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+	keepertest.CreateTestClobPairs(t, ks.Ctx, ks.ClobKeeper, []types.ClobPair{constants.ClobPair_Btc})
+
+	ks.SubaccountsKeeper.SetSubaccount(ks.Ctx, subaccount)
+	ks.ClobKeeper.InitializeClobPairConfig(
+		ks.Ctx,
+		types.ClobPairConfig{MaxSubaccountNotional: maxSubaccountNotional},
+	)
+
+	return ks
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
+	indexershared "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -264,6 +265,75 @@ func (untriggeredOrders *UntriggeredConditionalOrders) PollTriggeredConditionalO
 	return triggeredOrderIds
 }
 
+// RemoveUntriggeredConditionalOrdersForDelistedMarkets removes all conditional orders in
+// `UntriggeredConditionalOrders` whose trigger references a market that has been delisted or
+// no longer exists, since their trigger can never be evaluated. Each order is removed from state
+// and from the in-memory struct, and a stateful order removal event is emitted for it.
+// Function returns a list of removed conditional order ids, intended to be appended to
+// `ProcessProposerMatchesEvents.RemovedStatefulOrderIds`.
+// This function is called in EndBlocker.
+func (k Keeper) RemoveUntriggeredConditionalOrdersForDelistedMarkets(
+	ctx sdk.Context,
+) (removedOrderIds []types.OrderId) {
+	// Sort the keys for the untriggered conditional orders struct to have deterministic state writes.
+	sortedKeys := lib.GetSortedKeys[types.SortedClobPairId](k.UntriggeredConditionalOrders)
+
+	removedOrderIds = make([]types.OrderId, 0)
+	for _, clobPairId := range sortedKeys {
+		if !k.isTriggerMarketDelisted(ctx, clobPairId) {
+			continue
+		}
+
+		untriggered := k.UntriggeredConditionalOrders[clobPairId]
+		orders := append(
+			append([]types.Order{}, untriggered.OrdersToTriggerWhenOraclePriceLTETriggerPrice...),
+			untriggered.OrdersToTriggerWhenOraclePriceGTETriggerPrice...,
+		)
+		for _, order := range orders {
+			k.removeStatefulOrderWithoutPanicing(ctx, order.OrderId)
+			removedOrderIds = append(removedOrderIds, order.OrderId)
+
+			k.GetIndexerEventManager().AddBlockEvent(
+				ctx,
+				indexerevents.SubtypeStatefulOrder,
+				indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+				indexerevents.StatefulOrderEventVersion,
+				indexer_manager.GetBytes(
+					indexerevents.NewStatefulOrderRemovalEvent(
+						order.OrderId,
+						indexershared.OrderRemovalReason_ORDER_REMOVAL_REASON_MARKET_DELISTED,
+					),
+				),
+			)
+		}
+
+		delete(k.UntriggeredConditionalOrders, clobPairId)
+	}
+
+	return removedOrderIds
+}
+
+// isTriggerMarketDelisted returns true if the market referenced by the triggers of conditional
+// orders on the provided ClobPair has been delisted, or if the ClobPair, its perpetual or its
+// market no longer exists.
+func (k Keeper) isTriggerMarketDelisted(ctx sdk.Context, clobPairId types.ClobPairId) bool {
+	clobPair, found := k.GetClobPair(ctx, clobPairId)
+	if !found {
+		return true
+	}
+
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, clobPair.MustGetPerpetualId())
+	if err != nil {
+		return true
+	}
+	if perpetual.IsMarketDelisted() {
+		return true
+	}
+
+	_, exists := k.pricesKeeper.GetMarketParam(ctx, perpetual.Params.MarketId)
+	return !exists
+}
+
 // MaybeTriggerConditionalOrders queries the prices module for price updates and triggers
 // any conditional orders in `UntriggeredConditionalOrders` that can be triggered. For each triggered
 // order, it takes the stateful order placement stored in Untriggered state and moves it to Triggered state.
@@ -579,11 +579,13 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 		}
 		// If stateful taker order fails collateralization checks while matching, add Order Removal
 		// to operations queue to forcefully remove the order from state.
-		if takerOrderStatus.OrderStatus == types.Undercollateralized && order.IsStatefulOrder() {
+		if (takerOrderStatus.OrderStatus == types.Undercollateralized ||
+			takerOrderStatus.OrderStatus == types.ViolatesMinCollateralToOpenPosition) &&
+			order.IsStatefulOrder() {
 			if !m.operationsToPropose.IsOrderRemovalInOperationsQueue(order.OrderId) {
 				m.operationsToPropose.MustAddOrderRemovalToOperationsQueue(
 					order.OrderId,
-					types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED,
+					orderStatusToMakerRemovalReason(takerOrderStatus.OrderStatus),
 				)
 			}
 		}
@@ -636,6 +638,30 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 		return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, nil
 	}
 
+	// If the orderbook holds the maximum number of resting orders, cancel the remaining size of this
+	// Short-Term order unless the depth cap policy allows it to bump a resting order.
+	if order.IsShortTermOrder() {
+		canRestOnOrderbook, depthCapOffchainUpdates := m.maybeBumpOrderForOrderbookDepthCap(ctx, order)
+		offchainUpdates.Append(depthCapOffchainUpdates)
+		if !canRestOnOrderbook {
+			orderStatus := types.OrderbookDepthCapReached
+			if m.generateOffchainUpdates {
+				// Send an off-chain update message indicating the order should be removed from the orderbook
+				// on the Indexer.
+				if message, success := off_chain_updates.CreateOrderRemoveMessage(
+					ctx,
+					order.OrderId,
+					orderStatus,
+					nil,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				); success {
+					offchainUpdates.AddRemoveMessage(order.OrderId, message)
+				}
+			}
+			return orderSizeOptimisticallyFilledFromMatchingQuantums, orderStatus, offchainUpdates, nil
+		}
+	}
+
 	// If this is a Short-Term order and it's not in the operations queue, add the TX bytes to the
 	// operations to propose.
 	if order.IsShortTermOrder() &&
@@ -671,6 +697,56 @@ func (m *MemClobPriceTimePriority) PlaceOrder(
 	return orderSizeOptimisticallyFilledFromMatchingQuantums, types.Success, offchainUpdates, nil
 }
 
+// maybeBumpOrderForOrderbookDepthCap returns true if the Short-Term order can rest on its orderbook without
+// exceeding the maximum number of resting orders of its ClobPair in the `ClobPairConfig`. If the orderbook has
+// reached the limit and the `DepthCapPolicy` is to bump the lowest-priority order, the lowest-priority resting
+// Short-Term order on the same side of the orderbook with a strictly worse price than the order is removed to
+// make room for it.
+// Stateful orders are never bumped since removing them requires an order removal operation.
+func (m *MemClobPriceTimePriority) maybeBumpOrderForOrderbookDepthCap(
+	ctx sdk.Context,
+	order types.Order,
+) (
+	canRestOnOrderbook bool,
+	offchainUpdates *types.OffchainUpdates,
+) {
+	offchainUpdates = types.NewOffchainUpdates()
+	orderbook := m.mustGetOrderbook(order.GetClobPairId())
+
+	clobPairConfig := m.clobKeeper.GetClobPairConfig(ctx)
+	maxRestingOrders := clobPairConfig.GetMaxRestingOrders(order.GetClobPairId())
+	if maxRestingOrders == 0 || orderbook.TotalOpenOrders < uint(maxRestingOrders) {
+		return true, offchainUpdates
+	}
+
+	if !clobPairConfig.ShouldBumpLowestPriorityOrderAtDepthCap() {
+		return false, offchainUpdates
+	}
+
+	levelOrderToBump, found := orderbook.getLowestPriorityShortTermOrderWorseThan(
+		order.IsBuy(),
+		order.GetOrderSubticks(),
+	)
+	if !found {
+		return false, offchainUpdates
+	}
+
+	orderIdToBump := levelOrderToBump.Value.Order.OrderId
+	if m.generateOffchainUpdates {
+		if message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+			ctx,
+			orderIdToBump,
+			indexersharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP,
+			ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+		); success {
+			offchainUpdates.AddRemoveMessage(orderIdToBump, message)
+		}
+	}
+	m.mustRemoveOrder(ctx, orderIdToBump)
+
+	return orderbook.TotalOpenOrders < uint(maxRestingOrders), offchainUpdates
+}
+
 // PlacePerpetualLiquidation matches an IOC liquidation order against the orderbook. Specifically,
 // it will perform the following operations:
 //   - If the liquidation order overlaps the orderbook, it will match orders within that orderbook
@@ -1727,7 +1803,16 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 		// - If the taker order is a liquidation order or passed collateralization checks, then we
 		//   need to continue matching by attempting to find a new overlapping maker order.
 		if !success {
-			makerCollatOkay := updateResultToOrderStatus(makerUpdateResult).IsSuccess()
+			// If the maker order's fill was paused because the oracle price of the market is stale, leave the
+			// maker order on the book since the pause is only temporary. Stop matching since the taker order
+			// would otherwise rest on the book crossing the maker order.
+			if updateResultToOrderStatus(makerUpdateResult) == types.ViolatesStaleMarketPriceConstraints {
+				takerOrderStatus.OrderStatus = types.ViolatesStaleMarketPriceConstraints
+				break
+			}
+
+			makerOrderStatus := updateResultToOrderStatus(makerUpdateResult)
+			makerCollatOkay := makerOrderStatus.IsSuccess()
 			takerCollatOkay := takerIsLiquidation ||
 				updateResultToOrderStatus(takerUpdateResult).IsSuccess()
 
@@ -1738,7 +1823,7 @@ func (m *MemClobPriceTimePriority) mustPerformTakerOrderMatching(
 					makerOrdersToRemove,
 					OrderWithRemovalReason{
 						Order:         makerOrder.Order,
-						RemovalReason: types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED,
+						RemovalReason: orderStatusToMakerRemovalReason(makerOrderStatus),
 					},
 				)
 			}
@@ -1976,11 +2061,24 @@ func updateResultToOrderStatus(updateResult satypes.UpdateResult) types.OrderSta
 		return types.InternalError
 	case satypes.ViolatesIsolatedSubaccountConstraints:
 		return types.ViolatesIsolatedSubaccountConstraints
+	case satypes.ViolatesStaleMarketPriceConstraints:
+		return types.ViolatesStaleMarketPriceConstraints
+	case satypes.ViolatesMinCollateralToOpenPosition:
+		return types.ViolatesMinCollateralToOpenPosition
 	default:
 		return types.Undercollateralized
 	}
 }
 
+// orderStatusToMakerRemovalReason returns the reason for removing an order from the orderbook after it
+// failed the collateralization checks of a match with the provided status.
+func orderStatusToMakerRemovalReason(orderStatus types.OrderStatus) types.OrderRemoval_RemovalReason {
+	if orderStatus == types.ViolatesMinCollateralToOpenPosition {
+		return types.OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION
+	}
+	return types.OrderRemoval_REMOVAL_REASON_UNDERCOLLATERALIZED
+}
+
 // GetOrderRemainingAmount returns the remaining amount of an order (its size minus its filled amount).
 // It also returns a boolean indicating whether the remaining amount is positive (true) or not (false).
 func (m *MemClobPriceTimePriority) GetOrderRemainingAmount(
@@ -0,0 +1,437 @@
+package memclob
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates"
+	ocutypes "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	sharedtypes "github.com/dydxprotocol/v4-chain/protocol/indexer/shared/types"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	sdktest "github.com/dydxprotocol/v4-chain/protocol/testutil/sdk"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceOrder_OrderbookDepthCap(t *testing.T) {
+	ctx, _, _ := sdktest.NewSdkContextWithMultistore()
+	ctx = ctx.WithIsCheckTx(true)
+	tests := map[string]struct {
+		// State.
+		placedMatchableOrders []types.MatchableOrder
+		clobPairConfig        types.ClobPairConfig
+
+		// Parameters.
+		order types.Order
+
+		// Expectations.
+		expectedFilledSize    satypes.BaseQuantums
+		expectedOrderStatus   types.OrderStatus
+		expectedCollatCheck   []expectedMatch
+		expectedBumpedOrderId *types.OrderId
+		expectedRemainingBids []OrderWithRemainingSize
+		expectedRemainingAsks []OrderWithRemainingSize
+	}{
+		"Order rests on the orderbook when the orderbook is below the depth cap": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 3,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order rests on the orderbook when the depth cap is disabled": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order rests on the orderbook when the ClobPair's override is above the default depth cap": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				MaxRestingOrdersOverrides: []types.ClobPairMaxRestingOrders{
+					{ClobPairId: 1, MaxRestingOrders: 3},
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.Success,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order is rejected when the orderbook is at the depth cap of the ClobPair's override": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 3,
+				MaxRestingOrdersOverrides: []types.ClobPairMaxRestingOrders{
+					{ClobPairId: 0, MaxRestingOrders: 3},
+					{ClobPairId: 1, MaxRestingOrders: 2},
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order is rejected when the orderbook is at the depth cap with an unspecified policy": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order is rejected when the orderbook is at the depth cap with the reject policy": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_REJECT,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Remaining size of a partially matched order is rejected when the orderbook is at the depth cap": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+				&constants.Order_Bob_Num0_Id11_Clob1_Sell5_Price15_GTB20,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_REJECT,
+			},
+
+			order: constants.Order_Alice_Num0_Id9_Clob1_Buy15_Price45_GTB19,
+
+			expectedFilledSize:  5,
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Bob_Num0_Id11_Clob1_Sell5_Price15_GTB20,
+					takerOrder:      &constants.Order_Alice_Num0_Id9_Clob1_Buy15_Price45_GTB19,
+					matchedQuantums: 5,
+				},
+			},
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+					RemainingSize: 10,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order bumps the lowest-priced resting order when the orderbook is at the depth cap": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus:   types.Success,
+			expectedBumpedOrderId: &constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20.OrderId,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order bumps the most recent resting order at the lowest price level": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+				&constants.Order_Bob_Num0_Id3_Clob1_Buy10_Price10_GTB20,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus:   types.Success,
+			expectedBumpedOrderId: &constants.Order_Bob_Num0_Id3_Clob1_Buy10_Price10_GTB20.OrderId,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+					RemainingSize: 10,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Sell order bumps the highest-priced resting order when the orderbook is at the depth cap": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Bob_Num0_Id1_Clob1_Sell11_Price16_GTB20,
+				&constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Alice_Num1_Id5_Clob1_Sell50_Price40_GTB20,
+
+			expectedOrderStatus:   types.Success,
+			expectedBumpedOrderId: &constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10.OrderId,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Bob_Num0_Id1_Clob1_Sell11_Price16_GTB20,
+					RemainingSize: 11,
+				},
+				{
+					Order:         constants.Order_Alice_Num1_Id5_Clob1_Sell50_Price40_GTB20,
+					RemainingSize: 50,
+				},
+			},
+		},
+		"Order does not bump resting orders on the other side of the orderbook": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Bob_Num1_Id1_Clob1_Sell25_Price85_GTB10,
+
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id4_Clob1_Buy25_Price5_GTB20,
+					RemainingSize: 25,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order is rejected if it does not have a strictly better price than the lowest-priority resting order": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Bob_Num0_Id3_Clob1_Buy10_Price10_GTB20,
+
+			expectedOrderStatus: types.OrderbookDepthCapReached,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num1_Id2_Clob1_Buy10_Price10_GTB20,
+					RemainingSize: 10,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					RemainingSize: 20,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+		"Order does not bump stateful orders": {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.LongTermOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTBT5,
+				&constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+			},
+			clobPairConfig: types.ClobPairConfig{
+				MaxRestingOrdersPerClobPair: 2,
+				DepthCapPolicy:              types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY,
+			},
+
+			order: constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+
+			expectedOrderStatus:   types.Success,
+			expectedBumpedOrderId: &constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22.OrderId,
+			expectedRemainingBids: []OrderWithRemainingSize{
+				{
+					Order:         constants.LongTermOrder_Alice_Num0_Id0_Clob1_Buy5_Price10_GTBT5,
+					RemainingSize: 5,
+				},
+				{
+					Order:         constants.Order_Bob_Num0_Id11_Clob1_Buy5_Price40_GTB32,
+					RemainingSize: 5,
+				},
+			},
+			expectedRemainingAsks: []OrderWithRemainingSize{},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup memclob state and test expectations.
+			addOrderToOrderbookSize := satypes.BaseQuantums(0)
+			order := tc.order
+			memclob, fakeMemClobKeeper, expectedNumCollateralizationChecks, numCollateralChecks := placeOrderTestSetup(
+				t,
+				ctx,
+				tc.placedMatchableOrders,
+				&order,
+				tc.expectedCollatCheck,
+				tc.expectedOrderStatus,
+				addOrderToOrderbookSize,
+				nil,
+				map[int]map[satypes.SubaccountId]satypes.UpdateResult{},
+				constants.GetStatePosition_ZeroPositionSize,
+			)
+			fakeMemClobKeeper.WithClobPairConfig(tc.clobPairConfig)
+
+			// Run the test case and verify expectations.
+			offchainUpdates := placeOrderAndVerifyExpectations(
+				t,
+				ctx,
+				memclob,
+				order,
+				numCollateralChecks,
+				tc.expectedFilledSize,
+				tc.expectedFilledSize,
+				tc.expectedOrderStatus,
+				nil,
+				expectedNumCollateralizationChecks,
+				tc.expectedRemainingBids,
+				tc.expectedRemainingAsks,
+				tc.expectedCollatCheck,
+				nil,
+			)
+
+			// Verify an off-chain update removing the bumped order was returned.
+			if tc.expectedBumpedOrderId != nil {
+				message, success := off_chain_updates.CreateOrderRemoveMessageWithReason(
+					ctx,
+					*tc.expectedBumpedOrderId,
+					sharedtypes.OrderRemovalReason_ORDER_REMOVAL_REASON_ORDERBOOK_DEPTH_CAP,
+					ocutypes.OrderRemoveV1_ORDER_REMOVAL_STATUS_BEST_EFFORT_CANCELED,
+				)
+				require.True(t, success)
+				require.Contains(t, offchainUpdates.GetMessages(), message)
+			}
+		})
+	}
+}
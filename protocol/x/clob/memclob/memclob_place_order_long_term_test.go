@@ -442,6 +442,64 @@ func TestPlaceOrder_LongTerm(t *testing.T) {
 			expectedRemainingBids: []OrderWithRemainingSize{},
 			expectedRemainingAsks: []OrderWithRemainingSize{},
 		},
+		`A Long-Term buy order that fails the minimum collateral to open a position check as a maker is removed
+			with its own removal reason, and the Long-Term sell order is placed on the book`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+			},
+			collateralizationCheck: map[int]testutil_memclob.CollateralizationCheck{
+				0: {
+					CollatCheck: map[satypes.SubaccountId][]types.PendingOpenOrder{
+						constants.Alice_Num0: {
+							{
+								RemainingQuantums: 45,
+								IsBuy:             false,
+								Subticks:          10,
+								ClobPairId:        0,
+							},
+						},
+						constants.Bob_Num0: {
+							{
+								RemainingQuantums: 45,
+								IsBuy:             true,
+								Subticks:          10,
+								ClobPairId:        0,
+							},
+						},
+					},
+					Result: map[satypes.SubaccountId]satypes.UpdateResult{
+						constants.Alice_Num0: satypes.Success,
+						constants.Bob_Num0:   satypes.ViolatesMinCollateralToOpenPosition,
+					},
+				},
+			},
+
+			order: constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+
+			expectedFilledSize:  0,
+			expectedOrderStatus: types.Success,
+			expectedOperations: []types.Operation{
+				clobtest.NewOrderPlacementOperation(
+					constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10,
+				),
+				clobtest.NewOrderPlacementOperation(
+					constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+				),
+			},
+			expectedInternalOperations: []types.InternalOperation{
+				types.NewOrderRemovalInternalOperation(
+					constants.LongTermOrder_Bob_Num0_Id1_Clob0_Buy45_Price10_GTBT10.OrderId,
+					types.OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION,
+				),
+			},
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.LongTermOrder_Alice_Num0_Id2_Clob0_Sell65_Price10_GTBT25,
+					RemainingSize: 65,
+				},
+			},
+		},
 		`A Long-Term post-only sell order can partially match with a Long-Term buy order,
 				all existing matches are reverted and it's not added to pendingStatefulOrders`: {
 			placedMatchableOrders: []types.MatchableOrder{
@@ -2320,6 +2320,49 @@ func TestPlaceOrder_MatchOrders_CollatCheckFailure(t *testing.T) {
 			expectedOperations:         []types.Operation{},
 			expectedInternalOperations: []types.InternalOperation{},
 		},
+		`Matching stops if a maker order fill is paused by a stale market price, and the maker order is left on the
+		book`: {
+			placedMatchableOrders: []types.MatchableOrder{
+				&constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+				&constants.Order_Alice_Num0_Id3_Clob1_Sell5_Price10_GTB15,
+				&constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+			},
+			collateralizationCheckFailures: map[int]map[satypes.SubaccountId]satypes.UpdateResult{
+				0: {
+					constants.Alice_Num0: satypes.ViolatesStaleMarketPriceConstraints,
+				},
+			},
+
+			order: constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+
+			expectedFilledSize:    0,
+			expectedOrderStatus:   types.ViolatesStaleMarketPriceConstraints,
+			expectedRemainingBids: []OrderWithRemainingSize{},
+			expectedRemainingAsks: []OrderWithRemainingSize{
+				{
+					Order:         constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+					RemainingSize: 5,
+				},
+				{
+					Order:         constants.Order_Alice_Num0_Id3_Clob1_Sell5_Price10_GTB15,
+					RemainingSize: 5,
+				},
+				{
+					Order:         constants.Order_Alice_Num1_Id1_Clob1_Sell10_Price15_GTB20,
+					RemainingSize: 10,
+				},
+			},
+			expectedCollatCheck: []expectedMatch{
+				{
+					makerOrder:      &constants.Order_Alice_Num0_Id2_Clob1_Sell5_Price10_GTB15,
+					takerOrder:      &constants.Order_Bob_Num0_Id4_Clob1_Buy20_Price35_GTB22,
+					matchedQuantums: 5,
+				},
+			},
+			expectedMatches:            []expectedMatch{},
+			expectedOperations:         []types.Operation{},
+			expectedInternalOperations: []types.InternalOperation{},
+		},
 		`Matching stops if taker order is partially filled then fails collateralization, and all filled maker orders are
 		removed from the book`: {
 			placedMatchableOrders: []types.MatchableOrder{
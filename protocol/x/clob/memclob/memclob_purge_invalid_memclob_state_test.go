@@ -254,6 +254,7 @@ func TestPurgeInvalidMemclobState(t *testing.T) {
 			memclob.SetClobKeeper(mockMemClobKeeper)
 			mockMemClobKeeper.On("Logger", mock.Anything).Return(log.NewNopLogger()).Maybe()
 			mockMemClobKeeper.On("SendOrderbookUpdates", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			mockMemClobKeeper.On("GetClobPairConfig", mock.Anything).Return(types.ClobPairConfig{}).Maybe()
 
 			for _, operation := range tc.placedOperations {
 				switch operation.Operation.(type) {
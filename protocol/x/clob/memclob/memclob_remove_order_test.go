@@ -329,6 +329,7 @@ func TestRemoveOrderIfFilled(t *testing.T) {
 
 			memClobKeeper.On("ValidateSubaccountEquityTierLimitForNewOrder", mock.Anything, mock.Anything).Return(nil)
 			memClobKeeper.On("SendOrderbookUpdates", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+			memClobKeeper.On("GetClobPairConfig", mock.Anything).Return(types.ClobPairConfig{}).Maybe()
 
 			// Set initial fill amount to `0` for all orders.
 			initialCall := memClobKeeper.On("GetOrderFillAmount", mock.Anything, mock.Anything).
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
 	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	"github.com/pkg/errors"
@@ -208,6 +209,43 @@ func (ob *Orderbook) getBestOrderOnSide(
 	return ob.getFirstOrderAtSideAndSubticks(isBuy, bestSubticks)
 }
 
+// getLowestPriorityShortTermOrderWorseThan returns the lowest-priority resting Short-Term order on the
+// specified side of the book that has a strictly worse price than `subticks`. Orders are prioritized by
+// price and then by time, so the lowest-priority order is the most recently placed Short-Term order at the
+// worst price level that contains a Short-Term order. Returns false if no such order exists.
+func (ob *Orderbook) getLowestPriorityShortTermOrderWorseThan(
+	isBuy bool,
+	subticks types.Subticks,
+) (
+	lowestPriorityOrder *types.LevelOrder,
+	found bool,
+) {
+	levels := ob.GetSide(isBuy)
+	sortedSubticks := lib.GetSortedKeys[lib.Sortable[types.Subticks]](levels)
+
+	// Iterate over the price levels from worst to best. Bids are worst at the lowest price and asks are
+	// worst at the highest price.
+	for i := range sortedSubticks {
+		levelSubticks := sortedSubticks[i]
+		if !isBuy {
+			levelSubticks = sortedSubticks[len(sortedSubticks)-1-i]
+		}
+
+		if isBuy && levelSubticks >= subticks || !isBuy && levelSubticks <= subticks {
+			return nil, false
+		}
+
+		// Iterate over the orders in the level from newest to oldest.
+		for levelOrder := levels[levelSubticks].LevelOrders.Back; levelOrder != nil; levelOrder = levelOrder.Prev {
+			if levelOrder.Value.Order.IsShortTermOrder() {
+				return levelOrder, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
 // hasOrder returns true if the order by ID exists on the book.
 func (ob *Orderbook) hasOrder(
 	orderId types.OrderId,
@@ -45,7 +45,9 @@ func getValidGenesisStr() string {
 	gs += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
 	gs += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
 	gs += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
-	gs += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
+	gs += `"spread_to_maintenance_margin_ratio_ppm":100000},"subaccount_liquidation_cooldown_blocks":0,`
+	gs += `"max_deleveraging_fills_per_operation":0},`
+	gs += `"block_rate_limit_config":`
 	gs += `{"max_short_term_orders_and_cancels_per_n_blocks":[{"limit": 400,"num_blocks":1}],`
 	gs += `"max_stateful_orders_per_n_blocks":[{"limit": 2,"num_blocks":1},{"limit": 20,"num_blocks":100}]},`
 	gs += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[{"limit":0,"usd_tnc_required":"0"},`
@@ -148,7 +150,7 @@ func TestAppModuleBasic_RegisterInterfaces(t *testing.T) {
 	// due to it using an unexported method on the interface thus we use reflection to access the field
 	// directly that contains the registrations.
 	fv := reflect.ValueOf(registry).Elem().FieldByName("implInterfaces")
-	require.Len(t, fv.MapKeys(), 18)
+	require.Len(t, fv.MapKeys(), 24)
 }
 
 func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
@@ -165,10 +167,13 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	expected += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
 	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
 	expected += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
-	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
+	expected += `"spread_to_maintenance_margin_ratio_ppm":100000},"subaccount_liquidation_cooldown_blocks":0,`
+	expected += `"max_deleveraging_fills_per_operation":0},`
+	expected += `"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_and_cancels_per_n_blocks":[],"max_stateful_orders_per_n_blocks":[],`
 	expected += `"max_short_term_order_cancellations_per_n_blocks":[],"max_short_term_orders_per_n_blocks":[]},`
-	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]}}`
+	expected += `"equity_tier_limit_config":{"short_term_order_equity_tiers":[], "stateful_order_equity_tiers":[]},`
+	expected += `"clob_pair_config":{"min_subticks_per_tick":0,"max_price_update_gap_blocks":0,"max_subaccount_notional":"0","fee_rounding_mode":"ROUNDING_MODE_UNSPECIFIED","max_resting_orders_per_clob_pair":0,"depth_cap_policy":"DEPTH_CAP_POLICY_UNSPECIFIED","min_collateral_to_open_position":"0","max_resting_orders_overrides":[]}}`
 
 	require.JSONEq(t, expected, string(json))
 }
@@ -240,10 +245,11 @@ func TestAppModuleBasic_GetTxCmd(t *testing.T) {
 
 	cmd := am.GetTxCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 3, len(cmd.Commands()))
+	require.Equal(t, 4, len(cmd.Commands()))
 	require.Equal(t, "batch-cancel", cmd.Commands()[0].Name())
 	require.Equal(t, "cancel-order", cmd.Commands()[1].Name())
 	require.Equal(t, "place-order", cmd.Commands()[2].Name())
+	require.Equal(t, "reduce-order", cmd.Commands()[3].Name())
 }
 
 func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
@@ -251,13 +257,19 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "clob", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 12, len(cmd.Commands()))
 	require.Equal(t, "get-block-rate-limit-config", cmd.Commands()[0].Name())
-	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[1].Name())
-	require.Equal(t, "get-liquidations-config", cmd.Commands()[2].Name())
-	require.Equal(t, "list-clob-pair", cmd.Commands()[3].Name())
-	require.Equal(t, "show-clob-pair", cmd.Commands()[4].Name())
-	require.Equal(t, "stateful-order", cmd.Commands()[5].Name())
+	require.Equal(t, "get-clob-pair-market-price", cmd.Commands()[1].Name())
+	require.Equal(t, "get-equity-tier-limit-config", cmd.Commands()[2].Name())
+	require.Equal(t, "get-insurance-fund-balance", cmd.Commands()[3].Name())
+	require.Equal(t, "get-last-block-rejected-operations", cmd.Commands()[4].Name())
+	require.Equal(t, "get-liquidations-config", cmd.Commands()[5].Name())
+	require.Equal(t, "get-max-order-size", cmd.Commands()[6].Name())
+	require.Equal(t, "list-clob-pair", cmd.Commands()[7].Name())
+	require.Equal(t, "list-clob-pair-by-status", cmd.Commands()[8].Name())
+	require.Equal(t, "list-markets-overview", cmd.Commands()[9].Name())
+	require.Equal(t, "show-clob-pair", cmd.Commands()[10].Name())
+	require.Equal(t, "stateful-order", cmd.Commands()[11].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -428,7 +440,9 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `"max_position_portion_liquidated_ppm":1000000},"subaccount_block_limits":`
 	expected += `{"max_notional_liquidated":"100000000000000","max_quantums_insurance_lost":"100000000000000"},`
 	expected += `"fillable_price_config":{"bankruptcy_adjustment_ppm":1000000,`
-	expected += `"spread_to_maintenance_margin_ratio_ppm":100000}},"block_rate_limit_config":`
+	expected += `"spread_to_maintenance_margin_ratio_ppm":100000},"subaccount_liquidation_cooldown_blocks":0,`
+	expected += `"max_deleveraging_fills_per_operation":0},`
+	expected += `"block_rate_limit_config":`
 	expected += `{"max_short_term_orders_and_cancels_per_n_blocks":[{"limit": 400,"num_blocks":1}],`
 	expected += `"max_stateful_orders_per_n_blocks":[{"limit": 2,"num_blocks":1},`
 	expected += `{"limit": 20,"num_blocks":100}],"max_short_term_order_cancellations_per_n_blocks":[],`
@@ -439,7 +453,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	expected += `{"limit":1000,"usd_tnc_required":"100000"}],"stateful_order_equity_tiers":[`
 	expected += `{"limit":0,"usd_tnc_required":"0"},{"limit":1,"usd_tnc_required":"20"},`
 	expected += `{"limit":5,"usd_tnc_required":"100"},{"limit":10,"usd_tnc_required":"1000"},`
-	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]}}`
+	expected += `{"limit":100,"usd_tnc_required":"10000"},{"limit":200,"usd_tnc_required":"100000"}]},`
+	expected += `"clob_pair_config":{"min_subticks_per_tick":0,"max_price_update_gap_blocks":0,"max_subaccount_notional":"0","fee_rounding_mode":"ROUNDING_MODE_UNSPECIFIED","max_resting_orders_per_clob_pair":0,"depth_cap_policy":"DEPTH_CAP_POLICY_UNSPECIFIED","min_collateral_to_open_position":"0","max_resting_orders_overrides":[]}}`
 	require.JSONEq(t, expected, string(genesisJson))
 }
 
@@ -39,6 +39,7 @@ type ClobKeeper interface {
 		ClobPair,
 		error,
 	)
+	DelistPerpetualMarket(ctx sdk.Context, perpetualId uint32) error
 	HandleMsgCancelOrder(
 		ctx sdk.Context,
 		msg *MsgCancelOrder,
@@ -48,6 +49,10 @@ type ClobKeeper interface {
 		msg *MsgPlaceOrder,
 		isInternalOrder bool,
 	) (err error)
+	HandleMsgReduceOrder(
+		ctx sdk.Context,
+		msg *MsgReduceOrder,
+	) (err error)
 	GetAllClobPairs(ctx sdk.Context) (list []ClobPair)
 	GetClobPair(ctx sdk.Context, id ClobPairId) (val ClobPair, found bool)
 	HasAuthority(authority string) bool
@@ -130,6 +135,8 @@ type ClobKeeper interface {
 		ctx sdk.Context,
 	) (config BlockRateLimitConfiguration)
 	InitializeEquityTierLimit(ctx sdk.Context, config EquityTierLimitConfiguration) error
+	InitializeClobPairConfig(ctx sdk.Context, config ClobPairConfig)
+	GetClobPairConfig(ctx sdk.Context) (config ClobPairConfig)
 	Logger(ctx sdk.Context) log.Logger
 	UpdateClobPair(
 		ctx sdk.Context,
@@ -12,6 +12,7 @@ import (
 var SupportedClobPairStatusTransitions = map[ClobPair_Status]map[ClobPair_Status]struct{}{
 	ClobPair_STATUS_ACTIVE: {
 		ClobPair_STATUS_FINAL_SETTLEMENT: struct{}{},
+		ClobPair_STATUS_PAUSED:           struct{}{},
 	},
 	ClobPair_STATUS_INITIALIZING: {
 		ClobPair_STATUS_ACTIVE:           struct{}{},
@@ -20,6 +21,12 @@ var SupportedClobPairStatusTransitions = map[ClobPair_Status]map[ClobPair_Status
 	ClobPair_STATUS_FINAL_SETTLEMENT: {
 		ClobPair_STATUS_INITIALIZING: struct{}{},
 	},
+	// ClobPairs are transitioned to PAUSED when their perpetual's market is delisted or by governance,
+	// which may also resume a PAUSED ClobPair back to ACTIVE.
+	ClobPair_STATUS_PAUSED: {
+		ClobPair_STATUS_ACTIVE:           struct{}{},
+		ClobPair_STATUS_FINAL_SETTLEMENT: struct{}{},
+	},
 }
 
 // IsSupportedClobPairStatus returns true if the provided ClobPair_Status is in the list
@@ -78,8 +85,23 @@ func (c *ClobPair) GetClobPairId() ClobPairId {
 	return ClobPairId(c.Id)
 }
 
-// Stateless validation on ClobPair.
+// Stateless validation on a ClobPair being created. ClobPairs cannot be created with the PAUSED
+// status, which is only reachable by transitioning an existing ClobPair.
 func (c *ClobPair) Validate() error {
+	if c.Status == ClobPair_STATUS_PAUSED {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairParameter,
+			"CLOB pair (%+v) has unsupported status %+v",
+			c,
+			c.Status,
+		)
+	}
+
+	return c.ValidateUpdate()
+}
+
+// Stateless validation on a ClobPair being written over an existing ClobPair.
+func (c *ClobPair) ValidateUpdate() error {
 	switch c.Metadata.(type) {
 	// TODO(DEC-1535): update this when additional clob pair types are supported.
 	case *ClobPair_SpotClobMetadata:
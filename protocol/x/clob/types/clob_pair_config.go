@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+)
+
+// Validate validates the ClobPair config. It returns an error if the `FeeRoundingMode` or the
+// `DepthCapPolicy` is unknown, or if a ClobPair has more than one `MaxRestingOrdersOverrides` entry.
+func (c ClobPairConfig) Validate() error {
+	if _, ok := ClobPairConfig_RoundingMode_name[int32(c.FeeRoundingMode)]; !ok {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairConfig,
+			"%v is not a valid FeeRoundingMode",
+			c.FeeRoundingMode,
+		)
+	}
+	if _, ok := ClobPairConfig_DepthCapPolicy_name[int32(c.DepthCapPolicy)]; !ok {
+		return errorsmod.Wrapf(
+			ErrInvalidClobPairConfig,
+			"%v is not a valid DepthCapPolicy",
+			c.DepthCapPolicy,
+		)
+	}
+	overriddenClobPairIds := make(map[uint32]struct{}, len(c.MaxRestingOrdersOverrides))
+	for _, override := range c.MaxRestingOrdersOverrides {
+		if _, exists := overriddenClobPairIds[override.ClobPairId]; exists {
+			return errorsmod.Wrapf(
+				ErrInvalidClobPairConfig,
+				"duplicate MaxRestingOrdersOverrides entry for ClobPair %d",
+				override.ClobPairId,
+			)
+		}
+		overriddenClobPairIds[override.ClobPairId] = struct{}{}
+	}
+	return nil
+}
+
+// GetMaxRestingOrders returns the maximum number of orders that may rest on the orderbook of the
+// ClobPair. The `MaxRestingOrdersOverrides` entry of the ClobPair is used if one exists, and
+// `MaxRestingOrdersPerClobPair` otherwise. A value of 0 means there is no limit.
+func (c ClobPairConfig) GetMaxRestingOrders(clobPairId ClobPairId) uint32 {
+	for _, override := range c.MaxRestingOrdersOverrides {
+		if override.ClobPairId == clobPairId.ToUint32() {
+			return override.MaxRestingOrders
+		}
+	}
+	return c.MaxRestingOrdersPerClobPair
+}
+
+// ShouldBumpLowestPriorityOrderAtDepthCap returns true if a Short-Term order that would rest on an
+// orderbook that has reached its maximum number of resting orders should bump the lowest-priority resting
+// Short-Term order on its side of the orderbook, and false if it should be rejected.
+func (c ClobPairConfig) ShouldBumpLowestPriorityOrderAtDepthCap() bool {
+	return c.DepthCapPolicy == ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY
+}
+
+// GetFeeLibRoundingMode returns the rounding mode used when computing trading fees and maker rebates.
+// An unspecified rounding mode rounds towards positive infinity. This function panics if the
+// `FeeRoundingMode` is unknown.
+func (c ClobPairConfig) GetFeeLibRoundingMode() lib.RoundingMode {
+	switch c.FeeRoundingMode {
+	case ClobPairConfig_ROUNDING_MODE_UNSPECIFIED, ClobPairConfig_ROUNDING_MODE_CEIL:
+		return lib.RoundingModeCeil
+	case ClobPairConfig_ROUNDING_MODE_FLOOR:
+		return lib.RoundingModeFloor
+	case ClobPairConfig_ROUNDING_MODE_HALF_EVEN:
+		return lib.RoundingModeHalfEven
+	default:
+		panic(fmt.Sprintf("GetFeeLibRoundingMode: unknown FeeRoundingMode %v", c.FeeRoundingMode))
+	}
+}
@@ -0,0 +1,910 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dydxprotocol/clob/clob_pair_config.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RoundingMode specifies how amounts in quote quantums that are not whole
+// are rounded.
+type ClobPairConfig_RoundingMode int32
+
+const (
+	// Default value. Rounds towards positive infinity.
+	ClobPairConfig_ROUNDING_MODE_UNSPECIFIED ClobPairConfig_RoundingMode = 0
+	// Rounds towards negative infinity.
+	ClobPairConfig_ROUNDING_MODE_FLOOR ClobPairConfig_RoundingMode = 1
+	// Rounds towards positive infinity.
+	ClobPairConfig_ROUNDING_MODE_CEIL ClobPairConfig_RoundingMode = 2
+	// Rounds to the nearest integer, and to the nearest even integer on ties.
+	ClobPairConfig_ROUNDING_MODE_HALF_EVEN ClobPairConfig_RoundingMode = 3
+)
+
+var ClobPairConfig_RoundingMode_name = map[int32]string{
+	0: "ROUNDING_MODE_UNSPECIFIED",
+	1: "ROUNDING_MODE_FLOOR",
+	2: "ROUNDING_MODE_CEIL",
+	3: "ROUNDING_MODE_HALF_EVEN",
+}
+
+var ClobPairConfig_RoundingMode_value = map[string]int32{
+	"ROUNDING_MODE_UNSPECIFIED": 0,
+	"ROUNDING_MODE_FLOOR":       1,
+	"ROUNDING_MODE_CEIL":        2,
+	"ROUNDING_MODE_HALF_EVEN":   3,
+}
+
+func (x ClobPairConfig_RoundingMode) String() string {
+	return proto.EnumName(ClobPairConfig_RoundingMode_name, int32(x))
+}
+
+func (ClobPairConfig_RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e567df887c2c485a, []int{0, 0}
+}
+
+// DepthCapPolicy specifies how a Short-Term order that would rest on an
+// orderbook that has reached `max_resting_orders_per_clob_pair` is handled.
+type ClobPairConfig_DepthCapPolicy int32
+
+const (
+	// Default value. Rejects the order.
+	ClobPairConfig_DEPTH_CAP_POLICY_UNSPECIFIED ClobPairConfig_DepthCapPolicy = 0
+	// The remaining size of the order that would rest on the orderbook is
+	// canceled.
+	ClobPairConfig_DEPTH_CAP_POLICY_REJECT ClobPairConfig_DepthCapPolicy = 1
+	// The lowest-priority resting Short-Term order on the same side of the
+	// orderbook is removed to make room for the order, if the order has a
+	// strictly better price. Otherwise the order is rejected.
+	ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY ClobPairConfig_DepthCapPolicy = 2
+)
+
+var ClobPairConfig_DepthCapPolicy_name = map[int32]string{
+	0: "DEPTH_CAP_POLICY_UNSPECIFIED",
+	1: "DEPTH_CAP_POLICY_REJECT",
+	2: "DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY",
+}
+
+var ClobPairConfig_DepthCapPolicy_value = map[string]int32{
+	"DEPTH_CAP_POLICY_UNSPECIFIED":          0,
+	"DEPTH_CAP_POLICY_REJECT":               1,
+	"DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY": 2,
+}
+
+func (x ClobPairConfig_DepthCapPolicy) String() string {
+	return proto.EnumName(ClobPairConfig_DepthCapPolicy_name, int32(x))
+}
+
+func (ClobPairConfig_DepthCapPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e567df887c2c485a, []int{0, 1}
+}
+
+// ClobPairConfig stores all configurable fields that apply to every ClobPair.
+type ClobPairConfig struct {
+	// The minimum `subticks_per_tick` of a ClobPair. ClobPairs with a
+	// `subticks_per_tick` below this value cannot be created or updated.
+	// Specifying 0 disables this limit.
+	MinSubticksPerTick uint32 `protobuf:"varint,1,opt,name=min_subticks_per_tick,json=minSubticksPerTick,proto3" json:"min_subticks_per_tick,omitempty"`
+	// The maximum number of blocks that may pass without any accepted oracle
+	// price update before oracle prices are considered stale. While oracle
+	// prices are stale, placement of new orders that are not reduce-only is
+	// paused on every ClobPair until price updates resume.
+	// Specifying 0 disables this limit.
+	MaxPriceUpdateGapBlocks uint32 `protobuf:"varint,2,opt,name=max_price_update_gap_blocks,json=maxPriceUpdateGapBlocks,proto3" json:"max_price_update_gap_blocks,omitempty"`
+	// The maximum total notional exposure of a subaccount across all
+	// perpetuals, in quote quantums. Placement of orders that are not
+	// reduce-only is rejected if the sum of the absolute net notional of the
+	// subaccount's perpetual positions, after the order is fully filled, would
+	// exceed this value. This limit is only enforced when orders are placed,
+	// not when they are matched, so a subaccount may exceed it through fills of
+	// multiple resting orders or through price changes.
+	// Specifying 0 disables this limit.
+	MaxSubaccountNotional uint64 `protobuf:"varint,3,opt,name=max_subaccount_notional,json=maxSubaccountNotional,proto3" json:"max_subaccount_notional,omitempty"`
+	// The rounding mode used when computing trading fees and maker rebates of
+	// a fill in quote quantums.
+	FeeRoundingMode ClobPairConfig_RoundingMode `protobuf:"varint,4,opt,name=fee_rounding_mode,json=feeRoundingMode,proto3,enum=dydxprotocol.clob.ClobPairConfig_RoundingMode" json:"fee_rounding_mode,omitempty"`
+	// The maximum number of orders that may rest on the orderbook of each
+	// ClobPair. Once an orderbook holds this many resting orders, Short-Term
+	// orders that would rest on it are handled according to
+	// `depth_cap_policy`. Stateful orders are always added to the orderbook
+	// but count towards the limit.
+	// ClobPairs with an entry in `max_resting_orders_overrides` use the limit of
+	// that entry instead.
+	// Specifying 0 disables this limit.
+	MaxRestingOrdersPerClobPair uint32 `protobuf:"varint,5,opt,name=max_resting_orders_per_clob_pair,json=maxRestingOrdersPerClobPair,proto3" json:"max_resting_orders_per_clob_pair,omitempty"`
+	// The policy applied when an orderbook has reached
+	// `max_resting_orders_per_clob_pair`.
+	DepthCapPolicy ClobPairConfig_DepthCapPolicy `protobuf:"varint,6,opt,name=depth_cap_policy,json=depthCapPolicy,proto3,enum=dydxprotocol.clob.ClobPairConfig_DepthCapPolicy" json:"depth_cap_policy,omitempty"`
+	// The minimum USDC asset position, in quote quantums, that a subaccount
+	// must hold to place an order that would open a new perpetual position.
+	// Orders for perpetuals in which the subaccount already holds a position
+	// are unaffected.
+	// Specifying 0 disables this limit.
+	MinCollateralToOpenPosition uint64 `protobuf:"varint,7,opt,name=min_collateral_to_open_position,json=minCollateralToOpenPosition,proto3" json:"min_collateral_to_open_position,omitempty"`
+	// Per-ClobPair overrides of `max_resting_orders_per_clob_pair`. Each
+	// ClobPair may have at most one override.
+	MaxRestingOrdersOverrides []ClobPairMaxRestingOrders `protobuf:"bytes,8,rep,name=max_resting_orders_overrides,json=maxRestingOrdersOverrides,proto3" json:"max_resting_orders_overrides"`
+}
+
+func (m *ClobPairConfig) Reset()         { *m = ClobPairConfig{} }
+func (m *ClobPairConfig) String() string { return proto.CompactTextString(m) }
+func (*ClobPairConfig) ProtoMessage()    {}
+func (*ClobPairConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e567df887c2c485a, []int{0}
+}
+func (m *ClobPairConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClobPairConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClobPairConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClobPairConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClobPairConfig.Merge(m, src)
+}
+func (m *ClobPairConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClobPairConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClobPairConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClobPairConfig proto.InternalMessageInfo
+
+func (m *ClobPairConfig) GetMinSubticksPerTick() uint32 {
+	if m != nil {
+		return m.MinSubticksPerTick
+	}
+	return 0
+}
+
+func (m *ClobPairConfig) GetMaxPriceUpdateGapBlocks() uint32 {
+	if m != nil {
+		return m.MaxPriceUpdateGapBlocks
+	}
+	return 0
+}
+
+func (m *ClobPairConfig) GetMaxSubaccountNotional() uint64 {
+	if m != nil {
+		return m.MaxSubaccountNotional
+	}
+	return 0
+}
+
+func (m *ClobPairConfig) GetFeeRoundingMode() ClobPairConfig_RoundingMode {
+	if m != nil {
+		return m.FeeRoundingMode
+	}
+	return ClobPairConfig_ROUNDING_MODE_UNSPECIFIED
+}
+
+func (m *ClobPairConfig) GetMaxRestingOrdersPerClobPair() uint32 {
+	if m != nil {
+		return m.MaxRestingOrdersPerClobPair
+	}
+	return 0
+}
+
+func (m *ClobPairConfig) GetDepthCapPolicy() ClobPairConfig_DepthCapPolicy {
+	if m != nil {
+		return m.DepthCapPolicy
+	}
+	return ClobPairConfig_DEPTH_CAP_POLICY_UNSPECIFIED
+}
+
+func (m *ClobPairConfig) GetMinCollateralToOpenPosition() uint64 {
+	if m != nil {
+		return m.MinCollateralToOpenPosition
+	}
+	return 0
+}
+
+func (m *ClobPairConfig) GetMaxRestingOrdersOverrides() []ClobPairMaxRestingOrders {
+	if m != nil {
+		return m.MaxRestingOrdersOverrides
+	}
+	return nil
+}
+
+// ClobPairMaxRestingOrders overrides the maximum number of orders that may
+// rest on the orderbook of a single ClobPair.
+type ClobPairMaxRestingOrders struct {
+	// The id of the ClobPair.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// The maximum number of orders that may rest on the orderbook of the
+	// ClobPair.
+	// Specifying 0 disables this limit for the ClobPair.
+	MaxRestingOrders uint32 `protobuf:"varint,2,opt,name=max_resting_orders,json=maxRestingOrders,proto3" json:"max_resting_orders,omitempty"`
+}
+
+func (m *ClobPairMaxRestingOrders) Reset()         { *m = ClobPairMaxRestingOrders{} }
+func (m *ClobPairMaxRestingOrders) String() string { return proto.CompactTextString(m) }
+func (*ClobPairMaxRestingOrders) ProtoMessage()    {}
+func (*ClobPairMaxRestingOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e567df887c2c485a, []int{1}
+}
+func (m *ClobPairMaxRestingOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClobPairMaxRestingOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClobPairMaxRestingOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClobPairMaxRestingOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClobPairMaxRestingOrders.Merge(m, src)
+}
+func (m *ClobPairMaxRestingOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClobPairMaxRestingOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClobPairMaxRestingOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClobPairMaxRestingOrders proto.InternalMessageInfo
+
+func (m *ClobPairMaxRestingOrders) GetClobPairId() uint32 {
+	if m != nil {
+		return m.ClobPairId
+	}
+	return 0
+}
+
+func (m *ClobPairMaxRestingOrders) GetMaxRestingOrders() uint32 {
+	if m != nil {
+		return m.MaxRestingOrders
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dydxprotocol.clob.ClobPairConfig_RoundingMode", ClobPairConfig_RoundingMode_name, ClobPairConfig_RoundingMode_value)
+	proto.RegisterEnum("dydxprotocol.clob.ClobPairConfig_DepthCapPolicy", ClobPairConfig_DepthCapPolicy_name, ClobPairConfig_DepthCapPolicy_value)
+	proto.RegisterType((*ClobPairConfig)(nil), "dydxprotocol.clob.ClobPairConfig")
+	proto.RegisterType((*ClobPairMaxRestingOrders)(nil), "dydxprotocol.clob.ClobPairMaxRestingOrders")
+}
+
+func init() {
+	proto.RegisterFile("dydxprotocol/clob/clob_pair_config.proto", fileDescriptor_e567df887c2c485a)
+}
+
+var fileDescriptor_e567df887c2c485a = []byte{
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x6d, 0x0c, 0x64, 0x46, 0xc9, 0x0c, 0xa3, 0x19, 0x1d, 0x5d, 0x55, 0x09, 0xa9,
+	0x08, 0x68, 0x61, 0xa0, 0x9d, 0xb8, 0xac, 0x69, 0xb6, 0x05, 0xb5, 0x4d, 0x94, 0xb6, 0xa0, 0xed,
+	0x62, 0xb9, 0x8e, 0xd7, 0x99, 0x25, 0xb1, 0xe5, 0xa4, 0x53, 0x07, 0xff, 0x04, 0x7f, 0xd6, 0x8e,
+	0x3b, 0x72, 0x42, 0x68, 0x3b, 0xf2, 0x4f, 0xa0, 0xa4, 0x59, 0x59, 0x5a, 0x7e, 0x5c, 0x22, 0xe7,
+	0x7d, 0x3f, 0xcf, 0x7e, 0xef, 0x6b, 0x3d, 0x83, 0xaa, 0x7b, 0xe6, 0x8e, 0x85, 0xe4, 0x11, 0x27,
+	0xdc, 0xab, 0x13, 0x8f, 0x0f, 0x92, 0x0f, 0x12, 0x98, 0x49, 0x44, 0x78, 0x70, 0xc4, 0x86, 0xb5,
+	0x44, 0x86, 0xab, 0x37, 0xc9, 0x5a, 0x0c, 0x3d, 0x7e, 0x38, 0xe4, 0x43, 0x9e, 0x84, 0xea, 0xf1,
+	0x6a, 0x02, 0x56, 0x7e, 0x2e, 0x83, 0xbc, 0xee, 0xf1, 0x81, 0x8d, 0x99, 0xd4, 0x93, 0x1d, 0xe0,
+	0x6b, 0xb0, 0xe6, 0xb3, 0x00, 0x85, 0xa3, 0x41, 0xc4, 0xc8, 0x49, 0x88, 0x04, 0x95, 0x28, 0x5e,
+	0x69, 0x4a, 0x59, 0xa9, 0xde, 0x73, 0xa0, 0xcf, 0x82, 0x6e, 0xaa, 0xd9, 0x54, 0xf6, 0x18, 0x39,
+	0x81, 0xef, 0x40, 0xd1, 0xc7, 0x63, 0x24, 0x24, 0x23, 0x14, 0x8d, 0x84, 0x8b, 0x23, 0x8a, 0x86,
+	0x58, 0xa0, 0x81, 0xc7, 0xc9, 0x49, 0xa8, 0x2d, 0x24, 0x89, 0x05, 0x1f, 0x8f, 0xed, 0x98, 0xe8,
+	0x27, 0xc0, 0x1e, 0x16, 0x8d, 0x44, 0x86, 0xdb, 0x20, 0x96, 0xe2, 0x03, 0x31, 0x21, 0x7c, 0x14,
+	0x44, 0x28, 0xe0, 0x11, 0xe3, 0x01, 0xf6, 0xb4, 0xc5, 0xb2, 0x52, 0x5d, 0x72, 0xd6, 0x7c, 0x3c,
+	0xee, 0x4e, 0xd5, 0x4e, 0x2a, 0xc2, 0x43, 0xb0, 0x7a, 0x44, 0x29, 0x92, 0x7c, 0x14, 0xb8, 0x2c,
+	0x18, 0x22, 0x9f, 0xbb, 0x54, 0x5b, 0x2a, 0x2b, 0xd5, 0xfc, 0x56, 0xad, 0x36, 0x67, 0x40, 0x2d,
+	0xdb, 0x66, 0xcd, 0x49, 0xd3, 0xda, 0xdc, 0xa5, 0xce, 0xfd, 0x23, 0x4a, 0x6f, 0x06, 0xa0, 0x01,
+	0xca, 0x71, 0x4d, 0x92, 0x86, 0x51, 0xbc, 0x35, 0x97, 0x2e, 0x95, 0x13, 0x2b, 0xa6, 0x8e, 0x6b,
+	0xb7, 0x92, 0xb6, 0xe2, 0xce, 0x9d, 0x09, 0x66, 0x25, 0x94, 0x4d, 0xe5, 0xf5, 0x51, 0xf0, 0x10,
+	0xa8, 0x2e, 0x15, 0xd1, 0x31, 0x22, 0x58, 0x20, 0xc1, 0x3d, 0x46, 0xce, 0xb4, 0xe5, 0xa4, 0xc2,
+	0x57, 0xff, 0xaf, 0xb0, 0x19, 0x67, 0xea, 0x58, 0xd8, 0x49, 0x9e, 0x93, 0x77, 0x33, 0xff, 0xb0,
+	0x09, 0x36, 0xe3, 0x7b, 0x22, 0xdc, 0xf3, 0x70, 0x44, 0x25, 0xf6, 0x50, 0xc4, 0x11, 0x17, 0x34,
+	0x40, 0x82, 0x87, 0x2c, 0xf6, 0x48, 0xbb, 0x9d, 0xd8, 0x57, 0xf4, 0x59, 0xa0, 0x4f, 0xa9, 0x1e,
+	0xb7, 0x04, 0x0d, 0xec, 0x14, 0x81, 0x12, 0x6c, 0xfc, 0xa1, 0x51, 0x7e, 0x4a, 0xa5, 0x64, 0x2e,
+	0x0d, 0xb5, 0x3b, 0xe5, 0xc5, 0xea, 0xdd, 0xad, 0xe7, 0xff, 0xa8, 0xb6, 0x3d, 0xd3, 0x7f, 0x63,
+	0xe9, 0xfc, 0xfb, 0x66, 0xce, 0x59, 0x9f, 0xf5, 0xc5, 0xba, 0xde, 0xb3, 0xf2, 0x05, 0xac, 0x64,
+	0xcc, 0x7e, 0x02, 0xd6, 0x1d, 0xab, 0xdf, 0x69, 0x9a, 0x9d, 0x3d, 0xd4, 0xb6, 0x9a, 0x06, 0xea,
+	0x77, 0xba, 0xb6, 0xa1, 0x9b, 0xbb, 0xa6, 0xd1, 0x54, 0x73, 0xb0, 0x00, 0x1e, 0x64, 0xe5, 0xdd,
+	0x96, 0x65, 0x39, 0xaa, 0x02, 0x1f, 0x01, 0x98, 0x15, 0x74, 0xc3, 0x6c, 0xa9, 0x0b, 0xb0, 0x08,
+	0x0a, 0xd9, 0xf8, 0xfe, 0x4e, 0x6b, 0x17, 0x19, 0x1f, 0x8c, 0x8e, 0xba, 0x58, 0xf9, 0x0c, 0xf2,
+	0x59, 0x63, 0x61, 0x19, 0x6c, 0x34, 0x0d, 0xbb, 0xb7, 0x8f, 0xf4, 0x1d, 0x1b, 0xd9, 0x56, 0xcb,
+	0xd4, 0x0f, 0x66, 0x2a, 0x28, 0x82, 0xc2, 0x1c, 0xe1, 0x18, 0xef, 0x0d, 0xbd, 0xa7, 0x2a, 0xf0,
+	0x19, 0x78, 0x3a, 0x27, 0x36, 0xfa, 0x6d, 0x1b, 0xb5, 0xac, 0x8f, 0x46, 0xb7, 0x87, 0x6c, 0xc7,
+	0xb4, 0x1c, 0xb3, 0x77, 0xa0, 0x2e, 0x54, 0x3e, 0x01, 0xed, 0x6f, 0xae, 0xc1, 0x32, 0x58, 0xf9,
+	0x3d, 0xcc, 0xcc, 0x4d, 0xa7, 0x0d, 0x90, 0x94, 0x37, 0x5d, 0xf8, 0x02, 0xc0, 0xf9, 0xab, 0x4a,
+	0x87, 0x4b, 0x9d, 0x75, 0xbb, 0x61, 0x9f, 0x5f, 0x96, 0x94, 0x8b, 0xcb, 0x92, 0xf2, 0xe3, 0xb2,
+	0xa4, 0x7c, 0xbd, 0x2a, 0xe5, 0x2e, 0xae, 0x4a, 0xb9, 0x6f, 0x57, 0xa5, 0xdc, 0xe1, 0xf6, 0x90,
+	0x45, 0xc7, 0xa3, 0x41, 0x8d, 0x70, 0xbf, 0x9e, 0x79, 0x51, 0x4e, 0xdf, 0xbe, 0x24, 0xc7, 0x98,
+	0x05, 0xf5, 0x69, 0x64, 0x3c, 0x79, 0x65, 0xa2, 0x33, 0x41, 0xc3, 0xc1, 0x72, 0x12, 0x7e, 0xf3,
+	0x6b, 0x00, 0xbe, 0xbb, 0xaa, 0xbf, 0x87, 0x04, 0x00, 0x00,
+}
+
+func (m *ClobPairConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClobPairConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClobPairConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxRestingOrdersOverrides) > 0 {
+		for iNdEx := len(m.MaxRestingOrdersOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxRestingOrdersOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClobPairConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.MinCollateralToOpenPosition != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MinCollateralToOpenPosition))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DepthCapPolicy != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.DepthCapPolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRestingOrdersPerClobPair != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MaxRestingOrdersPerClobPair))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FeeRoundingMode != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.FeeRoundingMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSubaccountNotional != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MaxSubaccountNotional))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPriceUpdateGapBlocks != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MaxPriceUpdateGapBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinSubticksPerTick != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MinSubticksPerTick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClobPairMaxRestingOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClobPairMaxRestingOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClobPairMaxRestingOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRestingOrders != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.MaxRestingOrders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintClobPairConfig(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClobPairConfig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClobPairConfig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClobPairConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinSubticksPerTick != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MinSubticksPerTick))
+	}
+	if m.MaxPriceUpdateGapBlocks != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MaxPriceUpdateGapBlocks))
+	}
+	if m.MaxSubaccountNotional != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MaxSubaccountNotional))
+	}
+	if m.FeeRoundingMode != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.FeeRoundingMode))
+	}
+	if m.MaxRestingOrdersPerClobPair != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MaxRestingOrdersPerClobPair))
+	}
+	if m.DepthCapPolicy != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.DepthCapPolicy))
+	}
+	if m.MinCollateralToOpenPosition != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MinCollateralToOpenPosition))
+	}
+	if len(m.MaxRestingOrdersOverrides) > 0 {
+		for _, e := range m.MaxRestingOrdersOverrides {
+			l = e.Size()
+			n += 1 + l + sovClobPairConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClobPairMaxRestingOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.ClobPairId))
+	}
+	if m.MaxRestingOrders != 0 {
+		n += 1 + sovClobPairConfig(uint64(m.MaxRestingOrders))
+	}
+	return n
+}
+
+func sovClobPairConfig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClobPairConfig(x uint64) (n int) {
+	return sovClobPairConfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClobPairConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClobPairConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClobPairConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClobPairConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSubticksPerTick", wireType)
+			}
+			m.MinSubticksPerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSubticksPerTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceUpdateGapBlocks", wireType)
+			}
+			m.MaxPriceUpdateGapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceUpdateGapBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubaccountNotional", wireType)
+			}
+			m.MaxSubaccountNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubaccountNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRoundingMode", wireType)
+			}
+			m.FeeRoundingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRoundingMode |= ClobPairConfig_RoundingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestingOrdersPerClobPair", wireType)
+			}
+			m.MaxRestingOrdersPerClobPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestingOrdersPerClobPair |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepthCapPolicy", wireType)
+			}
+			m.DepthCapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepthCapPolicy |= ClobPairConfig_DepthCapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCollateralToOpenPosition", wireType)
+			}
+			m.MinCollateralToOpenPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCollateralToOpenPosition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestingOrdersOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClobPairConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClobPairConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRestingOrdersOverrides = append(m.MaxRestingOrdersOverrides, ClobPairMaxRestingOrders{})
+			if err := m.MaxRestingOrdersOverrides[len(m.MaxRestingOrdersOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClobPairConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClobPairConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClobPairMaxRestingOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClobPairConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClobPairMaxRestingOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClobPairMaxRestingOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestingOrders", wireType)
+			}
+			m.MaxRestingOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestingOrders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClobPairConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClobPairConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClobPairConfig(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClobPairConfig
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClobPairConfig
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClobPairConfig
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClobPairConfig
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClobPairConfig
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClobPairConfig        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClobPairConfig          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClobPairConfig = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/clob/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClobPairConfig_GetFeeLibRoundingMode(t *testing.T) {
+	tests := map[types.ClobPairConfig_RoundingMode]lib.RoundingMode{
+		types.ClobPairConfig_ROUNDING_MODE_UNSPECIFIED: lib.RoundingModeCeil,
+		types.ClobPairConfig_ROUNDING_MODE_FLOOR:       lib.RoundingModeFloor,
+		types.ClobPairConfig_ROUNDING_MODE_CEIL:        lib.RoundingModeCeil,
+		types.ClobPairConfig_ROUNDING_MODE_HALF_EVEN:   lib.RoundingModeHalfEven,
+	}
+	for mode, expected := range tests {
+		t.Run(mode.String(), func(t *testing.T) {
+			config := types.ClobPairConfig{FeeRoundingMode: mode}
+			require.NoError(t, config.Validate())
+			require.Equal(t, expected, config.GetFeeLibRoundingMode())
+		})
+	}
+}
+
+func TestClobPairConfig_UnknownFeeRoundingMode(t *testing.T) {
+	config := types.ClobPairConfig{FeeRoundingMode: types.ClobPairConfig_RoundingMode(4)}
+	require.ErrorIs(t, config.Validate(), types.ErrInvalidClobPairConfig)
+	require.PanicsWithValue(t, "GetFeeLibRoundingMode: unknown FeeRoundingMode 4", func() {
+		config.GetFeeLibRoundingMode()
+	})
+}
+
+func TestClobPairConfig_ShouldBumpLowestPriorityOrderAtDepthCap(t *testing.T) {
+	tests := map[types.ClobPairConfig_DepthCapPolicy]bool{
+		types.ClobPairConfig_DEPTH_CAP_POLICY_UNSPECIFIED:          false,
+		types.ClobPairConfig_DEPTH_CAP_POLICY_REJECT:               false,
+		types.ClobPairConfig_DEPTH_CAP_POLICY_BUMP_LOWEST_PRIORITY: true,
+	}
+	for policy, expected := range tests {
+		t.Run(policy.String(), func(t *testing.T) {
+			config := types.ClobPairConfig{DepthCapPolicy: policy}
+			require.NoError(t, config.Validate())
+			require.Equal(t, expected, config.ShouldBumpLowestPriorityOrderAtDepthCap())
+		})
+	}
+}
+
+func TestClobPairConfig_UnknownDepthCapPolicy(t *testing.T) {
+	config := types.ClobPairConfig{DepthCapPolicy: types.ClobPairConfig_DepthCapPolicy(3)}
+	require.ErrorIs(t, config.Validate(), types.ErrInvalidClobPairConfig)
+}
+
+func TestClobPairConfig_GetMaxRestingOrders(t *testing.T) {
+	config := types.ClobPairConfig{
+		MaxRestingOrdersPerClobPair: 100,
+		MaxRestingOrdersOverrides: []types.ClobPairMaxRestingOrders{
+			{ClobPairId: 1, MaxRestingOrders: 10},
+			{ClobPairId: 2, MaxRestingOrders: 0},
+		},
+	}
+	require.NoError(t, config.Validate())
+	require.Equal(t, uint32(100), config.GetMaxRestingOrders(types.ClobPairId(0)))
+	require.Equal(t, uint32(10), config.GetMaxRestingOrders(types.ClobPairId(1)))
+	require.Equal(t, uint32(0), config.GetMaxRestingOrders(types.ClobPairId(2)))
+}
+
+func TestClobPairConfig_DuplicateMaxRestingOrdersOverride(t *testing.T) {
+	config := types.ClobPairConfig{
+		MaxRestingOrdersOverrides: []types.ClobPairMaxRestingOrders{
+			{ClobPairId: 1, MaxRestingOrders: 10},
+			{ClobPairId: 1, MaxRestingOrders: 20},
+		},
+	}
+	require.ErrorIs(t, config.Validate(), types.ErrInvalidClobPairConfig)
+}
@@ -43,6 +43,7 @@ func TestIsSupportedClobPairStatus_Supported(t *testing.T) {
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_ACTIVE))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_INITIALIZING))
 	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_FINAL_SETTLEMENT))
+	require.True(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_PAUSED))
 }
 
 func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
@@ -51,7 +52,6 @@ func TestIsSupportedClobPairStatus_Unsupported(t *testing.T) {
 
 	// these are part of the ClobPair_Status enum but are not supported
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_UNSPECIFIED))
-	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_PAUSED))
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_CANCEL_ONLY))
 	require.False(t, types.IsSupportedClobPairStatus(types.ClobPair_STATUS_POST_ONLY))
 }
@@ -69,6 +69,15 @@ func TestIsSupportedClobPairStatusTransition_Supported(t *testing.T) {
 	require.True(t, types.IsSupportedClobPairStatusTransition(
 		types.ClobPair_STATUS_FINAL_SETTLEMENT, types.ClobPair_STATUS_INITIALIZING,
 	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_FINAL_SETTLEMENT,
+	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_ACTIVE, types.ClobPair_STATUS_PAUSED,
+	))
+	require.True(t, types.IsSupportedClobPairStatusTransition(
+		types.ClobPair_STATUS_PAUSED, types.ClobPair_STATUS_ACTIVE,
+	))
 }
 
 func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
@@ -109,6 +118,8 @@ func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
 				{
 					switch toClobPairStatus {
 					case int32(types.ClobPair_STATUS_FINAL_SETTLEMENT):
+						fallthrough
+					case int32(types.ClobPair_STATUS_PAUSED):
 						continue
 					default:
 						require.Equal(
@@ -137,6 +148,24 @@ func TestIsSupportedClobPairStatusTransition_Unsupported(t *testing.T) {
 						)
 					}
 				}
+			case int32(types.ClobPair_STATUS_PAUSED):
+				{
+					switch toClobPairStatus {
+					case int32(types.ClobPair_STATUS_ACTIVE):
+						fallthrough
+					case int32(types.ClobPair_STATUS_FINAL_SETTLEMENT):
+						continue
+					default:
+						require.Equal(
+							t,
+							toClobPairStatus == fromClobPairStatus,
+							types.IsSupportedClobPairStatusTransition(
+								types.ClobPair_Status(fromClobPairStatus),
+								types.ClobPair_Status(toClobPairStatus),
+							),
+						)
+					}
+				}
 			default:
 				require.False(
 					t,
@@ -338,6 +338,21 @@ var (
 		1022,
 		"Liquidation conflicts with ClobPair status",
 	)
+	ErrSubaccountInLiquidationCooldown = errorsmod.Register(
+		ModuleName,
+		1023,
+		"Subaccount was liquidated too recently and is in the liquidation cooldown",
+	)
+	ErrTooManyDeleveragingFills = errorsmod.Register(
+		ModuleName,
+		1024,
+		"Deleveraging match exceeds the maximum number of fills per operation",
+	)
+	ErrDeleveragingFillsDoNotMatchPositionSize = errorsmod.Register(
+		ModuleName,
+		1025,
+		"Deleveraging match fills exceed the size of the liquidated position",
+	)
 
 	// Advanced order type errors.
 	ErrFokOrderCouldNotBeFullyFilled = errorsmod.Register(
@@ -427,6 +442,16 @@ var (
 		3010,
 		"Stateful order cancellation failed because the order was already removed from state",
 	)
+	ErrInvalidOrderReduction = errorsmod.Register(
+		ModuleName,
+		3011,
+		"Stateful order reduction must decrease the size of the order",
+	)
+	ErrOrderReductionBelowFilledAmount = errorsmod.Register(
+		ModuleName,
+		3012,
+		"Stateful order cannot be reduced to a size at or below its filled amount",
+	)
 
 	// Operations Queue validation errors
 	ErrInvalidMsgProposedOperations = errorsmod.Register(
@@ -474,6 +499,11 @@ var (
 		4008,
 		"Zero-fill deleveraging operation included in block for non-negative TNC subaccount",
 	)
+	ErrMatchOrderClobPairIdMismatch = errorsmod.Register(
+		ModuleName,
+		4009,
+		"Maker order in match is for a different ClobPair than the taker order",
+	)
 
 	// Block rate limit errors.
 	ErrInvalidBlockRateLimitConfig = errorsmod.Register(
@@ -525,6 +555,16 @@ var (
 		9003,
 		"Reduce-only is currently disabled for non-IOC orders",
 	)
+	ErrOrderPlacementPausedStalePrices = errorsmod.Register(
+		ModuleName,
+		9004,
+		"Placement of orders that are not reduce-only is paused while oracle prices are stale",
+	)
+	ErrOrderPlacementPausedStaleMarketPrice = errorsmod.Register(
+		ModuleName,
+		9005,
+		"Placement of orders that are not reduce-only is paused while the ClobPair's oracle price is stale",
+	)
 
 	// Equity tier limit errors.
 	ErrInvalidEquityTierLimitConfig = errorsmod.Register(
@@ -537,4 +577,25 @@ var (
 		10001,
 		"Subaccount cannot open more orders due to equity tier limit.",
 	)
+
+	// Subaccount notional limit errors.
+	ErrOrderWouldExceedMaxSubaccountNotional = errorsmod.Register(
+		ModuleName,
+		11000,
+		"Order would cause the subaccount to exceed the max subaccount notional",
+	)
+
+	// Minimum collateral to open position errors.
+	ErrInsufficientCollateralToOpenPosition = errorsmod.Register(
+		ModuleName,
+		11001,
+		"Subaccount does not have the minimum collateral required to open a new position",
+	)
+
+	// ClobPair config errors.
+	ErrInvalidClobPairConfig = errorsmod.Register(
+		ModuleName,
+		12000,
+		"Proposed ClobPairConfig is invalid",
+	)
 )
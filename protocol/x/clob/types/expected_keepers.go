@@ -78,6 +78,14 @@ type SubaccountsKeeper interface {
 		quantums *big.Int,
 		perpetualId uint32,
 	) error
+	RecomputeOpenInterest(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (
+		openInterest *big.Int,
+		drift *big.Int,
+		err error,
+	)
 }
 
 type AssetsKeeper interface {
@@ -127,15 +135,23 @@ type PerpetualsKeeper interface {
 		perpetualId uint32,
 	) (perpetualsmoduletypes.Perpetual, pricestypes.MarketPrice, error)
 	MaybeProcessNewFundingTickEpoch(ctx sdk.Context)
+	DelistPerpetualMarket(
+		ctx sdk.Context,
+		perpetualId uint32,
+	) (perpetualsmoduletypes.Perpetual, error)
 	GetInsuranceFundModuleAddress(ctx sdk.Context, perpetualId uint32) (sdk.AccAddress, error)
 }
 
 type PricesKeeper interface {
 	GetMarketParam(ctx sdk.Context, id uint32) (param pricestypes.MarketParam, exists bool)
+	GetMarketPrice(ctx sdk.Context, id uint32) (pricestypes.MarketPrice, error)
+	GetLastPriceUpdateBlockHeight(ctx sdk.Context) uint32
+	GetMarketLastPriceUpdateBlockHeight(ctx sdk.Context, marketId uint32) uint32
 }
 
 type StatsKeeper interface {
-	RecordFill(ctx sdk.Context, takerAddress string, makerAddress string, notional *big.Int)
+	RecordFill(ctx sdk.Context, takerAddress string, makerAddress string, notional *big.Int, isLiquidation bool)
+	RecordInsuranceFundFlow(ctx sdk.Context, insuranceFundDelta *big.Int)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations.
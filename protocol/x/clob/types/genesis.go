@@ -9,6 +9,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		BlockRateLimitConfig:  BlockRateLimitConfiguration{},
 		ClobPairs:             []ClobPair{},
+		ClobPairConfig:        ClobPairConfig{},
 		EquityTierLimitConfig: EquityTierLimitConfiguration{},
 		LiquidationsConfig:    LiquidationsConfig_Default,
 	}
@@ -45,5 +46,9 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.ClobPairConfig.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -29,6 +29,7 @@ type GenesisState struct {
 	LiquidationsConfig    LiquidationsConfig           `protobuf:"bytes,2,opt,name=liquidations_config,json=liquidationsConfig,proto3" json:"liquidations_config"`
 	BlockRateLimitConfig  BlockRateLimitConfiguration  `protobuf:"bytes,3,opt,name=block_rate_limit_config,json=blockRateLimitConfig,proto3" json:"block_rate_limit_config"`
 	EquityTierLimitConfig EquityTierLimitConfiguration `protobuf:"bytes,4,opt,name=equity_tier_limit_config,json=equityTierLimitConfig,proto3" json:"equity_tier_limit_config"`
+	ClobPairConfig        ClobPairConfig               `protobuf:"bytes,5,opt,name=clob_pair_config,json=clobPairConfig,proto3" json:"clob_pair_config"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +93,13 @@ func (m *GenesisState) GetEquityTierLimitConfig() EquityTierLimitConfiguration {
 	return EquityTierLimitConfiguration{}
 }
 
+func (m *GenesisState) GetClobPairConfig() ClobPairConfig {
+	if m != nil {
+		return m.ClobPairConfig
+	}
+	return ClobPairConfig{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dydxprotocol.clob.GenesisState")
 }
@@ -99,30 +107,31 @@ func init() {
 func init() { proto.RegisterFile("dydxprotocol/clob/genesis.proto", fileDescriptor_2de77065a6fbee92) }
 
 var fileDescriptor_2de77065a6fbee92 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4b, 0xf3, 0x30,
-	0x1c, 0xc6, 0xdb, 0x77, 0xe3, 0x05, 0x33, 0x2f, 0xd6, 0x89, 0x65, 0x42, 0x37, 0x05, 0x61, 0x20,
-	0xb6, 0x32, 0xc5, 0xb3, 0x6c, 0x88, 0x97, 0x1d, 0xc6, 0xf4, 0x24, 0x42, 0x49, 0xb3, 0xd8, 0xfd,
-	0x59, 0xd6, 0x6c, 0x69, 0x2a, 0xdb, 0x67, 0xf0, 0xe2, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0x22, 0xdb,
-	0x17, 0x91, 0xa6, 0x65, 0x6c, 0x34, 0xbb, 0x94, 0xf6, 0xe9, 0xef, 0x79, 0x9e, 0xe4, 0x9f, 0xa0,
-	0xfa, 0x60, 0x3e, 0x98, 0x4d, 0x04, 0x97, 0x9c, 0x70, 0xe6, 0x11, 0xc6, 0x03, 0x2f, 0xa4, 0x11,
-	0x8d, 0x21, 0x76, 0x95, 0x6a, 0x1d, 0x6d, 0x03, 0x6e, 0x0a, 0xd4, 0xaa, 0x21, 0x0f, 0xb9, 0x92,
-	0xbc, 0xf4, 0x2d, 0x03, 0x6b, 0x5e, 0x31, 0x29, 0x60, 0x9c, 0x8c, 0x7c, 0x81, 0x25, 0xf5, 0x19,
-	0x8c, 0x41, 0xfa, 0x84, 0x47, 0xef, 0x10, 0xe6, 0x86, 0xf3, 0xa2, 0x21, 0x7d, 0xf8, 0x13, 0x0c,
-	0x22, 0x47, 0x6e, 0x8a, 0x08, 0x9d, 0x26, 0x20, 0xe7, 0xbe, 0x04, 0x2a, 0x74, 0xa1, 0x57, 0x45,
-	0x07, 0x83, 0x69, 0x02, 0x03, 0x2c, 0x81, 0x47, 0xf1, 0x0e, 0x7c, 0xf1, 0x59, 0x42, 0x87, 0x4f,
-	0xd9, 0x6e, 0x9f, 0x25, 0x96, 0xd4, 0x7a, 0x40, 0x68, 0xb3, 0x84, 0xd8, 0x36, 0x1b, 0xa5, 0x66,
-	0xa5, 0x75, 0xe6, 0x16, 0x26, 0xe0, 0x76, 0x18, 0x0f, 0x7a, 0x18, 0x44, 0xbb, 0xbc, 0xf8, 0xa9,
-	0x1b, 0xfd, 0x03, 0x92, 0x7f, 0xc7, 0xd6, 0x1b, 0x3a, 0xd6, 0xf4, 0xd9, 0xff, 0x1a, 0x66, 0xb3,
-	0xd2, 0xba, 0xd4, 0x44, 0x75, 0xb7, 0xe8, 0x8e, 0x82, 0xf3, 0x50, 0x8b, 0x15, 0xfe, 0x58, 0x23,
-	0x74, 0xba, 0x67, 0xa6, 0x76, 0x49, 0x35, 0xb8, 0x9a, 0x86, 0x76, 0xea, 0xe8, 0x63, 0x49, 0xbb,
-	0x29, 0x9f, 0x25, 0x25, 0x42, 0xe5, 0xe6, 0x55, 0xd5, 0x40, 0x83, 0x58, 0x11, 0xb2, 0xf7, 0x0d,
-	0xdb, 0x2e, 0xab, 0x36, 0x4f, 0xd3, 0xf6, 0xa8, 0x2c, 0x2f, 0x40, 0xc5, 0xde, 0xba, 0x13, 0xaa,
-	0x63, 0xda, 0xbd, 0xc5, 0xca, 0x31, 0x97, 0x2b, 0xc7, 0xfc, 0x5d, 0x39, 0xe6, 0xd7, 0xda, 0x31,
-	0x96, 0x6b, 0xc7, 0xf8, 0x5e, 0x3b, 0xc6, 0xeb, 0x7d, 0x08, 0x72, 0x98, 0x04, 0x2e, 0xe1, 0xe3,
-	0xdd, 0x5b, 0xf6, 0x71, 0x77, 0x4d, 0x86, 0x18, 0x22, 0x6f, 0xa3, 0xcc, 0xb2, 0x33, 0x97, 0xf3,
-	0x09, 0x8d, 0x83, 0xff, 0x4a, 0xbe, 0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x44, 0xfb, 0x91, 0xc2,
-	0xe5, 0x02, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0xe2, 0x40,
+	0x1c, 0xc5, 0x93, 0xd5, 0x5d, 0xd8, 0x71, 0x59, 0xda, 0xd4, 0xd2, 0x60, 0x21, 0x6a, 0xa1, 0x20,
+	0x94, 0x26, 0xc5, 0x96, 0x9e, 0x8b, 0x52, 0x7a, 0xf1, 0x60, 0x6d, 0x4f, 0xa5, 0x10, 0x92, 0x71,
+	0x1a, 0xff, 0x18, 0x33, 0x3a, 0x99, 0x14, 0xfd, 0x16, 0xfd, 0x58, 0x1e, 0x3d, 0xf6, 0x24, 0x45,
+	0xbf, 0x48, 0xc9, 0x24, 0x88, 0x69, 0x46, 0x2f, 0x21, 0x79, 0xf9, 0xbd, 0xf7, 0xc2, 0xcb, 0xa0,
+	0x6a, 0x7f, 0xd6, 0x9f, 0x8e, 0x19, 0xe5, 0x14, 0x53, 0xdf, 0xc2, 0x3e, 0x75, 0x2d, 0x8f, 0x04,
+	0x24, 0x84, 0xd0, 0x14, 0xaa, 0x76, 0xb8, 0x0d, 0x98, 0x31, 0x50, 0x29, 0x7b, 0xd4, 0xa3, 0x42,
+	0xb2, 0xe2, 0xbb, 0x04, 0xac, 0x58, 0xf9, 0x24, 0xd7, 0xa7, 0x78, 0x68, 0x33, 0x87, 0x13, 0xdb,
+	0x87, 0x11, 0x70, 0x1b, 0xd3, 0xe0, 0x0d, 0xbc, 0xd4, 0x50, 0xcf, 0x1b, 0xe2, 0x8b, 0x3d, 0x76,
+	0x80, 0xa5, 0x48, 0x63, 0x0f, 0x92, 0x0d, 0xbb, 0xca, 0x93, 0x64, 0x12, 0x01, 0x9f, 0xd9, 0x1c,
+	0x08, 0x93, 0xd5, 0x5f, 0xe4, 0x1d, 0x3e, 0x4c, 0x22, 0xe8, 0x3b, 0x1c, 0x68, 0x10, 0x66, 0xe0,
+	0xb3, 0x65, 0x01, 0xfd, 0x7b, 0x48, 0x76, 0x79, 0xe2, 0x0e, 0x27, 0xda, 0x1d, 0x42, 0x9b, 0x2f,
+	0x09, 0x75, 0xb5, 0x56, 0x68, 0x94, 0x9a, 0xa7, 0x66, 0x6e, 0x2b, 0xb3, 0xed, 0x53, 0xb7, 0xeb,
+	0x00, 0x6b, 0x15, 0xe7, 0xcb, 0xaa, 0xd2, 0xfb, 0x8b, 0xd3, 0xe7, 0x50, 0x7b, 0x45, 0x47, 0x92,
+	0x3e, 0xfd, 0x57, 0x4d, 0x6d, 0x94, 0x9a, 0xe7, 0x92, 0xa8, 0xce, 0x16, 0xdd, 0x16, 0x70, 0x1a,
+	0xaa, 0xf9, 0xb9, 0x37, 0xda, 0x10, 0x9d, 0xec, 0x58, 0x5f, 0x2f, 0x88, 0x06, 0x53, 0xd2, 0xd0,
+	0x8a, 0x1d, 0x3d, 0x87, 0x93, 0x4e, 0xcc, 0x27, 0x49, 0x11, 0x13, 0xb9, 0x69, 0x55, 0xd9, 0x95,
+	0x20, 0x5a, 0x80, 0xf4, 0x5d, 0x63, 0xeb, 0x45, 0xd1, 0x66, 0x49, 0xda, 0xee, 0x85, 0xe5, 0x19,
+	0x08, 0xdb, 0x59, 0x77, 0x4c, 0x64, 0x8c, 0xf6, 0x88, 0x0e, 0x7e, 0x1e, 0x03, 0xfd, 0xb7, 0xe8,
+	0xa9, 0xef, 0xf9, 0x05, 0x99, 0xcd, 0xfe, 0xe3, 0xac, 0xda, 0x9d, 0xaf, 0x0c, 0x75, 0xb1, 0x32,
+	0xd4, 0xaf, 0x95, 0xa1, 0x7e, 0xac, 0x0d, 0x65, 0xb1, 0x36, 0x94, 0xcf, 0xb5, 0xa1, 0xbc, 0xdc,
+	0x7a, 0xc0, 0x07, 0x91, 0x6b, 0x62, 0x3a, 0xca, 0x1e, 0xf1, 0xf7, 0x9b, 0x4b, 0x3c, 0x70, 0x20,
+	0xb0, 0x36, 0xca, 0x34, 0x39, 0x46, 0x7c, 0x36, 0x26, 0xa1, 0xfb, 0x47, 0xc8, 0xd7, 0xdf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x24, 0x5f, 0xcd, 0x75, 0x62, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +154,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ClobPairConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -221,6 +240,8 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.EquityTierLimitConfig.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ClobPairConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -392,6 +413,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPairConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -510,6 +510,21 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("not a valid Limit"),
 		},
+		"unknown fee rounding mode is invalid": {
+			genState: &types.GenesisState{
+				LiquidationsConfig: types.LiquidationsConfig{
+					MaxLiquidationFeePpm:  lib.OneMillion,
+					FillablePriceConfig:   constants.FillablePriceConfig_Default,
+					PositionBlockLimits:   constants.PositionBlockLimits_Default,
+					SubaccountBlockLimits: constants.SubaccountBlockLimits_Default,
+				},
+				ClobPairConfig: types.ClobPairConfig{
+					FeeRoundingMode: types.ClobPairConfig_RoundingMode(4),
+				},
+			},
+			expectedError: errors.New(
+				"4 is not a valid FeeRoundingMode: Proposed ClobPairConfig is invalid"),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -42,6 +42,9 @@ const (
 	// BlockRateLimitConfigKey is the key to retrieve the block rate limit configuration.
 	BlockRateLimitConfigKey = "RateLimCfg"
 
+	// ClobPairConfigKey is the key to retrieve the ClobPair config.
+	ClobPairConfigKey = "ClobPairCfg"
+
 	// ClobPairKeyPrefix is the prefix to retrieve all ClobPair
 	ClobPairKeyPrefix = "Clob:"
 
@@ -75,6 +78,14 @@ const (
 
 	// NextClobPairIDKey is the key to retrieve the next ClobPair ID to be used.
 	NextClobPairIDKey = "NextClobPairID"
+
+	// SubaccountLastLiquidationBlockKeyPrefix is the prefix to retrieve the block height at which
+	// a subaccount was last liquidated.
+	SubaccountLastLiquidationBlockKeyPrefix = "SaLastLiqBlk:"
+
+	// StaleMarketPricePausedClobPairKeyPrefix is the prefix to retrieve the ids of ClobPairs that were
+	// paused in EndBlocker because the oracle price of their market went stale.
+	StaleMarketPricePausedClobPairKeyPrefix = "StalePaused:"
 )
 
 // Memstore
@@ -103,6 +114,11 @@ const (
 	// StatefulOrderCountPrefix is the key to retrieve the stateful order count. The stateful order count
 	// represents the number of stateful orders stored in state.
 	StatefulOrderCountPrefix = "NumSO:"
+
+	// StatefulOrderSubaccountIndexKeyPrefix is the key prefix to retrieve the IDs of all stateful orders
+	// stored in state for a subaccount. The full format for these keys is
+	// <StatefulOrderSubaccountIndexKeyPrefix><subaccount_id>:<order_id>.
+	StatefulOrderSubaccountIndexKeyPrefix = "SOSa:"
 )
 
 // Transient Store
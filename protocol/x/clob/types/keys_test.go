@@ -25,6 +25,7 @@ func TestStateKeys(t *testing.T) {
 	require.Equal(t, "Clob:", types.ClobPairKeyPrefix)
 	require.Equal(t, "Fill:", types.OrderAmountFilledKeyPrefix)
 	require.Equal(t, "ExpHt:", types.LegacyBlockHeightToPotentiallyPrunableOrdersPrefix)
+	require.Equal(t, "SaLastLiqBlk:", types.SubaccountLastLiquidationBlockKeyPrefix)
 }
 
 func TestStoreAndMemstoreKeys(t *testing.T) {
@@ -37,6 +37,15 @@ type LiquidationsConfig struct {
 	// Config about how the fillable-price spread from the oracle price
 	// increases based on the adjusted bankruptcy rating of the subaccount.
 	FillablePriceConfig FillablePriceConfig `protobuf:"bytes,4,opt,name=fillable_price_config,json=fillablePriceConfig,proto3" json:"fillable_price_config"`
+	// The minimum number of blocks that must pass after a subaccount is
+	// liquidated before it can be liquidated again. A subaccount liquidated in
+	// block N cannot be liquidated again until block N + cooldown. Deleveraging
+	// is not affected. A value of 0 disables the cooldown.
+	SubaccountLiquidationCooldownBlocks uint32 `protobuf:"varint,5,opt,name=subaccount_liquidation_cooldown_blocks,json=subaccountLiquidationCooldownBlocks,proto3" json:"subaccount_liquidation_cooldown_blocks,omitempty"`
+	// The maximum number of offsetting subaccount fills in a single
+	// deleveraging operation. Deleveraging operations with more fills are
+	// rejected. A value of 0 disables the limit.
+	MaxDeleveragingFillsPerOperation uint32 `protobuf:"varint,6,opt,name=max_deleveraging_fills_per_operation,json=maxDeleveragingFillsPerOperation,proto3" json:"max_deleveraging_fills_per_operation,omitempty"`
 }
 
 func (m *LiquidationsConfig) Reset()         { *m = LiquidationsConfig{} }
@@ -100,6 +109,20 @@ func (m *LiquidationsConfig) GetFillablePriceConfig() FillablePriceConfig {
 	return FillablePriceConfig{}
 }
 
+func (m *LiquidationsConfig) GetSubaccountLiquidationCooldownBlocks() uint32 {
+	if m != nil {
+		return m.SubaccountLiquidationCooldownBlocks
+	}
+	return 0
+}
+
+func (m *LiquidationsConfig) GetMaxDeleveragingFillsPerOperation() uint32 {
+	if m != nil {
+		return m.MaxDeleveragingFillsPerOperation
+	}
+	return 0
+}
+
 // PositionBlockLimits stores all configurable fields related to limits
 // around how much of a single position can be liquidated within a single block.
 type PositionBlockLimits struct {
@@ -287,41 +310,45 @@ func init() {
 }
 
 var fileDescriptor_d11e0d49099a14b4 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x10, 0x71, 0x58, 0xc4, 0x01, 0xa7, 0x51, 0x02, 0x08, 0x37, 0x04, 0xa9, 0x8a,
-	0x84, 0x48, 0x24, 0x3e, 0x7a, 0x40, 0xe2, 0x40, 0x90, 0x8a, 0x90, 0x52, 0xe4, 0x06, 0x4e, 0x5c,
-	0x96, 0xf5, 0x7a, 0x93, 0x2e, 0xdd, 0xaf, 0x7a, 0xd7, 0xc8, 0x79, 0x09, 0xc4, 0x43, 0x70, 0xe4,
-	0x41, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x43, 0x70, 0xad, 0x76, 0xed, 0x38, 0xae, 0xe2, 0x9e,
-	0x6c, 0xed, 0xfc, 0xf6, 0x3f, 0x33, 0xff, 0x99, 0x05, 0x4f, 0xe3, 0x65, 0x9c, 0xa9, 0x44, 0x1a,
-	0x89, 0x25, 0x1b, 0x63, 0x26, 0xa3, 0x31, 0xa3, 0xe7, 0x29, 0x8d, 0x91, 0xa1, 0x52, 0x68, 0x88,
-	0xa5, 0x98, 0xd3, 0xc5, 0xc8, 0x11, 0xfe, 0xbd, 0x2a, 0x3c, 0xb2, 0xf0, 0x83, 0xbd, 0x85, 0x5c,
-	0x48, 0x77, 0x34, 0xb6, 0x7f, 0x39, 0x38, 0xf8, 0xdf, 0x04, 0xfe, 0xb4, 0x22, 0xf3, 0xce, 0xa9,
-	0xf8, 0xaf, 0x40, 0x97, 0xa3, 0x0c, 0x56, 0x12, 0xc0, 0x39, 0x21, 0x50, 0x29, 0xde, 0xf3, 0xfa,
-	0xde, 0xf0, 0xee, 0x6c, 0x8f, 0xa3, 0xac, 0x72, 0xef, 0x88, 0x90, 0x50, 0x71, 0xff, 0x2b, 0xe8,
-	0x28, 0xa9, 0xa9, 0xe3, 0x23, 0x26, 0xf1, 0x19, 0x64, 0x94, 0x53, 0xa3, 0x7b, 0xcd, 0xbe, 0x37,
-	0xbc, 0xf3, 0xfc, 0x60, 0xb4, 0x53, 0xd6, 0x28, 0x2c, 0xf8, 0x89, 0xc5, 0xa7, 0x8e, 0x9e, 0xb4,
-	0x2e, 0xfe, 0xee, 0x37, 0x66, 0x6d, 0xb5, 0x1b, 0xf2, 0xe7, 0xa0, 0xab, 0xd3, 0x08, 0x61, 0x2c,
-	0x53, 0x61, 0xae, 0xe7, 0xb8, 0xe5, 0x72, 0x0c, 0x6b, 0x72, 0x7c, 0x2a, 0x6f, 0xec, 0x66, 0xe9,
-	0xe8, 0xba, 0xa0, 0xed, 0x64, 0x4e, 0x19, 0x43, 0x11, 0x23, 0x50, 0x25, 0x14, 0x93, 0xc2, 0xdf,
-	0x5e, 0xeb, 0xc6, 0x4e, 0x8e, 0x0a, 0x3e, 0xb4, 0x78, 0xee, 0xe3, 0xa6, 0x93, 0xf9, 0x6e, 0x68,
-	0xf0, 0xdb, 0x03, 0xed, 0x9a, 0xe6, 0xfd, 0xf7, 0xa0, 0xcf, 0xa9, 0x80, 0xa5, 0x8f, 0x42, 0xda,
-	0x0f, 0x62, 0xe5, 0x30, 0x48, 0xec, 0x66, 0xd0, 0x9a, 0x3d, 0xe2, 0x54, 0x6c, 0x14, 0x3e, 0x16,
-	0xd4, 0xb4, 0x84, 0xfc, 0x29, 0x78, 0x62, 0x67, 0x58, 0x0a, 0x29, 0x99, 0xb8, 0xef, 0x56, 0xc7,
-	0xcd, 0xb3, 0xe9, 0xe6, 0xb9, 0xcf, 0x51, 0xb6, 0xd1, 0x0a, 0x73, 0x70, 0x2b, 0x15, 0x2a, 0x3e,
-	0xf8, 0xe1, 0x81, 0x4e, 0xad, 0x8f, 0xfe, 0x61, 0xbe, 0x2b, 0x37, 0xd7, 0xd9, 0xe1, 0x28, 0xab,
-	0xa9, 0xef, 0x0d, 0x78, 0x68, 0xef, 0x9d, 0xa7, 0x48, 0x98, 0x94, 0x6b, 0x48, 0x85, 0x4e, 0x13,
-	0x24, 0x30, 0x81, 0x4c, 0x6a, 0xe3, 0xea, 0x6a, 0xcd, 0x7a, 0x1c, 0x65, 0x27, 0x05, 0xf1, 0x61,
-	0x03, 0x4c, 0xa5, 0x36, 0x83, 0x5f, 0x1e, 0x68, 0xd7, 0x58, 0xee, 0xbf, 0x06, 0xf7, 0x23, 0x24,
-	0xce, 0x92, 0x54, 0x19, 0xbc, 0x84, 0x28, 0xfe, 0x96, 0x6a, 0xc3, 0x89, 0x30, 0x95, 0xe5, 0xed,
-	0x6e, 0x81, 0xb7, 0x65, 0xdc, 0xee, 0xef, 0x09, 0x38, 0xd0, 0x2a, 0x21, 0x28, 0x86, 0x46, 0x42,
-	0x8e, 0xa8, 0x30, 0x44, 0xb8, 0x8a, 0x38, 0x4a, 0x16, 0x54, 0xc0, 0xc4, 0x2e, 0x7b, 0xc5, 0xb5,
-	0xc7, 0x39, 0xfd, 0x59, 0x1e, 0x6f, 0xd9, 0x63, 0x87, 0xce, 0x2c, 0x19, 0x2a, 0x3e, 0x09, 0x2f,
-	0x56, 0x81, 0x77, 0xb9, 0x0a, 0xbc, 0x7f, 0xab, 0xc0, 0xfb, 0xb9, 0x0e, 0x1a, 0x97, 0xeb, 0xa0,
-	0xf1, 0x67, 0x1d, 0x34, 0xbe, 0x1c, 0x2e, 0xa8, 0x39, 0x4d, 0xa3, 0x11, 0x96, 0x7c, 0x7c, 0xed,
-	0x6d, 0x7f, 0x7f, 0xf9, 0x0c, 0x9f, 0x22, 0x2a, 0xc6, 0xe5, 0x49, 0x96, 0xbf, 0x77, 0xb3, 0x54,
-	0x44, 0x47, 0xb7, 0xdd, 0xf1, 0x8b, 0xab, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x89, 0xd8, 0x36,
-	0x11, 0x04, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x51, 0x76, 0x30, 0xe2, 0x40, 0xb6, 0x6a, 0x05, 0x44, 0x56, 0x3a, 0x34, 0x4d,
+	0x42, 0xb4, 0x12, 0x2f, 0x3b, 0x20, 0x71, 0x60, 0x43, 0x43, 0x48, 0xdd, 0xc8, 0x3a, 0x4e, 0x5c,
+	0x8c, 0xe3, 0xb8, 0x99, 0x99, 0xdf, 0x16, 0x3b, 0x23, 0xfb, 0x12, 0x88, 0x0f, 0xc0, 0x91, 0x23,
+	0x1f, 0x64, 0xc7, 0x1d, 0x39, 0x21, 0xb4, 0x7e, 0x11, 0x64, 0x27, 0x4d, 0x33, 0x35, 0x3b, 0x25,
+	0xb2, 0x7f, 0xcf, 0xff, 0xf9, 0x3f, 0x2f, 0x09, 0x78, 0x1a, 0x9f, 0xc7, 0xb9, 0x4a, 0xa5, 0x91,
+	0x58, 0xb2, 0x21, 0x66, 0x32, 0x1a, 0x32, 0x7a, 0x9a, 0xd1, 0x18, 0x19, 0x2a, 0x85, 0x86, 0x58,
+	0x8a, 0x09, 0x4d, 0x06, 0x8e, 0xf0, 0xef, 0xd5, 0xe1, 0x81, 0x85, 0x1f, 0xac, 0x26, 0x32, 0x91,
+	0xee, 0x68, 0x68, 0xdf, 0x0a, 0xb0, 0xff, 0xb3, 0x0d, 0xfc, 0x51, 0x4d, 0x66, 0xd7, 0xa9, 0xf8,
+	0xaf, 0xc0, 0x1a, 0x47, 0x39, 0xac, 0x25, 0x80, 0x13, 0x42, 0xa0, 0x52, 0xbc, 0xeb, 0xf5, 0xbc,
+	0xad, 0xbb, 0xe3, 0x55, 0x8e, 0xf2, 0x5a, 0xdc, 0x1e, 0x21, 0xa1, 0xe2, 0xfe, 0x17, 0xd0, 0x51,
+	0x52, 0x53, 0xc7, 0x47, 0x4c, 0xe2, 0x13, 0xc8, 0x28, 0xa7, 0x46, 0x77, 0x97, 0x7a, 0xde, 0xd6,
+	0x9d, 0xe7, 0x9b, 0x83, 0x05, 0x5b, 0x83, 0xb0, 0xe4, 0x77, 0x2c, 0x3e, 0x72, 0xf4, 0x4e, 0xfb,
+	0xe2, 0xef, 0x7a, 0x6b, 0xbc, 0xa2, 0x16, 0xaf, 0xfc, 0x09, 0x58, 0xd3, 0x59, 0x84, 0x30, 0x96,
+	0x99, 0x30, 0xd7, 0x73, 0xdc, 0x72, 0x39, 0xb6, 0x1a, 0x72, 0x1c, 0x55, 0x11, 0x8b, 0x59, 0x3a,
+	0xba, 0xe9, 0xd2, 0x56, 0x32, 0xa1, 0x8c, 0xa1, 0x88, 0x11, 0xa8, 0x52, 0x8a, 0x49, 0xd9, 0xdf,
+	0x6e, 0xfb, 0xc6, 0x4a, 0xf6, 0x4a, 0x3e, 0xb4, 0x78, 0xd1, 0xc7, 0x59, 0x25, 0x93, 0xc5, 0x2b,
+	0xff, 0x08, 0x6c, 0xd6, 0x2a, 0xa9, 0x77, 0x1a, 0x4b, 0xc9, 0x62, 0xf9, 0xad, 0x6c, 0xa1, 0xee,
+	0xde, 0x76, 0x1d, 0xdf, 0x98, 0xd3, 0xb5, 0xc6, 0xef, 0x96, 0xac, 0xf3, 0xae, 0xfd, 0x03, 0xf0,
+	0xc4, 0xce, 0x2d, 0x26, 0x8c, 0x9c, 0x91, 0x14, 0x25, 0x54, 0x24, 0xd0, 0x26, 0xd7, 0x50, 0x91,
+	0x14, 0x4a, 0x45, 0x52, 0x17, 0xd5, 0x5d, 0x76, 0x92, 0x3d, 0x8e, 0xf2, 0x77, 0x35, 0xd4, 0x56,
+	0xa0, 0x43, 0x92, 0x7e, 0x9c, 0x71, 0xfd, 0xdf, 0x1e, 0x58, 0x69, 0x98, 0x90, 0xff, 0x1e, 0xf4,
+	0x38, 0x15, 0xb0, 0x1a, 0xb6, 0x90, 0xf6, 0x81, 0x58, 0x55, 0x07, 0x89, 0xdd, 0xa2, 0xb4, 0xc7,
+	0x8f, 0x38, 0x15, 0x33, 0x85, 0x83, 0x92, 0x1a, 0x55, 0x90, 0x3f, 0x02, 0x1b, 0xd6, 0x70, 0x25,
+	0xa4, 0x64, 0xea, 0x9e, 0x73, 0x1d, 0xb7, 0x74, 0x4b, 0xce, 0xef, 0x3a, 0x47, 0xf9, 0x4c, 0x2b,
+	0x2c, 0xc0, 0xb9, 0x54, 0xa8, 0x78, 0xff, 0xbb, 0x07, 0x3a, 0x8d, 0xc3, 0xf6, 0xb7, 0x8b, 0x85,
+	0xbe, 0xd9, 0x67, 0x87, 0xa3, 0xbc, 0xc1, 0xdf, 0x1b, 0xf0, 0xd0, 0xc6, 0x9d, 0x66, 0x48, 0x98,
+	0x8c, 0x6b, 0x48, 0x85, 0xce, 0x52, 0x24, 0x30, 0x81, 0x4c, 0x6a, 0xe3, 0x7c, 0xb5, 0xc7, 0x5d,
+	0x8e, 0xf2, 0xc3, 0x92, 0xf8, 0x30, 0x03, 0x46, 0x52, 0x9b, 0xfe, 0x2f, 0x0f, 0xac, 0x34, 0xec,
+	0x85, 0xff, 0x1a, 0xdc, 0x8f, 0x90, 0x38, 0x49, 0x33, 0x65, 0xf0, 0x39, 0x44, 0xf1, 0xd7, 0x4c,
+	0x1b, 0x4e, 0x84, 0xa9, 0x7d, 0x61, 0x6b, 0x73, 0xe0, 0x6d, 0x75, 0x6f, 0x3f, 0xb2, 0x43, 0xb0,
+	0xa9, 0x55, 0x4a, 0x50, 0x0c, 0x8d, 0x84, 0x1c, 0x51, 0x61, 0x88, 0x70, 0x8e, 0x38, 0x4a, 0x13,
+	0x2a, 0xa0, 0x1b, 0x5d, 0xad, 0x6b, 0x8f, 0x0b, 0xfa, 0x93, 0xdc, 0x9f, 0xb3, 0xfb, 0x0e, 0x1d,
+	0x5b, 0x32, 0x54, 0x7c, 0x27, 0xbc, 0xb8, 0x0a, 0xbc, 0xcb, 0xab, 0xc0, 0xfb, 0x77, 0x15, 0x78,
+	0x3f, 0xa6, 0x41, 0xeb, 0x72, 0x1a, 0xb4, 0xfe, 0x4c, 0x83, 0xd6, 0xe7, 0xed, 0x84, 0x9a, 0xe3,
+	0x2c, 0x1a, 0x60, 0xc9, 0x87, 0xd7, 0x7e, 0x40, 0x67, 0x2f, 0x9f, 0xe1, 0x63, 0x44, 0xc5, 0xb0,
+	0x3a, 0xc9, 0x8b, 0x9f, 0x92, 0x39, 0x57, 0x44, 0x47, 0xcb, 0xee, 0xf8, 0xc5, 0xff, 0x01, 0x00,
+	0x90, 0x2d, 0xeb, 0xd8, 0xb6, 0x04, 0x00, 0x00,
 }
 
 func (m *LiquidationsConfig) Marshal() (dAtA []byte, err error) {
@@ -344,6 +371,16 @@ func (m *LiquidationsConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDeleveragingFillsPerOperation != 0 {
+		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(m.MaxDeleveragingFillsPerOperation))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubaccountLiquidationCooldownBlocks != 0 {
+		i = encodeVarintLiquidationsConfig(dAtA, i, uint64(m.SubaccountLiquidationCooldownBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.FillablePriceConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -507,6 +544,12 @@ func (m *LiquidationsConfig) Size() (n int) {
 	n += 1 + l + sovLiquidationsConfig(uint64(l))
 	l = m.FillablePriceConfig.Size()
 	n += 1 + l + sovLiquidationsConfig(uint64(l))
+	if m.SubaccountLiquidationCooldownBlocks != 0 {
+		n += 1 + sovLiquidationsConfig(uint64(m.SubaccountLiquidationCooldownBlocks))
+	}
+	if m.MaxDeleveragingFillsPerOperation != 0 {
+		n += 1 + sovLiquidationsConfig(uint64(m.MaxDeleveragingFillsPerOperation))
+	}
 	return n
 }
 
@@ -708,6 +751,44 @@ func (m *LiquidationsConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountLiquidationCooldownBlocks", wireType)
+			}
+			m.SubaccountLiquidationCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubaccountLiquidationCooldownBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeleveragingFillsPerOperation", wireType)
+			}
+			m.MaxDeleveragingFillsPerOperation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationsConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeleveragingFillsPerOperation |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidationsConfig(dAtA[iNdEx:])
@@ -104,6 +104,9 @@ type MemClobKeeper interface {
 		ctx sdk.Context,
 		orderbookFills []StreamOrderbookFill,
 	)
+	GetClobPairConfig(
+		ctx sdk.Context,
+	) ClobPairConfig
 	AddOrderToOrderbookSubaccountUpdatesCheck(
 		ctx sdk.Context,
 		subaccountId satypes.SubaccountId,
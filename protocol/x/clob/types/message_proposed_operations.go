@@ -64,6 +64,7 @@ func (msg *MsgProposedOperations) ValidateBasic() error {
 // Validations differ based on operation types. We are able to supply a TxDecoder and AnteHandler
 // to this function. These are needed to decode OperationRaw tx bytes and to validate that
 // the operations' transactions were signed correctly.
+// Returned errors are `OperationError`s which identify the invalid operation.
 func ValidateAndTransformRawOperations(
 	ctx sdk.Context,
 	rawOperations []OperationRaw,
@@ -77,14 +78,14 @@ func ValidateAndTransformRawOperations(
 	}
 
 	// Go through the operations one by one to validate them, updating state as necessary.
-	for _, rawOperation := range rawOperations {
+	for i, rawOperation := range rawOperations {
 		var err error
 		operation := &InternalOperation{}
 		switch rawOperation.Operation.(type) {
 		case *OperationRaw_Match:
 			match := rawOperation.GetMatch()
 			if err = validator.validateMatchOperation(match); err != nil {
-				return nil, err
+				return nil, NewOperationError(i, err)
 			}
 			operation.Operation = &InternalOperation_Match{
 				Match: match,
@@ -97,33 +98,36 @@ func ValidateAndTransformRawOperations(
 				anteHandler,
 			)
 			if err != nil {
-				return nil, err
+				return nil, NewOperationError(i, err)
 			}
 			if err = validator.validateShortTermOrderPlacementOperation(
 				operation.GetShortTermOrderPlacement(),
 			); err != nil {
-				return nil, err
+				return nil, NewOperationError(i, err)
 			}
 		case *OperationRaw_OrderRemoval:
 			orderRemoval := rawOperation.GetOrderRemoval()
 			if err := orderRemoval.OrderId.Validate(); err != nil {
-				return nil, err
+				return nil, NewOperationError(i, err)
 			}
 			// Order removal reason fully filled is only used by indexer and should not be
 			// placed in the operations queue.
 			if orderRemoval.RemovalReason == OrderRemoval_REMOVAL_REASON_UNSPECIFIED ||
 				orderRemoval.RemovalReason == OrderRemoval_REMOVAL_REASON_FULLY_FILLED {
-				return nil, errorsmod.Wrapf(
+				return nil, NewOperationError(i, errorsmod.Wrapf(
 					ErrInvalidOrderRemoval,
 					"Invalid order removal reason: %+v",
 					orderRemoval.RemovalReason,
-				)
+				))
 			}
 			operation.Operation = &InternalOperation_OrderRemoval{
 				OrderRemoval: rawOperation.GetOrderRemoval(),
 			}
 		default:
-			return nil, fmt.Errorf("operation queue type not implemented yet for raw operation %v", rawOperation)
+			return nil, NewOperationError(
+				i,
+				fmt.Errorf("operation queue type not implemented yet for raw operation %v", rawOperation),
+			)
 		}
 
 		operations = append(operations, *operation)
@@ -224,6 +228,7 @@ func (validator *operationsQueueValidator) validateShortTermOrderPlacementOperat
 //   - For all fills, maker order ids must be previously placed in an operation.
 //   - Taker order id must be previously placed in an operation.
 //   - There are no duplicate MakerOrderIds in fills.
+//   - For all fills, maker order ids are for the same ClobPair as the taker order id.
 func (validator *operationsQueueValidator) validateMatchOrdersOperation(
 	matchOrders *MatchOrders,
 ) error {
@@ -260,6 +265,16 @@ func (validator *operationsQueueValidator) validateMatchOrdersOperation(
 			)
 		}
 
+		// Maker order must be for the same ClobPair as the taker order.
+		if makerOrderId.GetClobPairId() != takerOrderId.GetClobPairId() {
+			return errorsmod.Wrapf(
+				ErrMatchOrderClobPairIdMismatch,
+				"maker: %+v, taker %+v",
+				makerOrderId,
+				takerOrderId,
+			)
+		}
+
 		// Maker order id must be previously placed in an operation.
 		if err := validator.verifyOrderPlacementInOperationsQueue(
 			makerOrderId,
@@ -375,6 +375,50 @@ func TestValidateAndTransformRawOperations(t *testing.T) {
 			},
 			expectedError: errors.New("duplicate Maker OrderId in a MatchOrder's fills"),
 		},
+		"Stateless match order validation: maker order on a different ClobPair than taker": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id3_Clob1_Sell1ETH_Price3000),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Carl_Num1_Id0_Clob0_Buy1BTC_Price50000),
+				clobtestutils.NewMatchOperationRaw(
+					&constants.Order_Carl_Num1_Id0_Clob0_Buy1BTC_Price50000,
+					[]types.MakerFill{
+						{
+							FillAmount:   1,
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000.GetOrderId(),
+						},
+						{
+							FillAmount:   1,
+							MakerOrderId: constants.Order_Dave_Num0_Id3_Clob1_Sell1ETH_Price3000.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: types.ErrMatchOrderClobPairIdMismatch,
+		},
+		"Stateless match order validation: multiple maker orders on the same ClobPair as taker": {
+			operations: []types.OperationRaw{
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(
+					constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11,
+				),
+				clobtestutils.NewShortTermOrderPlacementOperationRaw(constants.Order_Carl_Num1_Id0_Clob0_Buy1BTC_Price50000),
+				clobtestutils.NewMatchOperationRaw(
+					&constants.Order_Carl_Num1_Id0_Clob0_Buy1BTC_Price50000,
+					[]types.MakerFill{
+						{
+							FillAmount:   1,
+							MakerOrderId: constants.Order_Dave_Num0_Id0_Clob0_Sell1BTC_Price50000.GetOrderId(),
+						},
+						{
+							FillAmount:   1,
+							MakerOrderId: constants.Order_Dave_Num0_Id1_Clob0_Sell025BTC_Price50000_GTB11.GetOrderId(),
+						},
+					},
+				),
+			},
+			expectedError: nil,
+		},
 
 		// tests for Perpetual Liquidations
 		"Stateless liquidation validation: fails if total fill amount exceeds order size": {
@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
+)
+
+var _ sdk.Msg = &MsgReduceOrder{}
+
+// NewMsgReduceOrder constructs a MsgReduceOrder from an `OrderId` and the new size of the order.
+func NewMsgReduceOrder(orderId OrderId, newQuantums uint64) *MsgReduceOrder {
+	return &MsgReduceOrder{
+		OrderId:     orderId,
+		NewQuantums: newQuantums,
+	}
+}
+
+// ValidateBasic performs stateless validation on a MsgReduceOrder. Only Long-Term orders can be reduced
+// since they are the only stateful orders that rest on the orderbook once placed.
+func (msg *MsgReduceOrder) ValidateBasic() (err error) {
+	orderId := msg.GetOrderId()
+
+	defer func() {
+		if err != nil {
+			telemetry.IncrCounterWithLabels(
+				[]string{ModuleName, metrics.ReduceOrder, metrics.ValidateBasic, metrics.Error, metrics.Count},
+				1,
+				msg.OrderId.GetOrderIdLabels(),
+			)
+		}
+	}()
+
+	if err := orderId.Validate(); err != nil {
+		return err
+	}
+
+	if !orderId.IsLongTermOrder() {
+		return errorsmod.Wrapf(
+			ErrInvalidOrderFlag,
+			"only Long-Term orders can be reduced, orderId %+v",
+			orderId,
+		)
+	}
+
+	if msg.NewQuantums == 0 {
+		return errorsmod.Wrapf(ErrInvalidOrderQuantums, "new order size quantums cannot be 0")
+	}
+
+	return nil
+}
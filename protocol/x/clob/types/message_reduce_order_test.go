@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/sample"
+	satypes "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReduceOrder_ValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		msg MsgReduceOrder
+		err error
+	}{
+		"invalid subaccountId owner": {
+			msg: *NewMsgReduceOrder(OrderId{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  "invalid_owner",
+					Number: uint32(0),
+				},
+				OrderFlags: OrderIdFlags_LongTerm,
+			}, 10),
+			err: satypes.ErrInvalidSubaccountIdOwner,
+		},
+		"short term order": {
+			msg: *NewMsgReduceOrder(OrderId{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  sample.AccAddress(),
+					Number: uint32(0),
+				},
+				OrderFlags: OrderIdFlags_ShortTerm,
+			}, 10),
+			err: ErrInvalidOrderFlag,
+		},
+		"conditional order": {
+			msg: *NewMsgReduceOrder(OrderId{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  sample.AccAddress(),
+					Number: uint32(0),
+				},
+				OrderFlags: OrderIdFlags_Conditional,
+			}, 10),
+			err: ErrInvalidOrderFlag,
+		},
+		"long term order invalid 0 valued NewQuantums": {
+			msg: *NewMsgReduceOrder(OrderId{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  sample.AccAddress(),
+					Number: uint32(0),
+				},
+				OrderFlags: OrderIdFlags_LongTerm,
+			}, 0),
+			err: ErrInvalidOrderQuantums,
+		},
+		"long term order valid": {
+			msg: *NewMsgReduceOrder(OrderId{
+				SubaccountId: satypes.SubaccountId{
+					Owner:  sample.AccAddress(),
+					Number: uint32(0),
+				},
+				OrderFlags: OrderIdFlags_LongTerm,
+			}, 10),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
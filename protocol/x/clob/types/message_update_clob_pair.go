@@ -20,5 +20,5 @@ func (msg *MsgUpdateClobPair) ValidateBasic() error {
 			),
 		)
 	}
-	return msg.ClobPair.Validate()
+	return msg.ClobPair.ValidateUpdate()
 }
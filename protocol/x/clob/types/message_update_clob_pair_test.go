@@ -38,7 +38,7 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 				Metadata:         &types.ClobPair_PerpetualClobMetadata{},
 				StepBaseQuantums: 1,
 				SubticksPerTick:  1,
-				Status:           types.ClobPair_STATUS_PAUSED,
+				Status:           types.ClobPair_STATUS_UNSPECIFIED,
 			},
 			expectedErr: "has unsupported status",
 		},
@@ -107,6 +107,17 @@ func TestMsgUpdateClobPair_ValidateBasic(t *testing.T) {
 			},
 			expectedErr: "",
 		},
+		{
+			desc:      "Valid paused ClobPair",
+			authority: validAuthority,
+			clobPair: types.ClobPair{
+				Metadata:         &types.ClobPair_PerpetualClobMetadata{},
+				StepBaseQuantums: 1,
+				SubticksPerTick:  1,
+				Status:           types.ClobPair_STATUS_PAUSED,
+			},
+			expectedErr: "",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -256,40 +257,111 @@ func (*InternalOperation) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// RejectedOperation represents an operation in the proposed operations queue
+// that failed validation, causing the operations queue to be rejected.
+type RejectedOperation struct {
+	// The index of the rejected operation in the proposed operations queue.
+	OperationIndex uint32 `protobuf:"varint,1,opt,name=operation_index,json=operationIndex,proto3" json:"operation_index,omitempty"`
+	// The rejected operation.
+	Operation OperationRaw `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation"`
+	// The reason the operation was rejected.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RejectedOperation) Reset()         { *m = RejectedOperation{} }
+func (m *RejectedOperation) String() string { return proto.CompactTextString(m) }
+func (*RejectedOperation) ProtoMessage()    {}
+func (*RejectedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5906bab2b2e9b3cf, []int{2}
+}
+func (m *RejectedOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedOperation.Merge(m, src)
+}
+func (m *RejectedOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedOperation proto.InternalMessageInfo
+
+func (m *RejectedOperation) GetOperationIndex() uint32 {
+	if m != nil {
+		return m.OperationIndex
+	}
+	return 0
+}
+
+func (m *RejectedOperation) GetOperation() OperationRaw {
+	if m != nil {
+		return m.Operation
+	}
+	return OperationRaw{}
+}
+
+func (m *RejectedOperation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Operation)(nil), "dydxprotocol.clob.Operation")
 	proto.RegisterType((*InternalOperation)(nil), "dydxprotocol.clob.InternalOperation")
+	proto.RegisterType((*RejectedOperation)(nil), "dydxprotocol.clob.RejectedOperation")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/operation.proto", fileDescriptor_5906bab2b2e9b3cf) }
 
 var fileDescriptor_5906bab2b2e9b3cf = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcd, 0xaa, 0x9b, 0x40,
-	0x14, 0xd6, 0xa4, 0x2d, 0x64, 0xd2, 0x2e, 0xe2, 0xa6, 0x22, 0x8d, 0xf9, 0x59, 0x94, 0x6e, 0xaa,
-	0xd0, 0x86, 0x3e, 0x40, 0x02, 0xc5, 0x2c, 0x42, 0x82, 0xed, 0x2a, 0x1b, 0x19, 0xc7, 0x49, 0x14,
-	0x46, 0x47, 0xc6, 0x49, 0x30, 0x6f, 0xd1, 0xc7, 0xea, 0x32, 0xcb, 0x42, 0x37, 0x97, 0xe4, 0x11,
-	0xee, 0x0b, 0x5c, 0x1c, 0x35, 0x28, 0x9a, 0xbb, 0xb9, 0x9b, 0xbb, 0x9c, 0x73, 0xbe, 0x3f, 0x3e,
-	0xe6, 0x80, 0x89, 0x77, 0xf2, 0xd2, 0x98, 0x51, 0x4e, 0x11, 0x25, 0x26, 0x22, 0xd4, 0x35, 0x69,
-	0x8c, 0x19, 0xe4, 0x01, 0x8d, 0x0c, 0x31, 0x57, 0x06, 0x55, 0x88, 0x91, 0x41, 0xb4, 0x51, 0x93,
-	0x15, 0x42, 0x8e, 0x7c, 0x9c, 0xe4, 0x1c, 0x6d, 0xd8, 0x22, 0xcb, 0x3c, 0xcc, 0x8a, 0xf5, 0xe7,
-	0x3b, 0x6b, 0x87, 0xe1, 0x90, 0x1e, 0x21, 0x29, 0x65, 0xb4, 0x26, 0x8e, 0xa7, 0xf9, 0x6e, 0xfa,
-	0xd8, 0x01, 0xbd, 0x75, 0x19, 0x55, 0x99, 0x81, 0xb7, 0x22, 0x81, 0x2a, 0x8f, 0xe5, 0x2f, 0xfd,
-	0x6f, 0x9f, 0x8c, 0x46, 0x68, 0x63, 0x41, 0xa8, 0xbb, 0xca, 0x30, 0x96, 0x64, 0xe7, 0x60, 0xc5,
-	0x01, 0x5a, 0xe2, 0x53, 0xc6, 0x1d, 0x8e, 0x59, 0xe8, 0xe4, 0x11, 0x62, 0x02, 0x11, 0x0e, 0x71,
-	0xc4, 0xd5, 0x8e, 0x90, 0x1a, 0xb7, 0x48, 0xad, 0x92, 0xfd, 0x26, 0x83, 0xad, 0x33, 0x86, 0x25,
-	0xd9, 0x1f, 0x85, 0xca, 0x6f, 0xcc, 0x42, 0x31, 0xd9, 0x94, 0x12, 0xca, 0x0e, 0x0c, 0x1b, 0x06,
-	0x08, 0x46, 0x08, 0x13, 0x22, 0x72, 0xab, 0x5d, 0xe1, 0x31, 0x69, 0xf7, 0x58, 0x08, 0x64, 0x69,
-	0xa2, 0xd5, 0x4d, 0x16, 0x15, 0x19, 0x65, 0x0b, 0xb4, 0x98, 0x61, 0x9c, 0x06, 0x09, 0x0f, 0xa2,
-	0xbd, 0x93, 0x70, 0xc8, 0xf1, 0xee, 0x40, 0x72, 0x47, 0xf5, 0x8d, 0x30, 0xd1, 0x5a, 0x4c, 0x84,
-	0xd2, 0xd2, 0xb3, 0x24, 0x5b, 0xad, 0xf0, 0x7f, 0x15, 0x74, 0xb1, 0x9d, 0xf7, 0x41, 0xef, 0xf6,
-	0x25, 0xa6, 0xff, 0x3b, 0x60, 0xb0, 0x8c, 0x38, 0x66, 0x11, 0x24, 0xaf, 0xbe, 0xfd, 0xe7, 0x5b,
-	0xe9, 0xbe, 0xa4, 0x15, 0xe5, 0x27, 0xf8, 0x50, 0xfb, 0xb2, 0x45, 0xc9, 0xa3, 0x7b, 0x72, 0x76,
-	0x0e, 0xb3, 0x24, 0xfb, 0x3d, 0xad, 0xbc, 0x6b, 0xed, 0xce, 0x37, 0x7f, 0x2f, 0xba, 0x7c, 0xbe,
-	0xe8, 0xf2, 0xc3, 0x45, 0x97, 0xff, 0x5c, 0x75, 0xe9, 0x7c, 0xd5, 0xa5, 0x7f, 0x57, 0x5d, 0xda,
-	0xfe, 0xd8, 0x07, 0xdc, 0x3f, 0xb8, 0x06, 0xa2, 0xa1, 0x59, 0x3b, 0x8a, 0xe3, 0xec, 0x2b, 0xf2,
-	0x61, 0x10, 0x99, 0xb7, 0x49, 0x5a, 0x1c, 0xca, 0x29, 0xc6, 0x89, 0xfb, 0x4e, 0x8c, 0xbf, 0x3f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0xf7, 0xd2, 0xfe, 0x7e, 0xe8, 0x03, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xb5, 0xd3, 0x52, 0x29, 0x53, 0x0a, 0x8a, 0x85, 0xc0, 0xb2, 0xa8, 0xd3, 0x76, 0x01, 0x6c,
+	0xb0, 0x25, 0xa8, 0x38, 0x40, 0x22, 0xa1, 0x64, 0x51, 0x35, 0x1a, 0x58, 0x75, 0x63, 0x4d, 0xc6,
+	0xbf, 0x8e, 0xd1, 0xd8, 0x63, 0x8d, 0xa7, 0xc5, 0xbd, 0x05, 0x5b, 0x6e, 0xd4, 0x65, 0x97, 0x48,
+	0x48, 0x08, 0x25, 0x47, 0xe0, 0x02, 0xc8, 0xe3, 0x71, 0xea, 0xc8, 0x0e, 0x1b, 0x36, 0xdd, 0xcd,
+	0xbc, 0xff, 0xfe, 0x7b, 0x7f, 0x9e, 0xfe, 0xa0, 0xe3, 0xf0, 0x26, 0x2c, 0x32, 0xc1, 0x25, 0xa7,
+	0x9c, 0xf9, 0x94, 0xf1, 0xb9, 0xcf, 0x33, 0x10, 0x44, 0xc6, 0x3c, 0xf5, 0x14, 0x6e, 0x0d, 0x9a,
+	0x14, 0xaf, 0xa4, 0x38, 0xcf, 0x22, 0x1e, 0x71, 0x05, 0xf9, 0xe5, 0xa9, 0x22, 0x3a, 0xc3, 0xb6,
+	0x56, 0x42, 0x24, 0x5d, 0x40, 0xae, 0x09, 0x87, 0x1d, 0x66, 0x22, 0x04, 0xa1, 0xcb, 0xaf, 0xb6,
+	0x94, 0x03, 0x01, 0x09, 0xbf, 0x26, 0xac, 0x96, 0x71, 0xda, 0x3c, 0x59, 0x54, 0xb5, 0x93, 0x3f,
+	0x3d, 0xd4, 0x3f, 0xaf, 0x1f, 0x60, 0x9d, 0xa2, 0x47, 0x6a, 0x02, 0xdb, 0x3c, 0x32, 0xdf, 0xec,
+	0xbf, 0x7b, 0xe9, 0xb5, 0x9e, 0xe2, 0x8d, 0x19, 0x9f, 0x9f, 0x95, 0x9c, 0x89, 0x81, 0x2b, 0xb2,
+	0x15, 0x20, 0x27, 0x5f, 0x70, 0x21, 0x03, 0x09, 0x22, 0x09, 0xaa, 0x11, 0x32, 0x46, 0x28, 0x24,
+	0x90, 0x4a, 0xbb, 0xa7, 0xa4, 0x8e, 0x3a, 0xa4, 0xce, 0xf2, 0x68, 0x56, 0xd2, 0xce, 0xcb, 0x8e,
+	0x89, 0x81, 0x5f, 0x28, 0x95, 0xcf, 0x20, 0x12, 0x85, 0xcc, 0x6a, 0x09, 0xeb, 0x12, 0x1d, 0xb6,
+	0x0c, 0x28, 0x49, 0x29, 0x30, 0xa6, 0xe6, 0xb6, 0x77, 0x94, 0xc7, 0x71, 0xb7, 0xc7, 0x58, 0x31,
+	0x6b, 0x13, 0x67, 0xd3, 0x64, 0xdc, 0x90, 0xb1, 0x2e, 0x90, 0x93, 0x09, 0x80, 0x22, 0xce, 0x65,
+	0x9c, 0x46, 0x41, 0x2e, 0x89, 0x84, 0xcb, 0x2b, 0x56, 0x39, 0xda, 0xbb, 0xca, 0xc4, 0xe9, 0x30,
+	0x51, 0x4a, 0xd3, 0x70, 0x62, 0x60, 0xbb, 0xd1, 0xff, 0x49, 0xb7, 0xab, 0xea, 0x68, 0x1f, 0xf5,
+	0xd7, 0x8b, 0x72, 0xf2, 0xb3, 0x87, 0x06, 0xd3, 0x54, 0x82, 0x48, 0x09, 0x7b, 0xf0, 0xe9, 0xff,
+	0x3b, 0x95, 0x9d, 0xff, 0x49, 0xc5, 0xfa, 0x88, 0x0e, 0x36, 0x56, 0x56, 0x87, 0x3c, 0xdc, 0x26,
+	0x87, 0x2b, 0xda, 0xc4, 0xc0, 0x8f, 0x79, 0xe3, 0xbe, 0x99, 0xee, 0x77, 0x13, 0x0d, 0x30, 0x7c,
+	0x01, 0x2a, 0x21, 0xbc, 0x4f, 0xf7, 0x35, 0x7a, 0xba, 0xa6, 0x04, 0x71, 0x1a, 0x42, 0xa1, 0x72,
+	0x3e, 0xc0, 0x4f, 0xd6, 0xf0, 0xb4, 0x44, 0xad, 0x71, 0x43, 0x4b, 0xe7, 0xd7, 0x39, 0x4f, 0xcd,
+	0xc1, 0xe4, 0xeb, 0x68, 0xf7, 0xf6, 0xd7, 0xd0, 0xc0, 0xf7, 0x7d, 0xd6, 0x73, 0xb4, 0x27, 0x80,
+	0xe4, 0x7a, 0x37, 0xfb, 0x58, 0xdf, 0x46, 0xb3, 0xdb, 0xa5, 0x6b, 0xde, 0x2d, 0x5d, 0xf3, 0xf7,
+	0xd2, 0x35, 0xbf, 0xad, 0x5c, 0xe3, 0x6e, 0xe5, 0x1a, 0x3f, 0x56, 0xae, 0x71, 0xf1, 0x21, 0x8a,
+	0xe5, 0xe2, 0x6a, 0xee, 0x51, 0x9e, 0xf8, 0x1b, 0x1f, 0xf6, 0xfa, 0xf4, 0x2d, 0x5d, 0x90, 0x38,
+	0xf5, 0xd7, 0x48, 0xa1, 0x3f, 0xf1, 0x4d, 0x06, 0xf9, 0x7c, 0x4f, 0xc1, 0xef, 0xff, 0x06, 0x00,
+	0x00, 0xff, 0xff, 0x62, 0xba, 0x5c, 0x25, 0x9a, 0x04, 0x00, 0x00,
 }
 
 func (m *Operation) Marshal() (dAtA []byte, err error) {
@@ -524,6 +596,51 @@ func (m *InternalOperation_OrderRemoval) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *RejectedOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOperation(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Operation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOperation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OperationIndex != 0 {
+		i = encodeVarintOperation(dAtA, i, uint64(m.OperationIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOperation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOperation(v)
 	base := offset
@@ -655,6 +772,23 @@ func (m *InternalOperation_OrderRemoval) Size() (n int) {
 	}
 	return n
 }
+func (m *RejectedOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationIndex != 0 {
+		n += 1 + sovOperation(uint64(m.OperationIndex))
+	}
+	l = m.Operation.Size()
+	n += 1 + l + sovOperation(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOperation(uint64(l))
+	}
+	return n
+}
 
 func sovOperation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -1042,6 +1176,140 @@ func (m *InternalOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RejectedOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationIndex", wireType)
+			}
+			m.OperationIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOperation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOperation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -63,6 +63,10 @@ const (
 	// REMOVAL_REASON_FULLY_FILLED represents a removal of an order that
 	//  would lead to the subaccount violating isolated subaccount constraints.
 	OrderRemoval_REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS OrderRemoval_RemovalReason = 8
+	// REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION represents a removal of an
+	// order whose fill would open a new position for a subaccount with less
+	// than the minimum collateral required to open a position.
+	OrderRemoval_REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION OrderRemoval_RemovalReason = 9
 )
 
 var OrderRemoval_RemovalReason_name = map[int32]string{
@@ -75,6 +79,7 @@ var OrderRemoval_RemovalReason_name = map[int32]string{
 	6: "REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK",
 	7: "REMOVAL_REASON_FULLY_FILLED",
 	8: "REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS",
+	9: "REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION",
 }
 
 var OrderRemoval_RemovalReason_value = map[string]int32{
@@ -87,6 +92,7 @@ var OrderRemoval_RemovalReason_value = map[string]int32{
 	"REMOVAL_REASON_CONDITIONAL_IOC_WOULD_REST_ON_BOOK":        6,
 	"REMOVAL_REASON_FULLY_FILLED":                              7,
 	"REMOVAL_REASON_VIOLATES_ISOLATED_SUBACCOUNT_CONSTRAINTS":  8,
+	"REMOVAL_REASON_MIN_COLLATERAL_TO_OPEN_POSITION":           9,
 }
 
 func (x OrderRemoval_RemovalReason) String() string {
@@ -160,37 +166,38 @@ func init() {
 }
 
 var fileDescriptor_60fa12f781955c9f = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x4e, 0xdb, 0x4c,
-	0x14, 0xc5, 0x63, 0xc8, 0x07, 0x68, 0xbe, 0x82, 0xdc, 0x51, 0x17, 0x28, 0x55, 0x0d, 0x8d, 0x54,
-	0xc4, 0x06, 0xbb, 0xa5, 0xf4, 0x8f, 0x44, 0x37, 0x63, 0xcf, 0x58, 0x1a, 0x65, 0xf0, 0x44, 0x33,
-	0x76, 0x2a, 0xd8, 0x5c, 0x25, 0x71, 0x14, 0x22, 0x05, 0x06, 0x39, 0x29, 0x82, 0xb7, 0xe8, 0x9b,
-	0xf4, 0x35, 0x58, 0x22, 0x75, 0xd3, 0x55, 0x55, 0x25, 0x2f, 0x52, 0xd9, 0x8e, 0x2a, 0x12, 0x9a,
-	0xae, 0x3c, 0xf7, 0xde, 0xdf, 0x39, 0xe7, 0xca, 0xba, 0x68, 0x2f, 0xbd, 0x4d, 0x6f, 0xae, 0x32,
-	0x33, 0x36, 0x5d, 0x33, 0xf4, 0xba, 0x43, 0xd3, 0xf1, 0x4c, 0x96, 0xf6, 0x32, 0xc8, 0x7a, 0x17,
-	0xe6, 0xba, 0x3d, 0x1c, 0xb9, 0xc5, 0x10, 0x3f, 0x7d, 0xc8, 0xb9, 0x39, 0x57, 0x7b, 0xd6, 0x37,
-	0x7d, 0x53, 0xb4, 0xbc, 0xfc, 0x55, 0x82, 0xb5, 0x17, 0x4b, 0x0c, 0xcb, 0x71, 0xfd, 0x7b, 0x15,
-	0x3d, 0x91, 0x79, 0xad, 0x4a, 0x7f, 0x7c, 0x8c, 0x36, 0xca, 0xc0, 0x41, 0xba, 0x6d, 0xed, 0x5a,
-	0xfb, 0xff, 0x1f, 0xd6, 0xdc, 0x47, 0x59, 0x6e, 0x21, 0xe1, 0xa9, 0x5f, 0xbd, 0xfb, 0xb9, 0x53,
-	0x51, 0xeb, 0xa6, 0x2c, 0x71, 0x8c, 0xb6, 0x66, 0x7b, 0x42, 0xd6, 0x6b, 0x8f, 0xcc, 0xe5, 0xf6,
-	0xca, 0xae, 0xb5, 0xbf, 0x75, 0x78, 0xb0, 0xcc, 0x62, 0x96, 0xea, 0xce, 0xbe, 0xaa, 0x10, 0xa9,
-	0xcd, 0xec, 0x61, 0x59, 0xff, 0xb6, 0x8a, 0x36, 0xe7, 0x00, 0xec, 0xa0, 0x9a, 0x62, 0x27, 0xb2,
-	0x45, 0x04, 0x28, 0x46, 0xb4, 0x8c, 0x20, 0x89, 0x74, 0x93, 0x05, 0x3c, 0xe4, 0x8c, 0xda, 0x15,
-	0xbc, 0x87, 0xea, 0x8f, 0xe6, 0x94, 0xa9, 0x40, 0x0a, 0x41, 0x62, 0xa6, 0x88, 0xe0, 0x67, 0x8c,
-	0xda, 0xd6, 0x5f, 0x38, 0x1e, 0xb5, 0x88, 0xe0, 0x14, 0x14, 0xa3, 0x49, 0xc0, 0x40, 0x46, 0xe2,
-	0xd4, 0x5e, 0xc1, 0x47, 0xe8, 0xf5, 0x02, 0xd7, 0x94, 0x3a, 0x2e, 0xa6, 0xf0, 0x59, 0x26, 0x82,
-	0x42, 0xa0, 0xa4, 0xd6, 0x70, 0x42, 0x1a, 0x4c, 0x81, 0x54, 0x94, 0x29, 0x7b, 0x15, 0xbf, 0x42,
-	0x2f, 0x97, 0xb8, 0x6b, 0x26, 0x42, 0x88, 0x15, 0xa1, 0xcc, 0xae, 0xe2, 0x4f, 0xe8, 0xe3, 0x02,
-	0x16, 0xc8, 0x88, 0xf2, 0x98, 0xcb, 0x88, 0x08, 0x08, 0x65, 0x03, 0x82, 0x22, 0x22, 0x92, 0x31,
-	0xf8, 0x0c, 0xc2, 0x44, 0x88, 0x53, 0x08, 0xb9, 0x10, 0x8c, 0xda, 0xff, 0xe1, 0x77, 0xe8, 0xcd,
-	0x3f, 0xd4, 0x5c, 0x06, 0xb3, 0x05, 0x15, 0x2b, 0x16, 0x06, 0x5f, 0xca, 0x86, 0xbd, 0x86, 0x77,
-	0xd0, 0xf3, 0x05, 0xd9, 0x9c, 0xef, 0x3a, 0x3e, 0x46, 0x1f, 0x16, 0x80, 0x16, 0x97, 0xf9, 0xdf,
-	0xd3, 0xc0, 0x75, 0xf1, 0xa0, 0xa0, 0x13, 0x9f, 0x04, 0x81, 0x4c, 0xa2, 0x38, 0x0f, 0xd5, 0xb1,
-	0x22, 0x3c, 0x8a, 0xb5, 0xbd, 0xe1, 0x37, 0xef, 0x26, 0x8e, 0x75, 0x3f, 0x71, 0xac, 0x5f, 0x13,
-	0xc7, 0xfa, 0x3a, 0x75, 0x2a, 0xf7, 0x53, 0xa7, 0xf2, 0x63, 0xea, 0x54, 0xce, 0xde, 0xf7, 0x07,
-	0xe3, 0xf3, 0x2f, 0x1d, 0xb7, 0x6b, 0x2e, 0xbc, 0xb9, 0xcb, 0xbc, 0x3e, 0x3a, 0xe8, 0x9e, 0xb7,
-	0x07, 0x97, 0xde, 0x9f, 0xce, 0x4d, 0x79, 0xad, 0xe3, 0xdb, 0xab, 0xde, 0xa8, 0xb3, 0x56, 0xb4,
-	0xdf, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x45, 0x84, 0x1d, 0xbf, 0x20, 0x03, 0x00, 0x00,
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4b, 0x6b, 0x1b, 0x3f,
+	0x10, 0xf7, 0xe6, 0xfd, 0xd7, 0xbf, 0x09, 0x5b, 0xd1, 0x43, 0x70, 0xe9, 0x26, 0x35, 0x34, 0xe4,
+	0x92, 0x75, 0xeb, 0xa6, 0x0f, 0x48, 0x2f, 0xeb, 0x95, 0x0c, 0xc2, 0xf2, 0x8e, 0x91, 0x76, 0x5d,
+	0x92, 0xcb, 0xe0, 0x17, 0x8e, 0xc1, 0x89, 0xc2, 0xda, 0x0d, 0xc9, 0x27, 0xe8, 0xb5, 0x1f, 0x2b,
+	0xc7, 0x1c, 0x7b, 0x2a, 0xc5, 0xfe, 0x22, 0xc5, 0x5a, 0xd3, 0xc6, 0x4e, 0xdd, 0x93, 0x34, 0x33,
+	0xbf, 0x17, 0xc3, 0x90, 0x83, 0xce, 0x6d, 0xe7, 0xe6, 0x2a, 0x35, 0x23, 0xd3, 0x36, 0x83, 0x62,
+	0x7b, 0x60, 0x5a, 0x45, 0x93, 0x76, 0xba, 0x29, 0xa6, 0xdd, 0x0b, 0x73, 0xdd, 0x1c, 0x0c, 0x7d,
+	0x3b, 0xa4, 0x4f, 0x1f, 0xe2, 0xfc, 0x29, 0x2e, 0xff, 0xac, 0x67, 0x7a, 0xc6, 0xb6, 0x8a, 0xd3,
+	0x5f, 0x06, 0xcc, 0xbf, 0x58, 0x22, 0x98, 0x8d, 0x0b, 0x5f, 0xd7, 0xc9, 0x13, 0x98, 0xd6, 0x2a,
+	0xd3, 0xa7, 0x27, 0x64, 0x2b, 0x33, 0xec, 0x77, 0x76, 0x9d, 0x7d, 0xe7, 0xf0, 0xff, 0x52, 0xde,
+	0x7f, 0xe4, 0xe5, 0x5b, 0x8a, 0xe8, 0x94, 0xd7, 0xee, 0x7e, 0xec, 0xe5, 0xd4, 0xa6, 0xc9, 0x4a,
+	0x1a, 0x93, 0x9d, 0x59, 0x4e, 0x4c, 0xbb, 0xcd, 0xa1, 0xb9, 0xdc, 0x5d, 0xd9, 0x77, 0x0e, 0x77,
+	0x4a, 0x47, 0xcb, 0x24, 0x66, 0xae, 0xfe, 0xec, 0x55, 0x96, 0xa4, 0xb6, 0xd3, 0x87, 0x65, 0x61,
+	0xb2, 0x4a, 0xb6, 0xe7, 0x00, 0xd4, 0x23, 0x79, 0xc5, 0x6b, 0xd0, 0x08, 0x24, 0x2a, 0x1e, 0x68,
+	0x88, 0x30, 0x89, 0x74, 0x9d, 0x87, 0xa2, 0x22, 0x38, 0x73, 0x73, 0xf4, 0x80, 0x14, 0x1e, 0xcd,
+	0x19, 0x57, 0x21, 0x48, 0x19, 0xc4, 0x5c, 0x05, 0x52, 0x9c, 0x71, 0xe6, 0x3a, 0x7f, 0xc1, 0x89,
+	0xa8, 0x11, 0x48, 0xc1, 0x50, 0x71, 0x96, 0x84, 0x1c, 0x21, 0x92, 0xa7, 0xee, 0x0a, 0x3d, 0x26,
+	0xaf, 0x17, 0x70, 0x75, 0xd0, 0xb1, 0x9d, 0xe2, 0x67, 0x48, 0x24, 0xc3, 0x50, 0x81, 0xd6, 0x58,
+	0x0b, 0xaa, 0x5c, 0x21, 0x28, 0xc6, 0x95, 0xbb, 0x4a, 0x5f, 0x91, 0x97, 0x4b, 0xd4, 0x35, 0x97,
+	0x15, 0x8c, 0x55, 0xc0, 0xb8, 0xbb, 0x46, 0x3f, 0x91, 0x8f, 0x0b, 0xb0, 0x10, 0x22, 0x26, 0x62,
+	0x01, 0x51, 0x20, 0xb1, 0x02, 0x55, 0x0c, 0xad, 0x45, 0x04, 0x31, 0x96, 0x39, 0x56, 0x12, 0x29,
+	0x4f, 0xb1, 0x22, 0xa4, 0xe4, 0xcc, 0x5d, 0xa7, 0xef, 0xc8, 0x9b, 0x7f, 0xb0, 0x05, 0x84, 0xb3,
+	0x80, 0x8a, 0xdb, 0xc0, 0x58, 0x06, 0xa8, 0xba, 0x1b, 0x74, 0x8f, 0x3c, 0x5f, 0xa0, 0xcd, 0xe9,
+	0x6e, 0xd2, 0x13, 0xf2, 0x61, 0x01, 0xd0, 0x10, 0x30, 0xdd, 0x9e, 0x46, 0xa1, 0xed, 0x87, 0xa1,
+	0x4e, 0xca, 0x41, 0x18, 0x42, 0x12, 0xc5, 0x53, 0x53, 0x1d, 0xab, 0x40, 0x44, 0xb1, 0x76, 0xb7,
+	0x68, 0x89, 0xf8, 0x0b, 0xe4, 0x9a, 0x88, 0xf0, 0xcf, 0xfa, 0x31, 0x06, 0x84, 0x3a, 0xb7, 0x6b,
+	0xb4, 0x51, 0xdd, 0xff, 0xca, 0xf5, 0xbb, 0xb1, 0xe7, 0xdc, 0x8f, 0x3d, 0xe7, 0xe7, 0xd8, 0x73,
+	0xbe, 0x4d, 0xbc, 0xdc, 0xfd, 0xc4, 0xcb, 0x7d, 0x9f, 0x78, 0xb9, 0xb3, 0xf7, 0xbd, 0xfe, 0xe8,
+	0xfc, 0x4b, 0xcb, 0x6f, 0x9b, 0x8b, 0xe2, 0xdc, 0x35, 0x5f, 0x1f, 0x1f, 0xb5, 0xcf, 0x9b, 0xfd,
+	0xcb, 0xe2, 0xef, 0xce, 0x4d, 0x76, 0xe1, 0xa3, 0xdb, 0xab, 0xee, 0xb0, 0xb5, 0x61, 0xdb, 0x6f,
+	0x7f, 0x0d, 0x00, 0xf4, 0x56, 0x8c, 0xe5, 0x54, 0x03, 0x00, 0x00,
 }
 
 func (m *OrderRemoval) Marshal() (dAtA []byte, err error) {
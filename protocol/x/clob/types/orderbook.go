@@ -136,6 +136,15 @@ const (
 	// with either multiple positions in isolated perpetuals or both an isolated and a cross perpetual
 	// position.
 	ViolatesIsolatedSubaccountConstraints
+	// OrderbookDepthCapReached indicates this is a Short-Term order that would have been placed on
+	// an orderbook that holds the maximum number of resting orders, and was therefore canceled.
+	OrderbookDepthCapReached
+	// ViolatesStaleMarketPriceConstraints indicates that matching the order would open, increase or flip
+	// the subaccount's position while the oracle price of the ClobPair's market is stale.
+	ViolatesStaleMarketPriceConstraints
+	// ViolatesMinCollateralToOpenPosition indicates that matching the order would open a new position
+	// for a subaccount with less than the minimum collateral required to open a position.
+	ViolatesMinCollateralToOpenPosition
 )
 
 // String returns a string representation of this `OrderStatus` enum.
@@ -159,6 +168,12 @@ func (os OrderStatus) String() string {
 		return "LiquidationExceededSubaccountMaxInsuranceLost"
 	case ViolatesIsolatedSubaccountConstraints:
 		return "ViolatesIsolatedSubaccountConstraints"
+	case OrderbookDepthCapReached:
+		return "OrderbookDepthCapReached"
+	case ViolatesStaleMarketPriceConstraints:
+		return "ViolatesStaleMarketPriceConstraints"
+	case ViolatesMinCollateralToOpenPosition:
+		return "ViolatesMinCollateralToOpenPosition"
 	default:
 		return "Unknown"
 	}
@@ -83,6 +83,21 @@ func TestString(t *testing.T) {
 
 			expectedString: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"Order status is OrderbookDepthCapReached": {
+			orderStatus: types.OrderbookDepthCapReached,
+
+			expectedString: "OrderbookDepthCapReached",
+		},
+		"Order status is ViolatesStaleMarketPriceConstraints": {
+			orderStatus: types.ViolatesStaleMarketPriceConstraints,
+
+			expectedString: "ViolatesStaleMarketPriceConstraints",
+		},
+		"Order status is ViolatesMinCollateralToOpenPosition": {
+			orderStatus: types.ViolatesMinCollateralToOpenPosition,
+
+			expectedString: "ViolatesMinCollateralToOpenPosition",
+		},
 		"Order status is unknown enum value": {
 			orderStatus: 999,
 
@@ -11,7 +11,10 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
-	types "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types2 "github.com/dydxprotocol/v4-chain/protocol/indexer/off_chain_updates/types"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	types1 "github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -220,6 +223,100 @@ func (m *QueryClobPairAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClobPairsByStatusRequest is request type for the ClobPairsByStatus
+// method.
+type QueryClobPairsByStatusRequest struct {
+	// Status of the ClobPairs to return.
+	Status ClobPair_Status `protobuf:"varint,1,opt,name=status,proto3,enum=dydxprotocol.clob.ClobPair_Status" json:"status,omitempty"`
+}
+
+func (m *QueryClobPairsByStatusRequest) Reset()         { *m = QueryClobPairsByStatusRequest{} }
+func (m *QueryClobPairsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClobPairsByStatusRequest) ProtoMessage()    {}
+func (*QueryClobPairsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{4}
+}
+func (m *QueryClobPairsByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClobPairsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClobPairsByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClobPairsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClobPairsByStatusRequest.Merge(m, src)
+}
+func (m *QueryClobPairsByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClobPairsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClobPairsByStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClobPairsByStatusRequest proto.InternalMessageInfo
+
+func (m *QueryClobPairsByStatusRequest) GetStatus() ClobPair_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ClobPair_STATUS_UNSPECIFIED
+}
+
+// QueryClobPairsByStatusResponse is response type for the ClobPairsByStatus
+// method.
+type QueryClobPairsByStatusResponse struct {
+	// ClobPairs with the requested status, sorted by id.
+	ClobPair []ClobPair `protobuf:"bytes,1,rep,name=clob_pair,json=clobPair,proto3" json:"clob_pair"`
+}
+
+func (m *QueryClobPairsByStatusResponse) Reset()         { *m = QueryClobPairsByStatusResponse{} }
+func (m *QueryClobPairsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClobPairsByStatusResponse) ProtoMessage()    {}
+func (*QueryClobPairsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{5}
+}
+func (m *QueryClobPairsByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClobPairsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClobPairsByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClobPairsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClobPairsByStatusResponse.Merge(m, src)
+}
+func (m *QueryClobPairsByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClobPairsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClobPairsByStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClobPairsByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryClobPairsByStatusResponse) GetClobPair() []ClobPair {
+	if m != nil {
+		return m.ClobPair
+	}
+	return nil
+}
+
 // MevNodeToNodeCalculationRequest is a request message used to run the
 // MEV node <> node calculation.
 type MevNodeToNodeCalculationRequest struct {
@@ -236,7 +333,7 @@ func (m *MevNodeToNodeCalculationRequest) Reset()         { *m = MevNodeToNodeCa
 func (m *MevNodeToNodeCalculationRequest) String() string { return proto.CompactTextString(m) }
 func (*MevNodeToNodeCalculationRequest) ProtoMessage()    {}
 func (*MevNodeToNodeCalculationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{4}
+	return fileDescriptor_3365c195b25c5bc0, []int{6}
 }
 func (m *MevNodeToNodeCalculationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +386,7 @@ func (m *MevNodeToNodeCalculationResponse) Reset()         { *m = MevNodeToNodeC
 func (m *MevNodeToNodeCalculationResponse) String() string { return proto.CompactTextString(m) }
 func (*MevNodeToNodeCalculationResponse) ProtoMessage()    {}
 func (*MevNodeToNodeCalculationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{5}
+	return fileDescriptor_3365c195b25c5bc0, []int{7}
 }
 func (m *MevNodeToNodeCalculationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +437,7 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) String() string {
 }
 func (*MevNodeToNodeCalculationResponse_MevAndVolumePerClob) ProtoMessage() {}
 func (*MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{5, 0}
+	return fileDescriptor_3365c195b25c5bc0, []int{7, 0}
 }
 func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +498,7 @@ func (m *QueryEquityTierLimitConfigurationRequest) Reset() {
 func (m *QueryEquityTierLimitConfigurationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEquityTierLimitConfigurationRequest) ProtoMessage()    {}
 func (*QueryEquityTierLimitConfigurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{6}
+	return fileDescriptor_3365c195b25c5bc0, []int{8}
 }
 func (m *QueryEquityTierLimitConfigurationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +541,7 @@ func (m *QueryEquityTierLimitConfigurationResponse) String() string {
 }
 func (*QueryEquityTierLimitConfigurationResponse) ProtoMessage() {}
 func (*QueryEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{7}
+	return fileDescriptor_3365c195b25c5bc0, []int{9}
 }
 func (m *QueryEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +588,7 @@ func (m *QueryBlockRateLimitConfigurationRequest) Reset() {
 func (m *QueryBlockRateLimitConfigurationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockRateLimitConfigurationRequest) ProtoMessage()    {}
 func (*QueryBlockRateLimitConfigurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{8}
+	return fileDescriptor_3365c195b25c5bc0, []int{10}
 }
 func (m *QueryBlockRateLimitConfigurationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +629,7 @@ func (m *QueryBlockRateLimitConfigurationResponse) Reset() {
 func (m *QueryBlockRateLimitConfigurationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockRateLimitConfigurationResponse) ProtoMessage()    {}
 func (*QueryBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{9}
+	return fileDescriptor_3365c195b25c5bc0, []int{11}
 }
 func (m *QueryBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +675,7 @@ func (m *QueryStatefulOrderRequest) Reset()         { *m = QueryStatefulOrderReq
 func (m *QueryStatefulOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryStatefulOrderRequest) ProtoMessage()    {}
 func (*QueryStatefulOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{10}
+	return fileDescriptor_3365c195b25c5bc0, []int{12}
 }
 func (m *QueryStatefulOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +726,7 @@ func (m *QueryStatefulOrderResponse) Reset()         { *m = QueryStatefulOrderRe
 func (m *QueryStatefulOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryStatefulOrderResponse) ProtoMessage()    {}
 func (*QueryStatefulOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{11}
+	return fileDescriptor_3365c195b25c5bc0, []int{13}
 }
 func (m *QueryStatefulOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +785,7 @@ func (m *QueryLiquidationsConfigurationRequest) Reset()         { *m = QueryLiqu
 func (m *QueryLiquidationsConfigurationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidationsConfigurationRequest) ProtoMessage()    {}
 func (*QueryLiquidationsConfigurationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{12}
+	return fileDescriptor_3365c195b25c5bc0, []int{14}
 }
 func (m *QueryLiquidationsConfigurationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -729,7 +826,7 @@ func (m *QueryLiquidationsConfigurationResponse) Reset() {
 func (m *QueryLiquidationsConfigurationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidationsConfigurationResponse) ProtoMessage()    {}
 func (*QueryLiquidationsConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{13}
+	return fileDescriptor_3365c195b25c5bc0, []int{15}
 }
 func (m *QueryLiquidationsConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,25 +862,25 @@ func (m *QueryLiquidationsConfigurationResponse) GetLiquidationsConfig() Liquida
 	return LiquidationsConfig{}
 }
 
-// StreamOrderbookUpdatesRequest is a request message for the
-// StreamOrderbookUpdates method.
-type StreamOrderbookUpdatesRequest struct {
-	// Clob pair ids to stream orderbook updates for.
-	ClobPairId []uint32 `protobuf:"varint,1,rep,packed,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+// QueryLastBlockRejectedOperationsRequest is a request message for
+// LastBlockRejectedOperations.
+type QueryLastBlockRejectedOperationsRequest struct {
 }
 
-func (m *StreamOrderbookUpdatesRequest) Reset()         { *m = StreamOrderbookUpdatesRequest{} }
-func (m *StreamOrderbookUpdatesRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdatesRequest) ProtoMessage()    {}
-func (*StreamOrderbookUpdatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{14}
+func (m *QueryLastBlockRejectedOperationsRequest) Reset() {
+	*m = QueryLastBlockRejectedOperationsRequest{}
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLastBlockRejectedOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastBlockRejectedOperationsRequest) ProtoMessage()    {}
+func (*QueryLastBlockRejectedOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{16}
+}
+func (m *QueryLastBlockRejectedOperationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLastBlockRejectedOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdatesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLastBlockRejectedOperationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -793,44 +890,41 @@ func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdatesRequest.Merge(m, src)
+func (m *QueryLastBlockRejectedOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastBlockRejectedOperationsRequest.Merge(m, src)
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_Size() int {
+func (m *QueryLastBlockRejectedOperationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdatesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdatesRequest.DiscardUnknown(m)
+func (m *QueryLastBlockRejectedOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastBlockRejectedOperationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdatesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLastBlockRejectedOperationsRequest proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdatesRequest) GetClobPairId() []uint32 {
-	if m != nil {
-		return m.ClobPairId
-	}
-	return nil
+// QueryLastBlockRejectedOperationsResponse is a response message that contains
+// the proposed operations that were rejected in the last block.
+type QueryLastBlockRejectedOperationsResponse struct {
+	// The height of the last block in which proposed operations were processed.
+	BlockHeight uint32 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The rejected operations of the block, along with the rejection reasons.
+	RejectedOperations []RejectedOperation `protobuf:"bytes,2,rep,name=rejected_operations,json=rejectedOperations,proto3" json:"rejected_operations"`
 }
 
-// StreamOrderbookUpdatesResponse is a response message for the
-// StreamOrderbookUpdates method.
-type StreamOrderbookUpdatesResponse struct {
-	// Batch of updates for the clob pair.
-	Updates []StreamUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+func (m *QueryLastBlockRejectedOperationsResponse) Reset() {
+	*m = QueryLastBlockRejectedOperationsResponse{}
 }
-
-func (m *StreamOrderbookUpdatesResponse) Reset()         { *m = StreamOrderbookUpdatesResponse{} }
-func (m *StreamOrderbookUpdatesResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdatesResponse) ProtoMessage()    {}
-func (*StreamOrderbookUpdatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{15}
+func (m *QueryLastBlockRejectedOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastBlockRejectedOperationsResponse) ProtoMessage()    {}
+func (*QueryLastBlockRejectedOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{17}
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLastBlockRejectedOperationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLastBlockRejectedOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdatesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLastBlockRejectedOperationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,54 +934,51 @@ func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdatesResponse.Merge(m, src)
+func (m *QueryLastBlockRejectedOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastBlockRejectedOperationsResponse.Merge(m, src)
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_Size() int {
+func (m *QueryLastBlockRejectedOperationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdatesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdatesResponse.DiscardUnknown(m)
+func (m *QueryLastBlockRejectedOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastBlockRejectedOperationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdatesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLastBlockRejectedOperationsResponse proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdatesResponse) GetUpdates() []StreamUpdate {
+func (m *QueryLastBlockRejectedOperationsResponse) GetBlockHeight() uint32 {
 	if m != nil {
-		return m.Updates
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *QueryLastBlockRejectedOperationsResponse) GetRejectedOperations() []RejectedOperation {
+	if m != nil {
+		return m.RejectedOperations
 	}
 	return nil
 }
 
-// StreamUpdate is an update that will be pushed through the
-// GRPC stream.
-type StreamUpdate struct {
-	// Contains one of an StreamOrderbookUpdate,
-	// StreamOrderbookFill.
-	//
-	// Types that are valid to be assigned to UpdateMessage:
-	//
-	//	*StreamUpdate_OrderbookUpdate
-	//	*StreamUpdate_OrderFill
-	UpdateMessage isStreamUpdate_UpdateMessage `protobuf_oneof:"update_message"`
-	// Block height of the update.
-	BlockHeight uint32 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Exec mode of the update.
-	ExecMode uint32 `protobuf:"varint,4,opt,name=exec_mode,json=execMode,proto3" json:"exec_mode,omitempty"`
+// QueryClobPairMarketPriceRequest is a request message for
+// ClobPairMarketPrice.
+type QueryClobPairMarketPriceRequest struct {
+	// Id of the ClobPair to query the market price of.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 }
 
-func (m *StreamUpdate) Reset()         { *m = StreamUpdate{} }
-func (m *StreamUpdate) String() string { return proto.CompactTextString(m) }
-func (*StreamUpdate) ProtoMessage()    {}
-func (*StreamUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{16}
+func (m *QueryClobPairMarketPriceRequest) Reset()         { *m = QueryClobPairMarketPriceRequest{} }
+func (m *QueryClobPairMarketPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClobPairMarketPriceRequest) ProtoMessage()    {}
+func (*QueryClobPairMarketPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{18}
 }
-func (m *StreamUpdate) XXX_Unmarshal(b []byte) error {
+func (m *QueryClobPairMarketPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClobPairMarketPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClobPairMarketPriceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -897,102 +988,89 @@ func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *StreamUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamUpdate.Merge(m, src)
+func (m *QueryClobPairMarketPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClobPairMarketPriceRequest.Merge(m, src)
 }
-func (m *StreamUpdate) XXX_Size() int {
+func (m *QueryClobPairMarketPriceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamUpdate.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_StreamUpdate proto.InternalMessageInfo
-
-type isStreamUpdate_UpdateMessage interface {
-	isStreamUpdate_UpdateMessage()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type StreamUpdate_OrderbookUpdate struct {
-	OrderbookUpdate *StreamOrderbookUpdate `protobuf:"bytes,1,opt,name=orderbook_update,json=orderbookUpdate,proto3,oneof" json:"orderbook_update,omitempty"`
-}
-type StreamUpdate_OrderFill struct {
-	OrderFill *StreamOrderbookFill `protobuf:"bytes,2,opt,name=order_fill,json=orderFill,proto3,oneof" json:"order_fill,omitempty"`
+func (m *QueryClobPairMarketPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClobPairMarketPriceRequest.DiscardUnknown(m)
 }
 
-func (*StreamUpdate_OrderbookUpdate) isStreamUpdate_UpdateMessage() {}
-func (*StreamUpdate_OrderFill) isStreamUpdate_UpdateMessage()       {}
+var xxx_messageInfo_QueryClobPairMarketPriceRequest proto.InternalMessageInfo
 
-func (m *StreamUpdate) GetUpdateMessage() isStreamUpdate_UpdateMessage {
+func (m *QueryClobPairMarketPriceRequest) GetClobPairId() uint32 {
 	if m != nil {
-		return m.UpdateMessage
+		return m.ClobPairId
 	}
-	return nil
+	return 0
 }
 
-func (m *StreamUpdate) GetOrderbookUpdate() *StreamOrderbookUpdate {
-	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderbookUpdate); ok {
-		return x.OrderbookUpdate
-	}
-	return nil
+// QueryClobPairMarketPriceResponse is a response message that contains the
+// oracle price of the market of the perpetual of a ClobPair.
+type QueryClobPairMarketPriceResponse struct {
+	MarketPrice types.MarketPrice `protobuf:"bytes,1,opt,name=market_price,json=marketPrice,proto3" json:"market_price"`
 }
 
-func (m *StreamUpdate) GetOrderFill() *StreamOrderbookFill {
-	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderFill); ok {
-		return x.OrderFill
-	}
-	return nil
+func (m *QueryClobPairMarketPriceResponse) Reset()         { *m = QueryClobPairMarketPriceResponse{} }
+func (m *QueryClobPairMarketPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClobPairMarketPriceResponse) ProtoMessage()    {}
+func (*QueryClobPairMarketPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{19}
 }
-
-func (m *StreamUpdate) GetBlockHeight() uint32 {
-	if m != nil {
-		return m.BlockHeight
-	}
-	return 0
+func (m *QueryClobPairMarketPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *StreamUpdate) GetExecMode() uint32 {
-	if m != nil {
-		return m.ExecMode
+func (m *QueryClobPairMarketPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClobPairMarketPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryClobPairMarketPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClobPairMarketPriceResponse.Merge(m, src)
+}
+func (m *QueryClobPairMarketPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClobPairMarketPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClobPairMarketPriceResponse.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamUpdate) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamUpdate_OrderbookUpdate)(nil),
-		(*StreamUpdate_OrderFill)(nil),
+var xxx_messageInfo_QueryClobPairMarketPriceResponse proto.InternalMessageInfo
+
+func (m *QueryClobPairMarketPriceResponse) GetMarketPrice() types.MarketPrice {
+	if m != nil {
+		return m.MarketPrice
 	}
+	return types.MarketPrice{}
 }
 
-// StreamOrderbookUpdate provides information on an orderbook update. Used in
-// the full node GRPC stream.
-type StreamOrderbookUpdate struct {
-	// Orderbook updates for the clob pair. Can contain order place, removals,
-	// or updates.
-	Updates []types.OffChainUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
-	// Snapshot indicates if the response is from a snapshot of the orderbook.
-	// All updates should be ignored until snapshot is recieved.
-	// If the snapshot is true, then all previous entries should be
-	// discarded and the orderbook should be resynced.
-	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+// QueryAllMarketsOverviewRequest is a request message for
+// AllMarketsOverview.
+type QueryAllMarketsOverviewRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *StreamOrderbookUpdate) Reset()         { *m = StreamOrderbookUpdate{} }
-func (m *StreamOrderbookUpdate) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookUpdate) ProtoMessage()    {}
-func (*StreamOrderbookUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{17}
+func (m *QueryAllMarketsOverviewRequest) Reset()         { *m = QueryAllMarketsOverviewRequest{} }
+func (m *QueryAllMarketsOverviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarketsOverviewRequest) ProtoMessage()    {}
+func (*QueryAllMarketsOverviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{20}
 }
-func (m *StreamOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllMarketsOverviewRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllMarketsOverviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllMarketsOverviewRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1002,57 +1080,51 @@ func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookUpdate.Merge(m, src)
+func (m *QueryAllMarketsOverviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarketsOverviewRequest.Merge(m, src)
 }
-func (m *StreamOrderbookUpdate) XXX_Size() int {
+func (m *QueryAllMarketsOverviewRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookUpdate.DiscardUnknown(m)
+func (m *QueryAllMarketsOverviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarketsOverviewRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookUpdate proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllMarketsOverviewRequest proto.InternalMessageInfo
 
-func (m *StreamOrderbookUpdate) GetUpdates() []types.OffChainUpdateV1 {
+func (m *QueryAllMarketsOverviewRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Updates
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *StreamOrderbookUpdate) GetSnapshot() bool {
-	if m != nil {
-		return m.Snapshot
-	}
-	return false
+// MarketOverview contains a ClobPair along with the ticker of its perpetual
+// and the oracle price of the perpetual's market.
+type MarketOverview struct {
+	// Id of the ClobPair.
+	ClobPairId uint32 `protobuf:"varint,1,opt,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
+	// Id of the perpetual of the ClobPair.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Ticker of the perpetual of the ClobPair.
+	Ticker string `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// The oracle price of the market of the perpetual. Null if the market price
+	// is unavailable.
+	MarketPrice *types.MarketPrice `protobuf:"bytes,4,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
 }
 
-// StreamOrderbookFill provides information on an orderbook fill. Used in
-// the full node GRPC stream.
-type StreamOrderbookFill struct {
-	// Clob match. Provides information on which orders were matched
-	// and the type of order.
-	ClobMatch *ClobMatch `protobuf:"bytes,1,opt,name=clob_match,json=clobMatch,proto3" json:"clob_match,omitempty"`
-	// All orders involved in the specified clob match. Used to look up
-	// price of a match through a given maker order id.
-	Orders []Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
-	// Resulting fill amounts for each order in the orders array.
-	FillAmounts []uint64 `protobuf:"varint,3,rep,packed,name=fill_amounts,json=fillAmounts,proto3" json:"fill_amounts,omitempty"`
+func (m *MarketOverview) Reset()         { *m = MarketOverview{} }
+func (m *MarketOverview) String() string { return proto.CompactTextString(m) }
+func (*MarketOverview) ProtoMessage()    {}
+func (*MarketOverview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{21}
 }
-
-func (m *StreamOrderbookFill) Reset()         { *m = StreamOrderbookFill{} }
-func (m *StreamOrderbookFill) String() string { return proto.CompactTextString(m) }
-func (*StreamOrderbookFill) ProtoMessage()    {}
-func (*StreamOrderbookFill) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3365c195b25c5bc0, []int{18}
-}
-func (m *StreamOrderbookFill) XXX_Unmarshal(b []byte) error {
+func (m *MarketOverview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MarketOverview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamOrderbookFill.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MarketOverview.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1062,1655 +1134,4066 @@ func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *StreamOrderbookFill) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamOrderbookFill.Merge(m, src)
+func (m *MarketOverview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketOverview.Merge(m, src)
 }
-func (m *StreamOrderbookFill) XXX_Size() int {
+func (m *MarketOverview) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamOrderbookFill) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamOrderbookFill.DiscardUnknown(m)
+func (m *MarketOverview) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketOverview.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamOrderbookFill proto.InternalMessageInfo
+var xxx_messageInfo_MarketOverview proto.InternalMessageInfo
 
-func (m *StreamOrderbookFill) GetClobMatch() *ClobMatch {
+func (m *MarketOverview) GetClobPairId() uint32 {
 	if m != nil {
-		return m.ClobMatch
+		return m.ClobPairId
 	}
-	return nil
+	return 0
 }
 
-func (m *StreamOrderbookFill) GetOrders() []Order {
+func (m *MarketOverview) GetPerpetualId() uint32 {
 	if m != nil {
-		return m.Orders
+		return m.PerpetualId
 	}
-	return nil
+	return 0
 }
 
-func (m *StreamOrderbookFill) GetFillAmounts() []uint64 {
+func (m *MarketOverview) GetTicker() string {
 	if m != nil {
-		return m.FillAmounts
+		return m.Ticker
 	}
-	return nil
-}
-
-func init() {
-	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
-	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
-	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
-	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
-	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
-	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
-	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
-	proto.RegisterType((*QueryStatefulOrderRequest)(nil), "dydxprotocol.clob.QueryStatefulOrderRequest")
-	proto.RegisterType((*QueryStatefulOrderResponse)(nil), "dydxprotocol.clob.QueryStatefulOrderResponse")
-	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
-	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
-	proto.RegisterType((*StreamOrderbookUpdatesRequest)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesRequest")
-	proto.RegisterType((*StreamOrderbookUpdatesResponse)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesResponse")
-	proto.RegisterType((*StreamUpdate)(nil), "dydxprotocol.clob.StreamUpdate")
-	proto.RegisterType((*StreamOrderbookUpdate)(nil), "dydxprotocol.clob.StreamOrderbookUpdate")
-	proto.RegisterType((*StreamOrderbookFill)(nil), "dydxprotocol.clob.StreamOrderbookFill")
+	return ""
 }
 
-func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
-
-var fileDescriptor_3365c195b25c5bc0 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x5f, 0x27, 0xf9, 0xb6, 0x9b, 0x97, 0xfe, 0xfa, 0x4e, 0x9a, 0x76, 0xeb, 0xa4, 0x9b, 0xd4,
-	0xd0, 0x74, 0x93, 0xd2, 0x75, 0x93, 0x56, 0x55, 0x69, 0x50, 0x51, 0x12, 0xd1, 0x1f, 0x52, 0x43,
-	0x83, 0xfb, 0x83, 0x0a, 0x2a, 0x59, 0xb3, 0xf6, 0xac, 0x63, 0xd5, 0xf6, 0x6c, 0xec, 0xd9, 0x55,
-	0x22, 0x84, 0x40, 0x1c, 0xb8, 0x00, 0x12, 0x12, 0x07, 0x0e, 0x48, 0x5c, 0x38, 0x73, 0xe4, 0x88,
-	0x80, 0x5b, 0x8f, 0x95, 0xb8, 0x70, 0x40, 0x08, 0xb5, 0x9c, 0xf9, 0x1b, 0x90, 0x67, 0xc6, 0x9b,
-	0x75, 0x6c, 0xef, 0x26, 0xb9, 0xec, 0xda, 0x6f, 0xde, 0x7b, 0xf3, 0x79, 0xef, 0x7d, 0x66, 0xde,
-	0x33, 0x9c, 0xb5, 0xb7, 0xed, 0xad, 0x56, 0x48, 0x19, 0xb5, 0xa8, 0xa7, 0x5b, 0x1e, 0x6d, 0xe8,
-	0x9b, 0x6d, 0x12, 0x6e, 0xd7, 0xb9, 0x0c, 0xfd, 0xbf, 0x77, 0xb9, 0x1e, 0x2f, 0xab, 0x27, 0x1d,
-	0xea, 0x50, 0x2e, 0xd2, 0xe3, 0x27, 0xa1, 0xa8, 0x4e, 0x39, 0x94, 0x3a, 0x1e, 0xd1, 0x71, 0xcb,
-	0xd5, 0x71, 0x10, 0x50, 0x86, 0x99, 0x4b, 0x83, 0x48, 0xae, 0xce, 0x5b, 0x34, 0xf2, 0x69, 0xa4,
-	0x37, 0x70, 0x44, 0x84, 0x7f, 0xbd, 0xb3, 0xd0, 0x20, 0x0c, 0x2f, 0xe8, 0x2d, 0xec, 0xb8, 0x01,
-	0x57, 0x96, 0xba, 0x7a, 0x16, 0x51, 0xc3, 0xa3, 0xd6, 0x33, 0x33, 0xc4, 0x8c, 0x98, 0x9e, 0xeb,
-	0xbb, 0xcc, 0xb4, 0x68, 0xd0, 0x74, 0x1d, 0x69, 0x70, 0x2e, 0x6b, 0x10, 0xff, 0x98, 0x2d, 0xec,
-	0x86, 0x52, 0xe5, 0x72, 0x56, 0x85, 0x6c, 0xb6, 0x5d, 0xb6, 0x6d, 0x32, 0x97, 0x84, 0x79, 0x4e,
-	0x73, 0xf2, 0x42, 0x43, 0x9b, 0x24, 0x0e, 0xa7, 0xb3, 0xcb, 0x3e, 0x66, 0xd6, 0x06, 0x49, 0x22,
-	0xbe, 0x98, 0x55, 0xf0, 0xdc, 0xcd, 0xb6, 0x6b, 0x8b, 0xbc, 0xa4, 0x37, 0x9b, 0xcc, 0xf1, 0x46,
-	0x3a, 0x72, 0xf1, 0x66, 0x6a, 0xd1, 0x0d, 0x6c, 0xb2, 0x45, 0x42, 0x9d, 0x36, 0x9b, 0xa6, 0xb5,
-	0x81, 0xdd, 0xc0, 0x6c, 0xb7, 0x6c, 0xcc, 0x48, 0x94, 0x95, 0x08, 0x7b, 0x6d, 0x0e, 0x4e, 0xbf,
-	0x17, 0x67, 0xfc, 0x36, 0x61, 0xab, 0x1e, 0x6d, 0xac, 0x63, 0x37, 0x34, 0xc8, 0x66, 0x9b, 0x44,
-	0x0c, 0x1d, 0x83, 0x21, 0xd7, 0xae, 0x28, 0x33, 0x4a, 0xed, 0xa8, 0x31, 0xe4, 0xda, 0xda, 0xfb,
-	0x30, 0xc1, 0x55, 0x77, 0xf4, 0xa2, 0x16, 0x0d, 0x22, 0x82, 0x6e, 0xc2, 0x68, 0x37, 0xa5, 0x5c,
-	0x7f, 0x6c, 0x71, 0xb2, 0x9e, 0xa1, 0x46, 0x3d, 0xb1, 0x5b, 0x19, 0x79, 0xfe, 0xd7, 0x74, 0xc9,
-	0x28, 0x5b, 0xf2, 0x5d, 0xc3, 0x12, 0xc3, 0xb2, 0xe7, 0xed, 0xc6, 0x70, 0x0b, 0x60, 0x87, 0x02,
-	0xd2, 0xf7, 0x6c, 0x5d, 0xf0, 0xa5, 0x1e, 0xf3, 0xa5, 0x2e, 0xf8, 0x28, 0xf9, 0x52, 0x5f, 0xc7,
-	0x0e, 0x91, 0xb6, 0x46, 0x8f, 0xa5, 0xf6, 0x83, 0x02, 0x95, 0x14, 0xf8, 0x65, 0xcf, 0x2b, 0xc2,
-	0x3f, 0xbc, 0x4f, 0xfc, 0xe8, 0x76, 0x0a, 0xe4, 0x10, 0x07, 0x79, 0x61, 0x20, 0x48, 0xb1, 0x79,
-	0x0a, 0xe5, 0x9f, 0x0a, 0x4c, 0xaf, 0x91, 0xce, 0xbb, 0xd4, 0x26, 0x0f, 0x69, 0xfc, 0xbb, 0x8a,
-	0x3d, 0xab, 0xed, 0xf1, 0xc5, 0x24, 0x23, 0x4f, 0xe1, 0x94, 0x20, 0x7c, 0x2b, 0xa4, 0x2d, 0x1a,
-	0x91, 0xd0, 0x94, 0xd4, 0xea, 0x66, 0x27, 0x8b, 0xfc, 0x31, 0xf6, 0x62, 0x6a, 0xd1, 0x70, 0x8d,
-	0x74, 0xd6, 0x84, 0xb6, 0x71, 0x92, 0x7b, 0x59, 0x97, 0x4e, 0xa4, 0x14, 0x7d, 0x08, 0x13, 0x9d,
-	0x44, 0xd9, 0xf4, 0x49, 0xc7, 0xf4, 0x09, 0x0b, 0x5d, 0x2b, 0xea, 0x46, 0x95, 0x75, 0x9e, 0x02,
-	0xbc, 0x26, 0xd4, 0x8d, 0xf1, 0x4e, 0xef, 0x96, 0x42, 0xa8, 0xfd, 0xab, 0xc0, 0x4c, 0x71, 0x78,
-	0xb2, 0x18, 0x0e, 0x1c, 0x0e, 0x49, 0xd4, 0xf6, 0x58, 0x24, 0x4b, 0x71, 0x7b, 0xd0, 0x9e, 0x39,
-	0x5e, 0x62, 0x85, 0xe5, 0xc0, 0x7e, 0x4c, 0xbd, 0xb6, 0x4f, 0xd6, 0x49, 0x18, 0x97, 0x4e, 0x96,
-	0x2d, 0xf1, 0xae, 0x62, 0x18, 0xcf, 0xd1, 0x42, 0x33, 0x70, 0xa4, 0x4b, 0x06, 0xb3, 0xcb, 0x7f,
-	0x48, 0x8a, 0x7d, 0xd7, 0x46, 0x27, 0x60, 0xd8, 0x27, 0x1d, 0x9e, 0x91, 0x21, 0x23, 0x7e, 0x44,
-	0xa7, 0xe0, 0x50, 0x87, 0x3b, 0xa9, 0x0c, 0xcf, 0x28, 0xb5, 0x11, 0x43, 0xbe, 0x69, 0xf3, 0x50,
-	0xe3, 0xa4, 0x7b, 0x87, 0xdf, 0x26, 0x0f, 0x5d, 0x12, 0xde, 0x8b, 0xef, 0x92, 0x55, 0x7e, 0xba,
-	0xdb, 0x61, 0x6f, 0x5d, 0xb5, 0xef, 0x14, 0x98, 0xdb, 0x83, 0xb2, 0xcc, 0x52, 0x00, 0x95, 0xa2,
-	0x2b, 0x4a, 0xf2, 0x40, 0xcf, 0x49, 0x5b, 0x3f, 0xd7, 0x32, 0x3d, 0x13, 0x24, 0x4f, 0x47, 0x9b,
-	0x83, 0x0b, 0x1c, 0xdc, 0x4a, 0x4c, 0x1a, 0x03, 0x33, 0x52, 0x1c, 0xc8, 0xb7, 0x8a, 0x8c, 0xba,
-	0xaf, 0xae, 0x8c, 0xe3, 0x19, 0x9c, 0x2e, 0xb8, 0xbe, 0x65, 0x18, 0xf5, 0x9c, 0x30, 0xfa, 0x38,
-	0x96, 0x51, 0x08, 0x72, 0xef, 0x52, 0xd1, 0x9e, 0xc0, 0x19, 0x0e, 0xec, 0x01, 0xc3, 0x8c, 0x34,
-	0xdb, 0xde, 0xfd, 0xf8, 0xca, 0x4e, 0xce, 0xd5, 0x12, 0x94, 0xf9, 0x15, 0x9e, 0xd4, 0x7c, 0x6c,
-	0x51, 0xcd, 0xd9, 0x9a, 0x9b, 0xdc, 0xb5, 0x13, 0x2e, 0x51, 0xf1, 0xaa, 0xfd, 0xa4, 0x80, 0x9a,
-	0xe7, 0x5a, 0x46, 0xf9, 0x04, 0x8e, 0x0b, 0xdf, 0x2d, 0x0f, 0x5b, 0xc4, 0x27, 0x01, 0x93, 0x5b,
-	0xcc, 0xe5, 0x6c, 0x71, 0x8f, 0x06, 0xce, 0x43, 0x12, 0xfa, 0xdc, 0xc5, 0x7a, 0x62, 0x20, 0x77,
-	0x3c, 0x46, 0x53, 0x52, 0x34, 0x0d, 0x63, 0x4d, 0xd7, 0xf3, 0x4c, 0xec, 0xd3, 0x76, 0xc0, 0x38,
-	0x27, 0x47, 0x0c, 0x88, 0x45, 0xcb, 0x5c, 0x82, 0xa6, 0x60, 0x94, 0x85, 0xae, 0xe3, 0x90, 0x90,
-	0xd8, 0x9c, 0x9d, 0x65, 0x63, 0x47, 0xa0, 0x5d, 0x80, 0xf3, 0x1c, 0xf6, 0xbd, 0x9e, 0xe6, 0x93,
-	0x5b, 0xd4, 0xcf, 0x15, 0x98, 0x1d, 0xa4, 0x29, 0x83, 0x7d, 0x0a, 0xe3, 0x39, 0xbd, 0x4c, 0x06,
-	0x7c, 0x3e, 0x2f, 0xe0, 0x8c, 0x4b, 0x19, 0x2c, 0xf2, 0x32, 0x2b, 0xda, 0x32, 0x9c, 0x7d, 0xc0,
-	0x42, 0x82, 0x45, 0x7a, 0x1a, 0x94, 0x3e, 0x7b, 0x24, 0xfa, 0x59, 0x52, 0xc7, 0xec, 0xf9, 0x1d,
-	0x4e, 0x9f, 0x5f, 0x0d, 0x43, 0xb5, 0xc8, 0x85, 0x0c, 0xe1, 0x6d, 0x38, 0x2c, 0xbb, 0xa4, 0xbc,
-	0x83, 0xa6, 0x73, 0x60, 0x0b, 0x1f, 0xc2, 0x34, 0xe1, 0x83, 0xb4, 0xd2, 0x3e, 0x1d, 0x82, 0x23,
-	0xbd, 0xeb, 0xe8, 0x11, 0x9c, 0xa0, 0xc9, 0x6e, 0xb2, 0x03, 0xcb, 0x8c, 0xd4, 0x0a, 0x5d, 0xef,
-	0x82, 0x77, 0xa7, 0x64, 0x1c, 0xa7, 0x69, 0x51, 0xdc, 0x79, 0x04, 0xb1, 0xe2, 0x8a, 0xcb, 0x3b,
-	0x7a, 0x76, 0xb0, 0xc3, 0x5b, 0xae, 0xe7, 0xdd, 0x29, 0x19, 0xa3, 0xdc, 0x36, 0x7e, 0x41, 0xe7,
-	0xe0, 0x88, 0x38, 0x87, 0x1b, 0xc4, 0x75, 0x36, 0x18, 0x67, 0xca, 0x51, 0x63, 0x8c, 0xcb, 0xee,
-	0x70, 0x11, 0x9a, 0x84, 0x51, 0xb2, 0x45, 0x2c, 0xd3, 0xa7, 0x36, 0xa9, 0x8c, 0xf0, 0xf5, 0x72,
-	0x2c, 0x58, 0xa3, 0x36, 0x59, 0x39, 0x01, 0xc7, 0x44, 0x54, 0xa6, 0x4f, 0xa2, 0x08, 0x3b, 0x44,
-	0xfb, 0x4a, 0x81, 0x89, 0xdc, 0x38, 0xd0, 0x93, 0xdd, 0xd9, 0xbd, 0x9e, 0x46, 0x2c, 0x87, 0x98,
-	0x7a, 0x76, 0x64, 0xb9, 0xdf, 0x6c, 0xae, 0xc6, 0x02, 0xe1, 0xe8, 0xf1, 0xc2, 0xae, 0xb4, 0x23,
-	0x15, 0xca, 0x51, 0x80, 0x5b, 0xd1, 0x06, 0x15, 0x47, 0xa1, 0x6c, 0x74, 0xdf, 0xb5, 0x1f, 0x15,
-	0x18, 0xcf, 0x49, 0x03, 0x5a, 0x02, 0xce, 0x0d, 0xd1, 0x45, 0x65, 0x4d, 0xa6, 0x0a, 0xba, 0x3f,
-	0xef, 0x92, 0x06, 0x1f, 0x16, 0xf8, 0x23, 0xba, 0x06, 0x87, 0x78, 0x0e, 0xe3, 0xfe, 0x18, 0x47,
-	0x52, 0x29, 0xba, 0x32, 0x24, 0x52, 0xa9, 0x1d, 0xa7, 0xbb, 0xe7, 0xd8, 0x46, 0x95, 0xe1, 0x99,
-	0xe1, 0xda, 0x88, 0x31, 0xb6, 0x73, 0x6e, 0xa3, 0xc5, 0xef, 0x01, 0xfe, 0xc7, 0x4f, 0x1c, 0xfa,
-	0x42, 0x81, 0x72, 0x32, 0x7b, 0xa0, 0xf9, 0x9c, 0x1d, 0x0a, 0x06, 0x38, 0xb5, 0x56, 0xa4, 0xbb,
-	0x7b, 0x82, 0xd3, 0xe6, 0x3e, 0xfb, 0xfd, 0x9f, 0x6f, 0x86, 0x5e, 0x43, 0xe7, 0xf4, 0x3e, 0xd3,
-	0xb2, 0xfe, 0x91, 0x6b, 0x7f, 0x8c, 0xbe, 0x54, 0x60, 0xac, 0x67, 0x88, 0x2a, 0x06, 0x94, 0x9d,
-	0xe6, 0xd4, 0x8b, 0x83, 0x00, 0xf5, 0x4c, 0x65, 0xda, 0xeb, 0x1c, 0x53, 0x15, 0x4d, 0xf5, 0xc3,
-	0x84, 0x7e, 0x51, 0xa0, 0x52, 0x34, 0x0d, 0xa0, 0xc5, 0x7d, 0x8d, 0x0e, 0x02, 0xe3, 0x95, 0x03,
-	0x8c, 0x1b, 0xda, 0x0d, 0x8e, 0xf5, 0xea, 0x0d, 0x65, 0x5e, 0xd3, 0xf5, 0xdc, 0x71, 0xdd, 0x0c,
-	0xa8, 0x4d, 0x4c, 0x46, 0xc5, 0xbf, 0xd5, 0x03, 0xf2, 0x37, 0x05, 0xa6, 0xfa, 0x35, 0x66, 0xb4,
-	0x54, 0x94, 0xb5, 0x3d, 0x8c, 0x15, 0xea, 0x5b, 0x07, 0x33, 0x96, 0x71, 0xcd, 0xf2, 0xb8, 0x66,
-	0x50, 0x55, 0xef, 0xfb, 0x89, 0x84, 0x7e, 0x56, 0x60, 0xb2, 0x4f, 0x57, 0x46, 0x37, 0x8a, 0x50,
-	0x0c, 0x9e, 0x27, 0xd4, 0xa5, 0x03, 0xd9, 0xca, 0x00, 0xce, 0xf3, 0x00, 0xa6, 0xd1, 0xd9, 0xbe,
-	0xdf, 0x8d, 0xe8, 0x57, 0x05, 0xce, 0x14, 0x76, 0x36, 0x74, 0xbd, 0x08, 0xc1, 0xa0, 0xb6, 0xa9,
-	0xbe, 0x79, 0x00, 0x4b, 0x89, 0xbc, 0xce, 0x91, 0xd7, 0xd0, 0xac, 0xbe, 0xa7, 0x6f, 0x45, 0x14,
-	0xc0, 0xd1, 0xd4, 0xf0, 0x81, 0xde, 0x28, 0xda, 0x3b, 0x6f, 0xfc, 0x51, 0x2f, 0xed, 0x51, 0x5b,
-	0xa2, 0x2b, 0xa1, 0x4f, 0xe0, 0x54, 0x7e, 0x17, 0x45, 0x97, 0xf7, 0xda, 0xd1, 0x92, 0x9e, 0xad,
-	0x2e, 0xec, 0xc3, 0x42, 0x00, 0xb8, 0xac, 0xac, 0xac, 0x3f, 0x7f, 0x59, 0x55, 0x5e, 0xbc, 0xac,
-	0x2a, 0x7f, 0xbf, 0xac, 0x2a, 0x5f, 0xbf, 0xaa, 0x96, 0x5e, 0xbc, 0xaa, 0x96, 0xfe, 0x78, 0x55,
-	0x2d, 0x7d, 0x70, 0xcd, 0x71, 0xd9, 0x46, 0xbb, 0x51, 0xb7, 0xa8, 0x9f, 0x4e, 0x5e, 0xe7, 0xea,
-	0x25, 0xde, 0x50, 0xf4, 0xae, 0x64, 0x4b, 0x24, 0x94, 0x6d, 0xb7, 0x48, 0xd4, 0x38, 0xc4, 0xc5,
-	0x57, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xc6, 0xcc, 0x0a, 0xf6, 0x10, 0x00, 0x00,
+func (m *MarketOverview) GetMarketPrice() *types.MarketPrice {
+	if m != nil {
+		return m.MarketPrice
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a ClobPair by id.
-	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
-	// Queries the stateful order for a given order id.
-	StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error)
-	// Streams orderbook updates. Updates contain orderbook data
-	// such as order placements, updates, and fills.
-	StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error)
+// QueryAllMarketsOverviewResponse is a response message for
+// AllMarketsOverview.
+type QueryAllMarketsOverviewResponse struct {
+	Markets    []MarketOverview    `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllMarketsOverviewResponse) Reset()         { *m = QueryAllMarketsOverviewResponse{} }
+func (m *QueryAllMarketsOverviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllMarketsOverviewResponse) ProtoMessage()    {}
+func (*QueryAllMarketsOverviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{22}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAllMarketsOverviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
-	out := new(QueryClobPairResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllMarketsOverviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllMarketsOverviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllMarketsOverviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllMarketsOverviewResponse.Merge(m, src)
+}
+func (m *QueryAllMarketsOverviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllMarketsOverviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllMarketsOverviewResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
-	out := new(QueryClobPairAllResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllMarketsOverviewResponse proto.InternalMessageInfo
+
+func (m *QueryAllMarketsOverviewResponse) GetMarkets() []MarketOverview {
+	if m != nil {
+		return m.Markets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
-	out := new(MevNodeToNodeCalculationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllMarketsOverviewResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
-	out := new(QueryEquityTierLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryMaxOrderSizeRequest is a request message for MaxOrderSize.
+type QueryMaxOrderSizeRequest struct {
+	// Id of the subaccount placing the order.
+	SubaccountId types1.SubaccountId `protobuf:"bytes,1,opt,name=subaccount_id,json=subaccountId,proto3" json:"subaccount_id"`
+	// Id of the perpetual the order is for.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Side of the order.
+	Side Order_Side `protobuf:"varint,3,opt,name=side,proto3,enum=dydxprotocol.clob.Order_Side" json:"side,omitempty"`
 }
 
-func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
-	out := new(QueryBlockRateLimitConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMaxOrderSizeRequest) Reset()         { *m = QueryMaxOrderSizeRequest{} }
+func (m *QueryMaxOrderSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxOrderSizeRequest) ProtoMessage()    {}
+func (*QueryMaxOrderSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{23}
+}
+func (m *QueryMaxOrderSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxOrderSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxOrderSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMaxOrderSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxOrderSizeRequest.Merge(m, src)
+}
+func (m *QueryMaxOrderSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxOrderSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxOrderSizeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
-	out := new(QueryLiquidationsConfigurationResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMaxOrderSizeRequest proto.InternalMessageInfo
+
+func (m *QueryMaxOrderSizeRequest) GetSubaccountId() types1.SubaccountId {
+	if m != nil {
+		return m.SubaccountId
 	}
-	return out, nil
+	return types1.SubaccountId{}
 }
 
-func (c *queryClient) StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error) {
-	out := new(QueryStatefulOrderResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/StatefulOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMaxOrderSizeRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.clob.Query/StreamOrderbookUpdates", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &queryStreamOrderbookUpdatesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *QueryMaxOrderSizeRequest) GetSide() Order_Side {
+	if m != nil {
+		return m.Side
 	}
-	return x, nil
+	return Order_SIDE_UNSPECIFIED
 }
 
-type Query_StreamOrderbookUpdatesClient interface {
-	Recv() (*StreamOrderbookUpdatesResponse, error)
-	grpc.ClientStream
+// QueryMaxOrderSizeResponse is a response message that contains the largest
+// order size a subaccount can place.
+type QueryMaxOrderSizeResponse struct {
+	// The largest order size in base quantums, rounded down to a multiple of
+	// the step base quantums of the perpetual's ClobPair.
+	MaxSize uint64 `protobuf:"varint,1,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
 }
 
-type queryStreamOrderbookUpdatesClient struct {
-	grpc.ClientStream
+func (m *QueryMaxOrderSizeResponse) Reset()         { *m = QueryMaxOrderSizeResponse{} }
+func (m *QueryMaxOrderSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxOrderSizeResponse) ProtoMessage()    {}
+func (*QueryMaxOrderSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{24}
 }
-
-func (x *queryStreamOrderbookUpdatesClient) Recv() (*StreamOrderbookUpdatesResponse, error) {
-	m := new(StreamOrderbookUpdatesResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *QueryMaxOrderSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxOrderSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxOrderSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a ClobPair by id.
-	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
-	// Queries a list of ClobPair items.
-	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
-	// Runs the MEV node <> node calculation with the provided parameters.
-	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
-	// Queries EquityTierLimitConfiguration.
-	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
-	// Queries BlockRateLimitConfiguration.
-	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
-	// Queries LiquidationsConfiguration.
-	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
-	// Queries the stateful order for a given order id.
-	StatefulOrder(context.Context, *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error)
-	// Streams orderbook updates. Updates contain orderbook data
-	// such as order placements, updates, and fills.
-	StreamOrderbookUpdates(*StreamOrderbookUpdatesRequest, Query_StreamOrderbookUpdatesServer) error
+func (m *QueryMaxOrderSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxOrderSizeResponse.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryMaxOrderSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxOrderSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxOrderSizeResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
+var xxx_messageInfo_QueryMaxOrderSizeResponse proto.InternalMessageInfo
+
+func (m *QueryMaxOrderSizeResponse) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
+
+// QueryInsuranceFundBalanceRequest is a request message for
+// InsuranceFundBalance.
+type QueryInsuranceFundBalanceRequest struct {
+	// Id of the perpetual whose insurance fund to query.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 }
-func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
+
+func (m *QueryInsuranceFundBalanceRequest) Reset()         { *m = QueryInsuranceFundBalanceRequest{} }
+func (m *QueryInsuranceFundBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundBalanceRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{25}
 }
-func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+func (m *QueryInsuranceFundBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
+func (m *QueryInsuranceFundBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+func (m *QueryInsuranceFundBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundBalanceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StatefulOrder(ctx context.Context, req *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatefulOrder not implemented")
+func (m *QueryInsuranceFundBalanceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StreamOrderbookUpdates(req *StreamOrderbookUpdatesRequest, srv Query_StreamOrderbookUpdatesServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamOrderbookUpdates not implemented")
+func (m *QueryInsuranceFundBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundBalanceRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryInsuranceFundBalanceRequest proto.InternalMessageInfo
 
-func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+func (m *QueryInsuranceFundBalanceRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClobPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClobPairAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryInsuranceFundBalanceResponse is a response message for
+// InsuranceFundBalance.
+type QueryInsuranceFundBalanceResponse struct {
+	// Address of the insurance fund used by the perpetual. Isolated perpetuals
+	// have their own insurance fund, all other perpetuals share the cross
+	// insurance fund.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Balance of the insurance fund in quote quantums.
+	Balance github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"balance"`
 }
 
-func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MevNodeToNodeCalculationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryInsuranceFundBalanceResponse) Reset()         { *m = QueryInsuranceFundBalanceResponse{} }
+func (m *QueryInsuranceFundBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundBalanceResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{26}
 }
-
-func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEquityTierLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryInsuranceFundBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRateLimitConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
+func (m *QueryInsuranceFundBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryInsuranceFundBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundBalanceResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundBalanceResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidationsConfigurationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+var xxx_messageInfo_QueryInsuranceFundBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundBalanceResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StatefulOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatefulOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StatefulOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.clob.Query/StatefulOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StatefulOrder(ctx, req.(*QueryStatefulOrderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// StreamOrderbookUpdatesRequest is a request message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesRequest struct {
+	// Clob pair ids to stream orderbook updates for.
+	ClobPairId []uint32 `protobuf:"varint,1,rep,packed,name=clob_pair_id,json=clobPairId,proto3" json:"clob_pair_id,omitempty"`
 }
 
-func _Query_StreamOrderbookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamOrderbookUpdatesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *StreamOrderbookUpdatesRequest) Reset()         { *m = StreamOrderbookUpdatesRequest{} }
+func (m *StreamOrderbookUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesRequest) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{27}
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(QueryServer).StreamOrderbookUpdates(m, &queryStreamOrderbookUpdatesServer{stream})
 }
-
-type Query_StreamOrderbookUpdatesServer interface {
-	Send(*StreamOrderbookUpdatesResponse) error
-	grpc.ServerStream
+func (m *StreamOrderbookUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.Merge(m, src)
 }
-
-type queryStreamOrderbookUpdatesServer struct {
-	grpc.ServerStream
+func (m *StreamOrderbookUpdatesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *queryStreamOrderbookUpdatesServer) Send(m *StreamOrderbookUpdatesResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *StreamOrderbookUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.clob.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClobPair",
-			Handler:    _Query_ClobPair_Handler,
-		},
-		{
-			MethodName: "ClobPairAll",
-			Handler:    _Query_ClobPairAll_Handler,
-		},
-		{
-			MethodName: "MevNodeToNodeCalculation",
-			Handler:    _Query_MevNodeToNodeCalculation_Handler,
-		},
-		{
-			MethodName: "EquityTierLimitConfiguration",
-			Handler:    _Query_EquityTierLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "BlockRateLimitConfiguration",
-			Handler:    _Query_BlockRateLimitConfiguration_Handler,
-		},
-		{
-			MethodName: "LiquidationsConfiguration",
-			Handler:    _Query_LiquidationsConfiguration_Handler,
-		},
-		{
-			MethodName: "StatefulOrder",
-			Handler:    _Query_StatefulOrder_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamOrderbookUpdates",
-			Handler:       _Query_StreamOrderbookUpdates_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "dydxprotocol/clob/query.proto",
-}
+var xxx_messageInfo_StreamOrderbookUpdatesRequest proto.InternalMessageInfo
 
-func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookUpdatesRequest) GetClobPairId() []uint32 {
+	if m != nil {
+		return m.ClobPairId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamOrderbookUpdatesResponse is a response message for the
+// StreamOrderbookUpdates method.
+type StreamOrderbookUpdatesResponse struct {
+	// Batch of updates for the clob pair.
+	Updates []StreamUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
 }
 
-func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *StreamOrderbookUpdatesResponse) Reset()         { *m = StreamOrderbookUpdatesResponse{} }
+func (m *StreamOrderbookUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdatesResponse) ProtoMessage()    {}
+func (*StreamOrderbookUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{28}
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.Merge(m, src)
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamOrderbookUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamOrderbookUpdatesResponse proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdatesResponse) GetUpdates() []StreamUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamUpdate is an update that will be pushed through the
+// GRPC stream.
+type StreamUpdate struct {
+	// Contains one of an StreamOrderbookUpdate,
+	// StreamOrderbookFill.
+	//
+	// Types that are valid to be assigned to UpdateMessage:
+	//
+	//	*StreamUpdate_OrderbookUpdate
+	//	*StreamUpdate_OrderFill
+	UpdateMessage isStreamUpdate_UpdateMessage `protobuf_oneof:"update_message"`
+	// Block height of the update.
+	BlockHeight uint32 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Exec mode of the update.
+	ExecMode uint32 `protobuf:"varint,4,opt,name=exec_mode,json=execMode,proto3" json:"exec_mode,omitempty"`
 }
 
-func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *StreamUpdate) Reset()         { *m = StreamUpdate{} }
+func (m *StreamUpdate) String() string { return proto.CompactTextString(m) }
+func (*StreamUpdate) ProtoMessage()    {}
+func (*StreamUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{29}
+}
+func (m *StreamUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StreamUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamUpdate.Merge(m, src)
+}
+func (m *StreamUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamUpdate.DiscardUnknown(m)
 }
 
-func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StreamUpdate proto.InternalMessageInfo
+
+type isStreamUpdate_UpdateMessage interface {
+	isStreamUpdate_UpdateMessage()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type StreamUpdate_OrderbookUpdate struct {
+	OrderbookUpdate *StreamOrderbookUpdate `protobuf:"bytes,1,opt,name=orderbook_update,json=orderbookUpdate,proto3,oneof" json:"orderbook_update,omitempty"`
+}
+type StreamUpdate_OrderFill struct {
+	OrderFill *StreamOrderbookFill `protobuf:"bytes,2,opt,name=order_fill,json=orderFill,proto3,oneof" json:"order_fill,omitempty"`
 }
 
-func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (*StreamUpdate_OrderbookUpdate) isStreamUpdate_UpdateMessage() {}
+func (*StreamUpdate_OrderFill) isStreamUpdate_UpdateMessage()       {}
+
+func (m *StreamUpdate) GetUpdateMessage() isStreamUpdate_UpdateMessage {
+	if m != nil {
+		return m.UpdateMessage
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamUpdate) GetOrderbookUpdate() *StreamOrderbookUpdate {
+	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderbookUpdate); ok {
+		return x.OrderbookUpdate
+	}
+	return nil
 }
 
-func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClobPair) > 0 {
-		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *StreamUpdate) GetOrderFill() *StreamOrderbookFill {
+	if x, ok := m.GetUpdateMessage().(*StreamUpdate_OrderFill); ok {
+		return x.OrderFill
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamUpdate) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamUpdate) GetExecMode() uint32 {
+	if m != nil {
+		return m.ExecMode
+	}
+	return 0
 }
 
-func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorMevMetrics != nil {
-		{
-			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockProposerMatches != nil {
-		{
-			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamUpdate_OrderbookUpdate)(nil),
+		(*StreamUpdate_OrderFill)(nil),
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// StreamOrderbookUpdate provides information on an orderbook update. Used in
+// the full node GRPC stream.
+type StreamOrderbookUpdate struct {
+	// Orderbook updates for the clob pair. Can contain order place, removals,
+	// or updates.
+	Updates []types2.OffChainUpdateV1 `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// Snapshot indicates if the response is from a snapshot of the orderbook.
+	// All updates should be ignored until snapshot is recieved.
+	// If the snapshot is true, then all previous entries should be
+	// discarded and the orderbook should be resynced.
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 }
 
-func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdate) Reset()         { *m = StreamOrderbookUpdate{} }
+func (m *StreamOrderbookUpdate) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookUpdate) ProtoMessage()    {}
+func (*StreamOrderbookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{30}
 }
-
-func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StreamOrderbookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamOrderbookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StreamOrderbookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookUpdate.Merge(m, src)
 }
-
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdate) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Volume != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Mev != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
-		i--
-		dAtA[i] = 0x15
-	}
-	if m.ClobPairId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *StreamOrderbookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookUpdate.DiscardUnknown(m)
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamOrderbookUpdate proto.InternalMessageInfo
+
+func (m *StreamOrderbookUpdate) GetUpdates() []types2.OffChainUpdateV1 {
+	if m != nil {
+		return m.Updates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookUpdate) GetSnapshot() bool {
+	if m != nil {
+		return m.Snapshot
+	}
+	return false
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// StreamOrderbookFill provides information on an orderbook fill. Used in
+// the full node GRPC stream.
+type StreamOrderbookFill struct {
+	// Clob match. Provides information on which orders were matched
+	// and the type of order.
+	ClobMatch *ClobMatch `protobuf:"bytes,1,opt,name=clob_match,json=clobMatch,proto3" json:"clob_match,omitempty"`
+	// All orders involved in the specified clob match. Used to look up
+	// price of a match through a given maker order id.
+	Orders []Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	// Resulting fill amounts for each order in the orders array.
+	FillAmounts []uint64 `protobuf:"varint,3,rep,packed,name=fill_amounts,json=fillAmounts,proto3" json:"fill_amounts,omitempty"`
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StreamOrderbookFill) Reset()         { *m = StreamOrderbookFill{} }
+func (m *StreamOrderbookFill) String() string { return proto.CompactTextString(m) }
+func (*StreamOrderbookFill) ProtoMessage()    {}
+func (*StreamOrderbookFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3365c195b25c5bc0, []int{31}
 }
-
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+func (m *StreamOrderbookFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamOrderbookFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamOrderbookFill.Merge(m, src)
 }
-
-func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *StreamOrderbookFill) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StreamOrderbookFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamOrderbookFill.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_StreamOrderbookFill proto.InternalMessageInfo
 
-func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *StreamOrderbookFill) GetClobMatch() *ClobMatch {
+	if m != nil {
+		return m.ClobMatch
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryStatefulOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamOrderbookFill) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatefulOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamOrderbookFill) GetFillAmounts() []uint64 {
+	if m != nil {
+		return m.FillAmounts
+	}
+	return nil
 }
 
-func (m *QueryStatefulOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryGetClobPairRequest)(nil), "dydxprotocol.clob.QueryGetClobPairRequest")
+	proto.RegisterType((*QueryClobPairResponse)(nil), "dydxprotocol.clob.QueryClobPairResponse")
+	proto.RegisterType((*QueryAllClobPairRequest)(nil), "dydxprotocol.clob.QueryAllClobPairRequest")
+	proto.RegisterType((*QueryClobPairAllResponse)(nil), "dydxprotocol.clob.QueryClobPairAllResponse")
+	proto.RegisterType((*QueryClobPairsByStatusRequest)(nil), "dydxprotocol.clob.QueryClobPairsByStatusRequest")
+	proto.RegisterType((*QueryClobPairsByStatusResponse)(nil), "dydxprotocol.clob.QueryClobPairsByStatusResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationRequest)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationRequest")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse")
+	proto.RegisterType((*MevNodeToNodeCalculationResponse_MevAndVolumePerClob)(nil), "dydxprotocol.clob.MevNodeToNodeCalculationResponse.MevAndVolumePerClob")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationRequest")
+	proto.RegisterType((*QueryEquityTierLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryEquityTierLimitConfigurationResponse")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationRequest)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationRequest")
+	proto.RegisterType((*QueryBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.QueryBlockRateLimitConfigurationResponse")
+	proto.RegisterType((*QueryStatefulOrderRequest)(nil), "dydxprotocol.clob.QueryStatefulOrderRequest")
+	proto.RegisterType((*QueryStatefulOrderResponse)(nil), "dydxprotocol.clob.QueryStatefulOrderResponse")
+	proto.RegisterType((*QueryLiquidationsConfigurationRequest)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationRequest")
+	proto.RegisterType((*QueryLiquidationsConfigurationResponse)(nil), "dydxprotocol.clob.QueryLiquidationsConfigurationResponse")
+	proto.RegisterType((*QueryLastBlockRejectedOperationsRequest)(nil), "dydxprotocol.clob.QueryLastBlockRejectedOperationsRequest")
+	proto.RegisterType((*QueryLastBlockRejectedOperationsResponse)(nil), "dydxprotocol.clob.QueryLastBlockRejectedOperationsResponse")
+	proto.RegisterType((*QueryClobPairMarketPriceRequest)(nil), "dydxprotocol.clob.QueryClobPairMarketPriceRequest")
+	proto.RegisterType((*QueryClobPairMarketPriceResponse)(nil), "dydxprotocol.clob.QueryClobPairMarketPriceResponse")
+	proto.RegisterType((*QueryAllMarketsOverviewRequest)(nil), "dydxprotocol.clob.QueryAllMarketsOverviewRequest")
+	proto.RegisterType((*MarketOverview)(nil), "dydxprotocol.clob.MarketOverview")
+	proto.RegisterType((*QueryAllMarketsOverviewResponse)(nil), "dydxprotocol.clob.QueryAllMarketsOverviewResponse")
+	proto.RegisterType((*QueryMaxOrderSizeRequest)(nil), "dydxprotocol.clob.QueryMaxOrderSizeRequest")
+	proto.RegisterType((*QueryMaxOrderSizeResponse)(nil), "dydxprotocol.clob.QueryMaxOrderSizeResponse")
+	proto.RegisterType((*QueryInsuranceFundBalanceRequest)(nil), "dydxprotocol.clob.QueryInsuranceFundBalanceRequest")
+	proto.RegisterType((*QueryInsuranceFundBalanceResponse)(nil), "dydxprotocol.clob.QueryInsuranceFundBalanceResponse")
+	proto.RegisterType((*StreamOrderbookUpdatesRequest)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesRequest")
+	proto.RegisterType((*StreamOrderbookUpdatesResponse)(nil), "dydxprotocol.clob.StreamOrderbookUpdatesResponse")
+	proto.RegisterType((*StreamUpdate)(nil), "dydxprotocol.clob.StreamUpdate")
+	proto.RegisterType((*StreamOrderbookUpdate)(nil), "dydxprotocol.clob.StreamOrderbookUpdate")
+	proto.RegisterType((*StreamOrderbookFill)(nil), "dydxprotocol.clob.StreamOrderbookFill")
 }
 
-func (m *QueryStatefulOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("dydxprotocol/clob/query.proto", fileDescriptor_3365c195b25c5bc0) }
+
+var fileDescriptor_3365c195b25c5bc0 = []byte{
+	// 2082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x4f, 0x1c, 0xc9,
+	0x15, 0xa6, 0x81, 0x18, 0x78, 0x60, 0xd6, 0x5b, 0xd8, 0x5e, 0xdc, 0xc0, 0x00, 0xbd, 0x6b, 0x0c,
+	0xf6, 0xee, 0xb4, 0x19, 0x5b, 0x96, 0x17, 0xa2, 0xcd, 0x02, 0x5a, 0xdb, 0x48, 0x26, 0x66, 0x1b,
+	0xaf, 0x63, 0xc5, 0x1b, 0x75, 0x6a, 0xba, 0x8b, 0xa1, 0x43, 0x4f, 0xd7, 0xb8, 0xab, 0x7b, 0x16,
+	0x6c, 0xa1, 0x44, 0x39, 0xe4, 0x92, 0x44, 0x8a, 0x94, 0x43, 0x0e, 0x39, 0x45, 0xb9, 0x44, 0x51,
+	0x72, 0x59, 0x29, 0xc7, 0x28, 0x89, 0xf6, 0xb2, 0x47, 0x47, 0xb9, 0x44, 0x51, 0x64, 0x45, 0x76,
+	0xce, 0xf9, 0x0d, 0x51, 0x57, 0x55, 0x0f, 0xdd, 0xd3, 0xdd, 0x33, 0x03, 0xf2, 0x05, 0xa6, 0x5f,
+	0xbf, 0xf7, 0xea, 0x7b, 0xaf, 0x5e, 0xd5, 0x7b, 0x5f, 0xc3, 0x8c, 0x7d, 0x68, 0x1f, 0x34, 0x7c,
+	0x1a, 0x50, 0x8b, 0xba, 0xba, 0xe5, 0xd2, 0xaa, 0xfe, 0x34, 0x24, 0xfe, 0x61, 0x99, 0xcb, 0xd0,
+	0xdb, 0xc9, 0xd7, 0xe5, 0xe8, 0xb5, 0x7a, 0xbe, 0x46, 0x6b, 0x94, 0x8b, 0xf4, 0xe8, 0x97, 0x50,
+	0x54, 0xa7, 0x6b, 0x94, 0xd6, 0x5c, 0xa2, 0xe3, 0x86, 0xa3, 0x63, 0xcf, 0xa3, 0x01, 0x0e, 0x1c,
+	0xea, 0x31, 0xf9, 0xf6, 0xaa, 0x45, 0x59, 0x9d, 0x32, 0xbd, 0x8a, 0x19, 0x11, 0xfe, 0xf5, 0xe6,
+	0x72, 0x95, 0x04, 0x78, 0x59, 0x6f, 0xe0, 0x9a, 0xe3, 0x71, 0x65, 0xa9, 0xab, 0x67, 0x11, 0x55,
+	0x5d, 0x6a, 0xed, 0x9b, 0x3e, 0x0e, 0x88, 0xe9, 0x3a, 0x75, 0x27, 0x30, 0x2d, 0xea, 0xed, 0x3a,
+	0x35, 0x69, 0x30, 0x9f, 0x35, 0x88, 0xfe, 0x98, 0x0d, 0xec, 0xf8, 0x52, 0xe5, 0x7a, 0x56, 0x85,
+	0x3c, 0x0d, 0x9d, 0xe0, 0xd0, 0x0c, 0x1c, 0xe2, 0xe7, 0x39, 0xcd, 0xc9, 0x0b, 0xf5, 0x6d, 0x12,
+	0x3b, 0x9c, 0xcd, 0xbe, 0xae, 0xe3, 0xc0, 0xda, 0x23, 0x71, 0xc4, 0xd7, 0xb2, 0x0a, 0xae, 0xf3,
+	0x34, 0x74, 0x6c, 0x91, 0x97, 0xf4, 0x62, 0x53, 0x39, 0xde, 0x48, 0xb3, 0x38, 0x3c, 0xda, 0x20,
+	0x7e, 0x32, 0x65, 0x1f, 0xa5, 0x54, 0x1c, 0xcf, 0x26, 0x07, 0xc4, 0xd7, 0xe9, 0xee, 0xae, 0x69,
+	0xed, 0x61, 0xc7, 0x33, 0xc3, 0x86, 0x8d, 0x03, 0xc2, 0xb2, 0x12, 0x69, 0xbf, 0x90, 0xb2, 0x6f,
+	0xf8, 0x8e, 0x45, 0x98, 0x5e, 0xc7, 0xfe, 0x3e, 0x09, 0x4c, 0xfe, 0x24, 0xf5, 0x96, 0x52, 0x7a,
+	0x2c, 0xac, 0x62, 0xcb, 0xa2, 0xa1, 0x17, 0xb0, 0xc4, 0x6f, 0xa1, 0xaa, 0x2d, 0xc1, 0x3b, 0x9f,
+	0x46, 0xfb, 0x7c, 0x97, 0x04, 0x1b, 0x2e, 0xad, 0x6e, 0x63, 0xc7, 0x37, 0xc8, 0xd3, 0x90, 0xb0,
+	0x00, 0x8d, 0x43, 0xbf, 0x63, 0x4f, 0x2a, 0x73, 0xca, 0xe2, 0x59, 0xa3, 0xdf, 0xb1, 0xb5, 0xef,
+	0xc0, 0x05, 0xae, 0x7a, 0xac, 0xc7, 0x1a, 0xd4, 0x63, 0x04, 0x7d, 0x04, 0x23, 0xad, 0x8d, 0xe4,
+	0xfa, 0xa3, 0x95, 0xa9, 0x72, 0xa6, 0x20, 0xcb, 0xb1, 0xdd, 0xfa, 0xe0, 0xd7, 0x2f, 0x67, 0xfb,
+	0x8c, 0x61, 0x4b, 0x3e, 0x6b, 0x58, 0x62, 0x58, 0x73, 0xdd, 0x76, 0x0c, 0x77, 0x00, 0x8e, 0x0b,
+	0x4f, 0xfa, 0x5e, 0x28, 0x8b, 0x2a, 0x2d, 0x47, 0x55, 0x5a, 0x16, 0xa7, 0x40, 0x56, 0x69, 0x79,
+	0x1b, 0xd7, 0x88, 0xb4, 0x35, 0x12, 0x96, 0xda, 0x6f, 0x15, 0x98, 0x4c, 0x81, 0x5f, 0x73, 0xdd,
+	0x22, 0xfc, 0x03, 0x27, 0xc4, 0x8f, 0xee, 0xa6, 0x40, 0xf6, 0x73, 0x90, 0x57, 0xba, 0x82, 0x14,
+	0x8b, 0xa7, 0x50, 0x3e, 0x81, 0x99, 0x14, 0x48, 0xb6, 0x7e, 0xb8, 0x13, 0xe0, 0x20, 0x64, 0x71,
+	0x3a, 0x56, 0xe0, 0x0c, 0xe3, 0x02, 0x9e, 0x8a, 0xf1, 0x8a, 0xd6, 0x01, 0x66, 0x59, 0x9a, 0x4a,
+	0x0b, 0xed, 0xfb, 0x50, 0x2a, 0x72, 0xfe, 0x66, 0xf2, 0xa0, 0xfd, 0x5b, 0x81, 0xd9, 0x2d, 0xd2,
+	0xfc, 0x36, 0xb5, 0xc9, 0x43, 0x1a, 0xfd, 0xdd, 0xc0, 0xae, 0x15, 0xba, 0x3c, 0xb6, 0x38, 0x82,
+	0xcf, 0xe1, 0xa2, 0xb8, 0x25, 0x1a, 0x3e, 0x6d, 0x50, 0x46, 0x7c, 0x53, 0x9e, 0xc7, 0xd6, 0xe6,
+	0x66, 0x17, 0x7c, 0x84, 0xdd, 0xe8, 0x3c, 0x52, 0x7f, 0x8b, 0x34, 0xb7, 0x84, 0xb6, 0x71, 0x9e,
+	0x7b, 0xd9, 0x96, 0x4e, 0xa4, 0x14, 0x3d, 0x81, 0x0b, 0xcd, 0x58, 0xd9, 0xac, 0x93, 0xa6, 0x59,
+	0x27, 0x81, 0xef, 0x58, 0xac, 0xb5, 0x29, 0x59, 0xe7, 0x29, 0xc0, 0x5b, 0x42, 0xdd, 0x98, 0x68,
+	0x26, 0x97, 0x14, 0x42, 0xed, 0x7f, 0x0a, 0xcc, 0x15, 0x87, 0x27, 0x73, 0x58, 0x83, 0x21, 0x9f,
+	0xb0, 0xd0, 0x0d, 0x98, 0xcc, 0xe0, 0xdd, 0x6e, 0x6b, 0xe6, 0x78, 0x89, 0x14, 0xd6, 0x3c, 0xfb,
+	0x11, 0x75, 0xc3, 0x3a, 0xd9, 0x26, 0x7e, 0x94, 0x71, 0x99, 0xed, 0xd8, 0xbb, 0x8a, 0x61, 0x22,
+	0x47, 0x0b, 0xcd, 0xc1, 0x58, 0x6b, 0x0f, 0xcd, 0xd6, 0xf1, 0x85, 0x78, 0x8f, 0x36, 0x6d, 0x74,
+	0x0e, 0x06, 0xea, 0xa4, 0xc9, 0x33, 0xd2, 0x6f, 0x44, 0x3f, 0xd1, 0x45, 0x38, 0xd3, 0xe4, 0x4e,
+	0x26, 0x07, 0xe6, 0x94, 0xc5, 0x41, 0x43, 0x3e, 0x69, 0x57, 0x61, 0x91, 0x57, 0xcc, 0x27, 0xfc,
+	0x0a, 0x7e, 0xe8, 0x10, 0xff, 0x7e, 0x74, 0x01, 0x6f, 0xf0, 0x2b, 0x31, 0xf4, 0x93, 0xfb, 0xaa,
+	0xfd, 0x5a, 0x81, 0xa5, 0x1e, 0x94, 0x65, 0x96, 0x3c, 0x98, 0x2c, 0xba, 0xd7, 0x65, 0x1d, 0xe8,
+	0x39, 0x69, 0xeb, 0xe4, 0x5a, 0xa6, 0xe7, 0x02, 0xc9, 0xd3, 0xd1, 0x96, 0xe0, 0x0a, 0x07, 0xb7,
+	0x1e, 0x15, 0x8d, 0x81, 0x03, 0x52, 0x1c, 0xc8, 0xaf, 0x14, 0x58, 0xec, 0xae, 0x2b, 0xe3, 0xd8,
+	0x87, 0x77, 0x0a, 0x7a, 0x9e, 0x0c, 0xa3, 0x9c, 0x13, 0x46, 0x07, 0xc7, 0x32, 0x8a, 0xf3, 0xd5,
+	0x1c, 0x15, 0xed, 0x31, 0x5c, 0xe2, 0xc0, 0xa2, 0x53, 0x4b, 0x76, 0x43, 0xf7, 0x41, 0xd4, 0xe7,
+	0xe2, 0x73, 0xb5, 0x0a, 0xc3, 0xbc, 0xef, 0xc5, 0x7b, 0x3e, 0x5a, 0x51, 0x73, 0x96, 0xe6, 0x26,
+	0x9b, 0x76, 0x5c, 0x4b, 0x54, 0x3c, 0x6a, 0x7f, 0x52, 0x40, 0xcd, 0x73, 0x2d, 0xa3, 0x7c, 0x0c,
+	0x6f, 0x09, 0xdf, 0x0d, 0x17, 0x5b, 0xa4, 0x4e, 0xbc, 0x40, 0x2e, 0xb1, 0x94, 0xb3, 0xc4, 0x7d,
+	0xea, 0xd5, 0x1e, 0x12, 0xbf, 0xce, 0x5d, 0x6c, 0xc7, 0x06, 0x72, 0xc5, 0x71, 0x9a, 0x92, 0xa2,
+	0x59, 0x18, 0xdd, 0x75, 0x5c, 0xd7, 0xc4, 0xf5, 0xa8, 0x25, 0xf1, 0x9a, 0x1c, 0x34, 0x20, 0x12,
+	0xad, 0x71, 0x09, 0x9a, 0x86, 0x91, 0xc0, 0x77, 0x6a, 0x35, 0xe2, 0x13, 0x9b, 0x57, 0xe7, 0xb0,
+	0x71, 0x2c, 0xd0, 0xae, 0xc0, 0x65, 0x0e, 0xfb, 0x7e, 0xa2, 0x63, 0xe7, 0x6e, 0xea, 0x4f, 0x14,
+	0x58, 0xe8, 0xa6, 0x29, 0x83, 0xfd, 0x1c, 0x26, 0x72, 0x06, 0x00, 0x19, 0xf0, 0xe5, 0xbc, 0x80,
+	0x33, 0x2e, 0x65, 0xb0, 0xc8, 0xcd, 0xbc, 0x69, 0x15, 0xe2, 0x7d, 0xcc, 0x02, 0x51, 0x07, 0xe4,
+	0x07, 0xc4, 0x0a, 0x88, 0xfd, 0x20, 0x1e, 0x15, 0xe2, 0xbb, 0x5e, 0xfb, 0x7d, 0x5c, 0x88, 0x1d,
+	0x75, 0x25, 0xea, 0x79, 0x18, 0x13, 0x85, 0xb8, 0x47, 0x9c, 0xda, 0x5e, 0x20, 0x8f, 0xfd, 0x28,
+	0x97, 0xdd, 0xe3, 0x22, 0xf4, 0x04, 0x26, 0x7c, 0xe9, 0xc0, 0x6c, 0x0d, 0x26, 0xd1, 0xcd, 0x18,
+	0xdd, 0x52, 0xef, 0xe5, 0x04, 0x96, 0x59, 0x2e, 0x8e, 0xcb, 0xcf, 0xe0, 0xd0, 0x36, 0x60, 0x36,
+	0xd5, 0x5c, 0xb6, 0xf8, 0x50, 0xb2, 0x1d, 0xcd, 0x24, 0x71, 0x85, 0x76, 0xbd, 0x99, 0xb4, 0x3a,
+	0xcc, 0x15, 0x3b, 0x91, 0x81, 0x6e, 0xc2, 0x58, 0x72, 0xe0, 0x91, 0xfb, 0x32, 0x97, 0x86, 0xcf,
+	0x5f, 0xb1, 0x72, 0xc2, 0x5e, 0x42, 0x1f, 0xad, 0x1f, 0x8b, 0xb4, 0x3d, 0xd9, 0x10, 0xd7, 0x5c,
+	0x57, 0x68, 0xb2, 0x07, 0x4d, 0xe2, 0x37, 0x1d, 0xf2, 0xc5, 0x9b, 0x9e, 0x3e, 0xbe, 0x54, 0x60,
+	0x5c, 0x2c, 0x11, 0xaf, 0xd0, 0xc3, 0x3d, 0x3d, 0x0f, 0x63, 0x0d, 0xe2, 0x37, 0x48, 0x10, 0x62,
+	0x37, 0xd2, 0xe8, 0x17, 0x5b, 0xda, 0x92, 0x6d, 0xda, 0xd1, 0xc5, 0x1d, 0x38, 0xd6, 0x3e, 0xf1,
+	0xf9, 0xd1, 0x18, 0x31, 0xe4, 0x13, 0xda, 0x68, 0x4b, 0xd2, 0x60, 0x6f, 0x49, 0x4a, 0xa7, 0xe7,
+	0x8f, 0x0a, 0xcc, 0x16, 0xe6, 0x47, 0xee, 0xc6, 0x1a, 0x0c, 0x09, 0x93, 0xb8, 0xdb, 0xcd, 0xe7,
+	0x75, 0xbb, 0x54, 0xe4, 0xf1, 0xdd, 0x23, 0xed, 0xde, 0xdc, 0xf0, 0xf4, 0x55, 0x3c, 0xe2, 0x6d,
+	0xe1, 0x03, 0x7e, 0xf9, 0xec, 0x38, 0xcf, 0x5a, 0xc5, 0xf7, 0x29, 0x9c, 0x3d, 0x1e, 0x7d, 0x8f,
+	0xef, 0xc8, 0xb6, 0x69, 0xe3, 0x58, 0x85, 0x95, 0x77, 0x5a, 0xbf, 0x5b, 0xf7, 0xe5, 0x18, 0x4b,
+	0xc8, 0x7a, 0xd9, 0x9f, 0x65, 0x18, 0x64, 0x8e, 0x2d, 0xda, 0xea, 0x78, 0x65, 0xa6, 0xe8, 0x42,
+	0x2e, 0xef, 0x38, 0x36, 0x31, 0xb8, 0xaa, 0x76, 0x0b, 0x2e, 0xe5, 0x04, 0x21, 0xd3, 0x7d, 0x09,
+	0x86, 0xeb, 0xf8, 0xc0, 0x64, 0xce, 0x33, 0x51, 0xf8, 0x83, 0x51, 0x1a, 0x0f, 0x22, 0x15, 0xed,
+	0x13, 0x79, 0x76, 0x36, 0x3d, 0x16, 0xfa, 0xd8, 0xb3, 0xc8, 0x9d, 0xd0, 0xb3, 0xd7, 0xb1, 0x1b,
+	0xfd, 0x8c, 0x93, 0xd0, 0x8e, 0x58, 0xc9, 0x20, 0xd6, 0x7e, 0xa3, 0xc0, 0x7c, 0x07, 0x3f, 0x12,
+	0xc7, 0x24, 0x0c, 0x61, 0xdb, 0xf6, 0x09, 0x13, 0x53, 0xdb, 0x88, 0x11, 0x3f, 0xa2, 0x2a, 0x0c,
+	0x55, 0x85, 0x32, 0xcf, 0xc7, 0xd8, 0xfa, 0xbd, 0x28, 0x73, 0xff, 0x7a, 0x39, 0xfb, 0x71, 0xcd,
+	0x09, 0xf6, 0xc2, 0x6a, 0xd9, 0xa2, 0xf5, 0x34, 0x71, 0x6c, 0xde, 0xfc, 0x80, 0x53, 0x1d, 0xbd,
+	0x25, 0xb1, 0x83, 0xc3, 0x06, 0x61, 0xe5, 0x1d, 0xe2, 0x3b, 0xd8, 0x75, 0x9e, 0xe1, 0xaa, 0x4b,
+	0x36, 0xbd, 0xc0, 0x88, 0x1d, 0x6b, 0x6b, 0x30, 0xb3, 0x13, 0xf8, 0x04, 0x8b, 0x16, 0x53, 0xa5,
+	0x74, 0xff, 0x33, 0xc1, 0x92, 0x8a, 0x6f, 0x9a, 0x81, 0xb6, 0x9b, 0x06, 0x43, 0xa9, 0xc8, 0x85,
+	0x0c, 0xf1, 0x5b, 0x30, 0x24, 0xb9, 0x97, 0xac, 0xec, 0xd9, 0x9c, 0xdd, 0x13, 0x3e, 0x84, 0x69,
+	0x5c, 0xd7, 0xd2, 0x4a, 0xfb, 0x51, 0x3f, 0x8c, 0x25, 0xdf, 0xa3, 0xcf, 0xe0, 0x1c, 0x8d, 0x57,
+	0x93, 0xbc, 0x4e, 0x56, 0xe1, 0x62, 0xa1, 0xeb, 0x36, 0x78, 0xf7, 0xfa, 0x8c, 0xb7, 0x68, 0x5a,
+	0x14, 0x9d, 0x1f, 0xd1, 0x9c, 0xa3, 0xae, 0x39, 0xd9, 0x9f, 0x57, 0xd6, 0x79, 0x0e, 0xef, 0x38,
+	0xae, 0x7b, 0xaf, 0xcf, 0x18, 0xe1, 0xb6, 0xd1, 0x43, 0xa6, 0x85, 0x0c, 0x64, 0x5b, 0xc8, 0x14,
+	0x8c, 0x90, 0x03, 0x62, 0x99, 0x75, 0x6a, 0x8b, 0x4b, 0xe5, 0xac, 0x31, 0x1c, 0x09, 0xb6, 0xa8,
+	0x4d, 0xd6, 0xcf, 0xc1, 0xb8, 0x88, 0xca, 0xac, 0x13, 0xc6, 0x70, 0x8d, 0x68, 0x3f, 0x57, 0xe0,
+	0x42, 0x6e, 0x1c, 0xe8, 0x71, 0x7b, 0x76, 0x6f, 0xa7, 0x11, 0x4b, 0x6a, 0x5c, 0xce, 0x12, 0xe1,
+	0x07, 0xbb, 0xbb, 0x1b, 0x91, 0x40, 0x38, 0x7a, 0xb4, 0xdc, 0x96, 0x76, 0xa4, 0xc2, 0x30, 0xf3,
+	0x70, 0x83, 0xed, 0x51, 0x31, 0x4e, 0x0c, 0x1b, 0xad, 0xe7, 0xe8, 0x46, 0x9b, 0xc8, 0x49, 0x03,
+	0x5a, 0x05, 0x5e, 0x1b, 0x82, 0x89, 0xc8, 0x3d, 0x99, 0x2e, 0x20, 0x3e, 0x9c, 0x69, 0x18, 0x23,
+	0x56, 0xfc, 0x13, 0xdd, 0x82, 0x33, 0x3c, 0x87, 0x71, 0x27, 0x9d, 0x2c, 0x3a, 0xe5, 0x12, 0xa9,
+	0xd4, 0x8e, 0xd2, 0x9d, 0x18, 0x7d, 0xd8, 0xe4, 0xc0, 0xdc, 0xc0, 0xe2, 0xa0, 0x31, 0x7a, 0x3c,
+	0xfb, 0xb0, 0xca, 0x1f, 0x26, 0xe0, 0x1b, 0xfc, 0x30, 0xa2, 0x9f, 0x2a, 0x30, 0x1c, 0x77, 0x45,
+	0x74, 0x35, 0x67, 0x85, 0x02, 0x0e, 0xaf, 0x2e, 0x16, 0xe9, 0xb6, 0x93, 0x78, 0x6d, 0xe9, 0xc7,
+	0xff, 0xf8, 0xef, 0x2f, 0xfb, 0xdf, 0x45, 0xf3, 0x7a, 0x87, 0xcf, 0x34, 0xfa, 0x73, 0xc7, 0x3e,
+	0x42, 0x3f, 0x53, 0x60, 0x34, 0xc1, 0xa3, 0x8b, 0x01, 0x65, 0x09, 0xbd, 0x7a, 0xad, 0x1b, 0xa0,
+	0x04, 0x31, 0xd7, 0xde, 0xe3, 0x98, 0x4a, 0x68, 0xba, 0x13, 0x26, 0xf4, 0xa5, 0x02, 0x6f, 0x67,
+	0x48, 0x2d, 0xba, 0xde, 0x6d, 0xa1, 0x76, 0x72, 0xad, 0x2e, 0x9f, 0xc0, 0x42, 0x02, 0xbc, 0xcd,
+	0x01, 0x56, 0xd0, 0xf5, 0x4e, 0x00, 0x99, 0x59, 0x3d, 0x34, 0x05, 0x09, 0xd7, 0x9f, 0x8b, 0xff,
+	0x47, 0xe8, 0x2f, 0x0a, 0x4c, 0x16, 0xd1, 0x40, 0x54, 0x39, 0x11, 0x67, 0x14, 0xe8, 0x6f, 0x9c,
+	0x82, 0x67, 0x6a, 0x2b, 0x1c, 0xff, 0xcd, 0x15, 0xe5, 0xaa, 0xa6, 0xeb, 0xb9, 0x1f, 0xb7, 0x4c,
+	0x8f, 0xda, 0xc4, 0x0c, 0xa8, 0xf8, 0x6f, 0x25, 0x40, 0xfe, 0x4d, 0x81, 0xe9, 0x4e, 0x8c, 0x0c,
+	0xad, 0x16, 0xe5, 0xb3, 0x07, 0x3e, 0xa9, 0x7e, 0xf3, 0x74, 0xc6, 0x32, 0xae, 0x05, 0x1e, 0xd7,
+	0x1c, 0x2a, 0xe9, 0x1d, 0x3f, 0x28, 0xa2, 0x3f, 0x2b, 0x30, 0xd5, 0x81, 0x8e, 0xa1, 0x95, 0x22,
+	0x14, 0xdd, 0x89, 0xa4, 0xba, 0x7a, 0x2a, 0x5b, 0x19, 0xc0, 0x65, 0x1e, 0xc0, 0x2c, 0x9a, 0xe9,
+	0xf8, 0x95, 0x15, 0xfd, 0x55, 0x81, 0x4b, 0x85, 0x94, 0x06, 0xdd, 0x2e, 0x42, 0xd0, 0x8d, 0x2f,
+	0xa9, 0x1f, 0x9e, 0xc2, 0x52, 0x22, 0x2f, 0x73, 0xe4, 0x8b, 0x68, 0x41, 0xef, 0xe9, 0xcb, 0x2a,
+	0x72, 0xe1, 0x6c, 0x8a, 0x75, 0xa2, 0xf7, 0x8b, 0xd6, 0xce, 0xe3, 0xbd, 0xea, 0x07, 0x3d, 0x6a,
+	0xcb, 0xb6, 0xfe, 0x77, 0x05, 0xa6, 0x3a, 0xf0, 0xa9, 0xe2, 0x0d, 0xef, 0x4e, 0xd8, 0xd4, 0xd5,
+	0x53, 0xd9, 0xca, 0xb4, 0x7d, 0xc8, 0xd3, 0x76, 0x03, 0x2d, 0xe7, 0xa5, 0x0d, 0xb3, 0xc0, 0x94,
+	0xbb, 0x9e, 0x65, 0x70, 0x51, 0x11, 0x4c, 0xe4, 0x50, 0x26, 0x54, 0x29, 0xc2, 0x53, 0x4c, 0xd2,
+	0xd4, 0x1b, 0x27, 0xb2, 0x91, 0xd8, 0x3f, 0xe6, 0xd8, 0x57, 0xd0, 0xed, 0xce, 0xad, 0x23, 0x39,
+	0x93, 0x1d, 0xa5, 0x3e, 0x5b, 0xa3, 0xdf, 0x29, 0x80, 0xb2, 0x34, 0x03, 0x2d, 0x77, 0x68, 0x2c,
+	0xf9, 0x94, 0x4d, 0xad, 0x9c, 0xc4, 0x44, 0xe2, 0xbf, 0xc6, 0xf1, 0x5f, 0x46, 0xef, 0xe6, 0x5d,
+	0x81, 0xc2, 0xc6, 0xa4, 0x31, 0xa6, 0x17, 0x0a, 0x8c, 0x25, 0x87, 0x73, 0x54, 0xd8, 0xd1, 0x72,
+	0x78, 0x88, 0xfa, 0x7e, 0x6f, 0xca, 0x12, 0x18, 0xe1, 0xc0, 0x4c, 0xf4, 0xbd, 0x5c, 0x60, 0x07,
+	0xa6, 0x18, 0xfc, 0x22, 0x3a, 0xa0, 0x3f, 0x4f, 0xd1, 0x9b, 0x32, 0xfd, 0xc2, 0x23, 0xfe, 0x51,
+	0xbb, 0xd4, 0x0b, 0xeb, 0x55, 0x2e, 0x4e, 0xd2, 0x80, 0x23, 0xf4, 0x95, 0x02, 0xe7, 0xf3, 0xe6,
+	0x7d, 0x54, 0x58, 0x0d, 0x1d, 0x58, 0x86, 0x7a, 0xf3, 0x64, 0x46, 0x3d, 0xd4, 0x90, 0x13, 0x1b,
+	0x9a, 0xbb, 0xa1, 0x67, 0x9b, 0x92, 0x07, 0xb4, 0x47, 0xf1, 0x43, 0xb8, 0x98, 0x3f, 0xd3, 0xe7,
+	0x8e, 0x02, 0x1d, 0x19, 0x84, 0xba, 0x7c, 0x02, 0x0b, 0x11, 0xc0, 0x75, 0x65, 0x7d, 0xfb, 0xeb,
+	0x57, 0x25, 0xe5, 0xc5, 0xab, 0x92, 0xf2, 0x9f, 0x57, 0x25, 0xe5, 0x17, 0xaf, 0x4b, 0x7d, 0x2f,
+	0x5e, 0x97, 0xfa, 0xfe, 0xf9, 0xba, 0xd4, 0xf7, 0xdd, 0x5b, 0xbd, 0x93, 0x9f, 0x03, 0x11, 0x32,
+	0xa7, 0x40, 0xd5, 0x33, 0x5c, 0x7c, 0xe3, 0xff, 0x03, 0x00, 0xc8, 0x93, 0xc6, 0x5e, 0xfd, 0x1b,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a ClobPair by id.
+	ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error)
+	// Queries a list of ClobPair items with the provided status.
+	ClobPairsByStatus(ctx context.Context, in *QueryClobPairsByStatusRequest, opts ...grpc.CallOption) (*QueryClobPairsByStatusResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries the stateful order for a given order id.
+	StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error)
+	// Queries the proposed operations that were rejected in the last block.
+	LastBlockRejectedOperations(ctx context.Context, in *QueryLastBlockRejectedOperationsRequest, opts ...grpc.CallOption) (*QueryLastBlockRejectedOperationsResponse, error)
+	// Queries the oracle price of the market of the perpetual of a ClobPair.
+	ClobPairMarketPrice(ctx context.Context, in *QueryClobPairMarketPriceRequest, opts ...grpc.CallOption) (*QueryClobPairMarketPriceResponse, error)
+	// Queries an overview of all markets, containing every ClobPair with the
+	// ticker of its perpetual and the oracle price of the perpetual's market.
+	AllMarketsOverview(ctx context.Context, in *QueryAllMarketsOverviewRequest, opts ...grpc.CallOption) (*QueryAllMarketsOverviewResponse, error)
+	// Queries the largest order size, in base quantums, that a subaccount can
+	// place on a side of a perpetual without violating its initial margin
+	// requirement at the current oracle price.
+	MaxOrderSize(ctx context.Context, in *QueryMaxOrderSizeRequest, opts ...grpc.CallOption) (*QueryMaxOrderSizeResponse, error)
+	// Queries the balance of the insurance fund used by a perpetual.
+	InsuranceFundBalance(ctx context.Context, in *QueryInsuranceFundBalanceRequest, opts ...grpc.CallOption) (*QueryInsuranceFundBalanceResponse, error)
+	// Streams orderbook updates. Updates contain orderbook data
+	// such as order placements, updates, and fills.
+	StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ClobPair(ctx context.Context, in *QueryGetClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairResponse, error) {
+	out := new(QueryClobPairResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPair", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStatefulOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClobPairAll(ctx context.Context, in *QueryAllClobPairRequest, opts ...grpc.CallOption) (*QueryClobPairAllResponse, error) {
+	out := new(QueryClobPairAllResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStatefulOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Triggered {
-		i--
-		if m.Triggered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FillAmount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) ClobPairsByStatus(ctx context.Context, in *QueryClobPairsByStatusRequest, opts ...grpc.CallOption) (*QueryClobPairsByStatusResponse, error) {
+	out := new(QueryClobPairsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.OrderPlacement.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *queryClient) MevNodeToNodeCalculation(ctx context.Context, in *MevNodeToNodeCalculationRequest, opts ...grpc.CallOption) (*MevNodeToNodeCalculationResponse, error) {
+	out := new(MevNodeToNodeCalculationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MevNodeToNodeCalculation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EquityTierLimitConfiguration(ctx context.Context, in *QueryEquityTierLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryEquityTierLimitConfigurationResponse, error) {
+	out := new(QueryEquityTierLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/EquityTierLimitConfiguration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlockRateLimitConfiguration(ctx context.Context, in *QueryBlockRateLimitConfigurationRequest, opts ...grpc.CallOption) (*QueryBlockRateLimitConfigurationResponse, error) {
+	out := new(QueryBlockRateLimitConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/BlockRateLimitConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) LiquidationsConfiguration(ctx context.Context, in *QueryLiquidationsConfigurationRequest, opts ...grpc.CallOption) (*QueryLiquidationsConfigurationResponse, error) {
+	out := new(QueryLiquidationsConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LiquidationsConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StatefulOrder(ctx context.Context, in *QueryStatefulOrderRequest, opts ...grpc.CallOption) (*QueryStatefulOrderResponse, error) {
+	out := new(QueryStatefulOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/StatefulOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastBlockRejectedOperations(ctx context.Context, in *QueryLastBlockRejectedOperationsRequest, opts ...grpc.CallOption) (*QueryLastBlockRejectedOperationsResponse, error) {
+	out := new(QueryLastBlockRejectedOperationsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/LastBlockRejectedOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ClobPairMarketPrice(ctx context.Context, in *QueryClobPairMarketPriceRequest, opts ...grpc.CallOption) (*QueryClobPairMarketPriceResponse, error) {
+	out := new(QueryClobPairMarketPriceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/ClobPairMarketPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StreamOrderbookUpdatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllMarketsOverview(ctx context.Context, in *QueryAllMarketsOverviewRequest, opts ...grpc.CallOption) (*QueryAllMarketsOverviewResponse, error) {
+	out := new(QueryAllMarketsOverviewResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/AllMarketsOverview", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StreamOrderbookUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MaxOrderSize(ctx context.Context, in *QueryMaxOrderSizeRequest, opts ...grpc.CallOption) (*QueryMaxOrderSizeResponse, error) {
+	out := new(QueryMaxOrderSizeResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/MaxOrderSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StreamOrderbookUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClobPairId) > 0 {
-		dAtA12 := make([]byte, len(m.ClobPairId)*10)
-		var j11 int
-		for _, num := range m.ClobPairId {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintQuery(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) InsuranceFundBalance(ctx context.Context, in *QueryInsuranceFundBalanceRequest, opts ...grpc.CallOption) (*QueryInsuranceFundBalanceResponse, error) {
+	out := new(QueryInsuranceFundBalanceResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Query/InsuranceFundBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) StreamOrderbookUpdates(ctx context.Context, in *StreamOrderbookUpdatesRequest, opts ...grpc.CallOption) (Query_StreamOrderbookUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.clob.Query/StreamOrderbookUpdates", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &queryStreamOrderbookUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Query_StreamOrderbookUpdatesClient interface {
+	Recv() (*StreamOrderbookUpdatesResponse, error)
+	grpc.ClientStream
 }
 
-func (m *StreamOrderbookUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type queryStreamOrderbookUpdatesClient struct {
+	grpc.ClientStream
 }
 
-func (m *StreamUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *queryStreamOrderbookUpdatesClient) Recv() (*StreamOrderbookUpdatesResponse, error) {
+	m := new(StreamOrderbookUpdatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *StreamUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a ClobPair by id.
+	ClobPair(context.Context, *QueryGetClobPairRequest) (*QueryClobPairResponse, error)
+	// Queries a list of ClobPair items.
+	ClobPairAll(context.Context, *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error)
+	// Queries a list of ClobPair items with the provided status.
+	ClobPairsByStatus(context.Context, *QueryClobPairsByStatusRequest) (*QueryClobPairsByStatusResponse, error)
+	// Runs the MEV node <> node calculation with the provided parameters.
+	MevNodeToNodeCalculation(context.Context, *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error)
+	// Queries EquityTierLimitConfiguration.
+	EquityTierLimitConfiguration(context.Context, *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error)
+	// Queries BlockRateLimitConfiguration.
+	BlockRateLimitConfiguration(context.Context, *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error)
+	// Queries LiquidationsConfiguration.
+	LiquidationsConfiguration(context.Context, *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error)
+	// Queries the stateful order for a given order id.
+	StatefulOrder(context.Context, *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error)
+	// Queries the proposed operations that were rejected in the last block.
+	LastBlockRejectedOperations(context.Context, *QueryLastBlockRejectedOperationsRequest) (*QueryLastBlockRejectedOperationsResponse, error)
+	// Queries the oracle price of the market of the perpetual of a ClobPair.
+	ClobPairMarketPrice(context.Context, *QueryClobPairMarketPriceRequest) (*QueryClobPairMarketPriceResponse, error)
+	// Queries an overview of all markets, containing every ClobPair with the
+	// ticker of its perpetual and the oracle price of the perpetual's market.
+	AllMarketsOverview(context.Context, *QueryAllMarketsOverviewRequest) (*QueryAllMarketsOverviewResponse, error)
+	// Queries the largest order size, in base quantums, that a subaccount can
+	// place on a side of a perpetual without violating its initial margin
+	// requirement at the current oracle price.
+	MaxOrderSize(context.Context, *QueryMaxOrderSizeRequest) (*QueryMaxOrderSizeResponse, error)
+	// Queries the balance of the insurance fund used by a perpetual.
+	InsuranceFundBalance(context.Context, *QueryInsuranceFundBalanceRequest) (*QueryInsuranceFundBalanceResponse, error)
+	// Streams orderbook updates. Updates contain orderbook data
+	// such as order placements, updates, and fills.
+	StreamOrderbookUpdates(*StreamOrderbookUpdatesRequest, Query_StreamOrderbookUpdatesServer) error
 }
 
-func (m *StreamUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecMode != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ExecMode))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.UpdateMessage != nil {
-		{
-			size := m.UpdateMessage.Size()
-			i -= size
-			if _, err := m.UpdateMessage.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *StreamUpdate_OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ClobPair(ctx context.Context, req *QueryGetClobPairRequest) (*QueryClobPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPair not implemented")
 }
-
-func (m *StreamUpdate_OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderbookUpdate != nil {
-		{
-			size, err := m.OrderbookUpdate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ClobPairAll(ctx context.Context, req *QueryAllClobPairRequest) (*QueryClobPairAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairAll not implemented")
 }
-func (m *StreamUpdate_OrderFill) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ClobPairsByStatus(ctx context.Context, req *QueryClobPairsByStatusRequest) (*QueryClobPairsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairsByStatus not implemented")
 }
-
-func (m *StreamUpdate_OrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OrderFill != nil {
-		{
-			size, err := m.OrderFill.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) MevNodeToNodeCalculation(ctx context.Context, req *MevNodeToNodeCalculationRequest) (*MevNodeToNodeCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MevNodeToNodeCalculation not implemented")
 }
-func (m *StreamOrderbookUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) EquityTierLimitConfiguration(ctx context.Context, req *QueryEquityTierLimitConfigurationRequest) (*QueryEquityTierLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquityTierLimitConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) BlockRateLimitConfiguration(ctx context.Context, req *QueryBlockRateLimitConfigurationRequest) (*QueryBlockRateLimitConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRateLimitConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) LiquidationsConfiguration(ctx context.Context, req *QueryLiquidationsConfigurationRequest) (*QueryLiquidationsConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidationsConfiguration not implemented")
+}
+func (*UnimplementedQueryServer) StatefulOrder(ctx context.Context, req *QueryStatefulOrderRequest) (*QueryStatefulOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatefulOrder not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockRejectedOperations(ctx context.Context, req *QueryLastBlockRejectedOperationsRequest) (*QueryLastBlockRejectedOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockRejectedOperations not implemented")
+}
+func (*UnimplementedQueryServer) ClobPairMarketPrice(ctx context.Context, req *QueryClobPairMarketPriceRequest) (*QueryClobPairMarketPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClobPairMarketPrice not implemented")
+}
+func (*UnimplementedQueryServer) AllMarketsOverview(ctx context.Context, req *QueryAllMarketsOverviewRequest) (*QueryAllMarketsOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllMarketsOverview not implemented")
+}
+func (*UnimplementedQueryServer) MaxOrderSize(ctx context.Context, req *QueryMaxOrderSizeRequest) (*QueryMaxOrderSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxOrderSize not implemented")
+}
+func (*UnimplementedQueryServer) InsuranceFundBalance(ctx context.Context, req *QueryInsuranceFundBalanceRequest) (*QueryInsuranceFundBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundBalance not implemented")
+}
+func (*UnimplementedQueryServer) StreamOrderbookUpdates(req *StreamOrderbookUpdatesRequest, srv Query_StreamOrderbookUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderbookUpdates not implemented")
 }
 
-func (m *StreamOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *StreamOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Snapshot {
-		i--
-		if m.Snapshot {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func _Query_ClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClobPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Updates) > 0 {
-		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPair(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPair(ctx, req.(*QueryGetClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamOrderbookFill) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClobPairAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClobPairRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairAll(ctx, req.(*QueryAllClobPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamOrderbookFill) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ClobPairsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClobPairsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairsByStatus(ctx, req.(*QueryClobPairsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *StreamOrderbookFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FillAmounts) > 0 {
-		dAtA16 := make([]byte, len(m.FillAmounts)*10)
-		var j15 int
-		for _, num := range m.FillAmounts {
-			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA16[j15] = uint8(num)
-			j15++
-		}
-		i -= j15
-		copy(dAtA[i:], dAtA16[:j15])
-		i = encodeVarintQuery(dAtA, i, uint64(j15))
-		i--
-		dAtA[i] = 0x1a
+func _Query_MevNodeToNodeCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MevNodeToNodeCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, in)
 	}
-	if m.ClobMatch != nil {
-		{
-			size, err := m.ClobMatch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MevNodeToNodeCalculation",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MevNodeToNodeCalculation(ctx, req.(*MevNodeToNodeCalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_EquityTierLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEquityTierLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGetClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/EquityTierLimitConfiguration",
 	}
-	return n
-}
-
-func (m *QueryClobPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EquityTierLimitConfiguration(ctx, req.(*QueryEquityTierLimitConfigurationRequest))
 	}
-	var l int
-	_ = l
-	l = m.ClobPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllClobPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BlockRateLimitConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRateLimitConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/BlockRateLimitConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRateLimitConfiguration(ctx, req.(*QueryBlockRateLimitConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClobPairAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidationsConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidationsConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ClobPair) > 0 {
-		for _, e := range m.ClobPair {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/LiquidationsConfiguration",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidationsConfiguration(ctx, req.(*QueryLiquidationsConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StatefulOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatefulOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BlockProposerMatches != nil {
-		l = m.BlockProposerMatches.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).StatefulOrder(ctx, in)
 	}
-	if m.ValidatorMevMetrics != nil {
-		l = m.ValidatorMevMetrics.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/StatefulOrder",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatefulOrder(ctx, req.(*QueryStatefulOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LastBlockRejectedOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastBlockRejectedOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockRejectedOperations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/LastBlockRejectedOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockRejectedOperations(ctx, req.(*QueryLastBlockRejectedOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClobPairMarketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClobPairMarketPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClobPairId != 0 {
-		n += 1 + sovQuery(uint64(m.ClobPairId))
+	if interceptor == nil {
+		return srv.(QueryServer).ClobPairMarketPrice(ctx, in)
 	}
-	if m.Mev != 0 {
-		n += 5
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/ClobPairMarketPrice",
 	}
-	if m.Volume != 0 {
-		n += 1 + sovQuery(uint64(m.Volume))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClobPairMarketPrice(ctx, req.(*QueryClobPairMarketPriceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllMarketsOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMarketsOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).AllMarketsOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/AllMarketsOverview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllMarketsOverview(ctx, req.(*QueryAllMarketsOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MaxOrderSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxOrderSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EquityTierLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).MaxOrderSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/MaxOrderSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxOrderSize(ctx, req.(*QueryMaxOrderSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_InsuranceFundBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Query/InsuranceFundBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundBalance(ctx, req.(*QueryInsuranceFundBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_StreamOrderbookUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrderbookUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	l = m.BlockRateLimitConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return srv.(QueryServer).StreamOrderbookUpdates(m, &queryStreamOrderbookUpdatesServer{stream})
 }
 
-func (m *QueryStatefulOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.OrderId.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type Query_StreamOrderbookUpdatesServer interface {
+	Send(*StreamOrderbookUpdatesResponse) error
+	grpc.ServerStream
 }
 
-func (m *QueryStatefulOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type queryStreamOrderbookUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamOrderbookUpdatesServer) Send(m *StreamOrderbookUpdatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.clob.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClobPair",
+			Handler:    _Query_ClobPair_Handler,
+		},
+		{
+			MethodName: "ClobPairAll",
+			Handler:    _Query_ClobPairAll_Handler,
+		},
+		{
+			MethodName: "ClobPairsByStatus",
+			Handler:    _Query_ClobPairsByStatus_Handler,
+		},
+		{
+			MethodName: "MevNodeToNodeCalculation",
+			Handler:    _Query_MevNodeToNodeCalculation_Handler,
+		},
+		{
+			MethodName: "EquityTierLimitConfiguration",
+			Handler:    _Query_EquityTierLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "BlockRateLimitConfiguration",
+			Handler:    _Query_BlockRateLimitConfiguration_Handler,
+		},
+		{
+			MethodName: "LiquidationsConfiguration",
+			Handler:    _Query_LiquidationsConfiguration_Handler,
+		},
+		{
+			MethodName: "StatefulOrder",
+			Handler:    _Query_StatefulOrder_Handler,
+		},
+		{
+			MethodName: "LastBlockRejectedOperations",
+			Handler:    _Query_LastBlockRejectedOperations_Handler,
+		},
+		{
+			MethodName: "ClobPairMarketPrice",
+			Handler:    _Query_ClobPairMarketPrice_Handler,
+		},
+		{
+			MethodName: "AllMarketsOverview",
+			Handler:    _Query_AllMarketsOverview_Handler,
+		},
+		{
+			MethodName: "MaxOrderSize",
+			Handler:    _Query_MaxOrderSize_Handler,
+		},
+		{
+			MethodName: "InsuranceFundBalance",
+			Handler:    _Query_InsuranceFundBalance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderbookUpdates",
+			Handler:       _Query_StreamOrderbookUpdates_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dydxprotocol/clob/query.proto",
+}
+
+func (m *QueryGetClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.OrderPlacement.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.FillAmount != 0 {
-		n += 1 + sovQuery(uint64(m.FillAmount))
-	}
-	if m.Triggered {
-		n += 2
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClobPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClobPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LiquidationsConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamOrderbookUpdatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllClobPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClobPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClobPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClobPairId) > 0 {
-		l = 0
-		for _, e := range m.ClobPairId {
-			l += sovQuery(uint64(e))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamOrderbookUpdatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClobPairAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ClobPair) > 0 {
+		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClobPairsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UpdateMessage != nil {
-		n += m.UpdateMessage.Size()
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ExecMode != 0 {
-		n += 1 + sovQuery(uint64(m.ExecMode))
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for iNdEx := len(m.ClobPair) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClobPair[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorMevMetrics != nil {
+		{
+			size, err := m.ValidatorMevMetrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockProposerMatches != nil {
+		{
+			size, err := m.BlockProposerMatches.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Volume != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Volume))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Mev != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Mev))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EquityTierLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockRateLimitConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatefulOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatefulOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatefulOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatefulOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatefulOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatefulOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Triggered {
+		i--
+		if m.Triggered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FillAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FillAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OrderPlacement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidationsConfigurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidationsConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastBlockRejectedOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastBlockRejectedOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastBlockRejectedOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastBlockRejectedOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastBlockRejectedOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastBlockRejectedOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectedOperations) > 0 {
+		for iNdEx := len(m.RejectedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RejectedOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairMarketPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairMarketPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairMarketPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClobPairMarketPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClobPairMarketPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClobPairMarketPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MarketPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarketsOverviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarketsOverviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarketsOverviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketOverview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketOverview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketPrice != nil {
+		{
+			size, err := m.MarketPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClobPairId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClobPairId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMarketsOverviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMarketsOverviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMarketsOverviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxOrderSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxOrderSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxOrderSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.SubaccountId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxOrderSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxOrderSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxOrderSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClobPairId) > 0 {
+		dAtA17 := make([]byte, len(m.ClobPairId)*10)
+		var j16 int
+		for _, num := range m.ClobPairId {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExecMode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UpdateMessage != nil {
+		{
+			size := m.UpdateMessage.Size()
+			i -= size
+			if _, err := m.UpdateMessage.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamUpdate_OrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate_OrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderbookUpdate != nil {
+		{
+			size, err := m.OrderbookUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamUpdate_OrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamUpdate_OrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OrderFill != nil {
+		{
+			size, err := m.OrderFill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *StreamOrderbookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot {
+		i--
+		if m.Snapshot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamOrderbookFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamOrderbookFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamOrderbookFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FillAmounts) > 0 {
+		dAtA21 := make([]byte, len(m.FillAmounts)*10)
+		var j20 int
+		for _, num := range m.FillAmounts {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClobMatch != nil {
+		{
+			size, err := m.ClobMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryClobPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClobPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClobPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClobPairAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for _, e := range m.ClobPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClobPairsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryClobPairsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPair) > 0 {
+		for _, e := range m.ClobPair {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockProposerMatches != nil {
+		l = m.BlockProposerMatches.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidatorMevMetrics != nil {
+		l = m.ValidatorMevMetrics.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.Mev != 0 {
+		n += 5
+	}
+	if m.Volume != 0 {
+		n += 1 + sovQuery(uint64(m.Volume))
+	}
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEquityTierLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EquityTierLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockRateLimitConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockRateLimitConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatefulOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStatefulOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderPlacement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FillAmount != 0 {
+		n += 1 + sovQuery(uint64(m.FillAmount))
+	}
+	if m.Triggered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidationsConfigurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidationsConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastBlockRejectedOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastBlockRejectedOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if len(m.RejectedOperations) > 0 {
+		for _, e := range m.RejectedOperations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClobPairMarketPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	return n
+}
+
+func (m *QueryClobPairMarketPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMarketsOverviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobPairId != 0 {
+		n += 1 + sovQuery(uint64(m.ClobPairId))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketPrice != nil {
+		l = m.MarketPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMarketsOverviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaxOrderSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SubaccountId.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	return n
+}
+
+func (m *QueryMaxOrderSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSize))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StreamOrderbookUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClobPairId) > 0 {
+		l = 0
+		for _, e := range m.ClobPairId {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *StreamOrderbookUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateMessage != nil {
+		n += m.UpdateMessage.Size()
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.ExecMode != 0 {
+		n += 1 + sovQuery(uint64(m.ExecMode))
+	}
+	return n
+}
+
+func (m *StreamUpdate_OrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderbookUpdate != nil {
+		l = m.OrderbookUpdate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *StreamUpdate_OrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderFill != nil {
+		l = m.OrderFill.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *StreamOrderbookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Snapshot {
+		n += 2
+	}
+	return n
+}
+
+func (m *StreamOrderbookFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClobMatch != nil {
+		l = m.ClobMatch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FillAmounts) > 0 {
+		l = 0
+		for _, e := range m.FillAmounts {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClobPair = append(m.ClobPair, ClobPair{})
+			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairsByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairsByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ClobPair_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClobPairsByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClobPairsByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClobPairsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClobPair = append(m.ClobPair, ClobPair{})
+			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockProposerMatches == nil {
+				m.BlockProposerMatches = &ValidatorMevMatches{}
+			}
+			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorMevMetrics == nil {
+				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
+			}
+			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mev = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			m.Volume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Volume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *StreamUpdate_OrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderbookUpdate != nil {
-		l = m.OrderbookUpdate.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-func (m *StreamUpdate_OrderFill) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderFill != nil {
-		l = m.OrderFill.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-func (m *StreamOrderbookUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.Snapshot {
-		n += 2
-	}
-	return n
-}
-
-func (m *StreamOrderbookFill) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClobMatch != nil {
-		l = m.ClobMatch.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
 		}
-	}
-	if len(m.FillAmounts) > 0 {
-		l = 0
-		for _, e := range m.FillAmounts {
-			l += sovQuery(uint64(e))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovQuery(uint64(l)) + l
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2733,17 +5216,17 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2753,11 +5236,25 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2779,7 +5276,7 @@ func (m *QueryGetClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2802,15 +5299,65 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2837,7 +5384,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2862,7 +5409,7 @@ func (m *QueryClobPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2885,15 +5432,15 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatefulOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClobPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatefulOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2920,10 +5467,7 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2948,7 +5492,7 @@ func (m *QueryAllClobPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2971,15 +5515,15 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStatefulOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClobPairAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStatefulOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlacement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3006,16 +5550,34 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClobPair = append(m.ClobPair, ClobPair{})
-			if err := m.ClobPair[len(m.ClobPair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderPlacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+			}
+			m.FillAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3025,28 +5587,62 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.Triggered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3068,7 +5664,7 @@ func (m *QueryClobPairAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3091,51 +5687,15 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockProposerMatches", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BlockProposerMatches == nil {
-				m.BlockProposerMatches = &ValidatorMevMatches{}
-			}
-			if err := m.BlockProposerMatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMevMetrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3162,10 +5722,7 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatorMevMetrics == nil {
-				m.ValidatorMevMetrics = &MevNodeToNodeMetrics{}
-			}
-			if err := m.ValidatorMevMetrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3190,7 +5747,7 @@ func (m *MevNodeToNodeCalculationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastBlockRejectedOperationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3213,46 +5770,12 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastBlockRejectedOperationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevNodeToNodeCalculationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastBlockRejectedOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, MevNodeToNodeCalculationResponse_MevAndVolumePerClob{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3274,7 +5797,7 @@ func (m *MevNodeToNodeCalculationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []byte) error {
+func (m *QueryLastBlockRejectedOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3297,17 +5820,17 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastBlockRejectedOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MevAndVolumePerClob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastBlockRejectedOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ClobPairId = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3317,27 +5840,16 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClobPairId |= uint32(b&0x7F) << shift
+				m.BlockHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mev", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Mev = float32(math.Float32frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedOperations", wireType)
 			}
-			m.Volume = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3347,11 +5859,26 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Volume |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectedOperations = append(m.RejectedOperations, RejectedOperation{})
+			if err := m.RejectedOperations[len(m.RejectedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3373,7 +5900,7 @@ func (m *MevNodeToNodeCalculationResponse_MevAndVolumePerClob) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClobPairMarketPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3396,12 +5923,31 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClobPairMarketPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClobPairMarketPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3423,7 +5969,7 @@ func (m *QueryEquityTierLimitConfigurationRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClobPairMarketPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3446,15 +5992,15 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClobPairMarketPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEquityTierLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClobPairMarketPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EquityTierLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3481,7 +6027,7 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EquityTierLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarketPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3506,7 +6052,7 @@ func (m *QueryEquityTierLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMarketsOverviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3519,22 +6065,58 @@ func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMarketsOverviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMarketsOverviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3556,7 +6138,7 @@ func (m *QueryBlockRateLimitConfigurationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketOverview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,15 +6161,85 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketOverview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRateLimitConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketOverview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairId", wireType)
+			}
+			m.ClobPairId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClobPairId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRateLimitConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3614,7 +6266,10 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockRateLimitConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MarketPrice == nil {
+				m.MarketPrice = &types.MarketPrice{}
+			}
+			if err := m.MarketPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3639,7 +6294,7 @@ func (m *QueryBlockRateLimitConfigurationResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllMarketsOverviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3662,15 +6317,15 @@ func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatefulOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllMarketsOverviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatefulOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllMarketsOverviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3697,7 +6352,44 @@ func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, MarketOverview{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3722,7 +6414,7 @@ func (m *QueryStatefulOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMaxOrderSizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3745,15 +6437,15 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatefulOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaxOrderSizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatefulOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaxOrderSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPlacement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubaccountId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3780,15 +6472,15 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrderPlacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubaccountId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FillAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			m.FillAmount = 0
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3798,16 +6490,16 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FillAmount |= uint64(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triggered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
 			}
-			var v int
+			m.Side = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3817,12 +6509,11 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Side |= Order_Side(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Triggered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3844,7 +6535,7 @@ func (m *QueryStatefulOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMaxOrderSizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3867,12 +6558,31 @@ func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaxOrderSizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaxOrderSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3894,7 +6604,7 @@ func (m *QueryLiquidationsConfigurationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInsuranceFundBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3917,17 +6627,86 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInsuranceFundBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidationsConfigurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInsuranceFundBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationsConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3937,22 +6716,54 @@ func (m *QueryLiquidationsConfigurationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationsConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4403,7 +7214,7 @@ func (m *StreamOrderbookUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types.OffChainUpdateV1{})
+			m.Updates = append(m.Updates, types2.OffChainUpdateV1{})
 			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"sync"
+)
+
+// OperationError is an error caused by a specific operation in an operations queue.
+type OperationError struct {
+	// OperationIndex is the index of the operation in the operations queue.
+	OperationIndex int
+	// Err is the error caused by the operation.
+	Err error
+}
+
+// NewOperationError returns a new `OperationError` for the operation at `operationIndex`.
+func NewOperationError(operationIndex int, err error) *OperationError {
+	return &OperationError{
+		OperationIndex: operationIndex,
+		Err:            err,
+	}
+}
+
+// Error returns the error message of the underlying error.
+func (e *OperationError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the underlying error.
+func (e *OperationError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the underlying error.
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// LastBlockRejectedOperations holds the proposed operations that were rejected in the last block
+// in which proposed operations were processed. It is kept in memory rather than in state since
+// the state changes of a rejected operations queue are discarded. Access is synchronized since
+// it is read by gRPC queries.
+type LastBlockRejectedOperations struct {
+	sync.Mutex
+	blockHeight        uint32
+	rejectedOperations []RejectedOperation
+}
+
+// NewLastBlockRejectedOperations returns a new empty `LastBlockRejectedOperations`.
+func NewLastBlockRejectedOperations() *LastBlockRejectedOperations {
+	return &LastBlockRejectedOperations{
+		rejectedOperations: []RejectedOperation{},
+	}
+}
+
+// Set replaces the rejected operations with the rejected operations of the block at `blockHeight`.
+func (r *LastBlockRejectedOperations) Set(blockHeight uint32, rejectedOperations []RejectedOperation) {
+	r.Lock()
+	defer r.Unlock()
+	r.blockHeight = blockHeight
+	r.rejectedOperations = rejectedOperations
+}
+
+// Get returns the height of the last block and the operations that were rejected in it.
+func (r *LastBlockRejectedOperations) Get() (blockHeight uint32, rejectedOperations []RejectedOperation) {
+	r.Lock()
+	defer r.Unlock()
+	return r.blockHeight, append([]RejectedOperation{}, r.rejectedOperations...)
+}
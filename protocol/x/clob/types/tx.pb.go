@@ -605,6 +605,102 @@ func (m *MsgBatchCancelResponse) GetShortTermFailed() []*OrderBatch {
 	return nil
 }
 
+// MsgReduceOrder is a request type used for reducing the size of a resting
+// stateful order.
+type MsgReduceOrder struct {
+	// The id of the stateful order to reduce.
+	OrderId OrderId `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id"`
+	// The new size of the order in base quantums. Must be smaller than the
+	// current size of the order and greater than the amount of the order that
+	// has already been filled.
+	NewQuantums uint64 `protobuf:"varint,2,opt,name=new_quantums,json=newQuantums,proto3" json:"new_quantums,omitempty"`
+}
+
+func (m *MsgReduceOrder) Reset()         { *m = MsgReduceOrder{} }
+func (m *MsgReduceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReduceOrder) ProtoMessage()    {}
+func (*MsgReduceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
+}
+func (m *MsgReduceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReduceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReduceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReduceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReduceOrder.Merge(m, src)
+}
+func (m *MsgReduceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReduceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReduceOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReduceOrder proto.InternalMessageInfo
+
+func (m *MsgReduceOrder) GetOrderId() OrderId {
+	if m != nil {
+		return m.OrderId
+	}
+	return OrderId{}
+}
+
+func (m *MsgReduceOrder) GetNewQuantums() uint64 {
+	if m != nil {
+		return m.NewQuantums
+	}
+	return 0
+}
+
+// MsgReduceOrderResponse is a response type used for reducing the size of
+// orders.
+type MsgReduceOrderResponse struct {
+}
+
+func (m *MsgReduceOrderResponse) Reset()         { *m = MsgReduceOrderResponse{} }
+func (m *MsgReduceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReduceOrderResponse) ProtoMessage()    {}
+func (*MsgReduceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
+}
+func (m *MsgReduceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReduceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReduceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReduceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReduceOrderResponse.Merge(m, src)
+}
+func (m *MsgReduceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReduceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReduceOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReduceOrderResponse proto.InternalMessageInfo
+
 // MsgUpdateClobPair is a request type used for updating a ClobPair in state.
 type MsgUpdateClobPair struct {
 	// Authority is the address that may send this message.
@@ -617,7 +713,7 @@ func (m *MsgUpdateClobPair) Reset()         { *m = MsgUpdateClobPair{} }
 func (m *MsgUpdateClobPair) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateClobPair) ProtoMessage()    {}
 func (*MsgUpdateClobPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{11}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
 }
 func (m *MsgUpdateClobPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +765,7 @@ func (m *MsgUpdateClobPairResponse) Reset()         { *m = MsgUpdateClobPairResp
 func (m *MsgUpdateClobPairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateClobPairResponse) ProtoMessage()    {}
 func (*MsgUpdateClobPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{12}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
 }
 func (m *MsgUpdateClobPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +812,7 @@ func (m *OperationRaw) Reset()         { *m = OperationRaw{} }
 func (m *OperationRaw) String() string { return proto.CompactTextString(m) }
 func (*OperationRaw) ProtoMessage()    {}
 func (*OperationRaw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{13}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
 }
 func (m *OperationRaw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +911,7 @@ func (m *MsgUpdateEquityTierLimitConfiguration) Reset()         { *m = MsgUpdate
 func (m *MsgUpdateEquityTierLimitConfiguration) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateEquityTierLimitConfiguration) ProtoMessage()    {}
 func (*MsgUpdateEquityTierLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{14}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
 }
 func (m *MsgUpdateEquityTierLimitConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +967,7 @@ func (m *MsgUpdateEquityTierLimitConfigurationResponse) String() string {
 }
 func (*MsgUpdateEquityTierLimitConfigurationResponse) ProtoMessage() {}
 func (*MsgUpdateEquityTierLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{15}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
 }
 func (m *MsgUpdateEquityTierLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1009,7 @@ func (m *MsgUpdateBlockRateLimitConfiguration) Reset()         { *m = MsgUpdateB
 func (m *MsgUpdateBlockRateLimitConfiguration) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateBlockRateLimitConfiguration) ProtoMessage()    {}
 func (*MsgUpdateBlockRateLimitConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{16}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
 }
 func (m *MsgUpdateBlockRateLimitConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1065,7 @@ func (m *MsgUpdateBlockRateLimitConfigurationResponse) String() string {
 }
 func (*MsgUpdateBlockRateLimitConfigurationResponse) ProtoMessage() {}
 func (*MsgUpdateBlockRateLimitConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{17}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
 }
 func (m *MsgUpdateBlockRateLimitConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1108,7 @@ func (m *MsgUpdateLiquidationsConfig) Reset()         { *m = MsgUpdateLiquidatio
 func (m *MsgUpdateLiquidationsConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateLiquidationsConfig) ProtoMessage()    {}
 func (*MsgUpdateLiquidationsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{18}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{20}
 }
 func (m *MsgUpdateLiquidationsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1159,7 @@ func (m *MsgUpdateLiquidationsConfigResponse) Reset()         { *m = MsgUpdateLi
 func (m *MsgUpdateLiquidationsConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateLiquidationsConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateLiquidationsConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_19b9e2c0de4ab64a, []int{19}
+	return fileDescriptor_19b9e2c0de4ab64a, []int{21}
 }
 func (m *MsgUpdateLiquidationsConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,6 +1188,193 @@ func (m *MsgUpdateLiquidationsConfigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateLiquidationsConfigResponse proto.InternalMessageInfo
 
+// MsgUpdateClobPairConfig is a request type for updating the ClobPair config.
+type MsgUpdateClobPairConfig struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the ClobPair configuration to update to. All fields must be set.
+	ClobPairConfig ClobPairConfig `protobuf:"bytes,2,opt,name=clob_pair_config,json=clobPairConfig,proto3" json:"clob_pair_config"`
+}
+
+func (m *MsgUpdateClobPairConfig) Reset()         { *m = MsgUpdateClobPairConfig{} }
+func (m *MsgUpdateClobPairConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPairConfig) ProtoMessage()    {}
+func (*MsgUpdateClobPairConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{22}
+}
+func (m *MsgUpdateClobPairConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClobPairConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPairConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClobPairConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPairConfig.Merge(m, src)
+}
+func (m *MsgUpdateClobPairConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClobPairConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPairConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateClobPairConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateClobPairConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateClobPairConfig) GetClobPairConfig() ClobPairConfig {
+	if m != nil {
+		return m.ClobPairConfig
+	}
+	return ClobPairConfig{}
+}
+
+// MsgUpdateClobPairConfigResponse is the Msg/UpdateClobPairConfig response
+// type.
+type MsgUpdateClobPairConfigResponse struct {
+}
+
+func (m *MsgUpdateClobPairConfigResponse) Reset()         { *m = MsgUpdateClobPairConfigResponse{} }
+func (m *MsgUpdateClobPairConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateClobPairConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateClobPairConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{23}
+}
+func (m *MsgUpdateClobPairConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateClobPairConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateClobPairConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateClobPairConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateClobPairConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateClobPairConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateClobPairConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateClobPairConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateClobPairConfigResponse proto.InternalMessageInfo
+
+// MsgDelistPerpetualMarket is a request type used by x/gov for delisting the
+// market of a perpetual.
+type MsgDelistPerpetualMarket struct {
+	// Authority is the address that may send this message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The id of the perpetual whose market is delisted.
+	PerpetualId uint32 `protobuf:"varint,2,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+}
+
+func (m *MsgDelistPerpetualMarket) Reset()         { *m = MsgDelistPerpetualMarket{} }
+func (m *MsgDelistPerpetualMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistPerpetualMarket) ProtoMessage()    {}
+func (*MsgDelistPerpetualMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{24}
+}
+func (m *MsgDelistPerpetualMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistPerpetualMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistPerpetualMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistPerpetualMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistPerpetualMarket.Merge(m, src)
+}
+func (m *MsgDelistPerpetualMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistPerpetualMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistPerpetualMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistPerpetualMarket proto.InternalMessageInfo
+
+func (m *MsgDelistPerpetualMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDelistPerpetualMarket) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+// MsgDelistPerpetualMarketResponse is the Msg/DelistPerpetualMarket response
+// type.
+type MsgDelistPerpetualMarketResponse struct {
+}
+
+func (m *MsgDelistPerpetualMarketResponse) Reset()         { *m = MsgDelistPerpetualMarketResponse{} }
+func (m *MsgDelistPerpetualMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistPerpetualMarketResponse) ProtoMessage()    {}
+func (*MsgDelistPerpetualMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b9e2c0de4ab64a, []int{25}
+}
+func (m *MsgDelistPerpetualMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistPerpetualMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistPerpetualMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistPerpetualMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistPerpetualMarketResponse.Merge(m, src)
+}
+func (m *MsgDelistPerpetualMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistPerpetualMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistPerpetualMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistPerpetualMarketResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClobPair)(nil), "dydxprotocol.clob.MsgCreateClobPair")
 	proto.RegisterType((*MsgCreateClobPairResponse)(nil), "dydxprotocol.clob.MsgCreateClobPairResponse")
@@ -1104,6 +1387,8 @@ func init() {
 	proto.RegisterType((*MsgBatchCancel)(nil), "dydxprotocol.clob.MsgBatchCancel")
 	proto.RegisterType((*OrderBatch)(nil), "dydxprotocol.clob.OrderBatch")
 	proto.RegisterType((*MsgBatchCancelResponse)(nil), "dydxprotocol.clob.MsgBatchCancelResponse")
+	proto.RegisterType((*MsgReduceOrder)(nil), "dydxprotocol.clob.MsgReduceOrder")
+	proto.RegisterType((*MsgReduceOrderResponse)(nil), "dydxprotocol.clob.MsgReduceOrderResponse")
 	proto.RegisterType((*MsgUpdateClobPair)(nil), "dydxprotocol.clob.MsgUpdateClobPair")
 	proto.RegisterType((*MsgUpdateClobPairResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairResponse")
 	proto.RegisterType((*OperationRaw)(nil), "dydxprotocol.clob.OperationRaw")
@@ -1113,84 +1398,99 @@ func init() {
 	proto.RegisterType((*MsgUpdateBlockRateLimitConfigurationResponse)(nil), "dydxprotocol.clob.MsgUpdateBlockRateLimitConfigurationResponse")
 	proto.RegisterType((*MsgUpdateLiquidationsConfig)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfig")
 	proto.RegisterType((*MsgUpdateLiquidationsConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateLiquidationsConfigResponse")
+	proto.RegisterType((*MsgUpdateClobPairConfig)(nil), "dydxprotocol.clob.MsgUpdateClobPairConfig")
+	proto.RegisterType((*MsgUpdateClobPairConfigResponse)(nil), "dydxprotocol.clob.MsgUpdateClobPairConfigResponse")
+	proto.RegisterType((*MsgDelistPerpetualMarket)(nil), "dydxprotocol.clob.MsgDelistPerpetualMarket")
+	proto.RegisterType((*MsgDelistPerpetualMarketResponse)(nil), "dydxprotocol.clob.MsgDelistPerpetualMarketResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/clob/tx.proto", fileDescriptor_19b9e2c0de4ab64a) }
 
 var fileDescriptor_19b9e2c0de4ab64a = []byte{
-	// 1149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x24, 0x45,
-	0x18, 0x9e, 0x5e, 0xd4, 0x85, 0x17, 0x86, 0x85, 0x5a, 0x56, 0x66, 0x1b, 0x19, 0x86, 0x11, 0xc8,
-	0xac, 0x2e, 0x33, 0x2b, 0x6e, 0xd0, 0x68, 0xfc, 0x1a, 0xb2, 0x1b, 0x48, 0x96, 0x00, 0x0d, 0x26,
-	0x46, 0x4d, 0x3a, 0x3d, 0xdd, 0xc5, 0x50, 0xd9, 0xee, 0xa9, 0xa1, 0xab, 0x07, 0xe1, 0xba, 0xbf,
-	0xc0, 0xbb, 0x31, 0xfa, 0x13, 0x3c, 0xec, 0xc1, 0xbb, 0x97, 0x3d, 0x6e, 0x3c, 0x6d, 0xa2, 0x51,
-	0x03, 0x07, 0xff, 0x86, 0xe9, 0xaa, 0xee, 0x9a, 0xea, 0xed, 0xee, 0x61, 0x24, 0x1e, 0xbc, 0x40,
-	0x57, 0xd5, 0xf3, 0x7e, 0x3c, 0xcf, 0xfb, 0xd6, 0xc7, 0x80, 0xee, 0x9c, 0x39, 0xa7, 0x5d, 0x9f,
-	0x06, 0xd4, 0xa6, 0x6e, 0xc3, 0x76, 0x69, 0xab, 0x11, 0x9c, 0xd6, 0xf9, 0x04, 0x9a, 0x56, 0xd7,
-	0xea, 0xe1, 0x9a, 0x7e, 0xdb, 0xa6, 0xcc, 0xa3, 0xcc, 0xe4, 0xb3, 0x0d, 0x31, 0x10, 0x68, 0x7d,
-	0x56, 0x8c, 0x1a, 0x1e, 0x6b, 0x37, 0x4e, 0xde, 0x09, 0xff, 0x45, 0x0b, 0x33, 0x6d, 0xda, 0xa6,
-	0xc2, 0x20, 0xfc, 0x8a, 0x66, 0x1b, 0xe9, 0xc0, 0x2d, 0x97, 0xda, 0x8f, 0x4d, 0xdf, 0x0a, 0xb0,
-	0xe9, 0x12, 0x8f, 0x04, 0xa6, 0x4d, 0x3b, 0x87, 0x24, 0x76, 0xb3, 0x98, 0x36, 0x08, 0xff, 0x98,
-	0x5d, 0x8b, 0xf8, 0x11, 0xe4, 0x5e, 0x1a, 0x82, 0x8f, 0x7b, 0x24, 0x38, 0x33, 0x03, 0x82, 0xfd,
-	0x2c, 0xa7, 0x0b, 0x69, 0x0b, 0xcf, 0x0a, 0xec, 0x23, 0x1c, 0xb3, 0x9a, 0x4f, 0x03, 0xa8, 0xef,
-	0xe0, 0x38, 0xe2, 0x4a, 0xce, 0xb2, 0xe9, 0x63, 0x8f, 0x9e, 0x58, 0x6e, 0xec, 0xe6, 0xed, 0x34,
-	0xce, 0x25, 0xc7, 0x3d, 0xe2, 0x58, 0x01, 0xa1, 0x1d, 0x96, 0x4c, 0xea, 0x4e, 0x02, 0xcc, 0x7a,
-	0x2d, 0xcb, 0xb6, 0x69, 0xaf, 0x13, 0x30, 0xe5, 0x5b, 0x40, 0xab, 0xdf, 0x69, 0x30, 0xbd, 0xcd,
-	0xda, 0x1b, 0x3e, 0xb6, 0x02, 0xbc, 0xe1, 0xd2, 0xd6, 0xae, 0x45, 0x7c, 0xb4, 0x0e, 0x63, 0x56,
-	0x2f, 0x38, 0xa2, 0x3e, 0x09, 0xce, 0x4a, 0x5a, 0x45, 0xab, 0x8d, 0x35, 0x4b, 0xbf, 0x3e, 0x5d,
-	0x9d, 0x89, 0xea, 0xf5, 0x99, 0xe3, 0xf8, 0x98, 0xb1, 0xfd, 0xc0, 0x27, 0x9d, 0xb6, 0xd1, 0x87,
-	0xa2, 0x8f, 0x61, 0x4c, 0x4a, 0x5a, 0xba, 0x56, 0xd1, 0x6a, 0xe3, 0x6b, 0x73, 0xf5, 0x54, 0x13,
-	0xd4, 0xe3, 0x38, 0xcd, 0x57, 0x9e, 0xfd, 0xb1, 0x50, 0x30, 0x46, 0xed, 0x68, 0xfc, 0xc1, 0xe4,
-	0x93, 0xbf, 0x7f, 0x7a, 0xab, 0xef, 0xaf, 0x3a, 0x07, 0xb7, 0x53, 0xc9, 0x19, 0x98, 0x75, 0x69,
-	0x87, 0xe1, 0x2a, 0x81, 0x5b, 0xdb, 0xac, 0xbd, 0xeb, 0xd3, 0x2e, 0x65, 0xd8, 0xd9, 0xe9, 0x62,
-	0x5f, 0x68, 0x81, 0x76, 0x61, 0x8a, 0xca, 0x91, 0x79, 0xdc, 0xc3, 0x3d, 0x5c, 0xd2, 0x2a, 0x23,
-	0xb5, 0xf1, 0xb5, 0x85, 0x8c, 0x64, 0xa4, 0xa1, 0x61, 0x7d, 0x13, 0x25, 0x74, 0xa3, 0x6f, 0xbe,
-	0x17, 0x5a, 0x57, 0x17, 0x60, 0x3e, 0x33, 0x94, 0xcc, 0xe5, 0x01, 0x14, 0x43, 0x80, 0x6b, 0xd9,
-	0x78, 0x27, 0x2c, 0x1f, 0xba, 0x0f, 0xaf, 0xf2, 0x3a, 0x72, 0xf5, 0xc6, 0xd7, 0x4a, 0x59, 0x81,
-	0xc3, 0xf5, 0x28, 0xa2, 0x00, 0x57, 0x67, 0x05, 0x25, 0xe9, 0x46, 0xfa, 0xff, 0x59, 0x83, 0xc9,
-	0x50, 0x09, 0xab, 0x63, 0x63, 0x57, 0x44, 0xf8, 0x10, 0x46, 0x45, 0xa7, 0x10, 0x27, 0x0a, 0xa2,
-	0xe7, 0x05, 0xd9, 0x72, 0xa2, 0x30, 0xd7, 0xa9, 0x18, 0xa2, 0x15, 0x98, 0x6c, 0x53, 0xea, 0x98,
-	0x01, 0x71, 0x4d, 0xbe, 0x6b, 0x78, 0xb5, 0x8a, 0x9b, 0x05, 0x63, 0x22, 0x9c, 0x3f, 0x20, 0x6e,
-	0x33, 0x9c, 0x45, 0x0d, 0xb8, 0x99, 0xc4, 0x99, 0x01, 0xf1, 0x70, 0x69, 0xa4, 0xa2, 0xd5, 0xae,
-	0x6f, 0x16, 0x8c, 0x29, 0x15, 0x7c, 0x40, 0x3c, 0xdc, 0x9c, 0x52, 0x1c, 0xd3, 0x0e, 0xa6, 0x87,
-	0xd5, 0x12, 0xbc, 0x9e, 0xcc, 0x5c, 0x92, 0xfa, 0x5d, 0x90, 0x6a, 0x86, 0xfb, 0x45, 0xac, 0xa3,
-	0x3d, 0x28, 0xf6, 0x5b, 0xb4, 0xcf, 0x6c, 0x25, 0xc9, 0x4c, 0xe9, 0xe8, 0xfa, 0xbe, 0xfc, 0x96,
-	0x2c, 0x27, 0x98, 0x32, 0x87, 0xf6, 0x00, 0xb1, 0x23, 0xea, 0x07, 0x66, 0x80, 0x7d, 0xcf, 0xb4,
-	0x79, 0x1c, 0x56, 0xba, 0xc6, 0xfb, 0x61, 0x3e, 0xb7, 0x2c, 0x61, 0x4e, 0x91, 0xbb, 0x29, 0x6e,
-	0x7e, 0x80, 0x7d, 0x4f, 0x24, 0xc9, 0xd0, 0x52, 0x4a, 0xbd, 0x50, 0x90, 0x62, 0x52, 0xbb, 0xea,
-	0x36, 0x40, 0xdf, 0x17, 0xaa, 0xc0, 0x84, 0xdc, 0x1a, 0x31, 0xb1, 0xa2, 0x01, 0x71, 0xeb, 0x6f,
-	0x39, 0x68, 0x1e, 0xc0, 0x76, 0x09, 0xe6, 0xbc, 0x45, 0x82, 0x45, 0x63, 0x4c, 0xcc, 0x6c, 0x39,
-	0xac, 0xfa, 0x54, 0xe3, 0x42, 0x2a, 0x6a, 0xc5, 0x42, 0xa2, 0x1d, 0x98, 0x51, 0x28, 0xb2, 0x9e,
-	0x6d, 0x63, 0xec, 0x60, 0x27, 0x6a, 0xfa, 0xc1, 0x24, 0x0d, 0x24, 0xe9, 0xed, 0xc7, 0x86, 0x68,
-	0x0b, 0xa6, 0x15, 0x87, 0x87, 0x16, 0x71, 0xb1, 0x33, 0x94, 0x64, 0xc6, 0x0d, 0xe9, 0xed, 0x21,
-	0xb7, 0x8a, 0x0f, 0x98, 0xcf, 0xbb, 0xce, 0xff, 0xf7, 0x80, 0x49, 0x26, 0x27, 0xfb, 0xf3, 0x85,
-	0x06, 0x13, 0xea, 0xe9, 0x10, 0x6e, 0x6a, 0x7e, 0xb8, 0x47, 0x5d, 0xf9, 0x46, 0x4e, 0xe4, 0xed,
-	0x10, 0xb3, 0x59, 0x30, 0x04, 0x18, 0x7d, 0x04, 0xba, 0x22, 0xa6, 0xd8, 0xb3, 0xdd, 0x70, 0x8b,
-	0x7b, 0xb8, 0x13, 0x70, 0x12, 0x13, 0x9b, 0x05, 0x63, 0x56, 0x0a, 0xc7, 0xd5, 0xdc, 0x8d, 0x01,
-	0xe8, 0x21, 0x14, 0x13, 0x37, 0x02, 0xef, 0xb5, 0x9c, 0xa3, 0x4c, 0x6c, 0x2f, 0x0e, 0x0b, 0xb7,
-	0x32, 0x55, 0xc6, 0xcd, 0x71, 0x18, 0x93, 0xc7, 0x5a, 0xf5, 0x4f, 0x0d, 0x96, 0x25, 0xf1, 0x07,
-	0xfc, 0x8a, 0x3b, 0x20, 0xd8, 0x7f, 0x14, 0x5e, 0x70, 0x1b, 0xfc, 0x2a, 0xe9, 0x09, 0xe4, 0x95,
-	0x2b, 0xd5, 0x81, 0x52, 0xde, 0xd5, 0x19, 0x15, 0xae, 0x91, 0xc1, 0x60, 0x50, 0x2a, 0x51, 0x31,
-	0x6f, 0xe1, 0x2c, 0x4c, 0xaa, 0xb2, 0x0d, 0x58, 0x1d, 0x8a, 0xa0, 0xac, 0xf6, 0x6f, 0x1a, 0x2c,
-	0x49, 0x0b, 0xbe, 0x83, 0x0d, 0x2b, 0xc0, 0xff, 0xa1, 0x22, 0x8f, 0x61, 0x36, 0xe7, 0x81, 0x12,
-	0x95, 0xb4, 0x9e, 0x21, 0xc8, 0x80, 0x44, 0x22, 0x3d, 0x66, 0x5a, 0x19, 0x90, 0x94, 0x1c, 0x75,
-	0xb8, 0x3b, 0x0c, 0x39, 0xa9, 0xc6, 0x2f, 0x1a, 0xcc, 0x49, 0x83, 0x47, 0xca, 0x4b, 0x43, 0xc0,
-	0xaf, 0x2c, 0xc2, 0xd7, 0x70, 0x33, 0xe3, 0xdd, 0x12, 0x75, 0xc4, 0x72, 0x86, 0x00, 0xe9, 0xd8,
-	0x11, 0x6f, 0xe4, 0xa6, 0x56, 0x52, 0xac, 0x97, 0xe1, 0xcd, 0x01, 0x24, 0x62, 0xb2, 0x6b, 0x3f,
-	0x8c, 0xc2, 0xc8, 0x36, 0x6b, 0xa3, 0x2e, 0xa0, 0x8c, 0xe7, 0x44, 0x2d, 0x23, 0xab, 0xcc, 0xd7,
-	0x80, 0x7e, 0x6f, 0x58, 0xa4, 0x3c, 0xb9, 0xbf, 0x00, 0x50, 0x1e, 0x0d, 0x95, 0x1c, 0x7b, 0x89,
-	0xd0, 0x6b, 0x97, 0x21, 0xa4, 0xe7, 0xaf, 0x60, 0x5c, 0x7d, 0x2d, 0x2c, 0x66, 0x1b, 0x2a, 0x10,
-	0xfd, 0xce, 0xa5, 0x10, 0xd5, 0xb9, 0x7a, 0x6b, 0xe7, 0x38, 0x57, 0x20, 0x79, 0xce, 0xb3, 0x6e,
-	0x33, 0x07, 0x26, 0x5f, 0x7a, 0x8e, 0x2e, 0xe5, 0x64, 0x96, 0x40, 0xe9, 0x77, 0x87, 0x41, 0xa9,
-	0x51, 0x5e, 0xba, 0x93, 0x72, 0xa2, 0x24, 0x51, 0x79, 0x51, 0xb2, 0xaf, 0x10, 0xf4, 0xa3, 0x06,
-	0xd5, 0x21, 0x0e, 0xd9, 0xf7, 0x07, 0x39, 0x1d, 0x64, 0xa9, 0x7f, 0x7a, 0x55, 0x4b, 0x99, 0xe2,
-	0xf7, 0x1a, 0x2c, 0x5e, 0x7e, 0xe8, 0xbd, 0x37, 0x28, 0xce, 0x00, 0x43, 0xfd, 0x93, 0x2b, 0x1a,
-	0xca, 0xfc, 0x9e, 0x68, 0x50, 0xca, 0x3d, 0x86, 0xea, 0x83, 0xbc, 0xa7, 0xf1, 0xfa, 0xfa, 0xbf,
-	0xc3, 0xc7, 0x49, 0x34, 0x77, 0x9f, 0x9d, 0x97, 0xb5, 0xe7, 0xe7, 0x65, 0xed, 0xaf, 0xf3, 0xb2,
-	0xf6, 0xed, 0x45, 0xb9, 0xf0, 0xfc, 0xa2, 0x5c, 0x78, 0x71, 0x51, 0x2e, 0x7c, 0xb9, 0xde, 0x26,
-	0xc1, 0x51, 0xaf, 0x55, 0xb7, 0xa9, 0x97, 0xfc, 0x45, 0x7a, 0x72, 0x7f, 0xd5, 0x3e, 0xb2, 0x48,
-	0xa7, 0x21, 0x67, 0x4e, 0xa3, 0x9f, 0xc7, 0x67, 0x5d, 0xcc, 0x5a, 0xaf, 0xf1, 0xe9, 0x77, 0xff,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x64, 0x28, 0x2e, 0x40, 0x0f, 0x00, 0x00,
+	// 1321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xf7, 0x02, 0x2d, 0xe4, 0xd9, 0x0e, 0x61, 0x49, 0x1a, 0xb3, 0x34, 0x89, 0xed, 0x02, 0x72,
+	0xf8, 0xb0, 0x69, 0x40, 0xb4, 0x6a, 0xd5, 0x2f, 0x53, 0x50, 0x22, 0x61, 0xe1, 0x2c, 0xa9, 0x54,
+	0xb5, 0x95, 0x56, 0xeb, 0xdd, 0xc1, 0x19, 0xb1, 0xde, 0xd9, 0xec, 0xcc, 0x06, 0x72, 0x45, 0xea,
+	0xbd, 0xf7, 0xaa, 0x52, 0xff, 0x84, 0x1e, 0x38, 0xf4, 0xde, 0x0b, 0x47, 0xd4, 0x13, 0x52, 0xab,
+	0xb6, 0x82, 0x43, 0x8f, 0xfd, 0x17, 0xaa, 0x9d, 0x9d, 0x1d, 0xef, 0x66, 0x77, 0x1d, 0x93, 0x72,
+	0xe8, 0x05, 0x3c, 0x33, 0xbf, 0xf7, 0xf1, 0x7b, 0xef, 0xf9, 0xbd, 0x17, 0x83, 0x66, 0xef, 0xd9,
+	0x8f, 0x3c, 0x9f, 0x30, 0x62, 0x11, 0xa7, 0x63, 0x39, 0x64, 0xd0, 0x61, 0x8f, 0xda, 0xfc, 0x42,
+	0x3d, 0x95, 0x7c, 0x6b, 0x87, 0x6f, 0xda, 0x19, 0x8b, 0xd0, 0x11, 0xa1, 0x06, 0xbf, 0xed, 0x44,
+	0x87, 0x08, 0xad, 0x2d, 0x46, 0xa7, 0xce, 0x88, 0x0e, 0x3b, 0xbb, 0xef, 0x86, 0xff, 0x89, 0x87,
+	0xf9, 0x21, 0x19, 0x92, 0x48, 0x20, 0xfc, 0x24, 0x6e, 0x3b, 0x59, 0xc3, 0x03, 0x87, 0x58, 0x0f,
+	0x0c, 0xdf, 0x64, 0xc8, 0x70, 0xf0, 0x08, 0x33, 0xc3, 0x22, 0xee, 0x7d, 0x1c, 0xab, 0x69, 0x64,
+	0x05, 0xc2, 0x7f, 0x0c, 0xcf, 0xc4, 0xbe, 0x80, 0xb4, 0x26, 0x40, 0xd2, 0xca, 0xae, 0x66, 0x91,
+	0x68, 0x27, 0xc0, 0x6c, 0xcf, 0x60, 0x18, 0xf9, 0x79, 0xe6, 0x57, 0xb2, 0x12, 0x23, 0x93, 0x59,
+	0xdb, 0x28, 0xe6, 0xbf, 0x94, 0x05, 0x10, 0xdf, 0x46, 0xb1, 0x6f, 0x17, 0x0a, 0x9e, 0x0d, 0x1f,
+	0x8d, 0xc8, 0xae, 0xe9, 0xc4, 0x6a, 0x2e, 0x65, 0x71, 0x0e, 0xde, 0x09, 0xb0, 0x6d, 0x32, 0x4c,
+	0x5c, 0x9a, 0x76, 0x6a, 0x35, 0x05, 0xa6, 0xc1, 0xc0, 0xb4, 0x2c, 0x12, 0xb8, 0x8c, 0x26, 0x3e,
+	0x47, 0xd0, 0xe6, 0xf7, 0x0a, 0x9c, 0xea, 0xd1, 0xe1, 0x4d, 0x1f, 0x99, 0x0c, 0xdd, 0x74, 0xc8,
+	0xa0, 0x6f, 0x62, 0x5f, 0xbd, 0x01, 0x33, 0x66, 0xc0, 0xb6, 0x89, 0x8f, 0xd9, 0x5e, 0x4d, 0xa9,
+	0x2b, 0xad, 0x99, 0x6e, 0xed, 0xd7, 0x27, 0x57, 0xe6, 0x45, 0x66, 0x3f, 0xb3, 0x6d, 0x1f, 0x51,
+	0x7a, 0x8f, 0xf9, 0xd8, 0x1d, 0xea, 0x63, 0xa8, 0xfa, 0x31, 0xcc, 0xc8, 0xc8, 0xd6, 0x8e, 0xd4,
+	0x95, 0x56, 0x79, 0xed, 0x6c, 0x3b, 0x53, 0x2e, 0xed, 0xd8, 0x4e, 0xf7, 0xd8, 0xd3, 0x3f, 0x56,
+	0x4a, 0xfa, 0x09, 0x4b, 0x9c, 0x3f, 0x98, 0x7d, 0xfc, 0xf7, 0x4f, 0x17, 0xc7, 0xfa, 0x9a, 0x67,
+	0xe1, 0x4c, 0xc6, 0x39, 0x1d, 0x51, 0x8f, 0xb8, 0x14, 0x35, 0x31, 0x2c, 0xf4, 0xe8, 0xb0, 0xef,
+	0x13, 0x8f, 0x50, 0x64, 0xdf, 0xf5, 0x90, 0x1f, 0xc5, 0x42, 0xed, 0xc3, 0x1c, 0x91, 0x27, 0x63,
+	0x27, 0x40, 0x01, 0xaa, 0x29, 0xf5, 0xa3, 0xad, 0xf2, 0xda, 0x4a, 0x8e, 0x33, 0x52, 0x50, 0x37,
+	0x1f, 0x0a, 0x87, 0x4e, 0x8e, 0xc5, 0x37, 0x43, 0xe9, 0xe6, 0x0a, 0x2c, 0xe5, 0x9a, 0x92, 0xbe,
+	0xdc, 0x82, 0x6a, 0x08, 0x70, 0x4c, 0x0b, 0xdd, 0x0d, 0xd3, 0xa7, 0x5e, 0x87, 0x37, 0x78, 0x1e,
+	0x79, 0xf4, 0xca, 0x6b, 0xb5, 0x3c, 0xc3, 0xe1, 0xbb, 0xb0, 0x18, 0x81, 0x9b, 0x8b, 0xb0, 0x90,
+	0x52, 0x23, 0xf5, 0xff, 0xac, 0xc0, 0x6c, 0x18, 0x09, 0xd3, 0xb5, 0x90, 0x13, 0x59, 0xf8, 0x10,
+	0x4e, 0x44, 0x95, 0x82, 0x6d, 0x61, 0x44, 0x2b, 0x32, 0xb2, 0x61, 0x0b, 0x33, 0xc7, 0x49, 0x74,
+	0x54, 0x2f, 0xc0, 0xec, 0x90, 0x10, 0xdb, 0x60, 0xd8, 0x31, 0xf8, 0xf7, 0x8b, 0x67, 0xab, 0xba,
+	0x5e, 0xd2, 0x2b, 0xe1, 0xfd, 0x16, 0x76, 0xba, 0xe1, 0xad, 0xda, 0x81, 0xd3, 0x69, 0x9c, 0xc1,
+	0xf0, 0x08, 0xd5, 0x8e, 0xd6, 0x95, 0xd6, 0xf1, 0xf5, 0x92, 0x3e, 0x97, 0x04, 0x6f, 0xe1, 0x11,
+	0xea, 0xce, 0x25, 0x14, 0x13, 0x17, 0x91, 0xfb, 0xcd, 0x1a, 0xbc, 0x95, 0xf6, 0x5c, 0x92, 0xfa,
+	0x3d, 0x22, 0xd5, 0x0d, 0xbf, 0x2f, 0xd1, 0xbb, 0xba, 0x09, 0xd5, 0x71, 0x89, 0x8e, 0x99, 0x5d,
+	0x48, 0x33, 0x1b, 0x43, 0x68, 0xfb, 0x9e, 0xfc, 0x2c, 0x59, 0x56, 0x68, 0xe2, 0x4e, 0xdd, 0x04,
+	0x95, 0x6e, 0x13, 0x9f, 0x19, 0x0c, 0xf9, 0x23, 0xc3, 0xe2, 0x76, 0x68, 0xed, 0x08, 0xaf, 0x87,
+	0xa5, 0xc2, 0xb4, 0x84, 0x3e, 0x09, 0x75, 0x73, 0x5c, 0x7c, 0x0b, 0xf9, 0xa3, 0xc8, 0x49, 0xaa,
+	0x9e, 0xcb, 0x44, 0x2f, 0x0c, 0x48, 0x35, 0x1d, 0xbb, 0x66, 0x0f, 0x60, 0xac, 0x4b, 0xad, 0x43,
+	0x65, 0xdc, 0x74, 0x04, 0xb1, 0xaa, 0x0e, 0x71, 0xe9, 0x6f, 0xd8, 0xea, 0x12, 0x80, 0xe5, 0x60,
+	0xc4, 0x79, 0x47, 0x0e, 0x56, 0xf5, 0x99, 0xe8, 0x66, 0xc3, 0xa6, 0xcd, 0x27, 0x0a, 0x0f, 0x64,
+	0x22, 0x5a, 0x71, 0x20, 0xd5, 0xbb, 0x30, 0x9f, 0xa0, 0x48, 0x03, 0xcb, 0x42, 0xc8, 0x46, 0x76,
+	0x4d, 0x99, 0x82, 0xa4, 0xae, 0x4a, 0x7a, 0xf7, 0x62, 0x41, 0x75, 0x03, 0x4e, 0x25, 0x14, 0xde,
+	0x37, 0xb1, 0x83, 0xec, 0xa9, 0x42, 0xa6, 0x9f, 0x94, 0xda, 0x6e, 0x73, 0xa9, 0xa6, 0xc7, 0x73,
+	0xac, 0x23, 0x3b, 0xb0, 0xd0, 0x6b, 0x28, 0xdc, 0x06, 0x54, 0x5c, 0xf4, 0xd0, 0xd8, 0x09, 0x4c,
+	0x97, 0x05, 0x23, 0xca, 0xcb, 0xf6, 0x98, 0x5e, 0x76, 0xd1, 0xc3, 0x4d, 0x71, 0x25, 0x0a, 0x2e,
+	0x61, 0x51, 0x16, 0x9c, 0x68, 0x76, 0x5f, 0x78, 0xf6, 0xff, 0xb7, 0xd9, 0xa5, 0x9d, 0x93, 0xae,
+	0x3f, 0x57, 0xa0, 0x92, 0xec, 0x54, 0x61, 0x83, 0xe1, 0x83, 0x46, 0x84, 0xf0, 0xed, 0x02, 0xcb,
+	0xbd, 0x10, 0xb3, 0x5e, 0xd2, 0x23, 0xb0, 0xfa, 0x11, 0x68, 0x89, 0xc4, 0x46, 0x69, 0xf0, 0xc2,
+	0x76, 0x33, 0x42, 0x2e, 0xe3, 0x24, 0x2a, 0xeb, 0x25, 0x7d, 0x51, 0x26, 0x91, 0xc7, 0xaf, 0x1f,
+	0x03, 0xd4, 0xdb, 0x50, 0x4d, 0x4d, 0x27, 0x5e, 0xf7, 0x05, 0x6d, 0x35, 0x8a, 0x3c, 0x87, 0x85,
+	0x6d, 0x85, 0x24, 0xce, 0xdd, 0x32, 0xcc, 0xc8, 0x16, 0xdb, 0xfc, 0x53, 0x81, 0xf3, 0x92, 0xf8,
+	0x2d, 0x3e, 0x6e, 0xb7, 0x30, 0xf2, 0xef, 0x84, 0xc3, 0xf6, 0x26, 0x1f, 0x6b, 0x41, 0x84, 0x3c,
+	0x74, 0xa6, 0x5c, 0xa8, 0x15, 0x8d, 0x71, 0x91, 0xb8, 0x4e, 0x0e, 0x83, 0x49, 0xae, 0x88, 0x64,
+	0x2e, 0xa0, 0x3c, 0x4c, 0x26, 0xb3, 0x1d, 0xb8, 0x32, 0x15, 0x41, 0x99, 0xed, 0xdf, 0x14, 0x38,
+	0x27, 0x25, 0x78, 0x37, 0xd1, 0x4d, 0x86, 0x5e, 0x63, 0x44, 0x1e, 0xc0, 0x62, 0xc1, 0x5a, 0x25,
+	0x52, 0xda, 0xce, 0x09, 0xc8, 0x04, 0x47, 0x44, 0x3c, 0xe6, 0x07, 0x39, 0x90, 0x4c, 0x38, 0xda,
+	0x70, 0x79, 0x1a, 0x72, 0x32, 0x1a, 0xbf, 0x28, 0x70, 0x56, 0x0a, 0xdc, 0x49, 0x6c, 0x3d, 0x11,
+	0xfc, 0xd0, 0x41, 0xf8, 0x06, 0x4e, 0xe7, 0xec, 0x50, 0xa2, 0x22, 0xce, 0xe7, 0x04, 0x20, 0x6b,
+	0x5b, 0xf0, 0x56, 0x9d, 0xcc, 0x4b, 0x86, 0xf5, 0x79, 0x78, 0x67, 0x02, 0x09, 0x49, 0xf6, 0x89,
+	0x02, 0x8b, 0x99, 0x36, 0xf0, 0x1f, 0x89, 0x6e, 0xc2, 0xdc, 0xfe, 0x85, 0x57, 0xb0, 0x6c, 0x4c,
+	0x68, 0x58, 0x29, 0x86, 0xb3, 0x56, 0xea, 0x36, 0xc3, 0xae, 0x01, 0x2b, 0x05, 0x5e, 0x4b, 0x66,
+	0xdf, 0x2a, 0x50, 0xeb, 0xd1, 0xe1, 0xe7, 0xc8, 0xc1, 0x94, 0xf5, 0x91, 0xef, 0x21, 0x16, 0x98,
+	0x4e, 0xcf, 0xf4, 0x1f, 0x20, 0x76, 0x68, 0x6a, 0x0d, 0xa8, 0x78, 0xb1, 0xaa, 0x70, 0xa0, 0xf0,
+	0x35, 0x46, 0x2f, 0xcb, 0xbb, 0x0d, 0x3b, 0xe3, 0x6a, 0x13, 0xea, 0x45, 0x6e, 0xc4, 0xbe, 0xae,
+	0xfd, 0x03, 0x70, 0xb4, 0x47, 0x87, 0xaa, 0x07, 0x6a, 0xce, 0x82, 0xd9, 0xca, 0x89, 0x5a, 0xee,
+	0x7e, 0xa8, 0x5d, 0x9d, 0x16, 0x29, 0x67, 0xf9, 0x97, 0x00, 0x89, 0x35, 0xb2, 0x5e, 0x20, 0x2f,
+	0x11, 0x5a, 0xeb, 0x20, 0x84, 0xd4, 0xfc, 0x35, 0x94, 0x93, 0xfb, 0x63, 0x23, 0x5f, 0x30, 0x01,
+	0xd1, 0x56, 0x0f, 0x84, 0x24, 0x95, 0x27, 0xf7, 0xb8, 0x02, 0xe5, 0x09, 0x88, 0xb6, 0x7a, 0x20,
+	0x24, 0xa9, 0x3c, 0xb9, 0x40, 0x14, 0x28, 0x4f, 0x40, 0xb4, 0xd5, 0x03, 0x21, 0x52, 0xb9, 0x0d,
+	0xb3, 0xfb, 0xfe, 0xfa, 0x39, 0x57, 0x40, 0x3b, 0x85, 0xd2, 0x2e, 0x4f, 0x83, 0x4a, 0x5a, 0xd9,
+	0xb7, 0x76, 0x14, 0x58, 0x49, 0xa3, 0xb4, 0xcb, 0xd3, 0xa0, 0xa4, 0x95, 0x1f, 0x15, 0x68, 0x4e,
+	0x31, 0x47, 0xdf, 0x9f, 0xa4, 0x74, 0x92, 0xa4, 0xf6, 0xe9, 0x61, 0x25, 0xa5, 0x8b, 0x3f, 0x28,
+	0xd0, 0x38, 0x78, 0xae, 0xbd, 0x37, 0xc9, 0xce, 0x04, 0x41, 0xed, 0x93, 0x43, 0x0a, 0x4a, 0xff,
+	0x1e, 0x2b, 0x50, 0x2b, 0x9c, 0x34, 0xed, 0x49, 0xda, 0xb3, 0x78, 0xed, 0xc6, 0xab, 0xe1, 0xa5,
+	0x13, 0xbb, 0x30, 0x9f, 0x3b, 0x00, 0x2e, 0x4e, 0x53, 0x0d, 0xc2, 0xf6, 0xda, 0xf4, 0x58, 0x69,
+	0x77, 0x0f, 0x16, 0xf2, 0xdb, 0xf3, 0xa5, 0x7c, 0x65, 0xb9, 0x60, 0xed, 0xda, 0x2b, 0x80, 0x63,
+	0xd3, 0xdd, 0xfe, 0xd3, 0x17, 0xcb, 0xca, 0xb3, 0x17, 0xcb, 0xca, 0x5f, 0x2f, 0x96, 0x95, 0xef,
+	0x5e, 0x2e, 0x97, 0x9e, 0xbd, 0x5c, 0x2e, 0x3d, 0x7f, 0xb9, 0x5c, 0xfa, 0xea, 0xc6, 0x10, 0xb3,
+	0xed, 0x60, 0xd0, 0xb6, 0xc8, 0x28, 0xfd, 0xeb, 0xd0, 0xee, 0xf5, 0x2b, 0xd6, 0xb6, 0x89, 0xdd,
+	0x8e, 0xbc, 0x79, 0x24, 0x7e, 0xaa, 0xda, 0xf3, 0x10, 0x1d, 0xbc, 0xc9, 0xaf, 0xaf, 0xfd, 0x3b,
+	0x00, 0x3c, 0xf3, 0x68, 0xdc, 0xcc, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1214,6 +1514,9 @@ type MsgClient interface {
 	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
 	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
 	BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ...grpc.CallOption) (*MsgBatchCancelResponse, error)
+	// ReduceOrder allows accounts to reduce the size of existing stateful orders
+	// on the orderbook.
+	ReduceOrder(ctx context.Context, in *MsgReduceOrder, opts ...grpc.CallOption) (*MsgReduceOrderResponse, error)
 	// CreateClobPair creates a new clob pair.
 	CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error)
 	// UpdateClobPair sets the status of a clob pair. Should return an error
@@ -1229,6 +1532,12 @@ type MsgClient interface {
 	UpdateBlockRateLimitConfiguration(ctx context.Context, in *MsgUpdateBlockRateLimitConfiguration, opts ...grpc.CallOption) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
 	// UpdateLiquidationsConfig updates the liquidations configuration in state.
 	UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateLiquidationsConfig, opts ...grpc.CallOption) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateClobPairConfig updates the configuration that applies to every
+	// ClobPair in state.
+	UpdateClobPairConfig(ctx context.Context, in *MsgUpdateClobPairConfig, opts ...grpc.CallOption) (*MsgUpdateClobPairConfigResponse, error)
+	// DelistPerpetualMarket delists the market of a perpetual and pauses its
+	// clob pairs so that open positions may only be closed.
+	DelistPerpetualMarket(ctx context.Context, in *MsgDelistPerpetualMarket, opts ...grpc.CallOption) (*MsgDelistPerpetualMarketResponse, error)
 }
 
 type msgClient struct {
@@ -1275,6 +1584,15 @@ func (c *msgClient) BatchCancel(ctx context.Context, in *MsgBatchCancel, opts ..
 	return out, nil
 }
 
+func (c *msgClient) ReduceOrder(ctx context.Context, in *MsgReduceOrder, opts ...grpc.CallOption) (*MsgReduceOrderResponse, error) {
+	out := new(MsgReduceOrderResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/ReduceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateClobPair(ctx context.Context, in *MsgCreateClobPair, opts ...grpc.CallOption) (*MsgCreateClobPairResponse, error) {
 	out := new(MsgCreateClobPairResponse)
 	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/CreateClobPair", in, out, opts...)
@@ -1320,6 +1638,24 @@ func (c *msgClient) UpdateLiquidationsConfig(ctx context.Context, in *MsgUpdateL
 	return out, nil
 }
 
+func (c *msgClient) UpdateClobPairConfig(ctx context.Context, in *MsgUpdateClobPairConfig, opts ...grpc.CallOption) (*MsgUpdateClobPairConfigResponse, error) {
+	out := new(MsgUpdateClobPairConfigResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/UpdateClobPairConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistPerpetualMarket(ctx context.Context, in *MsgDelistPerpetualMarket, opts ...grpc.CallOption) (*MsgDelistPerpetualMarketResponse, error) {
+	out := new(MsgDelistPerpetualMarketResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.clob.Msg/DelistPerpetualMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ProposedOperations is a temporary message used by block proposers
@@ -1331,6 +1667,9 @@ type MsgServer interface {
 	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
 	// BatchCancel allows accounts to cancel a batch of orders on the orderbook.
 	BatchCancel(context.Context, *MsgBatchCancel) (*MsgBatchCancelResponse, error)
+	// ReduceOrder allows accounts to reduce the size of existing stateful orders
+	// on the orderbook.
+	ReduceOrder(context.Context, *MsgReduceOrder) (*MsgReduceOrderResponse, error)
 	// CreateClobPair creates a new clob pair.
 	CreateClobPair(context.Context, *MsgCreateClobPair) (*MsgCreateClobPairResponse, error)
 	// UpdateClobPair sets the status of a clob pair. Should return an error
@@ -1346,6 +1685,12 @@ type MsgServer interface {
 	UpdateBlockRateLimitConfiguration(context.Context, *MsgUpdateBlockRateLimitConfiguration) (*MsgUpdateBlockRateLimitConfigurationResponse, error)
 	// UpdateLiquidationsConfig updates the liquidations configuration in state.
 	UpdateLiquidationsConfig(context.Context, *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error)
+	// UpdateClobPairConfig updates the configuration that applies to every
+	// ClobPair in state.
+	UpdateClobPairConfig(context.Context, *MsgUpdateClobPairConfig) (*MsgUpdateClobPairConfigResponse, error)
+	// DelistPerpetualMarket delists the market of a perpetual and pauses its
+	// clob pairs so that open positions may only be closed.
+	DelistPerpetualMarket(context.Context, *MsgDelistPerpetualMarket) (*MsgDelistPerpetualMarketResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1364,6 +1709,9 @@ func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOr
 func (*UnimplementedMsgServer) BatchCancel(ctx context.Context, req *MsgBatchCancel) (*MsgBatchCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCancel not implemented")
 }
+func (*UnimplementedMsgServer) ReduceOrder(ctx context.Context, req *MsgReduceOrder) (*MsgReduceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReduceOrder not implemented")
+}
 func (*UnimplementedMsgServer) CreateClobPair(ctx context.Context, req *MsgCreateClobPair) (*MsgCreateClobPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateClobPair not implemented")
 }
@@ -1379,6 +1727,12 @@ func (*UnimplementedMsgServer) UpdateBlockRateLimitConfiguration(ctx context.Con
 func (*UnimplementedMsgServer) UpdateLiquidationsConfig(ctx context.Context, req *MsgUpdateLiquidationsConfig) (*MsgUpdateLiquidationsConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationsConfig not implemented")
 }
+func (*UnimplementedMsgServer) UpdateClobPairConfig(ctx context.Context, req *MsgUpdateClobPairConfig) (*MsgUpdateClobPairConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClobPairConfig not implemented")
+}
+func (*UnimplementedMsgServer) DelistPerpetualMarket(ctx context.Context, req *MsgDelistPerpetualMarket) (*MsgDelistPerpetualMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistPerpetualMarket not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1456,6 +1810,24 @@ func _Msg_BatchCancel_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReduceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReduceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReduceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/ReduceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReduceOrder(ctx, req.(*MsgReduceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateClobPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateClobPair)
 	if err := dec(in); err != nil {
@@ -1546,6 +1918,42 @@ func _Msg_UpdateLiquidationsConfig_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateClobPairConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClobPairConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClobPairConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/UpdateClobPairConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClobPairConfig(ctx, req.(*MsgUpdateClobPairConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistPerpetualMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistPerpetualMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistPerpetualMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.clob.Msg/DelistPerpetualMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistPerpetualMarket(ctx, req.(*MsgDelistPerpetualMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.clob.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1566,6 +1974,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCancel",
 			Handler:    _Msg_BatchCancel_Handler,
 		},
+		{
+			MethodName: "ReduceOrder",
+			Handler:    _Msg_ReduceOrder_Handler,
+		},
 		{
 			MethodName: "CreateClobPair",
 			Handler:    _Msg_CreateClobPair_Handler,
@@ -1586,6 +1998,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLiquidationsConfig",
 			Handler:    _Msg_UpdateLiquidationsConfig_Handler,
 		},
+		{
+			MethodName: "UpdateClobPairConfig",
+			Handler:    _Msg_UpdateClobPairConfig_Handler,
+		},
+		{
+			MethodName: "DelistPerpetualMarket",
+			Handler:    _Msg_DelistPerpetualMarket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/clob/tx.proto",
@@ -2009,7 +2429,7 @@ func (m *MsgBatchCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+func (m *MsgReduceOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2019,18 +2439,23 @@ func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReduceOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReduceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.NewQuantums != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewQuantums))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
-		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.OrderId.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2038,8 +2463,64 @@ func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReduceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReduceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReduceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
@@ -2351,6 +2832,127 @@ func (m *MsgUpdateLiquidationsConfigResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateClobPairConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPairConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPairConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClobPairConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateClobPairConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateClobPairConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateClobPairConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistPerpetualMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistPerpetualMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistPerpetualMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistPerpetualMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistPerpetualMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistPerpetualMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2531,6 +3133,29 @@ func (m *MsgBatchCancelResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReduceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OrderId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.NewQuantums != 0 {
+		n += 1 + sovTx(uint64(m.NewQuantums))
+	}
+	return n
+}
+
+func (m *MsgReduceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateClobPair) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2675,6 +3300,55 @@ func (m *MsgUpdateLiquidationsConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateClobPairConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ClobPairConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateClobPairConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelistPerpetualMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PerpetualId != 0 {
+		n += 1 + sovTx(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *MsgDelistPerpetualMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3676,7 +4350,7 @@ func (m *MsgBatchCancelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
+func (m *MsgReduceOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3699,17 +4373,17 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReduceOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReduceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3719,29 +4393,30 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.OrderId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewQuantums", wireType)
 			}
-			var msglen int
+			m.NewQuantums = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3751,19 +4426,170 @@ func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NewQuantums |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReduceOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReduceOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReduceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClobPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClobPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClobPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			if err := m.ClobPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -4489,6 +5315,322 @@ func (m *MsgUpdateLiquidationsConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateClobPairConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClobPairConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClobPairConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClobPairConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClobPairConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateClobPairConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateClobPairConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateClobPairConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistPerpetualMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistPerpetualMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistPerpetualMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistPerpetualMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistPerpetualMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistPerpetualMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"slices"
+	"sort"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,8 +38,9 @@ func (k Keeper) GetBlockMessageIds(
 }
 
 // addMessageIdToBlock adds a message id to the list of message ids for a block height. This method should only
-// be called from DelayMessageByBlocks whenever a new message is added. When this restriction is followed, the
-// message ids for a block height will always be in ascending order.
+// be called whenever a message is added or rescheduled. The id is inserted so that the message ids for a block
+// height remain in ascending order, since a rescheduled message may have a lower id than the messages already
+// scheduled for the block.
 func (k Keeper) addMessageIdToBlock(
 	ctx sdk.Context,
 	id uint32,
@@ -47,7 +51,8 @@ func (k Keeper) addMessageIdToBlock(
 	key := lib.Uint32ToKey(blockHeight)
 	if b := store.Get(key); b != nil {
 		k.cdc.MustUnmarshal(b, &blockMessageIds)
-		blockMessageIds.Ids = append(blockMessageIds.Ids, id)
+		i := sort.Search(len(blockMessageIds.Ids), func(i int) bool { return blockMessageIds.Ids[i] > id })
+		blockMessageIds.Ids = slices.Insert(blockMessageIds.Ids, i, id)
 	} else {
 		blockMessageIds = types.BlockMessageIds{
 			Ids: []uint32{id},
@@ -57,7 +62,7 @@ func (k Keeper) addMessageIdToBlock(
 }
 
 // deleteMessageIdFromBlock deletes a message id from the list of message ids for a block. This method should
-// only be called from DeleteMessage whenever a message is deleted. Message id removal assumes non-duplicate ids and
+// only be called whenever a message is deleted or rescheduled. Message id removal assumes non-duplicate ids and
 // respects the original ordering of message ids for a block, so if the message ids were already sorted, they will
 // remain sorted.
 func (k Keeper) deleteMessageIdFromBlock(
@@ -111,6 +111,51 @@ func (k Keeper) DeleteMessage(
 	return nil
 }
 
+// RescheduleDelayedMessage moves a delayed message to be executed at a new block height. The id and contents of
+// the message are preserved. Returns an error if the message is not found or if the new block height is not
+// after the current block height.
+func (k Keeper) RescheduleDelayedMessage(
+	ctx sdk.Context,
+	id uint32,
+	newBlockHeight uint32,
+) (
+	err error,
+) {
+	delayedMsg, found := k.GetMessage(ctx, id)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput,
+			"failed to reschedule message: message with id %d not found",
+			id,
+		)
+	}
+
+	currentBlockHeight := lib.MustConvertIntegerToUint32(ctx.BlockHeight())
+	if newBlockHeight <= currentBlockHeight {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput,
+			"failed to reschedule message: block height %d is not after the current block height %d",
+			newBlockHeight,
+			currentBlockHeight,
+		)
+	}
+
+	// Move the message id from its current block to the new block.
+	if err := k.deleteMessageIdFromBlock(ctx, id, delayedMsg.BlockHeight); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrInvalidInput,
+			"failed to reschedule message: %v",
+			err,
+		)
+	}
+	k.addMessageIdToBlock(ctx, id, newBlockHeight)
+
+	delayedMsg.BlockHeight = newBlockHeight
+	store := k.newDelayedMessageStore(ctx)
+	store.Set(lib.Uint32ToKey(id), k.cdc.MustMarshal(&delayedMsg))
+	return nil
+}
+
 // SetDelayedMessage delays a message to be executed at the specified block height. The delayed
 // message is assigned the specified id. This method is suitable for initializing from genesis state.
 func (k Keeper) SetDelayedMessage(
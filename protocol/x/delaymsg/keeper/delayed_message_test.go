@@ -238,6 +238,133 @@ func TestDeleteMessage(t *testing.T) {
 	require.Equal(t, uint32(1), delaymsg.GetNextDelayedMessageId(ctx))
 }
 
+func TestRescheduleDelayedMessage(t *testing.T) {
+	// Setup - add messages at block heights 10 and 20.
+	ctx, delaymsg, _, _, _, _ := keepertest.DelayMsgKeepers(t)
+
+	_, err := delaymsg.DelayMessageByBlocks(ctx, constants.TestMsg1, 10)
+	require.NoError(t, err)
+	_, err = delaymsg.DelayMessageByBlocks(ctx, constants.TestMsg2, 20)
+	require.NoError(t, err)
+	_, err = delaymsg.DelayMessageByBlocks(ctx, constants.TestMsg3, 20)
+	require.NoError(t, err)
+
+	// Act - move the message with id 0 from block 10 to block 20.
+	err = delaymsg.RescheduleDelayedMessage(ctx, 0, 20)
+	require.NoError(t, err)
+
+	// Assert - the message keeps its id and contents, and the block message ids stay in ascending order.
+	expectDelayedMessagesAndBlockIds(
+		t,
+		ctx,
+		delaymsg,
+		map[uint32]types.DelayedMessage{
+			0: {
+				Msg:         encoding.EncodeMessageToAny(t, constants.TestMsg1),
+				BlockHeight: 20,
+			},
+			1: {
+				Msg:         encoding.EncodeMessageToAny(t, constants.TestMsg2),
+				BlockHeight: 20,
+			},
+			2: {
+				Msg:         encoding.EncodeMessageToAny(t, constants.TestMsg3),
+				BlockHeight: 20,
+			},
+		},
+		map[uint32]types.BlockMessageIds{
+			20: {
+				Ids: []uint32{0, 1, 2},
+			},
+		},
+		3, // Next delayed message id unaffected.
+	)
+	_, found := delaymsg.GetBlockMessageIds(ctx, 10)
+	require.False(t, found)
+
+	// Act - move the message with id 1 to a new block.
+	err = delaymsg.RescheduleDelayedMessage(ctx, 1, 30)
+	require.NoError(t, err)
+
+	expectDelayedMessagesAndBlockIds(
+		t,
+		ctx,
+		delaymsg,
+		map[uint32]types.DelayedMessage{
+			1: {
+				Msg:         encoding.EncodeMessageToAny(t, constants.TestMsg2),
+				BlockHeight: 30,
+			},
+		},
+		map[uint32]types.BlockMessageIds{
+			20: {
+				Ids: []uint32{0, 2},
+			},
+			30: {
+				Ids: []uint32{1},
+			},
+		},
+		3,
+	)
+}
+
+func TestRescheduleDelayedMessage_Failures(t *testing.T) {
+	tests := map[string]struct {
+		id             uint32
+		newBlockHeight uint32
+		expectedError  string
+	}{
+		"Message not found": {
+			id:             1,
+			newBlockHeight: 20,
+			expectedError:  "failed to reschedule message: message with id 1 not found: Invalid input",
+		},
+		"Block height equal to current block height": {
+			id:             0,
+			newBlockHeight: 5,
+			expectedError: "failed to reschedule message: block height 5 is not after the current block " +
+				"height 5: Invalid input",
+		},
+		"Block height before current block height": {
+			id:             0,
+			newBlockHeight: 4,
+			expectedError: "failed to reschedule message: block height 4 is not after the current block " +
+				"height 5: Invalid input",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, delaymsg, _, _, _, _ := keepertest.DelayMsgKeepers(t)
+			ctx = ctx.WithBlockHeight(5)
+
+			_, err := delaymsg.DelayMessageByBlocks(ctx, constants.TestMsg1, 5)
+			require.NoError(t, err)
+
+			err = delaymsg.RescheduleDelayedMessage(ctx, tc.id, tc.newBlockHeight)
+			require.EqualError(t, err, tc.expectedError)
+
+			// The original message is unaffected.
+			expectDelayedMessagesAndBlockIds(
+				t,
+				ctx,
+				delaymsg,
+				map[uint32]types.DelayedMessage{
+					0: {
+						Msg:         encoding.EncodeMessageToAny(t, constants.TestMsg1),
+						BlockHeight: 10,
+					},
+				},
+				map[uint32]types.BlockMessageIds{
+					10: {
+						Ids: []uint32{0},
+					},
+				},
+				1,
+			)
+		})
+	}
+}
+
 func TestGetNextDelayedMessageId(t *testing.T) {
 	ctx, delaymsg, _, _, _, _ := keepertest.DelayMsgKeepers(t)
 
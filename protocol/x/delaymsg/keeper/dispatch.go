@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/abci"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/log"
@@ -9,8 +11,9 @@ import (
 )
 
 // DispatchMessagesForBlock executes all delayed messages scheduled for the given block height and deletes
-// the messages. If there are no delayed messages scheduled for this block, this function does nothing. It is
-// expected that this function is called at the end of every block.
+// the messages. An indexer event is emitted for each message that executes successfully. If there are no delayed
+// messages scheduled for this block, this function does nothing. It is expected that this function is called at
+// the end of every block.
 func DispatchMessagesForBlock(k types.DelayMsgKeeper, ctx sdk.Context) {
 	blockMessageIds, found := k.GetBlockMessageIds(ctx, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
 
@@ -68,7 +71,22 @@ func DispatchMessagesForBlock(k types.DelayMsgKeeper, ctx sdk.Context) {
 				types.MessageContentLogKey, msg.String(),
 				types.MessageTypeUrlLogKey, delayedMsg.Msg.TypeUrl,
 			)
+			continue
 		}
+
+		// Emit an indexer event for the executed message.
+		k.GetIndexerEventManager().AddBlockEvent(
+			ctx,
+			indexerevents.SubtypeDelayedMessage,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.DelayedMessageEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewDelayedMessageExecutionEvent(
+					id,
+					delayedMsg.Msg.TypeUrl,
+				),
+			),
+		)
 	}
 
 	// Propagate events emitted in message handlers to current context.
@@ -12,6 +12,8 @@ import (
 	cometbfttypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	indexerevents "github.com/dydxprotocol/v4-chain/protocol/indexer/events"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/mocks"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -44,7 +46,7 @@ var (
 )
 
 func TestDispatchMessagesForBlock(t *testing.T) {
-	ctx, k, _, bridgeKeeper, _ := keepertest.DelayMsgKeeperWithMockBridgeKeeper(t)
+	ctx, k, _, bridgeKeeper, indexerEventManager, _ := keepertest.DelayMsgKeeperWithMockBridgeKeeper(t)
 
 	// Add messages to the keeper.
 	for i, msg := range constants.AllMsgs {
@@ -63,6 +65,23 @@ func TestDispatchMessagesForBlock(t *testing.T) {
 		Return(nil).Times(len(constants.AllMsgs))
 	bridgeKeeper.On("HasAuthority", DelayMsgAuthority.String()).Return(true).Times(len(constants.AllMsgs))
 
+	// Expect an indexer event for each executed message.
+	for i, msg := range constants.AllMsgs {
+		indexerEventManager.On(
+			"AddBlockEvent",
+			ctx,
+			indexerevents.SubtypeDelayedMessage,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.DelayedMessageEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewDelayedMessageExecutionEvent(
+					uint32(i),
+					encoding.EncodeMessageToAny(t, msg).TypeUrl,
+				),
+			),
+		).Return().Once()
+	}
+
 	// Dispatch messages for block 0.
 
 	keeper.DispatchMessagesForBlock(k, ctx)
@@ -71,6 +90,7 @@ func TestDispatchMessagesForBlock(t *testing.T) {
 	require.False(t, found)
 
 	require.True(t, bridgeKeeper.AssertExpectations(t))
+	require.True(t, indexerEventManager.AssertExpectations(t))
 }
 
 func setupMockKeeperNoMessages(t *testing.T, ctx sdk.Context, k *mocks.DelayMsgKeeper) {
@@ -85,6 +105,29 @@ func HandlerFailure(_ sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
 	return &sdk.Result{}, fmt.Errorf("failed to handle message")
 }
 
+// setupMockIndexerEventManager expects a single indexer event for each of the provided ids of successfully
+// executed messages.
+func setupMockIndexerEventManager(t *testing.T, ctx sdk.Context, k *mocks.DelayMsgKeeper, executedIds ...uint32) {
+	indexerEventManager := &mocks.IndexerEventManager{}
+	for _, id := range executedIds {
+		indexerEventManager.On(
+			"AddBlockEvent",
+			ctx,
+			indexerevents.SubtypeDelayedMessage,
+			indexer_manager.IndexerTendermintEvent_BLOCK_EVENT_END_BLOCK,
+			indexerevents.DelayedMessageEventVersion,
+			indexer_manager.GetBytes(
+				indexerevents.NewDelayedMessageExecutionEvent(
+					id,
+					encoding.EncodeMessageToAny(t, constants.TestMsg1).TypeUrl,
+				),
+			),
+		).Return().Once()
+	}
+	k.On("GetIndexerEventManager").Return(indexerEventManager).Times(len(executedIds))
+	t.Cleanup(func() { indexerEventManager.AssertExpectations(t) })
+}
+
 // mockSuccessRouter returns a handler that succeeds on all calls.
 func mockSuccessRouter(_ sdk.Context) *mocks.MsgRouter {
 	router := &mocks.MsgRouter{}
@@ -132,6 +175,9 @@ func setupMockKeeperMessageNotFound(t *testing.T, ctx sdk.Context, k *mocks.Dela
 	cms := ctx.MultiStore().CacheMultiStore().(*mocks.CacheMultiStore)
 	cms.On("Write").Return(nil).Times(2)
 
+	// 2 executed messages emit indexer events.
+	setupMockIndexerEventManager(t, ctx, k, 0, 2)
+
 	// All deletes are called.
 	k.On("DeleteMessage", ctx, uint32(0)).Return(nil).Once()
 	k.On("DeleteMessage", ctx, uint32(1)).Return(nil).Once()
@@ -170,6 +216,9 @@ func setupMockKeeperExecutionFailure(t *testing.T, ctx sdk.Context, k *mocks.Del
 	cms := ctx.MultiStore().CacheMultiStore().(*mocks.CacheMultiStore)
 	cms.On("Write").Return(nil).Times(2)
 
+	// 2 executed messages emit indexer events.
+	setupMockIndexerEventManager(t, ctx, k, 1, 2)
+
 	// All deletes are called.
 	k.On("DeleteMessage", ctx, uint32(0)).Return(nil).Once()
 	k.On("DeleteMessage", ctx, uint32(1)).Return(nil).Once()
@@ -208,6 +257,9 @@ func setupMockKeeperMessageHandlerPanic(t *testing.T, ctx sdk.Context, k *mocks.
 	cms := ctx.MultiStore().CacheMultiStore().(*mocks.CacheMultiStore)
 	cms.On("Write").Return(nil).Times(2)
 
+	// 2 executed messages emit indexer events.
+	setupMockIndexerEventManager(t, ctx, k, 1, 2)
+
 	// All deletes are called.
 	k.On("DeleteMessage", ctx, uint32(0)).Return(nil).Once()
 	k.On("DeleteMessage", ctx, uint32(1)).Return(nil).Once()
@@ -219,8 +271,8 @@ func setupMockKeeperDecodeFailure(t *testing.T, ctx sdk.Context, k *mocks.DelayM
 		Ids: []uint32{0, 1, 2},
 	}, true).Once()
 
-	nonMsgAnyProto, err := codectypes.NewAnyWithValue(&types.BlockMessageIds{})
-	require.NoError(t, err)
+	// An any without a cached value cannot be decoded into a message.
+	undecodableAnyProto := &codectypes.Any{TypeUrl: "/dydxprotocol.bridge.MsgCompleteBridge"}
 
 	// All messages found.
 	k.On("GetMessage", ctx, uint32(0)).Return(types.DelayedMessage{
@@ -230,7 +282,7 @@ func setupMockKeeperDecodeFailure(t *testing.T, ctx sdk.Context, k *mocks.DelayM
 	}, true).Once()
 	k.On("GetMessage", ctx, uint32(1)).Return(types.DelayedMessage{
 		Id:          1,
-		Msg:         nonMsgAnyProto,
+		Msg:         undecodableAnyProto,
 		BlockHeight: 0,
 	}, true).Once()
 	k.On("GetMessage", ctx, uint32(2)).Return(types.DelayedMessage{
@@ -246,6 +298,9 @@ func setupMockKeeperDecodeFailure(t *testing.T, ctx sdk.Context, k *mocks.DelayM
 	cms := ctx.MultiStore().CacheMultiStore().(*mocks.CacheMultiStore)
 	cms.On("Write").Return(nil).Times(2)
 
+	// 2 executed messages emit indexer events.
+	setupMockIndexerEventManager(t, ctx, k, 0, 2)
+
 	// All deletes are called. 2nd delete fails.
 	k.On("DeleteMessage", ctx, uint32(0)).Return(nil).Once()
 	k.On("DeleteMessage", ctx, uint32(1)).Return(nil).Once()
@@ -281,6 +336,9 @@ func setupMockKeeperDeletionFailure(t *testing.T, ctx sdk.Context, k *mocks.Dela
 	cms := ctx.MultiStore().CacheMultiStore().(*mocks.CacheMultiStore)
 	cms.On("Write").Return(nil).Times(3)
 
+	// All executed messages emit indexer events.
+	setupMockIndexerEventManager(t, ctx, k, 0, 1, 2)
+
 	// All deletes are called. 2nd delete fails.
 	k.On("DeleteMessage", ctx, uint32(0)).Return(nil).Once()
 	k.On("DeleteMessage", ctx, uint32(1)).Return(fmt.Errorf("Deletion failure")).Once()
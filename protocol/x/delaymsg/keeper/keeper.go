@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/x/delaymsg/types"
 )
@@ -17,8 +18,9 @@ type (
 		cdc      codec.Codec
 		storeKey storetypes.StoreKey
 		// authorities stores addresses capable of submitting a delayed message.
-		authorities map[string]struct{}
-		router      *baseapp.MsgServiceRouter
+		authorities         map[string]struct{}
+		router              *baseapp.MsgServiceRouter
+		indexerEventManager indexer_manager.IndexerEventManager
 	}
 )
 
@@ -28,12 +30,14 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	router *baseapp.MsgServiceRouter,
 	authorities []string,
+	indexerEventManager indexer_manager.IndexerEventManager,
 ) *Keeper {
 	return &Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		authorities: lib.UniqueSliceToSet(authorities),
-		router:      router,
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		authorities:         lib.UniqueSliceToSet(authorities),
+		router:              router,
+		indexerEventManager: indexerEventManager,
 	}
 }
 
@@ -42,6 +46,11 @@ func (k Keeper) HasAuthority(authority string) bool {
 	return ok
 }
 
+// GetIndexerEventManager returns the indexer event manager of the x/delaymsg keeper.
+func (k Keeper) GetIndexerEventManager() indexer_manager.IndexerEventManager {
+	return k.indexerEventManager
+}
+
 // Router returns the x/delaymsg router.
 func (k Keeper) Router() lib.MsgRouter {
 	return k.router
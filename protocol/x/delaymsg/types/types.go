@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 )
 
@@ -45,6 +46,14 @@ type DelayMsgKeeper interface {
 		err error,
 	)
 
+	RescheduleDelayedMessage(
+		ctx sdk.Context,
+		id uint32,
+		newBlockHeight uint32,
+	) (
+		err error,
+	)
+
 	// Block message ids
 	GetBlockMessageIds(
 		ctx sdk.Context,
@@ -60,4 +69,6 @@ type DelayMsgKeeper interface {
 	Logger(ctx sdk.Context) log.Logger
 
 	Router() lib.MsgRouter
+
+	GetIndexerEventManager() indexer_manager.IndexerEventManager
 }
@@ -114,6 +114,7 @@ func TestBeginBlocker(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000075"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
 				),
 			},
 		},
@@ -160,6 +161,7 @@ func TestBeginBlocker(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000075"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
 				),
 				sdk.NewEvent(
 					types.EventTypeNewEpoch,
@@ -168,6 +170,7 @@ func TestBeginBlocker(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000000"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000075"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000060"),
 				),
 			},
 		},
@@ -200,6 +203,75 @@ func TestBeginBlocker(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000601"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
+				),
+			},
+		},
+		"ticks multiple epochs, one event per ticked epoch": {
+			epochInfosToCreate: []types.EpochInfo{
+				{
+					Name:          string(types.FundingSampleEpochInfoName),
+					Duration:      60,
+					NextTick:      1800000060,
+					IsInitialized: true,
+				},
+				{
+					Name:          string(types.FundingTickEpochInfoName),
+					Duration:      3600,
+					NextTick:      1800003600,
+					IsInitialized: true,
+				},
+				{
+					Name:          string(types.StatsEpochInfoName),
+					Duration:      3600,
+					NextTick:      1800000000,
+					IsInitialized: true,
+				},
+			},
+			nextBlockTimeSec: 1800000075,
+			nextBlockHeight:  65,
+			expectedEpochInfos: []types.EpochInfo{
+				{
+					Name:                   string(types.FundingSampleEpochInfoName),
+					Duration:               60,
+					NextTick:               1800000120,
+					CurrentEpoch:           1,
+					IsInitialized:          true,
+					CurrentEpochStartBlock: 65,
+				},
+				{
+					Name:          string(types.FundingTickEpochInfoName),
+					Duration:      3600,
+					NextTick:      1800003600, // NextTick not reached
+					IsInitialized: true,
+				},
+				{
+					Name:                   string(types.StatsEpochInfoName),
+					Duration:               3600,
+					NextTick:               1800003600,
+					CurrentEpoch:           1,
+					IsInitialized:          true,
+					CurrentEpochStartBlock: 65,
+				},
+			},
+			expectedEvents: []sdk.Event{
+				sdk.NewEvent(
+					types.EventTypeNewEpoch,
+					sdk.NewAttribute(types.AttributeKeyEpochInfoName, string(types.FundingSampleEpochInfoName)),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, "1"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000075"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
+				),
+				sdk.NewEvent(
+					types.EventTypeNewEpoch,
+					sdk.NewAttribute(types.AttributeKeyEpochInfoName, string(types.StatsEpochInfoName)),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, "1"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000000"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000075"),
+					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "65"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800003600"),
 				),
 			},
 		},
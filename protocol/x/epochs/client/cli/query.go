@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListEpochInfo())
 	cmd.AddCommand(CmdShowEpochInfo())
+	cmd.AddCommand(CmdTimeToNextEpoch())
+	cmd.AddCommand(CmdListEpochInfoCountdowns())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
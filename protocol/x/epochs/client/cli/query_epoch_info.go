@@ -71,3 +71,58 @@ func CmdShowEpochInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdTimeToNextEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-to-next-epoch [identifier]",
+		Short: "shows the seconds remaining until the next tick of an epoch_info",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTimeToNextEpochRequest{
+				Name: args[0],
+			}
+
+			res, err := queryClient.TimeToNextEpoch(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListEpochInfoCountdowns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-epoch-info-countdowns",
+		Short: "list all epoch_info with the seconds remaining until each one's next tick",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllEpochInfoCountdowns(
+				context.Background(),
+				&types.QueryAllEpochInfoCountdownsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -198,3 +198,25 @@ func TestListEpochInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestListEpochInfoCountdowns(t *testing.T) {
+	cfg := networkWithEpochInfoObjects(t)
+	networkStartTime := time.Now()
+	net := network.New(t, cfg)
+	_, err := net.WaitForHeight(3)
+	require.NoError(t, err)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdListEpochInfoCountdowns(), args)
+	require.NoError(t, err)
+	var resp types.QueryAllEpochInfoCountdownsResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+
+	require.Len(t, resp.Countdowns, len(types.DefaultGenesis().GetEpochInfoList()))
+	for _, countdown := range resp.Countdowns {
+		genesisEpoch := getDefaultGenesisEpochById(t, countdown.EpochInfo.Name)
+		checkExpectedEpoch(t, networkStartTime, genesisEpoch, countdown.EpochInfo)
+		require.LessOrEqual(t, countdown.SecondsUntilNextTick, genesisEpoch.Duration)
+	}
+}
@@ -185,6 +185,88 @@ func (k Keeper) NumBlocksSinceEpochStart(
 	return lib.MustConvertIntegerToUint32(ctx.BlockHeight() - int64(epoch.CurrentEpochStartBlock)), nil
 }
 
+// NumBlocksUntilNextEpochStart returns the estimated number of blocks from the current block until
+// the block that starts the next epoch. Since epochs are measured in seconds, the estimate assumes
+// the remainder of the epoch progresses at the average block rate observed since the epoch started.
+// Returns 0 if the current block time has already reached `NextTick`, and an error if no blocks or
+// no time have elapsed in the current epoch, since the block rate is then unknown.
+func (k Keeper) NumBlocksUntilNextEpochStart(
+	ctx sdk.Context,
+	id types.EpochInfoName,
+) (
+	uint32,
+	error,
+) {
+	epoch, found := k.GetEpochInfo(ctx, id)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrEpochInfoNotFound, "EpochInfo Id not found (%s)", id)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime >= int64(epoch.NextTick) {
+		return 0, nil
+	}
+
+	blocksElapsed := ctx.BlockHeight() - int64(epoch.CurrentEpochStartBlock)
+	secondsElapsed := blockTime - (int64(epoch.NextTick) - int64(epoch.Duration))
+	if blocksElapsed <= 0 || secondsElapsed <= 0 {
+		return 0, errorsmod.Wrapf(
+			types.ErrBlockRateUnknown,
+			"EpochInfo Id (%s), blocks elapsed (%d), seconds elapsed (%d)",
+			id,
+			blocksElapsed,
+			secondsElapsed,
+		)
+	}
+
+	// Round up so that a boundary that is less than one block away is reported as one block away.
+	secondsRemaining := int64(epoch.NextTick) - blockTime
+	numBlocks := (secondsRemaining*blocksElapsed + secondsElapsed - 1) / secondsElapsed
+	return lib.MustConvertIntegerToUint32(numBlocks), nil
+}
+
+// GetTimeToNextEpoch returns the number of seconds from the current block time until the
+// `NextTick` of the epoch. Returns 0 if the current block time has already reached `NextTick`.
+func (k Keeper) GetTimeToNextEpoch(
+	ctx sdk.Context,
+	id types.EpochInfoName,
+) (
+	secondsUntilNextTick uint32,
+	err error,
+) {
+	epoch, found := k.GetEpochInfo(ctx, id)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrEpochInfoNotFound, "EpochInfo Id not found (%s)", id)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime >= int64(epoch.NextTick) {
+		return 0, nil
+	}
+
+	return lib.MustConvertIntegerToUint32(int64(epoch.NextTick) - blockTime), nil
+}
+
+// GetAllEpochInfoCountdowns returns every epochInfo along with the number of seconds until
+// its `NextTick` and the number of blocks since its current epoch started.
+func (k Keeper) GetAllEpochInfoCountdowns(ctx sdk.Context) []types.EpochInfoCountdown {
+	epochInfos := k.GetAllEpochInfo(ctx)
+	countdowns := make([]types.EpochInfoCountdown, 0, len(epochInfos))
+	for _, epochInfo := range epochInfos {
+		countdown := types.EpochInfoCountdown{EpochInfo: epochInfo}
+		if blockTime := ctx.BlockTime().Unix(); blockTime < int64(epochInfo.NextTick) {
+			countdown.SecondsUntilNextTick = lib.MustConvertIntegerToUint32(int64(epochInfo.NextTick) - blockTime)
+		}
+		if ctx.BlockHeight() > int64(epochInfo.CurrentEpochStartBlock) {
+			countdown.BlocksSinceEpochStart = lib.MustConvertIntegerToUint32(
+				ctx.BlockHeight() - int64(epochInfo.CurrentEpochStartBlock),
+			)
+		}
+		countdowns = append(countdowns, countdown)
+	}
+	return countdowns
+}
+
 func (k Keeper) MustGetFundingTickEpochInfo(
 	ctx sdk.Context,
 ) types.EpochInfo {
@@ -85,6 +85,7 @@ func TestMaybeStartNextEpoch(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "1234"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
 				),
 			},
 		},
@@ -116,6 +117,7 @@ func TestMaybeStartNextEpoch(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000060"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800006660"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "1234"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000120"),
 				),
 			},
 		},
@@ -194,6 +196,7 @@ func TestMaybeStartNextEpoch(t *testing.T) {
 					sdk.NewAttribute(types.AttributeKeyEpochStartTickTime, "1800000000"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlockTime, "1800000001"),
 					sdk.NewAttribute(types.AttributeKeyEpochStartBlock, "1234"),
+					sdk.NewAttribute(types.AttributeKeyEpochNextTick, "1800000060"),
 				),
 			},
 		},
@@ -415,6 +418,103 @@ func TestEpochInfoGetAll(t *testing.T) {
 		}))
 }
 
+func TestNumBlocksUntilNextEpochStart(t *testing.T) {
+	const (
+		epochStartBlock = uint32(100)
+		epochStartTime  = int64(1_700_000_000)
+		epochDuration   = uint32(60)
+	)
+	tests := map[string]struct {
+		epochName       types.EpochInfoName
+		currBlockHeight int64
+		currBlockTime   int64
+		numBlocks       uint32
+		expectedErr     error
+	}{
+		"error - at epoch start": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 100,
+			currBlockTime:   epochStartTime,
+			expectedErr:     types.ErrBlockRateUnknown,
+		},
+		"one block after epoch start": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 101,
+			currBlockTime:   epochStartTime + 1,
+			numBlocks:       59,
+		},
+		"middle of epoch": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 130,
+			currBlockTime:   epochStartTime + 30,
+			numBlocks:       30,
+		},
+		"middle of epoch with slower blocks": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 115,
+			currBlockTime:   epochStartTime + 30,
+			numBlocks:       15,
+		},
+		"middle of epoch rounds up": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 107,
+			currBlockTime:   epochStartTime + 20,
+			numBlocks:       14, // ceil(40 * 7 / 20)
+		},
+		"one block before epoch boundary": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 159,
+			currBlockTime:   epochStartTime + 59,
+			numBlocks:       1,
+		},
+		"at epoch boundary": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 160,
+			currBlockTime:   epochStartTime + 60,
+			numBlocks:       0,
+		},
+		"past epoch boundary": {
+			epochName:       keepertest.TestEpochInfoName,
+			currBlockHeight: 161,
+			currBlockTime:   epochStartTime + 61,
+			numBlocks:       0,
+		},
+		"error - get non-existing epoch info Name": {
+			epochName:       "11",
+			currBlockHeight: 130,
+			currBlockTime:   epochStartTime + 30,
+			expectedErr:     types.ErrEpochInfoNotFound,
+		},
+	}
+
+	// Run tests.
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			epochInfo := types.EpochInfo{
+				Name:                   keepertest.TestEpochInfoName,
+				Duration:               epochDuration,
+				NextTick:               uint32(epochStartTime) + epochDuration,
+				CurrentEpoch:           1,
+				CurrentEpochStartBlock: epochStartBlock,
+				IsInitialized:          true,
+			}
+
+			ctx, keeper, _ := keepertest.EpochsKeeper(t)
+			require.NoError(t, keeper.CreateEpochInfo(ctx, epochInfo))
+
+			numCtx := ctx.WithBlockHeight(tc.currBlockHeight).WithBlockTime(time.Unix(tc.currBlockTime, 0))
+			numBlocks, err := keeper.NumBlocksUntilNextEpochStart(numCtx, tc.epochName)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.numBlocks, numBlocks)
+		})
+	}
+}
+
 func TestNumBlocksSinceEpochStart(t *testing.T) {
 	tests := map[string]struct {
 		epochName       types.EpochInfoName
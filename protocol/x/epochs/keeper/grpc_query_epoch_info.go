@@ -55,3 +55,40 @@ func (k Keeper) EpochInfo(
 
 	return &types.QueryEpochInfoResponse{EpochInfo: val}, nil
 }
+
+func (k Keeper) TimeToNextEpoch(
+	c context.Context, req *types.QueryTimeToNextEpochRequest) (*types.QueryTimeToNextEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	epochInfo, found := k.GetEpochInfo(ctx, types.EpochInfoName(req.Name))
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	secondsUntilNextTick, err := k.GetTimeToNextEpoch(ctx, epochInfo.GetEpochInfoName())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTimeToNextEpochResponse{
+		NextTick:             epochInfo.NextTick,
+		SecondsUntilNextTick: secondsUntilNextTick,
+	}, nil
+}
+
+func (k Keeper) AllEpochInfoCountdowns(
+	c context.Context,
+	req *types.QueryAllEpochInfoCountdownsRequest,
+) (*types.QueryAllEpochInfoCountdownsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	return &types.QueryAllEpochInfoCountdownsResponse{
+		Countdowns: k.GetAllEpochInfoCountdowns(ctx),
+	}, nil
+}
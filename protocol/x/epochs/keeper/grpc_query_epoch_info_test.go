@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
@@ -121,3 +122,134 @@ func TestEpochInfoQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestTimeToNextEpochQuery(t *testing.T) {
+	nextTick := uint32(1_700_002_800)
+	for _, epochName := range []types.EpochInfoName{
+		types.FundingSampleEpochInfoName,
+		types.FundingTickEpochInfoName,
+		types.StatsEpochInfoName,
+	} {
+		t.Run(string(epochName), func(t *testing.T) {
+			ctx, keeper, _ := keepertest.EpochsKeeper(t)
+			epochInfo := types.EpochInfo{
+				Name:                   string(epochName),
+				Duration:               types.FundingTickEpochDuration,
+				NextTick:               nextTick,
+				CurrentEpoch:           1,
+				CurrentEpochStartBlock: 1,
+				IsInitialized:          true,
+			}
+			if epochName == types.FundingSampleEpochInfoName {
+				epochInfo.Duration = types.FundingSampleEpochDuration
+			}
+			require.NoError(t, keeper.CreateEpochInfo(ctx, epochInfo))
+
+			// The countdown decreases as block time advances within the epoch.
+			epochStart := nextTick - epochInfo.Duration
+			for _, tc := range []struct {
+				blockTime                    uint32
+				expectedSecondsUntilNextTick uint32
+			}{
+				{blockTime: epochStart, expectedSecondsUntilNextTick: epochInfo.Duration},
+				{blockTime: epochStart + epochInfo.Duration/2, expectedSecondsUntilNextTick: epochInfo.Duration / 2},
+				{blockTime: nextTick - 1, expectedSecondsUntilNextTick: 1},
+				{blockTime: nextTick, expectedSecondsUntilNextTick: 0},
+				// Block time is past `NextTick` but the next epoch has not started yet.
+				{blockTime: nextTick + 5, expectedSecondsUntilNextTick: 0},
+			} {
+				blockCtx := ctx.WithBlockTime(time.Unix(int64(tc.blockTime), 0))
+				response, err := keeper.TimeToNextEpoch(
+					blockCtx,
+					&types.QueryTimeToNextEpochRequest{Name: string(epochName)},
+				)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&types.QueryTimeToNextEpochResponse{
+						NextTick:             nextTick,
+						SecondsUntilNextTick: tc.expectedSecondsUntilNextTick,
+					},
+					response,
+				)
+			}
+		})
+	}
+
+	t.Run("KeyNotFound", func(t *testing.T) {
+		ctx, keeper, _ := keepertest.EpochsKeeper(t)
+		_, err := keeper.TimeToNextEpoch(ctx, &types.QueryTimeToNextEpochRequest{Name: "unknown"})
+		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		ctx, keeper, _ := keepertest.EpochsKeeper(t)
+		_, err := keeper.TimeToNextEpoch(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestAllEpochInfoCountdownsQuery(t *testing.T) {
+	ctx, keeper, _ := keepertest.EpochsKeeper(t)
+	epochInfos := []types.EpochInfo{
+		{
+			Name:                   string(types.FundingSampleEpochInfoName),
+			Duration:               types.FundingSampleEpochDuration,
+			NextTick:               1_700_000_060,
+			CurrentEpoch:           30,
+			CurrentEpochStartBlock: 95,
+			IsInitialized:          true,
+		},
+		{
+			Name:                   string(types.FundingTickEpochInfoName),
+			Duration:               types.FundingTickEpochDuration,
+			NextTick:               1_700_003_600,
+			CurrentEpoch:           2,
+			CurrentEpochStartBlock: 50,
+			IsInitialized:          true,
+		},
+		{
+			Name:                   string(types.StatsEpochInfoName),
+			Duration:               types.StatsEpochDuration,
+			NextTick:               1_699_999_990,
+			CurrentEpoch:           2,
+			CurrentEpochStartBlock: 100,
+			IsInitialized:          true,
+		},
+	}
+	for _, epochInfo := range epochInfos {
+		require.NoError(t, keeper.CreateEpochInfo(ctx, epochInfo))
+	}
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1_700_000_000, 0))
+	response, err := keeper.AllEpochInfoCountdowns(ctx, &types.QueryAllEpochInfoCountdownsRequest{})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QueryAllEpochInfoCountdownsResponse{
+			Countdowns: []types.EpochInfoCountdown{
+				{
+					EpochInfo:             epochInfos[0],
+					SecondsUntilNextTick:  60,
+					BlocksSinceEpochStart: 5,
+				},
+				{
+					EpochInfo:             epochInfos[1],
+					SecondsUntilNextTick:  3_600,
+					BlocksSinceEpochStart: 50,
+				},
+				// Block time is past `NextTick` but the next epoch has not started yet.
+				{
+					EpochInfo:             epochInfos[2],
+					SecondsUntilNextTick:  0,
+					BlocksSinceEpochStart: 0,
+				},
+			},
+		},
+		response,
+	)
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AllEpochInfoCountdowns(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -183,9 +183,11 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "epochs", cmd.Use)
-	require.Equal(t, 2, len(cmd.Commands()))
+	require.Equal(t, 4, len(cmd.Commands()))
 	require.Equal(t, "list-epoch-info", cmd.Commands()[0].Name())
-	require.Equal(t, "show-epoch-info", cmd.Commands()[1].Name())
+	require.Equal(t, "list-epoch-info-countdowns", cmd.Commands()[1].Name())
+	require.Equal(t, "show-epoch-info", cmd.Commands()[2].Name())
+	require.Equal(t, "time-to-next-epoch", cmd.Commands()[3].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -32,4 +32,9 @@ var (
 		"Invalid CurrentEpoch and CurrentEpochStartBlock tuple: CurrentEpoch should"+
 			" be zero if and only if CurrentEpochStartBlock is zero",
 	)
+	ErrBlockRateUnknown = errorsmod.Register(
+		ModuleName,
+		7,
+		"Block rate of the current epoch is unknown",
+	)
 )
@@ -14,6 +14,7 @@ const (
 	AttributeKeyEpochStartTickTime  = "epoch_start_tick_time"
 	AttributeKeyEpochStartBlockTime = "epoch_start_block_time"
 	AttributeKeyEpochStartBlock     = "epoch_start_block"
+	AttributeKeyEpochNextTick       = "epoch_next_tick"
 )
 
 // NewEpochEvent constructs a new_epoch sdk.Event.
@@ -25,5 +26,6 @@ func NewEpochEvent(ctx sdk.Context, epoch EpochInfo, currentTick uint32) sdk.Eve
 		sdk.NewAttribute(AttributeKeyEpochStartTickTime, fmt.Sprint(currentTick)),
 		sdk.NewAttribute(AttributeKeyEpochStartBlockTime, fmt.Sprint(ctx.BlockTime().Unix())),
 		sdk.NewAttribute(AttributeKeyEpochStartBlock, fmt.Sprint(epoch.CurrentEpochStartBlock)),
+		sdk.NewAttribute(AttributeKeyEpochNextTick, fmt.Sprint(epoch.NextTick)),
 	)
 }
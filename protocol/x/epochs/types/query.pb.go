@@ -218,45 +218,321 @@ func (m *QueryEpochInfoAllResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTimeToNextEpochRequest is request type for the TimeToNextEpoch RPC
+// method.
+type QueryTimeToNextEpochRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryTimeToNextEpochRequest) Reset()         { *m = QueryTimeToNextEpochRequest{} }
+func (m *QueryTimeToNextEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeToNextEpochRequest) ProtoMessage()    {}
+func (*QueryTimeToNextEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_251d1b776a8ec968, []int{4}
+}
+func (m *QueryTimeToNextEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeToNextEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeToNextEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeToNextEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeToNextEpochRequest.Merge(m, src)
+}
+func (m *QueryTimeToNextEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeToNextEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeToNextEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeToNextEpochRequest proto.InternalMessageInfo
+
+func (m *QueryTimeToNextEpochRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryTimeToNextEpochResponse is response type for the TimeToNextEpoch RPC
+// method.
+type QueryTimeToNextEpochResponse struct {
+	// next_tick is the time at which the next epoch starts (in Unix Epoch
+	// seconds).
+	NextTick uint32 `protobuf:"varint,1,opt,name=next_tick,json=nextTick,proto3" json:"next_tick,omitempty"`
+	// seconds_until_next_tick is the number of seconds from the current block
+	// time until `next_tick`. 0 if the current block time has reached
+	// `next_tick` and the next epoch has not yet started.
+	SecondsUntilNextTick uint32 `protobuf:"varint,2,opt,name=seconds_until_next_tick,json=secondsUntilNextTick,proto3" json:"seconds_until_next_tick,omitempty"`
+}
+
+func (m *QueryTimeToNextEpochResponse) Reset()         { *m = QueryTimeToNextEpochResponse{} }
+func (m *QueryTimeToNextEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeToNextEpochResponse) ProtoMessage()    {}
+func (*QueryTimeToNextEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_251d1b776a8ec968, []int{5}
+}
+func (m *QueryTimeToNextEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeToNextEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeToNextEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeToNextEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeToNextEpochResponse.Merge(m, src)
+}
+func (m *QueryTimeToNextEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeToNextEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeToNextEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeToNextEpochResponse proto.InternalMessageInfo
+
+func (m *QueryTimeToNextEpochResponse) GetNextTick() uint32 {
+	if m != nil {
+		return m.NextTick
+	}
+	return 0
+}
+
+func (m *QueryTimeToNextEpochResponse) GetSecondsUntilNextTick() uint32 {
+	if m != nil {
+		return m.SecondsUntilNextTick
+	}
+	return 0
+}
+
+// QueryAllEpochInfoCountdownsRequest is request type for the
+// AllEpochInfoCountdowns RPC method.
+type QueryAllEpochInfoCountdownsRequest struct {
+}
+
+func (m *QueryAllEpochInfoCountdownsRequest) Reset()         { *m = QueryAllEpochInfoCountdownsRequest{} }
+func (m *QueryAllEpochInfoCountdownsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllEpochInfoCountdownsRequest) ProtoMessage()    {}
+func (*QueryAllEpochInfoCountdownsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_251d1b776a8ec968, []int{6}
+}
+func (m *QueryAllEpochInfoCountdownsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllEpochInfoCountdownsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllEpochInfoCountdownsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllEpochInfoCountdownsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllEpochInfoCountdownsRequest.Merge(m, src)
+}
+func (m *QueryAllEpochInfoCountdownsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllEpochInfoCountdownsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllEpochInfoCountdownsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllEpochInfoCountdownsRequest proto.InternalMessageInfo
+
+// EpochInfoCountdown is an EpochInfo along with its progress relative to the
+// current block.
+type EpochInfoCountdown struct {
+	EpochInfo EpochInfo `protobuf:"bytes,1,opt,name=epoch_info,json=epochInfo,proto3" json:"epoch_info"`
+	// seconds_until_next_tick is the number of seconds from the current block
+	// time until `next_tick`. 0 if the current block time has reached
+	// `next_tick` and the next epoch has not yet started.
+	SecondsUntilNextTick uint32 `protobuf:"varint,2,opt,name=seconds_until_next_tick,json=secondsUntilNextTick,proto3" json:"seconds_until_next_tick,omitempty"`
+	// blocks_since_epoch_start is the number of blocks since the current epoch
+	// started. The number of blocks until the next tick depends on future block
+	// times and cannot be known ahead of time.
+	BlocksSinceEpochStart uint32 `protobuf:"varint,3,opt,name=blocks_since_epoch_start,json=blocksSinceEpochStart,proto3" json:"blocks_since_epoch_start,omitempty"`
+}
+
+func (m *EpochInfoCountdown) Reset()         { *m = EpochInfoCountdown{} }
+func (m *EpochInfoCountdown) String() string { return proto.CompactTextString(m) }
+func (*EpochInfoCountdown) ProtoMessage()    {}
+func (*EpochInfoCountdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_251d1b776a8ec968, []int{7}
+}
+func (m *EpochInfoCountdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfoCountdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfoCountdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochInfoCountdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfoCountdown.Merge(m, src)
+}
+func (m *EpochInfoCountdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfoCountdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfoCountdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfoCountdown proto.InternalMessageInfo
+
+func (m *EpochInfoCountdown) GetEpochInfo() EpochInfo {
+	if m != nil {
+		return m.EpochInfo
+	}
+	return EpochInfo{}
+}
+
+func (m *EpochInfoCountdown) GetSecondsUntilNextTick() uint32 {
+	if m != nil {
+		return m.SecondsUntilNextTick
+	}
+	return 0
+}
+
+func (m *EpochInfoCountdown) GetBlocksSinceEpochStart() uint32 {
+	if m != nil {
+		return m.BlocksSinceEpochStart
+	}
+	return 0
+}
+
+// QueryAllEpochInfoCountdownsResponse is response type for the
+// AllEpochInfoCountdowns RPC method.
+type QueryAllEpochInfoCountdownsResponse struct {
+	Countdowns []EpochInfoCountdown `protobuf:"bytes,1,rep,name=countdowns,proto3" json:"countdowns"`
+}
+
+func (m *QueryAllEpochInfoCountdownsResponse) Reset()         { *m = QueryAllEpochInfoCountdownsResponse{} }
+func (m *QueryAllEpochInfoCountdownsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllEpochInfoCountdownsResponse) ProtoMessage()    {}
+func (*QueryAllEpochInfoCountdownsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_251d1b776a8ec968, []int{8}
+}
+func (m *QueryAllEpochInfoCountdownsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllEpochInfoCountdownsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllEpochInfoCountdownsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllEpochInfoCountdownsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllEpochInfoCountdownsResponse.Merge(m, src)
+}
+func (m *QueryAllEpochInfoCountdownsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllEpochInfoCountdownsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllEpochInfoCountdownsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllEpochInfoCountdownsResponse proto.InternalMessageInfo
+
+func (m *QueryAllEpochInfoCountdownsResponse) GetCountdowns() []EpochInfoCountdown {
+	if m != nil {
+		return m.Countdowns
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetEpochInfoRequest)(nil), "dydxprotocol.epochs.QueryGetEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "dydxprotocol.epochs.QueryEpochInfoResponse")
 	proto.RegisterType((*QueryAllEpochInfoRequest)(nil), "dydxprotocol.epochs.QueryAllEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoAllResponse)(nil), "dydxprotocol.epochs.QueryEpochInfoAllResponse")
+	proto.RegisterType((*QueryTimeToNextEpochRequest)(nil), "dydxprotocol.epochs.QueryTimeToNextEpochRequest")
+	proto.RegisterType((*QueryTimeToNextEpochResponse)(nil), "dydxprotocol.epochs.QueryTimeToNextEpochResponse")
+	proto.RegisterType((*QueryAllEpochInfoCountdownsRequest)(nil), "dydxprotocol.epochs.QueryAllEpochInfoCountdownsRequest")
+	proto.RegisterType((*EpochInfoCountdown)(nil), "dydxprotocol.epochs.EpochInfoCountdown")
+	proto.RegisterType((*QueryAllEpochInfoCountdownsResponse)(nil), "dydxprotocol.epochs.QueryAllEpochInfoCountdownsResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/epochs/query.proto", fileDescriptor_251d1b776a8ec968) }
 
 var fileDescriptor_251d1b776a8ec968 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0xc7, 0x3b, 0x88, 0x26, 0x8c, 0xae, 0x46, 0x63, 0xb0, 0x31, 0xc5, 0x34, 0xc4, 0x0f, 0x08,
-	0x33, 0x01, 0x5d, 0xb8, 0x05, 0xa3, 0xc4, 0x9d, 0x76, 0x69, 0x62, 0xcc, 0xb4, 0x0c, 0xa5, 0x49,
-	0x99, 0x29, 0xcc, 0x40, 0x20, 0xc6, 0x8d, 0x4f, 0x60, 0xe2, 0x4a, 0xdf, 0xc0, 0xb7, 0x70, 0xc9,
-	0x92, 0xc4, 0x8d, 0x2b, 0x63, 0xe0, 0x3e, 0xc8, 0x4d, 0xa7, 0x05, 0xca, 0xa5, 0x5c, 0x6e, 0xee,
-	0x6a, 0x26, 0xe7, 0xfc, 0xcf, 0x39, 0xbf, 0xf3, 0x01, 0x2b, 0xbd, 0x79, 0x6f, 0x16, 0x8d, 0x85,
-	0x12, 0x9e, 0x08, 0x09, 0x8b, 0x84, 0x37, 0x90, 0x64, 0x34, 0x61, 0xe3, 0x39, 0xd6, 0x56, 0x74,
-	0x37, 0x2b, 0xc0, 0x89, 0xc0, 0xbc, 0xe7, 0x0b, 0x5f, 0x68, 0x23, 0x89, 0x7f, 0x89, 0xd4, 0x7c,
-	0xe8, 0x0b, 0xe1, 0x87, 0x8c, 0xd0, 0x28, 0x20, 0x94, 0x73, 0xa1, 0xa8, 0x0a, 0x04, 0x97, 0xa9,
-	0xb7, 0xe6, 0x09, 0x39, 0x14, 0x92, 0xb8, 0x54, 0xb2, 0xa4, 0x02, 0x99, 0x36, 0x5d, 0xa6, 0x68,
-	0x93, 0x44, 0xd4, 0x0f, 0xb8, 0x16, 0xa7, 0xda, 0x6a, 0x1e, 0x95, 0x7e, 0x3e, 0x05, 0xbc, 0x9f,
-	0xd6, 0xb3, 0x31, 0x2c, 0xbf, 0x8f, 0xf3, 0x74, 0x99, 0x7a, 0x1d, 0xfb, 0xde, 0xf2, 0xbe, 0x70,
-	0xd8, 0x68, 0xc2, 0xa4, 0x42, 0x08, 0x16, 0x39, 0x1d, 0xb2, 0x32, 0x78, 0x04, 0x9e, 0x96, 0x1c,
-	0xfd, 0xb7, 0x3f, 0xc2, 0xfb, 0x5a, 0x9f, 0x11, 0xcb, 0x48, 0x70, 0xc9, 0xd0, 0x2b, 0x08, 0x77,
-	0xd9, 0x75, 0xcc, 0xed, 0x96, 0x85, 0x73, 0x3a, 0xc7, 0xdb, 0xd8, 0x4e, 0x71, 0xf1, 0xaf, 0x62,
-	0x38, 0x25, 0xb6, 0x31, 0xd8, 0x6e, 0x8a, 0xd3, 0x0e, 0xc3, 0x03, 0x9c, 0x37, 0x10, 0xee, 0x9a,
-	0x4c, 0x0b, 0x3c, 0xc6, 0xc9, 0x44, 0x70, 0x3c, 0x11, 0x9c, 0xcc, 0x3c, 0x9d, 0x08, 0x7e, 0x47,
-	0x7d, 0x96, 0xc6, 0x3a, 0x99, 0x48, 0xfb, 0x17, 0x80, 0x0f, 0xf6, 0x7b, 0x68, 0x87, 0xe1, 0xd1,
-	0x36, 0x6e, 0x5c, 0xa3, 0x0d, 0xd4, 0xdd, 0x43, 0x2d, 0x68, 0xd4, 0x27, 0x27, 0x51, 0x13, 0x82,
-	0x2c, 0x6b, 0xeb, 0x77, 0x01, 0xde, 0xd4, 0xac, 0xe8, 0x27, 0x80, 0xa5, 0x6d, 0x45, 0xd4, 0xc8,
-	0x25, 0x3a, 0xb6, 0x49, 0xb3, 0x7e, 0x5c, 0x7e, 0xb0, 0x48, 0xbb, 0xf9, 0xf5, 0xcf, 0xd9, 0xf7,
-	0x42, 0x1d, 0x3d, 0x23, 0x7b, 0x17, 0x34, 0x7d, 0x71, 0x78, 0x44, 0xe4, 0x73, 0x7c, 0x14, 0x5f,
-	0xd0, 0x0f, 0x00, 0xef, 0x64, 0xa7, 0x79, 0x19, 0x5f, 0xce, 0x6a, 0x4d, 0x7c, 0x05, 0xbe, 0xcc,
-	0x92, 0xec, 0x9a, 0x46, 0xac, 0x22, 0xfb, 0x34, 0x62, 0xc7, 0x59, 0xac, 0x2c, 0xb0, 0x5c, 0x59,
-	0xe0, 0xff, 0xca, 0x02, 0xdf, 0xd6, 0x96, 0xb1, 0x5c, 0x5b, 0xc6, 0xdf, 0xb5, 0x65, 0x7c, 0x78,
-	0xe9, 0x07, 0x6a, 0x30, 0x71, 0xb1, 0x27, 0x86, 0x17, 0xf3, 0x34, 0xbc, 0x01, 0x0d, 0x38, 0xd9,
-	0x5a, 0x66, 0x9b, 0xc4, 0x6a, 0x1e, 0x31, 0xe9, 0xde, 0xd2, 0x8e, 0xe7, 0xe7, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x24, 0x43, 0x8b, 0xbe, 0xfa, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0xfc, 0x30, 0xee, 0x53, 0x63, 0x32, 0x22, 0xae, 0x0b, 0x29, 0xa6, 0x12, 0x51,
+	0x08, 0xad, 0x0b, 0x1a, 0xf0, 0x08, 0x44, 0x89, 0x07, 0x89, 0x2e, 0x78, 0x31, 0x31, 0x4d, 0xdb,
+	0x1d, 0xca, 0x84, 0xee, 0x4c, 0xd9, 0x99, 0xc5, 0x25, 0xc6, 0x8b, 0x7f, 0x81, 0x89, 0x27, 0xfd,
+	0x0f, 0xbc, 0x98, 0x78, 0xf2, 0x5f, 0xc0, 0x1b, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x7f, 0x88, 0xe9,
+	0x74, 0xe8, 0x76, 0x69, 0x77, 0x59, 0x88, 0xa7, 0xdd, 0xbc, 0xf7, 0xbe, 0xef, 0x7d, 0xde, 0x9b,
+	0xf7, 0x0a, 0x13, 0xb5, 0xbd, 0x5a, 0x2b, 0x6c, 0x30, 0xc1, 0x3c, 0x16, 0x58, 0x38, 0x64, 0xde,
+	0x16, 0xb7, 0x76, 0x9a, 0xb8, 0xb1, 0x67, 0x4a, 0x2b, 0xba, 0x96, 0x0e, 0x30, 0xe3, 0x80, 0xf2,
+	0x88, 0xcf, 0x7c, 0x26, 0x8d, 0x56, 0xf4, 0x2f, 0x0e, 0x2d, 0x8f, 0xfb, 0x8c, 0xf9, 0x01, 0xb6,
+	0x9c, 0x90, 0x58, 0x0e, 0xa5, 0x4c, 0x38, 0x82, 0x30, 0xca, 0x95, 0x77, 0xda, 0x63, 0xbc, 0xce,
+	0xb8, 0xe5, 0x3a, 0x1c, 0xc7, 0x15, 0xac, 0xdd, 0x8a, 0x8b, 0x85, 0x53, 0xb1, 0x42, 0xc7, 0x27,
+	0x54, 0x06, 0xab, 0xd8, 0xc9, 0x3c, 0x2a, 0xf9, 0x63, 0x13, 0xba, 0xa9, 0xea, 0x19, 0x26, 0x94,
+	0x5e, 0x44, 0x79, 0x56, 0xb1, 0x78, 0x1c, 0xf9, 0x9e, 0xd2, 0x4d, 0x56, 0xc5, 0x3b, 0x4d, 0xcc,
+	0x05, 0x42, 0x30, 0x44, 0x9d, 0x3a, 0x2e, 0x69, 0xb7, 0xb4, 0xbb, 0xc5, 0xaa, 0xfc, 0x6f, 0xbc,
+	0x86, 0x51, 0x19, 0x9f, 0x0a, 0xe6, 0x21, 0xa3, 0x1c, 0xa3, 0x15, 0x80, 0x76, 0x76, 0xa9, 0xb9,
+	0x34, 0xa7, 0x9b, 0x39, 0x9d, 0x9b, 0x89, 0x76, 0x79, 0x68, 0xff, 0xf7, 0x44, 0xa1, 0x5a, 0xc4,
+	0xc7, 0x06, 0xc3, 0x55, 0x38, 0x4b, 0x41, 0x90, 0xc1, 0x79, 0x02, 0xd0, 0x6e, 0x52, 0x15, 0xb8,
+	0x63, 0xc6, 0x13, 0x31, 0xa3, 0x89, 0x98, 0xf1, 0xcc, 0xd5, 0x44, 0xcc, 0xe7, 0x8e, 0x8f, 0x95,
+	0xb6, 0x9a, 0x52, 0x1a, 0x5f, 0x34, 0xb8, 0xd9, 0xd9, 0xc3, 0x52, 0x10, 0x74, 0x6d, 0x63, 0xf0,
+	0x1c, 0x6d, 0xa0, 0xd5, 0x0e, 0xd4, 0x01, 0x89, 0x3a, 0x75, 0x2a, 0x6a, 0x4c, 0xd0, 0xc1, 0x5a,
+	0x81, 0x31, 0x89, 0xba, 0x41, 0xea, 0x78, 0x83, 0xad, 0xe1, 0x56, 0xfc, 0x4a, 0xbd, 0x5e, 0xa8,
+	0x01, 0xe3, 0xf9, 0x12, 0xd5, 0xe0, 0x18, 0x14, 0x29, 0x6e, 0x09, 0x5b, 0x10, 0x6f, 0x5b, 0x0a,
+	0xaf, 0x54, 0x2f, 0x46, 0x86, 0x0d, 0xe2, 0x6d, 0xa3, 0x87, 0x70, 0x83, 0x63, 0x8f, 0xd1, 0x1a,
+	0xb7, 0x9b, 0x54, 0x90, 0xc0, 0x6e, 0x87, 0x0e, 0xc8, 0xd0, 0x11, 0xe5, 0x7e, 0x19, 0x79, 0xd7,
+	0x94, 0xcc, 0x98, 0x04, 0x23, 0xf3, 0x6c, 0x2b, 0xac, 0x49, 0x45, 0x8d, 0xbd, 0xa1, 0x5c, 0xd1,
+	0x1a, 0x3f, 0x34, 0x40, 0x59, 0xf7, 0x7f, 0x59, 0x9c, 0x73, 0x82, 0xa3, 0x05, 0x28, 0xb9, 0x01,
+	0xf3, 0xb6, 0xb9, 0xcd, 0x09, 0xf5, 0xb0, 0x1d, 0x83, 0x70, 0xe1, 0x34, 0x44, 0x69, 0x50, 0xea,
+	0xae, 0xc7, 0xfe, 0xf5, 0xc8, 0x2d, 0xeb, 0xaf, 0x47, 0x4e, 0x43, 0xc0, 0xed, 0x9e, 0x1d, 0xab,
+	0x61, 0x3f, 0x03, 0xf0, 0x12, 0xab, 0xda, 0xa6, 0xa9, 0xde, 0xbd, 0x25, 0x59, 0x54, 0x93, 0xa9,
+	0x04, 0x73, 0x5f, 0x87, 0x61, 0x58, 0x96, 0x45, 0x9f, 0x35, 0x28, 0x26, 0x12, 0x34, 0x9b, 0x9b,
+	0xb2, 0xdb, 0x61, 0x97, 0x67, 0xba, 0x87, 0x67, 0xee, 0xda, 0xa8, 0xbc, 0xff, 0xf9, 0xf7, 0xe3,
+	0xc0, 0x0c, 0xba, 0x67, 0x75, 0x7c, 0x50, 0x76, 0x1f, 0x64, 0xbf, 0x29, 0xd6, 0xdb, 0x68, 0x03,
+	0xdf, 0xa1, 0x4f, 0x1a, 0x5c, 0x4e, 0x1f, 0x57, 0x2f, 0xbe, 0x9c, 0x4b, 0x2f, 0x9b, 0x7d, 0xf0,
+	0xa5, 0x6e, 0xd6, 0x98, 0x96, 0x88, 0x93, 0xc8, 0x38, 0x1d, 0x11, 0x7d, 0xd3, 0xe0, 0xea, 0x89,
+	0xd3, 0x40, 0xf7, 0xbb, 0xd7, 0xcb, 0x3f, 0xbc, 0x72, 0xe5, 0x0c, 0x0a, 0x05, 0xf9, 0x48, 0x42,
+	0xce, 0xa3, 0x4a, 0x37, 0x48, 0x41, 0xea, 0xd8, 0x16, 0x2c, 0xde, 0x5c, 0x69, 0x3b, 0x9e, 0xe7,
+	0x77, 0x0d, 0x46, 0xf3, 0x17, 0x0d, 0x2d, 0xf4, 0x37, 0xd9, 0xcc, 0x31, 0x96, 0x17, 0xcf, 0x2e,
+	0xec, 0x77, 0xda, 0xed, 0x85, 0x5d, 0xae, 0xee, 0x1f, 0xea, 0xda, 0xc1, 0xa1, 0xae, 0xfd, 0x39,
+	0xd4, 0xb5, 0x0f, 0x47, 0x7a, 0xe1, 0xe0, 0x48, 0x2f, 0xfc, 0x3a, 0xd2, 0x0b, 0xaf, 0x16, 0x7d,
+	0x22, 0xb6, 0x9a, 0xae, 0xe9, 0xb1, 0xfa, 0xc9, 0x3c, 0xb3, 0xde, 0x96, 0x43, 0xa8, 0x95, 0x58,
+	0x5a, 0xc9, 0x84, 0xf6, 0x42, 0xcc, 0xdd, 0x0b, 0xd2, 0x31, 0xff, 0x6f, 0x00, 0x76, 0xdb, 0x79,
+	0x79, 0x77, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +551,11 @@ type QueryClient interface {
 	EpochInfo(ctx context.Context, in *QueryGetEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
 	// Queries a list of EpochInfo items.
 	EpochInfoAll(ctx context.Context, in *QueryAllEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoAllResponse, error)
+	// Queries the time remaining until the next tick of an EpochInfo.
+	TimeToNextEpoch(ctx context.Context, in *QueryTimeToNextEpochRequest, opts ...grpc.CallOption) (*QueryTimeToNextEpochResponse, error)
+	// Queries all EpochInfos along with the countdown until each one's next
+	// tick.
+	AllEpochInfoCountdowns(ctx context.Context, in *QueryAllEpochInfoCountdownsRequest, opts ...grpc.CallOption) (*QueryAllEpochInfoCountdownsResponse, error)
 }
 
 type queryClient struct {
@@ -303,12 +584,35 @@ func (c *queryClient) EpochInfoAll(ctx context.Context, in *QueryAllEpochInfoReq
 	return out, nil
 }
 
+func (c *queryClient) TimeToNextEpoch(ctx context.Context, in *QueryTimeToNextEpochRequest, opts ...grpc.CallOption) (*QueryTimeToNextEpochResponse, error) {
+	out := new(QueryTimeToNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.epochs.Query/TimeToNextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllEpochInfoCountdowns(ctx context.Context, in *QueryAllEpochInfoCountdownsRequest, opts ...grpc.CallOption) (*QueryAllEpochInfoCountdownsResponse, error) {
+	out := new(QueryAllEpochInfoCountdownsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.epochs.Query/AllEpochInfoCountdowns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a EpochInfo by name.
 	EpochInfo(context.Context, *QueryGetEpochInfoRequest) (*QueryEpochInfoResponse, error)
 	// Queries a list of EpochInfo items.
 	EpochInfoAll(context.Context, *QueryAllEpochInfoRequest) (*QueryEpochInfoAllResponse, error)
+	// Queries the time remaining until the next tick of an EpochInfo.
+	TimeToNextEpoch(context.Context, *QueryTimeToNextEpochRequest) (*QueryTimeToNextEpochResponse, error)
+	// Queries all EpochInfos along with the countdown until each one's next
+	// tick.
+	AllEpochInfoCountdowns(context.Context, *QueryAllEpochInfoCountdownsRequest) (*QueryAllEpochInfoCountdownsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -321,6 +625,12 @@ func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryGetEpo
 func (*UnimplementedQueryServer) EpochInfoAll(ctx context.Context, req *QueryAllEpochInfoRequest) (*QueryEpochInfoAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfoAll not implemented")
 }
+func (*UnimplementedQueryServer) TimeToNextEpoch(ctx context.Context, req *QueryTimeToNextEpochRequest) (*QueryTimeToNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeToNextEpoch not implemented")
+}
+func (*UnimplementedQueryServer) AllEpochInfoCountdowns(ctx context.Context, req *QueryAllEpochInfoCountdownsRequest) (*QueryAllEpochInfoCountdownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllEpochInfoCountdowns not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -362,6 +672,42 @@ func _Query_EpochInfoAll_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TimeToNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeToNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeToNextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.epochs.Query/TimeToNextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeToNextEpoch(ctx, req.(*QueryTimeToNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllEpochInfoCountdowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEpochInfoCountdownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllEpochInfoCountdowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.epochs.Query/AllEpochInfoCountdowns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllEpochInfoCountdowns(ctx, req.(*QueryAllEpochInfoCountdownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.epochs.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -374,6 +720,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochInfoAll",
 			Handler:    _Query_EpochInfoAll_Handler,
 		},
+		{
+			MethodName: "TimeToNextEpoch",
+			Handler:    _Query_TimeToNextEpoch_Handler,
+		},
+		{
+			MethodName: "AllEpochInfoCountdowns",
+			Handler:    _Query_AllEpochInfoCountdowns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/epochs/query.proto",
@@ -526,57 +880,223 @@ func (m *QueryEpochInfoAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTimeToNextEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetEpochInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTimeToNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeToNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTimeToNextEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EpochInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTimeToNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeToNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SecondsUntilNextTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilNextTick))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.NextTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextTick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllEpochInfoCountdownsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochInfoCountdownsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochInfoCountdownsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfoCountdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfoCountdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfoCountdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksSinceEpochStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksSinceEpochStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SecondsUntilNextTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondsUntilNextTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.EpochInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochInfoCountdownsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochInfoCountdownsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochInfoCountdownsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Countdowns) > 0 {
+		for iNdEx := len(m.Countdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Countdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -586,20 +1106,340 @@ func (m *QueryEpochInfoAllResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeToNextEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeToNextEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextTick != 0 {
+		n += 1 + sovQuery(uint64(m.NextTick))
+	}
+	if m.SecondsUntilNextTick != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilNextTick))
+	}
+	return n
+}
+
+func (m *QueryAllEpochInfoCountdownsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EpochInfoCountdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SecondsUntilNextTick != 0 {
+		n += 1 + sovQuery(uint64(m.SecondsUntilNextTick))
+	}
+	if m.BlocksSinceEpochStart != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksSinceEpochStart))
+	}
+	return n
+}
+
+func (m *QueryAllEpochInfoCountdownsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Countdowns) > 0 {
+		for _, e := range m.Countdowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetEpochInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoAllResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -622,17 +1462,17 @@ func (m *QueryGetEpochInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoAllResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -642,23 +1482,61 @@ func (m *QueryGetEpochInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.EpochInfo = append(m.EpochInfo, EpochInfo{})
+			if err := m.EpochInfo[len(m.EpochInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -681,7 +1559,7 @@ func (m *QueryGetEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeToNextEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,17 +1582,17 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeToNextEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeToNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -724,24 +1602,23 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -764,7 +1641,7 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeToNextEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -787,17 +1664,17 @@ func (m *QueryAllEpochInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeToNextEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeToNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTick", wireType)
 			}
-			var msglen int
+			m.NextTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -807,28 +1684,80 @@ func (m *QueryAllEpochInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextTick |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilNextTick", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.SecondsUntilNextTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilNextTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochInfoCountdownsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochInfoCountdownsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochInfoCountdownsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -850,7 +1779,7 @@ func (m *QueryAllEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoAllResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochInfoCountdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,10 +1802,10 @@ func (m *QueryEpochInfoAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochInfoCountdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochInfoCountdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -908,14 +1837,101 @@ func (m *QueryEpochInfoAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochInfo = append(m.EpochInfo, EpochInfo{})
-			if err := m.EpochInfo[len(m.EpochInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilNextTick", wireType)
+			}
+			m.SecondsUntilNextTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilNextTick |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksSinceEpochStart", wireType)
+			}
+			m.BlocksSinceEpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksSinceEpochStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochInfoCountdownsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochInfoCountdownsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochInfoCountdownsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Countdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -942,10 +1958,8 @@ func (m *QueryEpochInfoAllResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Countdowns = append(m.Countdowns, EpochInfoCountdown{})
+			if err := m.Countdowns[len(m.Countdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23,6 +23,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryPerpetualFeeParams())
 	cmd.AddCommand(CmdQueryUserFeeTier())
+	cmd.AddCommand(CmdQueryFeeTiers())
 
 	return cmd
 }
@@ -75,3 +76,26 @@ func CmdQueryUserFeeTier() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryFeeTiers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-fee-tiers",
+		Short: "get the ordered table of perpetual fee tiers",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeTiers(
+				context.Background(),
+				&types.QueryFeeTiersRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
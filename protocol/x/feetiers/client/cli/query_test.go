@@ -62,3 +62,14 @@ func TestQueryUserFeeTier(t *testing.T) {
 	var resp types.QueryUserFeeTierResponse
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 }
+
+func TestQueryFeeTiers(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryFeeTiers(), []string{})
+
+	require.NoError(t, err)
+	var resp types.QueryFeeTiersResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, types.DefaultGenesis().Params.Tiers, resp.Tiers)
+}
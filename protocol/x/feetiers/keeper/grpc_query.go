@@ -48,3 +48,21 @@ func (k Keeper) UserFeeTier(
 		Tier:  tier,
 	}, nil
 }
+
+// FeeTiers processes a query request/response for the ordered table of perpetual fee tiers from state.
+func (k Keeper) FeeTiers(
+	c context.Context,
+	req *types.QueryFeeTiersRequest,
+) (
+	*types.QueryFeeTiersResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+	return &types.QueryFeeTiersResponse{
+		Tiers: k.GetPerpetualFeeParams(ctx).Tiers,
+	}, nil
+}
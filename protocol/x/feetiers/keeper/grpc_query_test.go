@@ -90,3 +90,39 @@ func TestUserFeeTier(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeTiers(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	for name, tc := range map[string]struct {
+		req *types.QueryFeeTiersRequest
+		res *types.QueryFeeTiersResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryFeeTiersRequest{},
+			res: &types.QueryFeeTiersResponse{
+				Tiers: types.DefaultGenesis().Params.Tiers,
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.FeeTiers(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+				require.Equal(t, k.GetPerpetualFeeParams(ctx).Tiers, res.Tiers)
+			}
+		})
+	}
+}
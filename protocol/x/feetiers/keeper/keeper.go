@@ -100,11 +100,25 @@ func (k Keeper) getUserFeeTier(ctx sdk.Context, address string) (uint32, *types.
 	return idx, tiers[idx]
 }
 
+// GetPerpetualFeePpm returns the signed fee in ppm charged to the user for a fill, based on whether
+// the user is the taker or the maker of the fill.
 func (k Keeper) GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32 {
-	_, userTier := k.getUserFeeTier(ctx, address)
 	if isTaker {
-		return userTier.TakerFeePpm
+		return k.GetEffectiveTakerFeePpm(ctx, address)
 	}
+	return k.GetEffectiveMakerFeePpm(ctx, address)
+}
+
+// GetEffectiveTakerFeePpm returns the signed taker fee in ppm of the user's fee tier.
+func (k Keeper) GetEffectiveTakerFeePpm(ctx sdk.Context, address string) int32 {
+	_, userTier := k.getUserFeeTier(ctx, address)
+	return userTier.TakerFeePpm
+}
+
+// GetEffectiveMakerFeePpm returns the signed maker fee in ppm of the user's fee tier.
+// A negative value is a rebate paid to the maker.
+func (k Keeper) GetEffectiveMakerFeePpm(ctx sdk.Context, address string) int32 {
+	_, userTier := k.getUserFeeTier(ctx, address)
 	return userTier.MakerFeePpm
 }
 
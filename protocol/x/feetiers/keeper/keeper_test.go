@@ -35,8 +35,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 			&stattypes.GlobalStats{
 				NotionalTraded: 10_000,
 			},
-			10,
-			1,
+			30,
+			3,
 		},
 		"regular user, increased tier": {
 			"alice",
@@ -71,8 +71,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 			&stattypes.GlobalStats{
 				NotionalTraded: 2_000_000_000,
 			},
-			30,
-			3,
+			10,
+			1,
 		},
 		"vault is top tier regardless of stats": {
 			constants.Vault_Clob0.ToModuleAccountAddress(),
@@ -83,8 +83,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 			&stattypes.GlobalStats{
 				NotionalTraded: 10_000,
 			},
-			30,
-			3,
+			10,
+			1,
 		},
 	}
 
@@ -100,8 +100,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 					Tiers: []*types.PerpetualFeeTier{
 						{
 							Name:        "1",
-							TakerFeePpm: 10,
-							MakerFeePpm: 1,
+							TakerFeePpm: 30,
+							MakerFeePpm: 3,
 						},
 						{
 							Name:                      "2",
@@ -113,8 +113,8 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 							Name:                           "3",
 							AbsoluteVolumeRequirement:      1_000_000_000,
 							MakerVolumeShareRequirementPpm: 500_000,
-							TakerFeePpm:                    30,
-							MakerFeePpm:                    3,
+							TakerFeePpm:                    10,
+							MakerFeePpm:                    1,
 						},
 					},
 				},
@@ -131,6 +131,40 @@ func TestGetPerpetualFeePpm(t *testing.T) {
 	}
 }
 
+func TestGetEffectiveFeePpm(t *testing.T) {
+	tests := map[string]struct {
+		feeParams           types.PerpetualFeeParams
+		expectedTakerFeePpm int32
+		expectedMakerFeePpm int32
+	}{
+		"standard tier user": {
+			feeParams:           constants.PerpetualFeeParams,
+			expectedTakerFeePpm: 500,
+			expectedMakerFeePpm: 200,
+		},
+		"rebate tier user": {
+			feeParams:           constants.PerpetualFeeParamsMakerRebate,
+			expectedTakerFeePpm: 500,
+			expectedMakerFeePpm: -200,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.FeeTiersKeeper
+			require.NoError(t, k.SetPerpetualFeeParams(ctx, tc.feeParams))
+
+			user := constants.AliceAccAddress.String()
+			require.Equal(t, tc.expectedTakerFeePpm, k.GetEffectiveTakerFeePpm(ctx, user))
+			require.Equal(t, tc.expectedMakerFeePpm, k.GetEffectiveMakerFeePpm(ctx, user))
+			require.Equal(t, k.GetPerpetualFeePpm(ctx, user, true), k.GetEffectiveTakerFeePpm(ctx, user))
+			require.Equal(t, k.GetPerpetualFeePpm(ctx, user, false), k.GetEffectiveMakerFeePpm(ctx, user))
+		})
+	}
+}
+
 func TestGetMaxMakerRebate(t *testing.T) {
 	tests := map[string]struct {
 		expectedLowestMakerFee int32
@@ -23,11 +23,12 @@ func TestSetPerpetualFeeParams_Success(t *testing.T) {
 
 	params := types.PerpetualFeeParams{
 		Tiers: []*types.PerpetualFeeTier{
-			{},
+			{
+				MakerFeePpm: 1,
+				TakerFeePpm: 1,
+			},
 			{
 				AbsoluteVolumeRequirement: 100,
-				MakerFeePpm:               1,
-				TakerFeePpm:               1,
 			},
 		},
 	}
@@ -36,3 +37,27 @@ func TestSetPerpetualFeeParams_Success(t *testing.T) {
 	require.NoError(t, k.SetPerpetualFeeParams(ctx, params))
 	require.Equal(t, params, k.GetPerpetualFeeParams(ctx))
 }
+
+func TestSetPerpetualFeeParams_FeesNotMonotonic(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.FeeTiersKeeper
+
+	initialParams := k.GetPerpetualFeeParams(ctx)
+	params := types.PerpetualFeeParams{
+		Tiers: []*types.PerpetualFeeTier{
+			{
+				MakerFeePpm: 1,
+				TakerFeePpm: 1,
+			},
+			{
+				AbsoluteVolumeRequirement: 100,
+				MakerFeePpm:               1,
+				TakerFeePpm:               2,
+			},
+		},
+	}
+
+	require.ErrorIs(t, k.SetPerpetualFeeParams(ctx, params), types.ErrFeesNotMonotonic)
+	require.Equal(t, initialParams, k.GetPerpetualFeeParams(ctx))
+}
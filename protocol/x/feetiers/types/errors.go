@@ -30,4 +30,9 @@ var (
 		404,
 		"Authority is invalid",
 	)
+	ErrFeesNotMonotonic = errorsmod.Register(
+		ModuleName,
+		405,
+		"Fee tiers must have non-increasing maker and taker fees",
+	)
 )
@@ -20,7 +20,10 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{
 				Params: types.PerpetualFeeParams{
 					Tiers: []*types.PerpetualFeeTier{
-						{},
+						{
+							MakerFeePpm: 2,
+							TakerFeePpm: 2,
+						},
 						{
 							AbsoluteVolumeRequirement: 10,
 							MakerFeePpm:               1,
@@ -30,8 +33,6 @@ func TestGenesisState_Validate(t *testing.T) {
 							AbsoluteVolumeRequirement:      15,
 							TotalVolumeShareRequirementPpm: 1,
 							MakerVolumeShareRequirementPpm: 1,
-							MakerFeePpm:                    2,
-							TakerFeePpm:                    2,
 						},
 					},
 				},
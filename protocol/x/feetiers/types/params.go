@@ -21,6 +21,10 @@ func (m *PerpetualFeeParams) Validate() error {
 			prevTier.MakerVolumeShareRequirementPpm > currTier.MakerVolumeShareRequirementPpm {
 			return ErrTiersOutOfOrder
 		}
+		if prevTier.MakerFeePpm < currTier.MakerFeePpm ||
+			prevTier.TakerFeePpm < currTier.TakerFeePpm {
+			return ErrFeesNotMonotonic
+		}
 	}
 
 	lowestMakerFee := int32(math.MaxInt32)
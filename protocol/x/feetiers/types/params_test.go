@@ -44,10 +44,92 @@ func TestPerpetualFeeParams_Validate(t *testing.T) {
 			},
 			err: types.ErrTiersOutOfOrder,
 		},
+		"non-increasing fees across tiers is valid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               100,
+						TakerFeePpm:               450,
+					},
+					{
+						AbsoluteVolumeRequirement:      10,
+						TotalVolumeShareRequirementPpm: 5,
+						MakerFeePpm:                    50,
+						TakerFeePpm:                    450,
+					},
+				},
+			},
+			err: nil,
+		},
+		"maker fee increasing across tiers is invalid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               101,
+						TakerFeePpm:               450,
+					},
+				},
+			},
+			err: types.ErrFeesNotMonotonic,
+		},
+		"taker fee increasing across tiers is invalid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               50,
+						TakerFeePpm:               501,
+					},
+				},
+			},
+			err: types.ErrFeesNotMonotonic,
+		},
+		"fee increasing in a later tier is invalid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               0,
+						TakerFeePpm:               400,
+					},
+					{
+						AbsoluteVolumeRequirement: 20,
+						MakerFeePpm:               -50,
+						TakerFeePpm:               300,
+					},
+					{
+						AbsoluteVolumeRequirement: 30,
+						MakerFeePpm:               -50,
+						TakerFeePpm:               350,
+					},
+				},
+			},
+			err: types.ErrFeesNotMonotonic,
+		},
 		"maker rebate exceeds taker fee": {
 			params: &types.PerpetualFeeParams{
 				Tiers: []*types.PerpetualFeeTier{
-					{},
+					{
+						TakerFeePpm: 3,
+					},
 					{
 						AbsoluteVolumeRequirement: 5,
 						MakerFeePpm:               -2,
@@ -55,6 +137,7 @@ func TestPerpetualFeeParams_Validate(t *testing.T) {
 					},
 					{
 						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               -2,
 						TakerFeePpm:               1,
 					},
 				},
@@ -75,12 +158,45 @@ func TestPerpetualFeeParams_Validate(t *testing.T) {
 					},
 					{
 						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               -2,
 						TakerFeePpm:               3,
 					},
 				},
 			},
 			err: nil,
 		},
+		"maker rebate equal to lowest taker fee is valid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               -250,
+						TakerFeePpm:               250,
+					},
+				},
+			},
+			err: nil,
+		},
+		"maker rebate exceeding lowest taker fee by one is invalid": {
+			params: &types.PerpetualFeeParams{
+				Tiers: []*types.PerpetualFeeTier{
+					{
+						MakerFeePpm: 100,
+						TakerFeePpm: 500,
+					},
+					{
+						AbsoluteVolumeRequirement: 10,
+						MakerFeePpm:               -251,
+						TakerFeePpm:               250,
+					},
+				},
+			},
+			err: types.ErrInvalidFee,
+		},
 		"maker rebate cannot coexist with no taker fee": {
 			params: &types.PerpetualFeeParams{
 				Tiers: []*types.PerpetualFeeTier{
@@ -213,45 +213,135 @@ func (m *QueryUserFeeTierResponse) GetTier() *PerpetualFeeTier {
 	return nil
 }
 
+// QueryFeeTiersRequest is a request type for the FeeTiers RPC method.
+type QueryFeeTiersRequest struct {
+}
+
+func (m *QueryFeeTiersRequest) Reset()         { *m = QueryFeeTiersRequest{} }
+func (m *QueryFeeTiersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTiersRequest) ProtoMessage()    {}
+func (*QueryFeeTiersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{4}
+}
+func (m *QueryFeeTiersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTiersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTiersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTiersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTiersRequest.Merge(m, src)
+}
+func (m *QueryFeeTiersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTiersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTiersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTiersRequest proto.InternalMessageInfo
+
+// QueryFeeTiersResponse is a response type for the FeeTiers RPC method.
+type QueryFeeTiersResponse struct {
+	// The perpetual fee tiers, ordered from the lowest to the highest volume
+	// requirements.
+	Tiers []*PerpetualFeeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (m *QueryFeeTiersResponse) Reset()         { *m = QueryFeeTiersResponse{} }
+func (m *QueryFeeTiersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTiersResponse) ProtoMessage()    {}
+func (*QueryFeeTiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f31456045d64644f, []int{5}
+}
+func (m *QueryFeeTiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTiersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTiersResponse.Merge(m, src)
+}
+func (m *QueryFeeTiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTiersResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTiersResponse) GetTiers() []*PerpetualFeeTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPerpetualFeeParamsRequest)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsRequest")
 	proto.RegisterType((*QueryPerpetualFeeParamsResponse)(nil), "dydxprotocol.feetiers.QueryPerpetualFeeParamsResponse")
 	proto.RegisterType((*QueryUserFeeTierRequest)(nil), "dydxprotocol.feetiers.QueryUserFeeTierRequest")
 	proto.RegisterType((*QueryUserFeeTierResponse)(nil), "dydxprotocol.feetiers.QueryUserFeeTierResponse")
+	proto.RegisterType((*QueryFeeTiersRequest)(nil), "dydxprotocol.feetiers.QueryFeeTiersRequest")
+	proto.RegisterType((*QueryFeeTiersResponse)(nil), "dydxprotocol.feetiers.QueryFeeTiersResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/feetiers/query.proto", fileDescriptor_f31456045d64644f) }
 
 var fileDescriptor_f31456045d64644f = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0xae, 0xd2, 0x40,
-	0x14, 0x6d, 0x09, 0xef, 0x25, 0xce, 0x8b, 0x9b, 0x09, 0xc6, 0xda, 0x98, 0xbe, 0x67, 0x37, 0x48,
-	0x22, 0x1d, 0x83, 0xca, 0x42, 0x57, 0xb2, 0x80, 0x2d, 0x56, 0xdc, 0xb8, 0x21, 0xa5, 0xbd, 0x94,
-	0x31, 0xb4, 0x53, 0x66, 0xa6, 0x06, 0xb6, 0x7e, 0x81, 0x89, 0x1f, 0xe0, 0x4f, 0xb8, 0xf3, 0x07,
-	0x58, 0x12, 0xdd, 0xb8, 0x32, 0x06, 0x4c, 0xfc, 0x0d, 0xd3, 0x69, 0x51, 0xb4, 0x40, 0x78, 0xbb,
-	0xb9, 0x77, 0xce, 0x39, 0xf7, 0x9c, 0x3b, 0x83, 0xee, 0x05, 0x8b, 0x60, 0x9e, 0x70, 0x26, 0x99,
-	0xcf, 0xa6, 0x64, 0x0c, 0x20, 0x29, 0x70, 0x41, 0x66, 0x29, 0xf0, 0x85, 0xa3, 0xfa, 0xf8, 0xd6,
-	0x2e, 0xc4, 0xd9, 0x42, 0xcc, 0x3b, 0x3e, 0x13, 0x11, 0x13, 0x43, 0x75, 0x43, 0xf2, 0x22, 0x67,
-	0x98, 0xb5, 0x90, 0x85, 0x2c, 0xef, 0x67, 0xa7, 0xa2, 0x7b, 0x37, 0x64, 0x2c, 0x9c, 0x02, 0xf1,
-	0x12, 0x4a, 0xbc, 0x38, 0x66, 0xd2, 0x93, 0x94, 0xc5, 0x5b, 0x8e, 0xbd, 0xdf, 0x48, 0xe2, 0x71,
-	0x2f, 0x2a, 0x30, 0xf6, 0x15, 0xb2, 0x5e, 0x64, 0xc6, 0xfa, 0xc0, 0x13, 0x90, 0xa9, 0x37, 0xed,
-	0x02, 0xf4, 0x15, 0xc0, 0x85, 0x59, 0x0a, 0x42, 0xda, 0x6f, 0xd0, 0xe5, 0x41, 0x84, 0x48, 0x58,
-	0x2c, 0x00, 0xf7, 0xd0, 0x79, 0x2e, 0x6a, 0xe8, 0x57, 0xfa, 0xfd, 0x8b, 0x56, 0xc3, 0xd9, 0x9b,
-	0xcf, 0x29, 0x4b, 0x74, 0xaa, 0xcb, 0xef, 0x97, 0x9a, 0x5b, 0xd0, 0xed, 0x1e, 0xba, 0xad, 0x66,
-	0xbd, 0x12, 0xc0, 0xbb, 0x00, 0x03, 0x0a, 0xbc, 0xb0, 0x81, 0x1f, 0xa0, 0x6a, 0x2a, 0x80, 0xab,
-	0x09, 0x37, 0x3a, 0xc6, 0x97, 0x4f, 0xcd, 0x5a, 0xb1, 0xa0, 0xe7, 0x41, 0xc0, 0x41, 0x88, 0x97,
-	0x92, 0xd3, 0x38, 0x74, 0x15, 0xca, 0x8e, 0x90, 0x51, 0x16, 0x2a, 0xdc, 0xd6, 0xd0, 0x19, 0x8d,
-	0x03, 0x98, 0x2b, 0xa9, 0x9b, 0x6e, 0x5e, 0xe0, 0x67, 0xa8, 0x9a, 0x99, 0x34, 0x2a, 0x2a, 0x41,
-	0xfd, 0x84, 0x04, 0x4a, 0x54, 0x91, 0x5a, 0xbf, 0x2a, 0xe8, 0x4c, 0xcd, 0xc3, 0x9f, 0x75, 0x84,
-	0xcb, 0x31, 0xf1, 0x93, 0x03, 0x7a, 0xc7, 0x77, 0x6f, 0xb6, 0xaf, 0x4b, 0xcb, 0x23, 0xda, 0xed,
-	0x77, 0x5f, 0x7f, 0x7e, 0xa8, 0x3c, 0xc4, 0x0e, 0xf9, 0xe7, 0x0b, 0xbc, 0x7d, 0xbc, 0xf3, 0x0b,
-	0xb6, 0xec, 0xe1, 0x18, 0x60, 0x98, 0xef, 0x1f, 0x7f, 0xd4, 0xd1, 0xc5, 0xce, 0xca, 0xb0, 0x73,
-	0x6c, 0x7e, 0xf9, 0x91, 0x4c, 0x72, 0x32, 0xbe, 0x30, 0x4a, 0x94, 0xd1, 0x06, 0xae, 0x1f, 0x36,
-	0x9a, 0xbd, 0xa7, 0xf2, 0x98, 0x95, 0x9d, 0xc1, 0x72, 0x6d, 0xe9, 0xab, 0xb5, 0xa5, 0xff, 0x58,
-	0x5b, 0xfa, 0xfb, 0x8d, 0xa5, 0xad, 0x36, 0x96, 0xf6, 0x6d, 0x63, 0x69, 0xaf, 0x9f, 0x86, 0x54,
-	0x4e, 0xd2, 0x91, 0xe3, 0xb3, 0xe8, 0x7f, 0xb1, 0xa6, 0x3f, 0xf1, 0x68, 0x4c, 0xfe, 0x74, 0xe6,
-	0x7f, 0xd5, 0xe5, 0x22, 0x01, 0x31, 0x3a, 0x57, 0x57, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x05, 0x23, 0x9b, 0x30, 0xbb, 0x03, 0x00, 0x00,
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x6d, 0x52, 0xc1, 0x56, 0x5c, 0x56, 0x29, 0x18, 0x0b, 0xb9, 0xc1, 0x08, 0x95,
+	0xaa, 0xad, 0x17, 0x15, 0xe8, 0x01, 0xc4, 0x81, 0x1c, 0xda, 0x6b, 0x09, 0x85, 0x03, 0x97, 0xc8,
+	0x8d, 0xa7, 0xae, 0x51, 0xe2, 0x75, 0x77, 0xd7, 0x28, 0xb9, 0xf2, 0x04, 0x08, 0x1e, 0x80, 0x97,
+	0xe0, 0xc6, 0x0b, 0xf4, 0x58, 0xc1, 0x85, 0x13, 0x42, 0x09, 0x8f, 0xc1, 0x01, 0x79, 0xbc, 0x2e,
+	0x69, 0x52, 0x47, 0xe9, 0xcd, 0x3b, 0xfe, 0xff, 0x7f, 0xbe, 0xdd, 0x19, 0x7a, 0x37, 0x18, 0x04,
+	0xfd, 0x44, 0x0a, 0x2d, 0x3a, 0xa2, 0xcb, 0x8f, 0x00, 0x74, 0x04, 0x52, 0xf1, 0x93, 0x14, 0xe4,
+	0xc0, 0xc3, 0x3a, 0x5b, 0x19, 0x97, 0x78, 0x85, 0xc4, 0xbe, 0xdd, 0x11, 0xaa, 0x27, 0x54, 0x1b,
+	0xff, 0xf0, 0xfc, 0x90, 0x3b, 0xec, 0x7a, 0x28, 0x42, 0x91, 0xd7, 0xb3, 0x2f, 0x53, 0xbd, 0x13,
+	0x0a, 0x11, 0x76, 0x81, 0xfb, 0x49, 0xc4, 0xfd, 0x38, 0x16, 0xda, 0xd7, 0x91, 0x88, 0x0b, 0x8f,
+	0x7b, 0x39, 0x48, 0xe2, 0x4b, 0xbf, 0x67, 0x34, 0x6e, 0x83, 0x3a, 0x2f, 0x33, 0xb0, 0x7d, 0x90,
+	0x09, 0xe8, 0xd4, 0xef, 0xee, 0x02, 0xec, 0xa3, 0xa0, 0x05, 0x27, 0x29, 0x28, 0xed, 0xbe, 0xa3,
+	0xab, 0xa5, 0x0a, 0x95, 0x88, 0x58, 0x01, 0xdb, 0xa3, 0x4b, 0x79, 0xa8, 0x45, 0x1a, 0xe4, 0xc1,
+	0xf2, 0xf6, 0xba, 0x77, 0xe9, 0xfd, 0xbc, 0xe9, 0x88, 0x66, 0xf5, 0xf4, 0xd7, 0x6a, 0xa5, 0x65,
+	0xec, 0xee, 0x1e, 0xbd, 0x85, 0xbd, 0x5e, 0x2b, 0x90, 0xbb, 0x00, 0x07, 0x11, 0x48, 0x83, 0xc1,
+	0x36, 0x69, 0x35, 0x55, 0x20, 0xb1, 0xc3, 0xf5, 0xa6, 0xf5, 0xfd, 0xeb, 0x56, 0xdd, 0x3c, 0xd0,
+	0x8b, 0x20, 0x90, 0xa0, 0xd4, 0x2b, 0x2d, 0xa3, 0x38, 0x6c, 0xa1, 0xca, 0xed, 0x51, 0x6b, 0x3a,
+	0xc8, 0xd0, 0xd6, 0x69, 0x2d, 0x8a, 0x03, 0xe8, 0x63, 0xd4, 0x8d, 0x56, 0x7e, 0x60, 0xcf, 0x68,
+	0x35, 0x83, 0xb4, 0x16, 0xf0, 0x06, 0x6b, 0x73, 0xdc, 0x00, 0x43, 0xd1, 0xe4, 0xde, 0xa4, 0x75,
+	0x6c, 0x67, 0xaa, 0xe7, 0x6f, 0xf7, 0x86, 0xae, 0x4c, 0xd4, 0x0d, 0xc3, 0x73, 0x5a, 0xc3, 0x40,
+	0x8b, 0x34, 0x16, 0xaf, 0xd2, 0x2e, 0x77, 0x6d, 0xff, 0x5d, 0xa4, 0x35, 0x0c, 0x66, 0xdf, 0x08,
+	0x65, 0xd3, 0xcf, 0xca, 0x9e, 0x94, 0x04, 0xce, 0x9e, 0xb5, 0xbd, 0x73, 0x55, 0x5b, 0x7e, 0x1d,
+	0x77, 0xe7, 0xc3, 0x8f, 0x3f, 0x9f, 0x17, 0x1e, 0x32, 0x8f, 0x5f, 0x58, 0xb9, 0xf7, 0x8f, 0xc7,
+	0xb6, 0xae, 0x70, 0xb7, 0x8f, 0x00, 0xda, 0xf9, 0xbc, 0xd9, 0x17, 0x42, 0x97, 0xc7, 0x46, 0xc4,
+	0xbc, 0x59, 0xfd, 0xa7, 0x97, 0xc2, 0xe6, 0x73, 0xeb, 0x0d, 0x28, 0x47, 0xd0, 0x75, 0xb6, 0x56,
+	0x0e, 0x9a, 0xed, 0x0f, 0x32, 0x66, 0x47, 0xf6, 0x89, 0xd0, 0x6b, 0xc5, 0xf4, 0xd8, 0xc6, 0xac,
+	0x76, 0x13, 0xb3, 0xb7, 0x37, 0xe7, 0x13, 0x1b, 0xb0, 0x0d, 0x04, 0xbb, 0xcf, 0xee, 0x95, 0x83,
+	0x15, 0x4c, 0xaa, 0x79, 0x70, 0x3a, 0x74, 0xc8, 0xd9, 0xd0, 0x21, 0xbf, 0x87, 0x0e, 0xf9, 0x38,
+	0x72, 0x2a, 0x67, 0x23, 0xa7, 0xf2, 0x73, 0xe4, 0x54, 0xde, 0x3e, 0x0d, 0x23, 0x7d, 0x9c, 0x1e,
+	0x7a, 0x1d, 0xd1, 0x9b, 0x0c, 0xda, 0xea, 0x1c, 0xfb, 0x51, 0xcc, 0xcf, 0x2b, 0xfd, 0xff, 0xc9,
+	0x7a, 0x90, 0x80, 0x3a, 0x5c, 0xc2, 0x5f, 0x8f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x05,
+	0x96, 0xaa, 0xc0, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -270,6 +360,8 @@ type QueryClient interface {
 	PerpetualFeeParams(ctx context.Context, in *QueryPerpetualFeeParamsRequest, opts ...grpc.CallOption) (*QueryPerpetualFeeParamsResponse, error)
 	// Queries a user's fee tier
 	UserFeeTier(ctx context.Context, in *QueryUserFeeTierRequest, opts ...grpc.CallOption) (*QueryUserFeeTierResponse, error)
+	// Queries the ordered table of perpetual fee tiers.
+	FeeTiers(ctx context.Context, in *QueryFeeTiersRequest, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error)
 }
 
 type queryClient struct {
@@ -298,12 +390,23 @@ func (c *queryClient) UserFeeTier(ctx context.Context, in *QueryUserFeeTierReque
 	return out, nil
 }
 
+func (c *queryClient) FeeTiers(ctx context.Context, in *QueryFeeTiersRequest, opts ...grpc.CallOption) (*QueryFeeTiersResponse, error) {
+	out := new(QueryFeeTiersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.feetiers.Query/FeeTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the PerpetualFeeParams.
 	PerpetualFeeParams(context.Context, *QueryPerpetualFeeParamsRequest) (*QueryPerpetualFeeParamsResponse, error)
 	// Queries a user's fee tier
 	UserFeeTier(context.Context, *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error)
+	// Queries the ordered table of perpetual fee tiers.
+	FeeTiers(context.Context, *QueryFeeTiersRequest) (*QueryFeeTiersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -316,6 +419,9 @@ func (*UnimplementedQueryServer) PerpetualFeeParams(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) UserFeeTier(ctx context.Context, req *QueryUserFeeTierRequest) (*QueryUserFeeTierResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserFeeTier not implemented")
 }
+func (*UnimplementedQueryServer) FeeTiers(ctx context.Context, req *QueryFeeTiersRequest) (*QueryFeeTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTiers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -357,6 +463,24 @@ func _Query_UserFeeTier_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.feetiers.Query/FeeTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTiers(ctx, req.(*QueryFeeTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.feetiers.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -369,6 +493,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserFeeTier",
 			Handler:    _Query_UserFeeTier_Handler,
 		},
+		{
+			MethodName: "FeeTiers",
+			Handler:    _Query_FeeTiers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/feetiers/query.proto",
@@ -500,6 +628,66 @@ func (m *QueryUserFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeTiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -560,6 +748,30 @@ func (m *QueryUserFeeTierResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -886,6 +1098,140 @@ func (m *QueryUserFeeTierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeTiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, &PerpetualFeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
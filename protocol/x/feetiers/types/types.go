@@ -7,6 +7,8 @@ import (
 type FeeTiersKeeper interface {
 	GetLowestMakerFee(ctx sdk.Context) int32
 	GetPerpetualFeePpm(ctx sdk.Context, address string, isTaker bool) int32
+	GetEffectiveTakerFeePpm(ctx sdk.Context, address string) int32
+	GetEffectiveMakerFeePpm(ctx sdk.Context, address string) int32
 	GetPerpetualFeeParams(
 		ctx sdk.Context,
 	) PerpetualFeeParams
@@ -7,6 +7,10 @@ import (
 
 // EndBlocker executes all ABCI EndBlock logic respective to the perpetuals module.
 func EndBlocker(ctx sdk.Context, k types.PerpetualsKeeper) {
+	// Net funding settled during this block is attributed to the current `funding-tick` epoch,
+	// so it must be persisted before a new `funding-tick` epoch is processed.
+	k.PersistBlockNetFunding(ctx)
+
 	// We don't expect the following two calls to take effect in the same block,
 	// since according to their genesis set-up, `funding-tick` happens every exact
 	// hour while `funding-sample` happens every minute on the half-minute.
@@ -20,6 +20,10 @@ func TestEndBlocker(t *testing.T) {
 	}{
 		"Success": {
 			setupMocks: func(ctx sdk.Context, mck *mocks.PerpetualsKeeper) {
+				mck.On(
+					"PersistBlockNetFunding",
+					ctx,
+				)
 				mck.On(
 					"MaybeProcessNewFundingTickEpoch",
 					ctx,
@@ -37,6 +41,10 @@ func TestEndBlocker(t *testing.T) {
 		},
 		"MaybeProcessNewFundingTickEpoch Error": {
 			setupMocks: func(ctx sdk.Context, mck *mocks.PerpetualsKeeper) {
+				mck.On(
+					"PersistBlockNetFunding",
+					ctx,
+				)
 				mck.On(
 					"MaybeProcessNewFundingSampleEpoch",
 					ctx,
@@ -50,6 +58,10 @@ func TestEndBlocker(t *testing.T) {
 		},
 		"MaybeProcessNewFundingSampleEpoch Error": {
 			setupMocks: func(ctx sdk.Context, mck *mocks.PerpetualsKeeper) {
+				mck.On(
+					"PersistBlockNetFunding",
+					ctx,
+				)
 				mck.On(
 					"MaybeProcessNewFundingSampleEpoch",
 					ctx,
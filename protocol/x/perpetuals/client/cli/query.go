@@ -29,7 +29,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryPremiumSamples())
 	cmd.AddCommand(CmdQueryPremiumVotes())
+	cmd.AddCommand(CmdQueryPremiumAccumulationStatus())
 	cmd.AddCommand(CmdQueryAllLiquidityTiers())
+	cmd.AddCommand(CmdQueryNetFundingPerPerpetual())
+	cmd.AddCommand(CmdQueryAllOpenInterest())
+	cmd.AddCommand(CmdQueryPerpetualMarginFractions())
+	cmd.AddCommand(CmdQueryPerpetualMetadata())
+	cmd.AddCommand(CmdQueryCurrentFundingRate())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
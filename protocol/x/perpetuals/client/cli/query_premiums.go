@@ -53,3 +53,26 @@ func CmdQueryPremiumVotes() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryPremiumAccumulationStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-premium-accumulation-status",
+		Short: "Get the number of premiums accumulated in the current funding epochs",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PremiumAccumulationStatus(
+				context.Background(),
+				&types.QueryPremiumAccumulationStatusRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package flags
+
+import (
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// A struct containing the values of all flags.
+type PerpetualsFlags struct {
+	TruncatePremiumsOnOverflow bool
+}
+
+// List of CLI flags.
+const (
+	// Funding.
+	TruncatePremiumsOnOverflow = "truncate-premiums-on-overflow"
+)
+
+// Default values.
+const (
+	DefaultTruncatePremiumsOnOverflow = false
+)
+
+// AddPerpetualsFlagsToCmd adds flags to app initialization.
+// These flags should be applied to the `start` command of the V4 Cosmos application.
+// E.g. `dydxprotocold start --truncate-premiums-on-overflow true`.
+func AddPerpetualsFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Bool(
+		TruncatePremiumsOnOverflow,
+		DefaultTruncatePremiumsOnOverflow,
+		"Truncates the stored premiums of a perpetual to the total number of premiums instead of "+
+			"halting the chain if the stored premiums exceed the total number of premiums. "+
+			"Intended for incident recovery only.",
+	)
+}
+
+func GetDefaultPerpetualsFlags() PerpetualsFlags {
+	return PerpetualsFlags{
+		TruncatePremiumsOnOverflow: DefaultTruncatePremiumsOnOverflow,
+	}
+}
+
+// GetPerpetualsFlagValuesFromOptions gets values from the `AppOptions` struct which contains values
+// from the command-line flags.
+func GetPerpetualsFlagValuesFromOptions(
+	appOpts servertypes.AppOptions,
+) PerpetualsFlags {
+	// Create default result.
+	result := GetDefaultPerpetualsFlags()
+
+	// Populate the flags if they exist.
+	if option := appOpts.Get(TruncatePremiumsOnOverflow); option != nil {
+		if v, err := cast.ToBoolE(option); err == nil {
+			result.TruncatePremiumsOnOverflow = v
+		}
+	}
+
+	return result
+}
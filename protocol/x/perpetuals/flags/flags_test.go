@@ -0,0 +1,68 @@
+package flags_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/mocks"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFlagsToCommand(t *testing.T) {
+	cmd := cobra.Command{}
+
+	flags.AddPerpetualsFlagsToCmd(&cmd)
+	tests := map[string]struct {
+		flagName string
+	}{
+		fmt.Sprintf("Has %s flag", flags.TruncatePremiumsOnOverflow): {
+			flagName: flags.TruncatePremiumsOnOverflow,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Contains(t, cmd.Flags().FlagUsages(), tc.flagName)
+		})
+	}
+}
+
+func TestGetFlagValuesFromOptions(t *testing.T) {
+	tests := map[string]struct {
+		// Parameters.
+		optsMap map[string]any
+
+		// Expectations.
+		expectedTruncatePremiumsOnOverflow bool
+	}{
+		"Sets to default if unset": {
+			expectedTruncatePremiumsOnOverflow: flags.DefaultTruncatePremiumsOnOverflow,
+		},
+		"Sets values from options": {
+			optsMap: map[string]any{
+				flags.TruncatePremiumsOnOverflow: true,
+			},
+			expectedTruncatePremiumsOnOverflow: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockOpts := mocks.AppOptions{}
+			mockOpts.On("Get", mock.AnythingOfType("string")).
+				Return(func(key string) interface{} {
+					return tc.optsMap[key]
+				})
+
+			flags := flags.GetPerpetualsFlagValuesFromOptions(&mockOpts)
+			require.Equal(
+				t,
+				tc.expectedTruncatePremiumsOnOverflow,
+				flags.TruncatePremiumsOnOverflow,
+			)
+		})
+	}
+}
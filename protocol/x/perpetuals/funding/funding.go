@@ -14,21 +14,23 @@ import (
 //
 //	 indexDelta =
 //		  fundingRatePpm *
-//	   (time / realizationPeriod) *
+//	   (time / fundingRatePeriod) *
 //	   quoteQuantumsPerBaseQuantum
 //
-// Any multiplication is done before division to avoid precision loss.
+// Any multiplication is done before division to avoid precision loss. A `fundingRatePeriodSeconds`
+// of zero, e.g. on a chain whose params predate the field, is treated as the default 8-hour period.
 func GetFundingIndexDelta(
 	perp types.Perpetual,
 	marketPrice pricestypes.MarketPrice,
-	big8hrFundingRatePpm *big.Int,
+	bigFundingRatePpm *big.Int,
 	timeSinceLastFunding uint32,
+	fundingRatePeriodSeconds uint32,
 ) (fundingIndexDelta *big.Int) {
 	// Get pro-rated funding rate adjusted by time delta.
 	result := new(big.Int).SetUint64(uint64(timeSinceLastFunding))
 
 	// Multiply by the time-delta numerator upfront.
-	result.Mul(result, big8hrFundingRatePpm)
+	result.Mul(result, bigFundingRatePpm)
 
 	// Multiply by the price of the asset.
 	result = lib.BaseToQuoteQuantums(
@@ -40,8 +42,10 @@ func GetFundingIndexDelta(
 
 	// Divide by the time-delta denominator.
 	// Use truncated division (towards zero) instead of Euclidean division.
-	// TODO(DEC-1536): Make the 8-hour funding rate period configurable.
-	result.Quo(result, big.NewInt(60*60*8))
+	if fundingRatePeriodSeconds == 0 {
+		fundingRatePeriodSeconds = types.DefaultFundingRatePeriodSeconds
+	}
+	result.Quo(result, new(big.Int).SetUint64(uint64(fundingRatePeriodSeconds)))
 
 	return result
 }
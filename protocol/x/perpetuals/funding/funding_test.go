@@ -14,11 +14,12 @@ import (
 
 func TestGetFundingIndexDelta(t *testing.T) {
 	testCases := map[string]struct {
-		perp                 types.Perpetual
-		marketPrice          pricestypes.MarketPrice
-		big8hrFundingRatePpm *big.Int
-		timeSinceLastFunding uint32
-		expected             *big.Int
+		perp                     types.Perpetual
+		marketPrice              pricestypes.MarketPrice
+		bigFundingRatePpm        *big.Int
+		timeSinceLastFunding     uint32
+		fundingRatePeriodSeconds uint32
+		expected                 *big.Int
 	}{
 		"Positive Funding Rate (rounds towards zero)": {
 			perp: *perptest.GeneratePerpetual(
@@ -29,9 +30,10 @@ func TestGetFundingIndexDelta(t *testing.T) {
 				Exponent: 0,
 				Price:    1_000,
 			},
-			big8hrFundingRatePpm: big.NewInt(1_001_999),
-			timeSinceLastFunding: 8 * 60 * 60,
-			expected:             big.NewInt(1_001),
+			bigFundingRatePpm:        big.NewInt(1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60,
+			fundingRatePeriodSeconds: types.DefaultFundingRatePeriodSeconds,
+			expected:                 big.NewInt(1_001),
 		},
 		"Negative Funding Rate (rounds towards zero)": {
 			perp: *perptest.GeneratePerpetual(
@@ -42,9 +44,10 @@ func TestGetFundingIndexDelta(t *testing.T) {
 				Exponent: 0,
 				Price:    1_000,
 			},
-			big8hrFundingRatePpm: big.NewInt(-1_001_999),
-			timeSinceLastFunding: 8 * 60 * 60,
-			expected:             big.NewInt(-1_001),
+			bigFundingRatePpm:        big.NewInt(-1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60,
+			fundingRatePeriodSeconds: types.DefaultFundingRatePeriodSeconds,
+			expected:                 big.NewInt(-1_001),
 		},
 		"Varied parameters (1)": {
 			perp: *perptest.GeneratePerpetual(
@@ -55,9 +58,10 @@ func TestGetFundingIndexDelta(t *testing.T) {
 				Exponent: 2,
 				Price:    1_000,
 			},
-			big8hrFundingRatePpm: big.NewInt(-1_001_999),
-			timeSinceLastFunding: 8 * 60 * 60 / 2,
-			expected:             big.NewInt(-5_009_995_000_000),
+			bigFundingRatePpm:        big.NewInt(-1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60 / 2,
+			fundingRatePeriodSeconds: types.DefaultFundingRatePeriodSeconds,
+			expected:                 big.NewInt(-5_009_995_000_000),
 		},
 		"Varied parameters (2)": {
 			perp: *perptest.GeneratePerpetual(
@@ -68,9 +72,40 @@ func TestGetFundingIndexDelta(t *testing.T) {
 				Exponent: -6,
 				Price:    1_000,
 			},
-			big8hrFundingRatePpm: big.NewInt(-1_001_999),
-			timeSinceLastFunding: 8 * 60 * 60 / 8,
-			expected:             big.NewInt(-125_249_875),
+			bigFundingRatePpm:        big.NewInt(-1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60 / 8,
+			fundingRatePeriodSeconds: types.DefaultFundingRatePeriodSeconds,
+			expected:                 big.NewInt(-125_249_875),
+		},
+		"Zero funding rate period defaults to 8 hours": {
+			perp: *perptest.GeneratePerpetual(
+				perptest.WithAtomicResolution(0),
+			),
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -6,
+				Price:    1_000,
+			},
+			bigFundingRatePpm:        big.NewInt(-1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60 / 8,
+			fundingRatePeriodSeconds: 0,
+			// Same as "Varied parameters (2)" with an 8 hour funding rate period.
+			expected: big.NewInt(-125_249_875),
+		},
+		"1 hour funding rate period": {
+			perp: *perptest.GeneratePerpetual(
+				perptest.WithAtomicResolution(0),
+			),
+			marketPrice: pricestypes.MarketPrice{
+				Id:       0,
+				Exponent: -6,
+				Price:    1_000,
+			},
+			bigFundingRatePpm:        big.NewInt(-1_001_999),
+			timeSinceLastFunding:     8 * 60 * 60 / 8,
+			fundingRatePeriodSeconds: 60 * 60,
+			// 8 times the index delta of "Varied parameters (2)" with an 8 hour funding rate period.
+			expected: big.NewInt(-1_001_999_000),
 		},
 	}
 
@@ -79,8 +114,9 @@ func TestGetFundingIndexDelta(t *testing.T) {
 			result := funding.GetFundingIndexDelta(
 				tc.perp,
 				tc.marketPrice,
-				tc.big8hrFundingRatePpm,
+				tc.bigFundingRatePpm,
 				tc.timeSinceLastFunding,
+				tc.fundingRatePeriodSeconds,
 			)
 
 			require.Equal(t, tc.expected, result)
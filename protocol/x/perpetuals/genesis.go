@@ -28,6 +28,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			elem.ImpactNotional,
 			elem.OpenInterestLowerCap,
 			elem.OpenInterestUpperCap,
+			elem.PremiumCombinationMethod,
 		)
 
 		if err != nil {
@@ -46,6 +47,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+	k.InitializePerpetualMarketIndex(ctx)
 }
 
 // ExportGenesis returns the perpetual module's exported genesis.
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+)
+
+// SetFundingIndexSnapshotForTest exposes `setFundingIndexSnapshot` to tests in the `keeper_test` package.
+func (k Keeper) SetFundingIndexSnapshotForTest(
+	ctx sdk.Context,
+	perpetualId uint32,
+	epoch uint32,
+	fundingIndex dtypes.SerializableInt,
+) {
+	k.setFundingIndexSnapshot(ctx, perpetualId, epoch, fundingIndex)
+}
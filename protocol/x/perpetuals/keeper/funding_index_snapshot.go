@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"cmp"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// GetFundingIndexAtEpoch returns the funding index of a perpetual at the start of the provided
+// `funding-tick` epoch. Snapshots are only retained for the last `FundingIndexSnapshotRetentionEpochs`
+// epochs, and an error is returned if no snapshot of the epoch is retained for the perpetual.
+func (k Keeper) GetFundingIndexAtEpoch(
+	ctx sdk.Context,
+	perpetualId uint32,
+	epoch uint32,
+) (dtypes.SerializableInt, error) {
+	store := k.getFundingIndexSnapshotStore(ctx, perpetualId)
+	b := store.Get(lib.Uint32ToKey(epoch % types.FundingIndexSnapshotRetentionEpochs))
+
+	var snapshot types.PerpetualFundingIndexSnapshot
+	if b != nil {
+		k.cdc.MustUnmarshal(b, &snapshot)
+	}
+
+	// The slot of the epoch may be empty or may have been overwritten by a more recent epoch.
+	if b == nil || snapshot.Epoch != epoch {
+		return dtypes.SerializableInt{}, errorsmod.Wrapf(
+			types.ErrFundingIndexSnapshotNotFound,
+			"perpetual id %d, epoch %d",
+			perpetualId,
+			epoch,
+		)
+	}
+
+	return snapshot.FundingIndex, nil
+}
+
+// GetFundingIndexSnapshots returns all retained funding index snapshots of a perpetual, sorted by epoch.
+func (k Keeper) GetFundingIndexSnapshots(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (list []types.PerpetualFundingIndexSnapshot) {
+	iterator := storetypes.KVStorePrefixIterator(k.getFundingIndexSnapshotStore(ctx, perpetualId), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PerpetualFundingIndexSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		list = append(list, snapshot)
+	}
+
+	// Snapshots are stored by ring buffer slot, so sort them by epoch.
+	slices.SortFunc(list, func(a, b types.PerpetualFundingIndexSnapshot) int {
+		return cmp.Compare(a.Epoch, b.Epoch)
+	})
+	return list
+}
+
+// setFundingIndexSnapshot stores a snapshot of the funding index of a perpetual at the start of a
+// `funding-tick` epoch. Snapshots are stored in a ring buffer of `FundingIndexSnapshotRetentionEpochs`
+// slots per perpetual, so a snapshot overwrites the snapshot from `FundingIndexSnapshotRetentionEpochs`
+// epochs earlier.
+func (k Keeper) setFundingIndexSnapshot(
+	ctx sdk.Context,
+	perpetualId uint32,
+	epoch uint32,
+	fundingIndex dtypes.SerializableInt,
+) {
+	store := k.getFundingIndexSnapshotStore(ctx, perpetualId)
+	snapshot := types.PerpetualFundingIndexSnapshot{
+		PerpetualId:  perpetualId,
+		Epoch:        epoch,
+		FundingIndex: fundingIndex,
+	}
+	store.Set(
+		lib.Uint32ToKey(epoch%types.FundingIndexSnapshotRetentionEpochs),
+		k.cdc.MustMarshal(&snapshot),
+	)
+}
+
+// getFundingIndexSnapshotStore returns a prefix store of the funding index snapshots of a perpetual.
+func (k Keeper) getFundingIndexSnapshotStore(ctx sdk.Context, perpetualId uint32) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingIndexSnapshotKeyPrefix))
+	return prefix.NewStore(store, lib.Uint32ToKey(perpetualId))
+}
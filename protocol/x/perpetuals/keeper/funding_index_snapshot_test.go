@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFundingIndexAtEpoch(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	p := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	perp, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx,
+		p.Params.Id,
+		p.Params.Ticker,
+		p.Params.MarketId,
+		p.Params.AtomicResolution,
+		p.Params.DefaultFundingPpm,
+		p.Params.LiquidityTier,
+		p.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			NextTick:               3600,
+			CurrentEpoch:           1,
+			CurrentEpochStartBlock: 10,
+			IsInitialized:          true,
+		},
+	)
+	require.NoError(t, err)
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	)
+	require.NoError(t, err)
+
+	// Process the funding ticks at the start of epochs 1 and 2, each with 60 samples of 0.001 percent.
+	fundingIndices := make(map[uint32]*big.Int)
+	for _, epoch := range []uint32{1, 2} {
+		if epoch > 1 {
+			started, err := pc.EpochsKeeper.MaybeStartNextEpoch(
+				ctx.WithBlockHeight(int64(10*epoch)).WithBlockTime(time.Unix(int64(3600*(epoch-1)), 0)),
+				epochstypes.FundingTickEpochInfoName,
+			)
+			require.NoError(t, err)
+			require.True(t, started)
+		}
+
+		keepertest.PopulateTestPremiumStore(
+			t,
+			ctx,
+			pc.PerpetualsKeeper,
+			[]types.Perpetual{perp},
+			constants.GenerateConstantFundingPremiums(1000, 60),
+			false, // isVote
+		)
+		pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx.WithBlockHeight(int64(10 * epoch)))
+
+		perp, err = pc.PerpetualsKeeper.GetPerpetual(ctx, perp.Params.Id)
+		require.NoError(t, err)
+		fundingIndices[epoch] = perp.FundingIndex.BigInt()
+	}
+	require.NotEqual(t, fundingIndices[1], fundingIndices[2])
+
+	// The funding index at the start of each past epoch is returned.
+	for epoch, expectedFundingIndex := range fundingIndices {
+		fundingIndex, err := pc.PerpetualsKeeper.GetFundingIndexAtEpoch(ctx, perp.Params.Id, epoch)
+		require.NoError(t, err)
+		require.Equal(t, expectedFundingIndex, fundingIndex.BigInt())
+	}
+
+	// Epochs without a snapshot, or that are out of retention, return an error.
+	for _, epoch := range []uint32{0, 3, 1 + types.FundingIndexSnapshotRetentionEpochs} {
+		_, err = pc.PerpetualsKeeper.GetFundingIndexAtEpoch(ctx, perp.Params.Id, epoch)
+		require.ErrorIs(t, err, types.ErrFundingIndexSnapshotNotFound)
+	}
+
+	// Perpetuals without a snapshot return an error.
+	_, err = pc.PerpetualsKeeper.GetFundingIndexAtEpoch(ctx, 99, 1)
+	require.ErrorIs(t, err, types.ErrFundingIndexSnapshotNotFound)
+}
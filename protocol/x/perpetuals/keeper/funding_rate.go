@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// GetCurrentFundingRate returns the clamped funding rate of a perpetual computed at the start of the
+// most recent `funding-tick` epoch, along with the current funding index of the perpetual. The funding
+// rate is zero if no funding rate has been computed for the perpetual yet. Returns an error if the
+// perpetual does not exist.
+func (k Keeper) GetCurrentFundingRate(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	fundingRatePpm int32,
+	fundingIndex dtypes.SerializableInt,
+	err error,
+) {
+	perpetual, err := k.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return 0, dtypes.SerializableInt{}, err
+	}
+
+	store := k.getFundingRateStore(ctx)
+	if b := store.Get(lib.Uint32ToKey(perpetualId)); b != nil {
+		var fundingRate types.PerpetualFundingRate
+		k.cdc.MustUnmarshal(b, &fundingRate)
+		fundingRatePpm = fundingRate.FundingRatePpm
+	}
+
+	return fundingRatePpm, perpetual.FundingIndex, nil
+}
+
+// setFundingRate stores the clamped funding rate of a perpetual computed at the start of the current
+// `funding-tick` epoch, overwriting the funding rate computed in the previous epoch.
+func (k Keeper) setFundingRate(
+	ctx sdk.Context,
+	perpetualId uint32,
+	fundingRatePpm int32,
+) {
+	fundingRate := types.PerpetualFundingRate{
+		PerpetualId:    perpetualId,
+		FundingRatePpm: fundingRatePpm,
+	}
+	store := k.getFundingRateStore(ctx)
+	store.Set(lib.Uint32ToKey(perpetualId), k.cdc.MustMarshal(&fundingRate))
+}
+
+// getFundingRateStore returns a prefix store of the most recent funding rates of all perpetuals.
+func (k Keeper) getFundingRateStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FundingRateKeyPrefix))
+}
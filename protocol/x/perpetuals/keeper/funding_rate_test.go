@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCurrentFundingRate_NoFundingTick(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	_, _, err := pc.PerpetualsKeeper.GetCurrentFundingRate(pc.Ctx, 0)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+
+	perpetual := constants.BtcUsd_100PercentMarginRequirement
+	_, err = pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// No funding rate has been computed before the first `funding-tick` epoch.
+	fundingRatePpm, fundingIndex, err := pc.PerpetualsKeeper.GetCurrentFundingRate(pc.Ctx, perpetual.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), fundingRatePpm)
+	require.Equal(t, dtypes.NewInt(0), fundingIndex)
+}
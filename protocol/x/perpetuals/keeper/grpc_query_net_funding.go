@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) NetFundingPerPerpetual(
+	c context.Context,
+	req *types.QueryNetFundingPerPerpetualRequest,
+) (*types.QueryNetFundingPerPerpetualResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	return &types.QueryNetFundingPerPerpetualResponse{
+		NetFunding: k.GetNetFundingPerPerpetual(ctx, req.Epoch),
+	}, nil
+}
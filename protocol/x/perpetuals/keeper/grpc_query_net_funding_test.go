@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNetFundingPerPerpetual(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	err := pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpoch:           3,
+			CurrentEpochStartBlock: 1,
+		},
+	)
+	require.NoError(t, err)
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 1, big.NewInt(-25))
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 0, big.NewInt(40))
+	pc.PerpetualsKeeper.PersistBlockNetFunding(pc.Ctx)
+
+	tests := map[string]struct {
+		req         *types.QueryNetFundingPerPerpetualRequest
+		res         *types.QueryNetFundingPerPerpetualResponse
+		expectedErr error
+	}{
+		"nil request": {
+			req:         nil,
+			res:         nil,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"epoch with settled funding": {
+			req: &types.QueryNetFundingPerPerpetualRequest{Epoch: 3},
+			res: &types.QueryNetFundingPerPerpetualResponse{
+				NetFunding: []types.PerpetualNetFunding{
+					{PerpetualId: 0, NetFundingPaid: dtypes.NewInt(40)},
+					{PerpetualId: 1, NetFundingPaid: dtypes.NewInt(-25)},
+				},
+			},
+		},
+		"epoch without settled funding": {
+			req: &types.QueryNetFundingPerPerpetualRequest{Epoch: 2},
+			res: &types.QueryNetFundingPerPerpetualResponse{
+				NetFunding: []types.PerpetualNetFunding{},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := pc.PerpetualsKeeper.NetFundingPerPerpetual(pc.Ctx, tc.req)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
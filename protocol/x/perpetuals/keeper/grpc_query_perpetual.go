@@ -71,3 +71,119 @@ func (k Keeper) Perpetual(c context.Context, req *types.QueryPerpetualRequest) (
 
 	return &types.QueryPerpetualResponse{Perpetual: val}, nil
 }
+
+func (k Keeper) AllOpenInterest(
+	c context.Context,
+	req *types.QueryAllOpenInterestRequest,
+) (*types.QueryAllOpenInterestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	perpetuals := k.GetAllPerpetuals(ctx)
+	openInterest := make(map[uint32]uint64, len(perpetuals))
+	for _, perpetual := range perpetuals {
+		openInterest[perpetual.Params.Id] = perpetual.OpenInterest.BigInt().Uint64()
+	}
+
+	return &types.QueryAllOpenInterestResponse{OpenInterest: openInterest}, nil
+}
+
+func (k Keeper) PerpetualMarginFractions(
+	c context.Context,
+	req *types.QueryPerpetualMarginFractionsRequest,
+) (*types.QueryPerpetualMarginFractionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	perpetual, err := k.GetPerpetual(ctx, req.PerpetualId)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpetualDoesNotExist) {
+			return nil,
+				status.Error(
+					codes.NotFound,
+					fmt.Sprintf(
+						"Perpetual id %+v not found.",
+						req.PerpetualId,
+					),
+				)
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	liquidityTier, err := k.GetLiquidityTier(ctx, perpetual.Params.LiquidityTier)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// Report the margin fractions that apply to the perpetual, which use its initial margin override
+	// if it is larger than the initial margin of its liquidity tier.
+	liquidityTier = perpetual.ApplyInitialMarginPpmOverride(liquidityTier)
+
+	return &types.QueryPerpetualMarginFractionsResponse{
+		LiquidityTier:          liquidityTier.Id,
+		InitialMarginPpm:       liquidityTier.InitialMarginPpm,
+		MaintenanceFractionPpm: liquidityTier.MaintenanceFractionPpm,
+		MaintenanceMarginPpm:   liquidityTier.GetMaintenanceMarginPpm(),
+	}, nil
+}
+
+func (k Keeper) PerpetualMetadata(
+	c context.Context,
+	req *types.QueryPerpetualMetadataRequest,
+) (*types.QueryPerpetualMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	metadata, found := k.GetPerpetualMetadata(ctx, req.PerpetualId)
+	if !found {
+		return nil,
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf(
+					"Metadata of perpetual id %+v not found.",
+					req.PerpetualId,
+				),
+			)
+	}
+
+	return &types.QueryPerpetualMetadataResponse{
+		Metadata: metadata,
+	}, nil
+}
+
+func (k Keeper) CurrentFundingRate(
+	c context.Context,
+	req *types.QueryCurrentFundingRateRequest,
+) (*types.QueryCurrentFundingRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	fundingRatePpm, fundingIndex, err := k.GetCurrentFundingRate(ctx, req.PerpetualId)
+	if err != nil {
+		if errors.Is(err, types.ErrPerpetualDoesNotExist) {
+			return nil,
+				status.Error(
+					codes.NotFound,
+					fmt.Sprintf(
+						"Perpetual id %+v not found.",
+						req.PerpetualId,
+					),
+				)
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryCurrentFundingRateResponse{
+		FundingRatePpm: fundingRatePpm,
+		FundingIndex:   fundingIndex,
+	}, nil
+}
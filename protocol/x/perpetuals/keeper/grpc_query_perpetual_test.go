@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -9,8 +10,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
@@ -121,3 +125,282 @@ func TestPerpetualQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestAllOpenInterestQuery(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 3)
+
+	// Seed each perpetual with a distinct open interest.
+	expected := make(map[uint32]uint64, len(perps))
+	for _, perp := range perps {
+		openInterest := 1_000_000 * uint64(perp.Params.Id)
+		err := pc.PerpetualsKeeper.ModifyOpenInterest(
+			pc.Ctx,
+			perp.Params.Id,
+			new(big.Int).SetUint64(openInterest),
+		)
+		require.NoError(t, err)
+		expected[perp.Params.Id] = openInterest
+	}
+
+	res, err := pc.PerpetualsKeeper.AllOpenInterest(pc.Ctx, &types.QueryAllOpenInterestRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.OpenInterest)
+
+	_, err = pc.PerpetualsKeeper.AllOpenInterest(pc.Ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestPerpetualMarginFractions(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	// Create perpetuals in liquidity tier 4 with initial margin overrides above and below its initial margin.
+	overrides := map[uint32]uint32{1: 600_000, 2: 300_000}
+	for id, override := range overrides {
+		_, err = pc.PerpetualsKeeper.CreatePerpetual(
+			pc.Ctx,
+			id,
+			fmt.Sprintf("TEST-USD-%d", id),
+			perpetual.Params.MarketId,
+			perpetual.Params.AtomicResolution,
+			perpetual.Params.DefaultFundingPpm,
+			perpetual.Params.LiquidityTier,
+			perpetual.Params.MarketType,
+		)
+		require.NoError(t, err)
+		_, err = pc.PerpetualsKeeper.ModifyPerpetual(
+			pc.Ctx,
+			id,
+			fmt.Sprintf("TEST-USD-%d", id),
+			perpetual.Params.MarketId,
+			perpetual.Params.DefaultFundingPpm,
+			perpetual.Params.LiquidityTier,
+			override,
+		)
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPerpetualMarginFractionsRequest
+		response *types.QueryPerpetualMarginFractionsResponse
+		err      error
+	}{
+		{
+			desc: "Success",
+			request: &types.QueryPerpetualMarginFractionsRequest{
+				PerpetualId: perpetual.Params.Id,
+			},
+			// Liquidity tier 4 has an initial margin of 50% and a maintenance fraction of 80%, so the
+			// effective maintenance margin is 50% * 80% = 40%.
+			response: &types.QueryPerpetualMarginFractionsResponse{
+				LiquidityTier:          4,
+				InitialMarginPpm:       500_000,
+				MaintenanceFractionPpm: 800_000,
+				MaintenanceMarginPpm:   400_000,
+			},
+		},
+		{
+			desc: "Success with initial margin override",
+			request: &types.QueryPerpetualMarginFractionsRequest{
+				PerpetualId: 1,
+			},
+			// The override of 60% replaces the initial margin of the liquidity tier, so the effective
+			// maintenance margin is 60% * 80% = 48%.
+			response: &types.QueryPerpetualMarginFractionsResponse{
+				LiquidityTier:          4,
+				InitialMarginPpm:       600_000,
+				MaintenanceFractionPpm: 800_000,
+				MaintenanceMarginPpm:   480_000,
+			},
+		},
+		{
+			desc: "Initial margin override below the liquidity tier initial margin is ignored",
+			request: &types.QueryPerpetualMarginFractionsRequest{
+				PerpetualId: 2,
+			},
+			response: &types.QueryPerpetualMarginFractionsResponse{
+				LiquidityTier:          4,
+				InitialMarginPpm:       500_000,
+				MaintenanceFractionPpm: 800_000,
+				MaintenanceMarginPpm:   400_000,
+			},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryPerpetualMarginFractionsRequest{
+				PerpetualId: uint32(100000),
+			},
+			err: status.Error(codes.NotFound, fmt.Sprintf(
+				"Perpetual id %+v not found.",
+				uint32(100000),
+			)),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.PerpetualMarginFractions(pc.Ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestPerpetualMetadata(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	err := pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpoch:           3,
+			CurrentEpochStartBlock: 1,
+		},
+	)
+	require.NoError(t, err)
+
+	ctx := pc.Ctx.WithBlockHeight(7)
+	perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err = pc.PerpetualsKeeper.CreatePerpetual(
+		ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPerpetualMetadataRequest
+		response *types.QueryPerpetualMetadataResponse
+		err      error
+	}{
+		{
+			desc: "Success",
+			request: &types.QueryPerpetualMetadataRequest{
+				PerpetualId: perpetual.Params.Id,
+			},
+			response: &types.QueryPerpetualMetadataResponse{
+				Metadata: types.PerpetualMetadata{
+					PerpetualId:         perpetual.Params.Id,
+					CreationBlockHeight: 7,
+					CreationEpoch:       3,
+				},
+			},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryPerpetualMetadataRequest{
+				PerpetualId: uint32(100000),
+			},
+			err: status.Error(codes.NotFound, fmt.Sprintf(
+				"Metadata of perpetual id %+v not found.",
+				uint32(100000),
+			)),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.PerpetualMetadata(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestCurrentFundingRate(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	perpetual := constants.BtcUsd_50PercentInitial_40PercentMaintenance
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		pc.Ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+	err = pc.PerpetualsKeeper.ModifyFundingIndex(pc.Ctx, perpetual.Params.Id, big.NewInt(-1_234))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryCurrentFundingRateRequest
+		response *types.QueryCurrentFundingRateResponse
+		err      error
+	}{
+		{
+			desc: "Success",
+			request: &types.QueryCurrentFundingRateRequest{
+				PerpetualId: perpetual.Params.Id,
+			},
+			response: &types.QueryCurrentFundingRateResponse{
+				FundingRatePpm: 0,
+				FundingIndex:   dtypes.NewInt(-1_234),
+			},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryCurrentFundingRateRequest{
+				PerpetualId: uint32(100000),
+			},
+			err: status.Error(codes.NotFound, fmt.Sprintf(
+				"Perpetual id %+v not found.",
+				uint32(100000),
+			)),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := pc.PerpetualsKeeper.CurrentFundingRate(pc.Ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
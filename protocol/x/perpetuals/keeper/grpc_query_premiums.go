@@ -36,3 +36,36 @@ func (k Keeper) PremiumSamples(
 
 	return &types.QueryPremiumSamplesResponse{PremiumSamples: premiumSamples}, nil
 }
+
+func (k Keeper) PremiumAccumulationStatus(
+	c context.Context,
+	req *types.QueryPremiumAccumulationStatusRequest,
+) (*types.QueryPremiumAccumulationStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	premiumSamples := k.GetPremiumSamples(ctx)
+	premiumVotes := k.GetPremiumVotes(ctx)
+
+	marketStatuses := make(map[uint32]types.MarketPremiumAccumulationStatus)
+	for _, marketPremiums := range premiumSamples.AllMarketPremiums {
+		marketStatus := marketStatuses[marketPremiums.PerpetualId]
+		marketStatus.PerpetualId = marketPremiums.PerpetualId
+		marketStatus.NumPremiumSamples = uint32(len(marketPremiums.Premiums))
+		marketStatuses[marketPremiums.PerpetualId] = marketStatus
+	}
+	for _, marketPremiums := range premiumVotes.AllMarketPremiums {
+		marketStatus := marketStatuses[marketPremiums.PerpetualId]
+		marketStatus.PerpetualId = marketPremiums.PerpetualId
+		marketStatus.NumPremiumVotes = uint32(len(marketPremiums.Premiums))
+		marketStatuses[marketPremiums.PerpetualId] = marketStatus
+	}
+
+	return &types.QueryPremiumAccumulationStatusResponse{
+		NumPremiumSamples: premiumSamples.NumPremiums,
+		NumPremiumVotes:   premiumVotes.NumPremiums,
+		MarketStatuses:    lib.MapToSortedSlice[lib.Sortable[uint32]](marketStatuses),
+	}, nil
+}
@@ -72,3 +72,79 @@ func TestPremiumSamples(t *testing.T) {
 		})
 	}
 }
+
+func TestPremiumAccumulationStatus(t *testing.T) {
+	tests := map[string]struct {
+		premiumSamples [][]types.FundingPremium
+		premiumVotes   [][]types.FundingPremium
+		req            *types.QueryPremiumAccumulationStatusRequest
+		res            *types.QueryPremiumAccumulationStatusResponse
+		expectedErr    error
+	}{
+		"nil request": {
+			req:         nil,
+			res:         nil,
+			expectedErr: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		"no premiums": {
+			req: &types.QueryPremiumAccumulationStatusRequest{},
+			res: &types.QueryPremiumAccumulationStatusResponse{
+				MarketStatuses: []types.MarketPremiumAccumulationStatus{},
+			},
+		},
+		"samples and votes": {
+			premiumSamples: [][]types.FundingPremium{
+				{
+					{PerpetualId: 0, PremiumPpm: 1_000},
+					{PerpetualId: 1, PremiumPpm: -1_000},
+				},
+				{
+					{PerpetualId: 1, PremiumPpm: 2_000},
+				},
+			},
+			premiumVotes: [][]types.FundingPremium{
+				{
+					{PerpetualId: 2, PremiumPpm: 500},
+				},
+				{
+					{PerpetualId: 0, PremiumPpm: -500},
+					{PerpetualId: 2, PremiumPpm: 100},
+				},
+				{
+					{PerpetualId: 2, PremiumPpm: 100},
+				},
+			},
+			req: &types.QueryPremiumAccumulationStatusRequest{},
+			res: &types.QueryPremiumAccumulationStatusResponse{
+				NumPremiumSamples: 2,
+				NumPremiumVotes:   3,
+				MarketStatuses: []types.MarketPremiumAccumulationStatus{
+					{PerpetualId: 0, NumPremiumSamples: 1, NumPremiumVotes: 1},
+					{PerpetualId: 1, NumPremiumSamples: 2, NumPremiumVotes: 0},
+					{PerpetualId: 2, NumPremiumSamples: 0, NumPremiumVotes: 3},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 3)
+
+			for _, samples := range tc.premiumSamples {
+				require.NoError(t, pc.PerpetualsKeeper.AddPremiumSamples(pc.Ctx, samples))
+			}
+			for _, votes := range tc.premiumVotes {
+				require.NoError(t, pc.PerpetualsKeeper.AddPremiumVotes(pc.Ctx, votes))
+			}
+
+			res, err := pc.PerpetualsKeeper.PremiumAccumulationStatus(pc.Ctx, tc.req)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamFundingHistory streams the retained funding index snapshots of a perpetual, oldest to newest,
+// as of the latest committed state. Each snapshot is sent individually so that gRPC flow control blocks
+// sending while the client is not receiving, and streaming stops once the client cancels the stream.
+func (k Keeper) StreamFundingHistory(
+	req *types.StreamFundingHistoryRequest,
+	stream types.Query_StreamFundingHistoryServer,
+) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.createQueryContext == nil {
+		return status.Error(codes.Unavailable, "query context creator is not set")
+	}
+
+	ctx, err := k.createQueryContext(0, false)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if !k.HasPerpetual(ctx, req.PerpetualId) {
+		return status.Error(
+			codes.NotFound,
+			fmt.Sprintf(
+				"Perpetual id %+v not found.",
+				req.PerpetualId,
+			),
+		)
+	}
+
+	for _, snapshot := range k.GetFundingIndexSnapshots(ctx, req.PerpetualId) {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		if err := stream.Send(&types.StreamFundingHistoryResponse{Snapshot: snapshot}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
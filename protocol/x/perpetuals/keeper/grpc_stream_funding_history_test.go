@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestStreamFundingHistory(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetualId := perps[0].Params.Id
+	pc.PerpetualsKeeper.SetQueryContextCreator(func(int64, bool) (sdk.Context, error) {
+		return pc.Ctx, nil
+	})
+
+	// Record two more epochs than are retained, so the oldest two snapshots are overwritten.
+	numEpochs := types.FundingIndexSnapshotRetentionEpochs + 2
+	for epoch := uint32(1); epoch <= numEpochs; epoch++ {
+		pc.PerpetualsKeeper.SetFundingIndexSnapshotForTest(pc.Ctx, perpetualId, epoch, dtypes.NewInt(int64(epoch)))
+	}
+
+	// Serve the query service from an in-process gRPC server.
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	types.RegisterQueryServer(server, pc.PerpetualsKeeper)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := types.NewQueryClient(conn)
+
+	t.Run("Streams the full retained history in order", func(t *testing.T) {
+		stream, err := client.StreamFundingHistory(
+			context.Background(),
+			&types.StreamFundingHistoryRequest{PerpetualId: perpetualId},
+		)
+		require.NoError(t, err)
+
+		expectedEpoch := uint32(3)
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.PerpetualFundingIndexSnapshot{
+				PerpetualId:  perpetualId,
+				Epoch:        expectedEpoch,
+				FundingIndex: dtypes.NewInt(int64(expectedEpoch)),
+			}, resp.Snapshot)
+			expectedEpoch++
+		}
+		require.Equal(t, numEpochs+1, expectedEpoch)
+	})
+
+	t.Run("Errors for a non-existent perpetual", func(t *testing.T) {
+		stream, err := client.StreamFundingHistory(
+			context.Background(),
+			&types.StreamFundingHistoryRequest{PerpetualId: constants.BtcUsd_0DefaultFunding_10AtomicResolution.Params.Id + 100},
+		)
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dydxprotocol/v4-chain/protocol/indexer/indexer_manager"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 )
 
@@ -22,6 +23,9 @@ type (
 		indexerEventManager indexer_manager.IndexerEventManager
 		authorities         map[string]struct{}
 		transientStoreKey   storetypes.StoreKey
+		createQueryContext  func(height int64, prove bool) (sdk.Context, error)
+
+		Flags flags.PerpetualsFlags
 	}
 )
 
@@ -33,6 +37,7 @@ func NewKeeper(
 	indexerEventsManager indexer_manager.IndexerEventManager,
 	authorities []string,
 	transientStoreKey storetypes.StoreKey,
+	perpetualsFlags flags.PerpetualsFlags,
 ) *Keeper {
 	return &Keeper{
 		cdc:                 cdc,
@@ -42,6 +47,7 @@ func NewKeeper(
 		indexerEventManager: indexerEventsManager,
 		authorities:         lib.UniqueSliceToSet(authorities),
 		transientStoreKey:   transientStoreKey,
+		Flags:               perpetualsFlags,
 	}
 }
 
@@ -58,6 +64,13 @@ func (k *Keeper) SetClobKeeper(getter types.PerpetualsClobKeeper) {
 	k.clobKeeper = getter
 }
 
+// SetQueryContextCreator sets the function used to create an `sdk.Context` for streaming queries,
+// which unlike unary queries are not provided one by the gRPC server.
+// This method is called after the Perpetuals Keeper struct is initialized.
+func (k *Keeper) SetQueryContextCreator(createQueryContext func(height int64, prove bool) (sdk.Context, error)) {
+	k.createQueryContext = createQueryContext
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With(log.ModuleKey, fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -32,6 +32,7 @@ func (k msgServer) SetLiquidityTier(
 		msg.LiquidityTier.ImpactNotional,
 		msg.LiquidityTier.OpenInterestLowerCap,
 		msg.LiquidityTier.OpenInterestUpperCap,
+		msg.LiquidityTier.PremiumCombinationMethod,
 	); err != nil {
 		return nil, err
 	}
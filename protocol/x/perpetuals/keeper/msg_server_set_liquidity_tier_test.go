@@ -143,6 +143,7 @@ func TestSetLiquidityTier(t *testing.T) {
 				testLt.ImpactNotional,
 				testLt.OpenInterestLowerCap,
 				testLt.OpenInterestUpperCap,
+				testLt.PremiumCombinationMethod,
 			)
 			require.NoError(t, err)
 
@@ -16,6 +16,7 @@ func TestUpdateParams(t *testing.T) {
 		FundingRateClampFactorPpm: 6_000_000,
 		PremiumVoteClampFactorPpm: 60_000_000,
 		MinNumVotesPerSample:      15,
+		FundingRatePeriodSeconds:  28_800,
 	}
 
 	tests := map[string]struct {
@@ -29,6 +30,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: 1_234,
 					PremiumVoteClampFactorPpm: initialParams.PremiumVoteClampFactorPpm,
 					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 		},
@@ -39,6 +41,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 		},
@@ -49,6 +52,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 0, // invalid
 					MinNumVotesPerSample:      initialParams.MinNumVotesPerSample,
+					FundingRatePeriodSeconds:  initialParams.FundingRatePeriodSeconds,
 				},
 			},
 			expectedErr: "Premium vote clamp factor ppm is zero",
@@ -60,6 +64,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "invalid authority",
@@ -71,6 +76,7 @@ func TestUpdateParams(t *testing.T) {
 					FundingRateClampFactorPpm: initialParams.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: 1_234,
 					MinNumVotesPerSample:      7,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "invalid authority",
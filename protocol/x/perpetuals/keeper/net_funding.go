@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ante_types "github.com/dydxprotocol/v4-chain/protocol/app/ante/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// AddNetFundingPaid adds a funding payment settled by a position in the perpetual to the net funding
+// of the perpetual for the current block. `fundingPaid` is positive if the position paid funding and
+// negative if the position received funding. The net funding of the block is accumulated in the
+// transient store without consuming gas, and is added to the net funding of the current `funding-tick`
+// epoch once per block in `PersistBlockNetFunding`.
+//
+// Note that funding is settled lazily whenever a subaccount is updated, so the net funding of an epoch
+// is the funding settled during that epoch rather than the funding accrued during it.
+func (k Keeper) AddNetFundingPaid(
+	ctx sdk.Context,
+	perpetualId uint32,
+	fundingPaid *big.Int,
+) {
+	if fundingPaid.Sign() == 0 {
+		return
+	}
+
+	noGasCtx := ctx.WithGasMeter(ante_types.NewFreeInfiniteGasMeter())
+	store := k.getBlockNetFundingStore(noGasCtx)
+	k.addNetFundingPaid(store, perpetualId, fundingPaid)
+}
+
+// PersistBlockNetFunding adds the net funding settled by each perpetual during the current block
+// to the net funding of the perpetual for the current `funding-tick` epoch. This is called once
+// per block in the EndBlocker.
+func (k Keeper) PersistBlockNetFunding(ctx sdk.Context) {
+	// The `funding-tick` epoch only doesn't exist before it is created in genesis.
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.FundingTickEpochInfoName)
+	if !found {
+		return
+	}
+
+	blockStore := k.getBlockNetFundingStore(ctx)
+	iterator := blockStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	store := k.getNetFundingStore(ctx, epochInfo.CurrentEpoch)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var netFunding types.PerpetualNetFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &netFunding)
+		k.addNetFundingPaid(store, netFunding.PerpetualId, netFunding.NetFundingPaid.BigInt())
+		keys = append(keys, iterator.Key())
+	}
+
+	// Clear the net funding of the block so that it is only persisted once.
+	for _, key := range keys {
+		blockStore.Delete(key)
+	}
+}
+
+// PruneNetFunding deletes the net funding of all `funding-tick` epochs that are not among the
+// `NetFundingRetentionEpochs` most recent epochs ending with `currentEpoch`.
+func (k Keeper) PruneNetFunding(ctx sdk.Context, currentEpoch uint32) {
+	if currentEpoch < types.NetFundingRetentionEpochs {
+		return
+	}
+
+	// Net funding is keyed by epoch first, so all pruned epochs precede the oldest retained epoch.
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.NetFundingKeyPrefix))
+	oldestRetainedEpoch := currentEpoch - types.NetFundingRetentionEpochs + 1
+	iterator := store.Iterator(nil, lib.Uint32ToKey(oldestRetainedEpoch))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetNetFundingPerPerpetual returns the net funding settled by each perpetual during the
+// provided `funding-tick` epoch, sorted by perpetual id. Perpetuals without any settled
+// funding during the epoch are omitted.
+func (k Keeper) GetNetFundingPerPerpetual(
+	ctx sdk.Context,
+	epoch uint32,
+) (list []types.PerpetualNetFunding) {
+	store := k.getNetFundingStore(ctx, epoch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	list = []types.PerpetualNetFunding{}
+	for ; iterator.Valid(); iterator.Next() {
+		var netFunding types.PerpetualNetFunding
+		k.cdc.MustUnmarshal(iterator.Value(), &netFunding)
+		list = append(list, netFunding)
+	}
+
+	return list
+}
+
+// getNetFundingStore returns a prefix store of the net funding of all perpetuals for an epoch.
+func (k Keeper) getNetFundingStore(ctx sdk.Context, epoch uint32) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.NetFundingKeyPrefix))
+	return prefix.NewStore(store, lib.Uint32ToKey(epoch))
+}
+
+// getBlockNetFundingStore returns a prefix store of the net funding of all perpetuals settled
+// during the current block.
+func (k Keeper) getBlockNetFundingStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.TransientStore(k.transientStoreKey), []byte(types.BlockNetFundingKeyPrefix))
+}
+
+// addNetFundingPaid adds `fundingPaid` to the net funding of the perpetual in the provided store.
+func (k Keeper) addNetFundingPaid(
+	store prefix.Store,
+	perpetualId uint32,
+	fundingPaid *big.Int,
+) {
+	key := lib.Uint32ToKey(perpetualId)
+
+	netFunding := types.PerpetualNetFunding{
+		PerpetualId:    perpetualId,
+		NetFundingPaid: dtypes.NewInt(0),
+	}
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &netFunding)
+	}
+
+	netFunding.NetFundingPaid = dtypes.NewIntFromBigInt(
+		new(big.Int).Add(netFunding.NetFundingPaid.BigInt(), fundingPaid),
+	)
+	store.Set(key, k.cdc.MustMarshal(&netFunding))
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNetFundingPaid(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	err := pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			NextTick:               3600,
+			CurrentEpoch:           1,
+			CurrentEpochStartBlock: 1,
+			IsInitialized:          true,
+		},
+	)
+	require.NoError(t, err)
+
+	// Settle a couple of positions in each perpetual during epoch 1.
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 0, big.NewInt(100))
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 0, big.NewInt(-30))
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 1, big.NewInt(-50))
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 1, big.NewInt(0))
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 2, big.NewInt(0))
+
+	// Net funding is only added to the epoch at the end of the block.
+	require.Equal(
+		t,
+		[]types.PerpetualNetFunding{},
+		pc.PerpetualsKeeper.GetNetFundingPerPerpetual(pc.Ctx, 1),
+	)
+	pc.PerpetualsKeeper.PersistBlockNetFunding(pc.Ctx)
+
+	// Start epoch 2 and settle another position.
+	ctx := pc.Ctx.WithBlockHeight(2).WithBlockTime(time.Unix(3600, 0))
+	started, err := pc.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+	require.NoError(t, err)
+	require.True(t, started)
+	pc.PerpetualsKeeper.AddNetFundingPaid(ctx, 0, big.NewInt(7))
+	pc.PerpetualsKeeper.PersistBlockNetFunding(ctx)
+
+	require.Equal(
+		t,
+		[]types.PerpetualNetFunding{},
+		pc.PerpetualsKeeper.GetNetFundingPerPerpetual(ctx, 0),
+	)
+	require.Equal(
+		t,
+		[]types.PerpetualNetFunding{
+			{PerpetualId: 0, NetFundingPaid: dtypes.NewInt(70)},
+			{PerpetualId: 1, NetFundingPaid: dtypes.NewInt(-50)},
+		},
+		pc.PerpetualsKeeper.GetNetFundingPerPerpetual(ctx, 1),
+	)
+	require.Equal(
+		t,
+		[]types.PerpetualNetFunding{
+			{PerpetualId: 0, NetFundingPaid: dtypes.NewInt(7)},
+		},
+		pc.PerpetualsKeeper.GetNetFundingPerPerpetual(ctx, 2),
+	)
+}
+
+func TestAddNetFundingPaid_NoFundingTickEpoch(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+
+	pc.PerpetualsKeeper.AddNetFundingPaid(pc.Ctx, 0, big.NewInt(100))
+	pc.PerpetualsKeeper.PersistBlockNetFunding(pc.Ctx)
+
+	require.Equal(
+		t,
+		[]types.PerpetualNetFunding{},
+		pc.PerpetualsKeeper.GetNetFundingPerPerpetual(pc.Ctx, 0),
+	)
+}
+
+func TestAddNetFundingPaid_ConsumesNoGas(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+
+	pc.PerpetualsKeeper.AddNetFundingPaid(ctx, 0, big.NewInt(100))
+	pc.PerpetualsKeeper.AddNetFundingPaid(ctx, 0, big.NewInt(-30))
+
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+}
+
+func TestPruneNetFunding(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	err := pc.EpochsKeeper.CreateEpochInfo(
+		pc.Ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			NextTick:               3600,
+			CurrentEpoch:           1,
+			CurrentEpochStartBlock: 1,
+			IsInitialized:          true,
+		},
+	)
+	require.NoError(t, err)
+
+	// Settle funding in perpetuals 0 and 1 during every epoch up to the end of the retention window.
+	lastEpoch := types.NetFundingRetentionEpochs + 2
+	for epoch := uint32(1); epoch <= lastEpoch; epoch++ {
+		ctx := pc.Ctx.WithBlockHeight(int64(epoch)).WithBlockTime(time.Unix(int64(epoch-1)*3600, 0))
+		if epoch > 1 {
+			started, err := pc.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.FundingTickEpochInfoName)
+			require.NoError(t, err)
+			require.True(t, started)
+		}
+		pc.PerpetualsKeeper.AddNetFundingPaid(ctx, 0, big.NewInt(int64(epoch)))
+		pc.PerpetualsKeeper.AddNetFundingPaid(ctx, 1, big.NewInt(-int64(epoch)))
+		pc.PerpetualsKeeper.PersistBlockNetFunding(ctx)
+	}
+
+	// Nothing is pruned before the retention window is full.
+	pc.PerpetualsKeeper.PruneNetFunding(pc.Ctx, types.NetFundingRetentionEpochs-1)
+	require.Len(t, pc.PerpetualsKeeper.GetNetFundingPerPerpetual(pc.Ctx, 1), 2)
+
+	// Only the most recent `NetFundingRetentionEpochs` epochs are retained.
+	pc.PerpetualsKeeper.PruneNetFunding(pc.Ctx, lastEpoch)
+	for epoch := uint32(1); epoch <= lastEpoch; epoch++ {
+		if epoch <= lastEpoch-types.NetFundingRetentionEpochs {
+			require.Empty(t, pc.PerpetualsKeeper.GetNetFundingPerPerpetual(pc.Ctx, epoch))
+		} else {
+			require.Equal(
+				t,
+				[]types.PerpetualNetFunding{
+					{PerpetualId: 0, NetFundingPaid: dtypes.NewInt(int64(epoch))},
+					{PerpetualId: 1, NetFundingPaid: dtypes.NewInt(-int64(epoch))},
+				},
+				pc.PerpetualsKeeper.GetNetFundingPerPerpetual(pc.Ctx, epoch),
+			)
+		}
+	}
+}
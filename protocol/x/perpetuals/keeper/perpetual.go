@@ -112,6 +112,8 @@ func (k Keeper) CreatePerpetual(
 	if err := k.ValidateAndSetPerpetual(ctx, perpetual); err != nil {
 		return types.Perpetual{}, err
 	}
+	k.setPerpetualMetadata(ctx, id)
+	k.setPerpetualMarketIndex(ctx, perpetual)
 
 	k.SetEmptyPremiumSamples(ctx)
 	k.SetEmptyPremiumVotes(ctx)
@@ -119,6 +121,50 @@ func (k Keeper) CreatePerpetual(
 	return perpetual, nil
 }
 
+// CreatePerpetuals atomically creates new perpetuals in the store, one for each of the provided
+// `PerpetualParams`. All perpetuals are validated before any of them is stored, so either all perpetuals
+// are created, or an error is returned and none are. Returns an error if any perpetual fails validation,
+// already exists, or shares its id with another perpetual in `perpetualParams`.
+func (k Keeper) CreatePerpetuals(
+	ctx sdk.Context,
+	perpetualParams []types.PerpetualParams,
+) ([]types.Perpetual, error) {
+	perpetuals := make([]types.Perpetual, 0, len(perpetualParams))
+	ids := make(map[uint32]struct{}, len(perpetualParams))
+	for _, params := range perpetualParams {
+		_, duplicate := ids[params.Id]
+		if duplicate || k.HasPerpetual(ctx, params.Id) {
+			return nil, errorsmod.Wrap(
+				types.ErrPerpetualAlreadyExists,
+				lib.UintToString(params.Id),
+			)
+		}
+		ids[params.Id] = struct{}{}
+
+		perpetual := types.Perpetual{
+			Params:       params,
+			FundingIndex: dtypes.ZeroInt(),
+			OpenInterest: dtypes.ZeroInt(),
+		}
+		if err := k.validatePerpetual(ctx, &perpetual); err != nil {
+			return nil, errorsmod.Wrapf(err, "perpetual id %d", params.Id)
+		}
+		perpetuals = append(perpetuals, perpetual)
+	}
+
+	// Store the new perpetuals only once all of them are valid.
+	for _, perpetual := range perpetuals {
+		k.setPerpetual(ctx, perpetual)
+		k.setPerpetualMetadata(ctx, perpetual.Params.Id)
+		k.setPerpetualMarketIndex(ctx, perpetual)
+	}
+
+	k.SetEmptyPremiumSamples(ctx)
+	k.SetEmptyPremiumVotes(ctx)
+
+	return perpetuals, nil
+}
+
 // HasPerpetual checks if a perpetual exists in the store.
 func (k Keeper) HasPerpetual(
 	ctx sdk.Context,
@@ -143,6 +189,7 @@ func (k Keeper) ModifyPerpetual(
 	marketId uint32,
 	defaultFundingPpm int32,
 	liquidityTier uint32,
+	initialMarginPpmOverride uint32,
 ) (types.Perpetual, error) {
 	// Get perpetual.
 	perpetual, err := k.GetPerpetual(ctx, id)
@@ -151,16 +198,24 @@ func (k Keeper) ModifyPerpetual(
 	}
 
 	// Modify perpetual.
+	oldMarketId := perpetual.Params.MarketId
 	perpetual.Params.Ticker = ticker
 	perpetual.Params.MarketId = marketId
 	perpetual.Params.DefaultFundingPpm = defaultFundingPpm
 	perpetual.Params.LiquidityTier = liquidityTier
+	perpetual.Params.InitialMarginPpmOverride = initialMarginPpmOverride
 
 	// Store the modified perpetual.
 	if err := k.ValidateAndSetPerpetual(ctx, perpetual); err != nil {
 		return types.Perpetual{}, err
 	}
 
+	// Move the perpetual to the index of the market it now references.
+	if oldMarketId != marketId {
+		k.getPerpetualByMarketStore(ctx, oldMarketId).Delete(lib.Uint32ToKey(id))
+		k.setPerpetualMarketIndex(ctx, perpetual)
+	}
+
 	// Emit indexer event.
 	k.GetIndexerEventManager().AddTxnEvent(
 		ctx,
@@ -252,24 +307,49 @@ func (k Keeper) GetAllPerpetuals(ctx sdk.Context) (list []types.Perpetual) {
 	return list
 }
 
+// GetPerpetualsForMarket returns all perpetuals that reference the provided market, sorted by id.
+func (k Keeper) GetPerpetualsForMarket(
+	ctx sdk.Context,
+	marketId uint32,
+) (list []types.Perpetual) {
+	iterator := storetypes.KVStorePrefixIterator(k.getPerpetualByMarketStore(ctx, marketId), []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		perpetualId := binary.BigEndian.Uint32(iterator.Key())
+		perpetual, err := k.GetPerpetual(ctx, perpetualId)
+		if err != nil {
+			panic(fmt.Sprintf("GetPerpetualsForMarket: perpetual %d in index does not exist", perpetualId))
+		}
+		list = append(list, perpetual)
+	}
+
+	return list
+}
+
 // processStoredPremiums combines all stored premiums into a single premium value
 // for each `MarketPremiums` in the premium storage.
 // Returns a mapping from perpetual Id to summarized premium value.
 // Arguments:
 // - `premiumKey`: indicates whether the function is processing `PremiumSamples`
 // or `PremiumVotes`.
-// - `combineFunc`: a function that converts a list of premium values into one
-// premium value (e.g. average or median)
+// - `getCombineFunc`: a function that returns, for a perpetual Id, the function
+// that converts a list of premium values of the perpetual into one premium value
+// (e.g. average or median)
 // - `filterFunc`: a function that takes in a list of premium values and filter
 // out some values.
 // - `minNumPremiumsRequired`: minimum number of premium values required for each
 // market. Padding will be added if `NumPremiums < minNumPremiumsRequired`.
+//
+// Panics if a market has more non-zero premiums than `NumPremiums`, unless the
+// `TruncatePremiumsOnOverflow` flag is set, in which case the premiums are truncated
+// to `NumPremiums` entries.
 func (k Keeper) processStoredPremiums(
 	ctx sdk.Context,
 	newEpochInfo epochstypes.EpochInfo,
 	premiumKey string,
 	minNumPremiumsRequired uint32,
-	combineFunc func([]int32) int32,
+	getCombineFunc func(perpetualId uint32) func([]int32) int32,
 	filterFunc func([]int32) []int32,
 ) (
 	perpIdToPremium map[uint32]int32,
@@ -304,11 +384,16 @@ func (k Keeper) processStoredPremiums(
 	for _, marketPremiums := range premiumStore.AllMarketPremiums {
 		// Invariant: `len(marketPremiums.Premiums) <= NumPremiums`
 		if uint32(len(marketPremiums.Premiums)) > premiumStore.NumPremiums {
-			panic(fmt.Errorf(
-				"marketPremiums (%+v) has more non-zero premiums than total number of premiums (%d)",
-				marketPremiums,
-				premiumStore.NumPremiums,
-			))
+			k.logPremiumsOverflow(ctx, premiumKey, marketPremiums, premiumStore.NumPremiums)
+			if !k.Flags.TruncatePremiumsOnOverflow {
+				panic(fmt.Errorf(
+					"marketPremiums (%+v) has more non-zero premiums than total number of premiums (%d)",
+					marketPremiums,
+					premiumStore.NumPremiums,
+				))
+			}
+			// Recover by dropping the premiums beyond `NumPremiums`, which restores the invariant.
+			marketPremiums.Premiums = marketPremiums.Premiums[:premiumStore.NumPremiums]
 		}
 
 		// Use minimum number of premiums as final length of array, if it's greater than NumPremiums.
@@ -323,12 +408,54 @@ func (k Keeper) processStoredPremiums(
 		padding := make([]int32, lenPadding)
 		paddedPremiums := append(marketPremiums.Premiums, padding...)
 
+		combineFunc := getCombineFunc(marketPremiums.PerpetualId)
 		perpIdToPremium[marketPremiums.PerpetualId] = combineFunc(filterFunc(paddedPremiums))
 	}
 
 	return perpIdToPremium
 }
 
+// logPremiumsOverflow logs an error and emits a metric identifying a perpetual whose number of non-zero
+// premiums exceeds the total number of premiums in the premium store.
+func (k Keeper) logPremiumsOverflow(
+	ctx sdk.Context,
+	premiumKey string,
+	marketPremiums types.MarketPremiums,
+	numPremiums uint32,
+) {
+	log.ErrorLog(
+		ctx,
+		"Perpetual has more non-zero premiums than total number of premiums",
+		metrics.PremiumType, premiumKey,
+		metrics.PerpetualId, marketPremiums.PerpetualId,
+		metrics.NumNonZeroPremiums, len(marketPremiums.Premiums),
+		metrics.NumPremiums, numPremiums,
+		metrics.Truncated, k.Flags.TruncatePremiumsOnOverflow,
+	)
+	telemetry.IncrCounterWithLabels(
+		[]string{
+			types.ModuleName,
+			metrics.PremiumsOverflow,
+			metrics.Count,
+		},
+		1,
+		[]gometrics.Label{
+			metrics.GetLabelForStringValue(
+				metrics.PremiumType,
+				premiumKey,
+			),
+			metrics.GetLabelForIntValue(
+				metrics.PerpetualId,
+				int(marketPremiums.PerpetualId),
+			),
+			metrics.GetLabelForBoolValue(
+				metrics.Truncated,
+				k.Flags.TruncatePremiumsOnOverflow,
+			),
+		},
+	)
+}
+
 // processPremiumVotesIntoSamples summarizes premium votes from proposers into premium samples.
 // For each perpetual market:
 //  1. Get the median of `PremiumVotes` collected during the past `funding-sample` epoch.
@@ -349,8 +476,8 @@ func (k Keeper) processPremiumVotesIntoSamples(
 		// `MustGetMedian` panics when the padded list is empty, which breaks the invariant that
 		// Max(premiumStore.NumPremiums, minNumPremiumsRequired) > 0.
 		// See details in implementation of `processStoredPremiums`.
-		lib.MustGetMedian[int32],                     // combineFunc
-		func(input []int32) []int32 { return input }, // filterFunc
+		func(uint32) func([]int32) int32 { return lib.MustGetMedian[int32] }, // getCombineFunc
+		func(input []int32) []int32 { return input },                         // filterFunc
 	)
 
 	newSamples := []types.FundingPremium{}
@@ -575,11 +702,13 @@ func (k Keeper) sampleAllPerpetuals(ctx sdk.Context) (
 // GetRemoveSampleTailsFunc returns a function that sorts the input samples (in place) and returns
 // the sub-slice from the original slice, which removes `tailRemovalRatePpm` from top and bottom from the samples.
 // Note the returned sub-slice is not a copy but references a sub-sequence of the original slice.
+// If the number of samples to remove is not less than the number of samples, the returned function
+// returns the unmodified samples along with `ErrSampleTailsRemovalExceedsNumSamples`.
 func (k Keeper) GetRemoveSampleTailsFunc(
 	ctx sdk.Context,
 	tailRemovalRatePpm uint32,
-) func(input []int32) (output []int32) {
-	return func(premiums []int32) []int32 {
+) func(input []int32) (output []int32, err error) {
+	return func(premiums []int32) ([]int32, error) {
 		totalRemoval := lib.Int64MulPpm(
 			int64(len(premiums)),
 			tailRemovalRatePpm*2,
@@ -587,17 +716,14 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 
 		// Return early if no tail to remove.
 		if totalRemoval == 0 {
-			return premiums
+			return premiums, nil
 		} else if totalRemoval >= int64(len(premiums)) {
-			log.ErrorLog(
-				ctx,
-				fmt.Sprintf(
-					"GetRemoveSampleTailsFunc: totalRemoval(%d) > length of premium samples (%d); skip removing",
-					totalRemoval,
-					len(premiums),
-				),
+			return premiums, errorsmod.Wrapf(
+				types.ErrSampleTailsRemovalExceedsNumSamples,
+				"totalRemoval (%d), length of premium samples (%d)",
+				totalRemoval,
+				len(premiums),
 			)
-			return premiums
 		}
 
 		bottomRemoval := totalRemoval / 2
@@ -607,7 +733,7 @@ func (k Keeper) GetRemoveSampleTailsFunc(
 
 		sort.Slice(premiums, func(i, j int) bool { return premiums[i] < premiums[j] })
 
-		return premiums[bottomRemoval:end]
+		return premiums[bottomRemoval:end], nil
 	}
 }
 
@@ -650,17 +776,46 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 
 	sampleTailsRemovalFunc := k.GetRemoveSampleTailsFunc(ctx, tailRemovalRatePpm)
 
+	// If the tails cannot be removed, e.g. due to a misconfigured `tailRemovalRatePpm`, fall back
+	// to combining all samples and surface the misconfiguration.
+	filterFunc := func(premiums []int32) []int32 {
+		filteredPremiums, err := sampleTailsRemovalFunc(premiums)
+		if err != nil {
+			log.ErrorLogWithError(
+				ctx,
+				"MaybeProcessNewFundingTickEpoch: skipped removing tails of premium samples",
+				err,
+			)
+			telemetry.IncrCounterWithLabels(
+				[]string{
+					types.ModuleName,
+					metrics.SampleTailsRemovalSkipped,
+					metrics.Count,
+				},
+				1,
+				[]gometrics.Label{
+					metrics.GetLabelForStringValue(
+						metrics.Reason,
+						metrics.RemovalExceedsNumSamples,
+					),
+				},
+			)
+		}
+		return filteredPremiums
+	}
+
 	// Process stored samples from last `funding-tick` epoch, and retrieve
 	// a mapping from `perpetualId` to summarized premium rate for this epoch.
 	// For premiums, we first remove a fixed amount of bottom/top samples, then
-	// take the average of the remaining samples.
+	// combine the remaining samples using the premium combination method of the
+	// liquidity tier of the perpetual.
 	perpIdToPremiumPpm := k.processStoredPremiums(
 		ctx,
 		fundingTickEpochInfo,
 		types.PremiumSamplesKey,
 		minSampleRequiredForPremiumRate,
-		lib.AvgInt32,           // combineFunc
-		sampleTailsRemovalFunc, // filterFunc
+		k.getPremiumSamplesCombineFunc(ctx, allPerps), // getCombineFunc
+		filterFunc,
 	)
 
 	newFundingRatesAndIndicesForEvent := []indexerevents.FundingUpdateV1{}
@@ -737,7 +892,7 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 		// Update the funding index if the funding rate is non-zero.
 		if bigFundingRatePpm.Sign() != 0 {
 			// Get the price of the perpetual from state.
-			marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perp.Params.MarketId)
+			marketPrice, err := k.getMarketPriceForPerpetual(ctx, perp)
 			if err != nil {
 				panic(err)
 			}
@@ -751,6 +906,7 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 				// TODO(DEC-1483): Handle the case when duration value is updated
 				// during the epoch.
 				fundingTickEpochInfo.Duration,
+				params.FundingRatePeriodSeconds,
 			)
 
 			// Update the funding index in state.
@@ -764,6 +920,8 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 		if err != nil {
 			panic(err)
 		}
+		k.setFundingIndexSnapshot(ctx, perp.Params.Id, fundingTickEpochInfo.CurrentEpoch, perp.FundingIndex)
+		k.setFundingRate(ctx, perp.Params.Id, int32(bigFundingRatePpm.Int64()))
 		newFundingRatesAndIndicesForEvent = append(newFundingRatesAndIndicesForEvent, indexerevents.FundingUpdateV1{
 			PerpetualId:     perp.Params.Id,
 			FundingValuePpm: int32(bigFundingRatePpm.Int64()),
@@ -783,6 +941,33 @@ func (k Keeper) MaybeProcessNewFundingTickEpoch(ctx sdk.Context) {
 
 	// Clear premium samples.
 	k.SetEmptyPremiumSamples(ctx)
+
+	// Prune the net funding of epochs outside of the retention window.
+	k.PruneNetFunding(ctx, fundingTickEpochInfo.CurrentEpoch)
+}
+
+// getPremiumSamplesCombineFunc returns a function that returns, for a perpetual Id, the function
+// used to combine the premium samples of the perpetual, as determined by the premium combination
+// method of its liquidity tier. Perpetuals not in `perpetuals` default to the average of the samples.
+func (k Keeper) getPremiumSamplesCombineFunc(
+	ctx sdk.Context,
+	perpetuals []types.Perpetual,
+) func(perpetualId uint32) func([]int32) int32 {
+	perpIdToCombineFunc := make(map[uint32]func([]int32) int32, len(perpetuals))
+	for _, perp := range perpetuals {
+		liquidityTier, err := k.GetLiquidityTier(ctx, perp.Params.LiquidityTier)
+		if err != nil {
+			panic(err)
+		}
+		perpIdToCombineFunc[perp.Params.Id] = liquidityTier.GetPremiumCombineFunc()
+	}
+
+	return func(perpetualId uint32) func([]int32) int32 {
+		if combineFunc, found := perpIdToCombineFunc[perpetualId]; found {
+			return combineFunc
+		}
+		return lib.AvgInt32
+	}
 }
 
 // GetNetNotional returns the net notional in quote quantums, which can be represented by the following equation:
@@ -823,6 +1008,56 @@ func (k Keeper) GetNetNotional(
 	return perplib.GetNetNotionalInQuoteQuantums(perpetual, marketPrice, bigQuantums), nil
 }
 
+// GetNetNotionalForPositions returns the sum of the net notional in quote quantums of all `positions`.
+// Each perpetual is fetched once per position, and the market price of each market is fetched at most
+// once within the call, so positions in perpetuals sharing a market only read the market price once.
+// Returns an error if a perpetual of a position does not exist or if its market does not exist.
+func (k Keeper) GetNetNotionalForPositions(
+	ctx sdk.Context,
+	positions []types.PerpetualPositionLite,
+) (
+	bigNetNotionalQuoteQuantums *big.Int,
+	err error,
+) {
+	bigNetNotionalQuoteQuantums = new(big.Int)
+	marketIdToPrice := make(map[uint32]pricestypes.MarketPrice)
+	for _, position := range positions {
+		perpetual, err := k.GetPerpetual(ctx, position.PerpetualId)
+		if err != nil {
+			return new(big.Int), err
+		}
+
+		// Delisted perpetuals use their settlement price rather than the price of their market.
+		marketPrice, cached := marketIdToPrice[perpetual.Params.MarketId]
+		if perpetual.IsMarketDelisted() || !cached {
+			marketPrice, err = k.getMarketPriceForPerpetual(ctx, perpetual)
+			if err != nil {
+				if errorsmod.IsOf(err, pricestypes.ErrMarketPriceDoesNotExist) {
+					return new(big.Int), errorsmod.Wrap(
+						types.ErrMarketDoesNotExist,
+						fmt.Sprintf(
+							"Market ID %d does not exist on perpetual ID %d",
+							perpetual.Params.MarketId,
+							perpetual.Params.Id,
+						),
+					)
+				}
+				return new(big.Int), err
+			}
+			if !perpetual.IsMarketDelisted() {
+				marketIdToPrice[perpetual.Params.MarketId] = marketPrice
+			}
+		}
+
+		bigNetNotionalQuoteQuantums.Add(
+			bigNetNotionalQuoteQuantums,
+			perplib.GetNetNotionalInQuoteQuantums(perpetual, marketPrice, position.Quantums),
+		)
+	}
+
+	return bigNetNotionalQuoteQuantums, nil
+}
+
 // GetNotionalInBaseQuantums returns the net notional in base quantums, which can be represented
 // by the following equation:
 // `quoteQuantums * 10^baseAtomicResolution / (marketPrice * 10^marketExponent * 10^quoteAtomicResolution)`.
@@ -1079,6 +1314,7 @@ func (k Keeper) SetPerpetualForTest(
 	perpetual types.Perpetual,
 ) {
 	k.setPerpetual(ctx, perpetual)
+	k.setPerpetualMarketIndex(ctx, perpetual)
 }
 
 func (k Keeper) setPerpetual(
@@ -1090,6 +1326,34 @@ func (k Keeper) setPerpetual(
 	perpetualStore.Set(lib.Uint32ToKey(perpetual.Params.Id), b)
 }
 
+// InitializePerpetualMarketIndex indexes every perpetual in state by the market it references.
+// This is used to populate the index for perpetuals created before it existed.
+func (k Keeper) InitializePerpetualMarketIndex(ctx sdk.Context) {
+	for _, perpetual := range k.GetAllPerpetuals(ctx) {
+		k.setPerpetualMarketIndex(ctx, perpetual)
+	}
+}
+
+// setPerpetualMarketIndex indexes the perpetual by the market it references. It must be called
+// when a perpetual is created or its `MarketId` changes.
+func (k Keeper) setPerpetualMarketIndex(
+	ctx sdk.Context,
+	perpetual types.Perpetual,
+) {
+	k.getPerpetualByMarketStore(ctx, perpetual.Params.MarketId).Set(lib.Uint32ToKey(perpetual.Params.Id), []byte{})
+}
+
+// getPerpetualByMarketStore returns the store of the ids of all perpetuals that reference the provided market.
+func (k Keeper) getPerpetualByMarketStore(
+	ctx sdk.Context,
+	marketId uint32,
+) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append([]byte(types.PerpetualByMarketKeyPrefix), lib.Uint32ToKey(marketId)...),
+	)
+}
+
 // SetPerpetual validates the perpetual object and sets it in state.
 func (k Keeper) ValidateAndSetPerpetual(
 	ctx sdk.Context,
@@ -1135,7 +1399,7 @@ func (k Keeper) GetPerpetualAndMarketPrice(
 	}
 
 	// Get market price.
-	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	marketPrice, err := k.getMarketPriceForPerpetual(ctx, perpetual)
 	if err != nil {
 		if errorsmod.IsOf(err, pricestypes.ErrMarketPriceDoesNotExist) {
 			return perpetual, marketPrice, errorsmod.Wrap(
@@ -1154,6 +1418,56 @@ func (k Keeper) GetPerpetualAndMarketPrice(
 	return perpetual, marketPrice, nil
 }
 
+// getMarketPriceForPerpetual returns the MarketPrice of the provided perpetual's market. If the
+// perpetual's market has been delisted, the settlement price recorded at delisting is returned
+// instead of the oracle price.
+func (k Keeper) getMarketPriceForPerpetual(
+	ctx sdk.Context,
+	perpetual types.Perpetual,
+) (pricestypes.MarketPrice, error) {
+	if perpetual.IsMarketDelisted() {
+		return *perpetual.DelistedSettlementPrice, nil
+	}
+	return k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+}
+
+// DelistPerpetualMarket marks the market of the provided perpetual as delisted, recording the
+// market's current price as the perpetual's settlement price. After delisting, the settlement
+// price is used in place of the oracle price, so the perpetual remains usable if its market
+// price is later removed.
+// Returns an error if the perpetual or its market price does not exist, or if the perpetual's
+// market is already delisted.
+func (k Keeper) DelistPerpetualMarket(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (types.Perpetual, error) {
+	perpetual, marketPrice, err := k.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if err != nil {
+		return perpetual, err
+	}
+
+	if perpetual.IsMarketDelisted() {
+		return perpetual, errorsmod.Wrapf(
+			types.ErrPerpetualMarketAlreadyDelisted,
+			"perpetual ID %d",
+			perpetualId,
+		)
+	}
+
+	perpetual.DelistedSettlementPrice = &marketPrice
+	k.setPerpetual(ctx, perpetual)
+
+	log.InfoLog(
+		ctx,
+		"Delisted perpetual market",
+		log.PerpetualId,
+		perpetualId,
+		"settlementPrice",
+		marketPrice,
+	)
+	return perpetual, nil
+}
+
 // GetPerpetualAndMarketPriceAndLiquidityTier retrieves a Perpetual by its id, its corresponding MarketPrice,
 // and its corresponding LiquidityTier.
 func (k Keeper) GetPerpetualAndMarketPriceAndLiquidityTier(
@@ -1169,7 +1483,7 @@ func (k Keeper) GetPerpetualAndMarketPriceAndLiquidityTier(
 	if err != nil {
 		return perpetual, pricestypes.MarketPrice{}, types.LiquidityTier{}, err
 	}
-	marketPrice, err := k.pricesKeeper.GetMarketPrice(ctx, perpetual.Params.MarketId)
+	marketPrice, err := k.getMarketPriceForPerpetual(ctx, perpetual)
 	if err != nil {
 		return perpetual, marketPrice, types.LiquidityTier{}, err
 	}
@@ -1325,19 +1639,21 @@ func (k Keeper) SetLiquidityTier(
 	impactNotional uint64,
 	openInterestLowerCap uint64,
 	openInterestUpperCap uint64,
+	premiumCombinationMethod types.PremiumCombinationMethod,
 ) (
 	liquidityTier types.LiquidityTier,
 	err error,
 ) {
 	// Construct liquidity tier.
 	liquidityTier = types.LiquidityTier{
-		Id:                     id,
-		Name:                   name,
-		InitialMarginPpm:       initialMarginPpm,
-		MaintenanceFractionPpm: maintenanceFractionPpm,
-		ImpactNotional:         impactNotional,
-		OpenInterestLowerCap:   openInterestLowerCap,
-		OpenInterestUpperCap:   openInterestUpperCap,
+		Id:                       id,
+		Name:                     name,
+		InitialMarginPpm:         initialMarginPpm,
+		MaintenanceFractionPpm:   maintenanceFractionPpm,
+		ImpactNotional:           impactNotional,
+		OpenInterestLowerCap:     openInterestLowerCap,
+		OpenInterestUpperCap:     openInterestUpperCap,
+		PremiumCombinationMethod: premiumCombinationMethod,
 	}
 
 	// Validate liquidity tier's fields.
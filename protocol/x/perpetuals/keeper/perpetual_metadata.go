@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+)
+
+// GetPerpetualMetadata returns the creation metadata of a perpetual. Returns false if no metadata
+// is stored for the perpetual.
+func (k Keeper) GetPerpetualMetadata(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (metadata types.PerpetualMetadata, found bool) {
+	store := k.getPerpetualMetadataStore(ctx)
+	b := store.Get(lib.Uint32ToKey(perpetualId))
+	if b == nil {
+		return metadata, false
+	}
+
+	k.cdc.MustUnmarshal(b, &metadata)
+	return metadata, true
+}
+
+// setPerpetualMetadata stores the creation metadata of a perpetual created in the current block.
+func (k Keeper) setPerpetualMetadata(
+	ctx sdk.Context,
+	perpetualId uint32,
+) {
+	metadata := types.PerpetualMetadata{
+		PerpetualId:         perpetualId,
+		CreationBlockHeight: lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+	}
+
+	// The `funding-tick` epoch doesn't exist before it is created in genesis.
+	if epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.FundingTickEpochInfoName); found {
+		metadata.CreationEpoch = epochInfo.CurrentEpoch
+	}
+
+	store := k.getPerpetualMetadataStore(ctx)
+	store.Set(lib.Uint32ToKey(perpetualId), k.cdc.MustMarshal(&metadata))
+}
+
+// getPerpetualMetadataStore returns a prefix store of the creation metadata of all perpetuals.
+func (k Keeper) getPerpetualMetadataStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PerpetualMetadataKeyPrefix))
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPerpetualMetadata_NoFundingTickEpoch(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	_, found := pc.PerpetualsKeeper.GetPerpetualMetadata(pc.Ctx, 0)
+	require.False(t, found)
+
+	ctx := pc.Ctx.WithBlockHeight(12)
+	perpetual := constants.BtcUsd_100PercentMarginRequirement
+	_, err := pc.PerpetualsKeeper.CreatePerpetual(
+		ctx,
+		perpetual.Params.Id,
+		perpetual.Params.Ticker,
+		perpetual.Params.MarketId,
+		perpetual.Params.AtomicResolution,
+		perpetual.Params.DefaultFundingPpm,
+		perpetual.Params.LiquidityTier,
+		perpetual.Params.MarketType,
+	)
+	require.NoError(t, err)
+
+	metadata, found := pc.PerpetualsKeeper.GetPerpetualMetadata(ctx, perpetual.Params.Id)
+	require.True(t, found)
+	require.Equal(
+		t,
+		types.PerpetualMetadata{
+			PerpetualId:         perpetual.Params.Id,
+			CreationBlockHeight: 12,
+			CreationEpoch:       0,
+		},
+		metadata,
+	)
+}
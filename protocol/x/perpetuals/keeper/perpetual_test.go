@@ -49,6 +49,7 @@ func TestModifyPerpetual_Success(t *testing.T) {
 		marketId := uint32(i*2) % numMarkets
 		defaultFundingPpm := int32(i * 2)
 		liquidityTier := uint32((i + 1) % numLiquidityTiers)
+		initialMarginPpmOverride := uint32(i * 1_000)
 		retItem, err := pc.PerpetualsKeeper.ModifyPerpetual(
 			pc.Ctx,
 			item.Params.Id,
@@ -56,6 +57,7 @@ func TestModifyPerpetual_Success(t *testing.T) {
 			marketId,
 			defaultFundingPpm,
 			liquidityTier,
+			initialMarginPpmOverride,
 		)
 		require.NoError(t, err)
 
@@ -101,6 +103,11 @@ func TestModifyPerpetual_Success(t *testing.T) {
 			liquidityTier,
 			newItem.Params.LiquidityTier,
 		)
+		require.Equal(
+			t,
+			initialMarginPpmOverride,
+			newItem.Params.InitialMarginPpmOverride,
+		)
 	}
 
 	// Verify that expected indexer events were emitted.
@@ -250,14 +257,126 @@ func TestCreatePerpetual_Failure(t *testing.T) {
 	}
 }
 
+func TestCreatePerpetuals_Success(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	perpetuals, err := pc.PerpetualsKeeper.CreatePerpetuals(
+		pc.Ctx,
+		[]types.PerpetualParams{
+			constants.BtcUsd_100PercentMarginRequirement.Params,
+			constants.EthUsd_100PercentMarginRequirement.Params,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]types.Perpetual{
+			constants.BtcUsd_100PercentMarginRequirement,
+			constants.EthUsd_100PercentMarginRequirement,
+		},
+		perpetuals,
+	)
+	require.Equal(t, perpetuals, pc.PerpetualsKeeper.GetAllPerpetuals(pc.Ctx))
+	for _, perpetual := range perpetuals {
+		_, found := pc.PerpetualsKeeper.GetPerpetualMetadata(pc.Ctx, perpetual.Params.Id)
+		require.True(t, found)
+	}
+}
+
+func TestCreatePerpetuals_Failure(t *testing.T) {
+	btcParams := constants.BtcUsd_100PercentMarginRequirement.Params
+	ethParams := constants.EthUsd_100PercentMarginRequirement.Params
+	solParams := constants.SolUsd_20PercentInitial_10PercentMaintenance.Params
+
+	invalidMarketParams := solParams
+	invalidMarketParams.MarketId = 999
+	invalidLiquidityTierParams := solParams
+	invalidLiquidityTierParams.LiquidityTier = 999
+	emptyTickerParams := solParams
+	emptyTickerParams.Ticker = ""
+	duplicateIdParams := solParams
+	duplicateIdParams.Id = btcParams.Id
+
+	tests := map[string]struct {
+		thirdPerpetualParams types.PerpetualParams
+		expectedError        error
+	}{
+		"Market doesn't exist": {
+			thirdPerpetualParams: invalidMarketParams,
+			expectedError:        pricestypes.ErrMarketPriceDoesNotExist,
+		},
+		"Liquidity tier doesn't exist": {
+			thirdPerpetualParams: invalidLiquidityTierParams,
+			expectedError:        types.ErrLiquidityTierDoesNotExist,
+		},
+		"Ticker is an empty string": {
+			thirdPerpetualParams: emptyTickerParams,
+			expectedError:        types.ErrTickerEmptyString,
+		},
+		"Duplicate perpetual id": {
+			thirdPerpetualParams: duplicateIdParams,
+			expectedError:        types.ErrPerpetualAlreadyExists,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := keepertest.PerpetualsKeepers(t)
+			keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+			_, err := pc.PerpetualsKeeper.CreatePerpetuals(
+				pc.Ctx,
+				[]types.PerpetualParams{btcParams, ethParams, tc.thirdPerpetualParams},
+			)
+			require.ErrorIs(t, err, tc.expectedError)
+
+			// None of the perpetuals are created.
+			require.Empty(t, pc.PerpetualsKeeper.GetAllPerpetuals(pc.Ctx))
+			for _, params := range []types.PerpetualParams{btcParams, ethParams} {
+				require.False(t, pc.PerpetualsKeeper.HasPerpetual(pc.Ctx, params.Id))
+				_, found := pc.PerpetualsKeeper.GetPerpetualMetadata(pc.Ctx, params.Id)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestCreatePerpetuals_PerpetualAlreadyExists(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	btcParams := constants.BtcUsd_100PercentMarginRequirement.Params
+	_, err := pc.PerpetualsKeeper.CreatePerpetuals(pc.Ctx, []types.PerpetualParams{btcParams})
+	require.NoError(t, err)
+
+	_, err = pc.PerpetualsKeeper.CreatePerpetuals(
+		pc.Ctx,
+		[]types.PerpetualParams{
+			constants.EthUsd_100PercentMarginRequirement.Params,
+			btcParams,
+		},
+	)
+	require.ErrorIs(t, err, types.ErrPerpetualAlreadyExists)
+	require.Equal(
+		t,
+		[]types.Perpetual{constants.BtcUsd_100PercentMarginRequirement},
+		pc.PerpetualsKeeper.GetAllPerpetuals(pc.Ctx),
+	)
+}
+
 func TestModifyPerpetual_Failure(t *testing.T) {
 	tests := map[string]struct {
-		id                uint32
-		ticker            string
-		marketId          uint32
-		defaultFundingPpm int32
-		liquidityTier     uint32
-		expectedError     error
+		id                       uint32
+		ticker                   string
+		marketId                 uint32
+		defaultFundingPpm        int32
+		liquidityTier            uint32
+		initialMarginPpmOverride uint32
+		expectedError            error
 	}{
 		"Perpetual doesn't exist": {
 			id:                999,
@@ -291,6 +410,18 @@ func TestModifyPerpetual_Failure(t *testing.T) {
 			liquidityTier:     999,
 			expectedError:     errorsmod.Wrap(types.ErrLiquidityTierDoesNotExist, fmt.Sprint(999)),
 		},
+		"Initial margin override exceeds max": {
+			id:                       0,
+			ticker:                   "ticker",
+			marketId:                 0,
+			defaultFundingPpm:        0,
+			liquidityTier:            0,
+			initialMarginPpmOverride: lib.OneMillion + 1,
+			expectedError: errorsmod.Wrap(
+				types.ErrInitialMarginPpmExceedsMax,
+				fmt.Sprint(lib.OneMillion+1),
+			),
+		},
 	}
 
 	// Test setup.
@@ -308,6 +439,7 @@ func TestModifyPerpetual_Failure(t *testing.T) {
 				tc.marketId,
 				tc.defaultFundingPpm,
 				tc.liquidityTier,
+				tc.initialMarginPpmOverride,
 			)
 
 			require.Error(t, err)
@@ -548,6 +680,97 @@ func TestGetAllPerpetuals_Sorted(t *testing.T) {
 	)
 }
 
+func TestGetPerpetualsForMarket(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	perps := []types.Perpetual{
+		*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+		*perptest.GeneratePerpetual(perptest.WithId(1), perptest.WithMarketId(0)),
+		*perptest.GeneratePerpetual(perptest.WithId(2), perptest.WithMarketId(1)),
+	}
+	for _, perp := range perps {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			pc.Ctx,
+			perp.Params.Id,
+			perp.Params.Ticker,
+			perp.Params.MarketId,
+			perp.Params.AtomicResolution,
+			perp.Params.DefaultFundingPpm,
+			perp.Params.LiquidityTier,
+			perp.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	getPerpetualIdsForMarket := func(marketId uint32) []uint32 {
+		ids := make([]uint32, 0)
+		for _, perp := range pc.PerpetualsKeeper.GetPerpetualsForMarket(pc.Ctx, marketId) {
+			require.Equal(t, marketId, perp.Params.MarketId)
+			ids = append(ids, perp.Params.Id)
+		}
+		return ids
+	}
+
+	require.Equal(t, []uint32{0, 1}, getPerpetualIdsForMarket(0))
+	require.Equal(t, []uint32{2}, getPerpetualIdsForMarket(1))
+	require.Empty(t, getPerpetualIdsForMarket(2))
+
+	// Updating other fields of a perpetual does not change the index.
+	require.NoError(t, pc.PerpetualsKeeper.ModifyOpenInterest(pc.Ctx, 0, big.NewInt(1_000)))
+	require.Equal(t, []uint32{0, 1}, getPerpetualIdsForMarket(0))
+
+	// Moving a perpetual to a different market moves it in the index.
+	_, err := pc.PerpetualsKeeper.ModifyPerpetual(
+		pc.Ctx,
+		perps[1].Params.Id,
+		perps[1].Params.Ticker,
+		1,
+		perps[1].Params.DefaultFundingPpm,
+		perps[1].Params.LiquidityTier,
+		perps[1].Params.InitialMarginPpmOverride,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0}, getPerpetualIdsForMarket(0))
+	require.Equal(t, []uint32{1, 2}, getPerpetualIdsForMarket(1))
+
+	// Modifying a perpetual without changing its market keeps it in the index.
+	_, err = pc.PerpetualsKeeper.ModifyPerpetual(
+		pc.Ctx,
+		perps[2].Params.Id,
+		"new ticker",
+		1,
+		perps[2].Params.DefaultFundingPpm,
+		perps[2].Params.LiquidityTier,
+		perps[2].Params.InitialMarginPpmOverride,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2}, getPerpetualIdsForMarket(1))
+}
+
+func TestInitializePerpetualMarketIndex(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	// Perpetuals written directly to state, as before the index existed, are not indexed.
+	perps := []types.Perpetual{
+		*perptest.GeneratePerpetual(perptest.WithId(0), perptest.WithMarketId(0)),
+		*perptest.GeneratePerpetual(perptest.WithId(1), perptest.WithMarketId(1)),
+		*perptest.GeneratePerpetual(perptest.WithId(2), perptest.WithMarketId(0)),
+	}
+	for _, perp := range perps {
+		require.NoError(t, pc.PerpetualsKeeper.ValidateAndSetPerpetual(pc.Ctx, perp))
+	}
+	require.Empty(t, pc.PerpetualsKeeper.GetPerpetualsForMarket(pc.Ctx, 0))
+	require.Empty(t, pc.PerpetualsKeeper.GetPerpetualsForMarket(pc.Ctx, 1))
+
+	pc.PerpetualsKeeper.InitializePerpetualMarketIndex(pc.Ctx)
+	require.Equal(t, []types.Perpetual{perps[0], perps[2]}, pc.PerpetualsKeeper.GetPerpetualsForMarket(pc.Ctx, 0))
+	require.Equal(t, []types.Perpetual{perps[1]}, pc.PerpetualsKeeper.GetPerpetualsForMarket(pc.Ctx, 1))
+}
+
 func TestModifyOpenInterest_Failure(t *testing.T) {
 	testCases := map[string]struct {
 		id                uint32
@@ -743,6 +966,54 @@ func TestGetPerpetualAndMarketPriceAndLiquidityTier_LiquidityTierNotFound(t *tes
 	require.ErrorIs(t, err, types.ErrLiquidityTierDoesNotExist)
 }
 
+func TestDelistPerpetualMarket(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+
+	// Create liquidity tiers and perpetuals,
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+	perpetual := perps[0]
+	require.False(t, perpetual.IsMarketDelisted())
+
+	marketPrice, err := pc.PricesKeeper.GetMarketPrice(pc.Ctx, perpetual.Params.MarketId)
+	require.NoError(t, err)
+
+	// Delisting a non-existent perpetual should return an error.
+	_, err = pc.PerpetualsKeeper.DelistPerpetualMarket(pc.Ctx, 999)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+
+	// Delist the perpetual's market, recording the current market price as the settlement price.
+	delistedPerpetual, err := pc.PerpetualsKeeper.DelistPerpetualMarket(pc.Ctx, perpetual.Params.Id)
+	require.NoError(t, err)
+	require.True(t, delistedPerpetual.IsMarketDelisted())
+	require.Equal(t, marketPrice, *delistedPerpetual.DelistedSettlementPrice)
+
+	perpetual, err = pc.PerpetualsKeeper.GetPerpetual(pc.Ctx, perpetual.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, delistedPerpetual, perpetual)
+
+	// Point the perpetual at a market with no price, simulating the removal of its market.
+	perpetual.Params.MarketId = 999
+	cdc := codec.NewProtoCodec(module.InterfaceRegistry)
+	b := cdc.MustMarshal(&perpetual)
+	perpetualStore := prefix.NewStore(pc.Ctx.KVStore(pc.StoreKey), []byte(types.PerpetualKeyPrefix))
+	perpetualStore.Set(lib.Uint32ToKey(perpetual.Params.Id), b)
+
+	// The settlement price is used in place of the missing market price.
+	_, gotMarketPrice, err := pc.PerpetualsKeeper.GetPerpetualAndMarketPrice(pc.Ctx, perpetual.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, marketPrice, gotMarketPrice)
+	_, gotMarketPrice, _, err = pc.PerpetualsKeeper.GetPerpetualAndMarketPriceAndLiquidityTier(
+		pc.Ctx,
+		perpetual.Params.Id,
+	)
+	require.NoError(t, err)
+	require.Equal(t, marketPrice, gotMarketPrice)
+
+	// Delisting an already delisted perpetual should return an error.
+	_, err = pc.PerpetualsKeeper.DelistPerpetualMarket(pc.Ctx, perpetual.Params.Id)
+	require.ErrorIs(t, err, types.ErrPerpetualMarketAlreadyDelisted)
+}
+
 func TestGetNetNotional_Success(t *testing.T) {
 	tests := map[string]struct {
 		price                               uint64
@@ -857,6 +1128,92 @@ func TestGetNetNotional_Success(t *testing.T) {
 	}
 }
 
+func TestGetNetNotionalForPositions(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	keepertest.CreateTestMarkets(t, pc.Ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, pc.Ctx, pc.PerpetualsKeeper)
+
+	// A second perpetual referencing the BTC market.
+	btcPerp2 := constants.BtcUsd_100PercentMarginRequirement
+	btcPerp2.Params.Id = 3
+	btcPerp2.Params.Ticker = "BTC-USD 2"
+	for _, p := range []types.Perpetual{
+		constants.BtcUsd_100PercentMarginRequirement,
+		constants.EthUsd_100PercentMarginRequirement,
+		constants.SolUsd_20PercentInitial_10PercentMaintenance,
+		btcPerp2,
+	} {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			pc.Ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	tests := map[string]struct {
+		positions []types.PerpetualPositionLite
+	}{
+		"No positions": {
+			positions: []types.PerpetualPositionLite{},
+		},
+		"Single long position": {
+			positions: []types.PerpetualPositionLite{
+				{PerpetualId: 0, Quantums: big.NewInt(100_000_000)},
+			},
+		},
+		"Mixed long and short positions": {
+			positions: []types.PerpetualPositionLite{
+				{PerpetualId: 0, Quantums: big.NewInt(100_000_000)},
+				{PerpetualId: 1, Quantums: big.NewInt(-2_000_000_000)},
+				{PerpetualId: 2, Quantums: big.NewInt(7_000_000_000)},
+			},
+		},
+		"Offsetting positions in perpetuals sharing a market": {
+			positions: []types.PerpetualPositionLite{
+				{PerpetualId: 0, Quantums: big.NewInt(100_000_000)},
+				{PerpetualId: 3, Quantums: big.NewInt(-100_000_000)},
+				{PerpetualId: 1, Quantums: big.NewInt(-3_000)},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			expectedNetNotional := new(big.Int)
+			for _, position := range tc.positions {
+				netNotional, err := pc.PerpetualsKeeper.GetNetNotional(pc.Ctx, position.PerpetualId, position.Quantums)
+				require.NoError(t, err)
+				expectedNetNotional.Add(expectedNetNotional, netNotional)
+			}
+
+			netNotional, err := pc.PerpetualsKeeper.GetNetNotionalForPositions(pc.Ctx, tc.positions)
+			require.NoError(t, err)
+			require.Equal(t, expectedNetNotional, netNotional)
+		})
+	}
+}
+
+func TestGetNetNotionalForPositions_PerpetualNotFound(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	perps := keepertest.CreateLiquidityTiersAndNPerpetuals(t, pc.Ctx, pc.PerpetualsKeeper, pc.PricesKeeper, 1)
+
+	nonExistentPerpetualId := uint32(999)
+	_, err := pc.PerpetualsKeeper.GetNetNotionalForPositions(
+		pc.Ctx,
+		[]types.PerpetualPositionLite{
+			{PerpetualId: perps[0].Params.Id, Quantums: big.NewInt(1)},
+			{PerpetualId: nonExistentPerpetualId, Quantums: big.NewInt(-1)},
+		},
+	)
+	require.ErrorIs(t, err, types.ErrPerpetualDoesNotExist)
+}
+
 func TestGetNetNotional_PerpetualNotFound(t *testing.T) {
 	pc := keepertest.PerpetualsKeepers(t)
 	nonExistentPerpetualId := uint32(0)
@@ -1298,6 +1655,7 @@ func TestGetRemoveSampleTailsFunc(t *testing.T) {
 		removalRatePpm uint32
 		input          []int32
 		expectedOutput []int32
+		expectedErr    error
 	}{
 		"25%, input length = 0": {
 			removalRatePpm: 250_000,
@@ -1334,10 +1692,22 @@ func TestGetRemoveSampleTailsFunc(t *testing.T) {
 			input:          []int32{0, -1, -3, -5, 5},
 			expectedOutput: []int32{-5, -3, -1, 0}, // bottomRemoval = 0, topRemoval = 1
 		},
+		"37.5%, input length = 4, removes all but one sample": {
+			removalRatePpm: 375_000,
+			input:          []int32{0, -1, -3, 5},
+			expectedOutput: []int32{-1}, // bottomRemoval = 1, topRemoval = 2
+		},
+		"50%, input length = 4, removal equals number of samples, skips removing samples": {
+			removalRatePpm: 500_000,
+			input:          []int32{0, -1, -3, 5},
+			expectedOutput: []int32{0, -1, -3, 5},
+			expectedErr:    types.ErrSampleTailsRemovalExceedsNumSamples,
+		},
 		"80%, invalid removal ratio, skips removing samples": {
 			removalRatePpm: 800_000,
 			input:          []int32{0, -1, -3, -5, 5},
 			expectedOutput: []int32{0, -1, -3, -5, 5},
+			expectedErr:    types.ErrSampleTailsRemovalExceedsNumSamples,
 		},
 	}
 
@@ -1346,7 +1716,12 @@ func TestGetRemoveSampleTailsFunc(t *testing.T) {
 			pc := keepertest.PerpetualsKeepers(t)
 
 			sampleTailsRemovalFunc := pc.PerpetualsKeeper.GetRemoveSampleTailsFunc(pc.Ctx, tc.removalRatePpm)
-			output := sampleTailsRemovalFunc(tc.input)
+			output, err := sampleTailsRemovalFunc(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
 
 			require.Equal(t,
 				tc.expectedOutput,
@@ -1801,10 +2176,102 @@ func TestMaybeProcessNewFundingTickEpoch_ProcessNewEpoch(t *testing.T) {
 				tc.fundingRatesAndIndices,
 			)
 			require.Contains(t, fundingEvents, expectedFundingEvent)
+
+			// Check that the funding rates and indices in the event are queryable from state.
+			for _, update := range tc.fundingRatesAndIndices {
+				fundingRatePpm, fundingIndex, err := pc.PerpetualsKeeper.GetCurrentFundingRate(
+					pc.Ctx,
+					update.PerpetualId,
+				)
+				require.NoError(t, err)
+				require.Equal(t, update.FundingValuePpm, fundingRatePpm)
+				require.Equal(t, update.FundingIndex, fundingIndex)
+			}
 		})
 	}
 }
 
+func TestMaybeProcessNewFundingTickEpoch_PremiumCombinationMethod(t *testing.T) {
+	pc := keepertest.PerpetualsKeepers(t)
+	ctx := pc.Ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateTestMarkets(t, ctx, pc.PricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, pc.PerpetualsKeeper)
+
+	// Combine the premium samples of the ETH perpetual's liquidity tier using the median.
+	meanPerp := constants.BtcUsd_0DefaultFunding_10AtomicResolution
+	medianPerp := constants.EthUsd_0DefaultFunding_9AtomicResolution
+	medianLt := constants.LiquidityTiers[medianPerp.Params.LiquidityTier]
+	_, err := pc.PerpetualsKeeper.SetLiquidityTier(
+		ctx,
+		medianLt.Id,
+		medianLt.Name,
+		medianLt.InitialMarginPpm,
+		medianLt.MaintenanceFractionPpm,
+		medianLt.ImpactNotional,
+		medianLt.OpenInterestLowerCap,
+		medianLt.OpenInterestUpperCap,
+		types.PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEDIAN,
+	)
+	require.NoError(t, err)
+
+	perps := []types.Perpetual{meanPerp, medianPerp}
+	for _, p := range perps {
+		_, err := pc.PerpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:                   string(epochstypes.FundingTickEpochInfoName),
+			Duration:               3600,
+			CurrentEpochStartBlock: 23,
+			CurrentEpoch:           1,
+		},
+	)
+	require.NoError(t, err)
+	err = pc.EpochsKeeper.CreateEpochInfo(
+		ctx,
+		epochstypes.EpochInfo{
+			Name:     string(epochstypes.FundingSampleEpochInfoName),
+			Duration: 60,
+		},
+	)
+	require.NoError(t, err)
+
+	// 40 samples of 0.1% for both perpetuals, padded with 20 zero samples to the
+	// 60 samples expected in the `funding-tick` epoch.
+	keepertest.PopulateTestPremiumStore(
+		t,
+		ctx,
+		pc.PerpetualsKeeper,
+		perps,
+		constants.GenerateConstantFundingPremiums(1_000, 40),
+		false, // isVote
+	)
+
+	pc.PerpetualsKeeper.MaybeProcessNewFundingTickEpoch(ctx.WithBlockHeight(23))
+
+	// The mean of the samples is 0.0666%.
+	meanFundingRatePpm, _, err := pc.PerpetualsKeeper.GetCurrentFundingRate(ctx, meanPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, int32(666), meanFundingRatePpm)
+
+	// The median of the samples is 0.1%.
+	medianFundingRatePpm, _, err := pc.PerpetualsKeeper.GetCurrentFundingRate(ctx, medianPerp.Params.Id)
+	require.NoError(t, err)
+	require.Equal(t, int32(1_000), medianFundingRatePpm)
+}
+
 // getFundingBlockEventsFromIndexerBlock returns all funding events from the indexer block.
 func getFundingBlockEventsFromIndexerBlock(
 	ctx sdk.Context,
@@ -2322,15 +2789,16 @@ func TestMaybeProcessNewFundingSampleEpoch(t *testing.T) {
 	testCurrentEpoch := uint32(5)
 
 	tests := map[string]struct {
-		currentEpochStartBlock uint32
-		currentBlockHeight     int64
-		minNumVotesPerSample   uint32
-		premiumVotes           types.PremiumStore
-		prevPremiumSamples     types.PremiumStore
-		expectedPremiumSamples types.PremiumStore
-		expectedPremiumVotes   types.PremiumStore
-		panicErr               error
-		expectedEvents         []sdk.Event
+		currentEpochStartBlock     uint32
+		currentBlockHeight         int64
+		minNumVotesPerSample       uint32
+		truncatePremiumsOnOverflow bool
+		premiumVotes               types.PremiumStore
+		prevPremiumSamples         types.PremiumStore
+		expectedPremiumSamples     types.PremiumStore
+		expectedPremiumVotes       types.PremiumStore
+		panicErr                   error
+		expectedEvents             []sdk.Event
 	}{
 		"Not new epoch": {
 			currentEpochStartBlock: 23,
@@ -2546,12 +3014,47 @@ func TestMaybeProcessNewFundingSampleEpoch(t *testing.T) {
 				1,
 			),
 		},
+		"Truncates premium entries to `NumPremiums` if truncation is enabled": {
+			currentEpochStartBlock:     23,
+			currentBlockHeight:         23,
+			minNumVotesPerSample:       1,
+			truncatePremiumsOnOverflow: true,
+			premiumVotes: types.PremiumStore{
+				NumPremiums: 1,
+				AllMarketPremiums: []types.MarketPremiums{
+					{
+						PerpetualId: 0,
+						Premiums:    []int32{1, 2, 3}, // truncated to [1], median = 1
+					},
+					{
+						PerpetualId: 1,
+						Premiums:    []int32{5}, // median = 5
+					},
+				},
+			},
+			prevPremiumSamples: types.PremiumStore{},
+			expectedPremiumSamples: types.PremiumStore{
+				NumPremiums: 1,
+				AllMarketPremiums: []types.MarketPremiums{
+					{
+						PerpetualId: 0,
+						Premiums:    []int32{1},
+					},
+					{
+						PerpetualId: 1,
+						Premiums:    []int32{5},
+					},
+				},
+			},
+			expectedPremiumVotes: types.PremiumStore{}, // reset to empty
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			pc := keepertest.PerpetualsKeepers(t)
 			pc.Ctx = pc.Ctx.WithTxBytes(constants.TestTxBytes)
+			pc.PerpetualsKeeper.Flags.TruncatePremiumsOnOverflow = tc.truncatePremiumsOnOverflow
 
 			// Create funding-sample epoch.
 			err := pc.EpochsKeeper.CreateEpochInfo(
@@ -2576,6 +3079,7 @@ func TestMaybeProcessNewFundingSampleEpoch(t *testing.T) {
 					FundingRateClampFactorPpm: params.FundingRateClampFactorPpm,
 					PremiumVoteClampFactorPpm: params.PremiumVoteClampFactorPpm,
 					MinNumVotesPerSample:      tc.minNumVotesPerSample,
+					FundingRatePeriodSeconds:  params.FundingRatePeriodSeconds,
 				},
 			)
 			require.NoError(t, err)
@@ -2640,6 +3144,7 @@ func TestGetAllLiquidityTiers_Sorted(t *testing.T) {
 			lt.ImpactNotional,
 			lt.OpenInterestLowerCap,
 			lt.OpenInterestUpperCap,
+			lt.PremiumCombinationMethod,
 		)
 		require.NoError(t, err)
 	}
@@ -2680,6 +3185,7 @@ func TestHasLiquidityTier(t *testing.T) {
 			lt.ImpactNotional,
 			lt.OpenInterestLowerCap,
 			lt.OpenInterestUpperCap,
+			lt.PremiumCombinationMethod,
 		)
 		require.NoError(t, err)
 	}
@@ -2706,6 +3212,7 @@ func TestCreateLiquidityTier_Success(t *testing.T) {
 			lt.ImpactNotional,
 			lt.OpenInterestLowerCap,
 			lt.OpenInterestUpperCap,
+			lt.PremiumCombinationMethod,
 		)
 		require.NoError(t, err)
 
@@ -2725,14 +3232,15 @@ func TestCreateLiquidityTier_Success(t *testing.T) {
 
 func TestSetLiquidityTier_New_Failure(t *testing.T) {
 	tests := map[string]struct {
-		id                     uint32
-		name                   string
-		initialMarginPpm       uint32
-		maintenanceFractionPpm uint32
-		impactNotional         uint64
-		openInterestLowerCap   uint64
-		openInterestUpperCap   uint64
-		expectedError          error
+		id                       uint32
+		name                     string
+		initialMarginPpm         uint32
+		maintenanceFractionPpm   uint32
+		impactNotional           uint64
+		openInterestLowerCap     uint64
+		openInterestUpperCap     uint64
+		premiumCombinationMethod types.PremiumCombinationMethod
+		expectedError            error
 	}{
 		"Initial Margin Ppm exceeds maximum": {
 			id:                     0,
@@ -2781,6 +3289,7 @@ func TestSetLiquidityTier_New_Failure(t *testing.T) {
 				tc.impactNotional,
 				tc.openInterestLowerCap,
 				tc.openInterestUpperCap,
+				tc.premiumCombinationMethod,
 			)
 
 			require.Error(t, err)
@@ -2801,6 +3310,7 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 			lt.ImpactNotional,
 			lt.OpenInterestLowerCap,
 			lt.OpenInterestUpperCap,
+			lt.PremiumCombinationMethod,
 		)
 		require.NoError(t, err)
 	}
@@ -2814,6 +3324,7 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 		impactNotional := uint64((i + 1) * 500_000_000)
 		openInterestLowerCap := uint64(0)
 		openInterestUpperCap := uint64(0)
+		premiumCombinationMethod := types.PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEDIAN
 		modifiedLt, err := pc.PerpetualsKeeper.SetLiquidityTier(
 			pc.Ctx,
 			lt.Id,
@@ -2823,6 +3334,7 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 			impactNotional,
 			openInterestLowerCap,
 			openInterestUpperCap,
+			premiumCombinationMethod,
 		)
 		require.NoError(t, err)
 		obtainedLt, err := pc.PerpetualsKeeper.GetLiquidityTier(pc.Ctx, lt.Id)
@@ -2859,14 +3371,15 @@ func TestModifyLiquidityTier_Success(t *testing.T) {
 
 func TestSetLiquidityTier_Existing_Failure(t *testing.T) {
 	tests := map[string]struct {
-		id                     uint32
-		name                   string
-		initialMarginPpm       uint32
-		maintenanceFractionPpm uint32
-		impactNotional         uint64
-		openInterestLowerCap   uint64
-		openInterestUpperCap   uint64
-		expectedError          error
+		id                       uint32
+		name                     string
+		initialMarginPpm         uint32
+		maintenanceFractionPpm   uint32
+		impactNotional           uint64
+		openInterestLowerCap     uint64
+		openInterestUpperCap     uint64
+		premiumCombinationMethod types.PremiumCombinationMethod
+		expectedError            error
 	}{
 		"Initial Margin Ppm exceeds maximum": {
 			id:                     0,
@@ -2913,6 +3426,17 @@ func TestSetLiquidityTier_Existing_Failure(t *testing.T) {
 				25_000_000_000_000,
 			),
 		},
+		"Invalid premium combination method": {
+			id:                       1,
+			name:                     "Small-Cap",
+			initialMarginPpm:         500_000,
+			maintenanceFractionPpm:   lib.OneMillion,
+			impactNotional:           uint64(lib.OneMillion),
+			openInterestLowerCap:     0,
+			openInterestUpperCap:     0,
+			premiumCombinationMethod: types.PremiumCombinationMethod(2),
+			expectedError:            errorsmod.Wrap(types.ErrInvalidPremiumCombinationMethod, "2"),
+		},
 	}
 
 	// Test setup.
@@ -2931,6 +3455,7 @@ func TestSetLiquidityTier_Existing_Failure(t *testing.T) {
 				tc.impactNotional,
 				tc.openInterestLowerCap,
 				tc.openInterestUpperCap,
+				tc.premiumCombinationMethod,
 			)
 
 			require.Error(t, err)
@@ -2949,6 +3474,7 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 6_000_000,
 				PremiumVoteClampFactorPpm: 60_000_000,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 		},
 		"Failure: Funding Rate Clamp is 0": {
@@ -2956,6 +3482,7 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 0,
 				PremiumVoteClampFactorPpm: 60_000_000,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 			expectedErr: types.ErrFundingRateClampFactorPpmIsZero.Error(),
 		},
@@ -2964,6 +3491,7 @@ func TestSetParams(t *testing.T) {
 				FundingRateClampFactorPpm: 6_000_000,
 				PremiumVoteClampFactorPpm: 0,
 				MinNumVotesPerSample:      15,
+				FundingRatePeriodSeconds:  28_800,
 			},
 			expectedErr: types.ErrPremiumVoteClampFactorPpmIsZero.Error(),
 		},
@@ -36,6 +36,25 @@ func GetSettlementPpmWithPerpetual(
 	return result, fundingIndex
 }
 
+// GetSettlementWithRounding returns the net settlement amount (in quote quantums) given the perpetual
+// and position size (in base quantums), rounded according to `roundingMode`.
+//
+// Settlement of subaccounts rounds towards negative infinity (`RoundingModeFloor`) so that the total value
+// of all subaccounts does not increase. Other rounding modes, such as `RoundingModeTowardZero` and the
+// round-to-nearest `RoundingModeHalfEven`, are only intended for reporting and reconciliation.
+func GetSettlementWithRounding(
+	perpetual types.Perpetual,
+	quantums *big.Int,
+	index *big.Int,
+	roundingMode lib.RoundingMode,
+) (
+	bigNetSettlement *big.Int,
+	newFundingIndex *big.Int,
+) {
+	bigNetSettlementPpm, newFundingIndex := GetSettlementPpmWithPerpetual(perpetual, quantums, index)
+	return lib.BigDivRound(bigNetSettlementPpm, lib.BigIntOneMillion(), roundingMode), newFundingIndex
+}
+
 // GetPositionNetNotionalValueAndMarginRequirements returns the net collateral, initial margin requirement,
 // and maintenance margin requirement in quote quantums, given the position size in base quantums.
 func GetPositionNetNotionalValueAndMarginRequirements(
@@ -118,6 +137,10 @@ func GetMarginRequirementsInQuoteQuantums(
 	bigInitialMarginQuoteQuantums *big.Int,
 	bigMaintenanceMarginQuoteQuantums *big.Int,
 ) {
+	// Margin requirements use the initial margin override of the perpetual, if it is larger than
+	// the initial margin of its liquidity tier.
+	liquidityTier = perpetual.ApplyInitialMarginPpmOverride(liquidityTier)
+
 	// Always consider the magnitude of the position regardless of whether it is long/short.
 	bigAbsQuantums := new(big.Int).Abs(bigQuantums)
 
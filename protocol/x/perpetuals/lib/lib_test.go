@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	libcommon "github.com/dydxprotocol/v4-chain/protocol/lib"
 	big_testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/big"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
@@ -139,6 +140,107 @@ func TestGetSettlementPpmWithPerpetual(t *testing.T) {
 	}
 }
 
+func TestGetSettlementWithRounding(t *testing.T) {
+	tests := map[string]struct {
+		perpetual types.Perpetual
+		quantums  *big.Int
+		index     *big.Int
+
+		expectedFloor      *big.Int
+		expectedTowardZero *big.Int
+		expectedHalfEven   *big.Int
+	}{
+		"zero indexDelta": {
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(100)},
+			quantums:           big.NewInt(1_000_000),
+			index:              big.NewInt(100),
+			expectedFloor:      big.NewInt(0),
+			expectedTowardZero: big.NewInt(0),
+			expectedHalfEven:   big.NewInt(0),
+		},
+		"exact positive settlement": {
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(0)},
+			quantums:           big.NewInt(10_000_000),
+			index:              big.NewInt(100),
+			expectedFloor:      big.NewInt(1_000),
+			expectedTowardZero: big.NewInt(1_000),
+			expectedHalfEven:   big.NewInt(1_000),
+		},
+		"positive settlement, remainder below half": {
+			// Settlement of 1.2 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(0)},
+			quantums:           big.NewInt(-12_000),
+			index:              big.NewInt(-100),
+			expectedFloor:      big.NewInt(1),
+			expectedTowardZero: big.NewInt(1),
+			expectedHalfEven:   big.NewInt(1),
+		},
+		"positive settlement, remainder above half": {
+			// Settlement of 1.8 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(0)},
+			quantums:           big.NewInt(18_000),
+			index:              big.NewInt(100),
+			expectedFloor:      big.NewInt(1),
+			expectedTowardZero: big.NewInt(1),
+			expectedHalfEven:   big.NewInt(2),
+		},
+		"positive settlement, remainder exactly half": {
+			// Settlement of 2.5 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(0)},
+			quantums:           big.NewInt(25_000),
+			index:              big.NewInt(100),
+			expectedFloor:      big.NewInt(2),
+			expectedTowardZero: big.NewInt(2),
+			expectedHalfEven:   big.NewInt(2),
+		},
+		"negative settlement, remainder below half": {
+			// Settlement of -1.2 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(100)},
+			quantums:           big.NewInt(12_000),
+			index:              big.NewInt(0),
+			expectedFloor:      big.NewInt(-2),
+			expectedTowardZero: big.NewInt(-1),
+			expectedHalfEven:   big.NewInt(-1),
+		},
+		"negative settlement, remainder above half": {
+			// Settlement of -1.8 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(-100)},
+			quantums:           big.NewInt(-18_000),
+			index:              big.NewInt(0),
+			expectedFloor:      big.NewInt(-2),
+			expectedTowardZero: big.NewInt(-1),
+			expectedHalfEven:   big.NewInt(-2),
+		},
+		"negative settlement, remainder exactly half": {
+			// Settlement of -3.5 quote quantums.
+			perpetual:          types.Perpetual{FundingIndex: dtypes.NewInt(100)},
+			quantums:           big.NewInt(35_000),
+			index:              big.NewInt(0),
+			expectedFloor:      big.NewInt(-4),
+			expectedTowardZero: big.NewInt(-3),
+			expectedHalfEven:   big.NewInt(-4),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for mode, expected := range map[libcommon.RoundingMode]*big.Int{
+				libcommon.RoundingModeFloor:      tc.expectedFloor,
+				libcommon.RoundingModeTowardZero: tc.expectedTowardZero,
+				libcommon.RoundingModeHalfEven:   tc.expectedHalfEven,
+			} {
+				netSettlement, newFundingIndex := lib.GetSettlementWithRounding(
+					tc.perpetual,
+					tc.quantums,
+					tc.index,
+					mode,
+				)
+				require.Zero(t, expected.Cmp(netSettlement), "%v: expected %v, got %v", mode, expected, netSettlement)
+				require.Equal(t, tc.perpetual.FundingIndex.BigInt(), newFundingIndex)
+			}
+		})
+	}
+}
+
 func TestGetNetCollateralAndMarginRequirements(t *testing.T) {
 	testPerpetual := types.Perpetual{
 		Params: types.PerpetualParams{
@@ -370,6 +472,35 @@ func TestGetMarginRequirementsInQuoteQuantums(t *testing.T) {
 			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("12345678912300000", 10)),
 			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("1234567891230000", 10)),
 		},
+		"positive quantums, initial margin override above liquidity tier": {
+			perpetual: types.Perpetual{
+				Params: types.PerpetualParams{
+					AtomicResolution:         4,
+					InitialMarginPpmOverride: 400_000,
+				},
+				OpenInterest: dtypes.NewInt(1_000_000_000_000),
+			},
+			marketPrice:   testMarketPrice,
+			liquidityTier: testLiquidityTier,
+			quantums:      big.NewInt(1),
+			// Maintenance margin is still half of the (overridden) initial margin.
+			expectedImr: big_testutil.MustFirst(new(big.Int).SetString("4938271564920000", 10)),
+			expectedMmr: big_testutil.MustFirst(new(big.Int).SetString("2469135782460000", 10)),
+		},
+		"positive quantums, initial margin override below liquidity tier": {
+			perpetual: types.Perpetual{
+				Params: types.PerpetualParams{
+					AtomicResolution:         4,
+					InitialMarginPpmOverride: 100_000,
+				},
+				OpenInterest: dtypes.NewInt(1_000_000_000_000),
+			},
+			marketPrice:   testMarketPrice,
+			liquidityTier: testLiquidityTier,
+			quantums:      big.NewInt(1),
+			expectedImr:   big_testutil.MustFirst(new(big.Int).SetString("2469135782460000", 10)),
+			expectedMmr:   big_testutil.MustFirst(new(big.Int).SetString("1234567891230000", 10)),
+		},
 		"negative quantums": {
 			perpetual:     testPerpetual,
 			marketPrice:   testMarketPrice,
@@ -672,6 +803,7 @@ func TestGetMarginRequirementsInQuoteQuantums_2(t *testing.T) {
 				1, // dummy impact notional value
 				tc.openInterestLowerCap,
 				tc.openInterestUpperCap,
+				types.PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEAN,
 			)
 			require.NoError(t, err)
 
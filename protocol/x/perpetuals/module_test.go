@@ -104,7 +104,8 @@ func TestAppModuleBasic_DefaultGenesis(t *testing.T) {
 	require.Equal(
 		t,
 		`{"perpetuals":[],"liquidity_tiers":[],"params":{"funding_rate_clamp_factor_ppm":6000000,`+
-			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15}}`,
+			`"premium_vote_clamp_factor_ppm":60000000,"min_num_votes_per_sample":15,`+
+			`"funding_rate_period_seconds":28800}}`,
 		string(json),
 	)
 }
@@ -136,7 +137,8 @@ func TestAppModuleBasic_ValidateGenesisErrBadState(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800
 		}
 	 }`)
 
@@ -161,7 +163,8 @@ func TestAppModuleBasic_ValidateGenesis(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800
 		}
 	 }`)
 
@@ -211,13 +214,19 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "perpetuals", cmd.Use)
-	require.Equal(t, 6, len(cmd.Commands()))
+	require.Equal(t, 12, len(cmd.Commands()))
 	require.Equal(t, "get-all-liquidity-tiers", cmd.Commands()[0].Name())
-	require.Equal(t, "get-params", cmd.Commands()[1].Name())
-	require.Equal(t, "get-premium-samples", cmd.Commands()[2].Name())
-	require.Equal(t, "get-premium-votes", cmd.Commands()[3].Name())
-	require.Equal(t, "list-perpetual", cmd.Commands()[4].Name())
-	require.Equal(t, "show-perpetual", cmd.Commands()[5].Name())
+	require.Equal(t, "get-all-open-interest", cmd.Commands()[1].Name())
+	require.Equal(t, "get-current-funding-rate", cmd.Commands()[2].Name())
+	require.Equal(t, "get-margin-fractions", cmd.Commands()[3].Name())
+	require.Equal(t, "get-net-funding", cmd.Commands()[4].Name())
+	require.Equal(t, "get-params", cmd.Commands()[5].Name())
+	require.Equal(t, "get-perpetual-metadata", cmd.Commands()[6].Name())
+	require.Equal(t, "get-premium-accumulation-status", cmd.Commands()[7].Name())
+	require.Equal(t, "get-premium-samples", cmd.Commands()[8].Name())
+	require.Equal(t, "get-premium-votes", cmd.Commands()[9].Name())
+	require.Equal(t, "list-perpetual", cmd.Commands()[10].Name())
+	require.Equal(t, "show-perpetual", cmd.Commands()[11].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
@@ -291,7 +300,8 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800
 		}
 	}`
 	gs := json.RawMessage(msg)
@@ -315,10 +325,12 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 				 "atomic_resolution":0,
 				 "default_funding_ppm":0,
 				 "liquidity_tier":0,
-				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS"
+				 "market_type":"PERPETUAL_MARKET_TYPE_CROSS",
+				 "initial_margin_ppm_override":0
 			  },
 			  "funding_index":"0",
-			  "open_interest":"0"
+			  "open_interest":"0",
+			  "delisted_settlement_price":null
 		   }
 		],
 		"liquidity_tiers":[
@@ -330,13 +342,15 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 			  "base_position_notional":"0",
 			  "impact_notional":"10000000000",
 			  "open_interest_lower_cap":"25000000000000",
-			  "open_interest_upper_cap":"50000000000000"
+			  "open_interest_upper_cap":"50000000000000",
+			  "premium_combination_method":"PREMIUM_COMBINATION_METHOD_MEAN"
 		   }
 		],
 		"params":{
 		   "funding_rate_clamp_factor_ppm":6000000,
 		   "premium_vote_clamp_factor_ppm":60000000,
-		   "min_num_votes_per_sample":15
+		   "min_num_votes_per_sample":15,
+		   "funding_rate_period_seconds":28800
 		}
 	 }`
 	require.Equal(t,
@@ -108,6 +108,7 @@ func genParams(r *rand.Rand, isReasonableGenesis bool) types.Params {
 		FundingRateClampFactorPpm: genFundingRateClampFactorPpm(r, isReasonableGenesis),
 		PremiumVoteClampFactorPpm: genPremiumVoteClampFactorPpm(r, isReasonableGenesis),
 		MinNumVotesPerSample:      genMinNumVotesPerSample(r, isReasonableGenesis),
+		FundingRatePeriodSeconds:  types.DefaultFundingRatePeriodSeconds,
 	}
 }
 
@@ -8,4 +8,13 @@ const (
 	// taking the average.
 	// TODO(DEC-1105): Move this constant to state so that it can be changed via governance.
 	RemovedTailSampleRatioPpm uint32 = 0
+
+	// FundingIndexSnapshotRetentionEpochs is the number of most recent `funding-tick` epochs for which
+	// a snapshot of the funding index of each perpetual is retained in state. With hourly funding ticks,
+	// this retains 30 days of snapshots.
+	FundingIndexSnapshotRetentionEpochs uint32 = 24 * 30
+
+	// NetFundingRetentionEpochs is the number of most recent `funding-tick` epochs for which the net
+	// funding of each perpetual is retained in state. Older epochs are pruned on each funding tick.
+	NetFundingRetentionEpochs uint32 = 24 * 30
 )
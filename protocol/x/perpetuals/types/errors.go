@@ -127,6 +127,31 @@ var (
 		26,
 		"PerpetualInfo does not exist",
 	)
+	ErrFundingIndexSnapshotNotFound = errorsmod.Register(
+		ModuleName,
+		27,
+		"Funding index snapshot not found",
+	)
+	ErrPerpetualMarketAlreadyDelisted = errorsmod.Register(
+		ModuleName,
+		28,
+		"Perpetual market is already delisted",
+	)
+	ErrFundingRatePeriodSecondsIsZero = errorsmod.Register(
+		ModuleName,
+		29,
+		"Funding rate period seconds is zero",
+	)
+	ErrInvalidPremiumCombinationMethod = errorsmod.Register(
+		ModuleName,
+		30,
+		"Invalid premium combination method",
+	)
+	ErrSampleTailsRemovalExceedsNumSamples = errorsmod.Register(
+		ModuleName,
+		31,
+		"Number of premium samples to remove from the tails is not less than the number of samples",
+	)
 
 	// Errors for Not Implemented
 	ErrNotImplementedFunding = errorsmod.Register(ModuleName, 1001, "Not Implemented: Perpetuals Funding")
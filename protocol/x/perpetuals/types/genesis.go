@@ -13,6 +13,8 @@ const (
 	DefaultPremiumVoteClampFactorPpm = 60 * lib.OneMillion
 	// Minimum number of votes per sample is by default 15.
 	DefaultMinNumVotesPerSample = 15
+	// Funding rate period is by default 8 hours.
+	DefaultFundingRatePeriodSeconds = 60 * 60 * 8
 
 	// Maximum default funding rate magnitude is 100%.
 	MaxDefaultFundingPpmAbs = lib.OneMillion
@@ -31,6 +33,7 @@ func DefaultGenesis() *GenesisState {
 			FundingRateClampFactorPpm: DefaultFundingRateClampFactorPpm,
 			PremiumVoteClampFactorPpm: DefaultPremiumVoteClampFactorPpm,
 			MinNumVotesPerSample:      DefaultMinNumVotesPerSample,
+			FundingRatePeriodSeconds:  DefaultFundingRatePeriodSeconds,
 		},
 	}
 }
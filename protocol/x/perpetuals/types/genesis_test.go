@@ -43,6 +43,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 3_000_000,
 					PremiumVoteClampFactorPpm: 30_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: nil,
@@ -80,6 +81,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("duplicated perpetual id"),
@@ -117,6 +119,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("found a gap in perpetual id"),
@@ -146,6 +149,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Ticker must be non-empty string"),
@@ -175,6 +179,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("InitialMarginPpm exceeds maximum value of 1e6"),
@@ -204,6 +209,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("MaintenanceFractionPpm exceeds maximum value of 1e6"),
@@ -233,6 +239,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 0,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Funding rate clamp factor ppm is zero"),
@@ -262,6 +269,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 0,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Premium vote clamp factor ppm is zero"),
@@ -291,6 +299,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      0,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("MinNumVotesPerSample is zero"),
@@ -320,6 +329,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					FundingRateClampFactorPpm: 6_000_000,
 					PremiumVoteClampFactorPpm: 60_000_000,
 					MinNumVotesPerSample:      15,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedError: errors.New("Impact notional is zero"),
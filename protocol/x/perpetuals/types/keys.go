@@ -37,6 +37,30 @@ const (
 
 	// NextPerpetualIDKey is the key to retrieve the next perpetual id to be used
 	NextPerpetualIDKey = "NextPerpetualID"
+
+	// NetFundingKeyPrefix is the prefix to retrieve the `PerpetualNetFunding` of
+	// each perpetual for a `funding-tick` epoch.
+	NetFundingKeyPrefix = "NetFunding:"
+
+	// BlockNetFundingKeyPrefix is the prefix to retrieve the `PerpetualNetFunding` of
+	// each perpetual settled during the current block from the transient store.
+	BlockNetFundingKeyPrefix = "BlockNetFunding:"
+
+	// FundingIndexSnapshotKeyPrefix is the prefix to retrieve the
+	// `PerpetualFundingIndexSnapshot`s of each perpetual.
+	FundingIndexSnapshotKeyPrefix = "FundingIdxSnap:"
+
+	// PerpetualMetadataKeyPrefix is the prefix to retrieve the `PerpetualMetadata`
+	// of each perpetual.
+	PerpetualMetadataKeyPrefix = "PerpMetadata:"
+
+	// PerpetualByMarketKeyPrefix is the prefix of the secondary index of perpetual ids
+	// keyed by the id of the market they reference.
+	PerpetualByMarketKeyPrefix = "PerpByMarket:"
+
+	// FundingRateKeyPrefix is the prefix to retrieve the `PerpetualFundingRate`
+	// of each perpetual computed in the most recent `funding-tick` epoch.
+	FundingRateKeyPrefix = "FundingRate:"
 )
 
 // Module Accounts
@@ -11,6 +11,7 @@ import (
 
 // - Initial margin is less than or equal to 1.
 // - Maintenance fraction is less than or equal to 1.
+// - Premium combination method is a known method.
 func (liquidityTier LiquidityTier) Validate() error {
 	if liquidityTier.InitialMarginPpm > MaxInitialMarginPpm {
 		return errorsmod.Wrap(ErrInitialMarginPpmExceedsMax, lib.UintToString(liquidityTier.InitialMarginPpm))
@@ -34,6 +35,13 @@ func (liquidityTier LiquidityTier) Validate() error {
 		)
 	}
 
+	if _, exists := PremiumCombinationMethod_name[int32(liquidityTier.PremiumCombinationMethod)]; !exists {
+		return errorsmod.Wrap(
+			ErrInvalidPremiumCombinationMethod,
+			liquidityTier.PremiumCombinationMethod.String(),
+		)
+	}
+
 	return nil
 }
 
@@ -55,6 +63,15 @@ func (liquidityTier LiquidityTier) GetMaintenanceMarginPpm() uint32 {
 	return uint32(bigMaintenanceMarginPpm.Uint64())
 }
 
+// GetPremiumCombineFunc returns the function used to combine the premium samples of perpetuals
+// in the liquidity tier into a premium rate.
+func (liquidityTier LiquidityTier) GetPremiumCombineFunc() func([]int32) int32 {
+	if liquidityTier.PremiumCombinationMethod == PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEDIAN {
+		return lib.MustGetMedian[int32]
+	}
+	return lib.AvgInt32
+}
+
 // `GetMaxAbsFundingClampPpm` returns the maximum absolute value according to the funding clamp function:
 // `|S| ≤ Clamp Factor * (Initial Margin - Maintenance Margin)`, which can be applied to both
 // funding rate clamping and premium vote clamping, each having their own clamp factor.
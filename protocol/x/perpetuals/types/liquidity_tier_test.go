@@ -11,12 +11,13 @@ import (
 
 func TestLiquidityTierValidate(t *testing.T) {
 	tests := map[string]struct {
-		initialMarginPpm       uint32
-		maintenanceFractionPpm uint32
-		ImpactNotional         uint64
-		openInterestLowerCap   uint64
-		openInterestUpperCap   uint64
-		expectedError          error
+		initialMarginPpm         uint32
+		maintenanceFractionPpm   uint32
+		ImpactNotional           uint64
+		openInterestLowerCap     uint64
+		openInterestUpperCap     uint64
+		premiumCombinationMethod types.PremiumCombinationMethod
+		expectedError            error
 	}{
 		"Validates successfully": {
 			initialMarginPpm:       150_000,       // 15%
@@ -58,17 +59,32 @@ func TestLiquidityTierValidate(t *testing.T) {
 			openInterestUpperCap:   0,
 			expectedError:          types.ErrOpenInterestLowerCapLargerThanUpperCap,
 		},
+		"Validates successfully with median premium combination method": {
+			initialMarginPpm:         150_000,       // 15%
+			maintenanceFractionPpm:   800_000,       // 80% of IM
+			ImpactNotional:           3_333_000_000, // 3_333 USDC
+			premiumCombinationMethod: types.PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEDIAN,
+			expectedError:            nil,
+		},
+		"Failure: invalid premium combination method": {
+			initialMarginPpm:         150_000,       // 15%
+			maintenanceFractionPpm:   800_000,       // 80% of IM
+			ImpactNotional:           3_333_000_000, // 3_333 USDC
+			premiumCombinationMethod: types.PremiumCombinationMethod(2),
+			expectedError:            types.ErrInvalidPremiumCombinationMethod,
+		},
 	}
 
 	// Run tests.
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			liquidityTier := &types.LiquidityTier{
-				InitialMarginPpm:       tc.initialMarginPpm,
-				MaintenanceFractionPpm: tc.maintenanceFractionPpm,
-				ImpactNotional:         tc.ImpactNotional,
-				OpenInterestLowerCap:   tc.openInterestLowerCap,
-				OpenInterestUpperCap:   tc.openInterestUpperCap,
+				InitialMarginPpm:         tc.initialMarginPpm,
+				MaintenanceFractionPpm:   tc.maintenanceFractionPpm,
+				ImpactNotional:           tc.ImpactNotional,
+				OpenInterestLowerCap:     tc.openInterestLowerCap,
+				OpenInterestUpperCap:     tc.openInterestUpperCap,
+				PremiumCombinationMethod: tc.premiumCombinationMethod,
 			}
 
 			err := liquidityTier.Validate()
@@ -19,6 +19,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 400_000,
 					PremiumVoteClampFactorPpm: 400_000,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 		},
@@ -35,6 +36,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 0,
 					PremiumVoteClampFactorPpm: 400_000,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "Funding rate clamp factor ppm is zero",
@@ -46,6 +48,7 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 					FundingRateClampFactorPpm: 400_000,
 					PremiumVoteClampFactorPpm: 0,
 					MinNumVotesPerSample:      5,
+					FundingRatePeriodSeconds:  28_800,
 				},
 			},
 			expectedErr: "Premium vote clamp factor ppm is zero",
@@ -11,6 +11,9 @@ func (params Params) Validate() error {
 	if params.MinNumVotesPerSample == 0 {
 		return ErrMinNumVotesPerSampleIsZero
 	}
+	if params.FundingRatePeriodSeconds == 0 {
+		return ErrFundingRatePeriodSecondsIsZero
+	}
 
 	return nil
 }
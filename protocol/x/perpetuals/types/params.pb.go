@@ -35,6 +35,10 @@ type Params struct {
 	// Minimum number of premium votes per premium sample. If number of premium
 	// votes is smaller than this number, pad with zeros up to this number.
 	MinNumVotesPerSample uint32 `protobuf:"varint,3,opt,name=min_num_votes_per_sample,json=minNumVotesPerSample,proto3" json:"min_num_votes_per_sample,omitempty"`
+	// Length of the funding rate period in seconds. Funding rates are expressed
+	// per funding rate period and are pro-rated by the time since the last
+	// funding tick when updating funding indices.
+	FundingRatePeriodSeconds uint32 `protobuf:"varint,4,opt,name=funding_rate_period_seconds,json=fundingRatePeriodSeconds,proto3" json:"funding_rate_period_seconds,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -91,6 +95,13 @@ func (m *Params) GetMinNumVotesPerSample() uint32 {
 	return 0
 }
 
+func (m *Params) GetFundingRatePeriodSeconds() uint32 {
+	if m != nil {
+		return m.FundingRatePeriodSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dydxprotocol.perpetuals.Params")
 }
@@ -100,24 +111,25 @@ func init() {
 }
 
 var fileDescriptor_8b16af88c7880f7e = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x0a, 0x1d, 0x02, 0x2e, 0x45, 0x30, 0x0e, 0x1e, 0x22, 0x0e, 0x2e, 0x26, 0x83,
-	0xe2, 0xe4, 0x20, 0x0a, 0x8e, 0x12, 0x2a, 0x74, 0x70, 0x39, 0xae, 0x97, 0xd7, 0xf6, 0x20, 0xef,
-	0xee, 0x71, 0x77, 0x29, 0xed, 0xb7, 0xf0, 0x43, 0x39, 0x38, 0x76, 0x74, 0x94, 0xe4, 0x8b, 0x48,
-	0xaf, 0x41, 0x2b, 0xba, 0xbe, 0xdf, 0xef, 0xfd, 0x86, 0x7f, 0x7a, 0x5e, 0xad, 0xaa, 0x25, 0x39,
-	0x1b, 0xac, 0xb2, 0x75, 0x41, 0xe0, 0x08, 0x42, 0x23, 0x6b, 0x5f, 0x90, 0x74, 0x12, 0x7d, 0x1e,
-	0xd1, 0xf0, 0x68, 0xd7, 0xca, 0x7f, 0xac, 0xb3, 0x37, 0x96, 0x0e, 0xca, 0x68, 0x0e, 0xef, 0xd2,
-	0x93, 0x69, 0x63, 0x2a, 0x6d, 0x66, 0xc2, 0xc9, 0x00, 0x42, 0xd5, 0x12, 0x49, 0x4c, 0xa5, 0x0a,
-	0xd6, 0x09, 0x22, 0xcc, 0xd8, 0x29, 0xbb, 0x38, 0x18, 0x1d, 0xf7, 0xd2, 0x48, 0x06, 0x78, 0xd8,
-	0x28, 0x8f, 0xd1, 0x28, 0x09, 0x37, 0x05, 0x72, 0x80, 0xba, 0x41, 0xb1, 0xb0, 0xff, 0x15, 0xf6,
-	0xb6, 0x85, 0x5e, 0x1a, 0xdb, 0x3f, 0x85, 0x9b, 0x34, 0x43, 0x6d, 0x84, 0xe9, 0x0b, 0x5e, 0x10,
-	0x38, 0xe1, 0x25, 0x52, 0x0d, 0xd9, 0x7e, 0x7c, 0x3e, 0x44, 0x6d, 0x9e, 0xb6, 0xbf, 0xbe, 0x04,
-	0xf7, 0x1c, 0xd9, 0xfd, 0xf8, 0xbd, 0xe5, 0x6c, 0xdd, 0x72, 0xf6, 0xd9, 0x72, 0xf6, 0xda, 0xf1,
-	0x64, 0xdd, 0xf1, 0xe4, 0xa3, 0xe3, 0xc9, 0xcb, 0xed, 0x4c, 0x87, 0x79, 0x33, 0xc9, 0x95, 0xc5,
-	0xe2, 0xd7, 0x54, 0x8b, 0xeb, 0x4b, 0x35, 0x97, 0xda, 0x14, 0xdf, 0x97, 0xe5, 0xee, 0x7c, 0x61,
-	0x45, 0xe0, 0x27, 0x83, 0x08, 0xaf, 0xbe, 0x02, 0x00, 0x00, 0xff, 0xff, 0x45, 0x37, 0xf4, 0x5b,
-	0x66, 0x01, 0x00, 0x00,
+	0x18, 0x86, 0x93, 0x2a, 0x1d, 0x02, 0x2e, 0x41, 0x30, 0x22, 0x1e, 0x22, 0x0e, 0x2e, 0x26, 0x83,
+	0xe2, 0xa4, 0x20, 0x0a, 0x8e, 0x12, 0x5a, 0xe8, 0xe0, 0x72, 0x5c, 0x2f, 0x5f, 0xdb, 0x83, 0xdc,
+	0xdd, 0xc7, 0xdd, 0xa5, 0xb4, 0xb3, 0x7f, 0xc0, 0x9f, 0xe5, 0xd8, 0xd1, 0x51, 0x92, 0x3f, 0x22,
+	0xbd, 0x04, 0x4d, 0xd1, 0xf5, 0x7b, 0x9f, 0xf7, 0xe1, 0xe3, 0x8d, 0x2e, 0x8a, 0x75, 0xb1, 0x42,
+	0xa3, 0x9d, 0xe6, 0xba, 0xcc, 0x10, 0x0c, 0x82, 0xab, 0x58, 0x69, 0x33, 0x64, 0x86, 0x49, 0x9b,
+	0xfa, 0x28, 0x3e, 0xea, 0x53, 0xe9, 0x2f, 0x75, 0xfe, 0x36, 0x88, 0x86, 0xb9, 0x27, 0xe3, 0x87,
+	0xe8, 0x74, 0x56, 0xa9, 0x42, 0xa8, 0x39, 0x35, 0xcc, 0x01, 0xe5, 0x25, 0x93, 0x48, 0x67, 0x8c,
+	0x3b, 0x6d, 0x28, 0xa2, 0x4c, 0xc2, 0xb3, 0xf0, 0xf2, 0x60, 0x74, 0xdc, 0x41, 0x23, 0xe6, 0xe0,
+	0x69, 0x8b, 0x3c, 0x7b, 0x22, 0x47, 0xb9, 0x35, 0xa0, 0x01, 0x29, 0x2a, 0x49, 0x97, 0xfa, 0x3f,
+	0xc3, 0xa0, 0x35, 0x74, 0xd0, 0x44, 0xff, 0x31, 0xdc, 0x46, 0x89, 0x14, 0x8a, 0xaa, 0xce, 0x60,
+	0x29, 0x82, 0xa1, 0x96, 0x49, 0x2c, 0x21, 0xd9, 0xf3, 0xe5, 0x43, 0x29, 0xd4, 0x4b, 0xdb, 0xb5,
+	0x39, 0x98, 0xb1, 0xcf, 0xe2, 0xfb, 0xe8, 0x64, 0xe7, 0x77, 0x04, 0x23, 0x74, 0x41, 0x2d, 0x70,
+	0xad, 0x0a, 0x9b, 0xec, 0xfb, 0x6a, 0xd2, 0xfb, 0x3c, 0xf7, 0xc0, 0xb8, 0xcd, 0x1f, 0x27, 0x1f,
+	0x35, 0x09, 0x37, 0x35, 0x09, 0xbf, 0x6a, 0x12, 0xbe, 0x37, 0x24, 0xd8, 0x34, 0x24, 0xf8, 0x6c,
+	0x48, 0xf0, 0x7a, 0x37, 0x17, 0x6e, 0x51, 0x4d, 0x53, 0xae, 0x65, 0xb6, 0xb3, 0xf4, 0xf2, 0xe6,
+	0x8a, 0x2f, 0x98, 0x50, 0xd9, 0xcf, 0x65, 0xd5, 0x5f, 0xdf, 0xad, 0x11, 0xec, 0x74, 0xe8, 0xc3,
+	0xeb, 0xef, 0x01, 0x00, 0x97, 0x10, 0x25, 0x0f, 0xa5, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +152,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FundingRatePeriodSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FundingRatePeriodSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.MinNumVotesPerSample != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinNumVotesPerSample))
 		i--
@@ -184,6 +201,9 @@ func (m *Params) Size() (n int) {
 	if m.MinNumVotesPerSample != 0 {
 		n += 1 + sovParams(uint64(m.MinNumVotesPerSample))
 	}
+	if m.FundingRatePeriodSeconds != 0 {
+		n += 1 + sovParams(uint64(m.FundingRatePeriodSeconds))
+	}
 	return n
 }
 
@@ -279,6 +299,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePeriodSeconds", wireType)
+			}
+			m.FundingRatePeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePeriodSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
@@ -12,6 +13,21 @@ func (p *Perpetual) GetId() uint32 {
 	return p.Params.Id
 }
 
+// IsMarketDelisted returns true if the market associated with this perpetual has been delisted.
+func (p *Perpetual) IsMarketDelisted() bool {
+	return p.DelistedSettlementPrice != nil
+}
+
+// ApplyInitialMarginPpmOverride returns the liquidity tier of the perpetual with its initial margin
+// raised to the initial margin override of the perpetual, if the override is larger. The maintenance
+// margin of the returned tier remains a fraction `MaintenanceFractionPpm` of its initial margin.
+func (p *Perpetual) ApplyInitialMarginPpmOverride(liquidityTier LiquidityTier) LiquidityTier {
+	if p.Params.InitialMarginPpmOverride > liquidityTier.InitialMarginPpm {
+		liquidityTier.InitialMarginPpm = p.Params.InitialMarginPpmOverride
+	}
+	return liquidityTier
+}
+
 // Stateless validation on Perpetual params.
 func (p *PerpetualParams) Validate() error {
 	// Check if market type is valid
@@ -36,5 +52,20 @@ func (p *PerpetualParams) Validate() error {
 			lib.IntToString(p.DefaultFundingPpm))
 	}
 
+	// Validate `initialMarginPpmOverride`.
+	if p.InitialMarginPpmOverride > MaxInitialMarginPpm {
+		return errorsmod.Wrap(
+			ErrInitialMarginPpmExceedsMax,
+			lib.UintToString(p.InitialMarginPpmOverride),
+		)
+	}
+
 	return nil
 }
+
+// PerpetualPositionLite is a perpetual position, identified by the id of its perpetual and its signed
+// size in base quantums. Longs are positive, and shorts are negative.
+type PerpetualPositionLite struct {
+	PerpetualId uint32
+	Quantums    *big.Int
+}
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	types "github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -55,6 +56,36 @@ func (PerpetualMarketType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ce7204eee10038be, []int{0}
 }
 
+// PremiumCombinationMethod is the method used to combine premium samples into
+// a premium rate.
+type PremiumCombinationMethod int32
+
+const (
+	// The mean of the premium samples, after removing the tails. This is the
+	// default method.
+	PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEAN PremiumCombinationMethod = 0
+	// The median of the premium samples.
+	PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEDIAN PremiumCombinationMethod = 1
+)
+
+var PremiumCombinationMethod_name = map[int32]string{
+	0: "PREMIUM_COMBINATION_METHOD_MEAN",
+	1: "PREMIUM_COMBINATION_METHOD_MEDIAN",
+}
+
+var PremiumCombinationMethod_value = map[string]int32{
+	"PREMIUM_COMBINATION_METHOD_MEAN":   0,
+	"PREMIUM_COMBINATION_METHOD_MEDIAN": 1,
+}
+
+func (x PremiumCombinationMethod) String() string {
+	return proto.EnumName(PremiumCombinationMethod_name, int32(x))
+}
+
+func (PremiumCombinationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{1}
+}
+
 // Perpetual represents a perpetual on the dYdX exchange.
 type Perpetual struct {
 	// PerpetualParams is the parameters of the perpetual.
@@ -64,6 +95,11 @@ type Perpetual struct {
 	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
 	// Total size of open long contracts, measured in base_quantums.
 	OpenInterest github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=open_interest,json=openInterest,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"open_interest"`
+	// The last-known market price of the perpetual, recorded when its market was
+	// delisted. Unset unless the market is delisted. Once set, it is used in
+	// place of the oracle price and only position-reducing activity is allowed
+	// on the perpetual's clob pairs.
+	DelistedSettlementPrice *types.MarketPrice `protobuf:"bytes,4,opt,name=delisted_settlement_price,json=delistedSettlementPrice,proto3" json:"delisted_settlement_price,omitempty"`
 }
 
 func (m *Perpetual) Reset()         { *m = Perpetual{} }
@@ -106,6 +142,13 @@ func (m *Perpetual) GetParams() PerpetualParams {
 	return PerpetualParams{}
 }
 
+func (m *Perpetual) GetDelistedSettlementPrice() *types.MarketPrice {
+	if m != nil {
+		return m.DelistedSettlementPrice
+	}
+	return nil
+}
+
 // PerpetualParams represents the parameters of a perpetual on the dYdX
 // exchange.
 type PerpetualParams struct {
@@ -129,6 +172,11 @@ type PerpetualParams struct {
 	LiquidityTier uint32 `protobuf:"varint,6,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
 	// The market type specifying if this perpetual is cross or isolated
 	MarketType PerpetualMarketType `protobuf:"varint,7,opt,name=market_type,json=marketType,proto3,enum=dydxprotocol.perpetuals.PerpetualMarketType" json:"market_type,omitempty"`
+	// An optional initial margin fraction of the perpetual, in parts-per-million.
+	// The initial margin fraction of the perpetual is the larger of this value
+	// and the initial margin fraction of its liquidity tier. Zero means no
+	// override.
+	InitialMarginPpmOverride uint32 `protobuf:"varint,8,opt,name=initial_margin_ppm_override,json=initialMarginPpmOverride,proto3" json:"initial_margin_ppm_override,omitempty"`
 }
 
 func (m *PerpetualParams) Reset()         { *m = PerpetualParams{} }
@@ -213,6 +261,13 @@ func (m *PerpetualParams) GetMarketType() PerpetualMarketType {
 	return PerpetualMarketType_PERPETUAL_MARKET_TYPE_UNSPECIFIED
 }
 
+func (m *PerpetualParams) GetInitialMarginPpmOverride() uint32 {
+	if m != nil {
+		return m.InitialMarginPpmOverride
+	}
+	return 0
+}
+
 // MarketPremiums stores a list of premiums for a single perpetual market.
 type MarketPremiums struct {
 	// perpetual_id is the Id of the perpetual market.
@@ -336,6 +391,57 @@ func (m *PremiumStore) GetNumPremiums() uint32 {
 	return 0
 }
 
+// PerpetualNetFunding stores the net funding settled by all positions of a
+// single perpetual over a `funding-tick` epoch.
+type PerpetualNetFunding struct {
+	// perpetual_id is the Id of the perpetual market.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The sum of all funding payments settled by positions in the perpetual,
+	// in quote quantums. Positive if positions paid more funding than they
+	// received.
+	NetFundingPaid github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=net_funding_paid,json=netFundingPaid,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_funding_paid"`
+}
+
+func (m *PerpetualNetFunding) Reset()         { *m = PerpetualNetFunding{} }
+func (m *PerpetualNetFunding) String() string { return proto.CompactTextString(m) }
+func (*PerpetualNetFunding) ProtoMessage()    {}
+func (*PerpetualNetFunding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{4}
+}
+func (m *PerpetualNetFunding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualNetFunding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualNetFunding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualNetFunding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualNetFunding.Merge(m, src)
+}
+func (m *PerpetualNetFunding) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualNetFunding) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualNetFunding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualNetFunding proto.InternalMessageInfo
+
+func (m *PerpetualNetFunding) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
 // LiquidityTier stores margin information.
 type LiquidityTier struct {
 	// Unique id.
@@ -369,13 +475,16 @@ type LiquidityTier struct {
 	// IMF scales linearly to 100% as OI approaches open_interest_upper_cap.
 	// If zero, then the IMF does not scale with OI.
 	OpenInterestUpperCap uint64 `protobuf:"varint,8,opt,name=open_interest_upper_cap,json=openInterestUpperCap,proto3" json:"open_interest_upper_cap,omitempty"`
+	// The method used to combine the premium samples of a `funding-tick` epoch
+	// into the premium rate of perpetuals in this tier.
+	PremiumCombinationMethod PremiumCombinationMethod `protobuf:"varint,9,opt,name=premium_combination_method,json=premiumCombinationMethod,proto3,enum=dydxprotocol.perpetuals.PremiumCombinationMethod" json:"premium_combination_method,omitempty"`
 }
 
 func (m *LiquidityTier) Reset()         { *m = LiquidityTier{} }
 func (m *LiquidityTier) String() string { return proto.CompactTextString(m) }
 func (*LiquidityTier) ProtoMessage()    {}
 func (*LiquidityTier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce7204eee10038be, []int{4}
+	return fileDescriptor_ce7204eee10038be, []int{5}
 }
 func (m *LiquidityTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,13 +570,206 @@ func (m *LiquidityTier) GetOpenInterestUpperCap() uint64 {
 	return 0
 }
 
+func (m *LiquidityTier) GetPremiumCombinationMethod() PremiumCombinationMethod {
+	if m != nil {
+		return m.PremiumCombinationMethod
+	}
+	return PremiumCombinationMethod_PREMIUM_COMBINATION_METHOD_MEAN
+}
+
+// PerpetualFundingIndexSnapshot stores the funding index of a perpetual at the
+// start of a `funding-tick` epoch.
+type PerpetualFundingIndexSnapshot struct {
+	// perpetual_id is the Id of the perpetual market.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The `funding-tick` epoch of the snapshot.
+	Epoch uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The funding index of the perpetual at the start of the epoch, in
+	// parts-per-million.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
+}
+
+func (m *PerpetualFundingIndexSnapshot) Reset()         { *m = PerpetualFundingIndexSnapshot{} }
+func (m *PerpetualFundingIndexSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PerpetualFundingIndexSnapshot) ProtoMessage()    {}
+func (*PerpetualFundingIndexSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{6}
+}
+func (m *PerpetualFundingIndexSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualFundingIndexSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualFundingIndexSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualFundingIndexSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualFundingIndexSnapshot.Merge(m, src)
+}
+func (m *PerpetualFundingIndexSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualFundingIndexSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualFundingIndexSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualFundingIndexSnapshot proto.InternalMessageInfo
+
+func (m *PerpetualFundingIndexSnapshot) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualFundingIndexSnapshot) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// PerpetualMetadata stores information about the creation of a perpetual.
+type PerpetualMetadata struct {
+	// perpetual_id is the Id of the perpetual market.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The block height at which the perpetual was created.
+	CreationBlockHeight uint32 `protobuf:"varint,2,opt,name=creation_block_height,json=creationBlockHeight,proto3" json:"creation_block_height,omitempty"`
+	// The `funding-tick` epoch during which the perpetual was created. 0 if the
+	// perpetual was created before the `funding-tick` epoch existed, e.g. in
+	// genesis.
+	CreationEpoch uint32 `protobuf:"varint,3,opt,name=creation_epoch,json=creationEpoch,proto3" json:"creation_epoch,omitempty"`
+}
+
+func (m *PerpetualMetadata) Reset()         { *m = PerpetualMetadata{} }
+func (m *PerpetualMetadata) String() string { return proto.CompactTextString(m) }
+func (*PerpetualMetadata) ProtoMessage()    {}
+func (*PerpetualMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{7}
+}
+func (m *PerpetualMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualMetadata.Merge(m, src)
+}
+func (m *PerpetualMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualMetadata proto.InternalMessageInfo
+
+func (m *PerpetualMetadata) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualMetadata) GetCreationBlockHeight() uint32 {
+	if m != nil {
+		return m.CreationBlockHeight
+	}
+	return 0
+}
+
+func (m *PerpetualMetadata) GetCreationEpoch() uint32 {
+	if m != nil {
+		return m.CreationEpoch
+	}
+	return 0
+}
+
+// PerpetualFundingRate stores the funding rate of a perpetual computed at the
+// start of the most recent `funding-tick` epoch.
+type PerpetualFundingRate struct {
+	// perpetual_id is the Id of the perpetual market.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The clamped funding rate of the perpetual, in parts-per-million.
+	FundingRatePpm int32 `protobuf:"varint,2,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+}
+
+func (m *PerpetualFundingRate) Reset()         { *m = PerpetualFundingRate{} }
+func (m *PerpetualFundingRate) String() string { return proto.CompactTextString(m) }
+func (*PerpetualFundingRate) ProtoMessage()    {}
+func (*PerpetualFundingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce7204eee10038be, []int{8}
+}
+func (m *PerpetualFundingRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualFundingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualFundingRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualFundingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualFundingRate.Merge(m, src)
+}
+func (m *PerpetualFundingRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualFundingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualFundingRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualFundingRate proto.InternalMessageInfo
+
+func (m *PerpetualFundingRate) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
+func (m *PerpetualFundingRate) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dydxprotocol.perpetuals.PerpetualMarketType", PerpetualMarketType_name, PerpetualMarketType_value)
+	proto.RegisterEnum("dydxprotocol.perpetuals.PremiumCombinationMethod", PremiumCombinationMethod_name, PremiumCombinationMethod_value)
 	proto.RegisterType((*Perpetual)(nil), "dydxprotocol.perpetuals.Perpetual")
 	proto.RegisterType((*PerpetualParams)(nil), "dydxprotocol.perpetuals.PerpetualParams")
 	proto.RegisterType((*MarketPremiums)(nil), "dydxprotocol.perpetuals.MarketPremiums")
 	proto.RegisterType((*PremiumStore)(nil), "dydxprotocol.perpetuals.PremiumStore")
+	proto.RegisterType((*PerpetualNetFunding)(nil), "dydxprotocol.perpetuals.PerpetualNetFunding")
 	proto.RegisterType((*LiquidityTier)(nil), "dydxprotocol.perpetuals.LiquidityTier")
+	proto.RegisterType((*PerpetualFundingIndexSnapshot)(nil), "dydxprotocol.perpetuals.PerpetualFundingIndexSnapshot")
+	proto.RegisterType((*PerpetualMetadata)(nil), "dydxprotocol.perpetuals.PerpetualMetadata")
+	proto.RegisterType((*PerpetualFundingRate)(nil), "dydxprotocol.perpetuals.PerpetualFundingRate")
 }
 
 func init() {
@@ -475,55 +777,75 @@ func init() {
 }
 
 var fileDescriptor_ce7204eee10038be = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
-	0x14, 0x8d, 0xdd, 0x10, 0xda, 0xc9, 0xcf, 0x97, 0x4c, 0xab, 0x7e, 0x56, 0x2b, 0xa5, 0x69, 0xa4,
-	0xaa, 0x11, 0x94, 0x44, 0x2a, 0x20, 0xb1, 0x60, 0x41, 0x93, 0x26, 0xc2, 0x22, 0x69, 0x2d, 0x27,
-	0x45, 0x02, 0x09, 0x8d, 0x26, 0xf6, 0x34, 0x1d, 0xd5, 0x1e, 0x0f, 0xf6, 0x18, 0x1a, 0x76, 0xbc,
-	0x41, 0x5f, 0x83, 0x25, 0x6f, 0xd1, 0x65, 0x97, 0x88, 0x45, 0x85, 0xda, 0x2d, 0x0f, 0x81, 0x3c,
-	0x9e, 0xba, 0x49, 0x7f, 0x04, 0x0b, 0x56, 0x1e, 0xdf, 0x73, 0xce, 0x9d, 0x33, 0xd7, 0xc7, 0x03,
-	0xf6, 0xdd, 0xb9, 0x7b, 0xc5, 0xc3, 0x40, 0x04, 0x4e, 0xe0, 0x75, 0x38, 0x09, 0x39, 0x11, 0x31,
-	0xf6, 0xa2, 0xa7, 0x65, 0x5b, 0xa2, 0xf0, 0xfd, 0x22, 0xb1, 0xfd, 0x44, 0xdc, 0xda, 0x98, 0x05,
-	0xb3, 0x40, 0x02, 0x9d, 0x64, 0x95, 0xd2, 0x9b, 0xbf, 0xeb, 0x60, 0xcd, 0x7a, 0x24, 0xc1, 0x01,
-	0x28, 0x70, 0x1c, 0x62, 0x3f, 0x32, 0xb4, 0x86, 0xd6, 0x2a, 0x1e, 0xb6, 0xda, 0x6f, 0x74, 0x6b,
-	0x67, 0x1a, 0x4b, 0xf2, 0xbb, 0xf9, 0x9b, 0xbb, 0x9d, 0x9c, 0xad, 0xd4, 0xd0, 0x07, 0xe5, 0xf3,
-	0x98, 0xb9, 0x94, 0xcd, 0x10, 0x65, 0x2e, 0xb9, 0x32, 0xf4, 0x86, 0xd6, 0x2a, 0x75, 0xbf, 0x4e,
-	0x48, 0x7f, 0xde, 0xed, 0x7c, 0x35, 0xa3, 0xe2, 0x22, 0x9e, 0xb6, 0x9d, 0xc0, 0xef, 0x2c, 0x9d,
-	0xeb, 0xa7, 0xcf, 0x3e, 0x71, 0x2e, 0x30, 0x65, 0x9d, 0xac, 0xe2, 0x8a, 0x39, 0x27, 0x51, 0x7b,
-	0x4c, 0x42, 0x8a, 0x3d, 0xfa, 0x0b, 0x9e, 0x7a, 0xc4, 0x64, 0xc2, 0x2e, 0xa9, 0xf6, 0x66, 0xd2,
-	0x3d, 0xd9, 0x2e, 0xe0, 0x84, 0x21, 0xca, 0x04, 0x09, 0x49, 0x24, 0x8c, 0x95, 0xff, 0x7b, 0xbb,
-	0xa4, 0xbd, 0xa9, 0xba, 0x37, 0x7f, 0xd3, 0xc1, 0xbb, 0x67, 0xe7, 0x87, 0x15, 0xa0, 0x53, 0x57,
-	0x4e, 0xad, 0x6c, 0xeb, 0xd4, 0x85, 0x9b, 0xa0, 0x20, 0xa8, 0x73, 0x49, 0x42, 0x79, 0xf4, 0x35,
-	0x5b, 0xbd, 0xc1, 0x6d, 0xb0, 0xe6, 0xe3, 0xf0, 0x92, 0x08, 0x44, 0x5d, 0x69, 0xb3, 0x6c, 0xaf,
-	0xa6, 0x05, 0xd3, 0x85, 0x1f, 0x83, 0x1a, 0x16, 0x81, 0x4f, 0x1d, 0x14, 0x92, 0x28, 0xf0, 0x62,
-	0x41, 0x03, 0x66, 0xe4, 0x1b, 0x5a, 0xab, 0x66, 0x57, 0x53, 0xc0, 0xce, 0xea, 0xb0, 0x0d, 0xd6,
-	0x5d, 0x72, 0x8e, 0x63, 0x4f, 0xa0, 0xc7, 0x59, 0x73, 0xee, 0x1b, 0x1f, 0x48, 0x7a, 0x4d, 0x41,
-	0x83, 0x14, 0xb1, 0xb8, 0x0f, 0xf7, 0x40, 0xc5, 0xa3, 0x3f, 0xc6, 0xd4, 0xa5, 0x62, 0x8e, 0x04,
-	0x25, 0xa1, 0x51, 0x90, 0xdb, 0x97, 0xb3, 0xea, 0x84, 0x92, 0x10, 0x8e, 0x40, 0x51, 0x19, 0x4c,
-	0x46, 0x61, 0x7c, 0xd8, 0xd0, 0x5a, 0x95, 0xc3, 0x83, 0x7f, 0xcf, 0xc1, 0x48, 0x8a, 0x26, 0x73,
-	0x4e, 0x6c, 0xe0, 0x67, 0xeb, 0xe6, 0x29, 0xa8, 0xa4, 0x88, 0x15, 0x12, 0x9f, 0xc6, 0x7e, 0x04,
-	0x77, 0x41, 0x29, 0xd3, 0xa3, 0x6c, 0x66, 0xc5, 0xac, 0x66, 0xba, 0x70, 0x0b, 0xac, 0x72, 0x45,
-	0x37, 0xf4, 0xc6, 0x4a, 0xab, 0x66, 0x67, 0xef, 0xcd, 0x6b, 0x0d, 0x94, 0x54, 0xaf, 0xb1, 0x08,
-	0x42, 0x02, 0x7f, 0x00, 0xeb, 0xd8, 0xf3, 0x90, 0x32, 0x9d, 0xe9, 0xb4, 0xc6, 0x4a, 0xab, 0x78,
-	0xb8, 0xff, 0xa6, 0xf1, 0x65, 0x57, 0x2a, 0xbf, 0x35, 0xec, 0x79, 0x2f, 0xed, 0xb2, 0xd8, 0x47,
-	0x0b, 0x7e, 0xa4, 0x5d, 0x16, 0xfb, 0x8f, 0x94, 0xe6, 0xdf, 0x3a, 0x28, 0x0f, 0x97, 0x86, 0xf8,
-	0x3c, 0x0d, 0x10, 0xe4, 0x19, 0xf6, 0x89, 0xca, 0x82, 0x5c, 0xc3, 0x03, 0x00, 0x29, 0xa3, 0x82,
-	0x62, 0xe9, 0x7d, 0x46, 0x99, 0xfc, 0x7c, 0x69, 0x24, 0xaa, 0x0a, 0x19, 0x49, 0x20, 0xf9, 0x7a,
-	0x5f, 0x00, 0xc3, 0xc7, 0x49, 0xbe, 0x19, 0x66, 0x0e, 0x41, 0xe7, 0x21, 0x76, 0x92, 0x14, 0x48,
-	0x4d, 0x5e, 0x6a, 0x36, 0x17, 0xf0, 0x81, 0x82, 0x53, 0xe5, 0xe6, 0x14, 0x47, 0x04, 0xf1, 0x20,
-	0xa2, 0x52, 0xc2, 0x82, 0xe4, 0x81, 0x3d, 0x19, 0x95, 0x7c, 0x57, 0x37, 0x34, 0x7b, 0x23, 0x61,
-	0x58, 0x8a, 0x70, 0xa2, 0x70, 0xb8, 0x0f, 0xde, 0x51, 0x9f, 0x63, 0x47, 0x3c, 0x49, 0x92, 0xc8,
-	0xe4, 0xed, 0x4a, 0x5a, 0xce, 0x88, 0x9f, 0x83, 0xf7, 0x4b, 0xff, 0x1f, 0xf2, 0x82, 0x9f, 0x49,
-	0x88, 0x1c, 0xcc, 0x65, 0x7e, 0xf2, 0xf6, 0xc6, 0xe2, 0xff, 0x33, 0x4c, 0xc0, 0x1e, 0xe6, 0x2f,
-	0x65, 0x31, 0xe7, 0x4a, 0xb6, 0xfa, 0x52, 0x76, 0x96, 0x80, 0x3d, 0xcc, 0x3f, 0xfa, 0x55, 0x03,
-	0xeb, 0xaf, 0xc4, 0x0e, 0xee, 0x81, 0x5d, 0xab, 0x6f, 0x5b, 0xfd, 0xc9, 0xd9, 0xd1, 0x10, 0x8d,
-	0x8e, 0xec, 0x6f, 0xfa, 0x13, 0x34, 0xf9, 0xce, 0xea, 0xa3, 0xb3, 0x93, 0xb1, 0xd5, 0xef, 0x99,
-	0x03, 0xb3, 0x7f, 0x5c, 0xcd, 0xc1, 0x1d, 0xb0, 0xfd, 0x3a, 0xad, 0x67, 0x9f, 0x8e, 0xc7, 0x55,
-	0x0d, 0x36, 0x41, 0xfd, 0x75, 0x82, 0x39, 0x3e, 0x1d, 0x1e, 0x4d, 0xfa, 0xc7, 0x55, 0xbd, 0xfb,
-	0xed, 0xcd, 0x7d, 0x5d, 0xbb, 0xbd, 0xaf, 0x6b, 0x7f, 0xdd, 0xd7, 0xb5, 0xeb, 0x87, 0x7a, 0xee,
-	0xf6, 0xa1, 0x9e, 0xfb, 0xe3, 0xa1, 0x9e, 0xfb, 0xfe, 0xcb, 0xff, 0x7e, 0xd9, 0x5c, 0x2d, 0xde,
-	0xe3, 0xf2, 0xe2, 0x99, 0x16, 0x24, 0xf8, 0xe9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x28,
-	0x1e, 0x85, 0xef, 0x05, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xb4, 0xb4, 0xd3, 0x26, 0x9b, 0x4c, 0x4b, 0x6b, 0x5a, 0x91, 0x66, 0x83, 0x96,
+	0x46, 0xcb, 0x92, 0x8a, 0x02, 0x12, 0x07, 0x90, 0x48, 0x5a, 0x57, 0xb5, 0x68, 0x12, 0xcb, 0x49,
+	0x91, 0x40, 0xa0, 0xd1, 0xc4, 0x9e, 0x26, 0xa3, 0xda, 0x9e, 0xc1, 0x9e, 0x2c, 0x2d, 0x37, 0x7e,
+	0x01, 0xcb, 0xff, 0xe0, 0x7f, 0xb0, 0xc7, 0x3d, 0x22, 0x24, 0x56, 0xa8, 0xbd, 0xf0, 0x33, 0x90,
+	0xc7, 0x13, 0x27, 0x6d, 0xb7, 0x90, 0xc3, 0xee, 0x29, 0x33, 0xef, 0xfb, 0xbe, 0x37, 0x6f, 0xde,
+	0xbc, 0xf7, 0x1c, 0xb0, 0xeb, 0x5e, 0xba, 0x17, 0x3c, 0x64, 0x82, 0x39, 0xcc, 0xdb, 0xe3, 0x24,
+	0xe4, 0x44, 0x8c, 0xb1, 0x17, 0x4d, 0x97, 0x0d, 0x89, 0xc2, 0xcd, 0x59, 0x62, 0x63, 0x4a, 0xdc,
+	0x5a, 0x1f, 0xb2, 0x21, 0x93, 0xc0, 0x5e, 0xbc, 0x4a, 0xe8, 0x5b, 0xef, 0xdf, 0xf4, 0x1b, 0x52,
+	0x87, 0x44, 0x7b, 0x3e, 0x0e, 0xcf, 0x89, 0x40, 0x72, 0x97, 0xf0, 0x6a, 0xbf, 0xe4, 0xc0, 0xb2,
+	0x35, 0x71, 0x06, 0x8f, 0xc0, 0x22, 0xc7, 0x21, 0xf6, 0x23, 0x5d, 0xab, 0x6a, 0xf5, 0x95, 0xfd,
+	0x7a, 0xe3, 0x9e, 0x53, 0x1b, 0xa9, 0xc6, 0x92, 0xfc, 0x56, 0xfe, 0xf9, 0xcb, 0x9d, 0x8c, 0xad,
+	0xd4, 0xd0, 0x07, 0x85, 0xb3, 0x71, 0xe0, 0xd2, 0x60, 0x88, 0x68, 0xe0, 0x92, 0x0b, 0x3d, 0x5b,
+	0xd5, 0xea, 0xab, 0xad, 0xe3, 0x98, 0xf4, 0xe7, 0xcb, 0x9d, 0x2f, 0x87, 0x54, 0x8c, 0xc6, 0x83,
+	0x86, 0xc3, 0xfc, 0xbd, 0x1b, 0x71, 0x3e, 0xfd, 0xe4, 0x43, 0x67, 0x84, 0x69, 0xb0, 0x97, 0x5a,
+	0x5c, 0x71, 0xc9, 0x49, 0xd4, 0xe8, 0x91, 0x90, 0x62, 0x8f, 0xfe, 0x84, 0x07, 0x1e, 0x31, 0x03,
+	0x61, 0xaf, 0x2a, 0xf7, 0x66, 0xec, 0x3d, 0x3e, 0x8e, 0x71, 0x12, 0x20, 0x1a, 0x08, 0x12, 0x92,
+	0x48, 0xe8, 0xb9, 0xd7, 0x7d, 0x5c, 0xec, 0xde, 0x54, 0xde, 0xe1, 0x77, 0xe0, 0x1d, 0x97, 0x78,
+	0x34, 0x12, 0xc4, 0x45, 0x11, 0x11, 0xc2, 0x23, 0x3e, 0x09, 0x54, 0x5a, 0xf5, 0xbc, 0x4c, 0x5c,
+	0xf5, 0x56, 0xe2, 0x64, 0xfe, 0x1b, 0x6d, 0x99, 0x7f, 0x2b, 0xde, 0xd8, 0x9b, 0x13, 0x17, 0xbd,
+	0xd4, 0x83, 0x04, 0x6a, 0xff, 0x64, 0xc1, 0x83, 0x5b, 0xd9, 0x85, 0x45, 0x90, 0xa5, 0xae, 0x7c,
+	0x93, 0x82, 0x9d, 0xa5, 0x2e, 0xdc, 0x00, 0x8b, 0x82, 0x3a, 0xe7, 0x24, 0x94, 0x89, 0x5d, 0xb6,
+	0xd5, 0x0e, 0x6e, 0x83, 0x65, 0xf5, 0xc6, 0xd4, 0x95, 0x49, 0x28, 0xd8, 0x4b, 0x89, 0xc1, 0x74,
+	0xe1, 0x07, 0xa0, 0x8c, 0x05, 0xf3, 0xa9, 0x83, 0x42, 0x12, 0x31, 0x6f, 0x2c, 0x28, 0x0b, 0x64,
+	0xb8, 0x65, 0xbb, 0x94, 0x00, 0x76, 0x6a, 0x87, 0x0d, 0xb0, 0xe6, 0x92, 0x33, 0x3c, 0xf6, 0x04,
+	0x9a, 0xbc, 0x24, 0xe7, 0xbe, 0xbe, 0x20, 0xe9, 0x65, 0x05, 0x1d, 0x25, 0x88, 0xc5, 0x7d, 0xf8,
+	0x08, 0x14, 0x3d, 0xfa, 0xc3, 0x98, 0xba, 0x54, 0x5c, 0x22, 0x41, 0x49, 0xa8, 0x2f, 0xca, 0xe3,
+	0x0b, 0xa9, 0xb5, 0x4f, 0x49, 0x08, 0xdb, 0x60, 0x45, 0x05, 0x18, 0x27, 0x5a, 0x7f, 0xab, 0xaa,
+	0xd5, 0x8b, 0xfb, 0x4f, 0xfe, 0xbf, 0xca, 0x92, 0xcc, 0xf5, 0x2f, 0x39, 0xb1, 0x81, 0x9f, 0xae,
+	0xe1, 0x17, 0x60, 0x9b, 0x06, 0x54, 0x50, 0xec, 0x21, 0x1f, 0x87, 0x43, 0x1a, 0xc4, 0x41, 0x22,
+	0xf6, 0x94, 0x84, 0x21, 0x75, 0x89, 0xbe, 0x24, 0x43, 0xd0, 0x15, 0xa5, 0x2d, 0x19, 0x16, 0xf7,
+	0xbb, 0x0a, 0xaf, 0x75, 0x41, 0x71, 0xf2, 0x24, 0xc4, 0xa7, 0x63, 0x3f, 0x82, 0x0f, 0xc1, 0x6a,
+	0x7a, 0x3c, 0x4a, 0x53, 0xbe, 0x92, 0xda, 0x4c, 0x17, 0x6e, 0x81, 0x25, 0xae, 0xe8, 0x7a, 0xb6,
+	0x9a, 0xab, 0x97, 0xed, 0x74, 0x5f, 0x7b, 0xa6, 0x81, 0x55, 0xe5, 0xab, 0x27, 0x58, 0x48, 0xe0,
+	0xf7, 0x60, 0x0d, 0x7b, 0x1e, 0x52, 0x77, 0x4e, 0x75, 0x5a, 0x35, 0x57, 0x5f, 0xd9, 0xdf, 0xbd,
+	0xf7, 0xde, 0x37, 0xa3, 0x52, 0xcd, 0x55, 0xc6, 0x9e, 0x77, 0x37, 0xdc, 0x60, 0xec, 0xa3, 0x99,
+	0x78, 0x64, 0xb8, 0xc1, 0xd8, 0x9f, 0x50, 0x6a, 0xbf, 0x69, 0x60, 0x2d, 0x4d, 0x63, 0x87, 0x4c,
+	0xde, 0x6c, 0x9e, 0x9b, 0x86, 0xa0, 0x14, 0x90, 0x99, 0xf7, 0xc7, 0xd4, 0x7d, 0xed, 0x8d, 0x5c,
+	0x0c, 0xd2, 0x90, 0x2c, 0x4c, 0xdd, 0xda, 0x5f, 0x39, 0x50, 0x38, 0xb9, 0x51, 0x32, 0xb7, 0x6b,
+	0x1f, 0x82, 0x7c, 0x80, 0x7d, 0xa2, 0x2a, 0x5f, 0xae, 0xe1, 0x13, 0x00, 0xef, 0xd6, 0x81, 0x6a,
+	0x80, 0xd2, 0xed, 0xe7, 0x87, 0x9f, 0x01, 0xdd, 0xc7, 0xf1, 0xac, 0x08, 0x70, 0xe0, 0x10, 0x74,
+	0x16, 0x62, 0x27, 0xae, 0x79, 0xa9, 0xc9, 0x4b, 0xcd, 0xc6, 0x0c, 0x7e, 0xa4, 0xe0, 0x44, 0xb9,
+	0x31, 0xc0, 0x11, 0x41, 0x9c, 0x45, 0x54, 0x4a, 0x02, 0x16, 0xff, 0x60, 0x4f, 0x36, 0x46, 0xbe,
+	0x95, 0xd5, 0x35, 0x7b, 0x3d, 0x66, 0x58, 0x8a, 0xd0, 0x51, 0x38, 0xdc, 0x05, 0x0f, 0xa8, 0xcf,
+	0xb1, 0x23, 0xa6, 0x92, 0xb8, 0x41, 0xf2, 0x76, 0x31, 0x31, 0xa7, 0xc4, 0x4f, 0xc1, 0xe6, 0x8d,
+	0x59, 0x86, 0x3c, 0xf6, 0x23, 0x09, 0x91, 0x83, 0xb9, 0xec, 0x96, 0xbc, 0xbd, 0x3e, 0x3b, 0x8b,
+	0x4e, 0x62, 0xf0, 0x00, 0xf3, 0xbb, 0xb2, 0x31, 0xe7, 0x4a, 0xb6, 0x74, 0x57, 0x76, 0xca, 0x79,
+	0x22, 0x63, 0x60, 0x4b, 0x15, 0x0f, 0x72, 0x98, 0x3f, 0xa0, 0x01, 0x96, 0xd7, 0xf2, 0x89, 0x18,
+	0x31, 0x57, 0x5f, 0x96, 0xed, 0xf9, 0xd1, 0xfd, 0xed, 0x99, 0x48, 0x0f, 0xa6, 0xca, 0xb6, 0x14,
+	0xda, 0x3a, 0xbf, 0x07, 0xa9, 0xfd, 0xae, 0x81, 0x77, 0xd3, 0x72, 0x3c, 0x9a, 0x19, 0xe2, 0xbd,
+	0x00, 0xf3, 0x68, 0xc4, 0xc4, 0x3c, 0x85, 0xb9, 0x0e, 0x16, 0x08, 0x67, 0xce, 0x48, 0xd5, 0x7b,
+	0xb2, 0xb9, 0xfb, 0xd1, 0xc9, 0xbd, 0xc9, 0x8f, 0x4e, 0xed, 0x57, 0x0d, 0x94, 0xa7, 0xf3, 0x89,
+	0x08, 0xec, 0x62, 0x81, 0xe7, 0x89, 0x7e, 0x1f, 0xbc, 0xed, 0x84, 0x24, 0x49, 0xf4, 0xc0, 0x63,
+	0xce, 0x39, 0x1a, 0x11, 0x3a, 0x1c, 0x09, 0x75, 0x9b, 0xb5, 0x09, 0xd8, 0x8a, 0xb1, 0x63, 0x09,
+	0xc5, 0xe3, 0x35, 0xd5, 0x24, 0x57, 0x4f, 0x8a, 0xbb, 0x30, 0xb1, 0x1a, 0xb1, 0xb1, 0xe6, 0x80,
+	0xf5, 0xdb, 0xc9, 0xb5, 0xb1, 0x20, 0xf3, 0x44, 0x55, 0x07, 0xa5, 0x49, 0xf6, 0x42, 0x2c, 0x88,
+	0x6c, 0x86, 0x38, 0xa0, 0x05, 0xbb, 0x78, 0x36, 0xf5, 0x64, 0x71, 0xff, 0xf1, 0xcf, 0xb3, 0x13,
+	0x65, 0x3a, 0x98, 0xe1, 0x23, 0xf0, 0xd0, 0x32, 0x6c, 0xcb, 0xe8, 0x9f, 0x36, 0x4f, 0x50, 0xbb,
+	0x69, 0x7f, 0x65, 0xf4, 0x51, 0xff, 0x1b, 0xcb, 0x40, 0xa7, 0x9d, 0x9e, 0x65, 0x1c, 0x98, 0x47,
+	0xa6, 0x71, 0x58, 0xca, 0xc0, 0x1d, 0xb0, 0xfd, 0x6a, 0xda, 0x81, 0xdd, 0xed, 0xf5, 0x4a, 0x1a,
+	0xac, 0x81, 0xca, 0xab, 0x09, 0x66, 0xaf, 0x7b, 0xd2, 0xec, 0x1b, 0x87, 0xa5, 0xec, 0xe3, 0x33,
+	0xa0, 0xdf, 0x57, 0x7c, 0xf0, 0x3d, 0xb0, 0x63, 0xd9, 0x46, 0xdb, 0x3c, 0x6d, 0xa3, 0x83, 0x6e,
+	0xbb, 0x65, 0x76, 0x9a, 0x7d, 0xb3, 0xdb, 0x41, 0x6d, 0xa3, 0x7f, 0xdc, 0x3d, 0x44, 0x6d, 0xa3,
+	0xd9, 0x29, 0x65, 0x64, 0xb0, 0xff, 0x45, 0x3a, 0x34, 0x9b, 0x9d, 0x92, 0xd6, 0xfa, 0xfa, 0xf9,
+	0x55, 0x45, 0x7b, 0x71, 0x55, 0xd1, 0xfe, 0xbe, 0xaa, 0x68, 0xcf, 0xae, 0x2b, 0x99, 0x17, 0xd7,
+	0x95, 0xcc, 0x1f, 0xd7, 0x95, 0xcc, 0xb7, 0x9f, 0xcf, 0x5f, 0x4e, 0x17, 0xb3, 0xff, 0xeb, 0x64,
+	0x69, 0x0d, 0x16, 0x25, 0xf8, 0xf1, 0xbf, 0x03, 0x00, 0xc3, 0x72, 0xa6, 0x8e, 0xff, 0x09, 0x00,
+	0x00,
 }
 
 func (m *Perpetual) Marshal() (dAtA []byte, err error) {
@@ -546,6 +868,18 @@ func (m *Perpetual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelistedSettlementPrice != nil {
+		{
+			size, err := m.DelistedSettlementPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPerpetual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.OpenInterest.Size()
 		i -= size
@@ -599,6 +933,11 @@ func (m *PerpetualParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InitialMarginPpmOverride != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.InitialMarginPpmOverride))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.MarketType != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.MarketType))
 		i--
@@ -660,21 +999,21 @@ func (m *MarketPremiums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Premiums) > 0 {
-		dAtA2 := make([]byte, len(m.Premiums)*5)
-		var j3 int
+		dAtA3 := make([]byte, len(m.Premiums)*5)
+		var j4 int
 		for _, num := range m.Premiums {
-			x4 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x4 >= 1<<7 {
-				dAtA2[j3] = uint8(uint64(x4)&0x7f | 0x80)
-				j3++
-				x4 >>= 7
-			}
-			dAtA2[j3] = uint8(x4)
-			j3++
+			x5 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x5 >= 1<<7 {
+				dAtA3[j4] = uint8(uint64(x5)&0x7f | 0x80)
+				j4++
+				x5 >>= 7
+			}
+			dAtA3[j4] = uint8(x5)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA2[:j3])
-		i = encodeVarintPerpetual(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA3[:j4])
+		i = encodeVarintPerpetual(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -728,6 +1067,44 @@ func (m *PremiumStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PerpetualNetFunding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualNetFunding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualNetFunding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetFundingPaid.Size()
+		i -= size
+		if _, err := m.NetFundingPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LiquidityTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,6 +1125,11 @@ func (m *LiquidityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PremiumCombinationMethod != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PremiumCombinationMethod))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.OpenInterestUpperCap != 0 {
 		i = encodeVarintPerpetual(dAtA, i, uint64(m.OpenInterestUpperCap))
 		i--
@@ -793,42 +1175,160 @@ func (m *LiquidityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPerpetual(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPerpetual(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PerpetualFundingIndexSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Perpetual) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovPerpetual(uint64(l))
-	l = m.FundingIndex.Size()
-	n += 1 + l + sovPerpetual(uint64(l))
-	l = m.OpenInterest.Size()
-	n += 1 + l + sovPerpetual(uint64(l))
-	return n
+
+func (m *PerpetualFundingIndexSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PerpetualParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PerpetualFundingIndexSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPerpetual(uint64(m.Id))
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPerpetual(dAtA, i, uint64(size))
 	}
-	l = len(m.Ticker)
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationEpoch != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.CreationEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreationBlockHeight != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.CreationBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualFundingRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualFundingRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualFundingRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.FundingRatePpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintPerpetual(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerpetual(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerpetual(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Perpetual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	l = m.OpenInterest.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	if m.DelistedSettlementPrice != nil {
+		l = m.DelistedSettlementPrice.Size()
+		n += 1 + l + sovPerpetual(uint64(l))
+	}
+	return n
+}
+
+func (m *PerpetualParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPerpetual(uint64(m.Id))
+	}
+	l = len(m.Ticker)
 	if l > 0 {
 		n += 1 + l + sovPerpetual(uint64(l))
 	}
@@ -847,6 +1347,9 @@ func (m *PerpetualParams) Size() (n int) {
 	if m.MarketType != 0 {
 		n += 1 + sovPerpetual(uint64(m.MarketType))
 	}
+	if m.InitialMarginPpmOverride != 0 {
+		n += 1 + sovPerpetual(uint64(m.InitialMarginPpmOverride))
+	}
 	return n
 }
 
@@ -887,6 +1390,20 @@ func (m *PremiumStore) Size() (n int) {
 	return n
 }
 
+func (m *PerpetualNetFunding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	l = m.NetFundingPaid.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	return n
+}
+
 func (m *LiquidityTier) Size() (n int) {
 	if m == nil {
 		return 0
@@ -918,6 +1435,59 @@ func (m *LiquidityTier) Size() (n int) {
 	if m.OpenInterestUpperCap != 0 {
 		n += 1 + sovPerpetual(uint64(m.OpenInterestUpperCap))
 	}
+	if m.PremiumCombinationMethod != 0 {
+		n += 1 + sovPerpetual(uint64(m.PremiumCombinationMethod))
+	}
+	return n
+}
+
+func (m *PerpetualFundingIndexSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovPerpetual(uint64(m.Epoch))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovPerpetual(uint64(l))
+	return n
+}
+
+func (m *PerpetualMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	if m.CreationBlockHeight != 0 {
+		n += 1 + sovPerpetual(uint64(m.CreationBlockHeight))
+	}
+	if m.CreationEpoch != 0 {
+		n += 1 + sovPerpetual(uint64(m.CreationEpoch))
+	}
+	return n
+}
+
+func (m *PerpetualFundingRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovPerpetual(uint64(m.PerpetualId))
+	}
+	if m.FundingRatePpm != 0 {
+		n += 1 + sovPerpetual(uint64(m.FundingRatePpm))
+	}
 	return n
 }
 
@@ -1055,6 +1625,42 @@ func (m *Perpetual) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelistedSettlementPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelistedSettlementPrice == nil {
+				m.DelistedSettlementPrice = &types.MarketPrice{}
+			}
+			if err := m.DelistedSettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -1255,6 +1861,25 @@ func (m *PerpetualParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpmOverride", wireType)
+			}
+			m.InitialMarginPpmOverride = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpmOverride |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -1526,7 +2151,7 @@ func (m *PremiumStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
+func (m *PerpetualNetFunding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1549,17 +2174,17 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityTier: wiretype end group for non-group")
+			return fmt.Errorf("proto: PerpetualNetFunding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PerpetualNetFunding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			m.Id = 0
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -1569,16 +2194,16 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFundingPaid", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPerpetual
@@ -1588,42 +2213,144 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPerpetual
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPerpetual
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			if err := m.NetFundingPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.InitialMarginPpm = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPerpetual
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialMarginPpm |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
+			}
+			m.InitialMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
 		case 4:
 			if wireType != 0 {
@@ -1720,6 +2447,341 @@ func (m *LiquidityTier) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumCombinationMethod", wireType)
+			}
+			m.PremiumCombinationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PremiumCombinationMethod |= PremiumCombinationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualFundingIndexSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualFundingIndexSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualFundingIndexSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationBlockHeight", wireType)
+			}
+			m.CreationBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationBlockHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationEpoch", wireType)
+			}
+			m.CreationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerpetual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerpetual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualFundingRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerpetual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualFundingRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualFundingRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
+			}
+			m.FundingRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerpetual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPerpetual(dAtA[iNdEx:])
@@ -40,6 +40,26 @@ func TestPerpetualParams_Validate(t *testing.T) {
 			},
 			expectedErr: "DefaultFundingPpm magnitude exceeds maximum value",
 		},
+		{
+			desc: "Valid InitialMarginPpmOverride",
+			params: types.PerpetualParams{
+				Ticker:                   "test",
+				DefaultFundingPpm:        1_000_000,
+				MarketType:               types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				InitialMarginPpmOverride: 1_000_000,
+			},
+			expectedErr: "",
+		},
+		{
+			desc: "Invalid InitialMarginPpmOverride",
+			params: types.PerpetualParams{
+				Ticker:                   "test",
+				DefaultFundingPpm:        1_000_000,
+				MarketType:               types.PerpetualMarketType_PERPETUAL_MARKET_TYPE_CROSS,
+				InitialMarginPpmOverride: 1_000_001,
+			},
+			expectedErr: "InitialMarginPpm exceeds maximum value",
+		},
 	}
 
 	for _, tc := range tests {
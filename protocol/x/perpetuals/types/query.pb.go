@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -484,22 +485,23 @@ func (m *QueryPremiumSamplesResponse) GetPremiumSamples() PremiumStore {
 	return PremiumStore{}
 }
 
-// QueryParamsResponse is the response type for the Params RPC method.
-type QueryParamsRequest struct {
+// QueryPremiumAccumulationStatusRequest is the request type for the
+// PremiumAccumulationStatus RPC method.
+type QueryPremiumAccumulationStatusRequest struct {
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPremiumAccumulationStatusRequest) Reset()         { *m = QueryPremiumAccumulationStatusRequest{} }
+func (m *QueryPremiumAccumulationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumAccumulationStatusRequest) ProtoMessage()    {}
+func (*QueryPremiumAccumulationStatusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_13b6d29860ccef6b, []int{10}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPremiumAccumulationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPremiumAccumulationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPremiumAccumulationStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,35 +511,44 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryPremiumAccumulationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumAccumulationStatusRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryPremiumAccumulationStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryPremiumAccumulationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumAccumulationStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPremiumAccumulationStatusRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Params RPC method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+// QueryPremiumAccumulationStatusResponse is the response type for the
+// PremiumAccumulationStatus RPC method.
+type QueryPremiumAccumulationStatusResponse struct {
+	// Number of premium samples accumulated in the current funding tick epoch.
+	NumPremiumSamples uint32 `protobuf:"varint,1,opt,name=num_premium_samples,json=numPremiumSamples,proto3" json:"num_premium_samples,omitempty"`
+	// Number of premium votes accumulated in the current funding sample epoch.
+	NumPremiumVotes uint32 `protobuf:"varint,2,opt,name=num_premium_votes,json=numPremiumVotes,proto3" json:"num_premium_votes,omitempty"`
+	// Per-market number of non-zero premiums accumulated, sorted by perpetual
+	// id.
+	MarketStatuses []MarketPremiumAccumulationStatus `protobuf:"bytes,3,rep,name=market_statuses,json=marketStatuses,proto3" json:"market_statuses"`
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPremiumAccumulationStatusResponse) Reset() {
+	*m = QueryPremiumAccumulationStatusResponse{}
+}
+func (m *QueryPremiumAccumulationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPremiumAccumulationStatusResponse) ProtoMessage()    {}
+func (*QueryPremiumAccumulationStatusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_13b6d29860ccef6b, []int{11}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPremiumAccumulationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPremiumAccumulationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPremiumAccumulationStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -547,927 +558,3916 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPremiumAccumulationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPremiumAccumulationStatusResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPremiumAccumulationStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPremiumAccumulationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPremiumAccumulationStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPremiumAccumulationStatusResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryPremiumAccumulationStatusResponse) GetNumPremiumSamples() uint32 {
 	if m != nil {
-		return m.Params
+		return m.NumPremiumSamples
 	}
-	return Params{}
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
-	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
-	proto.RegisterType((*QueryAllPerpetualsRequest)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsRequest")
-	proto.RegisterType((*QueryAllPerpetualsResponse)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsResponse")
-	proto.RegisterType((*QueryAllLiquidityTiersRequest)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersRequest")
-	proto.RegisterType((*QueryAllLiquidityTiersResponse)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersResponse")
-	proto.RegisterType((*QueryPremiumVotesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesRequest")
-	proto.RegisterType((*QueryPremiumVotesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesResponse")
-	proto.RegisterType((*QueryPremiumSamplesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesRequest")
-	proto.RegisterType((*QueryPremiumSamplesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.perpetuals.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.perpetuals.QueryParamsResponse")
+func (m *QueryPremiumAccumulationStatusResponse) GetNumPremiumVotes() uint32 {
+	if m != nil {
+		return m.NumPremiumVotes
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("dydxprotocol/perpetuals/query.proto", fileDescriptor_13b6d29860ccef6b)
+func (m *QueryPremiumAccumulationStatusResponse) GetMarketStatuses() []MarketPremiumAccumulationStatus {
+	if m != nil {
+		return m.MarketStatuses
+	}
+	return nil
 }
 
-var fileDescriptor_13b6d29860ccef6b = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xbf, 0x53, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x51, 0x71, 0x78, 0x42, 0x18, 0x57, 0x54, 0x3c, 0xf1, 0xd0, 0x13, 0x09, 0xa2,
-	0xde, 0x4a, 0x60, 0xb4, 0xd1, 0x42, 0x0a, 0x6c, 0x2c, 0x30, 0x22, 0x85, 0x0d, 0x5e, 0x92, 0x9d,
-	0x63, 0x67, 0xee, 0xb2, 0xc7, 0xdd, 0x86, 0x21, 0xe3, 0xd8, 0x58, 0x5b, 0x38, 0x63, 0x6d, 0xa7,
-	0x25, 0xad, 0xb5, 0x25, 0x25, 0x33, 0x36, 0x56, 0x8e, 0x03, 0xd6, 0xfe, 0x0d, 0x4e, 0x76, 0xf7,
-	0x92, 0x3b, 0xc8, 0x91, 0x84, 0xa1, 0xcb, 0xec, 0xfb, 0xf1, 0xfd, 0xec, 0xf7, 0xf6, 0xbd, 0x09,
-	0xdc, 0xae, 0x35, 0x6b, 0xdb, 0x41, 0xc8, 0x05, 0xaf, 0x72, 0x8f, 0x04, 0x34, 0x0c, 0xa8, 0x68,
-	0x38, 0x5e, 0x44, 0x36, 0x1b, 0x34, 0x6c, 0xda, 0x32, 0x82, 0xaf, 0x26, 0x93, 0xec, 0x4e, 0x92,
-	0x31, 0xee, 0x72, 0x97, 0xcb, 0x00, 0x69, 0xfd, 0x52, 0xe9, 0xc6, 0xa4, 0xcb, 0xb9, 0xeb, 0x51,
-	0xe2, 0x04, 0x8c, 0x38, 0xf5, 0x3a, 0x17, 0x8e, 0x60, 0xbc, 0x1e, 0xe9, 0xe8, 0x5c, 0x95, 0x47,
-	0x3e, 0x8f, 0x48, 0xc5, 0x89, 0xa8, 0x52, 0x21, 0x5b, 0xf3, 0x15, 0x2a, 0x9c, 0x79, 0x12, 0x38,
-	0x2e, 0xab, 0xcb, 0x64, 0x9d, 0x3b, 0x9d, 0x45, 0x17, 0x38, 0xa1, 0xe3, 0xc7, 0x1d, 0x8b, 0x99,
-	0x59, 0xf1, 0x4f, 0x95, 0x68, 0x15, 0xe1, 0xf2, 0xcb, 0x96, 0xe0, 0x4a, 0x7c, 0x5e, 0xa6, 0x9b,
-	0x0d, 0x1a, 0x09, 0x5c, 0x80, 0x3c, 0xab, 0x4d, 0xa0, 0x9b, 0x68, 0x76, 0xb4, 0x9c, 0x67, 0x35,
-	0xeb, 0x2d, 0x5c, 0x39, 0x9c, 0x18, 0x05, 0xbc, 0x1e, 0x51, 0xbc, 0x0c, 0xc3, 0xed, 0xae, 0xb2,
-	0xe0, 0x42, 0xc9, 0xb2, 0x33, 0xec, 0xb1, 0xdb, 0xe5, 0x4b, 0x67, 0x77, 0x7f, 0x4f, 0xe5, 0xca,
-	0x9d, 0x52, 0xab, 0x0a, 0xd7, 0xa4, 0xc2, 0x33, 0xcf, 0x6b, 0x67, 0x45, 0x31, 0xce, 0x32, 0x40,
-	0xc7, 0x0a, 0xad, 0x32, 0x63, 0x2b, 0xdf, 0xec, 0x96, 0x6f, 0xb6, 0xfa, 0x3a, 0xda, 0x37, 0x7b,
-	0xc5, 0x71, 0xa9, 0xae, 0x2d, 0x27, 0x2a, 0xad, 0x1d, 0x04, 0x46, 0x37, 0x95, 0xee, 0x77, 0x39,
-	0x73, 0xc2, 0xbb, 0xe0, 0xe7, 0x29, 0xdc, 0xbc, 0xc4, 0x2d, 0xf6, 0xc4, 0x55, 0x10, 0x29, 0x5e,
-	0x17, 0x6e, 0xc4, 0xb8, 0x2f, 0xd8, 0x66, 0x83, 0xd5, 0x98, 0x68, 0xae, 0x32, 0x1a, 0x9e, 0xba,
-	0x31, 0x3f, 0x10, 0x98, 0x59, 0x4a, 0xda, 0x9c, 0xd7, 0x30, 0xe6, 0xc5, 0x91, 0x75, 0xd1, 0x0a,
-	0x69, 0x8b, 0x66, 0x32, 0x2d, 0x4a, 0x75, 0xd2, 0x36, 0x15, 0xbc, 0x54, 0xfb, 0xd3, 0xf3, 0xca,
-	0x80, 0x09, 0xf5, 0x44, 0x43, 0xea, 0xb3, 0x86, 0xbf, 0xc6, 0x05, 0x8d, 0x6d, 0xb2, 0x7c, 0xfd,
-	0xb8, 0xd2, 0x31, 0x7d, 0xb1, 0x15, 0x18, 0x0d, 0xd4, 0xf9, 0xfa, 0x56, 0x2b, 0xa0, 0x6d, 0xbc,
-	0x93, 0xfd, 0xe5, 0x55, 0xf6, 0x2b, 0xc1, 0x43, 0xaa, 0x6f, 0x35, 0x12, 0x24, 0x3a, 0x5b, 0x93,
-	0xfa, 0x95, 0xc5, 0x89, 0x8e, 0x1f, 0x78, 0x1d, 0x98, 0x08, 0xae, 0x77, 0x8d, 0x6a, 0x9c, 0x55,
-	0x18, 0x8b, 0x71, 0x22, 0x15, 0x3a, 0x09, 0x50, 0x21, 0x48, 0x75, 0xb7, 0xc6, 0x01, 0x2b, 0x51,
-	0xb9, 0x27, 0x62, 0x94, 0x55, 0xb8, 0x94, 0x3a, 0xd5, 0x08, 0x4f, 0x61, 0x48, 0xed, 0x13, 0xad,
-	0x3c, 0x95, 0xad, 0x2c, 0xd3, 0xb4, 0xa6, 0x2e, 0x2a, 0xfd, 0x3b, 0x0f, 0xe7, 0x64, 0x5b, 0xfc,
-	0x05, 0xc1, 0x70, 0x7b, 0x4e, 0xb0, 0x9d, 0xd9, 0xa6, 0xeb, 0x12, 0x32, 0x48, 0xdf, 0xf9, 0x8a,
-	0xdb, 0x22, 0x1f, 0x7e, 0xfe, 0xfd, 0x9c, 0xbf, 0x8b, 0x8b, 0xa4, 0xe7, 0x02, 0x24, 0xef, 0x58,
-	0xed, 0x3d, 0xfe, 0x8a, 0x60, 0x34, 0xb5, 0x0a, 0x70, 0xe9, 0x78, 0xcd, 0x6e, 0xdb, 0xc9, 0x58,
-	0x18, 0xa8, 0x46, 0xb3, 0xce, 0x49, 0xd6, 0x69, 0x6c, 0xf5, 0x66, 0xc5, 0xdf, 0x11, 0x5c, 0x3c,
-	0x32, 0x98, 0xf8, 0x51, 0x4f, 0xd9, 0xae, 0x3b, 0xc3, 0x78, 0x3c, 0x70, 0x9d, 0x46, 0x7e, 0x28,
-	0x91, 0xe7, 0xf0, 0x6c, 0x26, 0xf2, 0xa1, 0x05, 0x81, 0xbf, 0x21, 0x18, 0x49, 0xce, 0x1c, 0x9e,
-	0xef, 0xf1, 0x49, 0x8f, 0xce, 0xae, 0x51, 0x1a, 0xa4, 0x44, 0x93, 0xda, 0x92, 0x74, 0x16, 0xcf,
-	0x64, 0x9b, 0x9b, 0x9c, 0x78, 0xbc, 0x83, 0xa0, 0x90, 0x1e, 0x47, 0xbc, 0xd0, 0x97, 0x6c, 0x7a,
-	0xb4, 0x8d, 0xc5, 0xc1, 0x8a, 0xfa, 0xf6, 0xf5, 0xd0, 0x42, 0xc0, 0x1f, 0x11, 0x0c, 0xa9, 0xd1,
-	0xc3, 0xf7, 0x7a, 0x48, 0x26, 0xe7, 0xdd, 0xb8, 0xdf, 0x5f, 0xb2, 0xe6, 0x2a, 0x4a, 0xae, 0x5b,
-	0x78, 0x8a, 0x1c, 0xff, 0xaf, 0x63, 0x69, 0x6d, 0x77, 0xdf, 0x44, 0x7b, 0xfb, 0x26, 0xfa, 0xb3,
-	0x6f, 0xa2, 0x4f, 0x07, 0x66, 0x6e, 0xef, 0xc0, 0xcc, 0xfd, 0x3a, 0x30, 0x73, 0x6f, 0x9e, 0xb8,
-	0x4c, 0x6c, 0x34, 0x2a, 0x76, 0x95, 0xfb, 0xe9, 0x26, 0x5b, 0x8b, 0x0f, 0xaa, 0x1b, 0x0e, 0xab,
-	0x93, 0xf6, 0xc9, 0x76, 0xb2, 0xb1, 0x68, 0x06, 0x34, 0xaa, 0x0c, 0xc9, 0xe0, 0xc2, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0x45, 0x0d, 0x89, 0x94, 0x09, 0x00, 0x00,
+// MarketPremiumAccumulationStatus is the number of non-zero premiums
+// accumulated for a single perpetual.
+type MarketPremiumAccumulationStatus struct {
+	// The id of the perpetual.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// Number of non-zero premium samples for the perpetual.
+	NumPremiumSamples uint32 `protobuf:"varint,2,opt,name=num_premium_samples,json=numPremiumSamples,proto3" json:"num_premium_samples,omitempty"`
+	// Number of non-zero premium votes for the perpetual.
+	NumPremiumVotes uint32 `protobuf:"varint,3,opt,name=num_premium_votes,json=numPremiumVotes,proto3" json:"num_premium_votes,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries a Perpetual by id.
-	Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error)
-	// Queries a list of Perpetual items.
-	AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error)
-	// Queries a list of LiquidityTiers.
-	AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error)
-	// Queries a list of premium votes.
-	PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error)
-	// Queries a list of premium samples.
-	PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error)
-	// Queries the perpetual params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *MarketPremiumAccumulationStatus) Reset()         { *m = MarketPremiumAccumulationStatus{} }
+func (m *MarketPremiumAccumulationStatus) String() string { return proto.CompactTextString(m) }
+func (*MarketPremiumAccumulationStatus) ProtoMessage()    {}
+func (*MarketPremiumAccumulationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{12}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *MarketPremiumAccumulationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MarketPremiumAccumulationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPremiumAccumulationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPremiumAccumulationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPremiumAccumulationStatus.Merge(m, src)
+}
+func (m *MarketPremiumAccumulationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPremiumAccumulationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPremiumAccumulationStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error) {
-	out := new(QueryPerpetualResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/Perpetual", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketPremiumAccumulationStatus proto.InternalMessageInfo
+
+func (m *MarketPremiumAccumulationStatus) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error) {
-	out := new(QueryAllPerpetualsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllPerpetuals", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketPremiumAccumulationStatus) GetNumPremiumSamples() uint32 {
+	if m != nil {
+		return m.NumPremiumSamples
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error) {
-	out := new(QueryAllLiquidityTiersResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllLiquidityTiers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketPremiumAccumulationStatus) GetNumPremiumVotes() uint32 {
+	if m != nil {
+		return m.NumPremiumVotes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error) {
-	out := new(QueryPremiumVotesResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryNetFundingPerPerpetualRequest is the request type for the
+// NetFundingPerPerpetual RPC method.
+type QueryNetFundingPerPerpetualRequest struct {
+	// The `funding-tick` epoch to query net funding for.
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryNetFundingPerPerpetualRequest) Reset()         { *m = QueryNetFundingPerPerpetualRequest{} }
+func (m *QueryNetFundingPerPerpetualRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetFundingPerPerpetualRequest) ProtoMessage()    {}
+func (*QueryNetFundingPerPerpetualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{13}
+}
+func (m *QueryNetFundingPerPerpetualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetFundingPerPerpetualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetFundingPerPerpetualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNetFundingPerPerpetualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetFundingPerPerpetualRequest.Merge(m, src)
+}
+func (m *QueryNetFundingPerPerpetualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetFundingPerPerpetualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetFundingPerPerpetualRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error) {
-	out := new(QueryPremiumSamplesResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumSamples", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNetFundingPerPerpetualRequest proto.InternalMessageInfo
+
+func (m *QueryNetFundingPerPerpetualRequest) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryNetFundingPerPerpetualResponse is the response type for the
+// NetFundingPerPerpetual RPC method.
+type QueryNetFundingPerPerpetualResponse struct {
+	// Net funding settled per perpetual during the epoch, sorted by perpetual
+	// id. Perpetuals without any settled funding are omitted.
+	NetFunding []PerpetualNetFunding `protobuf:"bytes,1,rep,name=net_funding,json=netFunding,proto3" json:"net_funding"`
+}
+
+func (m *QueryNetFundingPerPerpetualResponse) Reset()         { *m = QueryNetFundingPerPerpetualResponse{} }
+func (m *QueryNetFundingPerPerpetualResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetFundingPerPerpetualResponse) ProtoMessage()    {}
+func (*QueryNetFundingPerPerpetualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{14}
+}
+func (m *QueryNetFundingPerPerpetualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetFundingPerPerpetualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetFundingPerPerpetualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNetFundingPerPerpetualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetFundingPerPerpetualResponse.Merge(m, src)
+}
+func (m *QueryNetFundingPerPerpetualResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetFundingPerPerpetualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetFundingPerPerpetualResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries a Perpetual by id.
-	Perpetual(context.Context, *QueryPerpetualRequest) (*QueryPerpetualResponse, error)
-	// Queries a list of Perpetual items.
-	AllPerpetuals(context.Context, *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error)
-	// Queries a list of LiquidityTiers.
-	AllLiquidityTiers(context.Context, *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error)
-	// Queries a list of premium votes.
-	PremiumVotes(context.Context, *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error)
-	// Queries a list of premium samples.
-	PremiumSamples(context.Context, *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error)
-	// Queries the perpetual params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryNetFundingPerPerpetualResponse proto.InternalMessageInfo
+
+func (m *QueryNetFundingPerPerpetualResponse) GetNetFunding() []PerpetualNetFunding {
+	if m != nil {
+		return m.NetFunding
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAllOpenInterestRequest is the request type for the AllOpenInterest RPC
+// method.
+type QueryAllOpenInterestRequest struct {
 }
 
-func (*UnimplementedQueryServer) Perpetual(ctx context.Context, req *QueryPerpetualRequest) (*QueryPerpetualResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Perpetual not implemented")
+func (m *QueryAllOpenInterestRequest) Reset()         { *m = QueryAllOpenInterestRequest{} }
+func (m *QueryAllOpenInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOpenInterestRequest) ProtoMessage()    {}
+func (*QueryAllOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{15}
 }
-func (*UnimplementedQueryServer) AllPerpetuals(ctx context.Context, req *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPerpetuals not implemented")
+func (m *QueryAllOpenInterestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllLiquidityTiers(ctx context.Context, req *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllLiquidityTiers not implemented")
+func (m *QueryAllOpenInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOpenInterestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PremiumVotes(ctx context.Context, req *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PremiumVotes not implemented")
+func (m *QueryAllOpenInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOpenInterestRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PremiumSamples(ctx context.Context, req *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PremiumSamples not implemented")
+func (m *QueryAllOpenInterestRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllOpenInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOpenInterestRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryAllOpenInterestRequest proto.InternalMessageInfo
+
+// QueryAllOpenInterestResponse is the response type for the AllOpenInterest
+// RPC method.
+type QueryAllOpenInterestResponse struct {
+	// Open interest in base quantums, keyed by perpetual id.
+	OpenInterest map[uint32]uint64 `protobuf:"bytes,1,rep,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func _Query_Perpetual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPerpetualRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Perpetual(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/Perpetual",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Perpetual(ctx, req.(*QueryPerpetualRequest))
+func (m *QueryAllOpenInterestResponse) Reset()         { *m = QueryAllOpenInterestResponse{} }
+func (m *QueryAllOpenInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOpenInterestResponse) ProtoMessage()    {}
+func (*QueryAllOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{16}
+}
+func (m *QueryAllOpenInterestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOpenInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOpenInterestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_AllPerpetuals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPerpetualsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPerpetuals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/AllPerpetuals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPerpetuals(ctx, req.(*QueryAllPerpetualsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllOpenInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOpenInterestResponse.Merge(m, src)
+}
+func (m *QueryAllOpenInterestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOpenInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOpenInterestResponse.DiscardUnknown(m)
 }
 
-func _Query_AllLiquidityTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLiquidityTiersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllLiquidityTiers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/AllLiquidityTiers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllLiquidityTiers(ctx, req.(*QueryAllLiquidityTiersRequest))
+var xxx_messageInfo_QueryAllOpenInterestResponse proto.InternalMessageInfo
+
+func (m *QueryAllOpenInterestResponse) GetOpenInterest() map[uint32]uint64 {
+	if m != nil {
+		return m.OpenInterest
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PremiumVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPremiumVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PremiumVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PremiumVotes(ctx, req.(*QueryPremiumVotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPerpetualMarginFractionsRequest is the request type for the
+// PerpetualMarginFractions RPC method.
+type QueryPerpetualMarginFractionsRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 }
 
-func _Query_PremiumSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPremiumSamplesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PremiumSamples(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumSamples",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PremiumSamples(ctx, req.(*QueryPremiumSamplesRequest))
+func (m *QueryPerpetualMarginFractionsRequest) Reset()         { *m = QueryPerpetualMarginFractionsRequest{} }
+func (m *QueryPerpetualMarginFractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualMarginFractionsRequest) ProtoMessage()    {}
+func (*QueryPerpetualMarginFractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{17}
+}
+func (m *QueryPerpetualMarginFractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualMarginFractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualMarginFractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPerpetualMarginFractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualMarginFractionsRequest.Merge(m, src)
+}
+func (m *QueryPerpetualMarginFractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualMarginFractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualMarginFractionsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dydxprotocol.perpetuals.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPerpetualMarginFractionsRequest proto.InternalMessageInfo
+
+func (m *QueryPerpetualMarginFractionsRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dydxprotocol.perpetuals.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Perpetual",
-			Handler:    _Query_Perpetual_Handler,
-		},
-		{
-			MethodName: "AllPerpetuals",
-			Handler:    _Query_AllPerpetuals_Handler,
-		},
-		{
-			MethodName: "AllLiquidityTiers",
-			Handler:    _Query_AllLiquidityTiers_Handler,
-		},
-		{
-			MethodName: "PremiumVotes",
-			Handler:    _Query_PremiumVotes_Handler,
-		},
-		{
-			MethodName: "PremiumSamples",
-			Handler:    _Query_PremiumSamples_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dydxprotocol/perpetuals/query.proto",
+// QueryPerpetualMarginFractionsResponse is the response type for the
+// PerpetualMarginFractions RPC method.
+type QueryPerpetualMarginFractionsResponse struct {
+	// The id of the liquidity tier of the perpetual.
+	LiquidityTier uint32 `protobuf:"varint,1,opt,name=liquidity_tier,json=liquidityTier,proto3" json:"liquidity_tier,omitempty"`
+	// The initial margin fraction of the liquidity tier, in parts-per-million.
+	InitialMarginPpm uint32 `protobuf:"varint,2,opt,name=initial_margin_ppm,json=initialMarginPpm,proto3" json:"initial_margin_ppm,omitempty"`
+	// The maintenance fraction of the liquidity tier, as a fraction of the
+	// initial margin, in parts-per-million.
+	MaintenanceFractionPpm uint32 `protobuf:"varint,3,opt,name=maintenance_fraction_ppm,json=maintenanceFractionPpm,proto3" json:"maintenance_fraction_ppm,omitempty"`
+	// The effective maintenance margin fraction, in parts-per-million. Equal to
+	// `initial_margin_ppm * maintenance_fraction_ppm / 1_000_000`, rounded down.
+	MaintenanceMarginPpm uint32 `protobuf:"varint,4,opt,name=maintenance_margin_ppm,json=maintenanceMarginPpm,proto3" json:"maintenance_margin_ppm,omitempty"`
+}
+
+func (m *QueryPerpetualMarginFractionsResponse) Reset()         { *m = QueryPerpetualMarginFractionsResponse{} }
+func (m *QueryPerpetualMarginFractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualMarginFractionsResponse) ProtoMessage()    {}
+func (*QueryPerpetualMarginFractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{18}
+}
+func (m *QueryPerpetualMarginFractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualMarginFractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualMarginFractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPerpetualMarginFractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualMarginFractionsResponse.Merge(m, src)
+}
+func (m *QueryPerpetualMarginFractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualMarginFractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualMarginFractionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPerpetualRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPerpetualMarginFractionsResponse proto.InternalMessageInfo
+
+func (m *QueryPerpetualMarginFractionsResponse) GetLiquidityTier() uint32 {
+	if m != nil {
+		return m.LiquidityTier
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPerpetualRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPerpetualMarginFractionsResponse) GetInitialMarginPpm() uint32 {
+	if m != nil {
+		return m.InitialMarginPpm
+	}
+	return 0
 }
 
-func (m *QueryPerpetualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPerpetualMarginFractionsResponse) GetMaintenanceFractionPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceFractionPpm
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPerpetualResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPerpetualMarginFractionsResponse) GetMaintenanceMarginPpm() uint32 {
+	if m != nil {
+		return m.MaintenanceMarginPpm
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPerpetualResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPerpetualMetadataRequest is the request type for the
+// PerpetualMetadata RPC method.
+type QueryPerpetualMetadataRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 }
 
-func (m *QueryPerpetualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Perpetual.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPerpetualMetadataRequest) Reset()         { *m = QueryPerpetualMetadataRequest{} }
+func (m *QueryPerpetualMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualMetadataRequest) ProtoMessage()    {}
+func (*QueryPerpetualMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{19}
+}
+func (m *QueryPerpetualMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPerpetualMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualMetadataRequest.Merge(m, src)
+}
+func (m *QueryPerpetualMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualMetadataRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPerpetualsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPerpetualMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryPerpetualMetadataRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllPerpetualsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPerpetualMetadataResponse is the response type for the
+// PerpetualMetadata RPC method.
+type QueryPerpetualMetadataResponse struct {
+	Metadata PerpetualMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *QueryAllPerpetualsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPerpetualMetadataResponse) Reset()         { *m = QueryPerpetualMetadataResponse{} }
+func (m *QueryPerpetualMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPerpetualMetadataResponse) ProtoMessage()    {}
+func (*QueryPerpetualMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{20}
+}
+func (m *QueryPerpetualMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerpetualMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerpetualMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPerpetualMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerpetualMetadataResponse.Merge(m, src)
+}
+func (m *QueryPerpetualMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerpetualMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerpetualMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPerpetualsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPerpetualMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryPerpetualMetadataResponse) GetMetadata() PerpetualMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return PerpetualMetadata{}
 }
 
-func (m *QueryAllPerpetualsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCurrentFundingRateRequest is the request type for the
+// CurrentFundingRate RPC method.
+type QueryCurrentFundingRateRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 }
 
-func (m *QueryAllPerpetualsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Perpetual) > 0 {
-		for iNdEx := len(m.Perpetual) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Perpetual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCurrentFundingRateRequest) Reset()         { *m = QueryCurrentFundingRateRequest{} }
+func (m *QueryCurrentFundingRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentFundingRateRequest) ProtoMessage()    {}
+func (*QueryCurrentFundingRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{21}
+}
+func (m *QueryCurrentFundingRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentFundingRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentFundingRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCurrentFundingRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentFundingRateRequest.Merge(m, src)
+}
+func (m *QueryCurrentFundingRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentFundingRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentFundingRateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllLiquidityTiersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentFundingRateRequest proto.InternalMessageInfo
+
+func (m *QueryCurrentFundingRateRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllLiquidityTiersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCurrentFundingRateResponse is the response type for the
+// CurrentFundingRate RPC method.
+type QueryCurrentFundingRateResponse struct {
+	// The clamped funding rate computed at the start of the most recent
+	// `funding-tick` epoch, in parts-per-million. Zero if no funding rate has
+	// been computed for the perpetual yet.
+	FundingRatePpm int32 `protobuf:"varint,1,opt,name=funding_rate_ppm,json=fundingRatePpm,proto3" json:"funding_rate_ppm,omitempty"`
+	// The current funding index of the perpetual.
+	FundingIndex github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=funding_index,json=fundingIndex,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"funding_index"`
 }
 
-func (m *QueryAllLiquidityTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCurrentFundingRateResponse) Reset()         { *m = QueryCurrentFundingRateResponse{} }
+func (m *QueryCurrentFundingRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentFundingRateResponse) ProtoMessage()    {}
+func (*QueryCurrentFundingRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{22}
+}
+func (m *QueryCurrentFundingRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentFundingRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentFundingRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCurrentFundingRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentFundingRateResponse.Merge(m, src)
+}
+func (m *QueryCurrentFundingRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentFundingRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentFundingRateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllLiquidityTiersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentFundingRateResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentFundingRateResponse) GetFundingRatePpm() int32 {
+	if m != nil {
+		return m.FundingRatePpm
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllLiquidityTiersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsResponse is the response type for the Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryAllLiquidityTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LiquidityTiers) > 0 {
-		for iNdEx := len(m.LiquidityTiers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{23}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPremiumVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-
-func (m *QueryPremiumVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPremiumVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPremiumVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-func (m *QueryPremiumVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsResponse is the response type for the Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryPremiumVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PremiumVotes.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{24}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPremiumSamplesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryPremiumSamplesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamFundingHistoryRequest is the request type for the StreamFundingHistory
+// RPC method.
+type StreamFundingHistoryRequest struct {
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
 }
 
-func (m *QueryPremiumSamplesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *StreamFundingHistoryRequest) Reset()         { *m = StreamFundingHistoryRequest{} }
+func (m *StreamFundingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamFundingHistoryRequest) ProtoMessage()    {}
+func (*StreamFundingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{25}
+}
+func (m *StreamFundingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamFundingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamFundingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamFundingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamFundingHistoryRequest.Merge(m, src)
+}
+func (m *StreamFundingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamFundingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamFundingHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPremiumSamplesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamFundingHistoryRequest proto.InternalMessageInfo
+
+func (m *StreamFundingHistoryRequest) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPremiumSamplesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StreamFundingHistoryResponse is the response type for the
+// StreamFundingHistory RPC method. Each response contains a single funding
+// index snapshot.
+type StreamFundingHistoryResponse struct {
+	Snapshot PerpetualFundingIndexSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
 }
 
-func (m *QueryPremiumSamplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PremiumSamples.MarshalToSizedBuffer(dAtA[:i])
+func (m *StreamFundingHistoryResponse) Reset()         { *m = StreamFundingHistoryResponse{} }
+func (m *StreamFundingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamFundingHistoryResponse) ProtoMessage()    {}
+func (*StreamFundingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13b6d29860ccef6b, []int{26}
+}
+func (m *StreamFundingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamFundingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamFundingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *StreamFundingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamFundingHistoryResponse.Merge(m, src)
+}
+func (m *StreamFundingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamFundingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamFundingHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamFundingHistoryResponse proto.InternalMessageInfo
+
+func (m *StreamFundingHistoryResponse) GetSnapshot() PerpetualFundingIndexSnapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return dAtA[:n], nil
+	return PerpetualFundingIndexSnapshot{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualRequest")
+	proto.RegisterType((*QueryPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualResponse")
+	proto.RegisterType((*QueryAllPerpetualsRequest)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsRequest")
+	proto.RegisterType((*QueryAllPerpetualsResponse)(nil), "dydxprotocol.perpetuals.QueryAllPerpetualsResponse")
+	proto.RegisterType((*QueryAllLiquidityTiersRequest)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersRequest")
+	proto.RegisterType((*QueryAllLiquidityTiersResponse)(nil), "dydxprotocol.perpetuals.QueryAllLiquidityTiersResponse")
+	proto.RegisterType((*QueryPremiumVotesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesRequest")
+	proto.RegisterType((*QueryPremiumVotesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumVotesResponse")
+	proto.RegisterType((*QueryPremiumSamplesRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesRequest")
+	proto.RegisterType((*QueryPremiumSamplesResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumSamplesResponse")
+	proto.RegisterType((*QueryPremiumAccumulationStatusRequest)(nil), "dydxprotocol.perpetuals.QueryPremiumAccumulationStatusRequest")
+	proto.RegisterType((*QueryPremiumAccumulationStatusResponse)(nil), "dydxprotocol.perpetuals.QueryPremiumAccumulationStatusResponse")
+	proto.RegisterType((*MarketPremiumAccumulationStatus)(nil), "dydxprotocol.perpetuals.MarketPremiumAccumulationStatus")
+	proto.RegisterType((*QueryNetFundingPerPerpetualRequest)(nil), "dydxprotocol.perpetuals.QueryNetFundingPerPerpetualRequest")
+	proto.RegisterType((*QueryNetFundingPerPerpetualResponse)(nil), "dydxprotocol.perpetuals.QueryNetFundingPerPerpetualResponse")
+	proto.RegisterType((*QueryAllOpenInterestRequest)(nil), "dydxprotocol.perpetuals.QueryAllOpenInterestRequest")
+	proto.RegisterType((*QueryAllOpenInterestResponse)(nil), "dydxprotocol.perpetuals.QueryAllOpenInterestResponse")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "dydxprotocol.perpetuals.QueryAllOpenInterestResponse.OpenInterestEntry")
+	proto.RegisterType((*QueryPerpetualMarginFractionsRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualMarginFractionsRequest")
+	proto.RegisterType((*QueryPerpetualMarginFractionsResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualMarginFractionsResponse")
+	proto.RegisterType((*QueryPerpetualMetadataRequest)(nil), "dydxprotocol.perpetuals.QueryPerpetualMetadataRequest")
+	proto.RegisterType((*QueryPerpetualMetadataResponse)(nil), "dydxprotocol.perpetuals.QueryPerpetualMetadataResponse")
+	proto.RegisterType((*QueryCurrentFundingRateRequest)(nil), "dydxprotocol.perpetuals.QueryCurrentFundingRateRequest")
+	proto.RegisterType((*QueryCurrentFundingRateResponse)(nil), "dydxprotocol.perpetuals.QueryCurrentFundingRateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.perpetuals.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.perpetuals.QueryParamsResponse")
+	proto.RegisterType((*StreamFundingHistoryRequest)(nil), "dydxprotocol.perpetuals.StreamFundingHistoryRequest")
+	proto.RegisterType((*StreamFundingHistoryResponse)(nil), "dydxprotocol.perpetuals.StreamFundingHistoryResponse")
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dydxprotocol/perpetuals/query.proto", fileDescriptor_13b6d29860ccef6b)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_13b6d29860ccef6b = []byte{
+	// 1470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x13, 0xd7,
+	0x17, 0xce, 0x4d, 0x00, 0xc1, 0x21, 0x0f, 0x72, 0xc9, 0x8f, 0x5f, 0x18, 0x82, 0x53, 0x06, 0x48,
+	0xd2, 0x34, 0x9d, 0x81, 0x10, 0x20, 0xa2, 0x3c, 0x83, 0x1a, 0x88, 0x04, 0x6d, 0x6a, 0x53, 0x54,
+	0x75, 0xe3, 0xde, 0xd8, 0x17, 0x67, 0xc4, 0xbc, 0x98, 0xb9, 0x13, 0xc5, 0x20, 0x36, 0x95, 0xba,
+	0xeb, 0xa2, 0x52, 0xd7, 0x95, 0xba, 0x68, 0x97, 0x74, 0xd9, 0x4a, 0x5d, 0x54, 0xdd, 0x54, 0xa2,
+	0x8b, 0x4a, 0x48, 0xdd, 0x54, 0x54, 0x42, 0x15, 0x74, 0xd9, 0xfe, 0x0f, 0xd5, 0xdc, 0x39, 0x63,
+	0xcf, 0xd8, 0x1e, 0x8f, 0x1d, 0xb1, 0xb3, 0xef, 0x79, 0x7d, 0xe7, 0xbb, 0xe7, 0x1e, 0x7f, 0x09,
+	0x1c, 0xaf, 0xd6, 0xab, 0xdb, 0xae, 0xe7, 0x08, 0xa7, 0xe2, 0x98, 0xba, 0xcb, 0x3d, 0x97, 0x8b,
+	0x80, 0x99, 0xbe, 0xfe, 0x20, 0xe0, 0x5e, 0x5d, 0x93, 0x16, 0xfa, 0xff, 0xa4, 0x93, 0xd6, 0x74,
+	0x52, 0x26, 0x6a, 0x4e, 0xcd, 0x91, 0x06, 0x3d, 0xfc, 0x14, 0xb9, 0x2b, 0x53, 0x35, 0xc7, 0xa9,
+	0x99, 0x5c, 0x67, 0xae, 0xa1, 0x33, 0xdb, 0x76, 0x04, 0x13, 0x86, 0x63, 0xfb, 0x68, 0x9d, 0xaf,
+	0x38, 0xbe, 0xe5, 0xf8, 0xfa, 0x06, 0xf3, 0x79, 0x54, 0x45, 0xdf, 0x3a, 0xbd, 0xc1, 0x05, 0x3b,
+	0xad, 0xbb, 0xac, 0x66, 0xd8, 0xd2, 0x19, 0x7d, 0x4f, 0x64, 0xa1, 0x73, 0x99, 0xc7, 0xac, 0x38,
+	0xe3, 0x6c, 0xa6, 0x57, 0xfc, 0x31, 0x72, 0x54, 0x67, 0xe1, 0x7f, 0x1f, 0x84, 0x05, 0xd7, 0xe3,
+	0xf3, 0x22, 0x7f, 0x10, 0x70, 0x5f, 0xd0, 0x51, 0x18, 0x34, 0xaa, 0x93, 0xe4, 0x0d, 0x32, 0x37,
+	0x52, 0x1c, 0x34, 0xaa, 0xea, 0x27, 0x70, 0xa8, 0xd5, 0xd1, 0x77, 0x1d, 0xdb, 0xe7, 0x74, 0x15,
+	0xf6, 0x35, 0xb2, 0xca, 0x80, 0xfd, 0x8b, 0xaa, 0x96, 0x41, 0x8f, 0xd6, 0x08, 0x5f, 0xd9, 0xf5,
+	0xf4, 0xc5, 0xf4, 0x40, 0xb1, 0x19, 0xaa, 0x56, 0xe0, 0xb0, 0xac, 0x70, 0xcd, 0x34, 0x1b, 0x5e,
+	0x7e, 0x0c, 0x67, 0x15, 0xa0, 0x49, 0x05, 0x56, 0x99, 0xd1, 0x22, 0xde, 0xb4, 0x90, 0x37, 0x2d,
+	0xba, 0x1d, 0xe4, 0x4d, 0x5b, 0x67, 0x35, 0x8e, 0xb1, 0xc5, 0x44, 0xa4, 0xfa, 0x84, 0x80, 0xd2,
+	0xa9, 0x4a, 0xe7, 0x5e, 0x86, 0x76, 0xd8, 0x0b, 0xbd, 0x91, 0x82, 0x3b, 0x28, 0xe1, 0xce, 0xe6,
+	0xc2, 0x8d, 0x40, 0xa4, 0xf0, 0xd6, 0xe0, 0x68, 0x0c, 0xf7, 0x96, 0xf1, 0x20, 0x30, 0xaa, 0x86,
+	0xa8, 0xdf, 0x31, 0xb8, 0xf7, 0xda, 0x89, 0xf9, 0x99, 0x40, 0x21, 0xab, 0x12, 0x92, 0xf3, 0x21,
+	0x8c, 0x99, 0xb1, 0xa5, 0x2c, 0x42, 0x13, 0x52, 0x34, 0x93, 0x49, 0x51, 0x2a, 0x13, 0xd2, 0x34,
+	0x6a, 0xa6, 0xd2, 0xbf, 0x3e, 0xae, 0x14, 0x98, 0x8c, 0x46, 0xd4, 0xe3, 0x96, 0x11, 0x58, 0x77,
+	0x1d, 0xc1, 0x63, 0x9a, 0x54, 0x0b, 0x0e, 0x77, 0xb0, 0x61, 0x63, 0xeb, 0x30, 0xe2, 0x46, 0xe7,
+	0xe5, 0xad, 0xd0, 0x80, 0x34, 0x9e, 0xcc, 0xbe, 0xf9, 0xc8, 0xbb, 0x24, 0x1c, 0x8f, 0x63, 0x57,
+	0xc3, 0x6e, 0x22, 0xb3, 0x3a, 0x85, 0x53, 0x16, 0x3b, 0x32, 0xcb, 0x35, 0x9b, 0x60, 0x7c, 0x38,
+	0xd2, 0xd1, 0x8a, 0x70, 0xee, 0xc0, 0x58, 0x0c, 0xc7, 0x8f, 0x4c, 0x3b, 0x01, 0x34, 0xea, 0xa6,
+	0xb2, 0xab, 0xb3, 0x70, 0x32, 0x59, 0xf4, 0x5a, 0xa5, 0x12, 0x58, 0x81, 0x29, 0x99, 0x2b, 0x09,
+	0x26, 0x82, 0x06, 0xba, 0x7f, 0x09, 0xcc, 0xe4, 0x79, 0x22, 0x52, 0x0d, 0x0e, 0xda, 0x81, 0x55,
+	0xee, 0x84, 0x76, 0xa4, 0x38, 0x6e, 0x07, 0x56, 0xba, 0x43, 0x3a, 0x0f, 0xe3, 0x49, 0xff, 0x88,
+	0xec, 0x41, 0xe9, 0x3d, 0xd6, 0xf4, 0x96, 0x14, 0xd2, 0x1a, 0x8c, 0x59, 0xcc, 0xbb, 0xcf, 0x45,
+	0xd9, 0x97, 0x45, 0xb9, 0x3f, 0x39, 0x24, 0xa7, 0x6d, 0x39, 0x93, 0x85, 0xdb, 0xd2, 0x3f, 0x13,
+	0x76, 0x4c, 0x4c, 0x94, 0xb6, 0x84, 0x59, 0xd5, 0xaf, 0x09, 0x4c, 0xe7, 0x44, 0xd2, 0x63, 0x30,
+	0xdc, 0xa8, 0x53, 0x6e, 0xec, 0xc5, 0xfd, 0x8d, 0xb3, 0xb5, 0x6a, 0x16, 0x17, 0x83, 0x7d, 0x71,
+	0x31, 0xd4, 0x91, 0x0b, 0xf5, 0x02, 0xa8, 0xf2, 0x46, 0xde, 0xe3, 0x62, 0x35, 0xb0, 0xab, 0x86,
+	0x5d, 0x5b, 0xe7, 0x5e, 0xdb, 0xca, 0x9e, 0x80, 0xdd, 0xdc, 0x75, 0x2a, 0x9b, 0x88, 0x2e, 0xfa,
+	0xa2, 0x3e, 0x84, 0xe3, 0x5d, 0x63, 0xf1, 0x2a, 0x4b, 0xb0, 0xdf, 0xe6, 0xa2, 0x7c, 0x2f, 0x72,
+	0xc1, 0x87, 0xbd, 0x90, 0xbf, 0xfb, 0x9a, 0x69, 0x91, 0x5e, 0xb0, 0x1b, 0x27, 0xea, 0x51, 0x1c,
+	0xf4, 0x6b, 0xa6, 0xf9, 0xbe, 0xcb, 0xed, 0x35, 0x5b, 0x70, 0x2f, 0x5c, 0x3c, 0x38, 0x69, 0xbf,
+	0x11, 0x98, 0xea, 0x6c, 0x47, 0x50, 0x26, 0x8c, 0x38, 0x2e, 0xb7, 0xcb, 0x06, 0x1a, 0x10, 0xd6,
+	0x8d, 0x4c, 0x58, 0xdd, 0xb2, 0x69, 0xc9, 0xc3, 0x77, 0x6d, 0xe1, 0xd5, 0x8b, 0xc3, 0x4e, 0xe2,
+	0x48, 0xb9, 0x02, 0xe3, 0x6d, 0x2e, 0xf4, 0x00, 0x0c, 0xdd, 0xe7, 0x75, 0xa4, 0x34, 0xfc, 0x18,
+	0xd2, 0xbc, 0xc5, 0xcc, 0x80, 0xcb, 0xab, 0xdd, 0x55, 0x8c, 0xbe, 0x5c, 0x18, 0x5c, 0x26, 0xea,
+	0x1a, 0x9c, 0x48, 0xff, 0x46, 0xde, 0x66, 0x5e, 0xcd, 0xb0, 0x57, 0x3d, 0x56, 0x91, 0x3f, 0xf7,
+	0xf1, 0x45, 0xe5, 0x4f, 0x93, 0xfa, 0x0f, 0x89, 0x9f, 0x6b, 0x66, 0x2e, 0xe4, 0xe8, 0x24, 0x8c,
+	0xa6, 0xb7, 0x32, 0xa6, 0x1b, 0x49, 0xad, 0x59, 0xba, 0x00, 0xd4, 0xb0, 0x0d, 0x61, 0x30, 0xb3,
+	0x6c, 0xc9, 0x4c, 0x65, 0xd7, 0xb5, 0x70, 0x3a, 0x0f, 0xa0, 0x25, 0x2a, 0xb1, 0xee, 0x5a, 0x74,
+	0x19, 0x26, 0x2d, 0x16, 0xb2, 0x6e, 0x33, 0xbb, 0xc2, 0xcb, 0xf7, 0xb0, 0xaa, 0x8c, 0x89, 0x66,
+	0xf4, 0x50, 0xc2, 0x1e, 0x83, 0x0a, 0x23, 0x97, 0x20, 0x69, 0x49, 0xd6, 0xda, 0x25, 0xe3, 0x26,
+	0x12, 0xd6, 0x46, 0x3d, 0x75, 0x05, 0x8e, 0xb6, 0x74, 0xcb, 0x05, 0xab, 0x32, 0xc1, 0xfa, 0xa0,
+	0xcc, 0x86, 0x42, 0x56, 0x0e, 0xa4, 0xea, 0x16, 0xec, 0xb5, 0xf0, 0x0c, 0x37, 0xea, 0x7c, 0xfe,
+	0x80, 0xc7, 0x59, 0x70, 0xbc, 0x1b, 0x19, 0xd4, 0xeb, 0x58, 0xef, 0x7a, 0xe0, 0x79, 0xdc, 0x8e,
+	0x67, 0xbe, 0xc8, 0x04, 0xef, 0x03, 0xf4, 0x8f, 0x04, 0xa6, 0x33, 0xb3, 0x20, 0xec, 0x39, 0x38,
+	0x80, 0xcf, 0xb2, 0xec, 0x31, 0xc1, 0x25, 0x99, 0x61, 0xaa, 0xdd, 0xc5, 0xd1, 0x7b, 0x4d, 0xf7,
+	0x90, 0x7c, 0x0b, 0x46, 0x62, 0x4f, 0xc3, 0xae, 0xf2, 0x6d, 0x79, 0xbf, 0xc3, 0x2b, 0x37, 0x43,
+	0xe4, 0xcf, 0x5f, 0x4c, 0x5f, 0xad, 0x19, 0x62, 0x33, 0xd8, 0xd0, 0x2a, 0x8e, 0xa5, 0xa7, 0x04,
+	0xe2, 0xd6, 0xd2, 0xdb, 0x95, 0x4d, 0x66, 0xd8, 0x7a, 0xe3, 0xa4, 0x2a, 0xea, 0x2e, 0xf7, 0xb5,
+	0x12, 0xf7, 0x0c, 0x66, 0x1a, 0x0f, 0xd9, 0x86, 0xc9, 0xd7, 0x6c, 0x51, 0x1c, 0xc6, 0xf4, 0x6b,
+	0x61, 0x76, 0x75, 0x02, 0x68, 0xc4, 0xb8, 0x94, 0x9e, 0xf1, 0xab, 0xbe, 0x03, 0x07, 0x53, 0xa7,
+	0xd8, 0xc5, 0x25, 0xd8, 0x13, 0x49, 0x54, 0xa4, 0x7e, 0x3a, 0x9b, 0x7a, 0xe9, 0x86, 0x7c, 0x63,
+	0x90, 0x7a, 0x15, 0x8e, 0x94, 0x84, 0xc7, 0x99, 0x85, 0x0c, 0xdd, 0x34, 0x7c, 0xe1, 0x78, 0xf5,
+	0x3e, 0xa8, 0xde, 0x86, 0xa9, 0xce, 0x19, 0x10, 0xe0, 0x47, 0xb0, 0xd7, 0xb7, 0x99, 0xeb, 0x6f,
+	0x3a, 0x02, 0x21, 0x9e, 0xcb, 0x9f, 0x8e, 0xd5, 0x04, 0x1f, 0x25, 0x8c, 0x8e, 0x27, 0x25, 0xce,
+	0xb6, 0xf8, 0x03, 0x85, 0xdd, 0x92, 0x12, 0xfa, 0x15, 0x81, 0x7d, 0x8d, 0x58, 0xaa, 0x75, 0xdf,
+	0x63, 0xad, 0x0b, 0x5e, 0xd1, 0x7b, 0xf6, 0x8f, 0x5a, 0x52, 0xf5, 0x4f, 0x7f, 0xff, 0xfb, 0xcb,
+	0xc1, 0x37, 0xe9, 0xac, 0x9e, 0xfb, 0xf7, 0x80, 0xfe, 0xc8, 0xa8, 0x3e, 0xa6, 0xdf, 0x10, 0x18,
+	0x49, 0x29, 0x63, 0xba, 0x98, 0xbb, 0x6b, 0xdb, 0xc4, 0xba, 0x72, 0xa6, 0xaf, 0x18, 0xc4, 0x3a,
+	0x2f, 0xb1, 0x9e, 0xa0, 0x6a, 0x3e, 0x56, 0xfa, 0x3d, 0x81, 0xf1, 0x36, 0x9d, 0x4a, 0xcf, 0xe5,
+	0x96, 0xed, 0x28, 0xa1, 0x95, 0xf3, 0x7d, 0xc7, 0x21, 0xe4, 0x53, 0x12, 0xf2, 0x3c, 0x9d, 0xcb,
+	0x84, 0xdc, 0xa2, 0x97, 0xe9, 0xb7, 0x04, 0x86, 0x53, 0x2a, 0xe7, 0x74, 0xce, 0x95, 0xb6, 0x4b,
+	0x59, 0x65, 0xb1, 0x9f, 0x10, 0x44, 0xaa, 0x49, 0xa4, 0x73, 0x74, 0x26, 0x9b, 0xdc, 0xa4, 0x0e,
+	0xa1, 0x4f, 0x08, 0x8c, 0xb6, 0xe8, 0x95, 0x33, 0x3d, 0x95, 0x4d, 0x2b, 0x5d, 0x65, 0xa9, 0xbf,
+	0xa0, 0x9e, 0x79, 0x6d, 0x51, 0x59, 0xf4, 0x39, 0x81, 0xc3, 0xd9, 0xea, 0xed, 0x72, 0x4f, 0x28,
+	0x32, 0x15, 0xb1, 0x72, 0x65, 0xc7, 0xf1, 0xd8, 0xd0, 0x45, 0xd9, 0xd0, 0x39, 0xba, 0x94, 0xdb,
+	0x10, 0x4b, 0x24, 0x41, 0xe1, 0x4b, 0x7f, 0x25, 0x70, 0xa8, 0xb3, 0x7a, 0xa3, 0xef, 0x74, 0x47,
+	0xd6, 0x55, 0x2f, 0x2a, 0x17, 0x77, 0x16, 0x8c, 0x3d, 0x2d, 0xc9, 0x9e, 0x34, 0xba, 0x90, 0xd9,
+	0x53, 0x42, 0x4f, 0xea, 0x8f, 0xa4, 0x18, 0x7d, 0x4c, 0xbf, 0x23, 0x30, 0xd6, 0xa2, 0xcf, 0xe8,
+	0x52, 0x9f, 0x72, 0x2e, 0x42, 0x7f, 0x76, 0x47, 0x22, 0xb0, 0x87, 0x97, 0x90, 0x52, 0x9c, 0xf4,
+	0x4f, 0x02, 0x93, 0x59, 0x1a, 0x8c, 0x5e, 0xea, 0x71, 0x21, 0x77, 0xd6, 0x81, 0xca, 0xe5, 0x9d,
+	0x86, 0x63, 0x2f, 0x57, 0x65, 0x2f, 0x17, 0xe8, 0x72, 0x66, 0x2f, 0x28, 0xbf, 0x62, 0xfd, 0xe6,
+	0xeb, 0x8f, 0x92, 0xbf, 0x92, 0x8f, 0xe9, 0x4f, 0x04, 0xc6, 0xdb, 0x94, 0x4e, 0xde, 0x22, 0xcd,
+	0x12, 0x69, 0xca, 0xf9, 0xbe, 0xe3, 0xb0, 0x91, 0x65, 0xd9, 0xc8, 0x22, 0x3d, 0x95, 0xdd, 0x08,
+	0x86, 0xb4, 0x36, 0xf0, 0x0b, 0x01, 0xda, 0x2e, 0x9d, 0x68, 0x0e, 0x92, 0x4c, 0xc9, 0xa6, 0x2c,
+	0xf7, 0x1f, 0xd8, 0xf3, 0x1b, 0x4f, 0x8a, 0xb8, 0xd6, 0x3e, 0x3e, 0x27, 0xb0, 0x27, 0xd2, 0x3d,
+	0xf4, 0xad, 0x1c, 0x16, 0x93, 0x62, 0x4b, 0x59, 0xe8, 0xcd, 0x19, 0x31, 0xce, 0x4a, 0x8c, 0xc7,
+	0xe8, 0xb4, 0xde, 0xfd, 0xbf, 0x88, 0xf4, 0x33, 0x02, 0x13, 0x9d, 0xc4, 0x52, 0x97, 0xb7, 0xda,
+	0x45, 0x9d, 0x29, 0x67, 0xfb, 0x8c, 0x8a, 0xe0, 0x9e, 0x22, 0x2b, 0x77, 0x9f, 0xbe, 0x2c, 0x90,
+	0x67, 0x2f, 0x0b, 0xe4, 0xaf, 0x97, 0x05, 0xf2, 0xc5, 0xab, 0xc2, 0xc0, 0xb3, 0x57, 0x85, 0x81,
+	0x3f, 0x5e, 0x15, 0x06, 0x3e, 0xbe, 0xd8, 0xbb, 0x96, 0xdd, 0x4e, 0x36, 0x28, 0x75, 0xed, 0xc6,
+	0x1e, 0x69, 0x3c, 0xf3, 0xdf, 0x00, 0x48, 0xd6, 0x15, 0x56, 0xec, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries a Perpetual by id.
+	Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error)
+	// Queries a list of Perpetual items.
+	AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error)
+	// Queries a list of LiquidityTiers.
+	AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error)
+	// Queries a list of premium votes.
+	PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error)
+	// Queries a list of premium samples.
+	PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error)
+	// Queries the number of premiums accumulated so far in the current funding
+	// sample and funding tick epochs.
+	PremiumAccumulationStatus(ctx context.Context, in *QueryPremiumAccumulationStatusRequest, opts ...grpc.CallOption) (*QueryPremiumAccumulationStatusResponse, error)
+	// Queries the net funding settled per perpetual during a `funding-tick`
+	// epoch.
+	NetFundingPerPerpetual(ctx context.Context, in *QueryNetFundingPerPerpetualRequest, opts ...grpc.CallOption) (*QueryNetFundingPerPerpetualResponse, error)
+	// Queries the open interest of all perpetuals.
+	AllOpenInterest(ctx context.Context, in *QueryAllOpenInterestRequest, opts ...grpc.CallOption) (*QueryAllOpenInterestResponse, error)
+	// Queries the initial margin, maintenance fraction and effective
+	// maintenance margin of a perpetual, as defined by its liquidity tier.
+	PerpetualMarginFractions(ctx context.Context, in *QueryPerpetualMarginFractionsRequest, opts ...grpc.CallOption) (*QueryPerpetualMarginFractionsResponse, error)
+	// Queries the creation metadata of a perpetual.
+	PerpetualMetadata(ctx context.Context, in *QueryPerpetualMetadataRequest, opts ...grpc.CallOption) (*QueryPerpetualMetadataResponse, error)
+	// Queries the most recent funding rate and the current funding index of a
+	// perpetual.
+	CurrentFundingRate(ctx context.Context, in *QueryCurrentFundingRateRequest, opts ...grpc.CallOption) (*QueryCurrentFundingRateResponse, error)
+	// Queries the perpetual params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Streams the retained funding index snapshots of a perpetual, from oldest
+	// to newest.
+	StreamFundingHistory(ctx context.Context, in *StreamFundingHistoryRequest, opts ...grpc.CallOption) (Query_StreamFundingHistoryClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Perpetual(ctx context.Context, in *QueryPerpetualRequest, opts ...grpc.CallOption) (*QueryPerpetualResponse, error) {
+	out := new(QueryPerpetualResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/Perpetual", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllPerpetuals(ctx context.Context, in *QueryAllPerpetualsRequest, opts ...grpc.CallOption) (*QueryAllPerpetualsResponse, error) {
+	out := new(QueryAllPerpetualsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllPerpetuals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) AllLiquidityTiers(ctx context.Context, in *QueryAllLiquidityTiersRequest, opts ...grpc.CallOption) (*QueryAllLiquidityTiersResponse, error) {
+	out := new(QueryAllLiquidityTiersResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllLiquidityTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PremiumVotes(ctx context.Context, in *QueryPremiumVotesRequest, opts ...grpc.CallOption) (*QueryPremiumVotesResponse, error) {
+	out := new(QueryPremiumVotesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryPerpetualRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+
+func (c *queryClient) PremiumSamples(ctx context.Context, in *QueryPremiumSamplesRequest, opts ...grpc.CallOption) (*QueryPremiumSamplesResponse, error) {
+	out := new(QueryPremiumSamplesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumSamples", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPerpetualResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PremiumAccumulationStatus(ctx context.Context, in *QueryPremiumAccumulationStatusRequest, opts ...grpc.CallOption) (*QueryPremiumAccumulationStatusResponse, error) {
+	out := new(QueryPremiumAccumulationStatusResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PremiumAccumulationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Perpetual.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPerpetualsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) NetFundingPerPerpetual(ctx context.Context, in *QueryNetFundingPerPerpetualRequest, opts ...grpc.CallOption) (*QueryNetFundingPerPerpetualResponse, error) {
+	out := new(QueryNetFundingPerPerpetualResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/NetFundingPerPerpetual", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPerpetualsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Perpetual) > 0 {
-		for _, e := range m.Perpetual {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) AllOpenInterest(ctx context.Context, in *QueryAllOpenInterestRequest, opts ...grpc.CallOption) (*QueryAllOpenInterestResponse, error) {
+	out := new(QueryAllOpenInterestResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/AllOpenInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PerpetualMarginFractions(ctx context.Context, in *QueryPerpetualMarginFractionsRequest, opts ...grpc.CallOption) (*QueryPerpetualMarginFractionsResponse, error) {
+	out := new(QueryPerpetualMarginFractionsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PerpetualMarginFractions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllLiquidityTiersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PerpetualMetadata(ctx context.Context, in *QueryPerpetualMetadataRequest, opts ...grpc.CallOption) (*QueryPerpetualMetadataResponse, error) {
+	out := new(QueryPerpetualMetadataResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/PerpetualMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CurrentFundingRate(ctx context.Context, in *QueryCurrentFundingRateRequest, opts ...grpc.CallOption) (*QueryCurrentFundingRateResponse, error) {
+	out := new(QueryCurrentFundingRateResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/CurrentFundingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllLiquidityTiersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.perpetuals.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LiquidityTiers) > 0 {
-		for _, e := range m.LiquidityTiers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) StreamFundingHistory(ctx context.Context, in *StreamFundingHistoryRequest, opts ...grpc.CallOption) (Query_StreamFundingHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/dydxprotocol.perpetuals.Query/StreamFundingHistory", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	x := &queryStreamFundingHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return n
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *QueryPremiumVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type Query_StreamFundingHistoryClient interface {
+	Recv() (*StreamFundingHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamFundingHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamFundingHistoryClient) Recv() (*StreamFundingHistoryResponse, error) {
+	m := new(StreamFundingHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return m, nil
 }
 
-func (m *QueryPremiumVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries a Perpetual by id.
+	Perpetual(context.Context, *QueryPerpetualRequest) (*QueryPerpetualResponse, error)
+	// Queries a list of Perpetual items.
+	AllPerpetuals(context.Context, *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error)
+	// Queries a list of LiquidityTiers.
+	AllLiquidityTiers(context.Context, *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error)
+	// Queries a list of premium votes.
+	PremiumVotes(context.Context, *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error)
+	// Queries a list of premium samples.
+	PremiumSamples(context.Context, *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error)
+	// Queries the number of premiums accumulated so far in the current funding
+	// sample and funding tick epochs.
+	PremiumAccumulationStatus(context.Context, *QueryPremiumAccumulationStatusRequest) (*QueryPremiumAccumulationStatusResponse, error)
+	// Queries the net funding settled per perpetual during a `funding-tick`
+	// epoch.
+	NetFundingPerPerpetual(context.Context, *QueryNetFundingPerPerpetualRequest) (*QueryNetFundingPerPerpetualResponse, error)
+	// Queries the open interest of all perpetuals.
+	AllOpenInterest(context.Context, *QueryAllOpenInterestRequest) (*QueryAllOpenInterestResponse, error)
+	// Queries the initial margin, maintenance fraction and effective
+	// maintenance margin of a perpetual, as defined by its liquidity tier.
+	PerpetualMarginFractions(context.Context, *QueryPerpetualMarginFractionsRequest) (*QueryPerpetualMarginFractionsResponse, error)
+	// Queries the creation metadata of a perpetual.
+	PerpetualMetadata(context.Context, *QueryPerpetualMetadataRequest) (*QueryPerpetualMetadataResponse, error)
+	// Queries the most recent funding rate and the current funding index of a
+	// perpetual.
+	CurrentFundingRate(context.Context, *QueryCurrentFundingRateRequest) (*QueryCurrentFundingRateResponse, error)
+	// Queries the perpetual params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Streams the retained funding index snapshots of a perpetual, from oldest
+	// to newest.
+	StreamFundingHistory(*StreamFundingHistoryRequest, Query_StreamFundingHistoryServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Perpetual(ctx context.Context, req *QueryPerpetualRequest) (*QueryPerpetualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Perpetual not implemented")
+}
+func (*UnimplementedQueryServer) AllPerpetuals(ctx context.Context, req *QueryAllPerpetualsRequest) (*QueryAllPerpetualsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPerpetuals not implemented")
+}
+func (*UnimplementedQueryServer) AllLiquidityTiers(ctx context.Context, req *QueryAllLiquidityTiersRequest) (*QueryAllLiquidityTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllLiquidityTiers not implemented")
+}
+func (*UnimplementedQueryServer) PremiumVotes(ctx context.Context, req *QueryPremiumVotesRequest) (*QueryPremiumVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PremiumVotes not implemented")
+}
+func (*UnimplementedQueryServer) PremiumSamples(ctx context.Context, req *QueryPremiumSamplesRequest) (*QueryPremiumSamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PremiumSamples not implemented")
+}
+func (*UnimplementedQueryServer) PremiumAccumulationStatus(ctx context.Context, req *QueryPremiumAccumulationStatusRequest) (*QueryPremiumAccumulationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PremiumAccumulationStatus not implemented")
+}
+func (*UnimplementedQueryServer) NetFundingPerPerpetual(ctx context.Context, req *QueryNetFundingPerPerpetualRequest) (*QueryNetFundingPerPerpetualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetFundingPerPerpetual not implemented")
+}
+func (*UnimplementedQueryServer) AllOpenInterest(ctx context.Context, req *QueryAllOpenInterestRequest) (*QueryAllOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllOpenInterest not implemented")
+}
+func (*UnimplementedQueryServer) PerpetualMarginFractions(ctx context.Context, req *QueryPerpetualMarginFractionsRequest) (*QueryPerpetualMarginFractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerpetualMarginFractions not implemented")
+}
+func (*UnimplementedQueryServer) PerpetualMetadata(ctx context.Context, req *QueryPerpetualMetadataRequest) (*QueryPerpetualMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerpetualMetadata not implemented")
+}
+func (*UnimplementedQueryServer) CurrentFundingRate(ctx context.Context, req *QueryCurrentFundingRateRequest) (*QueryCurrentFundingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentFundingRate not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) StreamFundingHistory(req *StreamFundingHistoryRequest, srv Query_StreamFundingHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFundingHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Perpetual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PremiumVotes.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Perpetual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/Perpetual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Perpetual(ctx, req.(*QueryPerpetualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPremiumSamplesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllPerpetuals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPerpetualsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPerpetuals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/AllPerpetuals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPerpetuals(ctx, req.(*QueryAllPerpetualsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllLiquidityTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLiquidityTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllLiquidityTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/AllLiquidityTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllLiquidityTiers(ctx, req.(*QueryAllLiquidityTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PremiumVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPremiumVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PremiumVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PremiumVotes(ctx, req.(*QueryPremiumVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PremiumSamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPremiumSamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PremiumSamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumSamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PremiumSamples(ctx, req.(*QueryPremiumSamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PremiumAccumulationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPremiumAccumulationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PremiumAccumulationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PremiumAccumulationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PremiumAccumulationStatus(ctx, req.(*QueryPremiumAccumulationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetFundingPerPerpetual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetFundingPerPerpetualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetFundingPerPerpetual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/NetFundingPerPerpetual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetFundingPerPerpetual(ctx, req.(*QueryNetFundingPerPerpetualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOpenInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllOpenInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/AllOpenInterest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllOpenInterest(ctx, req.(*QueryAllOpenInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerpetualMarginFractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualMarginFractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerpetualMarginFractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PerpetualMarginFractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerpetualMarginFractions(ctx, req.(*QueryPerpetualMarginFractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerpetualMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerpetualMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerpetualMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/PerpetualMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerpetualMetadata(ctx, req.(*QueryPerpetualMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentFundingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentFundingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentFundingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/CurrentFundingRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentFundingRate(ctx, req.(*QueryCurrentFundingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.perpetuals.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamFundingHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFundingHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamFundingHistory(m, &queryStreamFundingHistoryServer{stream})
+}
+
+type Query_StreamFundingHistoryServer interface {
+	Send(*StreamFundingHistoryResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamFundingHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamFundingHistoryServer) Send(m *StreamFundingHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dydxprotocol.perpetuals.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Perpetual",
+			Handler:    _Query_Perpetual_Handler,
+		},
+		{
+			MethodName: "AllPerpetuals",
+			Handler:    _Query_AllPerpetuals_Handler,
+		},
+		{
+			MethodName: "AllLiquidityTiers",
+			Handler:    _Query_AllLiquidityTiers_Handler,
+		},
+		{
+			MethodName: "PremiumVotes",
+			Handler:    _Query_PremiumVotes_Handler,
+		},
+		{
+			MethodName: "PremiumSamples",
+			Handler:    _Query_PremiumSamples_Handler,
+		},
+		{
+			MethodName: "PremiumAccumulationStatus",
+			Handler:    _Query_PremiumAccumulationStatus_Handler,
+		},
+		{
+			MethodName: "NetFundingPerPerpetual",
+			Handler:    _Query_NetFundingPerPerpetual_Handler,
+		},
+		{
+			MethodName: "AllOpenInterest",
+			Handler:    _Query_AllOpenInterest_Handler,
+		},
+		{
+			MethodName: "PerpetualMarginFractions",
+			Handler:    _Query_PerpetualMarginFractions_Handler,
+		},
+		{
+			MethodName: "PerpetualMetadata",
+			Handler:    _Query_PerpetualMetadata_Handler,
+		},
+		{
+			MethodName: "CurrentFundingRate",
+			Handler:    _Query_CurrentFundingRate_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFundingHistory",
+			Handler:       _Query_StreamFundingHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dydxprotocol/perpetuals/query.proto",
+}
+
+func (m *QueryPerpetualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Perpetual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPerpetualsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPerpetualsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPerpetualsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPerpetualsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPerpetualsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPerpetualsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Perpetual) > 0 {
+		for iNdEx := len(m.Perpetual) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Perpetual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLiquidityTiersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLiquidityTiersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLiquidityTiersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLiquidityTiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLiquidityTiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLiquidityTiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LiquidityTiers) > 0 {
+		for iNdEx := len(m.LiquidityTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PremiumVotes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumSamplesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumSamplesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumSamplesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumSamplesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumSamplesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumSamplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PremiumSamples.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumAccumulationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumAccumulationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumAccumulationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPremiumAccumulationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPremiumAccumulationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPremiumAccumulationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketStatuses) > 0 {
+		for iNdEx := len(m.MarketStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumPremiumVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPremiumVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumPremiumSamples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPremiumSamples))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketPremiumAccumulationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPremiumAccumulationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPremiumAccumulationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPremiumVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPremiumVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumPremiumSamples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPremiumSamples))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetFundingPerPerpetualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetFundingPerPerpetualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetFundingPerPerpetualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetFundingPerPerpetualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetFundingPerPerpetualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetFundingPerPerpetualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetFunding) > 0 {
+		for iNdEx := len(m.NetFunding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetFunding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOpenInterestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOpenInterestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOpenInterestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOpenInterestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOpenInterestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOpenInterestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OpenInterest) > 0 {
+		for k := range m.OpenInterest {
+			v := m.OpenInterest[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualMarginFractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualMarginFractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualMarginFractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualMarginFractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualMarginFractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualMarginFractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaintenanceMarginPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaintenanceMarginPpm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaintenanceFractionPpm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialMarginPpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitialMarginPpm))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LiquidityTier != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LiquidityTier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPerpetualMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPerpetualMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPerpetualMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentFundingRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFundingRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFundingRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentFundingRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentFundingRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentFundingRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FundingIndex.Size()
+		i -= size
+		if _, err := m.FundingIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.FundingRatePpm != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FundingRatePpm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamFundingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamFundingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamFundingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamFundingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamFundingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamFundingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Perpetual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPerpetualsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPerpetualsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Perpetual) > 0 {
+		for _, e := range m.Perpetual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLiquidityTiersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLiquidityTiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityTiers) > 0 {
+		for _, e := range m.LiquidityTiers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPremiumVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPremiumSamplesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumSamplesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PremiumSamples.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPremiumAccumulationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPremiumAccumulationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPremiumSamples != 0 {
+		n += 1 + sovQuery(uint64(m.NumPremiumSamples))
+	}
+	if m.NumPremiumVotes != 0 {
+		n += 1 + sovQuery(uint64(m.NumPremiumVotes))
+	}
+	if len(m.MarketStatuses) > 0 {
+		for _, e := range m.MarketStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketPremiumAccumulationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	if m.NumPremiumSamples != 0 {
+		n += 1 + sovQuery(uint64(m.NumPremiumSamples))
+	}
+	if m.NumPremiumVotes != 0 {
+		n += 1 + sovQuery(uint64(m.NumPremiumVotes))
+	}
+	return n
+}
+
+func (m *QueryNetFundingPerPerpetualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryNetFundingPerPerpetualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NetFunding) > 0 {
+		for _, e := range m.NetFunding {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllOpenInterestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllOpenInterestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OpenInterest) > 0 {
+		for k, v := range m.OpenInterest {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryPerpetualMarginFractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryPerpetualMarginFractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidityTier != 0 {
+		n += 1 + sovQuery(uint64(m.LiquidityTier))
+	}
+	if m.InitialMarginPpm != 0 {
+		n += 1 + sovQuery(uint64(m.InitialMarginPpm))
+	}
+	if m.MaintenanceFractionPpm != 0 {
+		n += 1 + sovQuery(uint64(m.MaintenanceFractionPpm))
+	}
+	if m.MaintenanceMarginPpm != 0 {
+		n += 1 + sovQuery(uint64(m.MaintenanceMarginPpm))
+	}
+	return n
+}
+
+func (m *QueryPerpetualMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryPerpetualMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentFundingRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *QueryCurrentFundingRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FundingRatePpm != 0 {
+		n += 1 + sovQuery(uint64(m.FundingRatePpm))
+	}
+	l = m.FundingIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StreamFundingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	return n
+}
+
+func (m *StreamFundingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Perpetual = append(m.Perpetual, Perpetual{})
+			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityTiers = append(m.LiquidityTiers, LiquidityTier{})
+			if err := m.LiquidityTiers[len(m.LiquidityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PremiumSamples.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumAccumulationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumAccumulationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumAccumulationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPremiumAccumulationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPremiumAccumulationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPremiumAccumulationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPremiumSamples", wireType)
+			}
+			m.NumPremiumSamples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPremiumSamples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPremiumVotes", wireType)
+			}
+			m.NumPremiumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPremiumVotes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketStatuses = append(m.MarketStatuses, MarketPremiumAccumulationStatus{})
+			if err := m.MarketStatuses[len(m.MarketStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryPremiumSamplesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.PremiumSamples.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MarketPremiumAccumulationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPremiumAccumulationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPremiumAccumulationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPremiumSamples", wireType)
+			}
+			m.NumPremiumSamples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPremiumSamples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPremiumVotes", wireType)
+			}
+			m.NumPremiumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPremiumVotes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryNetFundingPerPerpetualRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetFundingPerPerpetualRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetFundingPerPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNetFundingPerPerpetualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1490,17 +4490,17 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetFundingPerPerpetualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetFundingPerPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetFunding", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1510,11 +4510,76 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetFunding = append(m.NetFunding, PerpetualNetFunding{})
+			if err := m.NetFunding[len(m.NetFunding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOpenInterestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOpenInterestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOpenInterestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1536,7 +4601,7 @@ func (m *QueryPerpetualRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOpenInterestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1559,15 +4624,15 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOpenInterestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPerpetualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOpenInterestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1594,9 +4659,75 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Perpetual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.OpenInterest == nil {
+				m.OpenInterest = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.OpenInterest[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1619,7 +4750,7 @@ func (m *QueryPerpetualResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPerpetualMarginFractionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1642,17 +4773,17 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPerpetualMarginFractionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPerpetualMarginFractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1662,28 +4793,11 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1705,7 +4819,7 @@ func (m *QueryAllPerpetualsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPerpetualMarginFractionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1728,17 +4842,17 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPerpetualMarginFractionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerpetualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPerpetualMarginFractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Perpetual", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTier", wireType)
 			}
-			var msglen int
+			m.LiquidityTier = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1748,31 +4862,16 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LiquidityTier |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Perpetual = append(m.Perpetual, Perpetual{})
-			if err := m.Perpetual[len(m.Perpetual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialMarginPpm", wireType)
 			}
-			var msglen int
+			m.InitialMarginPpm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1782,28 +4881,49 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InitialMarginPpm |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFractionPpm", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaintenanceFractionPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceFractionPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginPpm", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaintenanceMarginPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaintenanceMarginPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1825,7 +4945,7 @@ func (m *QueryAllPerpetualsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPerpetualMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1848,17 +4968,17 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPerpetualMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPerpetualMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
 			}
-			var msglen int
+			m.PerpetualId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1868,28 +4988,11 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PerpetualId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1911,7 +5014,7 @@ func (m *QueryAllLiquidityTiersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPerpetualMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,49 +5037,15 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPerpetualMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLiquidityTiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPerpetualMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityTiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityTiers = append(m.LiquidityTiers, LiquidityTier{})
-			if err := m.LiquidityTiers[len(m.LiquidityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2003,10 +5072,7 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2031,7 +5097,7 @@ func (m *QueryAllLiquidityTiersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentFundingRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,12 +5120,31 @@ func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentFundingRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentFundingRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2081,7 +5166,7 @@ func (m *QueryPremiumVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentFundingRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,17 +5189,36 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentFundingRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentFundingRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRatePpm", wireType)
+			}
+			m.FundingRatePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundingRatePpm |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingIndex", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2124,22 +5228,22 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PremiumVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2164,7 +5268,7 @@ func (m *QueryPremiumVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2187,10 +5291,10 @@ func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumSamplesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumSamplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2214,7 +5318,7 @@ func (m *QueryPremiumSamplesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2237,15 +5341,15 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPremiumSamplesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPremiumSamplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PremiumSamples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2272,7 +5376,7 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PremiumSamples.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2297,7 +5401,7 @@ func (m *QueryPremiumSamplesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamFundingHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,12 +5424,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamFundingHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamFundingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2347,7 +5470,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamFundingHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2370,15 +5493,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamFundingHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamFundingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2405,7 +5528,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -76,6 +76,7 @@ type PerpetualsKeeper interface {
 		marketId uint32,
 		defaultFundingPpm int32,
 		liquidityTier uint32,
+		initialMarginPpmOverride uint32,
 	) (Perpetual, error)
 	ModifyOpenInterest(
 		ctx sdk.Context,
@@ -93,6 +94,7 @@ type PerpetualsKeeper interface {
 		impactNotional uint64,
 		openInterestLowerCap uint64,
 		openInterestUpperCap uint64,
+		premiumCombinationMethod PremiumCombinationMethod,
 	) (
 		liquidityTier LiquidityTier,
 		err error,
@@ -113,8 +115,13 @@ type PerpetualsKeeper interface {
 	GetAllPerpetuals(
 		ctx sdk.Context,
 	) []Perpetual
+	GetPerpetualsForMarket(
+		ctx sdk.Context,
+		marketId uint32,
+	) []Perpetual
 	GetAllLiquidityTiers(ctx sdk.Context) (list []LiquidityTier)
 	SendOIUpdatesToIndexer(ctx sdk.Context)
+	PersistBlockNetFunding(ctx sdk.Context)
 	ValidateAndSetPerpetual(
 		ctx sdk.Context,
 		perpetual Perpetual,
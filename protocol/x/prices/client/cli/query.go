@@ -23,6 +23,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowMarketParam())
 	cmd.AddCommand(CmdListMarketPrice())
 	cmd.AddCommand(CmdShowMarketPrice())
+	cmd.AddCommand(CmdListConfiguredExchanges())
 
 	return cmd
 }
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListConfiguredExchanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-configured-exchanges",
+		Short: "list the distinct exchanges configured across all market params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ConfiguredExchanges(
+				context.Background(),
+				&types.QueryConfiguredExchangesRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -121,3 +121,23 @@ func (k Keeper) MarketParam(
 
 	return &types.QueryMarketParamResponse{MarketParam: val}, nil
 }
+
+func (k Keeper) ConfiguredExchanges(
+	c context.Context,
+	req *types.QueryConfiguredExchangesRequest,
+) (
+	*types.QueryConfiguredExchangesResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	exchangeIds, err := k.GetConfiguredExchanges(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConfiguredExchangesResponse{ExchangeIds: exchangeIds}, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
@@ -236,3 +237,76 @@ func TestMarketParamQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestConfiguredExchangesQuery(t *testing.T) {
+	for name, tc := range map[string]struct {
+		exchangeConfigJsons []string
+		request             *types.QueryConfiguredExchangesRequest
+		response            *types.QueryConfiguredExchangesResponse
+		err                 error
+	}{
+		"No markets": {
+			request:  &types.QueryConfiguredExchangesRequest{},
+			response: &types.QueryConfiguredExchangesResponse{ExchangeIds: []string{}},
+		},
+		"Markets with overlapping exchanges": {
+			exchangeConfigJsons: []string{
+				`{"exchanges":[{"exchangeName":"Binance","ticker":"BTCUSDT"},` +
+					`{"exchangeName":"Kraken","ticker":"XXBTZUSD"}]}`,
+				`{"exchanges":[{"exchangeName":"Kraken","ticker":"XETHZUSD"},` +
+					`{"exchangeName":"CoinbasePro","ticker":"ETH-USD"}]}`,
+				`{"exchanges":[{"exchangeName":"Binance","ticker":"SOLUSDT"},` +
+					`{"exchangeName":"CoinbasePro","ticker":"SOL-USD"},{"exchangeName":"Okx","ticker":"SOL-USDT"}]}`,
+			},
+			request: &types.QueryConfiguredExchangesRequest{},
+			response: &types.QueryConfiguredExchangesResponse{
+				ExchangeIds: []string{"Binance", "CoinbasePro", "Kraken", "Okx"},
+			},
+		},
+		"Markets without exchanges": {
+			exchangeConfigJsons: []string{
+				`{}`,
+				`{"exchanges":[{"exchangeName":"Bybit","ticker":"BTCUSDT"}]}`,
+			},
+			request: &types.QueryConfiguredExchangesRequest{},
+			response: &types.QueryConfiguredExchangesResponse{
+				ExchangeIds: []string{"Bybit"},
+			},
+		},
+		"InvalidRequest": {
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, _, _, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+			mockTimeProvider.On("Now").Return(constants.TimeT)
+			markets := make([]types.MarketParamPrice, len(tc.exchangeConfigJsons))
+			for i, exchangeConfigJson := range tc.exchangeConfigJsons {
+				markets[i] = types.MarketParamPrice{
+					Param: types.MarketParam{
+						Id:                 uint32(i),
+						Pair:               fmt.Sprintf("%v-%v", i, i),
+						Exponent:           -5,
+						MinExchanges:       1,
+						MinPriceChangePpm:  1,
+						ExchangeConfigJson: exchangeConfigJson,
+					},
+					Price: types.MarketPrice{
+						Id:       uint32(i),
+						Exponent: -5,
+						Price:    1_000,
+					},
+				}
+			}
+			keepertest.CreateTestPriceMarkets(t, ctx, keeper, markets)
+
+			response, err := keeper.ConfiguredExchanges(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
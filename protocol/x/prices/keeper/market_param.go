@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"encoding/json"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	pricefeedclienttypes "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/client/types"
 	"github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/slinky"
 
@@ -149,3 +151,25 @@ func (k Keeper) GetAllMarketParams(ctx sdk.Context) []types.MarketParam {
 
 	return allMarketParams
 }
+
+// GetConfiguredExchanges returns the distinct exchange ids referenced by the exchange config json of
+// all market params, sorted in ascending order.
+func (k Keeper) GetConfiguredExchanges(ctx sdk.Context) ([]string, error) {
+	exchangeIds := make(map[string]struct{})
+	for _, marketParam := range k.GetAllMarketParams(ctx) {
+		var exchangeConfigJson pricefeedclienttypes.ExchangeConfigJson
+		if err := json.Unmarshal([]byte(marketParam.ExchangeConfigJson), &exchangeConfigJson); err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidInput,
+				"failed to unmarshal exchange config json for market %d: %v",
+				marketParam.Id,
+				err,
+			)
+		}
+		for _, exchange := range exchangeConfigJson.Exchanges {
+			exchangeIds[exchange.ExchangeName] = struct{}{}
+		}
+	}
+
+	return lib.GetSortedKeys[sort.StringSlice](exchangeIds), nil
+}
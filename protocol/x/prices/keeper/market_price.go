@@ -15,6 +15,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	pricefeedmetrics "github.com/dydxprotocol/v4-chain/protocol/daemons/pricefeed/metrics"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/metrics"
@@ -87,6 +88,11 @@ func (k Keeper) UpdateMarketPrices(
 		// Store the modified market price.
 		b := k.cdc.MustMarshal(&marketPrice)
 		marketPriceStore.Set(lib.Uint32ToKey(marketPrice.Id), b)
+		k.setMarketLastPriceUpdateBlockHeight(
+			ctx,
+			marketPrice.Id,
+			lib.MustConvertIntegerToUint32(ctx.BlockHeight()),
+		)
 
 		// Monitor the last block a market price is updated.
 		telemetry.SetGaugeWithLabels(
@@ -98,6 +104,11 @@ func (k Keeper) UpdateMarketPrices(
 		)
 	}
 
+	// Record the block of the latest price update, which is used to detect oracle prices going stale.
+	if len(updatedMarketPrices) > 0 {
+		k.setLastPriceUpdateBlockHeight(ctx, lib.MustConvertIntegerToUint32(ctx.BlockHeight()))
+	}
+
 	// Generate indexer events.
 	priceUpdateIndexerEvents := GenerateMarketPriceUpdateIndexerEvents(updatedMarketPrices)
 	for _, update := range priceUpdateIndexerEvents {
@@ -183,3 +194,48 @@ func (k Keeper) GetMarketIdToValidIndexPrice(
 	}
 	return ret
 }
+
+// GetLastPriceUpdateBlockHeight returns the last block height at which any market price was updated,
+// or zero if no market price was ever updated.
+func (k Keeper) GetLastPriceUpdateBlockHeight(ctx sdk.Context) uint32 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(types.LastPriceUpdateBlockHeightKey))
+	if b == nil {
+		return 0
+	}
+	var result gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &result)
+	return result.Value
+}
+
+// setLastPriceUpdateBlockHeight sets the last block height at which any market price was updated.
+func (k Keeper) setLastPriceUpdateBlockHeight(ctx sdk.Context, blockHeight uint32) {
+	store := ctx.KVStore(k.storeKey)
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	store.Set([]byte(types.LastPriceUpdateBlockHeightKey), k.cdc.MustMarshal(&value))
+}
+
+// GetMarketLastPriceUpdateBlockHeight returns the last block height at which the price of the market
+// with the given id was updated, or zero if the market price was never updated.
+func (k Keeper) GetMarketLastPriceUpdateBlockHeight(ctx sdk.Context, marketId uint32) uint32 {
+	b := k.getMarketLastPriceUpdateBlockHeightStore(ctx).Get(lib.Uint32ToKey(marketId))
+	if b == nil {
+		return 0
+	}
+	var result gogotypes.UInt32Value
+	k.cdc.MustUnmarshal(b, &result)
+	return result.Value
+}
+
+// setMarketLastPriceUpdateBlockHeight sets the last block height at which the price of the market
+// with the given id was updated.
+func (k Keeper) setMarketLastPriceUpdateBlockHeight(ctx sdk.Context, marketId uint32, blockHeight uint32) {
+	value := gogotypes.UInt32Value{Value: blockHeight}
+	k.getMarketLastPriceUpdateBlockHeightStore(ctx).Set(lib.Uint32ToKey(marketId), k.cdc.MustMarshal(&value))
+}
+
+// getMarketLastPriceUpdateBlockHeightStore returns a prefix store for the per-market last price
+// update block heights.
+func (k Keeper) getMarketLastPriceUpdateBlockHeightStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.MarketLastPriceUpdateBlockHeightKeyPrefix))
+}
@@ -88,6 +88,46 @@ func TestUpdateMarketPrices_NotFound(t *testing.T) {
 	}
 }
 
+func TestUpdateMarketPrices_LastPriceUpdateBlockHeight(t *testing.T) {
+	ctx, keeper, _, _, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+	mockTimeProvider.On("Now").Return(constants.TimeT)
+	ctx = ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateNMarkets(t, ctx, keeper, 2)
+	require.Equal(t, uint32(0), keeper.GetLastPriceUpdateBlockHeight(ctx))
+
+	err := keeper.UpdateMarketPrices(ctx.WithBlockHeight(5), createNMarketPriceUpdates(2))
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), keeper.GetLastPriceUpdateBlockHeight(ctx))
+
+	// A block without any price updates does not change the last price update block height.
+	err = keeper.UpdateMarketPrices(ctx.WithBlockHeight(6), []*types.MsgUpdateMarketPrices_MarketPrice{})
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), keeper.GetLastPriceUpdateBlockHeight(ctx))
+}
+
+func TestUpdateMarketPrices_MarketLastPriceUpdateBlockHeight(t *testing.T) {
+	ctx, keeper, _, _, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+	mockTimeProvider.On("Now").Return(constants.TimeT)
+	ctx = ctx.WithTxBytes(constants.TestTxBytes)
+	keepertest.CreateNMarkets(t, ctx, keeper, 2)
+	require.Equal(t, uint32(0), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 0))
+	require.Equal(t, uint32(0), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 1))
+
+	err := keeper.UpdateMarketPrices(ctx.WithBlockHeight(5), createNMarketPriceUpdates(2))
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 0))
+	require.Equal(t, uint32(5), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 1))
+
+	// Only markets whose price is updated have their last price update block height changed.
+	err = keeper.UpdateMarketPrices(
+		ctx.WithBlockHeight(7),
+		[]*types.MsgUpdateMarketPrices_MarketPrice{{MarketId: 1, Price: 100}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 0))
+	require.Equal(t, uint32(7), keeper.GetMarketLastPriceUpdateBlockHeight(ctx, 1))
+}
+
 func TestGetMarketPrice(t *testing.T) {
 	ctx, keeper, _, _, mockTimeProvider, _ := keepertest.PricesKeepers(t)
 	mockTimeProvider.On("Now").Return(constants.TimeT)
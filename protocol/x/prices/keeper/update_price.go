@@ -26,6 +26,7 @@ import (
 // 3) The proposed price is either the index price or the smoothed price, depending on which is closer to the
 // oracle price.
 // 4) The proposed price meets the minimum price change ppm requirement.
+// 5) The proposed price is clamped to the maximum price change ppm requirement, if any.
 // Note: the list of market price updates can be empty if there are no "valid" index prices, smoothed prices, and/or
 // proposed prices for any market.
 func (k Keeper) GetValidMarketPriceUpdates(
@@ -110,12 +111,13 @@ func (k Keeper) GetValidMarketPriceUpdates(
 		}
 
 		if shouldPropose {
-			// Add as a "valid" price update.
+			// Add as a "valid" price update. The clamped price still moves towards the index price and
+			// meets the min price change, since the max price change is at least the min price change.
 			updates = append(
 				updates,
 				&types.MsgUpdateMarketPrices_MarketPrice{
 					MarketId: marketId,
-					Price:    indexPrice,
+					Price:    marketParamPrice.Param.ClampPriceChange(marketParamPrice.Price.Price, indexPrice),
 				},
 			)
 		}
@@ -142,3 +142,76 @@ func TestGetValidMarketPriceUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestGetValidMarketPriceUpdates_ClampsToMaxPriceChange(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		indexPrice uint64
+
+		// Expected.
+		expectedPrice uint64
+	}{
+		"Increase within max price change is not clamped": {
+			indexPrice:    constants.FiveBillion + 2_000_000,
+			expectedPrice: constants.FiveBillion + 2_000_000,
+		},
+		"Increase exactly at max price change is not clamped": {
+			indexPrice:    constants.FiveBillion + 2_500_000,
+			expectedPrice: constants.FiveBillion + 2_500_000,
+		},
+		"Increase over max price change is clamped": {
+			indexPrice:    fiveBillionAndFiveMillion,
+			expectedPrice: constants.FiveBillion + 2_500_000,
+		},
+		"Decrease over max price change is clamped": {
+			indexPrice:    fiveBillionMinusFiveMillionAndOne,
+			expectedPrice: constants.FiveBillion - 2_500_000,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup.
+			ctx, k, _, indexPriceCache, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+			mockTimeProvider.On("Now").Return(constants.TimeT)
+
+			keepertest.CreateTestMarkets(t, ctx, k)
+
+			// Allow at most a 0.05% (=2,500,000) price change from the current price of 5,000,000,000.
+			marketParam, exists := k.GetMarketParam(ctx, constants.MarketId0)
+			require.True(t, exists)
+			marketParam.MaxPriceChangePpm = 500
+			_, err := k.ModifyMarketParam(ctx, marketParam)
+			require.NoError(t, err)
+
+			indexPriceCache.UpdatePrices([]*api.MarketPriceUpdate{
+				{
+					MarketId: constants.MarketId0,
+					ExchangePrices: []*api.ExchangePrice{
+						{
+							ExchangeId:     constants.ExchangeId0,
+							Price:          tc.indexPrice,
+							LastUpdateTime: &constants.TimeT,
+						},
+					},
+				},
+			})
+
+			// Run.
+			result := k.GetValidMarketPriceUpdates(ctx)
+
+			// Validate.
+			require.Equal(
+				t,
+				&types.MsgUpdateMarketPrices{
+					MarketPriceUpdates: []*types.MsgUpdateMarketPrices_MarketPrice{
+						types.NewMarketPriceUpdate(constants.MarketId0, tc.expectedPrice),
+					},
+				},
+				result,
+			)
+
+			// The proposed price passes stateful validation.
+			require.NoError(t, k.PerformStatefulPriceUpdateValidation(ctx, result, true))
+		})
+	}
+}
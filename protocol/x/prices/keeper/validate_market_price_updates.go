@@ -170,6 +170,7 @@ func (k Keeper) performNonDeterministicStatefulValidation(
 // Specificically, for each price update, validate the following:
 //   - The market exists.
 //   - The price update is greater than the min price change.
+//   - The price update does not exceed the max price change, if any.
 func (k Keeper) performDeterministicStatefulValidation(
 	ctx sdk.Context,
 	marketPriceUpdates *types.MsgUpdateMarketPrices,
@@ -196,6 +197,20 @@ func (k Keeper) performDeterministicStatefulValidation(
 				marketParamPrice.Price.Price,
 			)
 		}
+
+		// Check price respects max price change.
+		err = marketParamPrice.Param.ValidatePriceChange(marketParamPrice.Price.Price, priceUpdate.Price)
+		if err != nil {
+			return errorsmod.Wrapf(
+				types.ErrInvalidMarketPriceUpdateDeterministic,
+				"update price (%d) for market (%d) exceeds max price change requirement"+
+					" (%d ppm) based on the current market price (%d)",
+				priceUpdate.Price,
+				priceUpdate.MarketId,
+				marketParamPrice.Param.MaxPriceChangePpm,
+				marketParamPrice.Price.Price,
+			)
+		}
 	}
 	return nil
 }
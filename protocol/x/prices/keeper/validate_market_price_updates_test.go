@@ -225,6 +225,76 @@ func TestPerformStatefulPriceUpdateValidation_SkipNonDeterministicCheck_Valid(t
 	}
 }
 
+func TestPerformStatefulPriceUpdateValidation_MaxPriceChange(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		updatePrice uint64
+
+		// Expected.
+		expectedErr string
+	}{
+		"Increase within max price change": {
+			updatePrice: constants.FiveBillion + 4_999_999,
+		},
+		"Decrease within max price change": {
+			updatePrice: constants.FiveBillion - 4_999_999,
+		},
+		"Increase exactly at max price change": {
+			updatePrice: constants.FiveBillion + 5_000_000,
+		},
+		"Decrease exactly at max price change": {
+			updatePrice: constants.FiveBillion - 5_000_000,
+		},
+		"Increase over max price change": {
+			updatePrice: constants.FiveBillion + 5_000_001,
+			expectedErr: errorsmod.Wrapf(
+				types.ErrInvalidMarketPriceUpdateDeterministic,
+				"update price (5005000001) for market (0) exceeds max price change requirement"+
+					" (1000 ppm) based on the current market price (5000000000)",
+			).Error(),
+		},
+		"Decrease over max price change": {
+			updatePrice: constants.FiveBillion - 5_000_001,
+			expectedErr: errorsmod.Wrapf(
+				types.ErrInvalidMarketPriceUpdateDeterministic,
+				"update price (4994999999) for market (0) exceeds max price change requirement"+
+					" (1000 ppm) based on the current market price (5000000000)",
+			).Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup.
+			ctx, k, _, _, mockTimeProvider, _ := keepertest.PricesKeepers(t)
+			mockTimeProvider.On("Now").Return(constants.TimeT)
+
+			keepertest.CreateTestMarkets(t, ctx, k)
+
+			// Allow at most a 0.1% (=5,000,000) price change from the current price of 5,000,000,000.
+			marketParam, exists := k.GetMarketParam(ctx, constants.MarketId0)
+			require.True(t, exists)
+			marketParam.MaxPriceChangePpm = 1_000
+			_, err := k.ModifyMarketParam(ctx, marketParam)
+			require.NoError(t, err)
+
+			// Run.
+			msg := &types.MsgUpdateMarketPrices{
+				MarketPriceUpdates: []*types.MsgUpdateMarketPrices_MarketPrice{
+					types.NewMarketPriceUpdate(constants.MarketId0, tc.updatePrice),
+				},
+			}
+			err = k.PerformStatefulPriceUpdateValidation(ctx, msg, false) // skips non-deterministic checks.
+
+			// Validate.
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestPerformStatefulPriceUpdateValidation_Error(t *testing.T) {
 	tests := map[string]struct {
 		// Setup.
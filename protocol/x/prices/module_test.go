@@ -34,7 +34,8 @@ const (
 	// This genesis state is formatted to export back to itself. It explicitly defines all fields using valid defaults.
 	validGenesisState = `{` +
 		`"market_params":[{"id":0,"pair":"DENT-USD","exponent":0,"min_exchanges":1,"min_price_change_ppm":1,` +
-		`"exchange_config_json":"{}"}],` +
+		`"exchange_config_json":"{}","max_price_update_gap_blocks":0,"min_exchanges_ppm":0,` +
+		`"max_price_change_ppm":0}],` +
 		`"market_prices":[{"id":0,"exponent":0,"price":"1"}]` +
 		`}`
 )
@@ -214,11 +215,12 @@ func TestAppModuleBasic_GetQueryCmd(t *testing.T) {
 
 	cmd := am.GetQueryCmd()
 	require.Equal(t, "prices", cmd.Use)
-	require.Equal(t, 4, len(cmd.Commands()))
-	require.Equal(t, "list-market-param", cmd.Commands()[0].Name())
-	require.Equal(t, "list-market-price", cmd.Commands()[1].Name())
-	require.Equal(t, "show-market-param", cmd.Commands()[2].Name())
-	require.Equal(t, "show-market-price", cmd.Commands()[3].Name())
+	require.Equal(t, 5, len(cmd.Commands()))
+	require.Equal(t, "list-configured-exchanges", cmd.Commands()[0].Name())
+	require.Equal(t, "list-market-param", cmd.Commands()[1].Name())
+	require.Equal(t, "list-market-price", cmd.Commands()[2].Name())
+	require.Equal(t, "show-market-param", cmd.Commands()[3].Name())
+	require.Equal(t, "show-market-price", cmd.Commands()[4].Name())
 }
 
 func TestAppModule_Name(t *testing.T) {
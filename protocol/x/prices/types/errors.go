@@ -17,6 +17,11 @@ var (
 		103,
 		"Exchanges must not contain duplicates and must be provided in ascending order",
 	)
+	ErrInvalidMinExchangesPpm = errorsmod.Register(
+		ModuleName,
+		104,
+		"Min exchanges ppm must not be greater than one million",
+	)
 
 	// 200 - 299: Market related errors.
 	ErrMarketParamDoesNotExist        = errorsmod.Register(ModuleName, 200, "Market param does not exist")
@@ -28,6 +33,7 @@ var (
 
 	// 300 - 399: Price related errors.
 	ErrIndexPriceNotAvailable = errorsmod.Register(ModuleName, 300, "Index price is not available")
+	ErrMarketPriceIsStale     = errorsmod.Register(ModuleName, 301, "Market price is stale")
 
 	// 400 - 499: Market price update related errors.
 	ErrInvalidMarketPriceUpdateStateless = errorsmod.Register(
@@ -36,6 +42,8 @@ var (
 		ModuleName, 401, "Market price update is invalid: deterministic.")
 	ErrInvalidMarketPriceUpdateNonDeterministic = errorsmod.Register(
 		ModuleName, 402, "Market price update is invalid: non-deterministic.")
+	ErrMaxPriceChangeExceeded = errorsmod.Register(
+		ModuleName, 403, "Market price update exceeds max price change")
 
 	// 500 - 599: sdk.Msg related errors.
 	ErrInvalidAuthority = errorsmod.Register(
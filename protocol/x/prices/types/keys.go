@@ -19,4 +19,11 @@ const (
 
 	// NextIDKey is the key for the next market ID
 	NextMarketIDKey = "NextMarketID"
+
+	// LastPriceUpdateBlockHeightKey is the key for the last block height at which any market price was updated
+	LastPriceUpdateBlockHeightKey = "LastPriceUpdateHeight"
+
+	// MarketLastPriceUpdateBlockHeightKeyPrefix is the prefix to retrieve the last block height at which
+	// each market price was updated
+	MarketLastPriceUpdateBlockHeightKeyPrefix = "MarketLastPriceUpdateHeight:"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	"github.com/dydxprotocol/v4-chain/protocol/lib"
 	"github.com/dydxprotocol/v4-chain/protocol/lib/json"
@@ -17,6 +19,10 @@ func (mp *MarketParam) Validate() error {
 		return ErrZeroMinExchanges
 	}
 
+	if mp.MinExchangesPpm > lib.OneMillion {
+		return ErrInvalidMinExchangesPpm
+	}
+
 	// Validate min price change.
 	if mp.MinPriceChangePpm == 0 || mp.MinPriceChangePpm >= lib.MaxPriceChangePpm {
 		return errorsmod.Wrapf(
@@ -25,6 +31,15 @@ func (mp *MarketParam) Validate() error {
 			lib.MaxPriceChangePpm)
 	}
 
+	// Validate max price change.
+	if mp.MaxPriceChangePpm != 0 && mp.MaxPriceChangePpm < mp.MinPriceChangePpm {
+		return errorsmod.Wrapf(
+			ErrInvalidInput,
+			"Max price change in parts-per-million must be 0 or at least the min price change (%d)",
+			mp.MinPriceChangePpm,
+		)
+	}
+
 	if err := json.IsValidJSON(mp.ExchangeConfigJson); err != nil {
 		return errorsmod.Wrapf(
 			ErrInvalidInput,
@@ -36,3 +51,65 @@ func (mp *MarketParam) Validate() error {
 
 	return nil
 }
+
+// GetMinExchangesQuorum returns the minimum number of exchanges that should be reporting a live price
+// for a price update to be considered valid, given the number of exchanges configured for the market.
+// If `MinExchangesPpm` is non-zero, the quorum is that fraction of the configured exchanges rounded up
+// and at least one. Otherwise, the quorum is `MinExchanges`.
+func (mp *MarketParam) GetMinExchangesQuorum(numConfiguredExchanges uint32) uint32 {
+	if mp.MinExchangesPpm == 0 {
+		return mp.MinExchanges
+	}
+
+	oneMillion := uint64(lib.OneMillion)
+	quorum := (uint64(numConfiguredExchanges)*uint64(mp.MinExchangesPpm) + oneMillion - 1) / oneMillion
+	return lib.Max(uint32(quorum), 1)
+}
+
+// ValidatePriceChange checks that `newPrice` differs from `currentPrice` by no more than `MaxPriceChangePpm` of
+// `currentPrice`. The check is skipped if `MaxPriceChangePpm` is 0 or if `currentPrice` is 0, i.e. the market
+// price has never been updated.
+func (mp *MarketParam) ValidatePriceChange(currentPrice uint64, newPrice uint64) error {
+	maxChangeAmt, isLimited := mp.getMaxPriceChangeAmount(currentPrice)
+	if !isLimited {
+		return nil
+	}
+
+	changeAmt := new(big.Int).SetUint64(lib.AbsDiffUint64(currentPrice, newPrice))
+	if changeAmt.Cmp(maxChangeAmt) > 0 {
+		return errorsmod.Wrapf(
+			ErrMaxPriceChangeExceeded,
+			"price change from %d to %d for market %d exceeds max price change of %d ppm",
+			currentPrice,
+			newPrice,
+			mp.Id,
+			mp.MaxPriceChangePpm,
+		)
+	}
+	return nil
+}
+
+// ClampPriceChange returns the price closest to `newPrice` that differs from `currentPrice` by no more than
+// `MaxPriceChangePpm` of `currentPrice`. `newPrice` is returned unchanged if it passes `ValidatePriceChange`.
+func (mp *MarketParam) ClampPriceChange(currentPrice uint64, newPrice uint64) uint64 {
+	if mp.ValidatePriceChange(currentPrice, newPrice) == nil {
+		return newPrice
+	}
+
+	// The max change amount is less than the change to `newPrice`, so it fits in a uint64 and the clamped
+	// price lies between `currentPrice` and `newPrice`.
+	maxChangeAmt, _ := mp.getMaxPriceChangeAmount(currentPrice)
+	if newPrice > currentPrice {
+		return currentPrice + maxChangeAmt.Uint64()
+	}
+	return currentPrice - maxChangeAmt.Uint64()
+}
+
+// getMaxPriceChangeAmount returns the largest change from `currentPrice` allowed by `MaxPriceChangePpm`, rounded
+// down, and whether the change is limited at all.
+func (mp *MarketParam) getMaxPriceChangeAmount(currentPrice uint64) (maxChangeAmt *big.Int, isLimited bool) {
+	if mp.MaxPriceChangePpm == 0 || currentPrice == 0 {
+		return nil, false
+	}
+	return lib.BigIntMulPpm(new(big.Int).SetUint64(currentPrice), mp.MaxPriceChangePpm), true
+}
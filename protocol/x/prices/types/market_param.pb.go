@@ -33,7 +33,7 @@ type MarketParam struct {
 	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
 	// Static value. The exponent of the price.
 	// For example if `Exponent == -5` then a `Value` of `1,000,000,000`
-	// represents “$10,000`. Therefore `10 ^ Exponent` represents the smallest
+	// represents ``$10,000`. Therefore `10 ^ Exponent` represents the smallest
 	// price step (in dollars) that can be recorded.
 	Exponent int32 `protobuf:"zigzag32,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
 	// The minimum number of exchanges that should be reporting a live price for
@@ -45,6 +45,24 @@ type MarketParam struct {
 	// A string of json that encodes the configuration for resolving the price
 	// of this market on various exchanges.
 	ExchangeConfigJson string `protobuf:"bytes,6,opt,name=exchange_config_json,json=exchangeConfigJson,proto3" json:"exchange_config_json,omitempty"`
+	// The maximum number of blocks that may pass without a price update of
+	// this market before its price is considered stale. While the price is
+	// stale, placement of new orders that are not reduce-only is paused on every
+	// ClobPair of a perpetual that uses this market.
+	// Specifying 0 disables this limit.
+	MaxPriceUpdateGapBlocks uint32 `protobuf:"varint,7,opt,name=max_price_update_gap_blocks,json=maxPriceUpdateGapBlocks,proto3" json:"max_price_update_gap_blocks,omitempty"`
+	// The minimum fraction of the exchanges configured for this market that
+	// should be reporting a live price for a price update to be considered
+	// valid, in parts-per-million. When non-zero, this fractional quorum is used
+	// instead of `min_exchanges`.
+	// Specifying 0 uses the absolute `min_exchanges` quorum.
+	MinExchangesPpm uint32 `protobuf:"varint,8,opt,name=min_exchanges_ppm,json=minExchangesPpm,proto3" json:"min_exchanges_ppm,omitempty"`
+	// The maximum allowable change in `price` value, relative to the current
+	// price, for a single price update. Measured as `1e-6` (parts per million).
+	// Price updates that exceed this change are rejected. Must be at least
+	// `min_price_change_ppm` when non-zero.
+	// Specifying 0 disables this limit.
+	MaxPriceChangePpm uint32 `protobuf:"varint,9,opt,name=max_price_change_ppm,json=maxPriceChangePpm,proto3" json:"max_price_change_ppm,omitempty"`
 }
 
 func (m *MarketParam) Reset()         { *m = MarketParam{} }
@@ -122,6 +140,27 @@ func (m *MarketParam) GetExchangeConfigJson() string {
 	return ""
 }
 
+func (m *MarketParam) GetMaxPriceUpdateGapBlocks() uint32 {
+	if m != nil {
+		return m.MaxPriceUpdateGapBlocks
+	}
+	return 0
+}
+
+func (m *MarketParam) GetMinExchangesPpm() uint32 {
+	if m != nil {
+		return m.MinExchangesPpm
+	}
+	return 0
+}
+
+func (m *MarketParam) GetMaxPriceChangePpm() uint32 {
+	if m != nil {
+		return m.MaxPriceChangePpm
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MarketParam)(nil), "dydxprotocol.prices.MarketParam")
 }
@@ -131,25 +170,29 @@ func init() {
 }
 
 var fileDescriptor_39174a2dba54f799 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x3f, 0x4b, 0xc4, 0x30,
-	0x1c, 0x86, 0x2f, 0xf5, 0x3c, 0x34, 0x7a, 0xc2, 0xc5, 0x1b, 0x82, 0x43, 0x28, 0x0a, 0xd2, 0xc5,
-	0x56, 0xd0, 0xc1, 0xd9, 0xc3, 0x45, 0x10, 0x4a, 0x47, 0x97, 0x90, 0x4b, 0x63, 0x1b, 0x35, 0x7f,
-	0x68, 0xa3, 0xf4, 0xbe, 0x85, 0x1f, 0xcb, 0xf1, 0x16, 0xc1, 0x51, 0xda, 0x2f, 0x22, 0xcd, 0xd1,
-	0x43, 0xb7, 0xe4, 0x7d, 0x1e, 0x7e, 0xbc, 0xbc, 0xf0, 0x3c, 0x5f, 0xe5, 0x8d, 0xad, 0x8c, 0x33,
-	0xdc, 0xbc, 0x26, 0xb6, 0x92, 0x5c, 0xd4, 0x89, 0x62, 0xd5, 0x8b, 0x70, 0xd4, 0xb2, 0x8a, 0xa9,
-	0xd8, 0x43, 0x74, 0xfc, 0xd7, 0x8b, 0x37, 0xde, 0xe9, 0x17, 0x80, 0x07, 0x0f, 0xde, 0x4d, 0x7b,
-	0x15, 0x1d, 0xc1, 0x40, 0xe6, 0x18, 0x84, 0x20, 0x9a, 0x66, 0x81, 0xcc, 0x11, 0x82, 0x63, 0xcb,
-	0x64, 0x85, 0x83, 0x10, 0x44, 0xfb, 0x99, 0x7f, 0xa3, 0x13, 0xb8, 0x27, 0x1a, 0x6b, 0xb4, 0xd0,
-	0x0e, 0xef, 0x84, 0x20, 0x9a, 0x65, 0xdb, 0x3f, 0x3a, 0x83, 0x53, 0x25, 0x35, 0x15, 0x0d, 0x2f,
-	0x99, 0x2e, 0x44, 0x8d, 0xc7, 0xfe, 0xd4, 0xa1, 0x92, 0xfa, 0x6e, 0xc8, 0x50, 0x02, 0xe7, 0xbd,
-	0xe4, 0x2b, 0xd0, 0x4d, 0x48, 0xad, 0x55, 0x78, 0xd7, 0xbb, 0x33, 0x25, 0x75, 0xda, 0xa3, 0x85,
-	0x27, 0xa9, 0x55, 0xe8, 0x12, 0xce, 0x87, 0x8b, 0x94, 0x1b, 0xfd, 0x24, 0x0b, 0xfa, 0x5c, 0x1b,
-	0x8d, 0x27, 0xbe, 0x15, 0x1a, 0xd8, 0xc2, 0xa3, 0xfb, 0xda, 0xe8, 0xdb, 0xec, 0xb3, 0x25, 0x60,
-	0xdd, 0x12, 0xf0, 0xd3, 0x12, 0xf0, 0xd1, 0x91, 0xd1, 0xba, 0x23, 0xa3, 0xef, 0x8e, 0x8c, 0x1e,
-	0x6f, 0x0a, 0xe9, 0xca, 0xb7, 0x65, 0xcc, 0x8d, 0x4a, 0xfe, 0x2d, 0xf7, 0x7e, 0x7d, 0xc1, 0x4b,
-	0x26, 0x75, 0xb2, 0x4d, 0x9a, 0x61, 0x4d, 0xb7, 0xb2, 0xa2, 0x5e, 0x4e, 0x3c, 0xb8, 0xfa, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x98, 0x21, 0x4b, 0x29, 0x71, 0x01, 0x00, 0x00,
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x9b, 0x58, 0x6b, 0x3b, 0x5a, 0xa5, 0x63, 0xc1, 0xa0, 0x10, 0x8a, 0x82, 0x14, 0xc1,
+	0x46, 0xd0, 0x85, 0x0b, 0x57, 0x2d, 0x22, 0x08, 0x42, 0x09, 0xb8, 0x71, 0x33, 0x4c, 0x27, 0x63,
+	0x3a, 0xb6, 0xf3, 0x43, 0x92, 0xca, 0xf4, 0x2d, 0x7c, 0x2c, 0x97, 0x5d, 0xba, 0x94, 0xf6, 0x05,
+	0x7c, 0x04, 0xc9, 0x6d, 0x53, 0xda, 0x5d, 0x72, 0xcf, 0x37, 0xf7, 0x9c, 0xc3, 0x45, 0x97, 0xd1,
+	0x34, 0xb2, 0x26, 0xd1, 0x99, 0x66, 0x7a, 0x1c, 0x98, 0x44, 0x30, 0x9e, 0x06, 0x92, 0x26, 0x23,
+	0x9e, 0x11, 0x43, 0x13, 0x2a, 0x3b, 0x20, 0xe2, 0xe3, 0x4d, 0xae, 0xb3, 0xe4, 0xce, 0xff, 0x5c,
+	0xb4, 0xff, 0x02, 0x6c, 0x3f, 0x47, 0xf1, 0x21, 0x72, 0x45, 0xe4, 0x39, 0x2d, 0xa7, 0x5d, 0x0f,
+	0x5d, 0x11, 0x61, 0x8c, 0xca, 0x86, 0x8a, 0xc4, 0x73, 0x5b, 0x4e, 0xbb, 0x16, 0xc2, 0x37, 0x3e,
+	0x45, 0x55, 0x6e, 0x8d, 0x56, 0x5c, 0x65, 0xde, 0x4e, 0xcb, 0x69, 0x37, 0xc2, 0xf5, 0x3f, 0xbe,
+	0x40, 0x75, 0x29, 0x14, 0xe1, 0x96, 0x0d, 0xa9, 0x8a, 0x79, 0xea, 0x95, 0x61, 0xd5, 0x81, 0x14,
+	0xea, 0xb1, 0x98, 0xe1, 0x00, 0x35, 0x73, 0x08, 0x22, 0x90, 0xe5, 0x90, 0x18, 0x23, 0xbd, 0x5d,
+	0x60, 0x1b, 0x52, 0xa8, 0x7e, 0x2e, 0xf5, 0x40, 0xe9, 0x1b, 0x89, 0x6f, 0x50, 0xb3, 0xd8, 0x48,
+	0x98, 0x56, 0xef, 0x22, 0x26, 0x1f, 0xa9, 0x56, 0x5e, 0x05, 0x52, 0xe1, 0x42, 0xeb, 0x81, 0xf4,
+	0x9c, 0x6a, 0x85, 0x1f, 0xd0, 0x99, 0xa4, 0x76, 0x65, 0x31, 0x31, 0x11, 0xcd, 0x38, 0x89, 0xa9,
+	0x21, 0x83, 0xb1, 0x66, 0xa3, 0xd4, 0xdb, 0x03, 0xa7, 0x13, 0x49, 0x2d, 0x38, 0xbd, 0x02, 0xf0,
+	0x44, 0x4d, 0x17, 0x64, 0x7c, 0x85, 0x1a, 0x5b, 0x2d, 0x20, 0x5d, 0x15, 0xde, 0x1c, 0x6d, 0x36,
+	0xc9, 0xb3, 0xe5, 0x65, 0xd6, 0x4e, 0x1b, 0x65, 0x6a, 0xab, 0x32, 0xd4, 0x6e, 0x97, 0xe9, 0x86,
+	0xdf, 0x73, 0xdf, 0x99, 0xcd, 0x7d, 0xe7, 0x77, 0xee, 0x3b, 0x5f, 0x0b, 0xbf, 0x34, 0x5b, 0xf8,
+	0xa5, 0x9f, 0x85, 0x5f, 0x7a, 0xbb, 0x8f, 0x45, 0x36, 0x9c, 0x0c, 0x3a, 0x4c, 0xcb, 0x60, 0xeb,
+	0xa8, 0x9f, 0x77, 0xd7, 0x6c, 0x48, 0x85, 0x0a, 0xd6, 0x13, 0x5b, 0x1c, 0x3a, 0x9b, 0x1a, 0x9e,
+	0x0e, 0x2a, 0x20, 0xdc, 0xfe, 0x0f, 0x00, 0x39, 0xb0, 0x3f, 0x17, 0x0c, 0x02, 0x00, 0x00,
 }
 
 func (m *MarketParam) Marshal() (dAtA []byte, err error) {
@@ -172,6 +215,21 @@ func (m *MarketParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPriceChangePpm != 0 {
+		i = encodeVarintMarketParam(dAtA, i, uint64(m.MaxPriceChangePpm))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinExchangesPpm != 0 {
+		i = encodeVarintMarketParam(dAtA, i, uint64(m.MinExchangesPpm))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPriceUpdateGapBlocks != 0 {
+		i = encodeVarintMarketParam(dAtA, i, uint64(m.MaxPriceUpdateGapBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ExchangeConfigJson) > 0 {
 		i -= len(m.ExchangeConfigJson)
 		copy(dAtA[i:], m.ExchangeConfigJson)
@@ -246,6 +304,15 @@ func (m *MarketParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarketParam(uint64(l))
 	}
+	if m.MaxPriceUpdateGapBlocks != 0 {
+		n += 1 + sovMarketParam(uint64(m.MaxPriceUpdateGapBlocks))
+	}
+	if m.MinExchangesPpm != 0 {
+		n += 1 + sovMarketParam(uint64(m.MinExchangesPpm))
+	}
+	if m.MaxPriceChangePpm != 0 {
+		n += 1 + sovMarketParam(uint64(m.MaxPriceChangePpm))
+	}
 	return n
 }
 
@@ -426,6 +493,63 @@ func (m *MarketParam) Unmarshal(dAtA []byte) error {
 			}
 			m.ExchangeConfigJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceUpdateGapBlocks", wireType)
+			}
+			m.MaxPriceUpdateGapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketParam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceUpdateGapBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExchangesPpm", wireType)
+			}
+			m.MinExchangesPpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketParam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinExchangesPpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChangePpm", wireType)
+			}
+			m.MaxPriceChangePpm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketParam
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceChangePpm |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarketParam(dAtA[iNdEx:])
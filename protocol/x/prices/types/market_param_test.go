@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
@@ -44,6 +45,50 @@ func TestMarketParam_Validate(t *testing.T) {
 			},
 			expErrMsg: "Min price change in parts-per-million must be greater than 0",
 		},
+		{
+			name: "Valid MinExchangesPpm",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: validExchangeConfigJson,
+				MinExchangesPpm:    1_000_000,
+			},
+			expErrMsg: "",
+		},
+		{
+			name: "Invalid MinExchangesPpm",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: validExchangeConfigJson,
+				MinExchangesPpm:    1_000_001,
+			},
+			expErrMsg: "Min exchanges ppm must not be greater than one million",
+		},
+		{
+			name: "Valid MaxPriceChangePpm",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: validExchangeConfigJson,
+				MaxPriceChangePpm:  1_000,
+			},
+			expErrMsg: "",
+		},
+		{
+			name: "Invalid MaxPriceChangePpm",
+			input: types.MarketParam{
+				Pair:               "BTC-USD",
+				MinExchanges:       1,
+				MinPriceChangePpm:  1_000,
+				ExchangeConfigJson: validExchangeConfigJson,
+				MaxPriceChangePpm:  999,
+			},
+			expErrMsg: "Max price change in parts-per-million must be 0 or at least the min price change (1000)",
+		},
 		{
 			name: "Empty ExchangeConfigJson",
 			input: types.MarketParam{
@@ -77,3 +122,200 @@ func TestMarketParam_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketParam_GetMinExchangesQuorum(t *testing.T) {
+	tests := map[string]struct {
+		minExchanges           uint32
+		minExchangesPpm        uint32
+		numConfiguredExchanges uint32
+		expectedQuorum         uint32
+	}{
+		"Absolute quorum when fractional quorum is disabled": {
+			minExchanges:           2,
+			minExchangesPpm:        0,
+			numConfiguredExchanges: 5,
+			expectedQuorum:         2,
+		},
+		"60% of 5 configured exchanges requires 3": {
+			minExchanges:           1,
+			minExchangesPpm:        600_000,
+			numConfiguredExchanges: 5,
+			expectedQuorum:         3,
+		},
+		"Fractional quorum rounds up": {
+			minExchanges:           1,
+			minExchangesPpm:        500_000,
+			numConfiguredExchanges: 5,
+			expectedQuorum:         3,
+		},
+		"100% of configured exchanges": {
+			minExchanges:           1,
+			minExchangesPpm:        1_000_000,
+			numConfiguredExchanges: 7,
+			expectedQuorum:         7,
+		},
+		"Fractional quorum overrides a larger absolute quorum": {
+			minExchanges:           4,
+			minExchangesPpm:        200_000,
+			numConfiguredExchanges: 5,
+			expectedQuorum:         1,
+		},
+		"Fractional quorum is at least one": {
+			minExchanges:           1,
+			minExchangesPpm:        1,
+			numConfiguredExchanges: 0,
+			expectedQuorum:         1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			marketParam := types.MarketParam{
+				MinExchanges:    tc.minExchanges,
+				MinExchangesPpm: tc.minExchangesPpm,
+			}
+			require.Equal(t, tc.expectedQuorum, marketParam.GetMinExchangesQuorum(tc.numConfiguredExchanges))
+		})
+	}
+}
+
+func TestMarketParam_ValidatePriceChange(t *testing.T) {
+	tests := map[string]struct {
+		maxPriceChangePpm uint32
+		currentPrice      uint64
+		newPrice          uint64
+		expectedErr       string
+	}{
+		"Disabled": {
+			maxPriceChangePpm: 0,
+			currentPrice:      1_000_000,
+			newPrice:          100_000_000,
+		},
+		"Market price has never been updated": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      0,
+			newPrice:          1_000_000,
+		},
+		"Unchanged price": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_000_000,
+		},
+		"Increase within bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_009_999,
+		},
+		"Decrease within bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          990_001,
+		},
+		"Increase exactly at bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_010_000,
+		},
+		"Decrease exactly at bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          990_000,
+		},
+		"Increase over bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_010_001,
+			expectedErr:       "price change from 1000000 to 1010001 for market 1 exceeds max price change of 10000 ppm",
+		},
+		"Decrease over bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          989_999,
+			expectedErr:       "price change from 1000000 to 989999 for market 1 exceeds max price change of 10000 ppm",
+		},
+		"Max change amount rounds down": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_099,
+			newPrice:          1_010_100,
+			expectedErr:       "price change from 1000099 to 1010100 for market 1 exceeds max price change of 10000 ppm",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			marketParam := types.MarketParam{Id: 1, MaxPriceChangePpm: tc.maxPriceChangePpm}
+			err := marketParam.ValidatePriceChange(tc.currentPrice, tc.newPrice)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrMaxPriceChangeExceeded)
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMarketParam_ClampPriceChange(t *testing.T) {
+	tests := map[string]struct {
+		maxPriceChangePpm uint32
+		currentPrice      uint64
+		newPrice          uint64
+		expectedPrice     uint64
+	}{
+		"Disabled": {
+			maxPriceChangePpm: 0,
+			currentPrice:      1_000_000,
+			newPrice:          100_000_000,
+			expectedPrice:     100_000_000,
+		},
+		"Market price has never been updated": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      0,
+			newPrice:          1_000_000,
+			expectedPrice:     1_000_000,
+		},
+		"Increase within bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_009_999,
+			expectedPrice:     1_009_999,
+		},
+		"Increase exactly at bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1_010_000,
+			expectedPrice:     1_010_000,
+		},
+		"Increase over bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          2_000_000,
+			expectedPrice:     1_010_000,
+		},
+		"Decrease exactly at bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          990_000,
+			expectedPrice:     990_000,
+		},
+		"Decrease over bound": {
+			maxPriceChangePpm: 10_000,
+			currentPrice:      1_000_000,
+			newPrice:          1,
+			expectedPrice:     990_000,
+		},
+		"Increase over bound near max uint64": {
+			maxPriceChangePpm: 2_000_000,
+			currentPrice:      math.MaxUint64 / 4,
+			newPrice:          math.MaxUint64,
+			expectedPrice:     math.MaxUint64/4 + (math.MaxUint64/4)*2,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			marketParam := types.MarketParam{Id: 1, MaxPriceChangePpm: tc.maxPriceChangePpm}
+			clampedPrice := marketParam.ClampPriceChange(tc.currentPrice, tc.newPrice)
+			require.Equal(t, tc.expectedPrice, clampedPrice)
+			require.NoError(t, marketParam.ValidatePriceChange(tc.currentPrice, clampedPrice))
+		})
+	}
+}
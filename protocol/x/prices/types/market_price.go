@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 )
 
@@ -26,3 +28,52 @@ func (mp *MarketPrice) ValidateFromParam(marketParam MarketParam) error {
 	}
 	return nil
 }
+
+// ValidateMarketPrices checks that every MarketPrice is non-zero and corresponds to the MarketParam with the
+// same id in `params`. Prices are validated in order and the first failure is returned.
+func ValidateMarketPrices(prices []MarketPrice, params map[uint32]MarketParam) error {
+	for _, marketPrice := range prices {
+		marketParam, exists := params[marketPrice.Id]
+		if !exists {
+			return errorsmod.Wrapf(
+				ErrMarketParamDoesNotExist,
+				"market param %d does not exist for market price %d",
+				marketPrice.Id,
+				marketPrice.Id,
+			)
+		}
+		if err := marketPrice.ValidateFromParam(marketParam); err != nil {
+			return err
+		}
+		if marketPrice.Price == 0 {
+			return errorsmod.Wrapf(
+				ErrInvalidInput,
+				"market price %d must be non-zero",
+				marketPrice.Id,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateFreshness checks that the MarketPrice, last updated at `lastUpdateTime`, was updated no more than
+// `maxAge` before `blockTime`. A price updated exactly `maxAge` before `blockTime` is considered fresh.
+func (mp *MarketPrice) ValidateFreshness(lastUpdateTime time.Time, blockTime time.Time, maxAge time.Duration) error {
+	if maxAge < 0 {
+		return errorsmod.Wrapf(
+			ErrInvalidInput,
+			"max age %s must not be negative",
+			maxAge,
+		)
+	}
+	if age := blockTime.Sub(lastUpdateTime); age > maxAge {
+		return errorsmod.Wrapf(
+			ErrMarketPriceIsStale,
+			"market price %d was last updated %s ago, which exceeds the max age of %s",
+			mp.Id,
+			age,
+			maxAge,
+		)
+	}
+	return nil
+}
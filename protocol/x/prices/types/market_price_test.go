@@ -0,0 +1,189 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dydxprotocol/v4-chain/protocol/x/prices/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketPrice_ValidateFromParam(t *testing.T) {
+	testCases := []struct {
+		name        string
+		marketPrice types.MarketPrice
+		marketParam types.MarketParam
+		expErrMsg   string
+	}{
+		{
+			name:        "Valid",
+			marketPrice: types.MarketPrice{Id: 1, Exponent: -5, Price: 100},
+			marketParam: types.MarketParam{Id: 1, Exponent: -5},
+		},
+		{
+			name:        "Mismatched id",
+			marketPrice: types.MarketPrice{Id: 1, Exponent: -5, Price: 100},
+			marketParam: types.MarketParam{Id: 2, Exponent: -5},
+			expErrMsg:   "market param id 2 does not match market price id 1",
+		},
+		{
+			name:        "Mismatched exponent",
+			marketPrice: types.MarketPrice{Id: 1, Exponent: -5, Price: 100},
+			marketParam: types.MarketParam{Id: 1, Exponent: -6},
+			expErrMsg:   "market param 1 exponent -6 does not match market price 1 exponent -5",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.marketPrice.ValidateFromParam(tc.marketParam)
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidInput)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
+func TestValidateMarketPrices(t *testing.T) {
+	params := map[uint32]types.MarketParam{
+		0: {Id: 0, Exponent: -5},
+		1: {Id: 1, Exponent: -6},
+	}
+	testCases := []struct {
+		name      string
+		prices    []types.MarketPrice
+		expErr    error
+		expErrMsg string
+	}{
+		{
+			name:   "Valid: no prices",
+			prices: []types.MarketPrice{},
+		},
+		{
+			name: "Valid",
+			prices: []types.MarketPrice{
+				{Id: 0, Exponent: -5, Price: 100},
+				{Id: 1, Exponent: -6, Price: 200},
+			},
+		},
+		{
+			name: "Missing param",
+			prices: []types.MarketPrice{
+				{Id: 0, Exponent: -5, Price: 100},
+				{Id: 2, Exponent: -6, Price: 200},
+			},
+			expErr:    types.ErrMarketParamDoesNotExist,
+			expErrMsg: "market param 2 does not exist for market price 2",
+		},
+		{
+			name: "Mismatched exponent",
+			prices: []types.MarketPrice{
+				{Id: 0, Exponent: -5, Price: 100},
+				{Id: 1, Exponent: -5, Price: 200},
+			},
+			expErr:    types.ErrInvalidInput,
+			expErrMsg: "market param 1 exponent -6 does not match market price 1 exponent -5",
+		},
+		{
+			name: "Zero price",
+			prices: []types.MarketPrice{
+				{Id: 0, Exponent: -5, Price: 0},
+				{Id: 1, Exponent: -6, Price: 200},
+			},
+			expErr:    types.ErrInvalidInput,
+			expErrMsg: "market price 0 must be non-zero",
+		},
+		{
+			name: "Returns the first failure",
+			prices: []types.MarketPrice{
+				{Id: 1, Exponent: -6, Price: 0},
+				{Id: 0, Exponent: -4, Price: 100},
+			},
+			expErr:    types.ErrInvalidInput,
+			expErrMsg: "market price 1 must be non-zero",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMarketPrices(tc.prices, params)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+
+	t.Run("Mismatched id", func(t *testing.T) {
+		err := types.ValidateMarketPrices(
+			[]types.MarketPrice{{Id: 0, Exponent: -5, Price: 100}},
+			map[uint32]types.MarketParam{0: {Id: 1, Exponent: -5}},
+		)
+		require.ErrorIs(t, err, types.ErrInvalidInput)
+		require.ErrorContains(t, err, "market param id 1 does not match market price id 0")
+	})
+}
+
+func TestMarketPrice_ValidateFreshness(t *testing.T) {
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	maxAge := 30 * time.Second
+	testCases := []struct {
+		name           string
+		lastUpdateTime time.Time
+		maxAge         time.Duration
+		expErr         error
+		expErrMsg      string
+	}{
+		{
+			name:           "Fresh",
+			lastUpdateTime: blockTime.Add(-10 * time.Second),
+			maxAge:         maxAge,
+		},
+		{
+			name:           "Fresh: updated at block time",
+			lastUpdateTime: blockTime,
+			maxAge:         maxAge,
+		},
+		{
+			name:           "Fresh: age equal to max age",
+			lastUpdateTime: blockTime.Add(-maxAge),
+			maxAge:         maxAge,
+		},
+		{
+			name:           "Stale: age just over max age",
+			lastUpdateTime: blockTime.Add(-maxAge - time.Nanosecond),
+			maxAge:         maxAge,
+			expErr:         types.ErrMarketPriceIsStale,
+			expErrMsg:      "market price 1 was last updated 30.000000001s ago, which exceeds the max age of 30s",
+		},
+		{
+			name:           "Stale",
+			lastUpdateTime: blockTime.Add(-time.Hour),
+			maxAge:         maxAge,
+			expErr:         types.ErrMarketPriceIsStale,
+			expErrMsg:      "market price 1 was last updated 1h0m0s ago, which exceeds the max age of 30s",
+		},
+		{
+			name:           "Invalid: negative max age",
+			lastUpdateTime: blockTime,
+			maxAge:         -time.Second,
+			expErr:         types.ErrInvalidInput,
+			expErrMsg:      "max age -1s must not be negative",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			marketPrice := types.MarketPrice{Id: 1, Exponent: -5, Price: 100}
+			err := marketPrice.ValidateFreshness(tc.lastUpdateTime, blockTime, tc.maxAge)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
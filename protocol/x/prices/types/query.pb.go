@@ -414,6 +414,92 @@ func (m *QueryAllMarketParamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryConfiguredExchangesRequest is request type for the Query/Params
+// `ConfiguredExchanges` RPC method.
+type QueryConfiguredExchangesRequest struct {
+}
+
+func (m *QueryConfiguredExchangesRequest) Reset()         { *m = QueryConfiguredExchangesRequest{} }
+func (m *QueryConfiguredExchangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConfiguredExchangesRequest) ProtoMessage()    {}
+func (*QueryConfiguredExchangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c306b315383f34f4, []int{8}
+}
+func (m *QueryConfiguredExchangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfiguredExchangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfiguredExchangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfiguredExchangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfiguredExchangesRequest.Merge(m, src)
+}
+func (m *QueryConfiguredExchangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfiguredExchangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfiguredExchangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfiguredExchangesRequest proto.InternalMessageInfo
+
+// QueryConfiguredExchangesResponse is response type for the Query/Params
+// `ConfiguredExchanges` RPC method.
+type QueryConfiguredExchangesResponse struct {
+	// The distinct exchange ids referenced by the exchange configs of all
+	// markets, sorted in ascending order.
+	ExchangeIds []string `protobuf:"bytes,1,rep,name=exchange_ids,json=exchangeIds,proto3" json:"exchange_ids,omitempty"`
+}
+
+func (m *QueryConfiguredExchangesResponse) Reset()         { *m = QueryConfiguredExchangesResponse{} }
+func (m *QueryConfiguredExchangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConfiguredExchangesResponse) ProtoMessage()    {}
+func (*QueryConfiguredExchangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c306b315383f34f4, []int{9}
+}
+func (m *QueryConfiguredExchangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConfiguredExchangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConfiguredExchangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConfiguredExchangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConfiguredExchangesResponse.Merge(m, src)
+}
+func (m *QueryConfiguredExchangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConfiguredExchangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConfiguredExchangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConfiguredExchangesResponse proto.InternalMessageInfo
+
+func (m *QueryConfiguredExchangesResponse) GetExchangeIds() []string {
+	if m != nil {
+		return m.ExchangeIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryMarketPriceRequest)(nil), "dydxprotocol.prices.QueryMarketPriceRequest")
 	proto.RegisterType((*QueryMarketPriceResponse)(nil), "dydxprotocol.prices.QueryMarketPriceResponse")
@@ -423,47 +509,54 @@ func init() {
 	proto.RegisterType((*QueryMarketParamResponse)(nil), "dydxprotocol.prices.QueryMarketParamResponse")
 	proto.RegisterType((*QueryAllMarketParamsRequest)(nil), "dydxprotocol.prices.QueryAllMarketParamsRequest")
 	proto.RegisterType((*QueryAllMarketParamsResponse)(nil), "dydxprotocol.prices.QueryAllMarketParamsResponse")
+	proto.RegisterType((*QueryConfiguredExchangesRequest)(nil), "dydxprotocol.prices.QueryConfiguredExchangesRequest")
+	proto.RegisterType((*QueryConfiguredExchangesResponse)(nil), "dydxprotocol.prices.QueryConfiguredExchangesResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/prices/query.proto", fileDescriptor_c306b315383f34f4) }
 
 var fileDescriptor_c306b315383f34f4 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0xce, 0x86, 0xc2, 0xc1, 0x49, 0x41, 0x32, 0x48, 0x44, 0x69, 0xd9, 0x46, 0x06, 0xf5, 0x4f,
-	0xd4, 0x26, 0x85, 0x03, 0x57, 0x7a, 0x00, 0x21, 0x84, 0x54, 0x72, 0xe4, 0x82, 0x9c, 0x5d, 0x6b,
-	0x6b, 0x91, 0x5d, 0x6f, 0xd7, 0x9b, 0xaa, 0x11, 0xe2, 0xc2, 0x13, 0x20, 0xc1, 0x8d, 0x1b, 0xcf,
-	0xc0, 0x43, 0xf4, 0x58, 0x89, 0x0b, 0x27, 0x84, 0x12, 0xde, 0x03, 0x64, 0x7b, 0x43, 0xbd, 0xc9,
-	0x86, 0xba, 0x12, 0xe2, 0x96, 0xcc, 0x7c, 0xe3, 0xf9, 0xe6, 0xfb, 0x66, 0x16, 0xac, 0x85, 0xa3,
-	0xf0, 0x38, 0xcd, 0x44, 0x2e, 0x02, 0x31, 0x20, 0x69, 0xc6, 0x03, 0x26, 0xc9, 0xe1, 0x90, 0x65,
-	0x23, 0xac, 0xa3, 0xf0, 0xba, 0x0d, 0xc0, 0x06, 0xd0, 0xbe, 0x11, 0x89, 0x48, 0xe8, 0x20, 0x51,
-	0xbf, 0x0c, 0xb4, 0xbd, 0x1a, 0x09, 0x11, 0x0d, 0x18, 0xa1, 0x29, 0x27, 0x34, 0x49, 0x44, 0x4e,
-	0x73, 0x2e, 0x12, 0x59, 0x64, 0xb7, 0x03, 0x21, 0x63, 0x21, 0x49, 0x9f, 0x4a, 0x66, 0x3a, 0x90,
-	0xa3, 0x6e, 0x9f, 0xe5, 0xb4, 0x4b, 0x52, 0x1a, 0xf1, 0x44, 0x83, 0x0b, 0xec, 0x7a, 0x15, 0xab,
-	0x98, 0x66, 0xaf, 0x59, 0xfe, 0x2a, 0xa5, 0x19, 0x8d, 0x5d, 0x70, 0xea, 0x9f, 0xc1, 0xa1, 0x2d,
-	0x70, 0xf3, 0x85, 0xea, 0xf8, 0x5c, 0xa7, 0xf6, 0x55, 0xa6, 0xc7, 0x0e, 0x87, 0x4c, 0xe6, 0xf0,
-	0x2a, 0xa8, 0xf3, 0xb0, 0xe5, 0x75, 0xbc, 0xcd, 0xe5, 0x5e, 0x9d, 0x87, 0x88, 0x81, 0xd6, 0x3c,
-	0x54, 0xa6, 0x22, 0x91, 0x0c, 0x3e, 0x05, 0x4d, 0xfb, 0x71, 0x5d, 0xd5, 0xd8, 0xed, 0xe0, 0x0a,
-	0x89, 0xb0, 0x55, 0xbf, 0xb7, 0x74, 0xf2, 0x7d, 0xad, 0xd6, 0x6b, 0xc4, 0x67, 0x21, 0xc4, 0xc0,
-	0x8a, 0x6e, 0xf3, 0x68, 0x30, 0xb0, 0x90, 0x72, 0xca, 0xea, 0x31, 0x00, 0x67, 0xa2, 0x14, 0x7d,
-	0xd6, 0xb1, 0x51, 0x10, 0x2b, 0x05, 0xb1, 0xf1, 0xa8, 0x50, 0x10, 0xef, 0xd3, 0x68, 0x3a, 0x51,
-	0xcf, 0xaa, 0x44, 0x5f, 0x3c, 0xb0, 0x5a, 0xdd, 0xa7, 0x18, 0xe9, 0x19, 0x58, 0xb6, 0x47, 0x92,
-	0x2d, 0xaf, 0x73, 0xe9, 0x02, 0x33, 0x35, 0xad, 0x99, 0x24, 0x7c, 0x52, 0x62, 0x5d, 0xd7, 0xac,
-	0x37, 0xce, 0x65, 0x6d, 0x98, 0x94, 0x68, 0xcf, 0xf8, 0xa5, 0x1c, 0x77, 0xf4, 0xcb, 0x40, 0xe7,
-	0xfd, 0x52, 0x71, 0x17, 0xbf, 0x14, 0x6e, 0xc6, 0x2f, 0x15, 0xaa, 0xf0, 0x4b, 0x85, 0xff, 0x87,
-	0x5f, 0x45, 0x9f, 0x79, 0xbf, 0x74, 0xc2, 0xc5, 0x2f, 0x6b, 0xa6, 0xa6, 0x35, 0xd3, 0xbf, 0xf3,
-	0x6b, 0xf7, 0xd7, 0x12, 0xb8, 0xac, 0x69, 0xc3, 0x8f, 0x1e, 0x68, 0x58, 0x6b, 0x02, 0xef, 0x56,
-	0x12, 0x5b, 0x70, 0x8c, 0xed, 0x1d, 0x47, 0xb4, 0xa1, 0x80, 0x36, 0xdf, 0x7d, 0xfd, 0xf9, 0xa1,
-	0x8e, 0x60, 0x87, 0x2c, 0xfe, 0x0e, 0x90, 0x37, 0x3c, 0x7c, 0x0b, 0x3f, 0x79, 0xe0, 0xda, 0xcc,
-	0x09, 0xc0, 0x7b, 0x8b, 0x9b, 0x55, 0x5f, 0x65, 0xbb, 0x7b, 0x81, 0x8a, 0x82, 0xe2, 0x6d, 0x4d,
-	0xf1, 0x16, 0x5c, 0xf9, 0x0b, 0x45, 0xc5, 0xae, 0x61, 0x79, 0xe5, 0x20, 0x9a, 0x75, 0x11, 0x0e,
-	0xa2, 0xd9, 0x47, 0x81, 0x88, 0x66, 0xb4, 0x05, 0x37, 0x2a, 0x19, 0x99, 0xad, 0x2a, 0x69, 0xf7,
-	0xb9, 0xa4, 0x9d, 0xd9, 0x1c, 0x27, 0xed, 0xec, 0x0b, 0x71, 0xd3, 0xae, 0xb4, 0xeb, 0x68, 0x5b,
-	0x33, 0xbd, 0x03, 0xd1, 0xf9, 0x4c, 0xf7, 0x7a, 0x27, 0x63, 0xdf, 0x3b, 0x1d, 0xfb, 0xde, 0x8f,
-	0xb1, 0xef, 0xbd, 0x9f, 0xf8, 0xb5, 0xd3, 0x89, 0x5f, 0xfb, 0x36, 0xf1, 0x6b, 0x2f, 0x1f, 0x46,
-	0x3c, 0x3f, 0x18, 0xf6, 0x71, 0x20, 0xe2, 0xf2, 0x3b, 0x47, 0x0f, 0x76, 0x82, 0x03, 0xca, 0x13,
-	0xf2, 0x27, 0x72, 0x3c, 0x7d, 0x3b, 0x1f, 0xa5, 0x4c, 0xf6, 0xaf, 0xe8, 0xc4, 0xfd, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xe8, 0x38, 0x1f, 0x8e, 0x24, 0x07, 0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0xf3, 0xfb, 0x51, 0x89, 0x4d, 0x0a, 0xd2, 0x16, 0x89, 0xca, 0x2d, 0xae, 0x6b, 0x50,
+	0xfa, 0x47, 0xc4, 0x4b, 0x4a, 0x91, 0xb8, 0x52, 0x54, 0x50, 0x85, 0x90, 0x8a, 0x8f, 0x5c, 0xaa,
+	0x8d, 0xbd, 0x38, 0x16, 0x89, 0xd7, 0xf5, 0x3a, 0x55, 0x22, 0xc4, 0x85, 0x4f, 0x80, 0x04, 0xb7,
+	0xde, 0x38, 0x22, 0x8e, 0x7c, 0x88, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xc2, 0x07, 0x41, 0xde,
+	0xdd, 0x34, 0x9b, 0xc4, 0xa6, 0x8e, 0x84, 0xb8, 0x25, 0x33, 0x6f, 0x76, 0xde, 0xbc, 0x37, 0x63,
+	0xb0, 0xe6, 0xf5, 0xbd, 0x5e, 0x14, 0xd3, 0x84, 0xba, 0xb4, 0x8d, 0xa2, 0x38, 0x70, 0x09, 0x43,
+	0xc7, 0x5d, 0x12, 0xf7, 0x6d, 0x1e, 0x85, 0x4b, 0x2a, 0xc0, 0x16, 0x00, 0xfd, 0x86, 0x4f, 0x7d,
+	0xca, 0x83, 0x28, 0xfd, 0x25, 0xa0, 0xfa, 0xaa, 0x4f, 0xa9, 0xdf, 0x26, 0x08, 0x47, 0x01, 0xc2,
+	0x61, 0x48, 0x13, 0x9c, 0x04, 0x34, 0x64, 0x32, 0xbb, 0xed, 0x52, 0xd6, 0xa1, 0x0c, 0x35, 0x31,
+	0x23, 0xa2, 0x03, 0x3a, 0x69, 0x34, 0x49, 0x82, 0x1b, 0x28, 0xc2, 0x7e, 0x10, 0x72, 0xb0, 0xc4,
+	0xd6, 0xb2, 0x58, 0x75, 0x70, 0xfc, 0x9a, 0x24, 0x47, 0x11, 0x8e, 0x71, 0xa7, 0x08, 0x2e, 0xfd,
+	0x27, 0x70, 0xd6, 0x16, 0xb8, 0xf9, 0x22, 0xed, 0xf8, 0x9c, 0xa7, 0x0e, 0xd3, 0x8c, 0x43, 0x8e,
+	0xbb, 0x84, 0x25, 0xf0, 0x1a, 0x28, 0x07, 0xde, 0xb2, 0x66, 0x6a, 0x9b, 0x8b, 0x4e, 0x39, 0xf0,
+	0x2c, 0x02, 0x96, 0x67, 0xa1, 0x2c, 0xa2, 0x21, 0x23, 0xf0, 0x00, 0x54, 0xd5, 0xc7, 0x79, 0x55,
+	0x65, 0xc7, 0xb4, 0x33, 0x24, 0xb2, 0x95, 0xfa, 0xbd, 0xff, 0xcf, 0x7e, 0xac, 0x95, 0x9c, 0x4a,
+	0x67, 0x1c, 0xb2, 0x08, 0x58, 0xe1, 0x6d, 0x1e, 0xb5, 0xdb, 0x0a, 0x92, 0x8d, 0x58, 0x3d, 0x01,
+	0x60, 0x2c, 0x8a, 0xec, 0x53, 0xb3, 0x85, 0x82, 0x76, 0xaa, 0xa0, 0x2d, 0x3c, 0x92, 0x0a, 0xda,
+	0x87, 0xd8, 0x1f, 0x4d, 0xe4, 0x28, 0x95, 0xd6, 0x57, 0x0d, 0xac, 0x66, 0xf7, 0x91, 0x23, 0x3d,
+	0x03, 0x8b, 0xea, 0x48, 0x6c, 0x59, 0x33, 0xff, 0x9b, 0x63, 0xa6, 0xaa, 0x32, 0x13, 0x83, 0x4f,
+	0x27, 0x58, 0x97, 0x39, 0xeb, 0x8d, 0x4b, 0x59, 0x0b, 0x26, 0x13, 0xb4, 0xa7, 0xfc, 0x4a, 0x1d,
+	0x2f, 0xe8, 0x97, 0x80, 0xce, 0xfa, 0x95, 0xc6, 0x8b, 0xf8, 0x95, 0xe2, 0xa6, 0xfc, 0x4a, 0x43,
+	0x19, 0x7e, 0xa5, 0xe1, 0x7f, 0xe1, 0x97, 0xec, 0x33, 0xeb, 0x17, 0x4f, 0x14, 0xf1, 0x4b, 0x99,
+	0xa9, 0xaa, 0xcc, 0xf4, 0x17, 0xfd, 0x5a, 0x07, 0x6b, 0x9c, 0xf5, 0x63, 0x1a, 0xbe, 0x0a, 0xfc,
+	0x6e, 0x4c, 0xbc, 0xfd, 0x9e, 0xdb, 0xc2, 0xa1, 0x7f, 0xb1, 0xd1, 0xd6, 0x3e, 0x30, 0xf3, 0x21,
+	0x72, 0xb8, 0x75, 0x50, 0x25, 0x32, 0x78, 0x14, 0x78, 0x62, 0xb6, 0xab, 0x4e, 0x65, 0x14, 0x3b,
+	0xf0, 0xd8, 0xce, 0x97, 0x05, 0x70, 0x85, 0xbf, 0x03, 0x3f, 0x6a, 0xa0, 0xa2, 0x2c, 0x24, 0xbc,
+	0x9b, 0x29, 0x41, 0xce, 0xd9, 0xeb, 0xf5, 0x82, 0x68, 0xc1, 0xcc, 0xda, 0x7c, 0xf7, 0xed, 0xd7,
+	0x87, 0xb2, 0x05, 0x4d, 0x94, 0xff, 0xc5, 0x41, 0x6f, 0x02, 0xef, 0x2d, 0x3c, 0xd5, 0xc0, 0xf5,
+	0xa9, 0x63, 0x83, 0xf7, 0xf2, 0x9b, 0x65, 0xdf, 0xbf, 0xde, 0x98, 0xa3, 0x42, 0x52, 0xbc, 0xcd,
+	0x29, 0xde, 0x82, 0x2b, 0x7f, 0xa0, 0x08, 0x4f, 0xc7, 0xa2, 0xa5, 0x2b, 0x50, 0x40, 0x34, 0xe5,
+	0xf6, 0xf4, 0x7a, 0x41, 0xb4, 0x64, 0x84, 0x38, 0xa3, 0x2d, 0xb8, 0x91, 0xc9, 0x48, 0xec, 0xef,
+	0x84, 0x76, 0x9f, 0x26, 0xb4, 0x13, 0x3b, 0x5a, 0x48, 0x3b, 0xf5, 0x16, 0xf5, 0xc6, 0x1c, 0x15,
+	0x92, 0xe9, 0x36, 0x67, 0x7a, 0x07, 0x5a, 0x97, 0x33, 0x85, 0x9f, 0x35, 0xb0, 0x94, 0xb1, 0xc4,
+	0x70, 0x37, 0xbf, 0x6d, 0xfe, 0x59, 0xe8, 0x0f, 0xe6, 0xac, 0x92, 0x84, 0x6b, 0x9c, 0xb0, 0x09,
+	0x8d, 0x4c, 0xc2, 0xa3, 0x83, 0x61, 0x7b, 0xce, 0xd9, 0xc0, 0xd0, 0xce, 0x07, 0x86, 0xf6, 0x73,
+	0x60, 0x68, 0xef, 0x87, 0x46, 0xe9, 0x7c, 0x68, 0x94, 0xbe, 0x0f, 0x8d, 0xd2, 0xcb, 0x87, 0x7e,
+	0x90, 0xb4, 0xba, 0x4d, 0xdb, 0xa5, 0x9d, 0xc9, 0x37, 0x4e, 0x76, 0xeb, 0x6e, 0x0b, 0x07, 0x21,
+	0xba, 0x88, 0xf4, 0x46, 0xef, 0x26, 0xfd, 0x88, 0xb0, 0xe6, 0x02, 0x4f, 0xdc, 0xff, 0x3d, 0x00,
+	0x85, 0x71, 0x23, 0x51, 0x3b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -486,6 +579,8 @@ type QueryClient interface {
 	MarketParam(ctx context.Context, in *QueryMarketParamRequest, opts ...grpc.CallOption) (*QueryMarketParamResponse, error)
 	// Queries a list of MarketParam items.
 	AllMarketParams(ctx context.Context, in *QueryAllMarketParamsRequest, opts ...grpc.CallOption) (*QueryAllMarketParamsResponse, error)
+	// Queries the distinct exchange ids configured across all MarketParams.
+	ConfiguredExchanges(ctx context.Context, in *QueryConfiguredExchangesRequest, opts ...grpc.CallOption) (*QueryConfiguredExchangesResponse, error)
 }
 
 type queryClient struct {
@@ -532,6 +627,15 @@ func (c *queryClient) AllMarketParams(ctx context.Context, in *QueryAllMarketPar
 	return out, nil
 }
 
+func (c *queryClient) ConfiguredExchanges(ctx context.Context, in *QueryConfiguredExchangesRequest, opts ...grpc.CallOption) (*QueryConfiguredExchangesResponse, error) {
+	out := new(QueryConfiguredExchangesResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.prices.Query/ConfiguredExchanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a MarketPrice by id.
@@ -542,6 +646,8 @@ type QueryServer interface {
 	MarketParam(context.Context, *QueryMarketParamRequest) (*QueryMarketParamResponse, error)
 	// Queries a list of MarketParam items.
 	AllMarketParams(context.Context, *QueryAllMarketParamsRequest) (*QueryAllMarketParamsResponse, error)
+	// Queries the distinct exchange ids configured across all MarketParams.
+	ConfiguredExchanges(context.Context, *QueryConfiguredExchangesRequest) (*QueryConfiguredExchangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -560,6 +666,9 @@ func (*UnimplementedQueryServer) MarketParam(ctx context.Context, req *QueryMark
 func (*UnimplementedQueryServer) AllMarketParams(ctx context.Context, req *QueryAllMarketParamsRequest) (*QueryAllMarketParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllMarketParams not implemented")
 }
+func (*UnimplementedQueryServer) ConfiguredExchanges(ctx context.Context, req *QueryConfiguredExchangesRequest) (*QueryConfiguredExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfiguredExchanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -637,6 +746,24 @@ func _Query_AllMarketParams_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConfiguredExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConfiguredExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConfiguredExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.prices.Query/ConfiguredExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConfiguredExchanges(ctx, req.(*QueryConfiguredExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.prices.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -657,6 +784,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllMarketParams",
 			Handler:    _Query_AllMarketParams_Handler,
 		},
+		{
+			MethodName: "ConfiguredExchanges",
+			Handler:    _Query_ConfiguredExchanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/prices/query.proto",
@@ -952,6 +1083,61 @@ func (m *QueryAllMarketParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConfiguredExchangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfiguredExchangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfiguredExchangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConfiguredExchangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConfiguredExchangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConfiguredExchangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeIds) > 0 {
+		for iNdEx := len(m.ExchangeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExchangeIds[iNdEx])
+			copy(dAtA[i:], m.ExchangeIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1073,6 +1259,30 @@ func (m *QueryAllMarketParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConfiguredExchangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConfiguredExchangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeIds) > 0 {
+		for _, s := range m.ExchangeIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1795,6 +2005,138 @@ func (m *QueryAllMarketParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConfiguredExchangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfiguredExchangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfiguredExchangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfiguredExchangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfiguredExchangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfiguredExchangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeIds = append(m.ExchangeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
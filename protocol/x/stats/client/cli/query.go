@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
@@ -25,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryStatsMetadata())
 	cmd.AddCommand(CmdQueryGlobalStats())
 	cmd.AddCommand(CmdQueryUserStats())
+	cmd.AddCommand(CmdQueryInsuranceFundFlow())
 
 	return cmd
 }
@@ -123,3 +125,33 @@ func CmdQueryUserStats() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryInsuranceFundFlow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-insurance-fund-flow [epoch]",
+		Short: "get the insurance fund flow of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			epoch, err := cast.ToUint32E(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.InsuranceFundFlow(
+				context.Background(),
+				&types.QueryInsuranceFundFlowRequest{
+					Epoch: epoch,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
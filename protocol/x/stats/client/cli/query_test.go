@@ -82,3 +82,13 @@ func TestQueryUserStats(t *testing.T) {
 	var resp types.QueryUserStatsResponse
 	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
 }
+
+func TestQueryInsuranceFundFlow(t *testing.T) {
+	net, ctx := setupNetwork(t)
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdQueryInsuranceFundFlow(), []string{"1"})
+
+	require.NoError(t, err)
+	var resp types.QueryInsuranceFundFlowResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+}
@@ -83,3 +83,21 @@ func (k Keeper) UserStats(
 		Stats: userStats,
 	}, nil
 }
+
+func (k Keeper) InsuranceFundFlow(
+	c context.Context,
+	req *types.QueryInsuranceFundFlowRequest,
+) (
+	*types.QueryInsuranceFundFlowResponse,
+	error,
+) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+	flow := k.GetInsuranceFundFlow(ctx, req.Epoch)
+	return &types.QueryInsuranceFundFlowResponse{
+		Flow: flow,
+	}, nil
+}
@@ -1,13 +1,16 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 )
 
@@ -166,3 +169,106 @@ func TestUserStats(t *testing.T) {
 		})
 	}
 }
+
+func TestUserStats_AccumulatedOverEpochs(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	ctx := tApp.AdvanceToBlock(10, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(int64(epochstypes.StatsEpochDuration)+1, 0).UTC(),
+	})
+	k := tApp.App.StatsKeeper
+
+	// Fills in the first epoch.
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(5), false)
+	k.RecordFill(ctx, "bob", "alice", new(big.Int).SetUint64(10), false)
+	k.ProcessBlockStats(ctx)
+
+	// Fills in the second epoch. Block stats are stored in the transient store, so they are reset
+	// for the new block.
+	k.SetBlockStats(ctx, &types.BlockStats{})
+	ctx = ctx.WithBlockTime(time.Unix(2*int64(epochstypes.StatsEpochDuration)+1, 0).UTC())
+	nextEpochStarted, err := tApp.App.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.StatsEpochInfoName)
+	require.NoError(t, err)
+	require.True(t, nextEpochStarted)
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(20), false)
+	k.RecordFill(ctx, "alice", "carl", new(big.Int).SetUint64(40), false)
+	k.ProcessBlockStats(ctx)
+	k.ExpireOldStats(ctx)
+
+	require.NotNil(t, k.GetEpochStatsOrNil(ctx, 1))
+	require.NotNil(t, k.GetEpochStatsOrNil(ctx, 2))
+
+	for user, expectedStats := range map[string]*types.UserStats{
+		"alice": {
+			TakerNotional: 65,
+			MakerNotional: 10,
+		},
+		"bob": {
+			TakerNotional: 10,
+			MakerNotional: 25,
+		},
+		"carl": {
+			MakerNotional: 40,
+		},
+		"dave": {},
+	} {
+		res, err := k.UserStats(ctx, &types.QueryUserStatsRequest{User: user})
+		require.NoError(t, err)
+		require.Equal(t, expectedStats, res.Stats, user)
+	}
+}
+
+func TestInsuranceFundFlow(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	ctx := tApp.InitChain()
+	k := tApp.App.StatsKeeper
+	flow := &types.InsuranceFundFlow{
+		Inflow:  250,
+		Outflow: 10,
+	}
+	k.SetInsuranceFundFlow(ctx, 1, flow)
+
+	for name, tc := range map[string]struct {
+		req *types.QueryInsuranceFundFlowRequest
+		res *types.QueryInsuranceFundFlowResponse
+		err error
+	}{
+		"Success": {
+			req: &types.QueryInsuranceFundFlowRequest{
+				Epoch: 1,
+			},
+			res: &types.QueryInsuranceFundFlowResponse{
+				Flow: flow,
+			},
+			err: nil,
+		},
+		"Success: no insurance fund flows in epoch": {
+			req: &types.QueryInsuranceFundFlowRequest{
+				Epoch: 2,
+			},
+			res: &types.QueryInsuranceFundFlowResponse{
+				Flow: &types.InsuranceFundFlow{},
+			},
+			err: nil,
+		},
+		"Nil": {
+			req: nil,
+			res: nil,
+			err: status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := k.InsuranceFundFlow(ctx, tc.req)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.res, res)
+			}
+		})
+	}
+}
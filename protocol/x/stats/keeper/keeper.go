@@ -71,20 +71,47 @@ func (k Keeper) SetBlockStats(ctx sdk.Context, blockStats *types.BlockStats) {
 	store.Set([]byte(types.BlockStatsKey), b)
 }
 
-// Record a match in BlockStats, which is stored in the transient store
-func (k Keeper) RecordFill(ctx sdk.Context, takerAddress string, makerAddress string, notional *big.Int) {
+// Record a match in BlockStats, which is stored in the transient store. Liquidation fills only
+// contribute to the taker notional of the taker and not to the maker notional of the maker.
+func (k Keeper) RecordFill(
+	ctx sdk.Context,
+	takerAddress string,
+	makerAddress string,
+	notional *big.Int,
+	isLiquidation bool,
+) {
 	blockStats := k.GetBlockStats(ctx)
 	blockStats.Fills = append(
 		blockStats.Fills,
 		&types.BlockStats_Fill{
-			Taker:    takerAddress,
-			Maker:    makerAddress,
-			Notional: notional.Uint64(),
+			Taker:         takerAddress,
+			Maker:         makerAddress,
+			Notional:      notional.Uint64(),
+			IsLiquidation: isLiquidation,
 		},
 	)
 	k.SetBlockStats(ctx, blockStats)
 }
 
+// Record a payment into (positive delta) or out of (negative delta) the insurance fund in BlockStats,
+// which is stored in the transient store
+func (k Keeper) RecordInsuranceFundFlow(ctx sdk.Context, insuranceFundDelta *big.Int) {
+	if insuranceFundDelta.Sign() == 0 {
+		return
+	}
+
+	blockStats := k.GetBlockStats(ctx)
+	if blockStats.InsuranceFundFlow == nil {
+		blockStats.InsuranceFundFlow = &types.InsuranceFundFlow{}
+	}
+	if insuranceFundDelta.Sign() > 0 {
+		blockStats.InsuranceFundFlow.Inflow += insuranceFundDelta.Uint64()
+	} else {
+		blockStats.InsuranceFundFlow.Outflow += new(big.Int).Neg(insuranceFundDelta).Uint64()
+	}
+	k.SetBlockStats(ctx, blockStats)
+}
+
 func (k Keeper) GetStatsMetadata(ctx sdk.Context) *types.StatsMetadata {
 	store := ctx.KVStore(k.storeKey)
 	bytes := store.Get([]byte(types.StatsMetadataKey))
@@ -168,13 +195,51 @@ func (k Keeper) SetGlobalStats(ctx sdk.Context, globalStats *types.GlobalStats)
 	store.Set([]byte(types.GlobalStatsKey), b)
 }
 
+// GetInsuranceFundFlow returns the InsuranceFundFlow for an epoch. An empty InsuranceFundFlow is
+// returned if no insurance fund flows were recorded in the epoch.
+func (k Keeper) GetInsuranceFundFlow(ctx sdk.Context, epoch uint32) *types.InsuranceFundFlow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.InsuranceFundFlowKeyPrefix))
+	bytes := store.Get(lib.Uint32ToKey(epoch))
+
+	if bytes == nil {
+		return &types.InsuranceFundFlow{}
+	}
+
+	var flow types.InsuranceFundFlow
+	k.cdc.MustUnmarshal(bytes, &flow)
+	return &flow
+}
+
+func (k Keeper) SetInsuranceFundFlow(ctx sdk.Context, epoch uint32, flow *types.InsuranceFundFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.InsuranceFundFlowKeyPrefix))
+	b := k.cdc.MustMarshal(flow)
+	store.Set(lib.Uint32ToKey(epoch), b)
+}
+
+func (k Keeper) deleteInsuranceFundFlow(ctx sdk.Context, epoch uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.InsuranceFundFlowKeyPrefix))
+	store.Delete(lib.Uint32ToKey(epoch))
+}
+
 // ProcessBlockStats persists the info from this block's BlockStats this epoch's stats.
-// It also appropriately increments the overall stats globally and for each user
+// It also appropriately increments the overall stats globally and for each user, and
+// this epoch's insurance fund flow
 func (k Keeper) ProcessBlockStats(ctx sdk.Context) {
 	epochInfo := k.epochsKeeper.MustGetStatsEpochInfo(ctx)
 	blockStats := k.GetBlockStats(ctx)
 
-	if len(blockStats.Fills) == 0 {
+	// NB: These unsigned ints can technically overflow and wrap around, but the insurance fund
+	// flows required to do so are unrealistic.
+	if blockStats.InsuranceFundFlow != nil {
+		flow := k.GetInsuranceFundFlow(ctx, epochInfo.CurrentEpoch)
+		flow.Inflow += blockStats.InsuranceFundFlow.Inflow
+		flow.Outflow += blockStats.InsuranceFundFlow.Outflow
+		k.SetInsuranceFundFlow(ctx, epochInfo.CurrentEpoch, flow)
+	}
+
+	// Epoch stats are also recorded for insurance fund flows so that the flows of the epoch are
+	// deleted together with its epoch stats once the epoch falls out of the window.
+	if len(blockStats.Fills) == 0 && blockStats.InsuranceFundFlow == nil {
 		return
 	}
 
@@ -197,8 +262,12 @@ func (k Keeper) ProcessBlockStats(ctx sdk.Context) {
 		userStats.TakerNotional += fill.Notional
 		k.SetUserStats(ctx, fill.Taker, userStats)
 
+		makerNotional := fill.Notional
+		if fill.IsLiquidation {
+			makerNotional = 0
+		}
 		userStats = k.GetUserStats(ctx, fill.Maker)
-		userStats.MakerNotional += fill.Notional
+		userStats.MakerNotional += makerNotional
 		k.SetUserStats(ctx, fill.Maker, userStats)
 
 		if _, ok := userStatsMap[fill.Taker]; !ok {
@@ -214,12 +283,15 @@ func (k Keeper) ProcessBlockStats(ctx sdk.Context) {
 			}
 		}
 		userStatsMap[fill.Taker].Stats.TakerNotional += fill.Notional
-		userStatsMap[fill.Maker].Stats.MakerNotional += fill.Notional
+		userStatsMap[fill.Maker].Stats.MakerNotional += makerNotional
 
 		globalStats := k.GetGlobalStats(ctx)
 		globalStats.NotionalTraded += fill.Notional
+		globalStats.MakerNotional += makerNotional
+		globalStats.FillCount += 1
 		k.SetGlobalStats(ctx, globalStats)
 	}
+	epochStats.FillCount += uint64(len(blockStats.Fills))
 
 	keys := make([]string, 0, len(userStatsMap))
 	for k := range userStatsMap {
@@ -234,16 +306,52 @@ func (k Keeper) ProcessBlockStats(ctx sdk.Context) {
 	k.SetEpochStats(ctx, epochInfo.CurrentEpoch, epochStats)
 }
 
+// InitializeGlobalMakerNotional sets the global maker notional to the maker notional of all epochs
+// still within the window. This is used to initialize it for epochs recorded before it was tracked.
+func (k Keeper) InitializeGlobalMakerNotional(ctx sdk.Context) {
+	currentEpoch := k.epochsKeeper.MustGetStatsEpochInfo(ctx).CurrentEpoch
+	metadata := k.GetStatsMetadata(ctx)
+
+	makerNotional := uint64(0)
+	for epoch := metadata.TrailingEpoch; epoch <= currentEpoch; epoch++ {
+		epochStats := k.GetEpochStatsOrNil(ctx, epoch)
+		if epochStats == nil {
+			continue
+		}
+		for _, userStats := range epochStats.Stats {
+			makerNotional += userStats.Stats.MakerNotional
+		}
+	}
+
+	globalStats := k.GetGlobalStats(ctx)
+	globalStats.MakerNotional = makerNotional
+	k.SetGlobalStats(ctx, globalStats)
+}
+
 // ExpireOldStats expiration of stats when they fall out of the window.
 // TrailingEpoch is next epoch that can potentially fall out of the window.
 // Attempt to expire the next epoch. TrailingEpoch will be advanced at most once.
 func (k Keeper) ExpireOldStats(ctx sdk.Context) {
+	k.expireTrailingEpoch(ctx)
+}
+
+// ExpireAllOldStats expires all epochs that have fallen out of the window, advancing TrailingEpoch
+// until it reaches an epoch within the window or the current epoch. This is used when the window
+// shrinks so that global and user stats reflect the new window immediately.
+func (k Keeper) ExpireAllOldStats(ctx sdk.Context) {
+	for k.expireTrailingEpoch(ctx) {
+	}
+}
+
+// expireTrailingEpoch attempts to expire the epoch at TrailingEpoch. Returns true iff TrailingEpoch
+// was advanced.
+func (k Keeper) expireTrailingEpoch(ctx sdk.Context) (advanced bool) {
 	currentEpoch := k.epochsKeeper.MustGetStatsEpochInfo(ctx).CurrentEpoch
 	metadata := k.GetStatsMetadata(ctx)
 
 	// Current epoch can't be expired.
 	if metadata.TrailingEpoch == currentEpoch {
-		return
+		return false
 	}
 
 	epochStats := k.GetEpochStatsOrNil(ctx, metadata.TrailingEpoch)
@@ -251,12 +359,12 @@ func (k Keeper) ExpireOldStats(ctx sdk.Context) {
 	if epochStats == nil {
 		metadata.TrailingEpoch += 1
 		k.SetStatsMetadata(ctx, metadata)
-		return
+		return true
 	}
 
 	// Epoch not ready to fall out of window
 	if !epochStats.EpochEndTime.Before(ctx.BlockTime().Add(-k.GetWindowDuration(ctx))) {
-		return
+		return false
 	}
 
 	globalStats := k.GetGlobalStats(ctx)
@@ -268,9 +376,13 @@ func (k Keeper) ExpireOldStats(ctx sdk.Context) {
 
 		// Just remove TakerNotional to avoid double counting
 		globalStats.NotionalTraded -= removedStats.Stats.TakerNotional
+		globalStats.MakerNotional -= removedStats.Stats.MakerNotional
 	}
+	globalStats.FillCount -= epochStats.FillCount
 	k.SetGlobalStats(ctx, globalStats)
 	k.deleteEpochStats(ctx, metadata.TrailingEpoch)
+	k.deleteInsuranceFundFlow(ctx, metadata.TrailingEpoch)
 	metadata.TrailingEpoch += 1
 	k.SetStatsMetadata(ctx, metadata)
+	return true
 }
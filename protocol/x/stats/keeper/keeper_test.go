@@ -21,9 +21,10 @@ func TestLogger(t *testing.T) {
 }
 
 type recordFillArgs struct {
-	taker    string
-	maker    string
-	notional *big.Int
+	taker         string
+	maker         string
+	notional      *big.Int
+	isLiquidation bool
 }
 
 func TestRecordFill(t *testing.T) {
@@ -39,7 +40,7 @@ func TestRecordFill(t *testing.T) {
 		},
 		"single fill": {
 			[]recordFillArgs{
-				{"taker", "maker", new(big.Int).SetUint64(123)},
+				{"taker", "maker", new(big.Int).SetUint64(123), false},
 			},
 			&types.BlockStats{
 				Fills: []*types.BlockStats_Fill{
@@ -53,8 +54,8 @@ func TestRecordFill(t *testing.T) {
 		},
 		"multiple fills": {
 			[]recordFillArgs{
-				{"alice", "bob", new(big.Int).SetUint64(123)},
-				{"bob", "alice", new(big.Int).SetUint64(321)},
+				{"alice", "bob", new(big.Int).SetUint64(123), false},
+				{"bob", "alice", new(big.Int).SetUint64(321), false},
 			},
 			&types.BlockStats{
 				Fills: []*types.BlockStats_Fill{
@@ -71,6 +72,21 @@ func TestRecordFill(t *testing.T) {
 				},
 			},
 		},
+		"liquidation fill": {
+			[]recordFillArgs{
+				{"taker", "maker", new(big.Int).SetUint64(123), true},
+			},
+			&types.BlockStats{
+				Fills: []*types.BlockStats_Fill{
+					{
+						Taker:         "taker",
+						Maker:         "maker",
+						Notional:      123,
+						IsLiquidation: true,
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -80,7 +96,61 @@ func TestRecordFill(t *testing.T) {
 			k := tApp.App.StatsKeeper
 
 			for _, fill := range tc.args {
-				k.RecordFill(ctx, fill.taker, fill.maker, fill.notional)
+				k.RecordFill(ctx, fill.taker, fill.maker, fill.notional, fill.isLiquidation)
+			}
+			require.Equal(t, tc.expectedBlockStats, k.GetBlockStats(ctx))
+		})
+	}
+}
+
+func TestRecordInsuranceFundFlow(t *testing.T) {
+	tests := map[string]struct {
+		insuranceFundDeltas []*big.Int
+		expectedBlockStats  *types.BlockStats
+	}{
+		"no insurance fund flows": {
+			[]*big.Int{},
+			&types.BlockStats{},
+		},
+		"zero insurance fund delta": {
+			[]*big.Int{big.NewInt(0)},
+			&types.BlockStats{},
+		},
+		"inflow": {
+			[]*big.Int{big.NewInt(123)},
+			&types.BlockStats{
+				InsuranceFundFlow: &types.InsuranceFundFlow{
+					Inflow: 123,
+				},
+			},
+		},
+		"outflow": {
+			[]*big.Int{big.NewInt(-123)},
+			&types.BlockStats{
+				InsuranceFundFlow: &types.InsuranceFundFlow{
+					Outflow: 123,
+				},
+			},
+		},
+		"multiple inflows and outflows": {
+			[]*big.Int{big.NewInt(123), big.NewInt(-10), big.NewInt(321), big.NewInt(-5)},
+			&types.BlockStats{
+				InsuranceFundFlow: &types.InsuranceFundFlow{
+					Inflow:  444,
+					Outflow: 15,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tApp := testapp.NewTestAppBuilder(t).Build()
+			ctx := tApp.InitChain()
+			k := tApp.App.StatsKeeper
+
+			for _, insuranceFundDelta := range tc.insuranceFundDeltas {
+				k.RecordInsuranceFundFlow(ctx, insuranceFundDelta)
 			}
 			require.Equal(t, tc.expectedBlockStats, k.GetBlockStats(ctx))
 		})
@@ -117,6 +187,8 @@ func TestProcessBlockStats(t *testing.T) {
 
 	assert.Equal(t, &types.GlobalStats{
 		NotionalTraded: 15,
+		MakerNotional:  15,
+		FillCount:      2,
 	}, k.GetGlobalStats(ctx))
 	assert.Equal(t, &types.UserStats{
 		TakerNotional: 5,
@@ -144,6 +216,7 @@ func TestProcessBlockStats(t *testing.T) {
 				},
 			},
 		},
+		FillCount: 2,
 	}, k.GetEpochStatsOrNil(ctx, 1))
 
 	k.SetBlockStats(ctx, &types.BlockStats{
@@ -158,6 +231,8 @@ func TestProcessBlockStats(t *testing.T) {
 	k.ProcessBlockStats(ctx)
 	assert.Equal(t, &types.GlobalStats{
 		NotionalTraded: 25,
+		MakerNotional:  25,
+		FillCount:      3,
 	}, k.GetGlobalStats(ctx))
 	assert.Equal(t, &types.UserStats{
 		TakerNotional: 5,
@@ -185,9 +260,232 @@ func TestProcessBlockStats(t *testing.T) {
 				},
 			},
 		},
+		FillCount: 3,
+	}, k.GetEpochStatsOrNil(ctx, 1))
+}
+
+func TestProcessBlockStats_LiquidationFill(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	ctx := tApp.AdvanceToBlock(10, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(int64(epochstypes.StatsEpochDuration)+1, 0).UTC(),
+	})
+	k := tApp.App.StatsKeeper
+
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(5), false)
+	k.RecordFill(ctx, "bob", "alice", new(big.Int).SetUint64(10), true)
+	k.ProcessBlockStats(ctx)
+
+	// The liquidation fill contributes to the taker notional of bob and the notional traded,
+	// but not to the maker notional of alice.
+	assert.Equal(t, &types.GlobalStats{
+		NotionalTraded: 15,
+		MakerNotional:  5,
+		FillCount:      2,
+	}, k.GetGlobalStats(ctx))
+	assert.Equal(t, &types.UserStats{
+		TakerNotional: 5,
+	}, k.GetUserStats(ctx, "alice"))
+	assert.Equal(t, &types.UserStats{
+		TakerNotional: 10,
+		MakerNotional: 5,
+	}, k.GetUserStats(ctx, "bob"))
+	assert.Equal(t, &types.EpochStats{
+		EpochEndTime: time.Unix(7200, 0).UTC(),
+		Stats: []*types.EpochStats_UserWithStats{
+			{
+				User: "alice",
+				Stats: &types.UserStats{
+					TakerNotional: 5,
+				},
+			},
+			{
+				User: "bob",
+				Stats: &types.UserStats{
+					TakerNotional: 10,
+					MakerNotional: 5,
+				},
+			},
+		},
+		FillCount: 2,
 	}, k.GetEpochStatsOrNil(ctx, 1))
 }
 
+func TestProcessBlockStats_InsuranceFundFlow(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	ctx := tApp.AdvanceToBlock(10, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(int64(epochstypes.StatsEpochDuration)+1, 0).UTC(),
+	})
+	k := tApp.App.StatsKeeper
+	require.Equal(t, &types.InsuranceFundFlow{}, k.GetInsuranceFundFlow(ctx, 1))
+
+	k.SetBlockStats(ctx, &types.BlockStats{
+		InsuranceFundFlow: &types.InsuranceFundFlow{
+			Inflow:  250,
+			Outflow: 1,
+		},
+	})
+	k.ProcessBlockStats(ctx)
+	assert.Equal(t, &types.InsuranceFundFlow{
+		Inflow:  250,
+		Outflow: 1,
+	}, k.GetInsuranceFundFlow(ctx, 1))
+
+	// Insurance fund flows accumulate over the epoch.
+	k.SetBlockStats(ctx, &types.BlockStats{
+		InsuranceFundFlow: &types.InsuranceFundFlow{
+			Outflow: 10,
+		},
+	})
+	k.ProcessBlockStats(ctx)
+	assert.Equal(t, &types.InsuranceFundFlow{
+		Inflow:  250,
+		Outflow: 11,
+	}, k.GetInsuranceFundFlow(ctx, 1))
+	assert.Equal(t, &types.InsuranceFundFlow{}, k.GetInsuranceFundFlow(ctx, 2))
+}
+
+func TestExpireOldStats_InsuranceFundFlow(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	epochDuration := time.Duration(epochstypes.StatsEpochDuration) * time.Second
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	ctx := tApp.AdvanceToBlock(10, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(0, 0).Add(epochDuration).Add(time.Second).UTC(),
+	})
+	k := tApp.App.StatsKeeper
+	windowDuration := k.GetWindowDuration(ctx)
+
+	// Epoch 1 only has an insurance fund flow.
+	k.RecordInsuranceFundFlow(ctx, big.NewInt(100))
+	k.ProcessBlockStats(ctx)
+	require.NotNil(t, k.GetEpochStatsOrNil(ctx, 1))
+
+	// Epoch 2 has a fill and an insurance fund flow.
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(2 * epochDuration).Add(time.Second).UTC())
+	nextEpochStarted, err := tApp.App.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.StatsEpochInfoName)
+	require.NoError(t, err)
+	require.True(t, nextEpochStarted)
+	k.SetBlockStats(ctx, &types.BlockStats{})
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(5), false)
+	k.RecordInsuranceFundFlow(ctx, big.NewInt(-20))
+	k.ProcessBlockStats(ctx)
+
+	// Epoch 1 falls out of the window, while epoch 2 is retained.
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(2 * epochDuration).Add(windowDuration).Add(time.Second).UTC())
+	k.ExpireAllOldStats(ctx)
+	require.Nil(t, k.GetEpochStatsOrNil(ctx, 1))
+	require.Equal(t, &types.InsuranceFundFlow{}, k.GetInsuranceFundFlow(ctx, 1))
+	require.Equal(t, &types.InsuranceFundFlow{Outflow: 20}, k.GetInsuranceFundFlow(ctx, 2))
+}
+
+func TestExpireOldStats_GlobalMakerNotionalAndFillCount(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	epochDuration := time.Duration(epochstypes.StatsEpochDuration) * time.Second
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	ctx := tApp.AdvanceToBlock(10, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(0, 0).Add(epochDuration).Add(time.Second).UTC(),
+	})
+	k := tApp.App.StatsKeeper
+	windowDuration := k.GetWindowDuration(ctx)
+
+	// startEpoch starts the epoch that contains the provided block time and resets the block stats,
+	// which are stored in the transient store.
+	startEpoch := func(blockTime time.Time) {
+		ctx = ctx.WithBlockTime(blockTime.UTC())
+		nextEpochStarted, err := tApp.App.EpochsKeeper.MaybeStartNextEpoch(ctx, epochstypes.StatsEpochInfoName)
+		require.NoError(t, err)
+		require.True(t, nextEpochStarted)
+		k.SetBlockStats(ctx, &types.BlockStats{})
+	}
+
+	// Epoch 1 has a regular fill and a liquidation fill.
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(10), false)
+	k.RecordFill(ctx, "bob", "alice", new(big.Int).SetUint64(20), true)
+	k.ProcessBlockStats(ctx)
+	require.Equal(t, &types.GlobalStats{
+		NotionalTraded: 30,
+		MakerNotional:  10,
+		FillCount:      2,
+	}, k.GetGlobalStats(ctx))
+
+	// Epoch 2 has a single regular fill.
+	startEpoch(time.Unix(0, 0).Add(2 * epochDuration).Add(time.Second))
+	k.RecordFill(ctx, "alice", "bob", new(big.Int).SetUint64(5), false)
+	k.ProcessBlockStats(ctx)
+	require.Equal(t, uint64(1), k.GetEpochStatsOrNil(ctx, 2).FillCount)
+	require.Equal(t, &types.GlobalStats{
+		NotionalTraded: 35,
+		MakerNotional:  15,
+		FillCount:      3,
+	}, k.GetGlobalStats(ctx))
+
+	// Epoch 1 falls out of the window.
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(2 * epochDuration).Add(windowDuration).Add(time.Second).UTC())
+	k.ExpireAllOldStats(ctx)
+	require.Nil(t, k.GetEpochStatsOrNil(ctx, 1))
+	require.Equal(t, &types.GlobalStats{
+		NotionalTraded: 5,
+		MakerNotional:  5,
+		FillCount:      1,
+	}, k.GetGlobalStats(ctx))
+
+	// Epoch 2 falls out of the window.
+	startEpoch(time.Unix(0, 0).Add(3 * epochDuration).Add(windowDuration).Add(time.Second))
+	k.ExpireAllOldStats(ctx)
+	require.Nil(t, k.GetEpochStatsOrNil(ctx, 2))
+	require.Equal(t, &types.GlobalStats{}, k.GetGlobalStats(ctx))
+	require.Equal(t, &types.UserStats{}, k.GetUserStats(ctx, "alice"))
+	require.Equal(t, &types.UserStats{}, k.GetUserStats(ctx, "bob"))
+
+	// Epochs recorded before maker notional was tracked globally are counted once it is initialized,
+	// and are removed from it when they fall out of the window.
+	k.SetEpochStats(ctx, 3, &types.EpochStats{
+		EpochEndTime: time.Unix(0, 0).Add(4 * epochDuration).UTC(),
+		Stats: []*types.EpochStats_UserWithStats{
+			{
+				User: "alice",
+				Stats: &types.UserStats{
+					TakerNotional: 7,
+					MakerNotional: 7,
+				},
+			},
+		},
+	})
+	k.SetUserStats(ctx, "alice", &types.UserStats{
+		TakerNotional: 7,
+		MakerNotional: 7,
+	})
+	k.SetGlobalStats(ctx, &types.GlobalStats{
+		NotionalTraded: 7,
+	})
+	k.InitializeGlobalMakerNotional(ctx)
+	require.Equal(t, &types.GlobalStats{
+		NotionalTraded: 7,
+		MakerNotional:  7,
+	}, k.GetGlobalStats(ctx))
+	startEpoch(time.Unix(0, 0).Add(4 * epochDuration).Add(windowDuration).Add(time.Second))
+	k.ExpireAllOldStats(ctx)
+	require.Nil(t, k.GetEpochStatsOrNil(ctx, 3))
+	require.Equal(t, &types.GlobalStats{}, k.GetGlobalStats(ctx))
+}
+
 func TestExpireOldStats(t *testing.T) {
 	tApp := testapp.NewTestAppBuilder(t).Build()
 
@@ -241,6 +539,7 @@ func TestExpireOldStats(t *testing.T) {
 	})
 	k.SetGlobalStats(ctx, &types.GlobalStats{
 		NotionalTraded: 90,
+		MakerNotional:  90,
 	})
 	k.SetStatsMetadata(ctx, &types.StatsMetadata{
 		TrailingEpoch: 0,
@@ -263,6 +562,7 @@ func TestExpireOldStats(t *testing.T) {
 		}, k.GetUserStats(ctx, "bob"))
 		require.Equal(t, &types.GlobalStats{
 			NotionalTraded: 90 - 3*uint64(i+1),
+			MakerNotional:  90 - 3*uint64(i+1),
 		}, k.GetGlobalStats(ctx))
 
 		// EpochStats removed
@@ -281,6 +581,7 @@ func TestExpireOldStats(t *testing.T) {
 		}, k.GetUserStats(ctx, "bob"))
 		require.Equal(t, &types.GlobalStats{
 			NotionalTraded: 90 - 3*uint64(i+1),
+			MakerNotional:  90 - 3*uint64(i+1),
 		}, k.GetGlobalStats(ctx))
 	}
 
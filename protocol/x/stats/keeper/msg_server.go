@@ -35,9 +35,16 @@ func (k msgServer) UpdateParams(
 	}
 
 	ctx := lib.UnwrapSDKContext(goCtx, types.ModuleName)
+	oldWindowDuration := k.GetWindowDuration(ctx)
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
+	// If the window shrinks, expire all epochs that are now outside of it instead of waiting for
+	// them to be expired one per block.
+	if msg.Params.WindowDuration < oldWindowDuration {
+		k.ExpireAllOldStats(ctx)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	testapp "github.com/dydxprotocol/v4-chain/protocol/testutil/app"
+	epochstypes "github.com/dydxprotocol/v4-chain/protocol/x/epochs/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/x/stats/types"
 	"github.com/stretchr/testify/require"
@@ -82,3 +84,75 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams_ShrinkingWindowExpiresOldStats(t *testing.T) {
+	tApp := testapp.NewTestAppBuilder(t).Build()
+	epochDuration := time.Duration(epochstypes.StatsEpochDuration) * time.Second
+
+	// Epochs initialize at block height 2
+	tApp.AdvanceToBlock(2, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(1, 0).UTC(),
+	})
+	tApp.AdvanceToBlock(3, testapp.AdvanceToBlockOptions{
+		BlockTime: time.Unix(0, 0).Add(10 * epochDuration).Add(time.Second).UTC(),
+	})
+	// Epochs advance at most once per block.
+	ctx := tApp.AdvanceToBlock(15, testapp.AdvanceToBlockOptions{})
+	require.Greater(t, tApp.App.EpochsKeeper.MustGetStatsEpochInfo(ctx).CurrentEpoch, uint32(5))
+	k := tApp.App.StatsKeeper
+	ms := keeper.NewMsgServerImpl(k)
+
+	// Epochs 0 through 4 each have 1 notional of stats for alice.
+	for i := 0; i < 5; i++ {
+		k.SetEpochStats(ctx, uint32(i), &types.EpochStats{
+			EpochEndTime: time.Unix(0, 0).Add(time.Duration(i+1) * epochDuration).UTC(),
+			Stats: []*types.EpochStats_UserWithStats{
+				{
+					User: "alice",
+					Stats: &types.UserStats{
+						TakerNotional: 1,
+					},
+				},
+			},
+		})
+	}
+	k.SetUserStats(ctx, "alice", &types.UserStats{
+		TakerNotional: 5,
+	})
+	k.SetGlobalStats(ctx, &types.GlobalStats{
+		NotionalTraded: 5,
+	})
+	k.SetStatsMetadata(ctx, &types.StatsMetadata{
+		TrailingEpoch: 0,
+	})
+
+	// Growing the window does not expire any epochs.
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: GovAuthority,
+		Params: types.Params{
+			WindowDuration: 2 * k.GetWindowDuration(ctx),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.StatsMetadata{TrailingEpoch: 0}, k.GetStatsMetadata(ctx))
+
+	// Shrinking the window so that epochs 0 through 2 end before the start of the window expires
+	// all of them immediately.
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: GovAuthority,
+		Params: types.Params{
+			WindowDuration: 7 * epochDuration,
+		},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, k.GetEpochStatsOrNil(ctx, uint32(i)))
+	}
+	for i := 3; i < 5; i++ {
+		require.NotNil(t, k.GetEpochStatsOrNil(ctx, uint32(i)))
+	}
+	require.Equal(t, &types.StatsMetadata{TrailingEpoch: 3}, k.GetStatsMetadata(ctx))
+	require.Equal(t, &types.UserStats{TakerNotional: 2}, k.GetUserStats(ctx, "alice"))
+	require.Equal(t, &types.GlobalStats{NotionalTraded: 2}, k.GetGlobalStats(ctx))
+}
@@ -20,6 +20,9 @@ const (
 	// UserStatsKeyPrefix is the prefix to retrieve the UserStats for a given user
 	UserStatsKeyPrefix = "User:"
 
+	// InsuranceFundFlowKeyPrefix is the prefix to retrieve the InsuranceFundFlow for a given epoch
+	InsuranceFundFlowKeyPrefix = "InsuranceFundFlow:"
+
 	// StatsMetadataKey is the key to get the StatsMetadata for the module
 	StatsMetadataKey = "Metadata"
 
@@ -366,6 +366,98 @@ func (m *QueryUserStatsResponse) GetStats() *UserStats {
 	return nil
 }
 
+// QueryInsuranceFundFlowRequest is a request type for the InsuranceFundFlow
+// RPC method.
+type QueryInsuranceFundFlowRequest struct {
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryInsuranceFundFlowRequest) Reset()         { *m = QueryInsuranceFundFlowRequest{} }
+func (m *QueryInsuranceFundFlowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundFlowRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundFlowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17835dac31373c4f, []int{8}
+}
+func (m *QueryInsuranceFundFlowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundFlowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundFlowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundFlowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundFlowRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundFlowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundFlowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundFlowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundFlowRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundFlowRequest) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryInsuranceFundFlowResponse is a response type for the InsuranceFundFlow
+// RPC method.
+type QueryInsuranceFundFlowResponse struct {
+	Flow *InsuranceFundFlow `protobuf:"bytes,1,opt,name=flow,proto3" json:"flow,omitempty"`
+}
+
+func (m *QueryInsuranceFundFlowResponse) Reset()         { *m = QueryInsuranceFundFlowResponse{} }
+func (m *QueryInsuranceFundFlowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundFlowResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundFlowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17835dac31373c4f, []int{9}
+}
+func (m *QueryInsuranceFundFlowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundFlowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundFlowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundFlowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundFlowResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundFlowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundFlowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundFlowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundFlowResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundFlowResponse) GetFlow() *InsuranceFundFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dydxprotocol.stats.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dydxprotocol.stats.QueryParamsResponse")
@@ -375,44 +467,51 @@ func init() {
 	proto.RegisterType((*QueryGlobalStatsResponse)(nil), "dydxprotocol.stats.QueryGlobalStatsResponse")
 	proto.RegisterType((*QueryUserStatsRequest)(nil), "dydxprotocol.stats.QueryUserStatsRequest")
 	proto.RegisterType((*QueryUserStatsResponse)(nil), "dydxprotocol.stats.QueryUserStatsResponse")
+	proto.RegisterType((*QueryInsuranceFundFlowRequest)(nil), "dydxprotocol.stats.QueryInsuranceFundFlowRequest")
+	proto.RegisterType((*QueryInsuranceFundFlowResponse)(nil), "dydxprotocol.stats.QueryInsuranceFundFlowResponse")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/stats/query.proto", fileDescriptor_17835dac31373c4f) }
 
 var fileDescriptor_17835dac31373c4f = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x43, 0x89, 0x7d, 0x8d, 0x97, 0x67, 0xd5, 0x76, 0xb5, 0x8b, 0xdd, 0x06, 0x6b,
-	0x45, 0x76, 0x93, 0x56, 0xa3, 0x17, 0x2f, 0xbd, 0x78, 0x6a, 0x14, 0x8c, 0x17, 0x3d, 0x34, 0x03,
-	0x4c, 0xb6, 0x24, 0xb0, 0xb3, 0xdd, 0x19, 0x4c, 0xb9, 0x19, 0xef, 0x26, 0x26, 0xc6, 0xa3, 0xff,
-	0x4f, 0x8f, 0x44, 0x2f, 0x9e, 0x8c, 0x01, 0xff, 0x10, 0xc3, 0x9b, 0x01, 0x59, 0xd8, 0x4d, 0xb9,
-	0x6c, 0x86, 0xf7, 0xbe, 0xf7, 0xfd, 0x3e, 0xf2, 0x1e, 0xb8, 0xed, 0x41, 0xfb, 0x22, 0x4e, 0xa4,
-	0x96, 0x2d, 0xd9, 0x0d, 0x94, 0xe6, 0x5a, 0x05, 0xe7, 0x7d, 0x91, 0x0c, 0x7c, 0x2a, 0x22, 0xce,
-	0xf7, 0x7d, 0xea, 0x3b, 0x9b, 0xa1, 0x0c, 0x25, 0xd5, 0x82, 0xc9, 0xcb, 0x28, 0x9d, 0x7b, 0xa1,
-	0x94, 0x61, 0x57, 0x04, 0x3c, 0xee, 0x04, 0x3c, 0x8a, 0xa4, 0xe6, 0xba, 0x23, 0x23, 0x65, 0xbb,
-	0xe5, 0x0c, 0x4e, 0xcc, 0x13, 0xde, 0x9b, 0x0a, 0xb2, 0x82, 0xd0, 0xd7, 0xf4, 0xbd, 0x4d, 0xc0,
-	0xfa, 0x24, 0xd7, 0x6b, 0x1a, 0x6a, 0x88, 0xf3, 0xbe, 0x50, 0xda, 0x7b, 0x05, 0x37, 0x53, 0x55,
-	0x15, 0xcb, 0x48, 0x09, 0x7c, 0x0e, 0x25, 0x63, 0xbe, 0xc5, 0xee, 0xb3, 0x87, 0x1b, 0x87, 0x8e,
-	0xbf, 0xfc, 0x37, 0x7c, 0x33, 0x73, 0x5c, 0xbc, 0xfc, 0x5d, 0x2e, 0x34, 0xac, 0xde, 0xbb, 0x0b,
-	0xdb, 0x64, 0xf8, 0x66, 0x22, 0x39, 0x11, 0x9a, 0xb7, 0xb9, 0xe6, 0x53, 0xda, 0x7b, 0x70, 0xb2,
-	0x9a, 0x16, 0xfa, 0x02, 0xae, 0xf7, 0x6c, 0xcd, 0x62, 0x77, 0xb3, 0xb0, 0xe9, 0xe1, 0xd9, 0x88,
-	0xb7, 0x0d, 0x77, 0xc8, 0xfc, 0x65, 0x57, 0x36, 0x79, 0x97, 0x54, 0x53, 0x6e, 0x1d, 0xb6, 0x96,
-	0x5b, 0x96, 0xfa, 0x14, 0xd6, 0xc8, 0xd7, 0x22, 0xcb, 0x59, 0xc8, 0xf9, 0x39, 0xa3, 0xf6, 0xaa,
-	0x70, 0x8b, 0x2c, 0xdf, 0x2a, 0x91, 0xcc, 0xb3, 0x10, 0xa1, 0xd8, 0x57, 0x22, 0x21, 0xbb, 0xf5,
-	0x06, 0xbd, 0xbd, 0x13, 0xb8, 0xbd, 0x28, 0xb6, 0xf4, 0xa3, 0x34, 0x7d, 0x27, 0x8b, 0xfe, 0x7f,
-	0xca, 0x68, 0x0f, 0x7f, 0x14, 0x61, 0x8d, 0xfc, 0xf0, 0x23, 0x83, 0x92, 0x59, 0x03, 0x3e, 0xc8,
-	0x1a, 0x5d, 0xde, 0xb8, 0xb3, 0x7f, 0xa5, 0xce, 0x44, 0xf3, 0x2a, 0x9f, 0x7e, 0xfe, 0xfd, 0x7a,
-	0xad, 0x8c, 0x3b, 0x41, 0xea, 0xb2, 0x3e, 0x3c, 0x49, 0x5d, 0x1f, 0x7e, 0x67, 0x70, 0x23, 0xb5,
-	0x12, 0xac, 0xe5, 0x12, 0xb2, 0x8e, 0xc2, 0xf1, 0x57, 0x95, 0xdb, 0x5c, 0x35, 0xca, 0xb5, 0x8f,
-	0x95, 0x9c, 0x5c, 0xf4, 0x3d, 0x9d, 0x9e, 0x05, 0x7e, 0x63, 0xb0, 0x31, 0xb7, 0x3f, 0xac, 0xe6,
-	0xe2, 0x96, 0x0f, 0xc7, 0x79, 0xbc, 0x9a, 0xd8, 0x26, 0xab, 0x52, 0xb2, 0x0a, 0xee, 0xe5, 0x24,
-	0x0b, 0x69, 0xe6, 0x94, 0x7e, 0xe0, 0x67, 0x06, 0xeb, 0xb3, 0xcd, 0xe2, 0x41, 0x2e, 0x68, 0xf1,
-	0xc0, 0x9c, 0x47, 0xab, 0x48, 0x6d, 0xa2, 0x03, 0x4a, 0xb4, 0x87, 0xbb, 0x39, 0x89, 0x26, 0xd7,
-	0x69, 0xf2, 0x1c, 0xd7, 0x2f, 0x47, 0x2e, 0x1b, 0x8e, 0x5c, 0xf6, 0x67, 0xe4, 0xb2, 0x2f, 0x63,
-	0xb7, 0x30, 0x1c, 0xbb, 0x85, 0x5f, 0x63, 0xb7, 0xf0, 0xee, 0x59, 0xd8, 0xd1, 0x67, 0xfd, 0xa6,
-	0xdf, 0x92, 0xbd, 0x45, 0x9b, 0x5a, 0xeb, 0x8c, 0x77, 0xa2, 0x60, 0x56, 0xb9, 0xb0, 0xbe, 0x7a,
-	0x10, 0x0b, 0xd5, 0x2c, 0x51, 0xfd, 0xe8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xdb, 0xd8,
-	0x74, 0x24, 0x05, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbd, 0x6e, 0xd3, 0x50,
+	0x14, 0x8e, 0x51, 0x13, 0xd1, 0x53, 0x75, 0xe0, 0x12, 0xa0, 0x35, 0xc4, 0xa1, 0xae, 0x42, 0x69,
+	0xd3, 0xd8, 0x22, 0xa5, 0x02, 0x06, 0x96, 0x0e, 0x45, 0x0c, 0x15, 0x24, 0x88, 0x85, 0x0e, 0xd1,
+	0x4d, 0x72, 0xeb, 0x44, 0x72, 0x7c, 0x5d, 0xff, 0xd0, 0x66, 0x43, 0x30, 0x23, 0x21, 0x21, 0x46,
+	0x5e, 0x81, 0xe7, 0xe8, 0x58, 0x89, 0x85, 0x09, 0xa1, 0x84, 0x07, 0x41, 0x39, 0xbe, 0x0e, 0x71,
+	0xec, 0x2b, 0xc2, 0x62, 0xd9, 0xe7, 0x7c, 0x7f, 0x96, 0xbe, 0x03, 0x5a, 0x77, 0xd8, 0x3d, 0x77,
+	0x3d, 0x1e, 0xf0, 0x0e, 0xb7, 0x4d, 0x3f, 0xa0, 0x81, 0x6f, 0x9e, 0x86, 0xcc, 0x1b, 0x1a, 0x38,
+	0x24, 0x64, 0x76, 0x6f, 0xe0, 0x5e, 0x2d, 0x5a, 0xdc, 0xe2, 0x38, 0x33, 0x27, 0x6f, 0x11, 0x52,
+	0xbd, 0x63, 0x71, 0x6e, 0xd9, 0xcc, 0xa4, 0x6e, 0xdf, 0xa4, 0x8e, 0xc3, 0x03, 0x1a, 0xf4, 0xb9,
+	0xe3, 0x8b, 0x6d, 0x39, 0xc3, 0xc7, 0xa5, 0x1e, 0x1d, 0xc4, 0x80, 0xac, 0x20, 0xf8, 0x8c, 0xf6,
+	0x7a, 0x11, 0x48, 0x63, 0x92, 0xeb, 0x25, 0x92, 0x9a, 0xec, 0x34, 0x64, 0x7e, 0xa0, 0xbf, 0x80,
+	0xeb, 0x89, 0xa9, 0xef, 0x72, 0xc7, 0x67, 0xe4, 0x31, 0x14, 0x22, 0xf1, 0x35, 0xe5, 0xae, 0x72,
+	0x7f, 0xa5, 0xae, 0x1a, 0xe9, 0xdf, 0x30, 0x22, 0xce, 0xc1, 0xd2, 0xc5, 0xcf, 0x72, 0xae, 0x29,
+	0xf0, 0xfa, 0x6d, 0x58, 0x47, 0xc1, 0x57, 0x13, 0xc8, 0x11, 0x0b, 0x68, 0x97, 0x06, 0x34, 0x76,
+	0x3b, 0x06, 0x35, 0x6b, 0x29, 0x4c, 0x9f, 0xc2, 0xd5, 0x81, 0x98, 0x09, 0xdb, 0x8d, 0x2c, 0xdb,
+	0x24, 0x79, 0x4a, 0xd1, 0xd7, 0xe1, 0x16, 0x8a, 0x3f, 0xb3, 0x79, 0x9b, 0xda, 0x88, 0x8a, 0x7d,
+	0x1b, 0xb0, 0x96, 0x5e, 0x09, 0xd7, 0x7d, 0xc8, 0xa3, 0xae, 0xb0, 0x2c, 0x67, 0x59, 0xce, 0xf2,
+	0x22, 0xb4, 0x5e, 0x85, 0x1b, 0x28, 0xf9, 0xda, 0x67, 0xde, 0xac, 0x17, 0x21, 0xb0, 0x14, 0xfa,
+	0xcc, 0x43, 0xb9, 0xe5, 0x26, 0xbe, 0xeb, 0x47, 0x70, 0x73, 0x1e, 0x2c, 0xdc, 0xf7, 0x92, 0xee,
+	0xa5, 0x2c, 0xf7, 0xbf, 0x2c, 0xe1, 0xbd, 0x0f, 0x25, 0x94, 0x7b, 0xee, 0xf8, 0xa1, 0x47, 0x9d,
+	0x0e, 0x3b, 0x0c, 0x9d, 0xee, 0xa1, 0xcd, 0xcf, 0xe2, 0x0c, 0x45, 0xc8, 0x33, 0x97, 0x77, 0x7a,
+	0xa8, 0xba, 0xda, 0x8c, 0x3e, 0xf4, 0x63, 0xd0, 0x64, 0x34, 0x91, 0xe6, 0x09, 0x2c, 0x9d, 0xd8,
+	0xfc, 0x4c, 0x84, 0xa9, 0x64, 0x85, 0x49, 0x93, 0x91, 0x52, 0xff, 0x50, 0x80, 0x3c, 0xaa, 0x93,
+	0x77, 0x0a, 0x14, 0xa2, 0x6a, 0x90, 0x7b, 0x59, 0x0a, 0xe9, 0x16, 0xaa, 0x5b, 0xff, 0xc4, 0x45,
+	0x01, 0xf5, 0xca, 0xfb, 0xef, 0xbf, 0x3f, 0x5f, 0x29, 0x93, 0x92, 0x99, 0x68, 0xfb, 0xdb, 0x87,
+	0x89, 0x8b, 0x20, 0x5f, 0x15, 0x58, 0x4d, 0xd4, 0x84, 0xd4, 0xa4, 0x0e, 0x59, 0x45, 0x55, 0x8d,
+	0x45, 0xe1, 0x22, 0x57, 0x0d, 0x73, 0x6d, 0x91, 0x8a, 0x24, 0x17, 0x3e, 0x5b, 0x71, 0x55, 0xc9,
+	0x17, 0x05, 0x56, 0x66, 0x3a, 0x45, 0xaa, 0x52, 0xbb, 0x74, 0x99, 0xd5, 0xdd, 0xc5, 0xc0, 0x22,
+	0x59, 0x15, 0x93, 0x55, 0xc8, 0xa6, 0x24, 0x99, 0x85, 0x9c, 0x16, 0x7e, 0x90, 0x8f, 0x0a, 0x2c,
+	0x4f, 0xdb, 0x46, 0xb6, 0xa5, 0x46, 0xf3, 0xa5, 0x57, 0x77, 0x16, 0x81, 0x8a, 0x44, 0xdb, 0x98,
+	0x68, 0x93, 0x6c, 0x48, 0x12, 0x4d, 0x2e, 0x46, 0xe4, 0xf9, 0xa6, 0xc0, 0xb5, 0x54, 0xe1, 0xc8,
+	0x03, 0xa9, 0x99, 0xec, 0x20, 0xd4, 0xfa, 0xff, 0x50, 0x44, 0xce, 0x3a, 0xe6, 0xdc, 0x25, 0x3b,
+	0x92, 0x9c, 0xfd, 0x98, 0xd9, 0x3a, 0x09, 0x9d, 0x6e, 0x6b, 0x72, 0x05, 0x07, 0x8d, 0x8b, 0x91,
+	0xa6, 0x5c, 0x8e, 0x34, 0xe5, 0xd7, 0x48, 0x53, 0x3e, 0x8d, 0xb5, 0xdc, 0xe5, 0x58, 0xcb, 0xfd,
+	0x18, 0x6b, 0xb9, 0x37, 0x8f, 0xac, 0x7e, 0xd0, 0x0b, 0xdb, 0x46, 0x87, 0x0f, 0xe6, 0xf5, 0x6a,
+	0x9d, 0x1e, 0xed, 0x3b, 0xe6, 0x74, 0x72, 0x2e, 0x0c, 0x82, 0xa1, 0xcb, 0xfc, 0x76, 0x01, 0xe7,
+	0x7b, 0x7f, 0x06, 0x00, 0x69, 0x57, 0x1e, 0x35, 0x69, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -435,6 +534,8 @@ type QueryClient interface {
 	GlobalStats(ctx context.Context, in *QueryGlobalStatsRequest, opts ...grpc.CallOption) (*QueryGlobalStatsResponse, error)
 	// Queries UserStats.
 	UserStats(ctx context.Context, in *QueryUserStatsRequest, opts ...grpc.CallOption) (*QueryUserStatsResponse, error)
+	// Queries the InsuranceFundFlow of an epoch.
+	InsuranceFundFlow(ctx context.Context, in *QueryInsuranceFundFlowRequest, opts ...grpc.CallOption) (*QueryInsuranceFundFlowResponse, error)
 }
 
 type queryClient struct {
@@ -481,6 +582,15 @@ func (c *queryClient) UserStats(ctx context.Context, in *QueryUserStatsRequest,
 	return out, nil
 }
 
+func (c *queryClient) InsuranceFundFlow(ctx context.Context, in *QueryInsuranceFundFlowRequest, opts ...grpc.CallOption) (*QueryInsuranceFundFlowResponse, error) {
+	out := new(QueryInsuranceFundFlowResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.stats.Query/InsuranceFundFlow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the Params.
@@ -491,6 +601,8 @@ type QueryServer interface {
 	GlobalStats(context.Context, *QueryGlobalStatsRequest) (*QueryGlobalStatsResponse, error)
 	// Queries UserStats.
 	UserStats(context.Context, *QueryUserStatsRequest) (*QueryUserStatsResponse, error)
+	// Queries the InsuranceFundFlow of an epoch.
+	InsuranceFundFlow(context.Context, *QueryInsuranceFundFlowRequest) (*QueryInsuranceFundFlowResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +621,9 @@ func (*UnimplementedQueryServer) GlobalStats(ctx context.Context, req *QueryGlob
 func (*UnimplementedQueryServer) UserStats(ctx context.Context, req *QueryUserStatsRequest) (*QueryUserStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserStats not implemented")
 }
+func (*UnimplementedQueryServer) InsuranceFundFlow(ctx context.Context, req *QueryInsuranceFundFlowRequest) (*QueryInsuranceFundFlowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundFlow not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -586,6 +701,24 @@ func _Query_UserStats_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InsuranceFundFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.stats.Query/InsuranceFundFlow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundFlow(ctx, req.(*QueryInsuranceFundFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.stats.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -606,6 +739,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserStats",
 			Handler:    _Query_UserStats_Handler,
 		},
+		{
+			MethodName: "InsuranceFundFlow",
+			Handler:    _Query_InsuranceFundFlow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/stats/query.proto",
@@ -848,6 +985,69 @@ func (m *QueryUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInsuranceFundFlowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundFlowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundFlowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundFlowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundFlowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundFlowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Flow != nil {
+		{
+			size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -949,6 +1149,31 @@ func (m *QueryUserStatsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInsuranceFundFlowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundFlowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flow != nil {
+		l = m.Flow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1528,6 +1753,161 @@ func (m *QueryUserStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInsuranceFundFlowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundFlowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundFlowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundFlowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flow == nil {
+				m.Flow = &InsuranceFundFlow{}
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
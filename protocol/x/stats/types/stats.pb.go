@@ -31,6 +31,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type BlockStats struct {
 	// The fills that occured on this block.
 	Fills []*BlockStats_Fill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills,omitempty"`
+	// The insurance fund flows that occured on this block.
+	InsuranceFundFlow *InsuranceFundFlow `protobuf:"bytes,2,opt,name=insurance_fund_flow,json=insuranceFundFlow,proto3" json:"insurance_fund_flow,omitempty"`
 }
 
 func (m *BlockStats) Reset()         { *m = BlockStats{} }
@@ -73,6 +75,13 @@ func (m *BlockStats) GetFills() []*BlockStats_Fill {
 	return nil
 }
 
+func (m *BlockStats) GetInsuranceFundFlow() *InsuranceFundFlow {
+	if m != nil {
+		return m.InsuranceFundFlow
+	}
+	return nil
+}
+
 // Fill records data about a fill on this block.
 type BlockStats_Fill struct {
 	// Taker wallet address
@@ -81,6 +90,9 @@ type BlockStats_Fill struct {
 	Maker string `protobuf:"bytes,2,opt,name=maker,proto3" json:"maker,omitempty"`
 	// Notional USDC filled in quantums
 	Notional uint64 `protobuf:"varint,3,opt,name=notional,proto3" json:"notional,omitempty"`
+	// Whether the taker of the fill is a liquidation. Liquidation fills
+	// contribute to taker notional but not to maker notional.
+	IsLiquidation bool `protobuf:"varint,4,opt,name=is_liquidation,json=isLiquidation,proto3" json:"is_liquidation,omitempty"`
 }
 
 func (m *BlockStats_Fill) Reset()         { *m = BlockStats_Fill{} }
@@ -137,6 +149,13 @@ func (m *BlockStats_Fill) GetNotional() uint64 {
 	return 0
 }
 
+func (m *BlockStats_Fill) GetIsLiquidation() bool {
+	if m != nil {
+		return m.IsLiquidation
+	}
+	return false
+}
+
 // StatsMetadata stores metadata for the x/stats module
 type StatsMetadata struct {
 	// The oldest epoch that is included in the stats. The next epoch to be
@@ -190,6 +209,8 @@ type EpochStats struct {
 	EpochEndTime time.Time `protobuf:"bytes,1,opt,name=epoch_end_time,json=epochEndTime,proto3,stdtime" json:"epoch_end_time"`
 	// Stats for each user in this epoch. Sorted by user.
 	Stats []*EpochStats_UserWithStats `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Number of fills in this epoch
+	FillCount uint64 `protobuf:"varint,3,opt,name=fill_count,json=fillCount,proto3" json:"fill_count,omitempty"`
 }
 
 func (m *EpochStats) Reset()         { *m = EpochStats{} }
@@ -239,6 +260,13 @@ func (m *EpochStats) GetStats() []*EpochStats_UserWithStats {
 	return nil
 }
 
+func (m *EpochStats) GetFillCount() uint64 {
+	if m != nil {
+		return m.FillCount
+	}
+	return 0
+}
+
 // A user and its associated stats
 type EpochStats_UserWithStats struct {
 	User  string     `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -296,6 +324,10 @@ func (m *EpochStats_UserWithStats) GetStats() *UserStats {
 type GlobalStats struct {
 	// Notional USDC traded in quantums
 	NotionalTraded uint64 `protobuf:"varint,1,opt,name=notional_traded,json=notionalTraded,proto3" json:"notional_traded,omitempty"`
+	// Maker notional USDC traded in quantums
+	MakerNotional uint64 `protobuf:"varint,2,opt,name=maker_notional,json=makerNotional,proto3" json:"maker_notional,omitempty"`
+	// Number of fills
+	FillCount uint64 `protobuf:"varint,3,opt,name=fill_count,json=fillCount,proto3" json:"fill_count,omitempty"`
 }
 
 func (m *GlobalStats) Reset()         { *m = GlobalStats{} }
@@ -338,6 +370,20 @@ func (m *GlobalStats) GetNotionalTraded() uint64 {
 	return 0
 }
 
+func (m *GlobalStats) GetMakerNotional() uint64 {
+	if m != nil {
+		return m.MakerNotional
+	}
+	return 0
+}
+
+func (m *GlobalStats) GetFillCount() uint64 {
+	if m != nil {
+		return m.FillCount
+	}
+	return 0
+}
+
 // UserStats stores stats for a User
 type UserStats struct {
 	// Taker USDC in quantums
@@ -393,6 +439,62 @@ func (m *UserStats) GetMakerNotional() uint64 {
 	return 0
 }
 
+// InsuranceFundFlow stores the insurance fund flows over a stats epoch
+type InsuranceFundFlow struct {
+	// USDC paid into the insurance fund by liquidations in quantums
+	Inflow uint64 `protobuf:"varint,1,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	// USDC paid out of the insurance fund to cover liquidation shortfalls in
+	// quantums
+	Outflow uint64 `protobuf:"varint,2,opt,name=outflow,proto3" json:"outflow,omitempty"`
+}
+
+func (m *InsuranceFundFlow) Reset()         { *m = InsuranceFundFlow{} }
+func (m *InsuranceFundFlow) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundFlow) ProtoMessage()    {}
+func (*InsuranceFundFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07475747e6dcccdc, []int{5}
+}
+func (m *InsuranceFundFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundFlow.Merge(m, src)
+}
+func (m *InsuranceFundFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundFlow proto.InternalMessageInfo
+
+func (m *InsuranceFundFlow) GetInflow() uint64 {
+	if m != nil {
+		return m.Inflow
+	}
+	return 0
+}
+
+func (m *InsuranceFundFlow) GetOutflow() uint64 {
+	if m != nil {
+		return m.Outflow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockStats)(nil), "dydxprotocol.stats.BlockStats")
 	proto.RegisterType((*BlockStats_Fill)(nil), "dydxprotocol.stats.BlockStats.Fill")
@@ -401,41 +503,49 @@ func init() {
 	proto.RegisterType((*EpochStats_UserWithStats)(nil), "dydxprotocol.stats.EpochStats.UserWithStats")
 	proto.RegisterType((*GlobalStats)(nil), "dydxprotocol.stats.GlobalStats")
 	proto.RegisterType((*UserStats)(nil), "dydxprotocol.stats.UserStats")
+	proto.RegisterType((*InsuranceFundFlow)(nil), "dydxprotocol.stats.InsuranceFundFlow")
 }
 
 func init() { proto.RegisterFile("dydxprotocol/stats/stats.proto", fileDescriptor_07475747e6dcccdc) }
 
 var fileDescriptor_07475747e6dcccdc = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
-	0x18, 0xc6, 0xa3, 0x34, 0x19, 0xed, 0x9b, 0x39, 0x03, 0xd1, 0x43, 0x30, 0xcc, 0x09, 0x1e, 0x63,
-	0x39, 0x6c, 0x32, 0xb4, 0xa3, 0x63, 0xd7, 0x40, 0x37, 0x18, 0xac, 0x30, 0xaf, 0x63, 0x7f, 0x2e,
-	0x46, 0xb1, 0x5d, 0x47, 0x54, 0xb6, 0x82, 0xad, 0x8c, 0xf6, 0x5b, 0xf4, 0xb6, 0xaf, 0xd4, 0x63,
-	0x8f, 0x3b, 0x6d, 0x23, 0xf9, 0x0e, 0x3b, 0x0f, 0xbd, 0xaa, 0x9d, 0x84, 0xe5, 0x62, 0xf4, 0xfe,
-	0xf4, 0xbc, 0xef, 0x63, 0x3d, 0xb2, 0xc1, 0x4b, 0xae, 0x93, 0xab, 0x79, 0xa9, 0xb4, 0x8a, 0x95,
-	0x0c, 0x2a, 0xcd, 0x75, 0x65, 0x9f, 0x0c, 0x21, 0xa5, 0x9b, 0xfb, 0x0c, 0x77, 0xdc, 0xc3, 0x4c,
-	0x65, 0x0a, 0x59, 0x60, 0x56, 0x56, 0xe9, 0x0e, 0x33, 0xa5, 0x32, 0x99, 0x06, 0x58, 0x4d, 0x17,
-	0x17, 0x81, 0x16, 0x79, 0x5a, 0x69, 0x9e, 0xcf, 0xad, 0xc0, 0xff, 0x41, 0x00, 0x26, 0x52, 0xc5,
-	0x97, 0x1f, 0xcd, 0x14, 0xfa, 0x1a, 0xba, 0x17, 0x42, 0xca, 0x6a, 0x40, 0x46, 0x7b, 0xe3, 0xde,
-	0xd1, 0x13, 0xf6, 0xbf, 0x13, 0x5b, 0xcb, 0xd9, 0x1b, 0x21, 0x65, 0x68, 0x3b, 0xdc, 0x33, 0xe8,
-	0x98, 0x92, 0x1e, 0x42, 0x57, 0xf3, 0xcb, 0xb4, 0x1c, 0x90, 0x11, 0x19, 0x1f, 0x84, 0xb6, 0x30,
-	0x34, 0x47, 0xda, 0xb6, 0x14, 0x0b, 0xea, 0xc2, 0x7e, 0xa1, 0xb4, 0x50, 0x05, 0x97, 0x83, 0xbd,
-	0x11, 0x19, 0x77, 0xc2, 0xa6, 0xf6, 0x4f, 0xc0, 0x41, 0x93, 0xf7, 0xa9, 0xe6, 0x09, 0xd7, 0x9c,
-	0x3e, 0x85, 0xbe, 0x2e, 0xb9, 0x90, 0xa2, 0xc8, 0xa2, 0x74, 0xae, 0xe2, 0x19, 0x3a, 0x38, 0xa1,
-	0x53, 0xd3, 0x53, 0x03, 0xfd, 0xbf, 0x04, 0x00, 0x57, 0xf6, 0x44, 0xef, 0xa0, 0x8f, 0xe2, 0x28,
-	0x2d, 0x92, 0xc8, 0x9c, 0x1e, 0xbb, 0x7a, 0x47, 0x2e, 0xb3, 0xd1, 0xb0, 0x3a, 0x1a, 0x76, 0x5e,
-	0x47, 0x33, 0xd9, 0xbf, 0xfd, 0x35, 0x6c, 0xdd, 0xfc, 0x1e, 0x92, 0xf0, 0x21, 0xf6, 0x9e, 0x16,
-	0x89, 0xd9, 0xa4, 0x13, 0xe8, 0x62, 0x04, 0x83, 0x36, 0xa6, 0xf3, 0x7c, 0x57, 0x3a, 0x6b, 0x6b,
-	0xf6, 0xa9, 0x4a, 0xcb, 0xcf, 0x42, 0xdb, 0x2a, 0xb4, 0xad, 0xee, 0x17, 0x70, 0xb6, 0x38, 0xa5,
-	0xd0, 0x59, 0x54, 0x4d, 0x5c, 0xb8, 0xa6, 0xc7, 0x6b, 0x23, 0xf3, 0xae, 0x8f, 0x77, 0x19, 0x99,
-	0x29, 0x9b, 0x93, 0xfd, 0x13, 0xe8, 0xbd, 0x95, 0x6a, 0xca, 0xa5, 0x9d, 0xfb, 0x0c, 0x1e, 0xd5,
-	0x59, 0x46, 0xba, 0xe4, 0x49, 0x9a, 0xa0, 0x45, 0x27, 0xec, 0xd7, 0xf8, 0x1c, 0xa9, 0xff, 0x15,
-	0x0e, 0x9a, 0x59, 0x18, 0xb2, 0xb9, 0x9a, 0xa8, 0xb9, 0x17, 0xdb, 0xe4, 0x20, 0x3d, 0xbb, 0x87,
-	0x46, 0x96, 0x6f, 0xcb, 0xda, 0x56, 0x96, 0x6f, 0xca, 0x26, 0x1f, 0x6e, 0x97, 0x1e, 0xb9, 0x5b,
-	0x7a, 0xe4, 0xcf, 0xd2, 0x23, 0x37, 0x2b, 0xaf, 0x75, 0xb7, 0xf2, 0x5a, 0x3f, 0x57, 0x5e, 0xeb,
-	0xdb, 0xab, 0x4c, 0xe8, 0xd9, 0x62, 0xca, 0x62, 0x95, 0x07, 0x5b, 0x5f, 0xfb, 0xf7, 0x97, 0x2f,
-	0xe2, 0x19, 0x17, 0x45, 0xd0, 0x90, 0xab, 0xfb, 0x3f, 0x40, 0x5f, 0xcf, 0xd3, 0x6a, 0xfa, 0x00,
-	0xf9, 0xf1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0x20, 0xac, 0x35, 0x24, 0x03, 0x00, 0x00,
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x2e, 0x1b, 0x9b, 0x4b, 0x86, 0x66, 0x26, 0x14, 0x45, 0x5a, 0x56, 0x15, 0x4d,
+	0xf4, 0x00, 0x89, 0xd4, 0x21, 0x10, 0xd7, 0xa2, 0x16, 0x81, 0x00, 0x89, 0xb0, 0x89, 0x97, 0x4b,
+	0xe4, 0x26, 0x69, 0x6a, 0xcd, 0xb5, 0x4b, 0xe2, 0xb0, 0x4d, 0xe2, 0x43, 0xec, 0xc2, 0x77, 0xda,
+	0x71, 0x47, 0x4e, 0x80, 0xda, 0x2f, 0x82, 0xfc, 0xb8, 0x49, 0x57, 0x56, 0x21, 0x2e, 0x95, 0x9f,
+	0x9f, 0x9f, 0x17, 0xfb, 0xff, 0x4f, 0x8d, 0xdc, 0xf8, 0x3c, 0x3e, 0x9b, 0x64, 0x42, 0x8a, 0x48,
+	0x30, 0x3f, 0x97, 0x44, 0xe6, 0xfa, 0xd7, 0x03, 0x88, 0xf1, 0xf5, 0x7d, 0x0f, 0x76, 0x9c, 0xdd,
+	0x54, 0xa4, 0x02, 0x98, 0xaf, 0x56, 0x3a, 0xd3, 0xd9, 0x4f, 0x85, 0x48, 0x59, 0xe2, 0x43, 0x34,
+	0x28, 0x86, 0xbe, 0xa4, 0xe3, 0x24, 0x97, 0x64, 0x3c, 0xd1, 0x09, 0xad, 0xef, 0x75, 0x84, 0xba,
+	0x4c, 0x44, 0x27, 0xef, 0x55, 0x17, 0xfc, 0x0c, 0xad, 0x0f, 0x29, 0x63, 0xb9, 0x6d, 0x34, 0xd7,
+	0xda, 0x8d, 0xce, 0x7d, 0xef, 0xe6, 0x24, 0x6f, 0x91, 0xee, 0xf5, 0x29, 0x63, 0x81, 0xae, 0xc0,
+	0xc7, 0xe8, 0x2e, 0xe5, 0x79, 0x91, 0x11, 0x1e, 0x25, 0xe1, 0xb0, 0xe0, 0x71, 0x38, 0x64, 0xe2,
+	0xd4, 0xae, 0x37, 0x8d, 0x76, 0xa3, 0x73, 0xb0, 0xaa, 0xd1, 0xcb, 0x32, 0xbd, 0x5f, 0xf0, 0xb8,
+	0xcf, 0xc4, 0x69, 0xb0, 0x43, 0xff, 0x46, 0x4e, 0x81, 0x4c, 0x35, 0x05, 0xef, 0xa2, 0x75, 0x49,
+	0x4e, 0x92, 0xcc, 0x36, 0x9a, 0x46, 0x7b, 0x2b, 0xd0, 0x81, 0xa2, 0x63, 0xa0, 0x75, 0x4d, 0x21,
+	0xc0, 0x0e, 0xda, 0xe4, 0x42, 0x52, 0xc1, 0x09, 0xb3, 0xd7, 0x9a, 0x46, 0xdb, 0x0c, 0xaa, 0x18,
+	0x1f, 0xa0, 0x6d, 0x9a, 0x87, 0x8c, 0x7e, 0x29, 0x68, 0x4c, 0x14, 0xb3, 0xcd, 0xa6, 0xd1, 0xde,
+	0x0c, 0x2c, 0x9a, 0xbf, 0x5e, 0xc0, 0xd6, 0x13, 0x64, 0xc1, 0x15, 0xdf, 0x24, 0x92, 0xc4, 0x44,
+	0x12, 0x55, 0x27, 0x33, 0x42, 0x19, 0xe5, 0x69, 0x98, 0x4c, 0x44, 0x34, 0x82, 0x83, 0x58, 0x81,
+	0x55, 0xd2, 0x9e, 0x82, 0xa0, 0x27, 0xac, 0xb4, 0x9e, 0xaf, 0xd0, 0x36, 0x24, 0x87, 0x09, 0x8f,
+	0x43, 0xa5, 0x3d, 0x54, 0x35, 0x3a, 0x8e, 0xa7, 0x8d, 0xf1, 0x4a, 0x63, 0xbc, 0xa3, 0xd2, 0x98,
+	0xee, 0xe6, 0xe5, 0xcf, 0xfd, 0xda, 0xc5, 0xaf, 0x7d, 0x23, 0xb8, 0x0d, 0xb5, 0x3d, 0x1e, 0xab,
+	0x4d, 0xdc, 0x45, 0xeb, 0xa0, 0x9b, 0x5d, 0x07, 0x6f, 0x1e, 0xae, 0x92, 0x74, 0x31, 0xda, 0x3b,
+	0xce, 0x93, 0xec, 0x03, 0x95, 0x3a, 0x0a, 0x74, 0x29, 0xde, 0x43, 0x48, 0xb9, 0x15, 0x46, 0xa2,
+	0xe0, 0x72, 0xae, 0xcd, 0x96, 0x22, 0xcf, 0x15, 0x70, 0x3e, 0x22, 0x6b, 0xa9, 0x0c, 0x63, 0x64,
+	0x16, 0x79, 0x25, 0x3a, 0xac, 0xf1, 0xe1, 0xe2, 0x1c, 0xea, 0x2a, 0x7b, 0xab, 0xce, 0xa1, 0xba,
+	0x5c, 0x1f, 0xdc, 0xfa, 0x86, 0x1a, 0x2f, 0x98, 0x18, 0x10, 0xa6, 0xfb, 0x3e, 0x40, 0x77, 0x4a,
+	0x47, 0x42, 0x99, 0x91, 0x38, 0x89, 0x61, 0x84, 0x19, 0x6c, 0x97, 0xf8, 0x08, 0xa8, 0x92, 0x1d,
+	0x3c, 0x0d, 0x2b, 0x43, 0xeb, 0x90, 0x67, 0x01, 0x7d, 0x5b, 0xba, 0xfa, 0xef, 0x7b, 0xb5, 0x3e,
+	0xa1, 0xad, 0xea, 0x44, 0xe0, 0xe4, 0x72, 0x4b, 0x3d, 0xda, 0x92, 0x4b, 0x2d, 0xff, 0x6f, 0x72,
+	0xab, 0x87, 0x76, 0x6e, 0x7c, 0xc7, 0xf8, 0x1e, 0xda, 0xa0, 0x1c, 0x3e, 0x7f, 0xdd, 0x7a, 0x1e,
+	0x61, 0x1b, 0xdd, 0x12, 0x85, 0xac, 0xfe, 0x17, 0x66, 0x50, 0x86, 0xdd, 0x77, 0x97, 0x53, 0xd7,
+	0xb8, 0x9a, 0xba, 0xc6, 0xef, 0xa9, 0x6b, 0x5c, 0xcc, 0xdc, 0xda, 0xd5, 0xcc, 0xad, 0xfd, 0x98,
+	0xb9, 0xb5, 0xcf, 0x4f, 0x53, 0x2a, 0x47, 0xc5, 0xc0, 0x8b, 0xc4, 0xd8, 0x5f, 0x7a, 0x17, 0xbe,
+	0x3e, 0x7e, 0x14, 0x8d, 0x08, 0xe5, 0x7e, 0x45, 0xce, 0xe6, 0x6f, 0x85, 0x3c, 0x9f, 0x24, 0xf9,
+	0x60, 0x03, 0xf8, 0xe1, 0x9f, 0x01, 0x00, 0x6d, 0xe4, 0x30, 0x05, 0x4e, 0x04, 0x00, 0x00,
 }
 
 func (m *BlockStats) Marshal() (dAtA []byte, err error) {
@@ -458,6 +568,18 @@ func (m *BlockStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InsuranceFundFlow != nil {
+		{
+			size, err := m.InsuranceFundFlow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStats(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Fills) > 0 {
 		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -495,6 +617,16 @@ func (m *BlockStats_Fill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsLiquidation {
+		i--
+		if m.IsLiquidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Notional != 0 {
 		i = encodeVarintStats(dAtA, i, uint64(m.Notional))
 		i--
@@ -565,6 +697,11 @@ func (m *EpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FillCount != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.FillCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Stats) > 0 {
 		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -579,12 +716,12 @@ func (m *EpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochEndTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochEndTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintStats(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintStats(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -652,6 +789,16 @@ func (m *GlobalStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FillCount != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.FillCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MakerNotional != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.MakerNotional))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.NotionalTraded != 0 {
 		i = encodeVarintStats(dAtA, i, uint64(m.NotionalTraded))
 		i--
@@ -693,6 +840,39 @@ func (m *UserStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InsuranceFundFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outflow != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Outflow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Inflow != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Inflow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStats(v)
 	base := offset
@@ -716,6 +896,10 @@ func (m *BlockStats) Size() (n int) {
 			n += 1 + l + sovStats(uint64(l))
 		}
 	}
+	if m.InsuranceFundFlow != nil {
+		l = m.InsuranceFundFlow.Size()
+		n += 1 + l + sovStats(uint64(l))
+	}
 	return n
 }
 
@@ -736,6 +920,9 @@ func (m *BlockStats_Fill) Size() (n int) {
 	if m.Notional != 0 {
 		n += 1 + sovStats(uint64(m.Notional))
 	}
+	if m.IsLiquidation {
+		n += 2
+	}
 	return n
 }
 
@@ -765,6 +952,9 @@ func (m *EpochStats) Size() (n int) {
 			n += 1 + l + sovStats(uint64(l))
 		}
 	}
+	if m.FillCount != 0 {
+		n += 1 + sovStats(uint64(m.FillCount))
+	}
 	return n
 }
 
@@ -794,6 +984,12 @@ func (m *GlobalStats) Size() (n int) {
 	if m.NotionalTraded != 0 {
 		n += 1 + sovStats(uint64(m.NotionalTraded))
 	}
+	if m.MakerNotional != 0 {
+		n += 1 + sovStats(uint64(m.MakerNotional))
+	}
+	if m.FillCount != 0 {
+		n += 1 + sovStats(uint64(m.FillCount))
+	}
 	return n
 }
 
@@ -812,6 +1008,21 @@ func (m *UserStats) Size() (n int) {
 	return n
 }
 
+func (m *InsuranceFundFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inflow != 0 {
+		n += 1 + sovStats(uint64(m.Inflow))
+	}
+	if m.Outflow != 0 {
+		n += 1 + sovStats(uint64(m.Outflow))
+	}
+	return n
+}
+
 func sovStats(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -881,6 +1092,42 @@ func (m *BlockStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundFlow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InsuranceFundFlow == nil {
+				m.InsuranceFundFlow = &InsuranceFundFlow{}
+			}
+			if err := m.InsuranceFundFlow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStats(dAtA[iNdEx:])
@@ -1014,6 +1261,26 @@ func (m *BlockStats_Fill) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLiquidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLiquidation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStats(dAtA[iNdEx:])
@@ -1200,6 +1467,25 @@ func (m *EpochStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillCount", wireType)
+			}
+			m.FillCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStats(dAtA[iNdEx:])
@@ -1387,6 +1673,44 @@ func (m *GlobalStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerNotional", wireType)
+			}
+			m.MakerNotional = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerNotional |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillCount", wireType)
+			}
+			m.FillCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStats(dAtA[iNdEx:])
@@ -1496,6 +1820,94 @@ func (m *UserStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InsuranceFundFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			m.Inflow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inflow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			m.Outflow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outflow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStats(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
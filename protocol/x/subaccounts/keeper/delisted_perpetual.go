@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// checkDelistedPerpetualConstraints will validate all `updates` to the relevant subaccounts against
+// the constraints for perpetuals whose market has been delisted.
+// The input subaccounts must be settled.
+//
+// Returns a `success` value of `true` if all updates are valid.
+// Returns a `successPerUpdates` value, which is a slice of `UpdateResult`.
+// These map to the updates and are used to indicate which of the updates
+// caused a failure, if any.
+func (k Keeper) checkDelistedPerpetualConstraints(
+	ctx sdk.Context,
+	settledUpdates []types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+) (
+	success bool,
+	successPerUpdate []types.UpdateResult,
+) {
+	success = true
+	successPerUpdate = make([]types.UpdateResult, len(settledUpdates))
+
+	for i, u := range settledUpdates {
+		result := isValidDelistedPerpetualUpdates(u, perpInfos)
+		if result != types.Success {
+			success = false
+		}
+
+		successPerUpdate[i] = result
+	}
+
+	return success, successPerUpdate
+}
+
+// Checks whether the perpetual updates to a settled subaccount violate constraints for delisted
+// perpetuals. The constraint being checked is:
+//   - a position in a perpetual whose market has been delisted can only be reduced, i.e. it cannot
+//     be increased, opened, or flipped to the opposite side
+func isValidDelistedPerpetualUpdates(
+	settledUpdate types.SettledUpdate,
+	perpInfos perptypes.PerpInfos,
+) types.UpdateResult {
+	for _, perpetualUpdate := range settledUpdate.PerpetualUpdates {
+		perpInfo := perpInfos.MustGet(perpetualUpdate.PerpetualId)
+		if !perpInfo.Perpetual.IsMarketDelisted() {
+			continue
+		}
+
+		currentQuantums := big.NewInt(0)
+		if position, exists := settledUpdate.SettledSubaccount.GetPerpetualPositionForId(
+			perpetualUpdate.PerpetualId,
+		); exists {
+			currentQuantums = position.GetBigQuantums()
+		}
+		newQuantums := new(big.Int).Add(currentQuantums, perpetualUpdate.GetBigQuantums())
+
+		if newQuantums.Sign() != 0 &&
+			(newQuantums.Sign() != currentQuantums.Sign() || newQuantums.CmpAbs(currentQuantums) > 0) {
+			return types.ViolatesDelistedPerpetualConstraints
+		}
+	}
+
+	return types.Success
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanUpdateSubaccounts_DelistedPerpetual(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		positionQuantums int64
+		deltaQuantums    int64
+		delisted         bool
+
+		// Expectations.
+		expectedResult types.UpdateResult
+	}{
+		"Reducing a long position in a delisted perpetual succeeds": {
+			positionQuantums: 100_000_000,
+			deltaQuantums:    -50_000_000,
+			delisted:         true,
+			expectedResult:   types.Success,
+		},
+		"Closing a short position in a delisted perpetual succeeds": {
+			positionQuantums: -100_000_000,
+			deltaQuantums:    100_000_000,
+			delisted:         true,
+			expectedResult:   types.Success,
+		},
+		"Increasing a long position in a delisted perpetual fails": {
+			positionQuantums: 100_000_000,
+			deltaQuantums:    10_000_000,
+			delisted:         true,
+			expectedResult:   types.ViolatesDelistedPerpetualConstraints,
+		},
+		"Flipping a short position in a delisted perpetual fails": {
+			positionQuantums: -100_000_000,
+			deltaQuantums:    110_000_000,
+			delisted:         true,
+			expectedResult:   types.ViolatesDelistedPerpetualConstraints,
+		},
+		"Opening a position in a delisted perpetual fails": {
+			deltaQuantums:  10_000_000,
+			delisted:       true,
+			expectedResult: types.ViolatesDelistedPerpetualConstraints,
+		},
+		"Increasing a long position in a listed perpetual succeeds": {
+			positionQuantums: 100_000_000,
+			deltaQuantums:    10_000_000,
+			expectedResult:   types.Success,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, assetsKeeper, _, _, _ := keepertest.SubaccountsKeepers(
+				t,
+				true,
+			)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+			require.NoError(t, keepertest.CreateUsdcAsset(ctx, assetsKeeper))
+
+			subaccount := types.Subaccount{
+				Id:             &constants.Alice_Num0,
+				AssetPositions: testutil.CreateUsdcAssetPositions(big.NewInt(1_000_000_000_000)),
+			}
+			if tc.positionQuantums != 0 {
+				subaccount.PerpetualPositions = []*types.PerpetualPosition{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(tc.positionQuantums),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+				}
+			}
+			keeper.SetSubaccount(ctx, subaccount)
+
+			if tc.delisted {
+				_, err := perpetualsKeeper.DelistPerpetualMarket(ctx, 0)
+				require.NoError(t, err)
+			}
+
+			success, successPerUpdate, err := keeper.CanUpdateSubaccounts(
+				ctx,
+				[]types.Update{
+					{
+						SubaccountId: constants.Alice_Num0,
+						PerpetualUpdates: []types.PerpetualUpdate{
+							{
+								PerpetualId:      0,
+								BigQuantumsDelta: big.NewInt(tc.deltaQuantums),
+							},
+						},
+					},
+				},
+				types.CollatCheck,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedResult.IsSuccess(), success)
+			require.Equal(t, []types.UpdateResult{tc.expectedResult}, successPerUpdate)
+		})
+	}
+}
@@ -61,3 +61,26 @@ func (k Keeper) Subaccount(
 
 	return &types.QuerySubaccountResponse{Subaccount: val}, nil
 }
+
+func (k Keeper) SubaccountPositions(
+	c context.Context,
+	req *types.QuerySubaccountPositionsRequest,
+) (*types.QuerySubaccountPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := lib.UnwrapSDKContext(c, types.ModuleName)
+
+	positions, err := k.GetPerpetualPositionInfos(
+		ctx,
+		types.SubaccountId{
+			Owner:  req.Owner,
+			Number: req.Number,
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubaccountPositionsResponse{Positions: positions}, nil
+}
@@ -10,8 +10,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
 	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
 	"github.com/dydxprotocol/v4-chain/protocol/testutil/nullify"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
 	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
 )
 
@@ -143,3 +147,96 @@ func TestSubaccountQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestSubaccountPositions(t *testing.T) {
+	ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+	keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+	keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+	for _, p := range []perptypes.Perpetual{
+		constants.BtcUsd_SmallMarginRequirement,
+		constants.EthUsd_NoMarginRequirement,
+	} {
+		_, err := perpetualsKeeper.CreatePerpetual(
+			ctx,
+			p.Params.Id,
+			p.Params.Ticker,
+			p.Params.MarketId,
+			p.Params.AtomicResolution,
+			p.Params.DefaultFundingPpm,
+			p.Params.LiquidityTier,
+			p.Params.MarketType,
+		)
+		require.NoError(t, err)
+	}
+
+	// Set the funding indices of the perpetuals.
+	for perpetualId, fundingIndex := range map[uint32]int64{0: 7, 1: 3} {
+		perpetual, err := perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+		require.NoError(t, err)
+		perpetual.FundingIndex = dtypes.NewInt(fundingIndex)
+		perpetualsKeeper.SetPerpetualForTest(ctx, perpetual)
+	}
+
+	subaccount := createNSubaccount(keeper, ctx, 1, big.NewInt(1_000))[0]
+	subaccount.PerpetualPositions = []*types.PerpetualPosition{
+		// 1 BTC long, last settled at a funding index of 2.
+		testutil.CreateSinglePerpetualPosition(
+			0,
+			big.NewInt(100_000_000),
+			big.NewInt(2),
+			big.NewInt(0),
+		),
+		// 0.1 ETH short, last settled at a funding index of 0.
+		testutil.CreateSinglePerpetualPosition(
+			1,
+			big.NewInt(-100_000_000),
+			big.NewInt(0),
+			big.NewInt(0),
+		),
+	}
+	keeper.SetSubaccount(ctx, subaccount)
+
+	response, err := keeper.SubaccountPositions(ctx, &types.QuerySubaccountPositionsRequest{
+		Owner:  subaccount.Id.Owner,
+		Number: subaccount.Id.Number,
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&types.QuerySubaccountPositionsResponse{
+			Positions: []types.PerpetualPositionInfo{
+				{
+					PerpetualId: 0,
+					Quantums:    dtypes.NewInt(100_000_000),
+					// 1 BTC * $50,000 = $50,000.
+					NetNotional: dtypes.NewInt(50_000_000_000),
+					// The long pays `(7 - 2) * 100_000_000 / 1_000_000 = 500` quote quantums.
+					PendingFunding: dtypes.NewInt(500),
+				},
+				{
+					PerpetualId: 1,
+					Quantums:    dtypes.NewInt(-100_000_000),
+					// -0.1 ETH * $3,000 = -$300.
+					NetNotional: dtypes.NewInt(-300_000_000),
+					// The short receives `(3 - 0) * 100_000_000 / 1_000_000 = 300` quote quantums.
+					PendingFunding: dtypes.NewInt(-300),
+				},
+			},
+		},
+		response,
+	)
+
+	// Querying positions does not settle funding.
+	require.Equal(t, subaccount, keeper.GetSubaccount(ctx, *subaccount.Id))
+
+	// A subaccount without positions has no position infos.
+	response, err = keeper.SubaccountPositions(ctx, &types.QuerySubaccountPositionsRequest{
+		Owner:  constants.AliceAccAddress.String(),
+		Number: 100,
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Positions)
+
+	_, err = keeper.SubaccountPositions(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// RecomputeOpenInterest re-derives the open interest of a perpetual from the perpetual positions
+// of all subaccounts, and overwrites the open interest stored on the perpetual if it has drifted.
+//
+// Open interest is defined as the sum of the sizes of all long positions in the perpetual (which
+// is equal to the sum of the sizes of all short positions). Returns the recomputed open interest
+// and the drift, defined as `recomputed - stored`, that was corrected.
+func (k Keeper) RecomputeOpenInterest(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	openInterest *big.Int,
+	drift *big.Int,
+	err error,
+) {
+	perpetual, err := k.perpetualsKeeper.GetPerpetual(ctx, perpetualId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	openInterest = big.NewInt(0)
+	k.ForEachSubaccount(ctx, func(subaccount types.Subaccount) (finished bool) {
+		for _, position := range subaccount.PerpetualPositions {
+			// Only record long positions. Total short position size should be equal
+			// to total long position size.
+			if position.PerpetualId == perpetualId && position.Quantums.Sign() > 0 {
+				openInterest.Add(openInterest, position.Quantums.BigInt())
+			}
+		}
+		return false
+	})
+
+	drift = new(big.Int).Sub(openInterest, perpetual.OpenInterest.BigInt())
+	if err := k.perpetualsKeeper.ModifyOpenInterest(ctx, perpetualId, drift); err != nil {
+		return nil, nil, err
+	}
+
+	return openInterest, drift, nil
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecomputeOpenInterest(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		perpetualId         uint32
+		storedOpenInterest  *big.Int
+		subaccountPositions [][]*types.PerpetualPosition
+
+		// Expectations.
+		expectedOpenInterest *big.Int
+		expectedDrift        *big.Int
+		expectedErr          error
+	}{
+		"No positions and no drift": {
+			perpetualId:          0,
+			storedOpenInterest:   big.NewInt(0),
+			expectedOpenInterest: big.NewInt(0),
+			expectedDrift:        big.NewInt(0),
+		},
+		"Stored open interest is correct": {
+			perpetualId:        0,
+			storedOpenInterest: big.NewInt(100_000_000),
+			subaccountPositions: [][]*types.PerpetualPosition{
+				{&constants.PerpetualPosition_OneBTCLong},
+				{&constants.PerpetualPosition_OneBTCShort},
+			},
+			expectedOpenInterest: big.NewInt(100_000_000),
+			expectedDrift:        big.NewInt(0),
+		},
+		"Stored open interest drifted below positions is corrected": {
+			perpetualId:        0,
+			storedOpenInterest: big.NewInt(40_000_000),
+			subaccountPositions: [][]*types.PerpetualPosition{
+				{&constants.PerpetualPosition_OneBTCLong},
+				{&constants.PerpetualPosition_OneTenthBTCLong},
+				{&constants.PerpetualPosition_OneAndHalfBTCLong},
+				{
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-260_000_000),
+						big.NewInt(0),
+						big.NewInt(0),
+					),
+					&constants.PerpetualPosition_OneTenthEthLong,
+				},
+			},
+			expectedOpenInterest: big.NewInt(260_000_000),
+			expectedDrift:        big.NewInt(220_000_000),
+		},
+		"Stored open interest drifted above positions is corrected": {
+			perpetualId:        1,
+			storedOpenInterest: big.NewInt(500_000_000),
+			subaccountPositions: [][]*types.PerpetualPosition{
+				{
+					&constants.PerpetualPosition_OneBTCLong,
+					&constants.PerpetualPosition_OneTenthEthLong,
+				},
+				{
+					&constants.PerpetualPosition_OneBTCShort,
+					&constants.PerpetualPosition_OneTenthEthShort,
+				},
+			},
+			expectedOpenInterest: big.NewInt(100_000_000),
+			expectedDrift:        big.NewInt(-400_000_000),
+		},
+		"Non-existent perpetual": {
+			perpetualId: 99,
+			expectedErr: perptypes.ErrPerpetualDoesNotExist,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			for _, p := range []perptypes.Perpetual{
+				constants.BtcUsd_SmallMarginRequirement,
+				constants.EthUsd_NoMarginRequirement,
+			} {
+				_, err := perpetualsKeeper.CreatePerpetual(
+					ctx,
+					p.Params.Id,
+					p.Params.Ticker,
+					p.Params.MarketId,
+					p.Params.AtomicResolution,
+					p.Params.DefaultFundingPpm,
+					p.Params.LiquidityTier,
+					p.Params.MarketType,
+				)
+				require.NoError(t, err)
+			}
+
+			subaccounts := createNSubaccount(keeper, ctx, len(tc.subaccountPositions), big.NewInt(1_000))
+			for i, positions := range tc.subaccountPositions {
+				subaccounts[i].PerpetualPositions = positions
+				keeper.SetSubaccount(ctx, subaccounts[i])
+			}
+
+			if tc.storedOpenInterest != nil {
+				// Deliberately drift the stored open interest.
+				perpetual, err := perpetualsKeeper.GetPerpetual(ctx, tc.perpetualId)
+				require.NoError(t, err)
+				perpetual.OpenInterest = dtypes.NewIntFromBigInt(tc.storedOpenInterest)
+				perpetualsKeeper.SetPerpetualForTest(ctx, perpetual)
+			}
+
+			openInterest, drift, err := keeper.RecomputeOpenInterest(ctx, tc.perpetualId)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Zero(t, tc.expectedOpenInterest.Cmp(openInterest))
+			require.Zero(t, tc.expectedDrift.Cmp(drift))
+
+			perpetual, err := perpetualsKeeper.GetPerpetual(ctx, tc.perpetualId)
+			require.NoError(t, err)
+			require.Zero(t, tc.expectedOpenInterest.Cmp(perpetual.OpenInterest.BigInt()))
+		})
+	}
+}
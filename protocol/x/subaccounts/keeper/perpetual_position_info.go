@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dydxprotocol/v4-chain/protocol/dtypes"
+	"github.com/dydxprotocol/v4-chain/protocol/lib"
+	perplib "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetPerpetualPositionInfos returns the perpetual positions of a subaccount, along with the net notional
+// of each position at the current oracle price and the funding payment that will be made when the position
+// is next settled. Pending funding is computed the same way as the funding payments emitted to the indexer
+// when the subaccount is settled. Does not modify state.
+func (k Keeper) GetPerpetualPositionInfos(
+	ctx sdk.Context,
+	subaccountId types.SubaccountId,
+) (
+	positionInfos []types.PerpetualPositionInfo,
+	err error,
+) {
+	perpInfos, err := k.GetAllRelevantPerpetuals(ctx, []types.Update{{SubaccountId: subaccountId}})
+	if err != nil {
+		return nil, err
+	}
+
+	subaccount := k.GetSubaccount(ctx, subaccountId)
+	positionInfos = make([]types.PerpetualPositionInfo, 0, len(subaccount.PerpetualPositions))
+	for _, position := range subaccount.PerpetualPositions {
+		perpInfo := perpInfos.MustGet(position.PerpetualId)
+		bigQuantums := position.GetBigQuantums()
+
+		bigNetNotional := perplib.GetNetNotionalInQuoteQuantums(
+			perpInfo.Perpetual,
+			perpInfo.Price,
+			bigQuantums,
+		)
+
+		// Funding payment is the negative of settlement.
+		bigNetSettlementPpm, _ := perplib.GetSettlementPpmWithPerpetual(
+			perpInfo.Perpetual,
+			bigQuantums,
+			position.FundingIndex.BigInt(),
+		)
+		bigPendingFunding := new(big.Int).Neg(
+			new(big.Int).Div(bigNetSettlementPpm, lib.BigIntOneMillion()),
+		)
+
+		positionInfos = append(positionInfos, types.PerpetualPositionInfo{
+			PerpetualId:    position.PerpetualId,
+			Quantums:       dtypes.NewIntFromBigInt(bigQuantums),
+			NetNotional:    dtypes.NewIntFromBigInt(bigNetNotional),
+			PendingFunding: dtypes.NewIntFromBigInt(bigPendingFunding),
+		})
+	}
+
+	return positionInfos, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	perplib "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/lib"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+)
+
+// GetAggregateUnrealizedPnl returns the signed sum of the unrealized PnL of all subaccount positions
+// in a perpetual, in quote quantums. The unrealized PnL of a position is its net notional at the
+// current oracle price plus its quote balance.
+//
+// Since every long position is offset by a short position, the aggregate should net to roughly zero
+// and a large deviation indicates an accounting issue. Does not modify state.
+func (k Keeper) GetAggregateUnrealizedPnl(
+	ctx sdk.Context,
+	perpetualId uint32,
+) (
+	aggregateUnrealizedPnl *big.Int,
+	err error,
+) {
+	perpetual, marketPrice, err := k.perpetualsKeeper.GetPerpetualAndMarketPrice(ctx, perpetualId)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateUnrealizedPnl = big.NewInt(0)
+	k.ForEachSubaccount(ctx, func(subaccount types.Subaccount) (finished bool) {
+		for _, position := range subaccount.PerpetualPositions {
+			if position.PerpetualId != perpetualId {
+				continue
+			}
+			aggregateUnrealizedPnl.Add(
+				aggregateUnrealizedPnl,
+				perplib.GetNetNotionalInQuoteQuantums(perpetual, marketPrice, position.GetBigQuantums()),
+			)
+			aggregateUnrealizedPnl.Add(aggregateUnrealizedPnl, position.GetQuoteBalance())
+		}
+		return false
+	})
+
+	return aggregateUnrealizedPnl, nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dydxprotocol/v4-chain/protocol/testutil/constants"
+	keepertest "github.com/dydxprotocol/v4-chain/protocol/testutil/keeper"
+	testutil "github.com/dydxprotocol/v4-chain/protocol/testutil/util"
+	perptypes "github.com/dydxprotocol/v4-chain/protocol/x/perpetuals/types"
+	"github.com/dydxprotocol/v4-chain/protocol/x/subaccounts/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAggregateUnrealizedPnl(t *testing.T) {
+	tests := map[string]struct {
+		// Setup.
+		perpetualId         uint32
+		subaccountPositions [][]*types.PerpetualPosition
+
+		// Expectations.
+		expectedPnl *big.Int
+		expectedErr error
+	}{
+		"No positions": {
+			perpetualId: 0,
+			expectedPnl: big.NewInt(0),
+		},
+		"Offsetting long and short positions opened at the same price net to zero": {
+			perpetualId: 0,
+			subaccountPositions: [][]*types.PerpetualPosition{
+				{
+					// 1 BTC long opened at $45,000, +$5,000 PnL.
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000),
+						big.NewInt(0),
+						big.NewInt(-45_000_000_000),
+					),
+				},
+				{
+					// 1 BTC short opened at $45,000, -$5,000 PnL.
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-100_000_000),
+						big.NewInt(0),
+						big.NewInt(45_000_000_000),
+					),
+				},
+			},
+			expectedPnl: big.NewInt(0),
+		},
+		"Offsetting long and short positions opened at different prices": {
+			perpetualId: 0,
+			subaccountPositions: [][]*types.PerpetualPosition{
+				{
+					// 1 BTC long opened at $45,000, +$5,000 PnL.
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(100_000_000),
+						big.NewInt(0),
+						big.NewInt(-45_000_000_000),
+					),
+					// Positions in other perpetuals are ignored.
+					&constants.PerpetualPosition_OneTenthEthLong,
+				},
+				{
+					// 0.5 BTC short opened at $48,000, -$1,000 PnL.
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-50_000_000),
+						big.NewInt(0),
+						big.NewInt(24_000_000_000),
+					),
+				},
+				{
+					// 0.5 BTC short opened at $52,000, +$1,000 PnL.
+					testutil.CreateSinglePerpetualPosition(
+						0,
+						big.NewInt(-50_000_000),
+						big.NewInt(0),
+						big.NewInt(26_000_000_000),
+					),
+				},
+			},
+			expectedPnl: big.NewInt(5_000_000_000),
+		},
+		"Non-existent perpetual": {
+			perpetualId: 99,
+			expectedErr: perptypes.ErrPerpetualDoesNotExist,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, keeper, pricesKeeper, perpetualsKeeper, _, _, _, _, _, _ := keepertest.SubaccountsKeepers(t, true)
+			keepertest.CreateTestMarkets(t, ctx, pricesKeeper)
+			keepertest.CreateTestLiquidityTiers(t, ctx, perpetualsKeeper)
+			keepertest.CreateTestPerpetuals(t, ctx, perpetualsKeeper)
+
+			subaccounts := createNSubaccount(keeper, ctx, len(tc.subaccountPositions), big.NewInt(1_000))
+			for i, positions := range tc.subaccountPositions {
+				subaccounts[i].PerpetualPositions = positions
+				keeper.SetSubaccount(ctx, subaccounts[i])
+			}
+
+			pnl, err := keeper.GetAggregateUnrealizedPnl(ctx, tc.perpetualId)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Zero(t, tc.expectedPnl.Cmp(pnl), "expected %v, got %v", tc.expectedPnl, pnl)
+		})
+	}
+}
@@ -370,12 +370,14 @@ func (k Keeper) UpdateSubaccounts(
 		)
 
 		// Emit an event indicating a funding payment was paid / received for each settled funding
-		// payment. Note that `fundingPaid` is positive if the subaccount paid funding,
-		// and negative if the subaccount received funding.
+		// payment and add it to the net funding of the perpetual for the current epoch. Note that
+		// `fundingPaid` is positive if the subaccount paid funding, and negative if the subaccount
+		// received funding.
 		// Note the perpetual IDs are sorted first to ensure event emission determinism.
 		sortedPerpIds := lib.GetSortedKeys[lib.Sortable[uint32]](fundingPayments)
 		for _, perpetualId := range sortedPerpIds {
 			fundingPaid := fundingPayments[perpetualId]
+			k.perpetualsKeeper.AddNetFundingPaid(ctx, perpetualId, fundingPaid.BigInt())
 			ctx.EventManager().EmitEvent(
 				types.NewCreateSettledFundingEvent(
 					*u.SettledSubaccount.Id,
@@ -465,6 +467,16 @@ func (k Keeper) internalCanUpdateSubaccounts(
 		return success, successPerUpdate, nil
 	}
 
+	// Check if the updates only reduce positions in perpetuals whose market has been delisted.
+	success, successPerUpdate = k.checkDelistedPerpetualConstraints(
+		ctx,
+		settledUpdates,
+		perpInfos,
+	)
+	if !success {
+		return success, successPerUpdate, nil
+	}
+
 	// Block all withdrawals and transfers if either of the following is true within the last
 	// `WITHDRAWAL_AND_TRANSFERS_BLOCKED_AFTER_NEGATIVE_TNC_SUBACCOUNT_SEEN_BLOCKS`:
 	// - There was a negative TNC subaccount seen for any of the collateral pools of subaccounts being updated
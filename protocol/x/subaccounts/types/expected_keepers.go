@@ -74,6 +74,7 @@ type PerpetualsKeeper interface {
 	GetInsuranceFundModuleAddress(ctx sdk.Context, perpetualId uint32) (sdk.AccAddress, error)
 	IsIsolatedPerpetual(ctx sdk.Context, perpetualId uint32) (bool, error)
 	ModifyOpenInterest(ctx sdk.Context, perpetualId uint32, bigQuantums *big.Int) error
+	AddNetFundingPaid(ctx sdk.Context, perpetualId uint32, fundingPaid *big.Int)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_dydxprotocol_v4_chain_protocol_dtypes "github.com/dydxprotocol/v4-chain/protocol/dtypes"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -438,6 +439,163 @@ func (m *QueryCollateralPoolAddressResponse) GetCollateralPoolAddress() string {
 	return ""
 }
 
+// QuerySubaccountPositionsRequest is the request type for the
+// SubaccountPositions RPC method.
+type QuerySubaccountPositionsRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Number uint32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (m *QuerySubaccountPositionsRequest) Reset()         { *m = QuerySubaccountPositionsRequest{} }
+func (m *QuerySubaccountPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountPositionsRequest) ProtoMessage()    {}
+func (*QuerySubaccountPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{8}
+}
+func (m *QuerySubaccountPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountPositionsRequest.Merge(m, src)
+}
+func (m *QuerySubaccountPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountPositionsRequest proto.InternalMessageInfo
+
+func (m *QuerySubaccountPositionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySubaccountPositionsRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+// QuerySubaccountPositionsResponse is the response type for the
+// SubaccountPositions RPC method.
+type QuerySubaccountPositionsResponse struct {
+	// The perpetual positions of the subaccount, sorted by perpetual id.
+	Positions []PerpetualPositionInfo `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *QuerySubaccountPositionsResponse) Reset()         { *m = QuerySubaccountPositionsResponse{} }
+func (m *QuerySubaccountPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubaccountPositionsResponse) ProtoMessage()    {}
+func (*QuerySubaccountPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{9}
+}
+func (m *QuerySubaccountPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubaccountPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubaccountPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubaccountPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubaccountPositionsResponse.Merge(m, src)
+}
+func (m *QuerySubaccountPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubaccountPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubaccountPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubaccountPositionsResponse proto.InternalMessageInfo
+
+func (m *QuerySubaccountPositionsResponse) GetPositions() []PerpetualPositionInfo {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+// PerpetualPositionInfo is a perpetual position of a subaccount, valued at
+// the current oracle price.
+type PerpetualPositionInfo struct {
+	// The `Id` of the `Perpetual`.
+	PerpetualId uint32 `protobuf:"varint,1,opt,name=perpetual_id,json=perpetualId,proto3" json:"perpetual_id,omitempty"`
+	// The signed size of the position in base quantums.
+	Quantums github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,2,opt,name=quantums,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"quantums"`
+	// The net notional of the position at the current oracle price, in quote
+	// quantums. Longs are positive, and shorts are negative.
+	NetNotional github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,3,opt,name=net_notional,json=netNotional,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"net_notional"`
+	// The funding payment that will be made when the position is next settled,
+	// in quote quantums. Positive values are paid by the subaccount, and
+	// negative values are received by the subaccount.
+	PendingFunding github_com_dydxprotocol_v4_chain_protocol_dtypes.SerializableInt `protobuf:"bytes,4,opt,name=pending_funding,json=pendingFunding,proto3,customtype=github.com/dydxprotocol/v4-chain/protocol/dtypes.SerializableInt" json:"pending_funding"`
+}
+
+func (m *PerpetualPositionInfo) Reset()         { *m = PerpetualPositionInfo{} }
+func (m *PerpetualPositionInfo) String() string { return proto.CompactTextString(m) }
+func (*PerpetualPositionInfo) ProtoMessage()    {}
+func (*PerpetualPositionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc19ff1d5b72954, []int{10}
+}
+func (m *PerpetualPositionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerpetualPositionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerpetualPositionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerpetualPositionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerpetualPositionInfo.Merge(m, src)
+}
+func (m *PerpetualPositionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerpetualPositionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerpetualPositionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerpetualPositionInfo proto.InternalMessageInfo
+
+func (m *PerpetualPositionInfo) GetPerpetualId() uint32 {
+	if m != nil {
+		return m.PerpetualId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryGetSubaccountRequest)(nil), "dydxprotocol.subaccounts.QueryGetSubaccountRequest")
 	proto.RegisterType((*QuerySubaccountResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountResponse")
@@ -447,6 +605,9 @@ func init() {
 	proto.RegisterType((*QueryGetWithdrawalAndTransfersBlockedInfoResponse)(nil), "dydxprotocol.subaccounts.QueryGetWithdrawalAndTransfersBlockedInfoResponse")
 	proto.RegisterType((*QueryCollateralPoolAddressRequest)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressRequest")
 	proto.RegisterType((*QueryCollateralPoolAddressResponse)(nil), "dydxprotocol.subaccounts.QueryCollateralPoolAddressResponse")
+	proto.RegisterType((*QuerySubaccountPositionsRequest)(nil), "dydxprotocol.subaccounts.QuerySubaccountPositionsRequest")
+	proto.RegisterType((*QuerySubaccountPositionsResponse)(nil), "dydxprotocol.subaccounts.QuerySubaccountPositionsResponse")
+	proto.RegisterType((*PerpetualPositionInfo)(nil), "dydxprotocol.subaccounts.PerpetualPositionInfo")
 }
 
 func init() {
@@ -454,56 +615,67 @@ func init() {
 }
 
 var fileDescriptor_adc19ff1d5b72954 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0x8d, 0xc3, 0x82, 0xb4, 0xc3, 0x72, 0x19, 0xf1, 0x23, 0x58, 0xab, 0x2c, 0x58, 0x59, 0x60,
-	0x57, 0x60, 0x6f, 0x80, 0xd5, 0x4a, 0xbb, 0x8b, 0xd4, 0xa4, 0x12, 0x14, 0x7a, 0x00, 0x02, 0x08,
-	0xa9, 0x52, 0x65, 0x8d, 0xed, 0xc1, 0x58, 0x35, 0x33, 0xc6, 0x33, 0x0e, 0x20, 0xc4, 0xa5, 0x7f,
-	0x41, 0xa5, 0x5e, 0x7a, 0xeb, 0xb5, 0xd7, 0xaa, 0xfd, 0x23, 0x38, 0xa2, 0xf6, 0xd2, 0x43, 0x55,
-	0x55, 0xa1, 0x7f, 0x48, 0x95, 0xf1, 0x24, 0x76, 0x00, 0x93, 0x80, 0x7a, 0xb3, 0x67, 0xbe, 0xf7,
-	0xbe, 0xf7, 0x9e, 0xfd, 0x7d, 0xa0, 0xe4, 0x9c, 0x38, 0xc7, 0x41, 0x48, 0x39, 0xb5, 0xa9, 0x6f,
-	0xb0, 0xc8, 0x42, 0xb6, 0x4d, 0x23, 0xc2, 0x99, 0x71, 0x18, 0xe1, 0xf0, 0x44, 0x17, 0x57, 0xb0,
-	0x90, 0xae, 0xd2, 0x53, 0x55, 0xea, 0xb8, 0x4d, 0xd9, 0x01, 0x65, 0xa6, 0xb8, 0x34, 0xe2, 0x97,
-	0x18, 0xa4, 0x0e, 0xbb, 0xd4, 0xa5, 0xf1, 0x79, 0xf3, 0x49, 0x9e, 0xfe, 0xea, 0x52, 0xea, 0xfa,
-	0xd8, 0x40, 0x81, 0x67, 0x20, 0x42, 0x28, 0x47, 0xdc, 0xa3, 0xa4, 0x85, 0xf9, 0x33, 0x66, 0x30,
-	0x2c, 0xc4, 0x70, 0xac, 0xc0, 0xa8, 0x97, 0x2d, 0xcc, 0x51, 0xd9, 0x08, 0x90, 0xeb, 0x11, 0x51,
-	0x2c, 0x6b, 0xff, 0xc8, 0x94, 0x9e, 0x3c, 0xc7, 0xa5, 0x9a, 0x0d, 0xc6, 0x37, 0x9b, 0x64, 0x2b,
-	0x98, 0x6f, 0xb5, 0xef, 0x6a, 0xf8, 0x30, 0xc2, 0x8c, 0x43, 0x1d, 0xf4, 0xd3, 0x23, 0x82, 0xc3,
-	0x82, 0x32, 0xa1, 0xcc, 0xfc, 0x5c, 0x2d, 0x7c, 0x78, 0x3f, 0x37, 0x2c, 0x8d, 0x54, 0x1c, 0x27,
-	0xc4, 0x8c, 0x6d, 0xf1, 0xd0, 0x23, 0x6e, 0x2d, 0x2e, 0x83, 0xa3, 0x60, 0x80, 0x44, 0x07, 0x16,
-	0x0e, 0x0b, 0xf9, 0x09, 0x65, 0x66, 0xa8, 0x26, 0xdf, 0x34, 0x0c, 0xc6, 0x44, 0x93, 0x74, 0x07,
-	0x16, 0x50, 0xc2, 0x30, 0x5c, 0x03, 0x20, 0xd1, 0x24, 0xfa, 0x0c, 0xce, 0x97, 0xf4, 0xac, 0x50,
-	0xf5, 0x84, 0xa1, 0xfa, 0xd3, 0xf9, 0x97, 0xdf, 0x72, 0xb5, 0x14, 0xba, 0xed, 0xa5, 0xe2, 0xfb,
-	0xd7, 0xbd, 0x2c, 0x03, 0x90, 0xe4, 0x24, 0x1b, 0x4d, 0xe9, 0xd2, 0x4d, 0x33, 0x54, 0x3d, 0xfe,
-	0xac, 0x32, 0x54, 0x7d, 0x03, 0xb9, 0x58, 0x62, 0x6b, 0x29, 0xa4, 0xf6, 0x56, 0x01, 0xea, 0x15,
-	0x33, 0x15, 0xdf, 0xcf, 0xf4, 0xd3, 0x77, 0x7f, 0x3f, 0x70, 0xa5, 0x43, 0x72, 0x5e, 0x48, 0x9e,
-	0xee, 0x2a, 0x39, 0x16, 0xd2, 0xa1, 0x79, 0x07, 0xfc, 0xd5, 0xfa, 0xc8, 0xbb, 0x1e, 0xdf, 0x77,
-	0x42, 0x74, 0x84, 0xfc, 0x0a, 0x71, 0xb6, 0x43, 0x44, 0xd8, 0x1e, 0x0e, 0x59, 0xd5, 0xa7, 0xf6,
-	0x33, 0xec, 0xac, 0x92, 0x3d, 0xda, 0xca, 0x6b, 0x12, 0xfc, 0x12, 0xe0, 0x30, 0xc0, 0x3c, 0x42,
-	0xbe, 0xe9, 0x39, 0x22, 0xb1, 0xa1, 0xda, 0x60, 0xfb, 0x6c, 0xd5, 0xd1, 0x5e, 0xe7, 0x41, 0xf9,
-	0x0e, 0xbc, 0x32, 0xa1, 0x75, 0xf0, 0x3b, 0xc1, 0x2e, 0xe2, 0x5e, 0x1d, 0x9b, 0x9c, 0xd8, 0x66,
-	0x62, 0xd8, 0x64, 0x18, 0x13, 0x13, 0x71, 0xd3, 0x6a, 0xc2, 0x64, 0xc7, 0x89, 0x56, 0xf1, 0x36,
-	0xb1, 0x93, 0xb4, 0xb6, 0x30, 0x26, 0x15, 0x2e, 0xe8, 0xe1, 0xbf, 0x40, 0xb5, 0xf7, 0x91, 0x47,
-	0x4c, 0x1a, 0x71, 0xe4, 0xe2, 0x2b, 0x2c, 0xf1, 0x9f, 0x38, 0x2a, 0x2a, 0xd6, 0x45, 0x41, 0x1a,
-	0xfb, 0x14, 0xcc, 0x1e, 0xb5, 0x95, 0x33, 0x13, 0x11, 0xc7, 0xe4, 0x2d, 0xf1, 0x66, 0x44, 0xac,
-	0x58, 0x7f, 0xc2, 0xd6, 0x27, 0xd8, 0xa6, 0x53, 0x98, 0xb4, 0xdd, 0x9d, 0x16, 0x40, 0xd2, 0x6b,
-	0xcb, 0x60, 0x52, 0x04, 0xf4, 0x90, 0xfa, 0x3e, 0xe2, 0x38, 0x44, 0xfe, 0x06, 0xa5, 0xbe, 0x9c,
-	0x9d, 0x3b, 0x24, 0x5d, 0x07, 0xda, 0x6d, 0x3c, 0x32, 0xd9, 0x0d, 0x30, 0x66, 0xb7, 0x0b, 0xcc,
-	0x80, 0x52, 0xdf, 0x44, 0x71, 0x49, 0xd7, 0x01, 0x1e, 0xb1, 0x6f, 0x62, 0x9e, 0x6f, 0x0c, 0x80,
-	0x7e, 0xd1, 0x18, 0xbe, 0x53, 0x00, 0x48, 0xe2, 0x87, 0x0b, 0xd9, 0xbf, 0x74, 0xe6, 0x3a, 0x51,
-	0xcb, 0x5d, 0x40, 0xd7, 0xd7, 0x83, 0xb6, 0xf4, 0xfc, 0xe3, 0xb7, 0x97, 0xf9, 0x7f, 0xe0, 0xdf,
-	0x46, 0x0f, 0x2b, 0xcd, 0x38, 0x15, 0x6b, 0xe8, 0xcc, 0x38, 0x8d, 0xf7, 0xce, 0x19, 0x7c, 0xa3,
-	0x80, 0xa1, 0x8e, 0x39, 0xed, 0x2a, 0xfc, 0xa6, 0xdd, 0xa1, 0x2e, 0xf6, 0x2c, 0x3c, 0xb5, 0x0a,
-	0xb4, 0x59, 0xa1, 0x7d, 0x0a, 0x96, 0x7a, 0xd1, 0x0e, 0x5f, 0xe5, 0x41, 0xa9, 0x97, 0x39, 0x82,
-	0x6b, 0xdd, 0xa3, 0xef, 0x75, 0xc8, 0xd5, 0xc7, 0x3f, 0x84, 0x4b, 0xfa, 0xdd, 0x15, 0x7e, 0x37,
-	0xe1, 0x7a, 0xb6, 0xdf, 0xec, 0x59, 0x6b, 0x4d, 0x9a, 0x47, 0xf6, 0xa8, 0x71, 0x9a, 0x9e, 0x87,
-	0x33, 0xf8, 0x59, 0x01, 0x23, 0x37, 0xfe, 0xf9, 0xf0, 0xbf, 0x2e, 0xfa, 0x6f, 0x9b, 0x3b, 0xf5,
-	0xff, 0xfb, 0x81, 0xa5, 0xdb, 0x47, 0xc2, 0x6d, 0x15, 0x3e, 0xc8, 0x76, 0x9b, 0x31, 0x8c, 0x57,
-	0xec, 0x55, 0x77, 0xcf, 0x1b, 0x45, 0xe5, 0xa2, 0x51, 0x54, 0xbe, 0x36, 0x8a, 0xca, 0x8b, 0xcb,
-	0x62, 0xee, 0xe2, 0xb2, 0x98, 0xfb, 0x74, 0x59, 0xcc, 0x3d, 0x59, 0x72, 0x3d, 0xbe, 0x1f, 0x59,
-	0xba, 0x4d, 0x0f, 0x3a, 0xbb, 0xd4, 0x17, 0xe7, 0xc4, 0x42, 0x33, 0xda, 0x27, 0xc7, 0x1d, 0x9d,
-	0xf9, 0x49, 0x80, 0x99, 0x35, 0x20, 0x6e, 0x17, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x51,
-	0x19, 0x95, 0xca, 0x08, 0x00, 0x00,
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6b, 0xdc, 0x46,
+	0x14, 0xb6, 0xd6, 0x49, 0x48, 0x9e, 0xed, 0x16, 0xa6, 0x71, 0xb2, 0x59, 0xca, 0xda, 0x11, 0x6e,
+	0x92, 0x96, 0x44, 0xaa, 0x13, 0xb7, 0xa5, 0x69, 0x02, 0xd9, 0x2d, 0x38, 0x71, 0x0a, 0xf5, 0x66,
+	0x37, 0xc1, 0x50, 0x28, 0x62, 0x24, 0x8d, 0x65, 0x91, 0xf1, 0x8c, 0xac, 0x19, 0xd9, 0x71, 0x8d,
+	0xa1, 0xf4, 0x17, 0x14, 0x7a, 0xe9, 0xad, 0xd7, 0xde, 0x4a, 0x69, 0x7f, 0x44, 0xe8, 0x29, 0xb4,
+	0x97, 0x52, 0x4a, 0x28, 0x76, 0x7f, 0x48, 0xd8, 0xd1, 0x68, 0xa5, 0x5d, 0xaf, 0xb2, 0x1b, 0xe3,
+	0xd3, 0xae, 0x66, 0xde, 0xfb, 0xde, 0xf7, 0xbd, 0x79, 0xf3, 0x0d, 0x2c, 0xf8, 0xbb, 0xfe, 0xb3,
+	0x28, 0xe6, 0x92, 0x7b, 0x9c, 0xda, 0x22, 0x71, 0xb1, 0xe7, 0xf1, 0x84, 0x49, 0x61, 0x6f, 0x25,
+	0x24, 0xde, 0xb5, 0xd4, 0x16, 0xaa, 0x16, 0xa3, 0xac, 0x42, 0x54, 0xed, 0x92, 0xc7, 0xc5, 0x26,
+	0x17, 0x8e, 0xda, 0xb4, 0xd3, 0x8f, 0x34, 0xa9, 0x76, 0x3e, 0xe0, 0x01, 0x4f, 0xd7, 0xbb, 0xff,
+	0xf4, 0xea, 0xbb, 0x01, 0xe7, 0x01, 0x25, 0x36, 0x8e, 0x42, 0x1b, 0x33, 0xc6, 0x25, 0x96, 0x21,
+	0x67, 0x59, 0xce, 0x07, 0x29, 0x82, 0xed, 0x62, 0x41, 0x52, 0x06, 0xf6, 0xf6, 0xa2, 0x4b, 0x24,
+	0x5e, 0xb4, 0x23, 0x1c, 0x84, 0x4c, 0x05, 0xeb, 0xd8, 0xf7, 0x4b, 0xa9, 0xe7, 0xff, 0xd3, 0x50,
+	0xd3, 0x83, 0x4b, 0x8f, 0xba, 0x60, 0xf7, 0x89, 0xec, 0xf4, 0xf6, 0xda, 0x64, 0x2b, 0x21, 0x42,
+	0x22, 0x0b, 0x4e, 0xf3, 0x1d, 0x46, 0xe2, 0xaa, 0x31, 0x6f, 0x5c, 0x3b, 0xd7, 0xac, 0xfe, 0xf9,
+	0xfb, 0x8d, 0xf3, 0x5a, 0x48, 0xc3, 0xf7, 0x63, 0x22, 0x44, 0x47, 0xc6, 0x21, 0x0b, 0xda, 0x69,
+	0x18, 0xba, 0x00, 0x67, 0x58, 0xb2, 0xe9, 0x92, 0xb8, 0x5a, 0x99, 0x37, 0xae, 0xcd, 0xb4, 0xf5,
+	0x97, 0x49, 0xe0, 0xa2, 0x2a, 0x52, 0xac, 0x20, 0x22, 0xce, 0x04, 0x41, 0x0f, 0x01, 0x72, 0x4e,
+	0xaa, 0xce, 0xd4, 0xcd, 0x05, 0xab, 0xac, 0xa9, 0x56, 0x8e, 0xd0, 0x3c, 0xf5, 0xfc, 0xe5, 0xdc,
+	0x44, 0xbb, 0x90, 0xdd, 0xd3, 0xd2, 0xa0, 0xf4, 0xa8, 0x96, 0x65, 0x80, 0xbc, 0x4f, 0xba, 0xd0,
+	0x15, 0x4b, 0xab, 0xe9, 0x36, 0xd5, 0x4a, 0x8f, 0x55, 0x37, 0xd5, 0x6a, 0xe1, 0x80, 0xe8, 0xdc,
+	0x76, 0x21, 0xd3, 0xfc, 0xd5, 0x80, 0xda, 0x80, 0x98, 0x06, 0xa5, 0xa5, 0x7a, 0x26, 0x8f, 0xaf,
+	0x07, 0xdd, 0xef, 0xa3, 0x5c, 0x51, 0x94, 0xaf, 0x8e, 0xa4, 0x9c, 0x12, 0xe9, 0xe3, 0xfc, 0x04,
+	0x3e, 0xcc, 0x0e, 0x79, 0x2d, 0x94, 0x1b, 0x7e, 0x8c, 0x77, 0x30, 0x6d, 0x30, 0xff, 0x71, 0x8c,
+	0x99, 0x58, 0x27, 0xb1, 0x68, 0x52, 0xee, 0x3d, 0x25, 0xfe, 0x0a, 0x5b, 0xe7, 0x59, 0xbf, 0x2e,
+	0xc3, 0x74, 0x44, 0xe2, 0x88, 0xc8, 0x04, 0x53, 0x27, 0xf4, 0x55, 0xc7, 0x66, 0xda, 0x53, 0xbd,
+	0xb5, 0x15, 0xdf, 0xfc, 0xa9, 0x02, 0x8b, 0x6f, 0x80, 0xab, 0x3b, 0xb4, 0x0a, 0xef, 0x31, 0x12,
+	0x60, 0x19, 0x6e, 0x13, 0x47, 0x32, 0xcf, 0xc9, 0x05, 0x3b, 0x82, 0x10, 0xe6, 0x60, 0xe9, 0xb8,
+	0xdd, 0x34, 0x5d, 0x71, 0x3e, 0x0b, 0x7e, 0xcc, 0xbc, 0xbc, 0x5b, 0x1d, 0x42, 0x58, 0x43, 0x2a,
+	0x78, 0x74, 0x1b, 0x6a, 0xde, 0x06, 0x0e, 0x99, 0xc3, 0x13, 0x89, 0x03, 0x32, 0x80, 0x92, 0x4e,
+	0xe2, 0x05, 0x15, 0xb1, 0xaa, 0x02, 0x8a, 0xb9, 0x5f, 0xc3, 0xf5, 0x9d, 0x1e, 0x73, 0xe1, 0x60,
+	0xe6, 0x3b, 0x32, 0x23, 0xef, 0x24, 0xcc, 0x4d, 0xf9, 0xe7, 0x68, 0x93, 0x0a, 0xed, 0x6a, 0x21,
+	0xa7, 0x28, 0xf7, 0x49, 0x96, 0xa0, 0xe1, 0xcd, 0x65, 0xb8, 0xac, 0x1a, 0xf4, 0x39, 0xa7, 0x14,
+	0x4b, 0x12, 0x63, 0xda, 0xe2, 0x9c, 0xea, 0xbb, 0xf3, 0x06, 0x9d, 0xde, 0x06, 0xf3, 0x75, 0x38,
+	0xba, 0xb3, 0x2d, 0xb8, 0xe8, 0xf5, 0x02, 0x9c, 0x88, 0x73, 0xea, 0xe0, 0x34, 0x64, 0xe4, 0x05,
+	0x9e, 0xf5, 0x86, 0x21, 0x9b, 0x21, 0xcc, 0x0d, 0xcc, 0x7a, 0x8b, 0x8b, 0x50, 0xd9, 0xd2, 0x49,
+	0x7b, 0xc4, 0x0e, 0xcc, 0x97, 0x97, 0xd2, 0x02, 0x3b, 0x70, 0x2e, 0xca, 0x16, 0xf5, 0xdd, 0xb2,
+	0xcb, 0xef, 0x56, 0x2b, 0x6b, 0x60, 0x06, 0xd4, 0x1d, 0x43, 0x7d, 0xcd, 0x72, 0x1c, 0xf3, 0xdb,
+	0x49, 0x98, 0x1d, 0x1a, 0x3a, 0xc6, 0xc1, 0x20, 0x1f, 0xce, 0x6e, 0x25, 0x98, 0xc9, 0x64, 0x53,
+	0x28, 0x3d, 0xd3, 0xcd, 0x07, 0x5d, 0xfc, 0x7f, 0x5e, 0xce, 0xdd, 0x0b, 0x42, 0xb9, 0x91, 0xb8,
+	0x96, 0xc7, 0x37, 0xed, 0x3e, 0x3b, 0xde, 0x5e, 0xba, 0xa1, 0x86, 0xd1, 0xee, 0xad, 0xf8, 0x72,
+	0x37, 0x22, 0xc2, 0xea, 0x90, 0x38, 0xc4, 0x34, 0xfc, 0x06, 0xbb, 0x94, 0xac, 0x30, 0xd9, 0xee,
+	0x21, 0xa3, 0xa7, 0x30, 0xcd, 0x88, 0x74, 0x18, 0xef, 0x52, 0xc3, 0x54, 0x4d, 0xe1, 0x49, 0x56,
+	0x9a, 0x62, 0x44, 0x7e, 0xa9, 0xc1, 0xd1, 0x16, 0xbc, 0x1d, 0x11, 0xe6, 0x87, 0x2c, 0x70, 0xd6,
+	0x13, 0xf5, 0x5b, 0x3d, 0x75, 0xc2, 0xf5, 0xde, 0xd2, 0x05, 0x96, 0x53, 0xfc, 0x9b, 0xbf, 0x9c,
+	0x85, 0xd3, 0xea, 0xf0, 0xd1, 0x6f, 0x06, 0x40, 0x3e, 0x01, 0xe8, 0x56, 0xf9, 0xe9, 0x96, 0xbe,
+	0x5a, 0xb5, 0xc5, 0x11, 0x49, 0x47, 0x5f, 0x21, 0xf3, 0xee, 0x77, 0x7f, 0xfd, 0xff, 0x43, 0xe5,
+	0x13, 0xf4, 0x91, 0x3d, 0xc6, 0xcb, 0x69, 0xef, 0xa9, 0x49, 0xde, 0xb7, 0xf7, 0xd2, 0xd1, 0xdd,
+	0x47, 0x3f, 0x1b, 0x30, 0xd3, 0xf7, 0x1c, 0x8c, 0x24, 0x3e, 0xec, 0x89, 0xaa, 0x2d, 0x8d, 0x4d,
+	0xbc, 0xf0, 0xe2, 0x98, 0xd7, 0x15, 0xf7, 0x2b, 0x68, 0x61, 0x1c, 0xee, 0xe8, 0xc7, 0x0a, 0x2c,
+	0x8c, 0x63, 0xd7, 0xe8, 0xe1, 0xe8, 0xd6, 0x8f, 0xfb, 0x96, 0xd4, 0xbe, 0x38, 0x11, 0x2c, 0xad,
+	0x77, 0x4d, 0xe9, 0x7d, 0x84, 0x56, 0xcb, 0xf5, 0x96, 0x5b, 0x7a, 0x66, 0xe8, 0x21, 0x5b, 0xe7,
+	0xf6, 0x5e, 0xf1, 0x76, 0xef, 0xa3, 0x7f, 0x0d, 0x98, 0x1d, 0x6a, 0xb0, 0xe8, 0xb3, 0x11, 0xfc,
+	0x5f, 0x67, 0xef, 0xb5, 0x3b, 0xc7, 0x4b, 0xd6, 0x6a, 0x1f, 0x28, 0xb5, 0x4d, 0x74, 0xaf, 0x5c,
+	0x6d, 0x89, 0xe7, 0x0f, 0xca, 0xfb, 0xc3, 0x80, 0x77, 0x86, 0x98, 0x2b, 0xfa, 0x74, 0xec, 0xa9,
+	0x1b, 0xf4, 0xfe, 0xda, 0xed, 0xe3, 0xa4, 0x6a, 0x61, 0x77, 0x94, 0xb0, 0x8f, 0xd1, 0x52, 0xb9,
+	0xb0, 0x9e, 0x47, 0x1f, 0xb9, 0x71, 0xcd, 0xb5, 0xe7, 0x07, 0x75, 0xe3, 0xc5, 0x41, 0xdd, 0xf8,
+	0xef, 0xa0, 0x6e, 0x7c, 0x7f, 0x58, 0x9f, 0x78, 0x71, 0x58, 0x9f, 0xf8, 0xfb, 0xb0, 0x3e, 0xf1,
+	0xd5, 0xdd, 0xf1, 0xdd, 0xe9, 0x59, 0x5f, 0x35, 0x65, 0x55, 0xee, 0x19, 0xb5, 0x7b, 0xeb, 0x55,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb, 0xfa, 0x41, 0xfe, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -527,6 +699,9 @@ type QueryClient interface {
 	GetWithdrawalAndTransfersBlockedInfo(ctx context.Context, in *QueryGetWithdrawalAndTransfersBlockedInfoRequest, opts ...grpc.CallOption) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
 	// Queries the collateral pool account address for a perpetual id.
 	CollateralPoolAddress(ctx context.Context, in *QueryCollateralPoolAddressRequest, opts ...grpc.CallOption) (*QueryCollateralPoolAddressResponse, error)
+	// Queries the perpetual positions of a Subaccount, along with their current
+	// net notional and pending funding.
+	SubaccountPositions(ctx context.Context, in *QuerySubaccountPositionsRequest, opts ...grpc.CallOption) (*QuerySubaccountPositionsResponse, error)
 }
 
 type queryClient struct {
@@ -573,6 +748,15 @@ func (c *queryClient) CollateralPoolAddress(ctx context.Context, in *QueryCollat
 	return out, nil
 }
 
+func (c *queryClient) SubaccountPositions(ctx context.Context, in *QuerySubaccountPositionsRequest, opts ...grpc.CallOption) (*QuerySubaccountPositionsResponse, error) {
+	out := new(QuerySubaccountPositionsResponse)
+	err := c.cc.Invoke(ctx, "/dydxprotocol.subaccounts.Query/SubaccountPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a Subaccount by id
@@ -584,6 +768,9 @@ type QueryServer interface {
 	GetWithdrawalAndTransfersBlockedInfo(context.Context, *QueryGetWithdrawalAndTransfersBlockedInfoRequest) (*QueryGetWithdrawalAndTransfersBlockedInfoResponse, error)
 	// Queries the collateral pool account address for a perpetual id.
 	CollateralPoolAddress(context.Context, *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error)
+	// Queries the perpetual positions of a Subaccount, along with their current
+	// net notional and pending funding.
+	SubaccountPositions(context.Context, *QuerySubaccountPositionsRequest) (*QuerySubaccountPositionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -602,6 +789,9 @@ func (*UnimplementedQueryServer) GetWithdrawalAndTransfersBlockedInfo(ctx contex
 func (*UnimplementedQueryServer) CollateralPoolAddress(ctx context.Context, req *QueryCollateralPoolAddressRequest) (*QueryCollateralPoolAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollateralPoolAddress not implemented")
 }
+func (*UnimplementedQueryServer) SubaccountPositions(ctx context.Context, req *QuerySubaccountPositionsRequest) (*QuerySubaccountPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubaccountPositions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -679,6 +869,24 @@ func _Query_CollateralPoolAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SubaccountPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubaccountPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubaccountPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dydxprotocol.subaccounts.Query/SubaccountPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubaccountPositions(ctx, req.(*QuerySubaccountPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dydxprotocol.subaccounts.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -699,6 +907,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CollateralPoolAddress",
 			Handler:    _Query_CollateralPoolAddress_Handler,
 		},
+		{
+			MethodName: "SubaccountPositions",
+			Handler:    _Query_SubaccountPositions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dydxprotocol/subaccounts/query.proto",
@@ -980,6 +1192,136 @@ func (m *QueryCollateralPoolAddressResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySubaccountPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubaccountPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubaccountPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubaccountPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerpetualPositionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerpetualPositionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerpetualPositionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PendingFunding.Size()
+		i -= size
+		if _, err := m.PendingFunding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NetNotional.Size()
+		i -= size
+		if _, err := m.NetNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Quantums.Size()
+		i -= size
+		if _, err := m.Quantums.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PerpetualId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerpetualId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1105,23 +1447,72 @@ func (m *QueryCollateralPoolAddressResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySubaccountPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySubaccountPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+
+func (m *PerpetualPositionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PerpetualId != 0 {
+		n += 1 + sovQuery(uint64(m.PerpetualId))
+	}
+	l = m.Quantums.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingFunding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetSubaccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1828,6 +2219,359 @@ func (m *QueryCollateralPoolAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySubaccountPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubaccountPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubaccountPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubaccountPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PerpetualPositionInfo{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerpetualPositionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerpetualPositionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerpetualPositionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpetualId", wireType)
+			}
+			m.PerpetualId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerpetualId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetNotional", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingFunding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingFunding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -62,6 +62,9 @@ var updateResultStringMap = map[UpdateResult]string{
 	WithdrawalsAndTransfersBlocked:        "WithdrawalsAndTransfersBlocked",
 	UpdateCausedError:                     "UpdateCausedError",
 	ViolatesIsolatedSubaccountConstraints: "ViolatesIsolatedSubaccountConstraints",
+	ViolatesDelistedPerpetualConstraints:  "ViolatesDelistedPerpetualConstraints",
+	ViolatesStaleMarketPriceConstraints:   "ViolatesStaleMarketPriceConstraints",
+	ViolatesMinCollateralToOpenPosition:   "ViolatesMinCollateralToOpenPosition",
 }
 
 const (
@@ -71,6 +74,9 @@ const (
 	WithdrawalsAndTransfersBlocked
 	UpdateCausedError
 	ViolatesIsolatedSubaccountConstraints
+	ViolatesDelistedPerpetualConstraints
+	ViolatesStaleMarketPriceConstraints
+	ViolatesMinCollateralToOpenPosition
 )
 
 // Update is used by the subaccounts keeper to allow other modules
@@ -92,8 +92,20 @@ func TestUpdateResultString(t *testing.T) {
 			value:          types.ViolatesIsolatedSubaccountConstraints,
 			expectedResult: "ViolatesIsolatedSubaccountConstraints",
 		},
+		"ViolatesDelistedPerpetualConstraints": {
+			value:          types.ViolatesDelistedPerpetualConstraints,
+			expectedResult: "ViolatesDelistedPerpetualConstraints",
+		},
+		"ViolatesStaleMarketPriceConstraints": {
+			value:          types.ViolatesStaleMarketPriceConstraints,
+			expectedResult: "ViolatesStaleMarketPriceConstraints",
+		},
+		"ViolatesMinCollateralToOpenPosition": {
+			value:          types.ViolatesMinCollateralToOpenPosition,
+			expectedResult: "ViolatesMinCollateralToOpenPosition",
+		},
 		"UnexpectedError": {
-			value:          types.UpdateResult(6),
+			value:          types.UpdateResult(9),
 			expectedResult: "UnexpectedError",
 		},
 	}